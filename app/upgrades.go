@@ -6,6 +6,7 @@ import (
 	"github.com/allora-network/allora-chain/app/upgrades/v0_4_0"
 	"github.com/allora-network/allora-chain/app/upgrades/v0_5_0"
 	"github.com/allora-network/allora-chain/app/upgrades/v0_6_0"
+	"github.com/allora-network/allora-chain/app/upgrades/v0_7_0"
 )
 
 var upgradeHandlers = []upgrades.Upgrade{
@@ -13,6 +14,7 @@ var upgradeHandlers = []upgrades.Upgrade{
 	v0_4_0.Upgrade,
 	v0_5_0.Upgrade,
 	v0_6_0.Upgrade,
+	v0_7_0.Upgrade,
 	// Add more upgrade handlers here
 	// ...
 }
@@ -184,6 +184,25 @@ func Median(data []Dec) (Dec, error) {
 	return sum.Quo(NewDecFromInt64(2))
 }
 
+// L2Norm calculates the euclidean norm of a slice of `Dec`
+func L2Norm(data []Dec) (Dec, error) {
+	sumSquares := ZeroDec()
+	for _, v := range data {
+		if v.isNaN {
+			return Dec{}, errorsmod.Wrap(ErrNaN, "norm input data contains NaN values")
+		}
+		square, err := v.Mul(v)
+		if err != nil {
+			return Dec{}, err
+		}
+		sumSquares, err = sumSquares.Add(square)
+		if err != nil {
+			return Dec{}, err
+		}
+	}
+	return sumSquares.Sqrt()
+}
+
 // Implements the new gradient function phi prime
 // φ'_p(x) = p / (exp(p * (c - x)) + 1)
 func Gradient(p, c, x Dec) (Dec, error) {
@@ -268,6 +268,22 @@ func TestMedianWithNaN(t *testing.T) {
 	require.ErrorIs(t, err, alloraMath.ErrNaN)
 }
 
+func TestL2Norm(t *testing.T) {
+	result, err := alloraMath.L2Norm([]alloraMath.Dec{
+		alloraMath.MustNewDecFromString("3"),
+		alloraMath.MustNewDecFromString("-4"),
+	})
+	require.NoError(t, err)
+	require.True(t, alloraMath.MustNewDecFromString("5").Equal(result))
+
+	result, err = alloraMath.L2Norm([]alloraMath.Dec{})
+	require.NoError(t, err)
+	require.True(t, result.IsZero())
+
+	_, err = alloraMath.L2Norm([]alloraMath.Dec{alloraMath.OneDec(), alloraMath.NewNaN()})
+	require.ErrorIs(t, err, alloraMath.ErrNaN)
+}
+
 func TestWeightedInferences(t *testing.T) {
 	data := []alloraMath.Dec{
 		alloraMath.MustNewDecFromString("1"),
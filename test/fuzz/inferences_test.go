@@ -159,6 +159,7 @@ func createWorkerDataBundle(
 				Value:       infererValue,
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &emissionstypes.Forecast{
 				TopicId:          topicId,
@@ -276,6 +277,8 @@ func createReputerValueBundle(
 			ReputerNonce: reputerNonce,
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 }
 
@@ -37,6 +37,16 @@ func createTopic(
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmossdk_io_math.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	ctx := context.Background()
@@ -3,6 +3,7 @@ package integration_test
 import (
 	"context"
 
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	testCommon "github.com/allora-network/allora-chain/test/common"
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
@@ -34,6 +35,16 @@ func CreateTopic(m testCommon.TestConfig) (topicId uint64) {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	txResp, err := m.Client.BroadcastTx(ctx, m.AliceAcc, createTopicRequest)
 	require.NoError(m.T, err)
@@ -49,6 +49,7 @@ func InsertSingleWorkerPayload(m testCommon.TestConfig, topic *types.Topic, bloc
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:     topicId,
@@ -178,6 +179,8 @@ func InsertReputerBundle(m testCommon.TestConfig, topic *types.Topic, BlockHeigh
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	// Sign
@@ -32,6 +32,16 @@ func createTopic(
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	txResp, err := m.Client.BroadcastTx(ctx, creator.aa.acc, createTopicRequest)
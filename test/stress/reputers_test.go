@@ -211,6 +211,8 @@ func generateValueBundle(
 		OneOutForecasterValues:        generateWithheldWorkerAttributedValueLosses(workerAddresses, 50, 50),
 		OneInForecasterValues:         generateWorkerAttributedValueLosses(workerAddresses, 50, 50),
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 }
 
@@ -194,6 +194,7 @@ func generateSingleWorkerBundle(
 				Value:       infererValue,
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &emissionstypes.Forecast{
 				TopicId:          topicId,
@@ -480,6 +480,8 @@ func GetNetworkLossFromCsv(
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 		OneOutInfererValues: []*emissionstypes.WithheldWorkerAttributedValue{
 			{
 				Worker: inferers[0],
@@ -745,6 +747,8 @@ func GetReputersDataFromCsv(
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 	}
 	signature1 := signValueBundle(valueBundle1, reputers[0].PrivateKey)
 	reputerValueBundle1 := &emissionstypes.ReputerValueBundle{
@@ -925,6 +929,8 @@ func GetReputersDataFromCsv(
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 	}
 	signature2 := signValueBundle(valueBundle2, reputers[1].PrivateKey)
 	reputerValueBundle2 := &emissionstypes.ReputerValueBundle{
@@ -1105,6 +1111,8 @@ func GetReputersDataFromCsv(
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 	}
 	signature3 := signValueBundle(valueBundle3, reputers[2].PrivateKey)
 	reputerValueBundle3 := &emissionstypes.ReputerValueBundle{
@@ -1285,6 +1293,8 @@ func GetReputersDataFromCsv(
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 	}
 	signature4 := signValueBundle(valueBundle4, reputers[3].PrivateKey)
 	reputerValueBundle4 := &emissionstypes.ReputerValueBundle{
@@ -1465,6 +1475,8 @@ func GetReputersDataFromCsv(
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 	}
 	signature5 := signValueBundle(valueBundle5, reputers[4].PrivateKey)
 	reputerValueBundle5 := &emissionstypes.ReputerValueBundle{
@@ -3,7 +3,10 @@ package emissionsv3
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -496,6 +499,2548 @@ func (x *fastReflection_OffchainNode) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_ActorLiveness                       protoreflect.MessageDescriptor
+	fd_ActorLiveness_last_submission_nonce protoreflect.FieldDescriptor
+	fd_ActorLiveness_active_since          protoreflect.FieldDescriptor
+	fd_ActorLiveness_jailed                protoreflect.FieldDescriptor
+	fd_ActorLiveness_jailed_at_block       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_node_proto_init()
+	md_ActorLiveness = File_emissions_v3_node_proto.Messages().ByName("ActorLiveness")
+	fd_ActorLiveness_last_submission_nonce = md_ActorLiveness.Fields().ByName("last_submission_nonce")
+	fd_ActorLiveness_active_since = md_ActorLiveness.Fields().ByName("active_since")
+	fd_ActorLiveness_jailed = md_ActorLiveness.Fields().ByName("jailed")
+	fd_ActorLiveness_jailed_at_block = md_ActorLiveness.Fields().ByName("jailed_at_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_ActorLiveness)(nil)
+
+type fastReflection_ActorLiveness ActorLiveness
+
+func (x *ActorLiveness) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActorLiveness)(x)
+}
+
+func (x *ActorLiveness) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_node_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ActorLiveness_messageType fastReflection_ActorLiveness_messageType
+var _ protoreflect.MessageType = fastReflection_ActorLiveness_messageType{}
+
+type fastReflection_ActorLiveness_messageType struct{}
+
+func (x fastReflection_ActorLiveness_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActorLiveness)(nil)
+}
+func (x fastReflection_ActorLiveness_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActorLiveness)
+}
+func (x fastReflection_ActorLiveness_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActorLiveness
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ActorLiveness) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActorLiveness
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ActorLiveness) Type() protoreflect.MessageType {
+	return _fastReflection_ActorLiveness_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ActorLiveness) New() protoreflect.Message {
+	return new(fastReflection_ActorLiveness)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ActorLiveness) Interface() protoreflect.ProtoMessage {
+	return (*ActorLiveness)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ActorLiveness) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LastSubmissionNonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastSubmissionNonce)
+		if !f(fd_ActorLiveness_last_submission_nonce, value) {
+			return
+		}
+	}
+	if x.ActiveSince != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActiveSince)
+		if !f(fd_ActorLiveness_active_since, value) {
+			return
+		}
+	}
+	if x.Jailed != false {
+		value := protoreflect.ValueOfBool(x.Jailed)
+		if !f(fd_ActorLiveness_jailed, value) {
+			return
+		}
+	}
+	if x.JailedAtBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.JailedAtBlock)
+		if !f(fd_ActorLiveness_jailed_at_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ActorLiveness) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.ActorLiveness.last_submission_nonce":
+		return x.LastSubmissionNonce != int64(0)
+	case "emissions.v3.ActorLiveness.active_since":
+		return x.ActiveSince != int64(0)
+	case "emissions.v3.ActorLiveness.jailed":
+		return x.Jailed != false
+	case "emissions.v3.ActorLiveness.jailed_at_block":
+		return x.JailedAtBlock != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ActorLiveness"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ActorLiveness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActorLiveness) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.ActorLiveness.last_submission_nonce":
+		x.LastSubmissionNonce = int64(0)
+	case "emissions.v3.ActorLiveness.active_since":
+		x.ActiveSince = int64(0)
+	case "emissions.v3.ActorLiveness.jailed":
+		x.Jailed = false
+	case "emissions.v3.ActorLiveness.jailed_at_block":
+		x.JailedAtBlock = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ActorLiveness"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ActorLiveness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ActorLiveness) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.ActorLiveness.last_submission_nonce":
+		value := x.LastSubmissionNonce
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.ActorLiveness.active_since":
+		value := x.ActiveSince
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.ActorLiveness.jailed":
+		value := x.Jailed
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v3.ActorLiveness.jailed_at_block":
+		value := x.JailedAtBlock
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ActorLiveness"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ActorLiveness does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActorLiveness) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.ActorLiveness.last_submission_nonce":
+		x.LastSubmissionNonce = value.Int()
+	case "emissions.v3.ActorLiveness.active_since":
+		x.ActiveSince = value.Int()
+	case "emissions.v3.ActorLiveness.jailed":
+		x.Jailed = value.Bool()
+	case "emissions.v3.ActorLiveness.jailed_at_block":
+		x.JailedAtBlock = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ActorLiveness"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ActorLiveness does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActorLiveness) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ActorLiveness.last_submission_nonce":
+		panic(fmt.Errorf("field last_submission_nonce of message emissions.v3.ActorLiveness is not mutable"))
+	case "emissions.v3.ActorLiveness.active_since":
+		panic(fmt.Errorf("field active_since of message emissions.v3.ActorLiveness is not mutable"))
+	case "emissions.v3.ActorLiveness.jailed":
+		panic(fmt.Errorf("field jailed of message emissions.v3.ActorLiveness is not mutable"))
+	case "emissions.v3.ActorLiveness.jailed_at_block":
+		panic(fmt.Errorf("field jailed_at_block of message emissions.v3.ActorLiveness is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ActorLiveness"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ActorLiveness does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ActorLiveness) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ActorLiveness.last_submission_nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.ActorLiveness.active_since":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.ActorLiveness.jailed":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v3.ActorLiveness.jailed_at_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ActorLiveness"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ActorLiveness does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ActorLiveness) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ActorLiveness", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ActorLiveness) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActorLiveness) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ActorLiveness) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ActorLiveness) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ActorLiveness)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LastSubmissionNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastSubmissionNonce))
+		}
+		if x.ActiveSince != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActiveSince))
+		}
+		if x.Jailed {
+			n += 2
+		}
+		if x.JailedAtBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailedAtBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ActorLiveness)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.JailedAtBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailedAtBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Jailed {
+			i--
+			if x.Jailed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ActiveSince != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActiveSince))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.LastSubmissionNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastSubmissionNonce))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ActorLiveness)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActorLiveness: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastSubmissionNonce", wireType)
+				}
+				x.LastSubmissionNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastSubmissionNonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveSince", wireType)
+				}
+				x.ActiveSince = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActiveSince |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Jailed = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailedAtBlock", wireType)
+				}
+				x.JailedAtBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailedAtBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_WorkerTopicProfile                                    protoreflect.MessageDescriptor
+	fd_WorkerTopicProfile_topic_id                           protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_latest_inferer_score               protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_inferer_score_ema                  protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_latest_forecaster_score            protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_forecaster_score_ema               protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_inferer_inclusions                 protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_forecaster_inclusions              protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_bond                               protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_previous_inference_reward_fraction protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_previous_forecast_reward_fraction  protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_is_active_inferer                  protoreflect.FieldDescriptor
+	fd_WorkerTopicProfile_is_active_forecaster               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_node_proto_init()
+	md_WorkerTopicProfile = File_emissions_v3_node_proto.Messages().ByName("WorkerTopicProfile")
+	fd_WorkerTopicProfile_topic_id = md_WorkerTopicProfile.Fields().ByName("topic_id")
+	fd_WorkerTopicProfile_latest_inferer_score = md_WorkerTopicProfile.Fields().ByName("latest_inferer_score")
+	fd_WorkerTopicProfile_inferer_score_ema = md_WorkerTopicProfile.Fields().ByName("inferer_score_ema")
+	fd_WorkerTopicProfile_latest_forecaster_score = md_WorkerTopicProfile.Fields().ByName("latest_forecaster_score")
+	fd_WorkerTopicProfile_forecaster_score_ema = md_WorkerTopicProfile.Fields().ByName("forecaster_score_ema")
+	fd_WorkerTopicProfile_inferer_inclusions = md_WorkerTopicProfile.Fields().ByName("inferer_inclusions")
+	fd_WorkerTopicProfile_forecaster_inclusions = md_WorkerTopicProfile.Fields().ByName("forecaster_inclusions")
+	fd_WorkerTopicProfile_bond = md_WorkerTopicProfile.Fields().ByName("bond")
+	fd_WorkerTopicProfile_previous_inference_reward_fraction = md_WorkerTopicProfile.Fields().ByName("previous_inference_reward_fraction")
+	fd_WorkerTopicProfile_previous_forecast_reward_fraction = md_WorkerTopicProfile.Fields().ByName("previous_forecast_reward_fraction")
+	fd_WorkerTopicProfile_is_active_inferer = md_WorkerTopicProfile.Fields().ByName("is_active_inferer")
+	fd_WorkerTopicProfile_is_active_forecaster = md_WorkerTopicProfile.Fields().ByName("is_active_forecaster")
+}
+
+var _ protoreflect.Message = (*fastReflection_WorkerTopicProfile)(nil)
+
+type fastReflection_WorkerTopicProfile WorkerTopicProfile
+
+func (x *WorkerTopicProfile) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WorkerTopicProfile)(x)
+}
+
+func (x *WorkerTopicProfile) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_node_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WorkerTopicProfile_messageType fastReflection_WorkerTopicProfile_messageType
+var _ protoreflect.MessageType = fastReflection_WorkerTopicProfile_messageType{}
+
+type fastReflection_WorkerTopicProfile_messageType struct{}
+
+func (x fastReflection_WorkerTopicProfile_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WorkerTopicProfile)(nil)
+}
+func (x fastReflection_WorkerTopicProfile_messageType) New() protoreflect.Message {
+	return new(fastReflection_WorkerTopicProfile)
+}
+func (x fastReflection_WorkerTopicProfile_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerTopicProfile
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WorkerTopicProfile) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerTopicProfile
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WorkerTopicProfile) Type() protoreflect.MessageType {
+	return _fastReflection_WorkerTopicProfile_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WorkerTopicProfile) New() protoreflect.Message {
+	return new(fastReflection_WorkerTopicProfile)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WorkerTopicProfile) Interface() protoreflect.ProtoMessage {
+	return (*WorkerTopicProfile)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WorkerTopicProfile) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_WorkerTopicProfile_topic_id, value) {
+			return
+		}
+	}
+	if x.LatestInfererScore != nil {
+		value := protoreflect.ValueOfMessage(x.LatestInfererScore.ProtoReflect())
+		if !f(fd_WorkerTopicProfile_latest_inferer_score, value) {
+			return
+		}
+	}
+	if x.InfererScoreEma != nil {
+		value := protoreflect.ValueOfMessage(x.InfererScoreEma.ProtoReflect())
+		if !f(fd_WorkerTopicProfile_inferer_score_ema, value) {
+			return
+		}
+	}
+	if x.LatestForecasterScore != nil {
+		value := protoreflect.ValueOfMessage(x.LatestForecasterScore.ProtoReflect())
+		if !f(fd_WorkerTopicProfile_latest_forecaster_score, value) {
+			return
+		}
+	}
+	if x.ForecasterScoreEma != nil {
+		value := protoreflect.ValueOfMessage(x.ForecasterScoreEma.ProtoReflect())
+		if !f(fd_WorkerTopicProfile_forecaster_score_ema, value) {
+			return
+		}
+	}
+	if x.InfererInclusions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InfererInclusions)
+		if !f(fd_WorkerTopicProfile_inferer_inclusions, value) {
+			return
+		}
+	}
+	if x.ForecasterInclusions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ForecasterInclusions)
+		if !f(fd_WorkerTopicProfile_forecaster_inclusions, value) {
+			return
+		}
+	}
+	if x.Bond != "" {
+		value := protoreflect.ValueOfString(x.Bond)
+		if !f(fd_WorkerTopicProfile_bond, value) {
+			return
+		}
+	}
+	if x.PreviousInferenceRewardFraction != "" {
+		value := protoreflect.ValueOfString(x.PreviousInferenceRewardFraction)
+		if !f(fd_WorkerTopicProfile_previous_inference_reward_fraction, value) {
+			return
+		}
+	}
+	if x.PreviousForecastRewardFraction != "" {
+		value := protoreflect.ValueOfString(x.PreviousForecastRewardFraction)
+		if !f(fd_WorkerTopicProfile_previous_forecast_reward_fraction, value) {
+			return
+		}
+	}
+	if x.IsActiveInferer != false {
+		value := protoreflect.ValueOfBool(x.IsActiveInferer)
+		if !f(fd_WorkerTopicProfile_is_active_inferer, value) {
+			return
+		}
+	}
+	if x.IsActiveForecaster != false {
+		value := protoreflect.ValueOfBool(x.IsActiveForecaster)
+		if !f(fd_WorkerTopicProfile_is_active_forecaster, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WorkerTopicProfile) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerTopicProfile.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.WorkerTopicProfile.latest_inferer_score":
+		return x.LatestInfererScore != nil
+	case "emissions.v3.WorkerTopicProfile.inferer_score_ema":
+		return x.InfererScoreEma != nil
+	case "emissions.v3.WorkerTopicProfile.latest_forecaster_score":
+		return x.LatestForecasterScore != nil
+	case "emissions.v3.WorkerTopicProfile.forecaster_score_ema":
+		return x.ForecasterScoreEma != nil
+	case "emissions.v3.WorkerTopicProfile.inferer_inclusions":
+		return x.InfererInclusions != uint64(0)
+	case "emissions.v3.WorkerTopicProfile.forecaster_inclusions":
+		return x.ForecasterInclusions != uint64(0)
+	case "emissions.v3.WorkerTopicProfile.bond":
+		return x.Bond != ""
+	case "emissions.v3.WorkerTopicProfile.previous_inference_reward_fraction":
+		return x.PreviousInferenceRewardFraction != ""
+	case "emissions.v3.WorkerTopicProfile.previous_forecast_reward_fraction":
+		return x.PreviousForecastRewardFraction != ""
+	case "emissions.v3.WorkerTopicProfile.is_active_inferer":
+		return x.IsActiveInferer != false
+	case "emissions.v3.WorkerTopicProfile.is_active_forecaster":
+		return x.IsActiveForecaster != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerTopicProfile) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerTopicProfile.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.WorkerTopicProfile.latest_inferer_score":
+		x.LatestInfererScore = nil
+	case "emissions.v3.WorkerTopicProfile.inferer_score_ema":
+		x.InfererScoreEma = nil
+	case "emissions.v3.WorkerTopicProfile.latest_forecaster_score":
+		x.LatestForecasterScore = nil
+	case "emissions.v3.WorkerTopicProfile.forecaster_score_ema":
+		x.ForecasterScoreEma = nil
+	case "emissions.v3.WorkerTopicProfile.inferer_inclusions":
+		x.InfererInclusions = uint64(0)
+	case "emissions.v3.WorkerTopicProfile.forecaster_inclusions":
+		x.ForecasterInclusions = uint64(0)
+	case "emissions.v3.WorkerTopicProfile.bond":
+		x.Bond = ""
+	case "emissions.v3.WorkerTopicProfile.previous_inference_reward_fraction":
+		x.PreviousInferenceRewardFraction = ""
+	case "emissions.v3.WorkerTopicProfile.previous_forecast_reward_fraction":
+		x.PreviousForecastRewardFraction = ""
+	case "emissions.v3.WorkerTopicProfile.is_active_inferer":
+		x.IsActiveInferer = false
+	case "emissions.v3.WorkerTopicProfile.is_active_forecaster":
+		x.IsActiveForecaster = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WorkerTopicProfile) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.WorkerTopicProfile.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.WorkerTopicProfile.latest_inferer_score":
+		value := x.LatestInfererScore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.inferer_score_ema":
+		value := x.InfererScoreEma
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.latest_forecaster_score":
+		value := x.LatestForecasterScore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.forecaster_score_ema":
+		value := x.ForecasterScoreEma
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.inferer_inclusions":
+		value := x.InfererInclusions
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.WorkerTopicProfile.forecaster_inclusions":
+		value := x.ForecasterInclusions
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.WorkerTopicProfile.bond":
+		value := x.Bond
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerTopicProfile.previous_inference_reward_fraction":
+		value := x.PreviousInferenceRewardFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerTopicProfile.previous_forecast_reward_fraction":
+		value := x.PreviousForecastRewardFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerTopicProfile.is_active_inferer":
+		value := x.IsActiveInferer
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v3.WorkerTopicProfile.is_active_forecaster":
+		value := x.IsActiveForecaster
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerTopicProfile does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerTopicProfile) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerTopicProfile.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.WorkerTopicProfile.latest_inferer_score":
+		x.LatestInfererScore = value.Message().Interface().(*Score)
+	case "emissions.v3.WorkerTopicProfile.inferer_score_ema":
+		x.InfererScoreEma = value.Message().Interface().(*Score)
+	case "emissions.v3.WorkerTopicProfile.latest_forecaster_score":
+		x.LatestForecasterScore = value.Message().Interface().(*Score)
+	case "emissions.v3.WorkerTopicProfile.forecaster_score_ema":
+		x.ForecasterScoreEma = value.Message().Interface().(*Score)
+	case "emissions.v3.WorkerTopicProfile.inferer_inclusions":
+		x.InfererInclusions = value.Uint()
+	case "emissions.v3.WorkerTopicProfile.forecaster_inclusions":
+		x.ForecasterInclusions = value.Uint()
+	case "emissions.v3.WorkerTopicProfile.bond":
+		x.Bond = value.Interface().(string)
+	case "emissions.v3.WorkerTopicProfile.previous_inference_reward_fraction":
+		x.PreviousInferenceRewardFraction = value.Interface().(string)
+	case "emissions.v3.WorkerTopicProfile.previous_forecast_reward_fraction":
+		x.PreviousForecastRewardFraction = value.Interface().(string)
+	case "emissions.v3.WorkerTopicProfile.is_active_inferer":
+		x.IsActiveInferer = value.Bool()
+	case "emissions.v3.WorkerTopicProfile.is_active_forecaster":
+		x.IsActiveForecaster = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerTopicProfile) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerTopicProfile.latest_inferer_score":
+		if x.LatestInfererScore == nil {
+			x.LatestInfererScore = new(Score)
+		}
+		return protoreflect.ValueOfMessage(x.LatestInfererScore.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.inferer_score_ema":
+		if x.InfererScoreEma == nil {
+			x.InfererScoreEma = new(Score)
+		}
+		return protoreflect.ValueOfMessage(x.InfererScoreEma.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.latest_forecaster_score":
+		if x.LatestForecasterScore == nil {
+			x.LatestForecasterScore = new(Score)
+		}
+		return protoreflect.ValueOfMessage(x.LatestForecasterScore.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.forecaster_score_ema":
+		if x.ForecasterScoreEma == nil {
+			x.ForecasterScoreEma = new(Score)
+		}
+		return protoreflect.ValueOfMessage(x.ForecasterScoreEma.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.WorkerTopicProfile is not mutable"))
+	case "emissions.v3.WorkerTopicProfile.inferer_inclusions":
+		panic(fmt.Errorf("field inferer_inclusions of message emissions.v3.WorkerTopicProfile is not mutable"))
+	case "emissions.v3.WorkerTopicProfile.forecaster_inclusions":
+		panic(fmt.Errorf("field forecaster_inclusions of message emissions.v3.WorkerTopicProfile is not mutable"))
+	case "emissions.v3.WorkerTopicProfile.bond":
+		panic(fmt.Errorf("field bond of message emissions.v3.WorkerTopicProfile is not mutable"))
+	case "emissions.v3.WorkerTopicProfile.previous_inference_reward_fraction":
+		panic(fmt.Errorf("field previous_inference_reward_fraction of message emissions.v3.WorkerTopicProfile is not mutable"))
+	case "emissions.v3.WorkerTopicProfile.previous_forecast_reward_fraction":
+		panic(fmt.Errorf("field previous_forecast_reward_fraction of message emissions.v3.WorkerTopicProfile is not mutable"))
+	case "emissions.v3.WorkerTopicProfile.is_active_inferer":
+		panic(fmt.Errorf("field is_active_inferer of message emissions.v3.WorkerTopicProfile is not mutable"))
+	case "emissions.v3.WorkerTopicProfile.is_active_forecaster":
+		panic(fmt.Errorf("field is_active_forecaster of message emissions.v3.WorkerTopicProfile is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WorkerTopicProfile) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerTopicProfile.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.WorkerTopicProfile.latest_inferer_score":
+		m := new(Score)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.inferer_score_ema":
+		m := new(Score)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.latest_forecaster_score":
+		m := new(Score)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.forecaster_score_ema":
+		m := new(Score)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.WorkerTopicProfile.inferer_inclusions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.WorkerTopicProfile.forecaster_inclusions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.WorkerTopicProfile.bond":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerTopicProfile.previous_inference_reward_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerTopicProfile.previous_forecast_reward_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerTopicProfile.is_active_inferer":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v3.WorkerTopicProfile.is_active_forecaster":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WorkerTopicProfile) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerTopicProfile", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WorkerTopicProfile) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerTopicProfile) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WorkerTopicProfile) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WorkerTopicProfile) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WorkerTopicProfile)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.LatestInfererScore != nil {
+			l = options.Size(x.LatestInfererScore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InfererScoreEma != nil {
+			l = options.Size(x.InfererScoreEma)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LatestForecasterScore != nil {
+			l = options.Size(x.LatestForecasterScore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ForecasterScoreEma != nil {
+			l = options.Size(x.ForecasterScoreEma)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InfererInclusions != 0 {
+			n += 1 + runtime.Sov(uint64(x.InfererInclusions))
+		}
+		if x.ForecasterInclusions != 0 {
+			n += 1 + runtime.Sov(uint64(x.ForecasterInclusions))
+		}
+		l = len(x.Bond)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousInferenceRewardFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousForecastRewardFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsActiveInferer {
+			n += 2
+		}
+		if x.IsActiveForecaster {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerTopicProfile)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IsActiveForecaster {
+			i--
+			if x.IsActiveForecaster {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.IsActiveInferer {
+			i--
+			if x.IsActiveInferer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.PreviousForecastRewardFraction) > 0 {
+			i -= len(x.PreviousForecastRewardFraction)
+			copy(dAtA[i:], x.PreviousForecastRewardFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousForecastRewardFraction)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.PreviousInferenceRewardFraction) > 0 {
+			i -= len(x.PreviousInferenceRewardFraction)
+			copy(dAtA[i:], x.PreviousInferenceRewardFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousInferenceRewardFraction)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Bond) > 0 {
+			i -= len(x.Bond)
+			copy(dAtA[i:], x.Bond)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bond)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ForecasterInclusions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ForecasterInclusions))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.InfererInclusions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InfererInclusions))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ForecasterScoreEma != nil {
+			encoded, err := options.Marshal(x.ForecasterScoreEma)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.LatestForecasterScore != nil {
+			encoded, err := options.Marshal(x.LatestForecasterScore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.InfererScoreEma != nil {
+			encoded, err := options.Marshal(x.InfererScoreEma)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LatestInfererScore != nil {
+			encoded, err := options.Marshal(x.LatestInfererScore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerTopicProfile)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerTopicProfile: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerTopicProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestInfererScore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LatestInfererScore == nil {
+					x.LatestInfererScore = &Score{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestInfererScore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEma", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InfererScoreEma == nil {
+					x.InfererScoreEma = &Score{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InfererScoreEma); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterScore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LatestForecasterScore == nil {
+					x.LatestForecasterScore = &Score{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestForecasterScore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEma", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ForecasterScoreEma == nil {
+					x.ForecasterScoreEma = &Score{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecasterScoreEma); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererInclusions", wireType)
+				}
+				x.InfererInclusions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InfererInclusions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterInclusions", wireType)
+				}
+				x.ForecasterInclusions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ForecasterInclusions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bond = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousInferenceRewardFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousForecastRewardFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActiveInferer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActiveInferer = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActiveForecaster", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActiveForecaster = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReputerTopicProfile                          protoreflect.MessageDescriptor
+	fd_ReputerTopicProfile_topic_id                 protoreflect.FieldDescriptor
+	fd_ReputerTopicProfile_latest_score             protoreflect.FieldDescriptor
+	fd_ReputerTopicProfile_score_ema                protoreflect.FieldDescriptor
+	fd_ReputerTopicProfile_stake                    protoreflect.FieldDescriptor
+	fd_ReputerTopicProfile_delegated_stake          protoreflect.FieldDescriptor
+	fd_ReputerTopicProfile_previous_reward_fraction protoreflect.FieldDescriptor
+	fd_ReputerTopicProfile_is_active                protoreflect.FieldDescriptor
+	fd_ReputerTopicProfile_commission_rate          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_node_proto_init()
+	md_ReputerTopicProfile = File_emissions_v3_node_proto.Messages().ByName("ReputerTopicProfile")
+	fd_ReputerTopicProfile_topic_id = md_ReputerTopicProfile.Fields().ByName("topic_id")
+	fd_ReputerTopicProfile_latest_score = md_ReputerTopicProfile.Fields().ByName("latest_score")
+	fd_ReputerTopicProfile_score_ema = md_ReputerTopicProfile.Fields().ByName("score_ema")
+	fd_ReputerTopicProfile_stake = md_ReputerTopicProfile.Fields().ByName("stake")
+	fd_ReputerTopicProfile_delegated_stake = md_ReputerTopicProfile.Fields().ByName("delegated_stake")
+	fd_ReputerTopicProfile_previous_reward_fraction = md_ReputerTopicProfile.Fields().ByName("previous_reward_fraction")
+	fd_ReputerTopicProfile_is_active = md_ReputerTopicProfile.Fields().ByName("is_active")
+	fd_ReputerTopicProfile_commission_rate = md_ReputerTopicProfile.Fields().ByName("commission_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReputerTopicProfile)(nil)
+
+type fastReflection_ReputerTopicProfile ReputerTopicProfile
+
+func (x *ReputerTopicProfile) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReputerTopicProfile)(x)
+}
+
+func (x *ReputerTopicProfile) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_node_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReputerTopicProfile_messageType fastReflection_ReputerTopicProfile_messageType
+var _ protoreflect.MessageType = fastReflection_ReputerTopicProfile_messageType{}
+
+type fastReflection_ReputerTopicProfile_messageType struct{}
+
+func (x fastReflection_ReputerTopicProfile_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReputerTopicProfile)(nil)
+}
+func (x fastReflection_ReputerTopicProfile_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReputerTopicProfile)
+}
+func (x fastReflection_ReputerTopicProfile_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerTopicProfile
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReputerTopicProfile) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerTopicProfile
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReputerTopicProfile) Type() protoreflect.MessageType {
+	return _fastReflection_ReputerTopicProfile_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReputerTopicProfile) New() protoreflect.Message {
+	return new(fastReflection_ReputerTopicProfile)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReputerTopicProfile) Interface() protoreflect.ProtoMessage {
+	return (*ReputerTopicProfile)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReputerTopicProfile) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_ReputerTopicProfile_topic_id, value) {
+			return
+		}
+	}
+	if x.LatestScore != nil {
+		value := protoreflect.ValueOfMessage(x.LatestScore.ProtoReflect())
+		if !f(fd_ReputerTopicProfile_latest_score, value) {
+			return
+		}
+	}
+	if x.ScoreEma != nil {
+		value := protoreflect.ValueOfMessage(x.ScoreEma.ProtoReflect())
+		if !f(fd_ReputerTopicProfile_score_ema, value) {
+			return
+		}
+	}
+	if x.Stake != "" {
+		value := protoreflect.ValueOfString(x.Stake)
+		if !f(fd_ReputerTopicProfile_stake, value) {
+			return
+		}
+	}
+	if x.DelegatedStake != "" {
+		value := protoreflect.ValueOfString(x.DelegatedStake)
+		if !f(fd_ReputerTopicProfile_delegated_stake, value) {
+			return
+		}
+	}
+	if x.PreviousRewardFraction != "" {
+		value := protoreflect.ValueOfString(x.PreviousRewardFraction)
+		if !f(fd_ReputerTopicProfile_previous_reward_fraction, value) {
+			return
+		}
+	}
+	if x.IsActive != false {
+		value := protoreflect.ValueOfBool(x.IsActive)
+		if !f(fd_ReputerTopicProfile_is_active, value) {
+			return
+		}
+	}
+	if x.CommissionRate != "" {
+		value := protoreflect.ValueOfString(x.CommissionRate)
+		if !f(fd_ReputerTopicProfile_commission_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReputerTopicProfile) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerTopicProfile.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.ReputerTopicProfile.latest_score":
+		return x.LatestScore != nil
+	case "emissions.v3.ReputerTopicProfile.score_ema":
+		return x.ScoreEma != nil
+	case "emissions.v3.ReputerTopicProfile.stake":
+		return x.Stake != ""
+	case "emissions.v3.ReputerTopicProfile.delegated_stake":
+		return x.DelegatedStake != ""
+	case "emissions.v3.ReputerTopicProfile.previous_reward_fraction":
+		return x.PreviousRewardFraction != ""
+	case "emissions.v3.ReputerTopicProfile.is_active":
+		return x.IsActive != false
+	case "emissions.v3.ReputerTopicProfile.commission_rate":
+		return x.CommissionRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerTopicProfile) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerTopicProfile.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.ReputerTopicProfile.latest_score":
+		x.LatestScore = nil
+	case "emissions.v3.ReputerTopicProfile.score_ema":
+		x.ScoreEma = nil
+	case "emissions.v3.ReputerTopicProfile.stake":
+		x.Stake = ""
+	case "emissions.v3.ReputerTopicProfile.delegated_stake":
+		x.DelegatedStake = ""
+	case "emissions.v3.ReputerTopicProfile.previous_reward_fraction":
+		x.PreviousRewardFraction = ""
+	case "emissions.v3.ReputerTopicProfile.is_active":
+		x.IsActive = false
+	case "emissions.v3.ReputerTopicProfile.commission_rate":
+		x.CommissionRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReputerTopicProfile) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.ReputerTopicProfile.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.ReputerTopicProfile.latest_score":
+		value := x.LatestScore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.ReputerTopicProfile.score_ema":
+		value := x.ScoreEma
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.ReputerTopicProfile.stake":
+		value := x.Stake
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerTopicProfile.delegated_stake":
+		value := x.DelegatedStake
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerTopicProfile.previous_reward_fraction":
+		value := x.PreviousRewardFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerTopicProfile.is_active":
+		value := x.IsActive
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v3.ReputerTopicProfile.commission_rate":
+		value := x.CommissionRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerTopicProfile does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerTopicProfile) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerTopicProfile.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.ReputerTopicProfile.latest_score":
+		x.LatestScore = value.Message().Interface().(*Score)
+	case "emissions.v3.ReputerTopicProfile.score_ema":
+		x.ScoreEma = value.Message().Interface().(*Score)
+	case "emissions.v3.ReputerTopicProfile.stake":
+		x.Stake = value.Interface().(string)
+	case "emissions.v3.ReputerTopicProfile.delegated_stake":
+		x.DelegatedStake = value.Interface().(string)
+	case "emissions.v3.ReputerTopicProfile.previous_reward_fraction":
+		x.PreviousRewardFraction = value.Interface().(string)
+	case "emissions.v3.ReputerTopicProfile.is_active":
+		x.IsActive = value.Bool()
+	case "emissions.v3.ReputerTopicProfile.commission_rate":
+		x.CommissionRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerTopicProfile) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerTopicProfile.latest_score":
+		if x.LatestScore == nil {
+			x.LatestScore = new(Score)
+		}
+		return protoreflect.ValueOfMessage(x.LatestScore.ProtoReflect())
+	case "emissions.v3.ReputerTopicProfile.score_ema":
+		if x.ScoreEma == nil {
+			x.ScoreEma = new(Score)
+		}
+		return protoreflect.ValueOfMessage(x.ScoreEma.ProtoReflect())
+	case "emissions.v3.ReputerTopicProfile.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.ReputerTopicProfile is not mutable"))
+	case "emissions.v3.ReputerTopicProfile.stake":
+		panic(fmt.Errorf("field stake of message emissions.v3.ReputerTopicProfile is not mutable"))
+	case "emissions.v3.ReputerTopicProfile.delegated_stake":
+		panic(fmt.Errorf("field delegated_stake of message emissions.v3.ReputerTopicProfile is not mutable"))
+	case "emissions.v3.ReputerTopicProfile.previous_reward_fraction":
+		panic(fmt.Errorf("field previous_reward_fraction of message emissions.v3.ReputerTopicProfile is not mutable"))
+	case "emissions.v3.ReputerTopicProfile.is_active":
+		panic(fmt.Errorf("field is_active of message emissions.v3.ReputerTopicProfile is not mutable"))
+	case "emissions.v3.ReputerTopicProfile.commission_rate":
+		panic(fmt.Errorf("field commission_rate of message emissions.v3.ReputerTopicProfile is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReputerTopicProfile) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerTopicProfile.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.ReputerTopicProfile.latest_score":
+		m := new(Score)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.ReputerTopicProfile.score_ema":
+		m := new(Score)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.ReputerTopicProfile.stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerTopicProfile.delegated_stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerTopicProfile.previous_reward_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerTopicProfile.is_active":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v3.ReputerTopicProfile.commission_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerTopicProfile"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerTopicProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReputerTopicProfile) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ReputerTopicProfile", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReputerTopicProfile) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerTopicProfile) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReputerTopicProfile) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReputerTopicProfile) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReputerTopicProfile)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.LatestScore != nil {
+			l = options.Size(x.LatestScore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ScoreEma != nil {
+			l = options.Size(x.ScoreEma)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Stake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DelegatedStake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousRewardFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsActive {
+			n += 2
+		}
+		l = len(x.CommissionRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerTopicProfile)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CommissionRate) > 0 {
+			i -= len(x.CommissionRate)
+			copy(dAtA[i:], x.CommissionRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommissionRate)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.IsActive {
+			i--
+			if x.IsActive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.PreviousRewardFraction) > 0 {
+			i -= len(x.PreviousRewardFraction)
+			copy(dAtA[i:], x.PreviousRewardFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousRewardFraction)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.DelegatedStake) > 0 {
+			i -= len(x.DelegatedStake)
+			copy(dAtA[i:], x.DelegatedStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatedStake)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Stake) > 0 {
+			i -= len(x.Stake)
+			copy(dAtA[i:], x.Stake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stake)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ScoreEma != nil {
+			encoded, err := options.Marshal(x.ScoreEma)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LatestScore != nil {
+			encoded, err := options.Marshal(x.LatestScore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerTopicProfile)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerTopicProfile: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerTopicProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestScore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LatestScore == nil {
+					x.LatestScore = &Score{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestScore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScoreEma", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ScoreEma == nil {
+					x.ScoreEma = &Score{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScoreEma); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatedStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatedStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousRewardFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousRewardFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsActive = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommissionRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -552,33 +3097,425 @@ func (x *OffchainNode) GetNodeAddress() string {
 	return ""
 }
 
+// Liveness of a worker or reputer registered in a topic
+type ActorLiveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block height of the last nonce the actor submitted a payload for
+	LastSubmissionNonce int64 `protobuf:"varint,1,opt,name=last_submission_nonce,json=lastSubmissionNonce,proto3" json:"last_submission_nonce,omitempty"`
+	// block height since which the actor has been expected to submit,
+	// i.e. at which it was first tracked or last unjailed
+	ActiveSince   int64 `protobuf:"varint,2,opt,name=active_since,json=activeSince,proto3" json:"active_since,omitempty"`
+	Jailed        bool  `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailedAtBlock int64 `protobuf:"varint,4,opt,name=jailed_at_block,json=jailedAtBlock,proto3" json:"jailed_at_block,omitempty"`
+}
+
+func (x *ActorLiveness) Reset() {
+	*x = ActorLiveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_node_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActorLiveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActorLiveness) ProtoMessage() {}
+
+// Deprecated: Use ActorLiveness.ProtoReflect.Descriptor instead.
+func (*ActorLiveness) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_node_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActorLiveness) GetLastSubmissionNonce() int64 {
+	if x != nil {
+		return x.LastSubmissionNonce
+	}
+	return 0
+}
+
+func (x *ActorLiveness) GetActiveSince() int64 {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return 0
+}
+
+func (x *ActorLiveness) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+func (x *ActorLiveness) GetJailedAtBlock() int64 {
+	if x != nil {
+		return x.JailedAtBlock
+	}
+	return 0
+}
+
+// Participation of an actor registered as a worker in a topic
+type WorkerTopicProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// scores of the worker at the last block its score emas were updated at,
+	// left empty if the worker was not scored at that block
+	LatestInfererScore    *Score `protobuf:"bytes,2,opt,name=latest_inferer_score,json=latestInfererScore,proto3" json:"latest_inferer_score,omitempty"`
+	InfererScoreEma       *Score `protobuf:"bytes,3,opt,name=inferer_score_ema,json=infererScoreEma,proto3" json:"inferer_score_ema,omitempty"`
+	LatestForecasterScore *Score `protobuf:"bytes,4,opt,name=latest_forecaster_score,json=latestForecasterScore,proto3" json:"latest_forecaster_score,omitempty"`
+	ForecasterScoreEma    *Score `protobuf:"bytes,5,opt,name=forecaster_score_ema,json=forecasterScoreEma,proto3" json:"forecaster_score_ema,omitempty"`
+	InfererInclusions     uint64 `protobuf:"varint,6,opt,name=inferer_inclusions,json=infererInclusions,proto3" json:"inferer_inclusions,omitempty"`
+	ForecasterInclusions  uint64 `protobuf:"varint,7,opt,name=forecaster_inclusions,json=forecasterInclusions,proto3" json:"forecaster_inclusions,omitempty"`
+	Bond                  string `protobuf:"bytes,8,opt,name=bond,proto3" json:"bond,omitempty"`
+	// fractions of the worker rewards of the topic earned at the last rewarded epoch
+	PreviousInferenceRewardFraction string `protobuf:"bytes,9,opt,name=previous_inference_reward_fraction,json=previousInferenceRewardFraction,proto3" json:"previous_inference_reward_fraction,omitempty"`
+	PreviousForecastRewardFraction  string `protobuf:"bytes,10,opt,name=previous_forecast_reward_fraction,json=previousForecastRewardFraction,proto3" json:"previous_forecast_reward_fraction,omitempty"`
+	IsActiveInferer                 bool   `protobuf:"varint,11,opt,name=is_active_inferer,json=isActiveInferer,proto3" json:"is_active_inferer,omitempty"`
+	IsActiveForecaster              bool   `protobuf:"varint,12,opt,name=is_active_forecaster,json=isActiveForecaster,proto3" json:"is_active_forecaster,omitempty"`
+}
+
+func (x *WorkerTopicProfile) Reset() {
+	*x = WorkerTopicProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_node_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerTopicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerTopicProfile) ProtoMessage() {}
+
+// Deprecated: Use WorkerTopicProfile.ProtoReflect.Descriptor instead.
+func (*WorkerTopicProfile) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_node_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkerTopicProfile) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *WorkerTopicProfile) GetLatestInfererScore() *Score {
+	if x != nil {
+		return x.LatestInfererScore
+	}
+	return nil
+}
+
+func (x *WorkerTopicProfile) GetInfererScoreEma() *Score {
+	if x != nil {
+		return x.InfererScoreEma
+	}
+	return nil
+}
+
+func (x *WorkerTopicProfile) GetLatestForecasterScore() *Score {
+	if x != nil {
+		return x.LatestForecasterScore
+	}
+	return nil
+}
+
+func (x *WorkerTopicProfile) GetForecasterScoreEma() *Score {
+	if x != nil {
+		return x.ForecasterScoreEma
+	}
+	return nil
+}
+
+func (x *WorkerTopicProfile) GetInfererInclusions() uint64 {
+	if x != nil {
+		return x.InfererInclusions
+	}
+	return 0
+}
+
+func (x *WorkerTopicProfile) GetForecasterInclusions() uint64 {
+	if x != nil {
+		return x.ForecasterInclusions
+	}
+	return 0
+}
+
+func (x *WorkerTopicProfile) GetBond() string {
+	if x != nil {
+		return x.Bond
+	}
+	return ""
+}
+
+func (x *WorkerTopicProfile) GetPreviousInferenceRewardFraction() string {
+	if x != nil {
+		return x.PreviousInferenceRewardFraction
+	}
+	return ""
+}
+
+func (x *WorkerTopicProfile) GetPreviousForecastRewardFraction() string {
+	if x != nil {
+		return x.PreviousForecastRewardFraction
+	}
+	return ""
+}
+
+func (x *WorkerTopicProfile) GetIsActiveInferer() bool {
+	if x != nil {
+		return x.IsActiveInferer
+	}
+	return false
+}
+
+func (x *WorkerTopicProfile) GetIsActiveForecaster() bool {
+	if x != nil {
+		return x.IsActiveForecaster
+	}
+	return false
+}
+
+// Participation of an actor registered as a reputer in a topic
+type ReputerTopicProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// score of the reputer at the last block its score ema was updated at,
+	// left empty if the reputer was not scored at that block
+	LatestScore *Score `protobuf:"bytes,2,opt,name=latest_score,json=latestScore,proto3" json:"latest_score,omitempty"`
+	ScoreEma    *Score `protobuf:"bytes,3,opt,name=score_ema,json=scoreEma,proto3" json:"score_ema,omitempty"`
+	// stake of the reputer including the stake delegated to it
+	Stake          string `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	DelegatedStake string `protobuf:"bytes,5,opt,name=delegated_stake,json=delegatedStake,proto3" json:"delegated_stake,omitempty"`
+	// fraction of the reputer rewards of the topic earned at the last rewarded epoch
+	PreviousRewardFraction string `protobuf:"bytes,6,opt,name=previous_reward_fraction,json=previousRewardFraction,proto3" json:"previous_reward_fraction,omitempty"`
+	IsActive               bool   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// share of the rewards of the delegators the reputer keeps as commission
+	CommissionRate string `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
+}
+
+func (x *ReputerTopicProfile) Reset() {
+	*x = ReputerTopicProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_node_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReputerTopicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReputerTopicProfile) ProtoMessage() {}
+
+// Deprecated: Use ReputerTopicProfile.ProtoReflect.Descriptor instead.
+func (*ReputerTopicProfile) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_node_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReputerTopicProfile) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ReputerTopicProfile) GetLatestScore() *Score {
+	if x != nil {
+		return x.LatestScore
+	}
+	return nil
+}
+
+func (x *ReputerTopicProfile) GetScoreEma() *Score {
+	if x != nil {
+		return x.ScoreEma
+	}
+	return nil
+}
+
+func (x *ReputerTopicProfile) GetStake() string {
+	if x != nil {
+		return x.Stake
+	}
+	return ""
+}
+
+func (x *ReputerTopicProfile) GetDelegatedStake() string {
+	if x != nil {
+		return x.DelegatedStake
+	}
+	return ""
+}
+
+func (x *ReputerTopicProfile) GetPreviousRewardFraction() string {
+	if x != nil {
+		return x.PreviousRewardFraction
+	}
+	return ""
+}
+
+func (x *ReputerTopicProfile) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ReputerTopicProfile) GetCommissionRate() string {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return ""
+}
+
 var File_emissions_v3_node_proto protoreflect.FileDescriptor
 
 var file_emissions_v3_node_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x2f, 0x6e,
 	0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x22, 0x7e, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x05,
-	0x10, 0x06, 0x52, 0x0b, 0x6c, 0x69, 0x62, 0x5f, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x52,
-	0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x42, 0xbf, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x09, 0x4e, 0x6f,
-	0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58,
-	0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca,
-	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02,
-	0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x76, 0x33, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7e, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4a, 0x04,
+	0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
+	0x52, 0x0b, 0x6c, 0x69, 0x62, 0x5f, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x52, 0x0d, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xdf,
+	0x06, 0x0a, 0x12, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x12, 0x45, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x11, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x33, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x61, 0x12, 0x4b, 0x0a, 0x17, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x15,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x45, 0x0a, 0x14, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x33, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x61, 0x12, 0x2d, 0x0a, 0x12,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x72, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x66,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x44, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x84, 0x01, 0x0a, 0x22, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x82, 0x01,
+	0x0a, 0x21, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x1e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x14, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x22, 0xaf, 0x04, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0b,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x61, 0x12, 0x46, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x12, 0x71, 0x0a, 0x18, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x16, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x60, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x42, 0xbf, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -593,16 +3530,26 @@ func file_emissions_v3_node_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_node_proto_rawDescData
 }
 
-var file_emissions_v3_node_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_emissions_v3_node_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_emissions_v3_node_proto_goTypes = []interface{}{
-	(*OffchainNode)(nil), // 0: emissions.v3.OffchainNode
+	(*OffchainNode)(nil),        // 0: emissions.v3.OffchainNode
+	(*ActorLiveness)(nil),       // 1: emissions.v3.ActorLiveness
+	(*WorkerTopicProfile)(nil),  // 2: emissions.v3.WorkerTopicProfile
+	(*ReputerTopicProfile)(nil), // 3: emissions.v3.ReputerTopicProfile
+	(*Score)(nil),               // 4: emissions.v3.Score
 }
 var file_emissions_v3_node_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: emissions.v3.WorkerTopicProfile.latest_inferer_score:type_name -> emissions.v3.Score
+	4, // 1: emissions.v3.WorkerTopicProfile.inferer_score_ema:type_name -> emissions.v3.Score
+	4, // 2: emissions.v3.WorkerTopicProfile.latest_forecaster_score:type_name -> emissions.v3.Score
+	4, // 3: emissions.v3.WorkerTopicProfile.forecaster_score_ema:type_name -> emissions.v3.Score
+	4, // 4: emissions.v3.ReputerTopicProfile.latest_score:type_name -> emissions.v3.Score
+	4, // 5: emissions.v3.ReputerTopicProfile.score_ema:type_name -> emissions.v3.Score
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v3_node_proto_init() }
@@ -610,6 +3557,7 @@ func file_emissions_v3_node_proto_init() {
 	if File_emissions_v3_node_proto != nil {
 		return
 	}
+	file_emissions_v3_score_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_emissions_v3_node_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OffchainNode); i {
@@ -623,6 +3571,42 @@ func file_emissions_v3_node_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v3_node_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActorLiveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_node_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerTopicProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_node_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReputerTopicProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -630,7 +3614,7 @@ func file_emissions_v3_node_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_node_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
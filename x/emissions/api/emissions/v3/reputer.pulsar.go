@@ -1845,6 +1845,52 @@ func (x *_ValueBundle_12_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_ValueBundle_14_list)(nil)
+
+type _ValueBundle_14_list struct {
+	list *[]string
+}
+
+func (x *_ValueBundle_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValueBundle_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ValueBundle_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValueBundle_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValueBundle_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValueBundle at list field GroundTruthValues as it is not of Message kind"))
+}
+
+func (x *_ValueBundle_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValueBundle_14_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ValueBundle_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_ValueBundle                                   protoreflect.MessageDescriptor
 	fd_ValueBundle_topic_id                          protoreflect.FieldDescriptor
@@ -1859,6 +1905,8 @@ var (
 	fd_ValueBundle_one_out_forecaster_values         protoreflect.FieldDescriptor
 	fd_ValueBundle_one_in_forecaster_values          protoreflect.FieldDescriptor
 	fd_ValueBundle_one_out_inferer_forecaster_values protoreflect.FieldDescriptor
+	fd_ValueBundle_ground_truth                      protoreflect.FieldDescriptor
+	fd_ValueBundle_ground_truth_values               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1876,6 +1924,8 @@ func init() {
 	fd_ValueBundle_one_out_forecaster_values = md_ValueBundle.Fields().ByName("one_out_forecaster_values")
 	fd_ValueBundle_one_in_forecaster_values = md_ValueBundle.Fields().ByName("one_in_forecaster_values")
 	fd_ValueBundle_one_out_inferer_forecaster_values = md_ValueBundle.Fields().ByName("one_out_inferer_forecaster_values")
+	fd_ValueBundle_ground_truth = md_ValueBundle.Fields().ByName("ground_truth")
+	fd_ValueBundle_ground_truth_values = md_ValueBundle.Fields().ByName("ground_truth_values")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValueBundle)(nil)
@@ -2015,6 +2065,18 @@ func (x *fastReflection_ValueBundle) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.GroundTruth != "" {
+		value := protoreflect.ValueOfString(x.GroundTruth)
+		if !f(fd_ValueBundle_ground_truth, value) {
+			return
+		}
+	}
+	if len(x.GroundTruthValues) != 0 {
+		value := protoreflect.ValueOfList(&_ValueBundle_14_list{list: &x.GroundTruthValues})
+		if !f(fd_ValueBundle_ground_truth_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2054,6 +2116,10 @@ func (x *fastReflection_ValueBundle) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.OneInForecasterValues) != 0
 	case "emissions.v3.ValueBundle.one_out_inferer_forecaster_values":
 		return len(x.OneOutInfererForecasterValues) != 0
+	case "emissions.v3.ValueBundle.ground_truth":
+		return x.GroundTruth != ""
+	case "emissions.v3.ValueBundle.ground_truth_values":
+		return len(x.GroundTruthValues) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2094,6 +2160,10 @@ func (x *fastReflection_ValueBundle) Clear(fd protoreflect.FieldDescriptor) {
 		x.OneInForecasterValues = nil
 	case "emissions.v3.ValueBundle.one_out_inferer_forecaster_values":
 		x.OneOutInfererForecasterValues = nil
+	case "emissions.v3.ValueBundle.ground_truth":
+		x.GroundTruth = ""
+	case "emissions.v3.ValueBundle.ground_truth_values":
+		x.GroundTruthValues = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2164,6 +2234,15 @@ func (x *fastReflection_ValueBundle) Get(descriptor protoreflect.FieldDescriptor
 		}
 		listValue := &_ValueBundle_12_list{list: &x.OneOutInfererForecasterValues}
 		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.ValueBundle.ground_truth":
+		value := x.GroundTruth
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ValueBundle.ground_truth_values":
+		if len(x.GroundTruthValues) == 0 {
+			return protoreflect.ValueOfList(&_ValueBundle_14_list{})
+		}
+		listValue := &_ValueBundle_14_list{list: &x.GroundTruthValues}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2220,6 +2299,12 @@ func (x *fastReflection_ValueBundle) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_ValueBundle_12_list)
 		x.OneOutInfererForecasterValues = *clv.list
+	case "emissions.v3.ValueBundle.ground_truth":
+		x.GroundTruth = value.Interface().(string)
+	case "emissions.v3.ValueBundle.ground_truth_values":
+		lv := value.List()
+		clv := lv.(*_ValueBundle_14_list)
+		x.GroundTruthValues = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2281,6 +2366,12 @@ func (x *fastReflection_ValueBundle) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_ValueBundle_12_list{list: &x.OneOutInfererForecasterValues}
 		return protoreflect.ValueOfList(value)
+	case "emissions.v3.ValueBundle.ground_truth_values":
+		if x.GroundTruthValues == nil {
+			x.GroundTruthValues = []string{}
+		}
+		value := &_ValueBundle_14_list{list: &x.GroundTruthValues}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v3.ValueBundle.topic_id":
 		panic(fmt.Errorf("field topic_id of message emissions.v3.ValueBundle is not mutable"))
 	case "emissions.v3.ValueBundle.reputer":
@@ -2291,6 +2382,8 @@ func (x *fastReflection_ValueBundle) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field combined_value of message emissions.v3.ValueBundle is not mutable"))
 	case "emissions.v3.ValueBundle.naive_value":
 		panic(fmt.Errorf("field naive_value of message emissions.v3.ValueBundle is not mutable"))
+	case "emissions.v3.ValueBundle.ground_truth":
+		panic(fmt.Errorf("field ground_truth of message emissions.v3.ValueBundle is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2335,6 +2428,11 @@ func (x *fastReflection_ValueBundle) NewField(fd protoreflect.FieldDescriptor) p
 	case "emissions.v3.ValueBundle.one_out_inferer_forecaster_values":
 		list := []*OneOutInfererForecasterValues{}
 		return protoreflect.ValueOfList(&_ValueBundle_12_list{list: &list})
+	case "emissions.v3.ValueBundle.ground_truth":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ValueBundle.ground_truth_values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValueBundle_14_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ValueBundle"))
@@ -2463,6 +2561,16 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.GroundTruth)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.GroundTruthValues) > 0 {
+			for _, s := range x.GroundTruthValues {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2492,6 +2600,22 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.GroundTruthValues) > 0 {
+			for iNdEx := len(x.GroundTruthValues) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.GroundTruthValues[iNdEx])
+				copy(dAtA[i:], x.GroundTruthValues[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroundTruthValues[iNdEx])))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.GroundTruth) > 0 {
+			i -= len(x.GroundTruth)
+			copy(dAtA[i:], x.GroundTruth)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroundTruth)))
+			i--
+			dAtA[i] = 0x6a
+		}
 		if len(x.OneOutInfererForecasterValues) > 0 {
 			for iNdEx := len(x.OneOutInfererForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.OneOutInfererForecasterValues[iNdEx])
@@ -3073,6 +3197,70 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroundTruth", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroundTruth = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroundTruthValues", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroundTruthValues = append(x.GroundTruthValues, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4167,112 +4355,694 @@ func (x *fastReflection_ReputerValueBundles) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v3/reputer.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_ReputerCommitment                    protoreflect.MessageDescriptor
+	fd_ReputerCommitment_topic_id           protoreflect.FieldDescriptor
+	fd_ReputerCommitment_nonce_block_height protoreflect.FieldDescriptor
+	fd_ReputerCommitment_reputer            protoreflect.FieldDescriptor
+	fd_ReputerCommitment_commitment         protoreflect.FieldDescriptor
 )
 
-type WorkerAttributedValue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_emissions_v3_reputer_proto_init()
+	md_ReputerCommitment = File_emissions_v3_reputer_proto.Messages().ByName("ReputerCommitment")
+	fd_ReputerCommitment_topic_id = md_ReputerCommitment.Fields().ByName("topic_id")
+	fd_ReputerCommitment_nonce_block_height = md_ReputerCommitment.Fields().ByName("nonce_block_height")
+	fd_ReputerCommitment_reputer = md_ReputerCommitment.Fields().ByName("reputer")
+	fd_ReputerCommitment_commitment = md_ReputerCommitment.Fields().ByName("commitment")
+}
 
-	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"` // worker who created the value
-	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+var _ protoreflect.Message = (*fastReflection_ReputerCommitment)(nil)
+
+type fastReflection_ReputerCommitment ReputerCommitment
+
+func (x *ReputerCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReputerCommitment)(x)
 }
 
-func (x *WorkerAttributedValue) Reset() {
-	*x = WorkerAttributedValue{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_reputer_proto_msgTypes[0]
+func (x *ReputerCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_reputer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *WorkerAttributedValue) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_ReputerCommitment_messageType fastReflection_ReputerCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_ReputerCommitment_messageType{}
 
-func (*WorkerAttributedValue) ProtoMessage() {}
+type fastReflection_ReputerCommitment_messageType struct{}
 
-// Deprecated: Use WorkerAttributedValue.ProtoReflect.Descriptor instead.
-func (*WorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_ReputerCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReputerCommitment)(nil)
 }
-
-func (x *WorkerAttributedValue) GetWorker() string {
-	if x != nil {
-		return x.Worker
-	}
-	return ""
+func (x fastReflection_ReputerCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReputerCommitment)
 }
-
-func (x *WorkerAttributedValue) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+func (x fastReflection_ReputerCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerCommitment
 }
 
-type WithheldWorkerAttributedValue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
-	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReputerCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReputerCommitment
 }
 
-func (x *WithheldWorkerAttributedValue) Reset() {
-	*x = WithheldWorkerAttributedValue{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_reputer_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReputerCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_ReputerCommitment_messageType
 }
 
-func (x *WithheldWorkerAttributedValue) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReputerCommitment) New() protoreflect.Message {
+	return new(fastReflection_ReputerCommitment)
 }
 
-func (*WithheldWorkerAttributedValue) ProtoMessage() {}
-
-// Deprecated: Use WithheldWorkerAttributedValue.ProtoReflect.Descriptor instead.
-func (*WithheldWorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReputerCommitment) Interface() protoreflect.ProtoMessage {
+	return (*ReputerCommitment)(x)
 }
 
-func (x *WithheldWorkerAttributedValue) GetWorker() string {
-	if x != nil {
-		return x.Worker
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReputerCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_ReputerCommitment_topic_id, value) {
+			return
+		}
+	}
+	if x.NonceBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NonceBlockHeight)
+		if !f(fd_ReputerCommitment_nonce_block_height, value) {
+			return
+		}
+	}
+	if x.Reputer != "" {
+		value := protoreflect.ValueOfString(x.Reputer)
+		if !f(fd_ReputerCommitment_reputer, value) {
+			return
+		}
+	}
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commitment)
+		if !f(fd_ReputerCommitment_commitment, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *WithheldWorkerAttributedValue) GetValue() string {
-	if x != nil {
-		return x.Value
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReputerCommitment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommitment.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.ReputerCommitment.nonce_block_height":
+		return x.NonceBlockHeight != int64(0)
+	case "emissions.v3.ReputerCommitment.reputer":
+		return x.Reputer != ""
+	case "emissions.v3.ReputerCommitment.commitment":
+		return len(x.Commitment) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommitment"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommitment does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-type OneOutInfererForecasterValues struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Forecaster          string                           `protobuf:"bytes,3,opt,name=forecaster,proto3" json:"forecaster,omitempty"`
-	OneOutInfererValues []*WithheldWorkerAttributedValue `protobuf:"bytes,4,rep,name=one_out_inferer_values,json=oneOutInfererValues,proto3" json:"one_out_inferer_values,omitempty"`
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommitment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommitment.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.ReputerCommitment.nonce_block_height":
+		x.NonceBlockHeight = int64(0)
+	case "emissions.v3.ReputerCommitment.reputer":
+		x.Reputer = ""
+	case "emissions.v3.ReputerCommitment.commitment":
+		x.Commitment = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommitment"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReputerCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.ReputerCommitment.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.ReputerCommitment.nonce_block_height":
+		value := x.NonceBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.ReputerCommitment.reputer":
+		value := x.Reputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ReputerCommitment.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommitment"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommitment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommitment.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.ReputerCommitment.nonce_block_height":
+		x.NonceBlockHeight = value.Int()
+	case "emissions.v3.ReputerCommitment.reputer":
+		x.Reputer = value.Interface().(string)
+	case "emissions.v3.ReputerCommitment.commitment":
+		x.Commitment = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommitment"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommitment.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.ReputerCommitment is not mutable"))
+	case "emissions.v3.ReputerCommitment.nonce_block_height":
+		panic(fmt.Errorf("field nonce_block_height of message emissions.v3.ReputerCommitment is not mutable"))
+	case "emissions.v3.ReputerCommitment.reputer":
+		panic(fmt.Errorf("field reputer of message emissions.v3.ReputerCommitment is not mutable"))
+	case "emissions.v3.ReputerCommitment.commitment":
+		panic(fmt.Errorf("field commitment of message emissions.v3.ReputerCommitment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommitment"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReputerCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.ReputerCommitment.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.ReputerCommitment.nonce_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.ReputerCommitment.reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ReputerCommitment.commitment":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ReputerCommitment"))
+		}
+		panic(fmt.Errorf("message emissions.v3.ReputerCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReputerCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ReputerCommitment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReputerCommitment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReputerCommitment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReputerCommitment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReputerCommitment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReputerCommitment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.NonceBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NonceBlockHeight))
+		}
+		l = len(x.Reputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerCommitment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reputer) > 0 {
+			i -= len(x.Reputer)
+			copy(dAtA[i:], x.Reputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NonceBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NonceBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReputerCommitment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerCommitment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReputerCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+				}
+				x.NonceBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NonceBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = append(x.Commitment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commitment == nil {
+					x.Commitment = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v3/reputer.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WorkerAttributedValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"` // worker who created the value
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WorkerAttributedValue) Reset() {
+	*x = WorkerAttributedValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerAttributedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerAttributedValue) ProtoMessage() {}
+
+// Deprecated: Use WorkerAttributedValue.ProtoReflect.Descriptor instead.
+func (*WorkerAttributedValue) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkerAttributedValue) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WorkerAttributedValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type WithheldWorkerAttributedValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WithheldWorkerAttributedValue) Reset() {
+	*x = WithheldWorkerAttributedValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithheldWorkerAttributedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithheldWorkerAttributedValue) ProtoMessage() {}
+
+// Deprecated: Use WithheldWorkerAttributedValue.ProtoReflect.Descriptor instead.
+func (*WithheldWorkerAttributedValue) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WithheldWorkerAttributedValue) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WithheldWorkerAttributedValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type OneOutInfererForecasterValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forecaster          string                           `protobuf:"bytes,3,opt,name=forecaster,proto3" json:"forecaster,omitempty"`
+	OneOutInfererValues []*WithheldWorkerAttributedValue `protobuf:"bytes,4,rep,name=one_out_inferer_values,json=oneOutInfererValues,proto3" json:"one_out_inferer_values,omitempty"`
 }
 
 func (x *OneOutInfererForecasterValues) Reset() {
@@ -4341,6 +5111,13 @@ type ValueBundle struct {
 	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any j
 	// log10 L^-_j'ikm || I^-_j'ik
 	OneOutInfererForecasterValues []*OneOutInfererForecasterValues `protobuf:"bytes,12,rep,name=one_out_inferer_forecaster_values,json=oneOutInfererForecasterValues,proto3" json:"one_out_inferer_forecaster_values,omitempty"`
+	// Ground truth reported for topics whose losses are computed by the chain.
+	// The losses above are then left empty by the reputer and filled in by the
+	// chain, which excludes them from the signed bytes of the bundle.
+	GroundTruth string `protobuf:"bytes,13,opt,name=ground_truth,json=groundTruth,proto3" json:"ground_truth,omitempty"`
+	// Ground truth of a topic with chain computed losses and an output dimension
+	// greater than 1, one value per output, in place of ground_truth
+	GroundTruthValues []string `protobuf:"bytes,14,rep,name=ground_truth_values,json=groundTruthValues,proto3" json:"ground_truth_values,omitempty"`
 }
 
 func (x *ValueBundle) Reset() {
@@ -4447,6 +5224,20 @@ func (x *ValueBundle) GetOneOutInfererForecasterValues() []*OneOutInfererForecas
 	return nil
 }
 
+func (x *ValueBundle) GetGroundTruth() string {
+	if x != nil {
+		return x.GroundTruth
+	}
+	return ""
+}
+
+func (x *ValueBundle) GetGroundTruthValues() []string {
+	if x != nil {
+		return x.GroundTruthValues
+	}
+	return nil
+}
+
 // For when the bundle is computed on a per-reputer basis (ie.. if there is an
 // index `m` in the above)
 type ReputerValueBundle struct {
@@ -4535,6 +5326,68 @@ func (x *ReputerValueBundles) GetReputerValueBundles() []*ReputerValueBundle {
 	return nil
 }
 
+// Hash a reputer committed to in the submission window of a reputer nonce,
+// for the value bundle it reveals in the reveal window of the nonce
+type ReputerCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId          uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64  `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	Reputer          string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// sha256 of the serialized value bundle followed by a salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *ReputerCommitment) Reset() {
+	*x = ReputerCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_reputer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReputerCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReputerCommitment) ProtoMessage() {}
+
+// Deprecated: Use ReputerCommitment.ProtoReflect.Descriptor instead.
+func (*ReputerCommitment) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_reputer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReputerCommitment) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *ReputerCommitment) GetNonceBlockHeight() int64 {
+	if x != nil {
+		return x.NonceBlockHeight
+	}
+	return 0
+}
+
+func (x *ReputerCommitment) GetReputer() string {
+	if x != nil {
+		return x.Reputer
+	}
+	return ""
+}
+
+func (x *ReputerCommitment) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
 var File_emissions_v3_reputer_proto protoreflect.FileDescriptor
 
 var file_emissions_v3_reputer_proto_rawDesc = []byte{
@@ -4571,7 +5424,7 @@ var file_emissions_v3_reputer_proto_rawDesc = []byte{
 	0x33, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
 	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
 	0x13, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb5, 0x07, 0x0a, 0x0b, 0x56,
+	0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xfa, 0x08, 0x0a, 0x0b, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
@@ -4630,36 +5483,58 @@ var file_emissions_v3_reputer_proto_rawDesc = []byte{
 	0x4f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72,
 	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x1d, 0x6f,
 	0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65,
-	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x3a, 0x04, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x72, 0x65,
-	0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x13, 0x72, 0x65, 0x70,
-	0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
-	0x42, 0xc2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x0c, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x0c,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x12, 0x67, 0x0a, 0x13, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3c,
+	0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12,
+	0x54, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0xc2,
+	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x33, 0x42, 0x0c, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4674,7 +5549,7 @@ func file_emissions_v3_reputer_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_reputer_proto_rawDescData
 }
 
-var file_emissions_v3_reputer_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_emissions_v3_reputer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_emissions_v3_reputer_proto_goTypes = []interface{}{
 	(*WorkerAttributedValue)(nil),         // 0: emissions.v3.WorkerAttributedValue
 	(*WithheldWorkerAttributedValue)(nil), // 1: emissions.v3.WithheldWorkerAttributedValue
@@ -4682,11 +5557,12 @@ var file_emissions_v3_reputer_proto_goTypes = []interface{}{
 	(*ValueBundle)(nil),                   // 3: emissions.v3.ValueBundle
 	(*ReputerValueBundle)(nil),            // 4: emissions.v3.ReputerValueBundle
 	(*ReputerValueBundles)(nil),           // 5: emissions.v3.ReputerValueBundles
-	(*ReputerRequestNonce)(nil),           // 6: emissions.v3.ReputerRequestNonce
+	(*ReputerCommitment)(nil),             // 6: emissions.v3.ReputerCommitment
+	(*ReputerRequestNonce)(nil),           // 7: emissions.v3.ReputerRequestNonce
 }
 var file_emissions_v3_reputer_proto_depIdxs = []int32{
 	1,  // 0: emissions.v3.OneOutInfererForecasterValues.one_out_inferer_values:type_name -> emissions.v3.WithheldWorkerAttributedValue
-	6,  // 1: emissions.v3.ValueBundle.reputer_request_nonce:type_name -> emissions.v3.ReputerRequestNonce
+	7,  // 1: emissions.v3.ValueBundle.reputer_request_nonce:type_name -> emissions.v3.ReputerRequestNonce
 	0,  // 2: emissions.v3.ValueBundle.inferer_values:type_name -> emissions.v3.WorkerAttributedValue
 	0,  // 3: emissions.v3.ValueBundle.forecaster_values:type_name -> emissions.v3.WorkerAttributedValue
 	1,  // 4: emissions.v3.ValueBundle.one_out_inferer_values:type_name -> emissions.v3.WithheldWorkerAttributedValue
@@ -4781,6 +5657,18 @@ func file_emissions_v3_reputer_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v3_reputer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReputerCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4788,7 +5676,7 @@ func file_emissions_v3_reputer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_reputer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
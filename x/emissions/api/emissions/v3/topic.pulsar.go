@@ -3,7 +3,9 @@ package emissionsv3
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -13,6 +15,52 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Topic_25_list)(nil)
+
+type _Topic_25_list struct {
+	list *[]string
+}
+
+func (x *_Topic_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Topic_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Topic_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Topic_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Topic_25_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Topic at list field QuantileLevels as it is not of Message kind"))
+}
+
+func (x *_Topic_25_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Topic_25_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Topic_25_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Topic                            protoreflect.MessageDescriptor
 	fd_Topic_id                         protoreflect.FieldDescriptor
@@ -32,6 +80,16 @@ var (
 	fd_Topic_active_inferer_quantile    protoreflect.FieldDescriptor
 	fd_Topic_active_forecaster_quantile protoreflect.FieldDescriptor
 	fd_Topic_active_reputer_quantile    protoreflect.FieldDescriptor
+	fd_Topic_typed_metadata             protoreflect.FieldDescriptor
+	fd_Topic_chain_computed_losses      protoreflect.FieldDescriptor
+	fd_Topic_output_dimension           protoreflect.FieldDescriptor
+	fd_Topic_quantile_levels            protoreflect.FieldDescriptor
+	fd_Topic_ground_truth_source        protoreflect.FieldDescriptor
+	fd_Topic_creator_commission         protoreflect.FieldDescriptor
+	fd_Topic_worker_reveal_window       protoreflect.FieldDescriptor
+	fd_Topic_reputer_reveal_window      protoreflect.FieldDescriptor
+	fd_Topic_min_worker_bond            protoreflect.FieldDescriptor
+	fd_Topic_reputer_outlier_threshold  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -54,6 +112,16 @@ func init() {
 	fd_Topic_active_inferer_quantile = md_Topic.Fields().ByName("active_inferer_quantile")
 	fd_Topic_active_forecaster_quantile = md_Topic.Fields().ByName("active_forecaster_quantile")
 	fd_Topic_active_reputer_quantile = md_Topic.Fields().ByName("active_reputer_quantile")
+	fd_Topic_typed_metadata = md_Topic.Fields().ByName("typed_metadata")
+	fd_Topic_chain_computed_losses = md_Topic.Fields().ByName("chain_computed_losses")
+	fd_Topic_output_dimension = md_Topic.Fields().ByName("output_dimension")
+	fd_Topic_quantile_levels = md_Topic.Fields().ByName("quantile_levels")
+	fd_Topic_ground_truth_source = md_Topic.Fields().ByName("ground_truth_source")
+	fd_Topic_creator_commission = md_Topic.Fields().ByName("creator_commission")
+	fd_Topic_worker_reveal_window = md_Topic.Fields().ByName("worker_reveal_window")
+	fd_Topic_reputer_reveal_window = md_Topic.Fields().ByName("reputer_reveal_window")
+	fd_Topic_min_worker_bond = md_Topic.Fields().ByName("min_worker_bond")
+	fd_Topic_reputer_outlier_threshold = md_Topic.Fields().ByName("reputer_outlier_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_Topic)(nil)
@@ -223,6 +291,66 @@ func (x *fastReflection_Topic) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.TypedMetadata != nil {
+		value := protoreflect.ValueOfMessage(x.TypedMetadata.ProtoReflect())
+		if !f(fd_Topic_typed_metadata, value) {
+			return
+		}
+	}
+	if x.ChainComputedLosses != false {
+		value := protoreflect.ValueOfBool(x.ChainComputedLosses)
+		if !f(fd_Topic_chain_computed_losses, value) {
+			return
+		}
+	}
+	if x.OutputDimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputDimension)
+		if !f(fd_Topic_output_dimension, value) {
+			return
+		}
+	}
+	if len(x.QuantileLevels) != 0 {
+		value := protoreflect.ValueOfList(&_Topic_25_list{list: &x.QuantileLevels})
+		if !f(fd_Topic_quantile_levels, value) {
+			return
+		}
+	}
+	if x.GroundTruthSource != nil {
+		value := protoreflect.ValueOfMessage(x.GroundTruthSource.ProtoReflect())
+		if !f(fd_Topic_ground_truth_source, value) {
+			return
+		}
+	}
+	if x.CreatorCommission != "" {
+		value := protoreflect.ValueOfString(x.CreatorCommission)
+		if !f(fd_Topic_creator_commission, value) {
+			return
+		}
+	}
+	if x.WorkerRevealWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WorkerRevealWindow)
+		if !f(fd_Topic_worker_reveal_window, value) {
+			return
+		}
+	}
+	if x.ReputerRevealWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ReputerRevealWindow)
+		if !f(fd_Topic_reputer_reveal_window, value) {
+			return
+		}
+	}
+	if x.MinWorkerBond != "" {
+		value := protoreflect.ValueOfString(x.MinWorkerBond)
+		if !f(fd_Topic_min_worker_bond, value) {
+			return
+		}
+	}
+	if x.ReputerOutlierThreshold != "" {
+		value := protoreflect.ValueOfString(x.ReputerOutlierThreshold)
+		if !f(fd_Topic_reputer_outlier_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -272,6 +400,26 @@ func (x *fastReflection_Topic) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ActiveForecasterQuantile != ""
 	case "emissions.v3.Topic.active_reputer_quantile":
 		return x.ActiveReputerQuantile != ""
+	case "emissions.v3.Topic.typed_metadata":
+		return x.TypedMetadata != nil
+	case "emissions.v3.Topic.chain_computed_losses":
+		return x.ChainComputedLosses != false
+	case "emissions.v3.Topic.output_dimension":
+		return x.OutputDimension != uint64(0)
+	case "emissions.v3.Topic.quantile_levels":
+		return len(x.QuantileLevels) != 0
+	case "emissions.v3.Topic.ground_truth_source":
+		return x.GroundTruthSource != nil
+	case "emissions.v3.Topic.creator_commission":
+		return x.CreatorCommission != ""
+	case "emissions.v3.Topic.worker_reveal_window":
+		return x.WorkerRevealWindow != int64(0)
+	case "emissions.v3.Topic.reputer_reveal_window":
+		return x.ReputerRevealWindow != int64(0)
+	case "emissions.v3.Topic.min_worker_bond":
+		return x.MinWorkerBond != ""
+	case "emissions.v3.Topic.reputer_outlier_threshold":
+		return x.ReputerOutlierThreshold != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -322,6 +470,26 @@ func (x *fastReflection_Topic) Clear(fd protoreflect.FieldDescriptor) {
 		x.ActiveForecasterQuantile = ""
 	case "emissions.v3.Topic.active_reputer_quantile":
 		x.ActiveReputerQuantile = ""
+	case "emissions.v3.Topic.typed_metadata":
+		x.TypedMetadata = nil
+	case "emissions.v3.Topic.chain_computed_losses":
+		x.ChainComputedLosses = false
+	case "emissions.v3.Topic.output_dimension":
+		x.OutputDimension = uint64(0)
+	case "emissions.v3.Topic.quantile_levels":
+		x.QuantileLevels = nil
+	case "emissions.v3.Topic.ground_truth_source":
+		x.GroundTruthSource = nil
+	case "emissions.v3.Topic.creator_commission":
+		x.CreatorCommission = ""
+	case "emissions.v3.Topic.worker_reveal_window":
+		x.WorkerRevealWindow = int64(0)
+	case "emissions.v3.Topic.reputer_reveal_window":
+		x.ReputerRevealWindow = int64(0)
+	case "emissions.v3.Topic.min_worker_bond":
+		x.MinWorkerBond = ""
+	case "emissions.v3.Topic.reputer_outlier_threshold":
+		x.ReputerOutlierThreshold = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -389,6 +557,39 @@ func (x *fastReflection_Topic) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "emissions.v3.Topic.active_reputer_quantile":
 		value := x.ActiveReputerQuantile
 		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.typed_metadata":
+		value := x.TypedMetadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.Topic.chain_computed_losses":
+		value := x.ChainComputedLosses
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v3.Topic.output_dimension":
+		value := x.OutputDimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.Topic.quantile_levels":
+		if len(x.QuantileLevels) == 0 {
+			return protoreflect.ValueOfList(&_Topic_25_list{})
+		}
+		listValue := &_Topic_25_list{list: &x.QuantileLevels}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.Topic.ground_truth_source":
+		value := x.GroundTruthSource
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.Topic.creator_commission":
+		value := x.CreatorCommission
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.worker_reveal_window":
+		value := x.WorkerRevealWindow
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.Topic.reputer_reveal_window":
+		value := x.ReputerRevealWindow
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.Topic.min_worker_bond":
+		value := x.MinWorkerBond
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Topic.reputer_outlier_threshold":
+		value := x.ReputerOutlierThreshold
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -443,6 +644,28 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 		x.ActiveForecasterQuantile = value.Interface().(string)
 	case "emissions.v3.Topic.active_reputer_quantile":
 		x.ActiveReputerQuantile = value.Interface().(string)
+	case "emissions.v3.Topic.typed_metadata":
+		x.TypedMetadata = value.Message().Interface().(*TopicMetadata)
+	case "emissions.v3.Topic.chain_computed_losses":
+		x.ChainComputedLosses = value.Bool()
+	case "emissions.v3.Topic.output_dimension":
+		x.OutputDimension = value.Uint()
+	case "emissions.v3.Topic.quantile_levels":
+		lv := value.List()
+		clv := lv.(*_Topic_25_list)
+		x.QuantileLevels = *clv.list
+	case "emissions.v3.Topic.ground_truth_source":
+		x.GroundTruthSource = value.Message().Interface().(*GroundTruthSource)
+	case "emissions.v3.Topic.creator_commission":
+		x.CreatorCommission = value.Interface().(string)
+	case "emissions.v3.Topic.worker_reveal_window":
+		x.WorkerRevealWindow = value.Int()
+	case "emissions.v3.Topic.reputer_reveal_window":
+		x.ReputerRevealWindow = value.Int()
+	case "emissions.v3.Topic.min_worker_bond":
+		x.MinWorkerBond = value.Interface().(string)
+	case "emissions.v3.Topic.reputer_outlier_threshold":
+		x.ReputerOutlierThreshold = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -463,6 +686,22 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v3.Topic.typed_metadata":
+		if x.TypedMetadata == nil {
+			x.TypedMetadata = new(TopicMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.TypedMetadata.ProtoReflect())
+	case "emissions.v3.Topic.quantile_levels":
+		if x.QuantileLevels == nil {
+			x.QuantileLevels = []string{}
+		}
+		value := &_Topic_25_list{list: &x.QuantileLevels}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.Topic.ground_truth_source":
+		if x.GroundTruthSource == nil {
+			x.GroundTruthSource = new(GroundTruthSource)
+		}
+		return protoreflect.ValueOfMessage(x.GroundTruthSource.ProtoReflect())
 	case "emissions.v3.Topic.id":
 		panic(fmt.Errorf("field id of message emissions.v3.Topic is not mutable"))
 	case "emissions.v3.Topic.creator":
@@ -497,6 +736,20 @@ func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field active_forecaster_quantile of message emissions.v3.Topic is not mutable"))
 	case "emissions.v3.Topic.active_reputer_quantile":
 		panic(fmt.Errorf("field active_reputer_quantile of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.chain_computed_losses":
+		panic(fmt.Errorf("field chain_computed_losses of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.output_dimension":
+		panic(fmt.Errorf("field output_dimension of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.creator_commission":
+		panic(fmt.Errorf("field creator_commission of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.worker_reveal_window":
+		panic(fmt.Errorf("field worker_reveal_window of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reputer_reveal_window":
+		panic(fmt.Errorf("field reputer_reveal_window of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.min_worker_bond":
+		panic(fmt.Errorf("field min_worker_bond of message emissions.v3.Topic is not mutable"))
+	case "emissions.v3.Topic.reputer_outlier_threshold":
+		panic(fmt.Errorf("field reputer_outlier_threshold of message emissions.v3.Topic is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -544,6 +797,29 @@ func (x *fastReflection_Topic) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfString("")
 	case "emissions.v3.Topic.active_reputer_quantile":
 		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.typed_metadata":
+		m := new(TopicMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.Topic.chain_computed_losses":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v3.Topic.output_dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.Topic.quantile_levels":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Topic_25_list{list: &list})
+	case "emissions.v3.Topic.ground_truth_source":
+		m := new(GroundTruthSource)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.Topic.creator_commission":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.worker_reveal_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.Topic.reputer_reveal_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.Topic.min_worker_bond":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Topic.reputer_outlier_threshold":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Topic"))
@@ -675,6 +951,44 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.TypedMetadata != nil {
+			l = options.Size(x.TypedMetadata)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainComputedLosses {
+			n += 3
+		}
+		if x.OutputDimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.OutputDimension))
+		}
+		if len(x.QuantileLevels) > 0 {
+			for _, s := range x.QuantileLevels {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.GroundTruthSource != nil {
+			l = options.Size(x.GroundTruthSource)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CreatorCommission)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.WorkerRevealWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.WorkerRevealWindow))
+		}
+		if x.ReputerRevealWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReputerRevealWindow))
+		}
+		l = len(x.MinWorkerBond)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputerOutlierThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -704,6 +1018,109 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ReputerOutlierThreshold) > 0 {
+			i -= len(x.ReputerOutlierThreshold)
+			copy(dAtA[i:], x.ReputerOutlierThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerOutlierThreshold)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.MinWorkerBond) > 0 {
+			i -= len(x.MinWorkerBond)
+			copy(dAtA[i:], x.MinWorkerBond)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinWorkerBond)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if x.ReputerRevealWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputerRevealWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe8
+		}
+		if x.WorkerRevealWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkerRevealWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe0
+		}
+		if len(x.CreatorCommission) > 0 {
+			i -= len(x.CreatorCommission)
+			copy(dAtA[i:], x.CreatorCommission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreatorCommission)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.GroundTruthSource != nil {
+			encoded, err := options.Marshal(x.GroundTruthSource)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+		if len(x.QuantileLevels) > 0 {
+			for iNdEx := len(x.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.QuantileLevels[iNdEx])
+				copy(dAtA[i:], x.QuantileLevels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuantileLevels[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if x.OutputDimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputDimension))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.ChainComputedLosses {
+			i--
+			if x.ChainComputedLosses {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.TypedMetadata != nil {
+			encoded, err := options.Marshal(x.TypedMetadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
 		if len(x.ActiveReputerQuantile) > 0 {
 			i -= len(x.ActiveReputerQuantile)
 			copy(dAtA[i:], x.ActiveReputerQuantile)
@@ -1344,80 +1761,2924 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				}
 				x.ActiveReputerQuantile = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypedMetadata", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.TypedMetadata == nil {
+					x.TypedMetadata = &TopicMetadata{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_TopicList_1_list)(nil)
-
-type _TopicList_1_list struct {
-	list *[]*Topic
-}
-
-func (x *_TopicList_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_TopicList_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_TopicList_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Topic)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_TopicList_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Topic)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_TopicList_1_list) AppendMutable() protoreflect.Value {
-	v := new(Topic)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_TopicList_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TypedMetadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainComputedLosses", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ChainComputedLosses = bool(v != 0)
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
+				}
+				x.OutputDimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputDimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuantileLevels = append(x.QuantileLevels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroundTruthSource", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GroundTruthSource == nil {
+					x.GroundTruthSource = &GroundTruthSource{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GroundTruthSource); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatorCommission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreatorCommission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 28:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+				}
+				x.WorkerRevealWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkerRevealWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 29:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerRevealWindow", wireType)
+				}
+				x.ReputerRevealWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputerRevealWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinWorkerBond", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinWorkerBond = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerOutlierThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GroundTruthSource                 protoreflect.MessageDescriptor
+	fd_GroundTruthSource_parent_topic_id protoreflect.FieldDescriptor
+	fd_GroundTruthSource_aggregation     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_GroundTruthSource = File_emissions_v3_topic_proto.Messages().ByName("GroundTruthSource")
+	fd_GroundTruthSource_parent_topic_id = md_GroundTruthSource.Fields().ByName("parent_topic_id")
+	fd_GroundTruthSource_aggregation = md_GroundTruthSource.Fields().ByName("aggregation")
+}
+
+var _ protoreflect.Message = (*fastReflection_GroundTruthSource)(nil)
+
+type fastReflection_GroundTruthSource GroundTruthSource
+
+func (x *GroundTruthSource) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GroundTruthSource)(x)
+}
+
+func (x *GroundTruthSource) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GroundTruthSource_messageType fastReflection_GroundTruthSource_messageType
+var _ protoreflect.MessageType = fastReflection_GroundTruthSource_messageType{}
+
+type fastReflection_GroundTruthSource_messageType struct{}
+
+func (x fastReflection_GroundTruthSource_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GroundTruthSource)(nil)
+}
+func (x fastReflection_GroundTruthSource_messageType) New() protoreflect.Message {
+	return new(fastReflection_GroundTruthSource)
+}
+func (x fastReflection_GroundTruthSource_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroundTruthSource
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GroundTruthSource) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroundTruthSource
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GroundTruthSource) Type() protoreflect.MessageType {
+	return _fastReflection_GroundTruthSource_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GroundTruthSource) New() protoreflect.Message {
+	return new(fastReflection_GroundTruthSource)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GroundTruthSource) Interface() protoreflect.ProtoMessage {
+	return (*GroundTruthSource)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GroundTruthSource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ParentTopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParentTopicId)
+		if !f(fd_GroundTruthSource_parent_topic_id, value) {
+			return
+		}
+	}
+	if x.Aggregation != "" {
+		value := protoreflect.ValueOfString(x.Aggregation)
+		if !f(fd_GroundTruthSource_aggregation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GroundTruthSource) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruthSource.parent_topic_id":
+		return x.ParentTopicId != uint64(0)
+	case "emissions.v3.GroundTruthSource.aggregation":
+		return x.Aggregation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruthSource"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruthSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruthSource) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruthSource.parent_topic_id":
+		x.ParentTopicId = uint64(0)
+	case "emissions.v3.GroundTruthSource.aggregation":
+		x.Aggregation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruthSource"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruthSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GroundTruthSource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.GroundTruthSource.parent_topic_id":
+		value := x.ParentTopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.GroundTruthSource.aggregation":
+		value := x.Aggregation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruthSource"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruthSource does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruthSource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruthSource.parent_topic_id":
+		x.ParentTopicId = value.Uint()
+	case "emissions.v3.GroundTruthSource.aggregation":
+		x.Aggregation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruthSource"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruthSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruthSource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruthSource.parent_topic_id":
+		panic(fmt.Errorf("field parent_topic_id of message emissions.v3.GroundTruthSource is not mutable"))
+	case "emissions.v3.GroundTruthSource.aggregation":
+		panic(fmt.Errorf("field aggregation of message emissions.v3.GroundTruthSource is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruthSource"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruthSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GroundTruthSource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.GroundTruthSource.parent_topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.GroundTruthSource.aggregation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.GroundTruthSource"))
+		}
+		panic(fmt.Errorf("message emissions.v3.GroundTruthSource does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GroundTruthSource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.GroundTruthSource", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GroundTruthSource) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GroundTruthSource) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GroundTruthSource) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GroundTruthSource) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GroundTruthSource)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ParentTopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParentTopicId))
+		}
+		l = len(x.Aggregation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GroundTruthSource)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Aggregation) > 0 {
+			i -= len(x.Aggregation)
+			copy(dAtA[i:], x.Aggregation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aggregation)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ParentTopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParentTopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GroundTruthSource)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroundTruthSource: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroundTruthSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParentTopicId", wireType)
+				}
+				x.ParentTopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParentTopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Aggregation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TopicMetadata_4_list)(nil)
+
+type _TopicMetadata_4_list struct {
+	list *[]string
+}
+
+func (x *_TopicMetadata_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicMetadata_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicMetadata_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicMetadata_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicMetadata_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicMetadata at list field Tags as it is not of Message kind"))
+}
+
+func (x *_TopicMetadata_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicMetadata_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicMetadata_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TopicMetadata                            protoreflect.MessageDescriptor
+	fd_TopicMetadata_target                     protoreflect.FieldDescriptor
+	fd_TopicMetadata_unit                       protoreflect.FieldDescriptor
+	fd_TopicMetadata_prediction_horizon_seconds protoreflect.FieldDescriptor
+	fd_TopicMetadata_tags                       protoreflect.FieldDescriptor
+	fd_TopicMetadata_category                   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_TopicMetadata = File_emissions_v3_topic_proto.Messages().ByName("TopicMetadata")
+	fd_TopicMetadata_target = md_TopicMetadata.Fields().ByName("target")
+	fd_TopicMetadata_unit = md_TopicMetadata.Fields().ByName("unit")
+	fd_TopicMetadata_prediction_horizon_seconds = md_TopicMetadata.Fields().ByName("prediction_horizon_seconds")
+	fd_TopicMetadata_tags = md_TopicMetadata.Fields().ByName("tags")
+	fd_TopicMetadata_category = md_TopicMetadata.Fields().ByName("category")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicMetadata)(nil)
+
+type fastReflection_TopicMetadata TopicMetadata
+
+func (x *TopicMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicMetadata)(x)
+}
+
+func (x *TopicMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicMetadata_messageType fastReflection_TopicMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_TopicMetadata_messageType{}
+
+type fastReflection_TopicMetadata_messageType struct{}
+
+func (x fastReflection_TopicMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicMetadata)(nil)
+}
+func (x fastReflection_TopicMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicMetadata)
+}
+func (x fastReflection_TopicMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_TopicMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicMetadata) New() protoreflect.Message {
+	return new(fastReflection_TopicMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicMetadata) Interface() protoreflect.ProtoMessage {
+	return (*TopicMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_TopicMetadata_target, value) {
+			return
+		}
+	}
+	if x.Unit != "" {
+		value := protoreflect.ValueOfString(x.Unit)
+		if !f(fd_TopicMetadata_unit, value) {
+			return
+		}
+	}
+	if x.PredictionHorizonSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PredictionHorizonSeconds)
+		if !f(fd_TopicMetadata_prediction_horizon_seconds, value) {
+			return
+		}
+	}
+	if len(x.Tags) != 0 {
+		value := protoreflect.ValueOfList(&_TopicMetadata_4_list{list: &x.Tags})
+		if !f(fd_TopicMetadata_tags, value) {
+			return
+		}
+	}
+	if x.Category != "" {
+		value := protoreflect.ValueOfString(x.Category)
+		if !f(fd_TopicMetadata_category, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.TopicMetadata.target":
+		return x.Target != ""
+	case "emissions.v3.TopicMetadata.unit":
+		return x.Unit != ""
+	case "emissions.v3.TopicMetadata.prediction_horizon_seconds":
+		return x.PredictionHorizonSeconds != int64(0)
+	case "emissions.v3.TopicMetadata.tags":
+		return len(x.Tags) != 0
+	case "emissions.v3.TopicMetadata.category":
+		return x.Category != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicMetadata"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicMetadata.target":
+		x.Target = ""
+	case "emissions.v3.TopicMetadata.unit":
+		x.Unit = ""
+	case "emissions.v3.TopicMetadata.prediction_horizon_seconds":
+		x.PredictionHorizonSeconds = int64(0)
+	case "emissions.v3.TopicMetadata.tags":
+		x.Tags = nil
+	case "emissions.v3.TopicMetadata.category":
+		x.Category = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicMetadata"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.TopicMetadata.target":
+		value := x.Target
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.TopicMetadata.unit":
+		value := x.Unit
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.TopicMetadata.prediction_horizon_seconds":
+		value := x.PredictionHorizonSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.TopicMetadata.tags":
+		if len(x.Tags) == 0 {
+			return protoreflect.ValueOfList(&_TopicMetadata_4_list{})
+		}
+		listValue := &_TopicMetadata_4_list{list: &x.Tags}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.TopicMetadata.category":
+		value := x.Category
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicMetadata"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicMetadata.target":
+		x.Target = value.Interface().(string)
+	case "emissions.v3.TopicMetadata.unit":
+		x.Unit = value.Interface().(string)
+	case "emissions.v3.TopicMetadata.prediction_horizon_seconds":
+		x.PredictionHorizonSeconds = value.Int()
+	case "emissions.v3.TopicMetadata.tags":
+		lv := value.List()
+		clv := lv.(*_TopicMetadata_4_list)
+		x.Tags = *clv.list
+	case "emissions.v3.TopicMetadata.category":
+		x.Category = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicMetadata"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicMetadata.tags":
+		if x.Tags == nil {
+			x.Tags = []string{}
+		}
+		value := &_TopicMetadata_4_list{list: &x.Tags}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v3.TopicMetadata.target":
+		panic(fmt.Errorf("field target of message emissions.v3.TopicMetadata is not mutable"))
+	case "emissions.v3.TopicMetadata.unit":
+		panic(fmt.Errorf("field unit of message emissions.v3.TopicMetadata is not mutable"))
+	case "emissions.v3.TopicMetadata.prediction_horizon_seconds":
+		panic(fmt.Errorf("field prediction_horizon_seconds of message emissions.v3.TopicMetadata is not mutable"))
+	case "emissions.v3.TopicMetadata.category":
+		panic(fmt.Errorf("field category of message emissions.v3.TopicMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicMetadata"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicMetadata.target":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.TopicMetadata.unit":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.TopicMetadata.prediction_horizon_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.TopicMetadata.tags":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicMetadata_4_list{list: &list})
+	case "emissions.v3.TopicMetadata.category":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicMetadata"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Target)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Unit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PredictionHorizonSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.PredictionHorizonSeconds))
+		}
+		if len(x.Tags) > 0 {
+			for _, s := range x.Tags {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Category)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Category) > 0 {
+			i -= len(x.Category)
+			copy(dAtA[i:], x.Category)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Category)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Tags) > 0 {
+			for iNdEx := len(x.Tags) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Tags[iNdEx])
+				copy(dAtA[i:], x.Tags[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tags[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.PredictionHorizonSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PredictionHorizonSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Unit) > 0 {
+			i -= len(x.Unit)
+			copy(dAtA[i:], x.Unit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Unit)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Unit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PredictionHorizonSeconds", wireType)
+				}
+				x.PredictionHorizonSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PredictionHorizonSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tags = append(x.Tags, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Category = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicArchive                                 protoreflect.MessageDescriptor
+	fd_TopicArchive_topic                           protoreflect.FieldDescriptor
+	fd_TopicArchive_archived_block_height           protoreflect.FieldDescriptor
+	fd_TopicArchive_stake_removals_due_block_height protoreflect.FieldDescriptor
+	fd_TopicArchive_stake_returned                  protoreflect.FieldDescriptor
+	fd_TopicArchive_pruned                          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_TopicArchive = File_emissions_v3_topic_proto.Messages().ByName("TopicArchive")
+	fd_TopicArchive_topic = md_TopicArchive.Fields().ByName("topic")
+	fd_TopicArchive_archived_block_height = md_TopicArchive.Fields().ByName("archived_block_height")
+	fd_TopicArchive_stake_removals_due_block_height = md_TopicArchive.Fields().ByName("stake_removals_due_block_height")
+	fd_TopicArchive_stake_returned = md_TopicArchive.Fields().ByName("stake_returned")
+	fd_TopicArchive_pruned = md_TopicArchive.Fields().ByName("pruned")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicArchive)(nil)
+
+type fastReflection_TopicArchive TopicArchive
+
+func (x *TopicArchive) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicArchive)(x)
+}
+
+func (x *TopicArchive) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicArchive_messageType fastReflection_TopicArchive_messageType
+var _ protoreflect.MessageType = fastReflection_TopicArchive_messageType{}
+
+type fastReflection_TopicArchive_messageType struct{}
+
+func (x fastReflection_TopicArchive_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicArchive)(nil)
+}
+func (x fastReflection_TopicArchive_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicArchive)
+}
+func (x fastReflection_TopicArchive_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicArchive
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicArchive) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicArchive
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicArchive) Type() protoreflect.MessageType {
+	return _fastReflection_TopicArchive_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicArchive) New() protoreflect.Message {
+	return new(fastReflection_TopicArchive)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicArchive) Interface() protoreflect.ProtoMessage {
+	return (*TopicArchive)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicArchive) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Topic != nil {
+		value := protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
+		if !f(fd_TopicArchive_topic, value) {
+			return
+		}
+	}
+	if x.ArchivedBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ArchivedBlockHeight)
+		if !f(fd_TopicArchive_archived_block_height, value) {
+			return
+		}
+	}
+	if x.StakeRemovalsDueBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StakeRemovalsDueBlockHeight)
+		if !f(fd_TopicArchive_stake_removals_due_block_height, value) {
+			return
+		}
+	}
+	if x.StakeReturned != false {
+		value := protoreflect.ValueOfBool(x.StakeReturned)
+		if !f(fd_TopicArchive_stake_returned, value) {
+			return
+		}
+	}
+	if x.Pruned != false {
+		value := protoreflect.ValueOfBool(x.Pruned)
+		if !f(fd_TopicArchive_pruned, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicArchive) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.TopicArchive.topic":
+		return x.Topic != nil
+	case "emissions.v3.TopicArchive.archived_block_height":
+		return x.ArchivedBlockHeight != int64(0)
+	case "emissions.v3.TopicArchive.stake_removals_due_block_height":
+		return x.StakeRemovalsDueBlockHeight != int64(0)
+	case "emissions.v3.TopicArchive.stake_returned":
+		return x.StakeReturned != false
+	case "emissions.v3.TopicArchive.pruned":
+		return x.Pruned != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicArchive"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicArchive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicArchive) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicArchive.topic":
+		x.Topic = nil
+	case "emissions.v3.TopicArchive.archived_block_height":
+		x.ArchivedBlockHeight = int64(0)
+	case "emissions.v3.TopicArchive.stake_removals_due_block_height":
+		x.StakeRemovalsDueBlockHeight = int64(0)
+	case "emissions.v3.TopicArchive.stake_returned":
+		x.StakeReturned = false
+	case "emissions.v3.TopicArchive.pruned":
+		x.Pruned = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicArchive"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicArchive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicArchive) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.TopicArchive.topic":
+		value := x.Topic
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.TopicArchive.archived_block_height":
+		value := x.ArchivedBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.TopicArchive.stake_removals_due_block_height":
+		value := x.StakeRemovalsDueBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.TopicArchive.stake_returned":
+		value := x.StakeReturned
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v3.TopicArchive.pruned":
+		value := x.Pruned
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicArchive"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicArchive does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicArchive) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.TopicArchive.topic":
+		x.Topic = value.Message().Interface().(*Topic)
+	case "emissions.v3.TopicArchive.archived_block_height":
+		x.ArchivedBlockHeight = value.Int()
+	case "emissions.v3.TopicArchive.stake_removals_due_block_height":
+		x.StakeRemovalsDueBlockHeight = value.Int()
+	case "emissions.v3.TopicArchive.stake_returned":
+		x.StakeReturned = value.Bool()
+	case "emissions.v3.TopicArchive.pruned":
+		x.Pruned = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicArchive"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicArchive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicArchive) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicArchive.topic":
+		if x.Topic == nil {
+			x.Topic = new(Topic)
+		}
+		return protoreflect.ValueOfMessage(x.Topic.ProtoReflect())
+	case "emissions.v3.TopicArchive.archived_block_height":
+		panic(fmt.Errorf("field archived_block_height of message emissions.v3.TopicArchive is not mutable"))
+	case "emissions.v3.TopicArchive.stake_removals_due_block_height":
+		panic(fmt.Errorf("field stake_removals_due_block_height of message emissions.v3.TopicArchive is not mutable"))
+	case "emissions.v3.TopicArchive.stake_returned":
+		panic(fmt.Errorf("field stake_returned of message emissions.v3.TopicArchive is not mutable"))
+	case "emissions.v3.TopicArchive.pruned":
+		panic(fmt.Errorf("field pruned of message emissions.v3.TopicArchive is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicArchive"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicArchive does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicArchive) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.TopicArchive.topic":
+		m := new(Topic)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.TopicArchive.archived_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.TopicArchive.stake_removals_due_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.TopicArchive.stake_returned":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v3.TopicArchive.pruned":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.TopicArchive"))
+		}
+		panic(fmt.Errorf("message emissions.v3.TopicArchive does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicArchive) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.TopicArchive", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicArchive) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicArchive) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicArchive) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicArchive) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicArchive)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Topic != nil {
+			l = options.Size(x.Topic)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ArchivedBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ArchivedBlockHeight))
+		}
+		if x.StakeRemovalsDueBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StakeRemovalsDueBlockHeight))
+		}
+		if x.StakeReturned {
+			n += 2
+		}
+		if x.Pruned {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicArchive)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pruned {
+			i--
+			if x.Pruned {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.StakeReturned {
+			i--
+			if x.StakeReturned {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.StakeRemovalsDueBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StakeRemovalsDueBlockHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ArchivedBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ArchivedBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Topic != nil {
+			encoded, err := options.Marshal(x.Topic)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicArchive)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicArchive: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicArchive: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Topic == nil {
+					x.Topic = &Topic{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topic); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedBlockHeight", wireType)
+				}
+				x.ArchivedBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ArchivedBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsDueBlockHeight", wireType)
+				}
+				x.StakeRemovalsDueBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StakeRemovalsDueBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeReturned", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.StakeReturned = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Pruned = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FundingSubscription                        protoreflect.MessageDescriptor
+	fd_FundingSubscription_id                     protoreflect.FieldDescriptor
+	fd_FundingSubscription_topic_id               protoreflect.FieldDescriptor
+	fd_FundingSubscription_funder                 protoreflect.FieldDescriptor
+	fd_FundingSubscription_amount_per_drip        protoreflect.FieldDescriptor
+	fd_FundingSubscription_interval_blocks        protoreflect.FieldDescriptor
+	fd_FundingSubscription_escrow                 protoreflect.FieldDescriptor
+	fd_FundingSubscription_next_drip_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_topic_proto_init()
+	md_FundingSubscription = File_emissions_v3_topic_proto.Messages().ByName("FundingSubscription")
+	fd_FundingSubscription_id = md_FundingSubscription.Fields().ByName("id")
+	fd_FundingSubscription_topic_id = md_FundingSubscription.Fields().ByName("topic_id")
+	fd_FundingSubscription_funder = md_FundingSubscription.Fields().ByName("funder")
+	fd_FundingSubscription_amount_per_drip = md_FundingSubscription.Fields().ByName("amount_per_drip")
+	fd_FundingSubscription_interval_blocks = md_FundingSubscription.Fields().ByName("interval_blocks")
+	fd_FundingSubscription_escrow = md_FundingSubscription.Fields().ByName("escrow")
+	fd_FundingSubscription_next_drip_block_height = md_FundingSubscription.Fields().ByName("next_drip_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_FundingSubscription)(nil)
+
+type fastReflection_FundingSubscription FundingSubscription
+
+func (x *FundingSubscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FundingSubscription)(x)
+}
+
+func (x *FundingSubscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_topic_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FundingSubscription_messageType fastReflection_FundingSubscription_messageType
+var _ protoreflect.MessageType = fastReflection_FundingSubscription_messageType{}
+
+type fastReflection_FundingSubscription_messageType struct{}
+
+func (x fastReflection_FundingSubscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FundingSubscription)(nil)
+}
+func (x fastReflection_FundingSubscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_FundingSubscription)
+}
+func (x fastReflection_FundingSubscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FundingSubscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FundingSubscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_FundingSubscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FundingSubscription) Type() protoreflect.MessageType {
+	return _fastReflection_FundingSubscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FundingSubscription) New() protoreflect.Message {
+	return new(fastReflection_FundingSubscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FundingSubscription) Interface() protoreflect.ProtoMessage {
+	return (*FundingSubscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FundingSubscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_FundingSubscription_id, value) {
+			return
+		}
+	}
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_FundingSubscription_topic_id, value) {
+			return
+		}
+	}
+	if x.Funder != "" {
+		value := protoreflect.ValueOfString(x.Funder)
+		if !f(fd_FundingSubscription_funder, value) {
+			return
+		}
+	}
+	if x.AmountPerDrip != "" {
+		value := protoreflect.ValueOfString(x.AmountPerDrip)
+		if !f(fd_FundingSubscription_amount_per_drip, value) {
+			return
+		}
+	}
+	if x.IntervalBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.IntervalBlocks)
+		if !f(fd_FundingSubscription_interval_blocks, value) {
+			return
+		}
+	}
+	if x.Escrow != "" {
+		value := protoreflect.ValueOfString(x.Escrow)
+		if !f(fd_FundingSubscription_escrow, value) {
+			return
+		}
+	}
+	if x.NextDripBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextDripBlockHeight)
+		if !f(fd_FundingSubscription_next_drip_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FundingSubscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.FundingSubscription.id":
+		return x.Id != uint64(0)
+	case "emissions.v3.FundingSubscription.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.FundingSubscription.funder":
+		return x.Funder != ""
+	case "emissions.v3.FundingSubscription.amount_per_drip":
+		return x.AmountPerDrip != ""
+	case "emissions.v3.FundingSubscription.interval_blocks":
+		return x.IntervalBlocks != int64(0)
+	case "emissions.v3.FundingSubscription.escrow":
+		return x.Escrow != ""
+	case "emissions.v3.FundingSubscription.next_drip_block_height":
+		return x.NextDripBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.FundingSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v3.FundingSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingSubscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.FundingSubscription.id":
+		x.Id = uint64(0)
+	case "emissions.v3.FundingSubscription.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.FundingSubscription.funder":
+		x.Funder = ""
+	case "emissions.v3.FundingSubscription.amount_per_drip":
+		x.AmountPerDrip = ""
+	case "emissions.v3.FundingSubscription.interval_blocks":
+		x.IntervalBlocks = int64(0)
+	case "emissions.v3.FundingSubscription.escrow":
+		x.Escrow = ""
+	case "emissions.v3.FundingSubscription.next_drip_block_height":
+		x.NextDripBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.FundingSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v3.FundingSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FundingSubscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.FundingSubscription.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.FundingSubscription.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.FundingSubscription.funder":
+		value := x.Funder
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.FundingSubscription.amount_per_drip":
+		value := x.AmountPerDrip
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.FundingSubscription.interval_blocks":
+		value := x.IntervalBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.FundingSubscription.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.FundingSubscription.next_drip_block_height":
+		value := x.NextDripBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.FundingSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v3.FundingSubscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingSubscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.FundingSubscription.id":
+		x.Id = value.Uint()
+	case "emissions.v3.FundingSubscription.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.FundingSubscription.funder":
+		x.Funder = value.Interface().(string)
+	case "emissions.v3.FundingSubscription.amount_per_drip":
+		x.AmountPerDrip = value.Interface().(string)
+	case "emissions.v3.FundingSubscription.interval_blocks":
+		x.IntervalBlocks = value.Int()
+	case "emissions.v3.FundingSubscription.escrow":
+		x.Escrow = value.Interface().(string)
+	case "emissions.v3.FundingSubscription.next_drip_block_height":
+		x.NextDripBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.FundingSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v3.FundingSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingSubscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.FundingSubscription.id":
+		panic(fmt.Errorf("field id of message emissions.v3.FundingSubscription is not mutable"))
+	case "emissions.v3.FundingSubscription.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.FundingSubscription is not mutable"))
+	case "emissions.v3.FundingSubscription.funder":
+		panic(fmt.Errorf("field funder of message emissions.v3.FundingSubscription is not mutable"))
+	case "emissions.v3.FundingSubscription.amount_per_drip":
+		panic(fmt.Errorf("field amount_per_drip of message emissions.v3.FundingSubscription is not mutable"))
+	case "emissions.v3.FundingSubscription.interval_blocks":
+		panic(fmt.Errorf("field interval_blocks of message emissions.v3.FundingSubscription is not mutable"))
+	case "emissions.v3.FundingSubscription.escrow":
+		panic(fmt.Errorf("field escrow of message emissions.v3.FundingSubscription is not mutable"))
+	case "emissions.v3.FundingSubscription.next_drip_block_height":
+		panic(fmt.Errorf("field next_drip_block_height of message emissions.v3.FundingSubscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.FundingSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v3.FundingSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FundingSubscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.FundingSubscription.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.FundingSubscription.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.FundingSubscription.funder":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.FundingSubscription.amount_per_drip":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.FundingSubscription.interval_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.FundingSubscription.escrow":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.FundingSubscription.next_drip_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.FundingSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v3.FundingSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FundingSubscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.FundingSubscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FundingSubscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FundingSubscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FundingSubscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FundingSubscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FundingSubscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.Funder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AmountPerDrip)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IntervalBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalBlocks))
+		}
+		l = len(x.Escrow)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextDripBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextDripBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FundingSubscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextDripBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextDripBlockHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Escrow) > 0 {
+			i -= len(x.Escrow)
+			copy(dAtA[i:], x.Escrow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Escrow)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.IntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalBlocks))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.AmountPerDrip) > 0 {
+			i -= len(x.AmountPerDrip)
+			copy(dAtA[i:], x.AmountPerDrip)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountPerDrip)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Funder) > 0 {
+			i -= len(x.Funder)
+			copy(dAtA[i:], x.Funder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Funder)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FundingSubscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FundingSubscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FundingSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Funder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountPerDrip", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmountPerDrip = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+				}
+				x.IntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrow = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextDripBlockHeight", wireType)
+				}
+				x.NextDripBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextDripBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TopicList_1_list)(nil)
+
+type _TopicList_1_list struct {
+	list *[]*Topic
+}
+
+func (x *_TopicList_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicList_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TopicList_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Topic)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicList_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Topic)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicList_1_list) AppendMutable() protoreflect.Value {
+	v := new(Topic)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TopicList_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
@@ -1450,7 +4711,7 @@ func (x *TopicList) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicList) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[1]
+	mi := &file_emissions_v3_topic_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +5156,7 @@ func (x *TimestampedActorNonce) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TimestampedActorNonce) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[2]
+	mi := &file_emissions_v3_topic_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2422,7 +5683,7 @@ func (x *TopicIds) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIds) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[3]
+	mi := &file_emissions_v3_topic_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2914,7 +6175,7 @@ func (x *TopicIdWeightPair) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TopicIdWeightPair) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_topic_proto_msgTypes[4]
+	mi := &file_emissions_v3_topic_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3360,184 +6621,569 @@ func (x *fastReflection_TopicIdWeightPair) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v3/topic.proto
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v3/topic.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Topic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator                string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata               string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LossMethod             string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
+	EpochLastEnded         int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
+	EpochLength            int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag         int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	PNorm                  string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
+	AlphaRegret            string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
+	AllowNegative          bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
+	Epsilon                string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	InitialRegret          string `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
+	WorkerSubmissionWindow int64  `protobuf:"varint,17,opt,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	// alpha parameter to weight previous score EMA importance in
+	// filtering the active set of inferers, forecasters, and reputers
+	MeritSortitionAlpha      string `protobuf:"bytes,18,opt,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3" json:"merit_sortition_alpha,omitempty"`
+	ActiveInfererQuantile    string `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3" json:"active_inferer_quantile,omitempty"`
+	ActiveForecasterQuantile string `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3" json:"active_forecaster_quantile,omitempty"`
+	ActiveReputerQuantile    string `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3" json:"active_reputer_quantile,omitempty"`
+	// structured description of the topic, if any, indexed to filter topics
+	TypedMetadata *TopicMetadata `protobuf:"bytes,22,opt,name=typed_metadata,json=typedMetadata,proto3" json:"typed_metadata,omitempty"`
+	// reputers report the ground truth and the chain computes the losses with
+	// the loss method of the topic, which must then be a registered one
+	ChainComputedLosses bool `protobuf:"varint,23,opt,name=chain_computed_losses,json=chainComputedLosses,proto3" json:"chain_computed_losses,omitempty"`
+	// number of values of each inference, 0 and 1 both standing for a single
+	// value
+	OutputDimension uint64 `protobuf:"varint,24,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	// levels, strictly increasing in (0, 1), of the quantiles making up the
+	// inferences of a probabilistic topic, one per output
+	QuantileLevels []string `protobuf:"bytes,25,rep,name=quantile_levels,json=quantileLevels,proto3" json:"quantile_levels,omitempty"`
+	// ground truth of a derived topic, taken from the network inferences of
+	// another topic, in which case the chain computes the losses of the topic
+	// and reputers do not submit any
+	GroundTruthSource *GroundTruthSource `protobuf:"bytes,26,opt,name=ground_truth_source,json=groundTruthSource,proto3" json:"ground_truth_source,omitempty"`
+	// share of the rewards of the topic paid to its creator, in [0, 1), of which
+	// at most the max_topic_creator_commission param is paid out
+	CreatorCommission string `protobuf:"bytes,27,opt,name=creator_commission,json=creatorCommission,proto3" json:"creator_commission,omitempty"`
+	// number of blocks following the worker submission window during which
+	// workers reveal the payloads they committed to in the submission window,
+	// 0 for workers to submit their payloads in plaintext
+	WorkerRevealWindow int64 `protobuf:"varint,28,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	// number of blocks following the reputer submission window during which
+	// reputers reveal the loss bundles they committed to in the submission
+	// window, 0 for reputers to submit their loss bundles in plaintext
+	ReputerRevealWindow int64 `protobuf:"varint,29,opt,name=reputer_reveal_window,json=reputerRevealWindow,proto3" json:"reputer_reveal_window,omitempty"`
+	// bond workers must hold in the topic for their payloads to be accepted,
+	// 0 for workers to need no bond
+	MinWorkerBond string `protobuf:"bytes,30,opt,name=min_worker_bond,json=minWorkerBond,proto3" json:"min_worker_bond,omitempty"`
+	// distance of the losses reported by a reputer to the consensus losses,
+	// relative to the norm of the consensus losses, beyond which the reputer is
+	// an outlier of the epoch, 0 for reputers never to be slashed as outliers
+	ReputerOutlierThreshold string `protobuf:"bytes,31,opt,name=reputer_outlier_threshold,json=reputerOutlierThreshold,proto3" json:"reputer_outlier_threshold,omitempty"`
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Topic) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Topic) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Topic) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Topic) GetLossMethod() string {
+	if x != nil {
+		return x.LossMethod
+	}
+	return ""
+}
+
+func (x *Topic) GetEpochLastEnded() int64 {
+	if x != nil {
+		return x.EpochLastEnded
+	}
+	return 0
+}
+
+func (x *Topic) GetEpochLength() int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *Topic) GetGroundTruthLag() int64 {
+	if x != nil {
+		return x.GroundTruthLag
+	}
+	return 0
+}
+
+func (x *Topic) GetPNorm() string {
+	if x != nil {
+		return x.PNorm
+	}
+	return ""
+}
+
+func (x *Topic) GetAlphaRegret() string {
+	if x != nil {
+		return x.AlphaRegret
+	}
+	return ""
+}
+
+func (x *Topic) GetAllowNegative() bool {
+	if x != nil {
+		return x.AllowNegative
+	}
+	return false
+}
+
+func (x *Topic) GetEpsilon() string {
+	if x != nil {
+		return x.Epsilon
+	}
+	return ""
+}
+
+func (x *Topic) GetInitialRegret() string {
+	if x != nil {
+		return x.InitialRegret
+	}
+	return ""
+}
+
+func (x *Topic) GetWorkerSubmissionWindow() int64 {
+	if x != nil {
+		return x.WorkerSubmissionWindow
+	}
+	return 0
+}
+
+func (x *Topic) GetMeritSortitionAlpha() string {
+	if x != nil {
+		return x.MeritSortitionAlpha
+	}
+	return ""
+}
+
+func (x *Topic) GetActiveInfererQuantile() string {
+	if x != nil {
+		return x.ActiveInfererQuantile
+	}
+	return ""
+}
+
+func (x *Topic) GetActiveForecasterQuantile() string {
+	if x != nil {
+		return x.ActiveForecasterQuantile
+	}
+	return ""
+}
+
+func (x *Topic) GetActiveReputerQuantile() string {
+	if x != nil {
+		return x.ActiveReputerQuantile
+	}
+	return ""
+}
+
+func (x *Topic) GetTypedMetadata() *TopicMetadata {
+	if x != nil {
+		return x.TypedMetadata
+	}
+	return nil
+}
+
+func (x *Topic) GetChainComputedLosses() bool {
+	if x != nil {
+		return x.ChainComputedLosses
+	}
+	return false
+}
+
+func (x *Topic) GetOutputDimension() uint64 {
+	if x != nil {
+		return x.OutputDimension
+	}
+	return 0
+}
+
+func (x *Topic) GetQuantileLevels() []string {
+	if x != nil {
+		return x.QuantileLevels
+	}
+	return nil
+}
+
+func (x *Topic) GetGroundTruthSource() *GroundTruthSource {
+	if x != nil {
+		return x.GroundTruthSource
+	}
+	return nil
+}
+
+func (x *Topic) GetCreatorCommission() string {
+	if x != nil {
+		return x.CreatorCommission
+	}
+	return ""
+}
+
+func (x *Topic) GetWorkerRevealWindow() int64 {
+	if x != nil {
+		return x.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (x *Topic) GetReputerRevealWindow() int64 {
+	if x != nil {
+		return x.ReputerRevealWindow
+	}
+	return 0
+}
+
+func (x *Topic) GetMinWorkerBond() string {
+	if x != nil {
+		return x.MinWorkerBond
+	}
+	return ""
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *Topic) GetReputerOutlierThreshold() string {
+	if x != nil {
+		return x.ReputerOutlierThreshold
+	}
+	return ""
+}
 
-type Topic struct {
+// Network inferences of a parent topic from which the ground truth of a
+// derived topic is computed. With y(b) the combined network inference of the
+// parent at its latest inference nonce at or before block b, the ground truth
+// of the derived inferences at nonce n is, depending on the aggregation:
+// "value_at_block": y(n + ground truth lag of the derived topic)
+// "difference": y(n + ground truth lag) - y(n)
+// "ratio": y(n + ground truth lag) / y(n)
+type GroundTruthSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator                string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Metadata               string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LossMethod             string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
-	EpochLastEnded         int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
-	EpochLength            int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	GroundTruthLag         int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
-	PNorm                  string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
-	AlphaRegret            string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
-	AllowNegative          bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
-	Epsilon                string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
-	InitialRegret          string `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
-	WorkerSubmissionWindow int64  `protobuf:"varint,17,opt,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
-	// alpha parameter to weight previous score EMA importance in
-	// filtering the active set of inferers, forecasters, and reputers
-	MeritSortitionAlpha      string `protobuf:"bytes,18,opt,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3" json:"merit_sortition_alpha,omitempty"`
-	ActiveInfererQuantile    string `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3" json:"active_inferer_quantile,omitempty"`
-	ActiveForecasterQuantile string `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3" json:"active_forecaster_quantile,omitempty"`
-	ActiveReputerQuantile    string `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3" json:"active_reputer_quantile,omitempty"`
+	ParentTopicId uint64 `protobuf:"varint,1,opt,name=parent_topic_id,json=parentTopicId,proto3" json:"parent_topic_id,omitempty"`
+	Aggregation   string `protobuf:"bytes,2,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
 }
 
-func (x *Topic) Reset() {
-	*x = Topic{}
+func (x *GroundTruthSource) Reset() {
+	*x = GroundTruthSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_topic_proto_msgTypes[0]
+		mi := &file_emissions_v3_topic_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Topic) String() string {
+func (x *GroundTruthSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Topic) ProtoMessage() {}
+func (*GroundTruthSource) ProtoMessage() {}
 
-// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
-func (*Topic) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GroundTruthSource.ProtoReflect.Descriptor instead.
+func (*GroundTruthSource) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Topic) GetId() uint64 {
+func (x *GroundTruthSource) GetParentTopicId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ParentTopicId
 	}
 	return 0
 }
 
-func (x *Topic) GetCreator() string {
+func (x *GroundTruthSource) GetAggregation() string {
 	if x != nil {
-		return x.Creator
+		return x.Aggregation
 	}
 	return ""
 }
 
-func (x *Topic) GetMetadata() string {
+// Structured description of what a topic predicts. Every field is optional.
+// Tags and category are lowercase labels used to look topics up.
+type TopicMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// asset or series being predicted, e.g. "ETH/USD"
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// unit of the predicted values, e.g. "USD"
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// how far ahead the predictions look, in seconds
+	PredictionHorizonSeconds int64    `protobuf:"varint,3,opt,name=prediction_horizon_seconds,json=predictionHorizonSeconds,proto3" json:"prediction_horizon_seconds,omitempty"`
+	Tags                     []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category                 string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *TopicMetadata) Reset() {
+	*x = TopicMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicMetadata) ProtoMessage() {}
+
+// Deprecated: Use TopicMetadata.ProtoReflect.Descriptor instead.
+func (*TopicMetadata) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TopicMetadata) GetTarget() string {
 	if x != nil {
-		return x.Metadata
+		return x.Target
 	}
 	return ""
 }
 
-func (x *Topic) GetLossMethod() string {
+func (x *TopicMetadata) GetUnit() string {
 	if x != nil {
-		return x.LossMethod
+		return x.Unit
 	}
 	return ""
 }
 
-func (x *Topic) GetEpochLastEnded() int64 {
+func (x *TopicMetadata) GetPredictionHorizonSeconds() int64 {
 	if x != nil {
-		return x.EpochLastEnded
+		return x.PredictionHorizonSeconds
 	}
 	return 0
 }
 
-func (x *Topic) GetEpochLength() int64 {
+func (x *TopicMetadata) GetTags() []string {
 	if x != nil {
-		return x.EpochLength
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *Topic) GetGroundTruthLag() int64 {
+func (x *TopicMetadata) GetCategory() string {
 	if x != nil {
-		return x.GroundTruthLag
+		return x.Category
 	}
-	return 0
+	return ""
 }
 
-func (x *Topic) GetPNorm() string {
+// State of a topic retired through MsgArchiveTopic. The topic is kept as it
+// was when archived so that it can still be queried once its state is pruned.
+type TopicArchive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic               *Topic `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	ArchivedBlockHeight int64  `protobuf:"varint,2,opt,name=archived_block_height,json=archivedBlockHeight,proto3" json:"archived_block_height,omitempty"`
+	// stake removals queued before archival have all completed by this block
+	StakeRemovalsDueBlockHeight int64 `protobuf:"varint,3,opt,name=stake_removals_due_block_height,json=stakeRemovalsDueBlockHeight,proto3" json:"stake_removals_due_block_height,omitempty"`
+	// remaining stake has been returned to reputers and delegators
+	StakeReturned bool `protobuf:"varint,4,opt,name=stake_returned,json=stakeReturned,proto3" json:"stake_returned,omitempty"`
+	// all per-topic state has been pruned
+	Pruned bool `protobuf:"varint,5,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (x *TopicArchive) Reset() {
+	*x = TopicArchive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicArchive) ProtoMessage() {}
+
+// Deprecated: Use TopicArchive.ProtoReflect.Descriptor instead.
+func (*TopicArchive) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TopicArchive) GetTopic() *Topic {
 	if x != nil {
-		return x.PNorm
+		return x.Topic
 	}
-	return ""
+	return nil
 }
 
-func (x *Topic) GetAlphaRegret() string {
+func (x *TopicArchive) GetArchivedBlockHeight() int64 {
 	if x != nil {
-		return x.AlphaRegret
+		return x.ArchivedBlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *Topic) GetAllowNegative() bool {
+func (x *TopicArchive) GetStakeRemovalsDueBlockHeight() int64 {
 	if x != nil {
-		return x.AllowNegative
+		return x.StakeRemovalsDueBlockHeight
+	}
+	return 0
+}
+
+func (x *TopicArchive) GetStakeReturned() bool {
+	if x != nil {
+		return x.StakeReturned
 	}
 	return false
 }
 
-func (x *Topic) GetEpsilon() string {
+func (x *TopicArchive) GetPruned() bool {
 	if x != nil {
-		return x.Epsilon
+		return x.Pruned
 	}
-	return ""
+	return false
 }
 
-func (x *Topic) GetInitialRegret() string {
+// Recurring funding of a topic. The escrow is held by the module and
+// `amount_per_drip` of it is added to the fee revenue of the topic at every
+// drip, until the escrow runs out or the funder cancels the subscription.
+type FundingSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicId       uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Funder        string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+	AmountPerDrip string `protobuf:"bytes,4,opt,name=amount_per_drip,json=amountPerDrip,proto3" json:"amount_per_drip,omitempty"`
+	// blocks between drips, 0 to drip once per epoch of the topic
+	IntervalBlocks int64 `protobuf:"varint,5,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// unspent escrow, refunded to the funder on cancellation
+	Escrow              string `protobuf:"bytes,6,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	NextDripBlockHeight int64  `protobuf:"varint,7,opt,name=next_drip_block_height,json=nextDripBlockHeight,proto3" json:"next_drip_block_height,omitempty"`
+}
+
+func (x *FundingSubscription) Reset() {
+	*x = FundingSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_topic_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundingSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingSubscription) ProtoMessage() {}
+
+// Deprecated: Use FundingSubscription.ProtoReflect.Descriptor instead.
+func (*FundingSubscription) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FundingSubscription) GetId() uint64 {
 	if x != nil {
-		return x.InitialRegret
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Topic) GetWorkerSubmissionWindow() int64 {
+func (x *FundingSubscription) GetTopicId() uint64 {
 	if x != nil {
-		return x.WorkerSubmissionWindow
+		return x.TopicId
 	}
 	return 0
 }
 
-func (x *Topic) GetMeritSortitionAlpha() string {
+func (x *FundingSubscription) GetFunder() string {
 	if x != nil {
-		return x.MeritSortitionAlpha
+		return x.Funder
 	}
 	return ""
 }
 
-func (x *Topic) GetActiveInfererQuantile() string {
+func (x *FundingSubscription) GetAmountPerDrip() string {
 	if x != nil {
-		return x.ActiveInfererQuantile
+		return x.AmountPerDrip
 	}
 	return ""
 }
 
-func (x *Topic) GetActiveForecasterQuantile() string {
+func (x *FundingSubscription) GetIntervalBlocks() int64 {
 	if x != nil {
-		return x.ActiveForecasterQuantile
+		return x.IntervalBlocks
 	}
-	return ""
+	return 0
 }
 
-func (x *Topic) GetActiveReputerQuantile() string {
+func (x *FundingSubscription) GetEscrow() string {
 	if x != nil {
-		return x.ActiveReputerQuantile
+		return x.Escrow
 	}
 	return ""
 }
 
+func (x *FundingSubscription) GetNextDripBlockHeight() int64 {
+	if x != nil {
+		return x.NextDripBlockHeight
+	}
+	return 0
+}
+
 type TopicList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3549,7 +7195,7 @@ type TopicList struct {
 func (x *TopicList) Reset() {
 	*x = TopicList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_topic_proto_msgTypes[1]
+		mi := &file_emissions_v3_topic_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3563,7 +7209,7 @@ func (*TopicList) ProtoMessage() {}
 
 // Deprecated: Use TopicList.ProtoReflect.Descriptor instead.
 func (*TopicList) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{1}
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TopicList) GetTopics() []*Topic {
@@ -3585,7 +7231,7 @@ type TimestampedActorNonce struct {
 func (x *TimestampedActorNonce) Reset() {
 	*x = TimestampedActorNonce{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_topic_proto_msgTypes[2]
+		mi := &file_emissions_v3_topic_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3599,7 +7245,7 @@ func (*TimestampedActorNonce) ProtoMessage() {}
 
 // Deprecated: Use TimestampedActorNonce.ProtoReflect.Descriptor instead.
 func (*TimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{2}
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TimestampedActorNonce) GetBlockHeight() int64 {
@@ -3627,7 +7273,7 @@ type TopicIds struct {
 func (x *TopicIds) Reset() {
 	*x = TopicIds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_topic_proto_msgTypes[3]
+		mi := &file_emissions_v3_topic_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3641,7 +7287,7 @@ func (*TopicIds) ProtoMessage() {}
 
 // Deprecated: Use TopicIds.ProtoReflect.Descriptor instead.
 func (*TopicIds) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{3}
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TopicIds) GetTopicIds() []uint64 {
@@ -3663,7 +7309,7 @@ type TopicIdWeightPair struct {
 func (x *TopicIdWeightPair) Reset() {
 	*x = TopicIdWeightPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_topic_proto_msgTypes[4]
+		mi := &file_emissions_v3_topic_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3677,7 +7323,7 @@ func (*TopicIdWeightPair) ProtoMessage() {}
 
 // Deprecated: Use TopicIdWeightPair.ProtoReflect.Descriptor instead.
 func (*TopicIdWeightPair) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{4}
+	return file_emissions_v3_topic_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TopicIdWeightPair) GetTopicId() uint64 {
@@ -3699,121 +7345,225 @@ var File_emissions_v3_topic_proto protoreflect.FileDescriptor
 var file_emissions_v3_topic_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x2f, 0x74,
 	0x6f, 0x70, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x09, 0x0a, 0x05, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x73, 0x73,
-	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
-	0x6f, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x74, 0x72, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x4c, 0x61, 0x67,
-	0x12, 0x4e, 0x0a, 0x06, 0x70, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x70, 0x4e, 0x6f, 0x72, 0x6d,
-	0x12, 0x5a, 0x0a, 0x0c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
-	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
-	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x0b, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x65,
-	0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x12, 0x6b, 0x0a, 0x15, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x53,
-	0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x6f, 0x0a,
-	0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x76, 0x33, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x0f, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x73,
+	0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74,
+	0x72, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x4c, 0x61, 0x67, 0x12, 0x4e,
+	0x0a, 0x06, 0x70, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
 	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
 	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x75,
-	0x0a, 0x1a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x5a,
+	0x0a, 0x0c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x51, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x65, 0x70, 0x73,
+	0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x67, 0x72, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x67, 0x72, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x6b,
+	0x0a, 0x15, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x6d, 0x65, 0x72, 0x69, 0x74, 0x53, 0x6f, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x6f, 0x0a, 0x17, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x75, 0x0a, 0x1a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x13, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74,
+	0x68, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x72, 0x75, 0x74, 0x68, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x12, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
 	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
 	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06,
-	0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x52, 0x0a,
-	0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x0f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x10, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2d,
-	0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x7f, 0x0a,
-	0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61,
-	0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x4f, 0x0a,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x58, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x6f,
+	0x6e, 0x64, 0x12, 0x73, 0x0a, 0x19, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x52,
+	0x0a, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x0f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x52, 0x10, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x22, 0x5d, 0x0a, 0x11, 0x47,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x70, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0xf8, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x1f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x5f, 0x64,
+	0x75, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x44, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x75, 0x6e, 0x65,
+	0x64, 0x22, 0xda, 0x02, 0x0a, 0x13, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x69, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x65, 0x72, 0x44, 0x72, 0x69, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x48, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x33, 0x0a, 0x16, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x64, 0x72, 0x69, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x44,
+	0x72, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x38,
+	0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x33, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x22, 0x7f, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x50, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x4f, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xc0,
-	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56,
-	0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3828,23 +7578,30 @@ func file_emissions_v3_topic_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_topic_proto_rawDescData
 }
 
-var file_emissions_v3_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_emissions_v3_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_emissions_v3_topic_proto_goTypes = []interface{}{
 	(*Topic)(nil),                 // 0: emissions.v3.Topic
-	(*TopicList)(nil),             // 1: emissions.v3.TopicList
-	(*TimestampedActorNonce)(nil), // 2: emissions.v3.TimestampedActorNonce
-	(*TopicIds)(nil),              // 3: emissions.v3.TopicIds
-	(*TopicIdWeightPair)(nil),     // 4: emissions.v3.TopicIdWeightPair
-	(*Nonce)(nil),                 // 5: emissions.v3.Nonce
+	(*GroundTruthSource)(nil),     // 1: emissions.v3.GroundTruthSource
+	(*TopicMetadata)(nil),         // 2: emissions.v3.TopicMetadata
+	(*TopicArchive)(nil),          // 3: emissions.v3.TopicArchive
+	(*FundingSubscription)(nil),   // 4: emissions.v3.FundingSubscription
+	(*TopicList)(nil),             // 5: emissions.v3.TopicList
+	(*TimestampedActorNonce)(nil), // 6: emissions.v3.TimestampedActorNonce
+	(*TopicIds)(nil),              // 7: emissions.v3.TopicIds
+	(*TopicIdWeightPair)(nil),     // 8: emissions.v3.TopicIdWeightPair
+	(*Nonce)(nil),                 // 9: emissions.v3.Nonce
 }
 var file_emissions_v3_topic_proto_depIdxs = []int32{
-	0, // 0: emissions.v3.TopicList.topics:type_name -> emissions.v3.Topic
-	5, // 1: emissions.v3.TimestampedActorNonce.nonce:type_name -> emissions.v3.Nonce
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: emissions.v3.Topic.typed_metadata:type_name -> emissions.v3.TopicMetadata
+	1, // 1: emissions.v3.Topic.ground_truth_source:type_name -> emissions.v3.GroundTruthSource
+	0, // 2: emissions.v3.TopicArchive.topic:type_name -> emissions.v3.Topic
+	0, // 3: emissions.v3.TopicList.topics:type_name -> emissions.v3.Topic
+	9, // 4: emissions.v3.TimestampedActorNonce.nonce:type_name -> emissions.v3.Nonce
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v3_topic_proto_init() }
@@ -3867,7 +7624,7 @@ func file_emissions_v3_topic_proto_init() {
 			}
 		}
 		file_emissions_v3_topic_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicList); i {
+			switch v := v.(*GroundTruthSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3879,7 +7636,7 @@ func file_emissions_v3_topic_proto_init() {
 			}
 		}
 		file_emissions_v3_topic_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampedActorNonce); i {
+			switch v := v.(*TopicMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3891,7 +7648,7 @@ func file_emissions_v3_topic_proto_init() {
 			}
 		}
 		file_emissions_v3_topic_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicIds); i {
+			switch v := v.(*TopicArchive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3903,6 +7660,54 @@ func file_emissions_v3_topic_proto_init() {
 			}
 		}
 		file_emissions_v3_topic_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundingSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_topic_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_topic_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampedActorNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_topic_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_topic_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopicIdWeightPair); i {
 			case 0:
 				return &v.state
@@ -3921,7 +7726,7 @@ func file_emissions_v3_topic_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_topic_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -481,6 +481,52 @@ func (x *fastReflection_TimestampedValue) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Inference_7_list)(nil)
+
+type _Inference_7_list struct {
+	list *[]string
+}
+
+func (x *_Inference_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Inference_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Inference_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Inference_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Inference_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Inference at list field Values as it is not of Message kind"))
+}
+
+func (x *_Inference_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Inference_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Inference_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Inference              protoreflect.MessageDescriptor
 	fd_Inference_topic_id     protoreflect.FieldDescriptor
@@ -489,6 +535,7 @@ var (
 	fd_Inference_value        protoreflect.FieldDescriptor
 	fd_Inference_extra_data   protoreflect.FieldDescriptor
 	fd_Inference_proof        protoreflect.FieldDescriptor
+	fd_Inference_values       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -500,6 +547,7 @@ func init() {
 	fd_Inference_value = md_Inference.Fields().ByName("value")
 	fd_Inference_extra_data = md_Inference.Fields().ByName("extra_data")
 	fd_Inference_proof = md_Inference.Fields().ByName("proof")
+	fd_Inference_values = md_Inference.Fields().ByName("values")
 }
 
 var _ protoreflect.Message = (*fastReflection_Inference)(nil)
@@ -603,6 +651,12 @@ func (x *fastReflection_Inference) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_Inference_7_list{list: &x.Values})
+		if !f(fd_Inference_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -630,6 +684,8 @@ func (x *fastReflection_Inference) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.ExtraData) != 0
 	case "emissions.v3.Inference.proof":
 		return x.Proof != ""
+	case "emissions.v3.Inference.values":
+		return len(x.Values) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Inference"))
@@ -658,6 +714,8 @@ func (x *fastReflection_Inference) Clear(fd protoreflect.FieldDescriptor) {
 		x.ExtraData = nil
 	case "emissions.v3.Inference.proof":
 		x.Proof = ""
+	case "emissions.v3.Inference.values":
+		x.Values = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Inference"))
@@ -692,6 +750,12 @@ func (x *fastReflection_Inference) Get(descriptor protoreflect.FieldDescriptor)
 	case "emissions.v3.Inference.proof":
 		value := x.Proof
 		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Inference.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_Inference_7_list{})
+		}
+		listValue := &_Inference_7_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Inference"))
@@ -724,6 +788,10 @@ func (x *fastReflection_Inference) Set(fd protoreflect.FieldDescriptor, value pr
 		x.ExtraData = value.Bytes()
 	case "emissions.v3.Inference.proof":
 		x.Proof = value.Interface().(string)
+	case "emissions.v3.Inference.values":
+		lv := value.List()
+		clv := lv.(*_Inference_7_list)
+		x.Values = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Inference"))
@@ -744,6 +812,12 @@ func (x *fastReflection_Inference) Set(fd protoreflect.FieldDescriptor, value pr
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Inference) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v3.Inference.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_Inference_7_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v3.Inference.topic_id":
 		panic(fmt.Errorf("field topic_id of message emissions.v3.Inference is not mutable"))
 	case "emissions.v3.Inference.block_height":
@@ -781,6 +855,9 @@ func (x *fastReflection_Inference) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfBytes(nil)
 	case "emissions.v3.Inference.proof":
 		return protoreflect.ValueOfString("")
+	case "emissions.v3.Inference.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Inference_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Inference"))
@@ -872,6 +949,12 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -901,6 +984,15 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if len(x.Proof) > 0 {
 			i -= len(x.Proof)
 			copy(dAtA[i:], x.Proof)
@@ -1156,6 +1248,38 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 				}
 				x.Proof = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1686,27 +1810,27 @@ func (x *fastReflection_Inferences) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ForecastElement         protoreflect.MessageDescriptor
-	fd_ForecastElement_inferer protoreflect.FieldDescriptor
-	fd_ForecastElement_value   protoreflect.FieldDescriptor
+	md_Quantile       protoreflect.MessageDescriptor
+	fd_Quantile_level protoreflect.FieldDescriptor
+	fd_Quantile_value protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_worker_proto_init()
-	md_ForecastElement = File_emissions_v3_worker_proto.Messages().ByName("ForecastElement")
-	fd_ForecastElement_inferer = md_ForecastElement.Fields().ByName("inferer")
-	fd_ForecastElement_value = md_ForecastElement.Fields().ByName("value")
+	md_Quantile = File_emissions_v3_worker_proto.Messages().ByName("Quantile")
+	fd_Quantile_level = md_Quantile.Fields().ByName("level")
+	fd_Quantile_value = md_Quantile.Fields().ByName("value")
 }
 
-var _ protoreflect.Message = (*fastReflection_ForecastElement)(nil)
+var _ protoreflect.Message = (*fastReflection_Quantile)(nil)
 
-type fastReflection_ForecastElement ForecastElement
+type fastReflection_Quantile Quantile
 
-func (x *ForecastElement) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ForecastElement)(x)
+func (x *Quantile) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Quantile)(x)
 }
 
-func (x *ForecastElement) slowProtoReflect() protoreflect.Message {
+func (x *Quantile) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v3_worker_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1718,43 +1842,43 @@ func (x *ForecastElement) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ForecastElement_messageType fastReflection_ForecastElement_messageType
-var _ protoreflect.MessageType = fastReflection_ForecastElement_messageType{}
+var _fastReflection_Quantile_messageType fastReflection_Quantile_messageType
+var _ protoreflect.MessageType = fastReflection_Quantile_messageType{}
 
-type fastReflection_ForecastElement_messageType struct{}
+type fastReflection_Quantile_messageType struct{}
 
-func (x fastReflection_ForecastElement_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ForecastElement)(nil)
+func (x fastReflection_Quantile_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Quantile)(nil)
 }
-func (x fastReflection_ForecastElement_messageType) New() protoreflect.Message {
-	return new(fastReflection_ForecastElement)
+func (x fastReflection_Quantile_messageType) New() protoreflect.Message {
+	return new(fastReflection_Quantile)
 }
-func (x fastReflection_ForecastElement_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ForecastElement
+func (x fastReflection_Quantile_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Quantile
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ForecastElement) Descriptor() protoreflect.MessageDescriptor {
-	return md_ForecastElement
+func (x *fastReflection_Quantile) Descriptor() protoreflect.MessageDescriptor {
+	return md_Quantile
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ForecastElement) Type() protoreflect.MessageType {
-	return _fastReflection_ForecastElement_messageType
+func (x *fastReflection_Quantile) Type() protoreflect.MessageType {
+	return _fastReflection_Quantile_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ForecastElement) New() protoreflect.Message {
-	return new(fastReflection_ForecastElement)
+func (x *fastReflection_Quantile) New() protoreflect.Message {
+	return new(fastReflection_Quantile)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ForecastElement) Interface() protoreflect.ProtoMessage {
-	return (*ForecastElement)(x)
+func (x *fastReflection_Quantile) Interface() protoreflect.ProtoMessage {
+	return (*Quantile)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1762,16 +1886,16 @@ func (x *fastReflection_ForecastElement) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ForecastElement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Inferer != "" {
-		value := protoreflect.ValueOfString(x.Inferer)
-		if !f(fd_ForecastElement_inferer, value) {
+func (x *fastReflection_Quantile) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Level != "" {
+		value := protoreflect.ValueOfString(x.Level)
+		if !f(fd_Quantile_level, value) {
 			return
 		}
 	}
 	if x.Value != "" {
 		value := protoreflect.ValueOfString(x.Value)
-		if !f(fd_ForecastElement_value, value) {
+		if !f(fd_Quantile_value, value) {
 			return
 		}
 	}
@@ -1788,17 +1912,17 @@ func (x *fastReflection_ForecastElement) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ForecastElement) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Quantile) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.ForecastElement.inferer":
-		return x.Inferer != ""
-	case "emissions.v3.ForecastElement.value":
+	case "emissions.v3.Quantile.level":
+		return x.Level != ""
+	case "emissions.v3.Quantile.value":
 		return x.Value != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Quantile"))
 		}
-		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Quantile does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1808,17 +1932,17 @@ func (x *fastReflection_ForecastElement) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForecastElement) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Quantile) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.ForecastElement.inferer":
-		x.Inferer = ""
-	case "emissions.v3.ForecastElement.value":
+	case "emissions.v3.Quantile.level":
+		x.Level = ""
+	case "emissions.v3.Quantile.value":
 		x.Value = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Quantile"))
 		}
-		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Quantile does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1828,19 +1952,19 @@ func (x *fastReflection_ForecastElement) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ForecastElement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Quantile) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.ForecastElement.inferer":
-		value := x.Inferer
+	case "emissions.v3.Quantile.level":
+		value := x.Level
 		return protoreflect.ValueOfString(value)
-	case "emissions.v3.ForecastElement.value":
+	case "emissions.v3.Quantile.value":
 		value := x.Value
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Quantile"))
 		}
-		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Quantile does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1854,17 +1978,17 @@ func (x *fastReflection_ForecastElement) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForecastElement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Quantile) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.ForecastElement.inferer":
-		x.Inferer = value.Interface().(string)
-	case "emissions.v3.ForecastElement.value":
+	case "emissions.v3.Quantile.level":
+		x.Level = value.Interface().(string)
+	case "emissions.v3.Quantile.value":
 		x.Value = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Quantile"))
 		}
-		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Quantile does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1878,44 +2002,44 @@ func (x *fastReflection_ForecastElement) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForecastElement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Quantile) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.ForecastElement.inferer":
-		panic(fmt.Errorf("field inferer of message emissions.v3.ForecastElement is not mutable"))
-	case "emissions.v3.ForecastElement.value":
-		panic(fmt.Errorf("field value of message emissions.v3.ForecastElement is not mutable"))
+	case "emissions.v3.Quantile.level":
+		panic(fmt.Errorf("field level of message emissions.v3.Quantile is not mutable"))
+	case "emissions.v3.Quantile.value":
+		panic(fmt.Errorf("field value of message emissions.v3.Quantile is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Quantile"))
 		}
-		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Quantile does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ForecastElement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Quantile) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.ForecastElement.inferer":
+	case "emissions.v3.Quantile.level":
 		return protoreflect.ValueOfString("")
-	case "emissions.v3.ForecastElement.value":
+	case "emissions.v3.Quantile.value":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Quantile"))
 		}
-		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Quantile does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ForecastElement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Quantile) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ForecastElement", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.Quantile", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1923,7 +2047,7 @@ func (x *fastReflection_ForecastElement) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ForecastElement) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Quantile) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1934,7 +2058,7 @@ func (x *fastReflection_ForecastElement) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ForecastElement) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Quantile) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1946,7 +2070,7 @@ func (x *fastReflection_ForecastElement) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ForecastElement) IsValid() bool {
+func (x *fastReflection_Quantile) IsValid() bool {
 	return x != nil
 }
 
@@ -1956,9 +2080,9 @@ func (x *fastReflection_ForecastElement) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Quantile) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ForecastElement)
+		x := input.Message.Interface().(*Quantile)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1970,7 +2094,7 @@ func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Inferer)
+		l = len(x.Level)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1988,7 +2112,7 @@ func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ForecastElement)
+		x := input.Message.Interface().(*Quantile)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2014,10 +2138,10 @@ func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Inferer) > 0 {
-			i -= len(x.Inferer)
-			copy(dAtA[i:], x.Inferer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Inferer)))
+		if len(x.Level) > 0 {
+			i -= len(x.Level)
+			copy(dAtA[i:], x.Level)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Level)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2032,7 +2156,7 @@ func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ForecastElement)
+		x := input.Message.Interface().(*Quantile)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2064,15 +2188,15 @@ func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForecastElement: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Quantile: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForecastElement: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Quantile: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inferer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2100,7 +2224,7 @@ func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Inferer = string(dAtA[iNdEx:postIndex])
+				x.Level = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -2169,85 +2293,28 @@ func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Forecast_4_list)(nil)
-
-type _Forecast_4_list struct {
-	list *[]*ForecastElement
-}
-
-func (x *_Forecast_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Forecast_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Forecast_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ForecastElement)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Forecast_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ForecastElement)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Forecast_4_list) AppendMutable() protoreflect.Value {
-	v := new(ForecastElement)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Forecast_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Forecast_4_list) NewElement() protoreflect.Value {
-	v := new(ForecastElement)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Forecast_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Forecast                   protoreflect.MessageDescriptor
-	fd_Forecast_topic_id          protoreflect.FieldDescriptor
-	fd_Forecast_block_height      protoreflect.FieldDescriptor
-	fd_Forecast_forecaster        protoreflect.FieldDescriptor
-	fd_Forecast_forecast_elements protoreflect.FieldDescriptor
-	fd_Forecast_extra_data        protoreflect.FieldDescriptor
+	md_ForecastElement         protoreflect.MessageDescriptor
+	fd_ForecastElement_inferer protoreflect.FieldDescriptor
+	fd_ForecastElement_value   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_worker_proto_init()
-	md_Forecast = File_emissions_v3_worker_proto.Messages().ByName("Forecast")
-	fd_Forecast_topic_id = md_Forecast.Fields().ByName("topic_id")
-	fd_Forecast_block_height = md_Forecast.Fields().ByName("block_height")
-	fd_Forecast_forecaster = md_Forecast.Fields().ByName("forecaster")
-	fd_Forecast_forecast_elements = md_Forecast.Fields().ByName("forecast_elements")
-	fd_Forecast_extra_data = md_Forecast.Fields().ByName("extra_data")
+	md_ForecastElement = File_emissions_v3_worker_proto.Messages().ByName("ForecastElement")
+	fd_ForecastElement_inferer = md_ForecastElement.Fields().ByName("inferer")
+	fd_ForecastElement_value = md_ForecastElement.Fields().ByName("value")
 }
 
-var _ protoreflect.Message = (*fastReflection_Forecast)(nil)
+var _ protoreflect.Message = (*fastReflection_ForecastElement)(nil)
 
-type fastReflection_Forecast Forecast
+type fastReflection_ForecastElement ForecastElement
 
-func (x *Forecast) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Forecast)(x)
+func (x *ForecastElement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForecastElement)(x)
 }
 
-func (x *Forecast) slowProtoReflect() protoreflect.Message {
+func (x *ForecastElement) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v3_worker_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2259,43 +2326,43 @@ func (x *Forecast) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Forecast_messageType fastReflection_Forecast_messageType
-var _ protoreflect.MessageType = fastReflection_Forecast_messageType{}
+var _fastReflection_ForecastElement_messageType fastReflection_ForecastElement_messageType
+var _ protoreflect.MessageType = fastReflection_ForecastElement_messageType{}
 
-type fastReflection_Forecast_messageType struct{}
+type fastReflection_ForecastElement_messageType struct{}
 
-func (x fastReflection_Forecast_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Forecast)(nil)
+func (x fastReflection_ForecastElement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForecastElement)(nil)
 }
-func (x fastReflection_Forecast_messageType) New() protoreflect.Message {
-	return new(fastReflection_Forecast)
+func (x fastReflection_ForecastElement_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForecastElement)
 }
-func (x fastReflection_Forecast_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Forecast
+func (x fastReflection_ForecastElement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForecastElement
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Forecast) Descriptor() protoreflect.MessageDescriptor {
-	return md_Forecast
+func (x *fastReflection_ForecastElement) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForecastElement
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Forecast) Type() protoreflect.MessageType {
-	return _fastReflection_Forecast_messageType
+func (x *fastReflection_ForecastElement) Type() protoreflect.MessageType {
+	return _fastReflection_ForecastElement_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Forecast) New() protoreflect.Message {
-	return new(fastReflection_Forecast)
+func (x *fastReflection_ForecastElement) New() protoreflect.Message {
+	return new(fastReflection_ForecastElement)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Forecast) Interface() protoreflect.ProtoMessage {
-	return (*Forecast)(x)
+func (x *fastReflection_ForecastElement) Interface() protoreflect.ProtoMessage {
+	return (*ForecastElement)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2303,34 +2370,16 @@ func (x *fastReflection_Forecast) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Forecast) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TopicId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_Forecast_topic_id, value) {
-			return
-		}
-	}
-	if x.BlockHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_Forecast_block_height, value) {
-			return
-		}
-	}
-	if x.Forecaster != "" {
-		value := protoreflect.ValueOfString(x.Forecaster)
-		if !f(fd_Forecast_forecaster, value) {
-			return
-		}
-	}
-	if len(x.ForecastElements) != 0 {
-		value := protoreflect.ValueOfList(&_Forecast_4_list{list: &x.ForecastElements})
-		if !f(fd_Forecast_forecast_elements, value) {
+func (x *fastReflection_ForecastElement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Inferer != "" {
+		value := protoreflect.ValueOfString(x.Inferer)
+		if !f(fd_ForecastElement_inferer, value) {
 			return
 		}
 	}
-	if len(x.ExtraData) != 0 {
-		value := protoreflect.ValueOfBytes(x.ExtraData)
-		if !f(fd_Forecast_extra_data, value) {
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_ForecastElement_value, value) {
 			return
 		}
 	}
@@ -2347,23 +2396,17 @@ func (x *fastReflection_Forecast) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Forecast) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ForecastElement) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.Forecast.topic_id":
-		return x.TopicId != uint64(0)
-	case "emissions.v3.Forecast.block_height":
-		return x.BlockHeight != int64(0)
-	case "emissions.v3.Forecast.forecaster":
-		return x.Forecaster != ""
-	case "emissions.v3.Forecast.forecast_elements":
-		return len(x.ForecastElements) != 0
-	case "emissions.v3.Forecast.extra_data":
-		return len(x.ExtraData) != 0
+	case "emissions.v3.ForecastElement.inferer":
+		return x.Inferer != ""
+	case "emissions.v3.ForecastElement.value":
+		return x.Value != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2373,23 +2416,17 @@ func (x *fastReflection_Forecast) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecast) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ForecastElement) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.Forecast.topic_id":
-		x.TopicId = uint64(0)
-	case "emissions.v3.Forecast.block_height":
-		x.BlockHeight = int64(0)
-	case "emissions.v3.Forecast.forecaster":
-		x.Forecaster = ""
-	case "emissions.v3.Forecast.forecast_elements":
-		x.ForecastElements = nil
-	case "emissions.v3.Forecast.extra_data":
-		x.ExtraData = nil
+	case "emissions.v3.ForecastElement.inferer":
+		x.Inferer = ""
+	case "emissions.v3.ForecastElement.value":
+		x.Value = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2399,31 +2436,19 @@ func (x *fastReflection_Forecast) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Forecast) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ForecastElement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.Forecast.topic_id":
-		value := x.TopicId
-		return protoreflect.ValueOfUint64(value)
-	case "emissions.v3.Forecast.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfInt64(value)
-	case "emissions.v3.Forecast.forecaster":
-		value := x.Forecaster
+	case "emissions.v3.ForecastElement.inferer":
+		value := x.Inferer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.ForecastElement.value":
+		value := x.Value
 		return protoreflect.ValueOfString(value)
-	case "emissions.v3.Forecast.forecast_elements":
-		if len(x.ForecastElements) == 0 {
-			return protoreflect.ValueOfList(&_Forecast_4_list{})
-		}
-		listValue := &_Forecast_4_list{list: &x.ForecastElements}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v3.Forecast.extra_data":
-		value := x.ExtraData
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2437,25 +2462,17 @@ func (x *fastReflection_Forecast) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecast) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ForecastElement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.Forecast.topic_id":
-		x.TopicId = value.Uint()
-	case "emissions.v3.Forecast.block_height":
-		x.BlockHeight = value.Int()
-	case "emissions.v3.Forecast.forecaster":
-		x.Forecaster = value.Interface().(string)
-	case "emissions.v3.Forecast.forecast_elements":
-		lv := value.List()
-		clv := lv.(*_Forecast_4_list)
-		x.ForecastElements = *clv.list
-	case "emissions.v3.Forecast.extra_data":
-		x.ExtraData = value.Bytes()
+	case "emissions.v3.ForecastElement.inferer":
+		x.Inferer = value.Interface().(string)
+	case "emissions.v3.ForecastElement.value":
+		x.Value = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2469,61 +2486,44 @@ func (x *fastReflection_Forecast) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecast) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ForecastElement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.Forecast.forecast_elements":
-		if x.ForecastElements == nil {
-			x.ForecastElements = []*ForecastElement{}
-		}
-		value := &_Forecast_4_list{list: &x.ForecastElements}
-		return protoreflect.ValueOfList(value)
-	case "emissions.v3.Forecast.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v3.Forecast is not mutable"))
-	case "emissions.v3.Forecast.block_height":
-		panic(fmt.Errorf("field block_height of message emissions.v3.Forecast is not mutable"))
-	case "emissions.v3.Forecast.forecaster":
-		panic(fmt.Errorf("field forecaster of message emissions.v3.Forecast is not mutable"))
-	case "emissions.v3.Forecast.extra_data":
-		panic(fmt.Errorf("field extra_data of message emissions.v3.Forecast is not mutable"))
+	case "emissions.v3.ForecastElement.inferer":
+		panic(fmt.Errorf("field inferer of message emissions.v3.ForecastElement is not mutable"))
+	case "emissions.v3.ForecastElement.value":
+		panic(fmt.Errorf("field value of message emissions.v3.ForecastElement is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Forecast) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ForecastElement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.Forecast.topic_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v3.Forecast.block_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "emissions.v3.Forecast.forecaster":
+	case "emissions.v3.ForecastElement.inferer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.ForecastElement.value":
 		return protoreflect.ValueOfString("")
-	case "emissions.v3.Forecast.forecast_elements":
-		list := []*ForecastElement{}
-		return protoreflect.ValueOfList(&_Forecast_4_list{list: &list})
-	case "emissions.v3.Forecast.extra_data":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.ForecastElement"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.ForecastElement does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Forecast) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ForecastElement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.Forecast", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.ForecastElement", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2531,7 +2531,7 @@ func (x *fastReflection_Forecast) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Forecast) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ForecastElement) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2542,7 +2542,7 @@ func (x *fastReflection_Forecast) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecast) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ForecastElement) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2554,7 +2554,7 @@ func (x *fastReflection_Forecast) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Forecast) IsValid() bool {
+func (x *fastReflection_ForecastElement) IsValid() bool {
 	return x != nil
 }
 
@@ -2564,9 +2564,9 @@ func (x *fastReflection_Forecast) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ForecastElement) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Forecast)
+		x := input.Message.Interface().(*ForecastElement)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2578,23 +2578,11 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.TopicId != 0 {
-			n += 1 + runtime.Sov(uint64(x.TopicId))
-		}
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		l = len(x.Forecaster)
+		l = len(x.Inferer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.ForecastElements) > 0 {
-			for _, e := range x.ForecastElements {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.ExtraData)
+		l = len(x.Value)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2608,7 +2596,7 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Forecast)
+		x := input.Message.Interface().(*ForecastElement)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2627,50 +2615,24 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ExtraData) > 0 {
-			i -= len(x.ExtraData)
-			copy(dAtA[i:], x.ExtraData)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtraData)))
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
 			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.ForecastElements) > 0 {
-			for iNdEx := len(x.ForecastElements) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ForecastElements[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
+			dAtA[i] = 0x12
 		}
-		if len(x.Forecaster) > 0 {
-			i -= len(x.Forecaster)
-			copy(dAtA[i:], x.Forecaster)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Forecaster)))
+		if len(x.Inferer) > 0 {
+			i -= len(x.Inferer)
+			copy(dAtA[i:], x.Inferer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Inferer)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.TopicId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
 		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2678,7 +2640,7 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Forecast)
+		x := input.Message.Interface().(*ForecastElement)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2710,53 +2672,15 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecast: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForecastElement: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecast: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForecastElement: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-				}
-				x.TopicId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TopicId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-				}
-				x.BlockHeight = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockHeight |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecaster", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inferer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2784,47 +2708,13 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Forecaster = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecastElements", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ForecastElements = append(x.ForecastElements, &ForecastElement{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecastElements[len(x.ForecastElements)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Inferer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraData", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2834,25 +2724,23 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExtraData = append(x.ExtraData[:0], dAtA[iNdEx:postIndex]...)
-				if x.ExtraData == nil {
-					x.ExtraData = []byte{}
-				}
+				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2889,77 +2777,85 @@ func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Forecasts_1_list)(nil)
+var _ protoreflect.List = (*_Forecast_4_list)(nil)
 
-type _Forecasts_1_list struct {
-	list *[]*Forecast
+type _Forecast_4_list struct {
+	list *[]*ForecastElement
 }
 
-func (x *_Forecasts_1_list) Len() int {
+func (x *_Forecast_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Forecasts_1_list) Get(i int) protoreflect.Value {
+func (x *_Forecast_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Forecasts_1_list) Set(i int, value protoreflect.Value) {
+func (x *_Forecast_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Forecast)
+	concreteValue := valueUnwrapped.Interface().(*ForecastElement)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Forecasts_1_list) Append(value protoreflect.Value) {
+func (x *_Forecast_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Forecast)
+	concreteValue := valueUnwrapped.Interface().(*ForecastElement)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Forecasts_1_list) AppendMutable() protoreflect.Value {
-	v := new(Forecast)
+func (x *_Forecast_4_list) AppendMutable() protoreflect.Value {
+	v := new(ForecastElement)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Forecasts_1_list) Truncate(n int) {
+func (x *_Forecast_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Forecasts_1_list) NewElement() protoreflect.Value {
-	v := new(Forecast)
+func (x *_Forecast_4_list) NewElement() protoreflect.Value {
+	v := new(ForecastElement)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Forecasts_1_list) IsValid() bool {
+func (x *_Forecast_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Forecasts           protoreflect.MessageDescriptor
-	fd_Forecasts_forecasts protoreflect.FieldDescriptor
+	md_Forecast                   protoreflect.MessageDescriptor
+	fd_Forecast_topic_id          protoreflect.FieldDescriptor
+	fd_Forecast_block_height      protoreflect.FieldDescriptor
+	fd_Forecast_forecaster        protoreflect.FieldDescriptor
+	fd_Forecast_forecast_elements protoreflect.FieldDescriptor
+	fd_Forecast_extra_data        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_worker_proto_init()
-	md_Forecasts = File_emissions_v3_worker_proto.Messages().ByName("Forecasts")
-	fd_Forecasts_forecasts = md_Forecasts.Fields().ByName("forecasts")
+	md_Forecast = File_emissions_v3_worker_proto.Messages().ByName("Forecast")
+	fd_Forecast_topic_id = md_Forecast.Fields().ByName("topic_id")
+	fd_Forecast_block_height = md_Forecast.Fields().ByName("block_height")
+	fd_Forecast_forecaster = md_Forecast.Fields().ByName("forecaster")
+	fd_Forecast_forecast_elements = md_Forecast.Fields().ByName("forecast_elements")
+	fd_Forecast_extra_data = md_Forecast.Fields().ByName("extra_data")
 }
 
-var _ protoreflect.Message = (*fastReflection_Forecasts)(nil)
+var _ protoreflect.Message = (*fastReflection_Forecast)(nil)
 
-type fastReflection_Forecasts Forecasts
+type fastReflection_Forecast Forecast
 
-func (x *Forecasts) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Forecasts)(x)
+func (x *Forecast) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Forecast)(x)
 }
 
-func (x *Forecasts) slowProtoReflect() protoreflect.Message {
+func (x *Forecast) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v3_worker_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2971,43 +2867,43 @@ func (x *Forecasts) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Forecasts_messageType fastReflection_Forecasts_messageType
-var _ protoreflect.MessageType = fastReflection_Forecasts_messageType{}
+var _fastReflection_Forecast_messageType fastReflection_Forecast_messageType
+var _ protoreflect.MessageType = fastReflection_Forecast_messageType{}
 
-type fastReflection_Forecasts_messageType struct{}
+type fastReflection_Forecast_messageType struct{}
 
-func (x fastReflection_Forecasts_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Forecasts)(nil)
+func (x fastReflection_Forecast_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Forecast)(nil)
 }
-func (x fastReflection_Forecasts_messageType) New() protoreflect.Message {
-	return new(fastReflection_Forecasts)
+func (x fastReflection_Forecast_messageType) New() protoreflect.Message {
+	return new(fastReflection_Forecast)
 }
-func (x fastReflection_Forecasts_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Forecasts
+func (x fastReflection_Forecast_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Forecast
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Forecasts) Descriptor() protoreflect.MessageDescriptor {
-	return md_Forecasts
+func (x *fastReflection_Forecast) Descriptor() protoreflect.MessageDescriptor {
+	return md_Forecast
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Forecasts) Type() protoreflect.MessageType {
-	return _fastReflection_Forecasts_messageType
+func (x *fastReflection_Forecast) Type() protoreflect.MessageType {
+	return _fastReflection_Forecast_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Forecasts) New() protoreflect.Message {
-	return new(fastReflection_Forecasts)
+func (x *fastReflection_Forecast) New() protoreflect.Message {
+	return new(fastReflection_Forecast)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Forecasts) Interface() protoreflect.ProtoMessage {
-	return (*Forecasts)(x)
+func (x *fastReflection_Forecast) Interface() protoreflect.ProtoMessage {
+	return (*Forecast)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3015,10 +2911,34 @@ func (x *fastReflection_Forecasts) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Forecasts) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Forecasts) != 0 {
-		value := protoreflect.ValueOfList(&_Forecasts_1_list{list: &x.Forecasts})
-		if !f(fd_Forecasts_forecasts, value) {
+func (x *fastReflection_Forecast) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_Forecast_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_Forecast_block_height, value) {
+			return
+		}
+	}
+	if x.Forecaster != "" {
+		value := protoreflect.ValueOfString(x.Forecaster)
+		if !f(fd_Forecast_forecaster, value) {
+			return
+		}
+	}
+	if len(x.ForecastElements) != 0 {
+		value := protoreflect.ValueOfList(&_Forecast_4_list{list: &x.ForecastElements})
+		if !f(fd_Forecast_forecast_elements, value) {
+			return
+		}
+	}
+	if len(x.ExtraData) != 0 {
+		value := protoreflect.ValueOfBytes(x.ExtraData)
+		if !f(fd_Forecast_extra_data, value) {
 			return
 		}
 	}
@@ -3035,15 +2955,23 @@ func (x *fastReflection_Forecasts) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Forecasts) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Forecast) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.Forecasts.forecasts":
-		return len(x.Forecasts) != 0
+	case "emissions.v3.Forecast.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.Forecast.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v3.Forecast.forecaster":
+		return x.Forecaster != ""
+	case "emissions.v3.Forecast.forecast_elements":
+		return len(x.ForecastElements) != 0
+	case "emissions.v3.Forecast.extra_data":
+		return len(x.ExtraData) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3053,15 +2981,23 @@ func (x *fastReflection_Forecasts) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecasts) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Forecast) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.Forecasts.forecasts":
-		x.Forecasts = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
+	case "emissions.v3.Forecast.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.Forecast.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v3.Forecast.forecaster":
+		x.Forecaster = ""
+	case "emissions.v3.Forecast.forecast_elements":
+		x.ForecastElements = nil
+	case "emissions.v3.Forecast.extra_data":
+		x.ExtraData = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3071,19 +3007,31 @@ func (x *fastReflection_Forecasts) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Forecasts) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Forecast) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.Forecasts.forecasts":
-		if len(x.Forecasts) == 0 {
-			return protoreflect.ValueOfList(&_Forecasts_1_list{})
+	case "emissions.v3.Forecast.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.Forecast.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.Forecast.forecaster":
+		value := x.Forecaster
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.Forecast.forecast_elements":
+		if len(x.ForecastElements) == 0 {
+			return protoreflect.ValueOfList(&_Forecast_4_list{})
 		}
-		listValue := &_Forecasts_1_list{list: &x.Forecasts}
+		listValue := &_Forecast_4_list{list: &x.ForecastElements}
 		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.Forecast.extra_data":
+		value := x.ExtraData
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3097,17 +3045,25 @@ func (x *fastReflection_Forecasts) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecasts) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Forecast) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.Forecasts.forecasts":
+	case "emissions.v3.Forecast.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.Forecast.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v3.Forecast.forecaster":
+		x.Forecaster = value.Interface().(string)
+	case "emissions.v3.Forecast.forecast_elements":
 		lv := value.List()
-		clv := lv.(*_Forecasts_1_list)
-		x.Forecasts = *clv.list
+		clv := lv.(*_Forecast_4_list)
+		x.ForecastElements = *clv.list
+	case "emissions.v3.Forecast.extra_data":
+		x.ExtraData = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3121,45 +3077,61 @@ func (x *fastReflection_Forecasts) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecasts) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Forecast) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.Forecasts.forecasts":
-		if x.Forecasts == nil {
-			x.Forecasts = []*Forecast{}
+	case "emissions.v3.Forecast.forecast_elements":
+		if x.ForecastElements == nil {
+			x.ForecastElements = []*ForecastElement{}
 		}
-		value := &_Forecasts_1_list{list: &x.Forecasts}
+		value := &_Forecast_4_list{list: &x.ForecastElements}
 		return protoreflect.ValueOfList(value)
+	case "emissions.v3.Forecast.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.Forecast is not mutable"))
+	case "emissions.v3.Forecast.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v3.Forecast is not mutable"))
+	case "emissions.v3.Forecast.forecaster":
+		panic(fmt.Errorf("field forecaster of message emissions.v3.Forecast is not mutable"))
+	case "emissions.v3.Forecast.extra_data":
+		panic(fmt.Errorf("field extra_data of message emissions.v3.Forecast is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Forecasts) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Forecast) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.Forecasts.forecasts":
-		list := []*Forecast{}
-		return protoreflect.ValueOfList(&_Forecasts_1_list{list: &list})
+	case "emissions.v3.Forecast.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.Forecast.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.Forecast.forecaster":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.Forecast.forecast_elements":
+		list := []*ForecastElement{}
+		return protoreflect.ValueOfList(&_Forecast_4_list{list: &list})
+	case "emissions.v3.Forecast.extra_data":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecast"))
 		}
-		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecast does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Forecasts) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Forecast) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.Forecasts", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.Forecast", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3167,7 +3139,7 @@ func (x *fastReflection_Forecasts) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Forecasts) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Forecast) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3178,7 +3150,7 @@ func (x *fastReflection_Forecasts) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Forecasts) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Forecast) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3190,7 +3162,7 @@ func (x *fastReflection_Forecasts) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Forecasts) IsValid() bool {
+func (x *fastReflection_Forecast) IsValid() bool {
 	return x != nil
 }
 
@@ -3200,9 +3172,9 @@ func (x *fastReflection_Forecasts) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Forecast) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Forecasts)
+		x := input.Message.Interface().(*Forecast)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3214,12 +3186,26 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Forecasts) > 0 {
-			for _, e := range x.Forecasts {
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Forecaster)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ForecastElements) > 0 {
+			for _, e := range x.ForecastElements {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.ExtraData)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3230,7 +3216,7 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Forecasts)
+		x := input.Message.Interface().(*Forecast)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3249,9 +3235,16 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Forecasts) > 0 {
-			for iNdEx := len(x.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Forecasts[iNdEx])
+		if len(x.ExtraData) > 0 {
+			i -= len(x.ExtraData)
+			copy(dAtA[i:], x.ExtraData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtraData)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ForecastElements) > 0 {
+			for iNdEx := len(x.ForecastElements) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForecastElements[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3262,9 +3255,26 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x22
 			}
 		}
+		if len(x.Forecaster) > 0 {
+			i -= len(x.Forecaster)
+			copy(dAtA[i:], x.Forecaster)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Forecaster)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3276,7 +3286,7 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Forecasts)
+		x := input.Message.Interface().(*Forecast)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3308,15 +3318,85 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecasts: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecast: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecasts: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecast: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecaster", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Forecaster = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecastElements", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3343,16 +3423,50 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Forecasts = append(x.Forecasts, &Forecast{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forecasts[len(x.Forecasts)-1]); err != nil {
+				x.ForecastElements = append(x.ForecastElements, &ForecastElement{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecastElements[len(x.ForecastElements)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraData", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtraData = append(x.ExtraData[:0], dAtA[iNdEx:postIndex]...)
+				if x.ExtraData == nil {
+					x.ExtraData = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -3383,28 +3497,77 @@ func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Forecasts_1_list)(nil)
+
+type _Forecasts_1_list struct {
+	list *[]*Forecast
+}
+
+func (x *_Forecasts_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Forecasts_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Forecasts_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Forecast)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Forecasts_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Forecast)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Forecasts_1_list) AppendMutable() protoreflect.Value {
+	v := new(Forecast)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Forecasts_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Forecasts_1_list) NewElement() protoreflect.Value {
+	v := new(Forecast)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Forecasts_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_InferenceForecastBundle           protoreflect.MessageDescriptor
-	fd_InferenceForecastBundle_inference protoreflect.FieldDescriptor
-	fd_InferenceForecastBundle_forecast  protoreflect.FieldDescriptor
+	md_Forecasts           protoreflect.MessageDescriptor
+	fd_Forecasts_forecasts protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_worker_proto_init()
-	md_InferenceForecastBundle = File_emissions_v3_worker_proto.Messages().ByName("InferenceForecastBundle")
-	fd_InferenceForecastBundle_inference = md_InferenceForecastBundle.Fields().ByName("inference")
-	fd_InferenceForecastBundle_forecast = md_InferenceForecastBundle.Fields().ByName("forecast")
+	md_Forecasts = File_emissions_v3_worker_proto.Messages().ByName("Forecasts")
+	fd_Forecasts_forecasts = md_Forecasts.Fields().ByName("forecasts")
 }
 
-var _ protoreflect.Message = (*fastReflection_InferenceForecastBundle)(nil)
+var _ protoreflect.Message = (*fastReflection_Forecasts)(nil)
 
-type fastReflection_InferenceForecastBundle InferenceForecastBundle
+type fastReflection_Forecasts Forecasts
 
-func (x *InferenceForecastBundle) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_InferenceForecastBundle)(x)
+func (x *Forecasts) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Forecasts)(x)
 }
 
-func (x *InferenceForecastBundle) slowProtoReflect() protoreflect.Message {
+func (x *Forecasts) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v3_worker_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3416,43 +3579,43 @@ func (x *InferenceForecastBundle) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_InferenceForecastBundle_messageType fastReflection_InferenceForecastBundle_messageType
-var _ protoreflect.MessageType = fastReflection_InferenceForecastBundle_messageType{}
+var _fastReflection_Forecasts_messageType fastReflection_Forecasts_messageType
+var _ protoreflect.MessageType = fastReflection_Forecasts_messageType{}
 
-type fastReflection_InferenceForecastBundle_messageType struct{}
+type fastReflection_Forecasts_messageType struct{}
 
-func (x fastReflection_InferenceForecastBundle_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_InferenceForecastBundle)(nil)
+func (x fastReflection_Forecasts_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Forecasts)(nil)
 }
-func (x fastReflection_InferenceForecastBundle_messageType) New() protoreflect.Message {
-	return new(fastReflection_InferenceForecastBundle)
+func (x fastReflection_Forecasts_messageType) New() protoreflect.Message {
+	return new(fastReflection_Forecasts)
 }
-func (x fastReflection_InferenceForecastBundle_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_InferenceForecastBundle
+func (x fastReflection_Forecasts_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Forecasts
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_InferenceForecastBundle) Descriptor() protoreflect.MessageDescriptor {
-	return md_InferenceForecastBundle
+func (x *fastReflection_Forecasts) Descriptor() protoreflect.MessageDescriptor {
+	return md_Forecasts
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_InferenceForecastBundle) Type() protoreflect.MessageType {
-	return _fastReflection_InferenceForecastBundle_messageType
+func (x *fastReflection_Forecasts) Type() protoreflect.MessageType {
+	return _fastReflection_Forecasts_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_InferenceForecastBundle) New() protoreflect.Message {
-	return new(fastReflection_InferenceForecastBundle)
+func (x *fastReflection_Forecasts) New() protoreflect.Message {
+	return new(fastReflection_Forecasts)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_InferenceForecastBundle) Interface() protoreflect.ProtoMessage {
-	return (*InferenceForecastBundle)(x)
+func (x *fastReflection_Forecasts) Interface() protoreflect.ProtoMessage {
+	return (*Forecasts)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3460,16 +3623,10 @@ func (x *fastReflection_InferenceForecastBundle) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_InferenceForecastBundle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Inference != nil {
-		value := protoreflect.ValueOfMessage(x.Inference.ProtoReflect())
-		if !f(fd_InferenceForecastBundle_inference, value) {
-			return
-		}
-	}
-	if x.Forecast != nil {
-		value := protoreflect.ValueOfMessage(x.Forecast.ProtoReflect())
-		if !f(fd_InferenceForecastBundle_forecast, value) {
+func (x *fastReflection_Forecasts) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Forecasts) != 0 {
+		value := protoreflect.ValueOfList(&_Forecasts_1_list{list: &x.Forecasts})
+		if !f(fd_Forecasts_forecasts, value) {
 			return
 		}
 	}
@@ -3486,17 +3643,15 @@ func (x *fastReflection_InferenceForecastBundle) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_InferenceForecastBundle) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Forecasts) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.InferenceForecastBundle.inference":
-		return x.Inference != nil
-	case "emissions.v3.InferenceForecastBundle.forecast":
-		return x.Forecast != nil
+	case "emissions.v3.Forecasts.forecasts":
+		return len(x.Forecasts) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
 		}
-		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3506,17 +3661,15 @@ func (x *fastReflection_InferenceForecastBundle) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InferenceForecastBundle) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Forecasts) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.InferenceForecastBundle.inference":
-		x.Inference = nil
-	case "emissions.v3.InferenceForecastBundle.forecast":
-		x.Forecast = nil
+	case "emissions.v3.Forecasts.forecasts":
+		x.Forecasts = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
 		}
-		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3526,19 +3679,19 @@ func (x *fastReflection_InferenceForecastBundle) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_InferenceForecastBundle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Forecasts) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.InferenceForecastBundle.inference":
-		value := x.Inference
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "emissions.v3.InferenceForecastBundle.forecast":
-		value := x.Forecast
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.Forecasts.forecasts":
+		if len(x.Forecasts) == 0 {
+			return protoreflect.ValueOfList(&_Forecasts_1_list{})
+		}
+		listValue := &_Forecasts_1_list{list: &x.Forecasts}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
 		}
-		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3552,17 +3705,17 @@ func (x *fastReflection_InferenceForecastBundle) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InferenceForecastBundle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Forecasts) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.InferenceForecastBundle.inference":
-		x.Inference = value.Message().Interface().(*Inference)
-	case "emissions.v3.InferenceForecastBundle.forecast":
-		x.Forecast = value.Message().Interface().(*Forecast)
+	case "emissions.v3.Forecasts.forecasts":
+		lv := value.List()
+		clv := lv.(*_Forecasts_1_list)
+		x.Forecasts = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
 		}
-		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3576,52 +3729,45 @@ func (x *fastReflection_InferenceForecastBundle) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InferenceForecastBundle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Forecasts) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.InferenceForecastBundle.inference":
-		if x.Inference == nil {
-			x.Inference = new(Inference)
-		}
-		return protoreflect.ValueOfMessage(x.Inference.ProtoReflect())
-	case "emissions.v3.InferenceForecastBundle.forecast":
-		if x.Forecast == nil {
-			x.Forecast = new(Forecast)
+	case "emissions.v3.Forecasts.forecasts":
+		if x.Forecasts == nil {
+			x.Forecasts = []*Forecast{}
 		}
-		return protoreflect.ValueOfMessage(x.Forecast.ProtoReflect())
+		value := &_Forecasts_1_list{list: &x.Forecasts}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
 		}
-		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_InferenceForecastBundle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Forecasts) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.InferenceForecastBundle.inference":
-		m := new(Inference)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "emissions.v3.InferenceForecastBundle.forecast":
-		m := new(Forecast)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.Forecasts.forecasts":
+		list := []*Forecast{}
+		return protoreflect.ValueOfList(&_Forecasts_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.Forecasts"))
 		}
-		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.Forecasts does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_InferenceForecastBundle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Forecasts) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.InferenceForecastBundle", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.Forecasts", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3629,7 +3775,7 @@ func (x *fastReflection_InferenceForecastBundle) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_InferenceForecastBundle) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Forecasts) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3640,7 +3786,7 @@ func (x *fastReflection_InferenceForecastBundle) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_InferenceForecastBundle) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Forecasts) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3652,7 +3798,7 @@ func (x *fastReflection_InferenceForecastBundle) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_InferenceForecastBundle) IsValid() bool {
+func (x *fastReflection_Forecasts) IsValid() bool {
 	return x != nil
 }
 
@@ -3662,9 +3808,9 @@ func (x *fastReflection_InferenceForecastBundle) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Forecasts) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*InferenceForecastBundle)
+		x := input.Message.Interface().(*Forecasts)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3676,13 +3822,11 @@ func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Inference != nil {
-			l = options.Size(x.Inference)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Forecast != nil {
-			l = options.Size(x.Forecast)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Forecasts) > 0 {
+			for _, e := range x.Forecasts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3694,7 +3838,7 @@ func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*InferenceForecastBundle)
+		x := input.Message.Interface().(*Forecasts)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3713,33 +3857,21 @@ func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Forecast != nil {
-			encoded, err := options.Marshal(x.Forecast)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Inference != nil {
-			encoded, err := options.Marshal(x.Inference)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Forecasts) > 0 {
+			for iNdEx := len(x.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Forecasts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3752,7 +3884,7 @@ func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*InferenceForecastBundle)
+		x := input.Message.Interface().(*Forecasts)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3784,51 +3916,15 @@ func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceForecastBundle: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecasts: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceForecastBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Forecasts: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inference", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Inference == nil {
-					x.Inference = &Inference{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Inference); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecast", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3855,10 +3951,8 @@ func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Forecast == nil {
-					x.Forecast = &Forecast{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forecast); err != nil {
+				x.Forecasts = append(x.Forecasts, &Forecast{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forecasts[len(x.Forecasts)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3898,35 +3992,27 @@ func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_WorkerDataBundle                                       protoreflect.MessageDescriptor
-	fd_WorkerDataBundle_worker                                protoreflect.FieldDescriptor
-	fd_WorkerDataBundle_nonce                                 protoreflect.FieldDescriptor
-	fd_WorkerDataBundle_topic_id                              protoreflect.FieldDescriptor
-	fd_WorkerDataBundle_inference_forecasts_bundle            protoreflect.FieldDescriptor
-	fd_WorkerDataBundle_inferences_forecasts_bundle_signature protoreflect.FieldDescriptor
-	fd_WorkerDataBundle_pubkey                                protoreflect.FieldDescriptor
+	md_InferenceForecastBundle           protoreflect.MessageDescriptor
+	fd_InferenceForecastBundle_inference protoreflect.FieldDescriptor
+	fd_InferenceForecastBundle_forecast  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_worker_proto_init()
-	md_WorkerDataBundle = File_emissions_v3_worker_proto.Messages().ByName("WorkerDataBundle")
-	fd_WorkerDataBundle_worker = md_WorkerDataBundle.Fields().ByName("worker")
-	fd_WorkerDataBundle_nonce = md_WorkerDataBundle.Fields().ByName("nonce")
-	fd_WorkerDataBundle_topic_id = md_WorkerDataBundle.Fields().ByName("topic_id")
-	fd_WorkerDataBundle_inference_forecasts_bundle = md_WorkerDataBundle.Fields().ByName("inference_forecasts_bundle")
-	fd_WorkerDataBundle_inferences_forecasts_bundle_signature = md_WorkerDataBundle.Fields().ByName("inferences_forecasts_bundle_signature")
-	fd_WorkerDataBundle_pubkey = md_WorkerDataBundle.Fields().ByName("pubkey")
+	md_InferenceForecastBundle = File_emissions_v3_worker_proto.Messages().ByName("InferenceForecastBundle")
+	fd_InferenceForecastBundle_inference = md_InferenceForecastBundle.Fields().ByName("inference")
+	fd_InferenceForecastBundle_forecast = md_InferenceForecastBundle.Fields().ByName("forecast")
 }
 
-var _ protoreflect.Message = (*fastReflection_WorkerDataBundle)(nil)
+var _ protoreflect.Message = (*fastReflection_InferenceForecastBundle)(nil)
 
-type fastReflection_WorkerDataBundle WorkerDataBundle
+type fastReflection_InferenceForecastBundle InferenceForecastBundle
 
-func (x *WorkerDataBundle) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_WorkerDataBundle)(x)
+func (x *InferenceForecastBundle) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InferenceForecastBundle)(x)
 }
 
-func (x *WorkerDataBundle) slowProtoReflect() protoreflect.Message {
+func (x *InferenceForecastBundle) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v3_worker_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3938,43 +4024,43 @@ func (x *WorkerDataBundle) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_WorkerDataBundle_messageType fastReflection_WorkerDataBundle_messageType
-var _ protoreflect.MessageType = fastReflection_WorkerDataBundle_messageType{}
+var _fastReflection_InferenceForecastBundle_messageType fastReflection_InferenceForecastBundle_messageType
+var _ protoreflect.MessageType = fastReflection_InferenceForecastBundle_messageType{}
 
-type fastReflection_WorkerDataBundle_messageType struct{}
+type fastReflection_InferenceForecastBundle_messageType struct{}
 
-func (x fastReflection_WorkerDataBundle_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_WorkerDataBundle)(nil)
+func (x fastReflection_InferenceForecastBundle_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InferenceForecastBundle)(nil)
 }
-func (x fastReflection_WorkerDataBundle_messageType) New() protoreflect.Message {
-	return new(fastReflection_WorkerDataBundle)
+func (x fastReflection_InferenceForecastBundle_messageType) New() protoreflect.Message {
+	return new(fastReflection_InferenceForecastBundle)
 }
-func (x fastReflection_WorkerDataBundle_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_WorkerDataBundle
+func (x fastReflection_InferenceForecastBundle_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceForecastBundle
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_WorkerDataBundle) Descriptor() protoreflect.MessageDescriptor {
-	return md_WorkerDataBundle
+func (x *fastReflection_InferenceForecastBundle) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceForecastBundle
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_WorkerDataBundle) Type() protoreflect.MessageType {
-	return _fastReflection_WorkerDataBundle_messageType
+func (x *fastReflection_InferenceForecastBundle) Type() protoreflect.MessageType {
+	return _fastReflection_InferenceForecastBundle_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_WorkerDataBundle) New() protoreflect.Message {
-	return new(fastReflection_WorkerDataBundle)
+func (x *fastReflection_InferenceForecastBundle) New() protoreflect.Message {
+	return new(fastReflection_InferenceForecastBundle)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_WorkerDataBundle) Interface() protoreflect.ProtoMessage {
-	return (*WorkerDataBundle)(x)
+func (x *fastReflection_InferenceForecastBundle) Interface() protoreflect.ProtoMessage {
+	return (*InferenceForecastBundle)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3982,40 +4068,16 @@ func (x *fastReflection_WorkerDataBundle) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_WorkerDataBundle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Worker != "" {
-		value := protoreflect.ValueOfString(x.Worker)
-		if !f(fd_WorkerDataBundle_worker, value) {
-			return
-		}
-	}
-	if x.Nonce != nil {
-		value := protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
-		if !f(fd_WorkerDataBundle_nonce, value) {
-			return
-		}
-	}
-	if x.TopicId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_WorkerDataBundle_topic_id, value) {
-			return
-		}
-	}
-	if x.InferenceForecastsBundle != nil {
-		value := protoreflect.ValueOfMessage(x.InferenceForecastsBundle.ProtoReflect())
-		if !f(fd_WorkerDataBundle_inference_forecasts_bundle, value) {
-			return
-		}
-	}
-	if len(x.InferencesForecastsBundleSignature) != 0 {
-		value := protoreflect.ValueOfBytes(x.InferencesForecastsBundleSignature)
-		if !f(fd_WorkerDataBundle_inferences_forecasts_bundle_signature, value) {
+func (x *fastReflection_InferenceForecastBundle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Inference != nil {
+		value := protoreflect.ValueOfMessage(x.Inference.ProtoReflect())
+		if !f(fd_InferenceForecastBundle_inference, value) {
 			return
 		}
 	}
-	if x.Pubkey != "" {
-		value := protoreflect.ValueOfString(x.Pubkey)
-		if !f(fd_WorkerDataBundle_pubkey, value) {
+	if x.Forecast != nil {
+		value := protoreflect.ValueOfMessage(x.Forecast.ProtoReflect())
+		if !f(fd_InferenceForecastBundle_forecast, value) {
 			return
 		}
 	}
@@ -4032,25 +4094,17 @@ func (x *fastReflection_WorkerDataBundle) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_WorkerDataBundle) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_InferenceForecastBundle) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundle.worker":
-		return x.Worker != ""
-	case "emissions.v3.WorkerDataBundle.nonce":
-		return x.Nonce != nil
-	case "emissions.v3.WorkerDataBundle.topic_id":
-		return x.TopicId != uint64(0)
-	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
-		return x.InferenceForecastsBundle != nil
-	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
-		return len(x.InferencesForecastsBundleSignature) != 0
-	case "emissions.v3.WorkerDataBundle.pubkey":
-		return x.Pubkey != ""
+	case "emissions.v3.InferenceForecastBundle.inference":
+		return x.Inference != nil
+	case "emissions.v3.InferenceForecastBundle.forecast":
+		return x.Forecast != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4060,25 +4114,17 @@ func (x *fastReflection_WorkerDataBundle) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundle) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_InferenceForecastBundle) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundle.worker":
-		x.Worker = ""
-	case "emissions.v3.WorkerDataBundle.nonce":
-		x.Nonce = nil
-	case "emissions.v3.WorkerDataBundle.topic_id":
-		x.TopicId = uint64(0)
-	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
-		x.InferenceForecastsBundle = nil
-	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
-		x.InferencesForecastsBundleSignature = nil
-	case "emissions.v3.WorkerDataBundle.pubkey":
-		x.Pubkey = ""
+	case "emissions.v3.InferenceForecastBundle.inference":
+		x.Inference = nil
+	case "emissions.v3.InferenceForecastBundle.forecast":
+		x.Forecast = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4088,31 +4134,19 @@ func (x *fastReflection_WorkerDataBundle) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_WorkerDataBundle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InferenceForecastBundle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.WorkerDataBundle.worker":
-		value := x.Worker
-		return protoreflect.ValueOfString(value)
-	case "emissions.v3.WorkerDataBundle.nonce":
-		value := x.Nonce
+	case "emissions.v3.InferenceForecastBundle.inference":
+		value := x.Inference
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "emissions.v3.WorkerDataBundle.topic_id":
-		value := x.TopicId
-		return protoreflect.ValueOfUint64(value)
-	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
-		value := x.InferenceForecastsBundle
+	case "emissions.v3.InferenceForecastBundle.forecast":
+		value := x.Forecast
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
-		value := x.InferencesForecastsBundleSignature
-		return protoreflect.ValueOfBytes(value)
-	case "emissions.v3.WorkerDataBundle.pubkey":
-		value := x.Pubkey
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4126,25 +4160,17 @@ func (x *fastReflection_WorkerDataBundle) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_InferenceForecastBundle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundle.worker":
-		x.Worker = value.Interface().(string)
-	case "emissions.v3.WorkerDataBundle.nonce":
-		x.Nonce = value.Message().Interface().(*Nonce)
-	case "emissions.v3.WorkerDataBundle.topic_id":
-		x.TopicId = value.Uint()
-	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
-		x.InferenceForecastsBundle = value.Message().Interface().(*InferenceForecastBundle)
-	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
-		x.InferencesForecastsBundleSignature = value.Bytes()
-	case "emissions.v3.WorkerDataBundle.pubkey":
-		x.Pubkey = value.Interface().(string)
+	case "emissions.v3.InferenceForecastBundle.inference":
+		x.Inference = value.Message().Interface().(*Inference)
+	case "emissions.v3.InferenceForecastBundle.forecast":
+		x.Forecast = value.Message().Interface().(*Forecast)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4158,68 +4184,52 @@ func (x *fastReflection_WorkerDataBundle) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InferenceForecastBundle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundle.nonce":
-		if x.Nonce == nil {
-			x.Nonce = new(Nonce)
+	case "emissions.v3.InferenceForecastBundle.inference":
+		if x.Inference == nil {
+			x.Inference = new(Inference)
 		}
-		return protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
-	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
-		if x.InferenceForecastsBundle == nil {
-			x.InferenceForecastsBundle = new(InferenceForecastBundle)
+		return protoreflect.ValueOfMessage(x.Inference.ProtoReflect())
+	case "emissions.v3.InferenceForecastBundle.forecast":
+		if x.Forecast == nil {
+			x.Forecast = new(Forecast)
 		}
-		return protoreflect.ValueOfMessage(x.InferenceForecastsBundle.ProtoReflect())
-	case "emissions.v3.WorkerDataBundle.worker":
-		panic(fmt.Errorf("field worker of message emissions.v3.WorkerDataBundle is not mutable"))
-	case "emissions.v3.WorkerDataBundle.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v3.WorkerDataBundle is not mutable"))
-	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
-		panic(fmt.Errorf("field inferences_forecasts_bundle_signature of message emissions.v3.WorkerDataBundle is not mutable"))
-	case "emissions.v3.WorkerDataBundle.pubkey":
-		panic(fmt.Errorf("field pubkey of message emissions.v3.WorkerDataBundle is not mutable"))
+		return protoreflect.ValueOfMessage(x.Forecast.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_WorkerDataBundle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_InferenceForecastBundle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundle.worker":
-		return protoreflect.ValueOfString("")
-	case "emissions.v3.WorkerDataBundle.nonce":
-		m := new(Nonce)
+	case "emissions.v3.InferenceForecastBundle.inference":
+		m := new(Inference)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "emissions.v3.WorkerDataBundle.topic_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
-		m := new(InferenceForecastBundle)
+	case "emissions.v3.InferenceForecastBundle.forecast":
+		m := new(Forecast)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
-		return protoreflect.ValueOfBytes(nil)
-	case "emissions.v3.WorkerDataBundle.pubkey":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.InferenceForecastBundle"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.InferenceForecastBundle does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_WorkerDataBundle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_InferenceForecastBundle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerDataBundle", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.InferenceForecastBundle", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4227,7 +4237,7 @@ func (x *fastReflection_WorkerDataBundle) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_WorkerDataBundle) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_InferenceForecastBundle) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4238,7 +4248,7 @@ func (x *fastReflection_WorkerDataBundle) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundle) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_InferenceForecastBundle) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4250,7 +4260,7 @@ func (x *fastReflection_WorkerDataBundle) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_WorkerDataBundle) IsValid() bool {
+func (x *fastReflection_InferenceForecastBundle) IsValid() bool {
 	return x != nil
 }
 
@@ -4260,9 +4270,9 @@ func (x *fastReflection_WorkerDataBundle) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_InferenceForecastBundle) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*WorkerDataBundle)
+		x := input.Message.Interface().(*InferenceForecastBundle)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4274,27 +4284,12 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Worker)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Nonce != nil {
-			l = options.Size(x.Nonce)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TopicId != 0 {
-			n += 1 + runtime.Sov(uint64(x.TopicId))
-		}
-		if x.InferenceForecastsBundle != nil {
-			l = options.Size(x.InferenceForecastsBundle)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.InferencesForecastsBundleSignature)
-		if l > 0 {
+		if x.Inference != nil {
+			l = options.Size(x.Inference)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Pubkey)
-		if l > 0 {
+		if x.Forecast != nil {
+			l = options.Size(x.Forecast)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4307,7 +4302,7 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*WorkerDataBundle)
+		x := input.Message.Interface().(*InferenceForecastBundle)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4326,22 +4321,8 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Pubkey) > 0 {
-			i -= len(x.Pubkey)
-			copy(dAtA[i:], x.Pubkey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pubkey)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.InferencesForecastsBundleSignature) > 0 {
-			i -= len(x.InferencesForecastsBundleSignature)
-			copy(dAtA[i:], x.InferencesForecastsBundleSignature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InferencesForecastsBundleSignature)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.InferenceForecastsBundle != nil {
-			encoded, err := options.Marshal(x.InferenceForecastsBundle)
+		if x.Forecast != nil {
+			encoded, err := options.Marshal(x.Forecast)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4352,15 +4333,10 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
-		}
-		if x.TopicId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
-			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x12
 		}
-		if x.Nonce != nil {
-			encoded, err := options.Marshal(x.Nonce)
+		if x.Inference != nil {
+			encoded, err := options.Marshal(x.Inference)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4371,13 +4347,6 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Worker) > 0 {
-			i -= len(x.Worker)
-			copy(dAtA[i:], x.Worker)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -4391,7 +4360,7 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*WorkerDataBundle)
+		x := input.Message.Interface().(*InferenceForecastBundle)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4423,17 +4392,17 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundle: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceForecastBundle: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceForecastBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inference", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4443,27 +4412,31 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Worker = string(dAtA[iNdEx:postIndex])
+				if x.Inference == nil {
+					x.Inference = &Inference{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Inference); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecast", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4490,35 +4463,1232 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Nonce == nil {
-					x.Nonce = &Nonce{}
+				if x.Forecast == nil {
+					x.Forecast = &Forecast{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nonce); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forecast); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.TopicId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TopicId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_WorkerDataBundle                                       protoreflect.MessageDescriptor
+	fd_WorkerDataBundle_worker                                protoreflect.FieldDescriptor
+	fd_WorkerDataBundle_nonce                                 protoreflect.FieldDescriptor
+	fd_WorkerDataBundle_topic_id                              protoreflect.FieldDescriptor
+	fd_WorkerDataBundle_inference_forecasts_bundle            protoreflect.FieldDescriptor
+	fd_WorkerDataBundle_inferences_forecasts_bundle_signature protoreflect.FieldDescriptor
+	fd_WorkerDataBundle_pubkey                                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_worker_proto_init()
+	md_WorkerDataBundle = File_emissions_v3_worker_proto.Messages().ByName("WorkerDataBundle")
+	fd_WorkerDataBundle_worker = md_WorkerDataBundle.Fields().ByName("worker")
+	fd_WorkerDataBundle_nonce = md_WorkerDataBundle.Fields().ByName("nonce")
+	fd_WorkerDataBundle_topic_id = md_WorkerDataBundle.Fields().ByName("topic_id")
+	fd_WorkerDataBundle_inference_forecasts_bundle = md_WorkerDataBundle.Fields().ByName("inference_forecasts_bundle")
+	fd_WorkerDataBundle_inferences_forecasts_bundle_signature = md_WorkerDataBundle.Fields().ByName("inferences_forecasts_bundle_signature")
+	fd_WorkerDataBundle_pubkey = md_WorkerDataBundle.Fields().ByName("pubkey")
+}
+
+var _ protoreflect.Message = (*fastReflection_WorkerDataBundle)(nil)
+
+type fastReflection_WorkerDataBundle WorkerDataBundle
+
+func (x *WorkerDataBundle) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WorkerDataBundle)(x)
+}
+
+func (x *WorkerDataBundle) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_worker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WorkerDataBundle_messageType fastReflection_WorkerDataBundle_messageType
+var _ protoreflect.MessageType = fastReflection_WorkerDataBundle_messageType{}
+
+type fastReflection_WorkerDataBundle_messageType struct{}
+
+func (x fastReflection_WorkerDataBundle_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WorkerDataBundle)(nil)
+}
+func (x fastReflection_WorkerDataBundle_messageType) New() protoreflect.Message {
+	return new(fastReflection_WorkerDataBundle)
+}
+func (x fastReflection_WorkerDataBundle_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerDataBundle
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WorkerDataBundle) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerDataBundle
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WorkerDataBundle) Type() protoreflect.MessageType {
+	return _fastReflection_WorkerDataBundle_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WorkerDataBundle) New() protoreflect.Message {
+	return new(fastReflection_WorkerDataBundle)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WorkerDataBundle) Interface() protoreflect.ProtoMessage {
+	return (*WorkerDataBundle)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WorkerDataBundle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Worker != "" {
+		value := protoreflect.ValueOfString(x.Worker)
+		if !f(fd_WorkerDataBundle_worker, value) {
+			return
+		}
+	}
+	if x.Nonce != nil {
+		value := protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
+		if !f(fd_WorkerDataBundle_nonce, value) {
+			return
+		}
+	}
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_WorkerDataBundle_topic_id, value) {
+			return
+		}
+	}
+	if x.InferenceForecastsBundle != nil {
+		value := protoreflect.ValueOfMessage(x.InferenceForecastsBundle.ProtoReflect())
+		if !f(fd_WorkerDataBundle_inference_forecasts_bundle, value) {
+			return
+		}
+	}
+	if len(x.InferencesForecastsBundleSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.InferencesForecastsBundleSignature)
+		if !f(fd_WorkerDataBundle_inferences_forecasts_bundle_signature, value) {
+			return
+		}
+	}
+	if x.Pubkey != "" {
+		value := protoreflect.ValueOfString(x.Pubkey)
+		if !f(fd_WorkerDataBundle_pubkey, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WorkerDataBundle) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundle.worker":
+		return x.Worker != ""
+	case "emissions.v3.WorkerDataBundle.nonce":
+		return x.Nonce != nil
+	case "emissions.v3.WorkerDataBundle.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
+		return x.InferenceForecastsBundle != nil
+	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
+		return len(x.InferencesForecastsBundleSignature) != 0
+	case "emissions.v3.WorkerDataBundle.pubkey":
+		return x.Pubkey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundle) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundle.worker":
+		x.Worker = ""
+	case "emissions.v3.WorkerDataBundle.nonce":
+		x.Nonce = nil
+	case "emissions.v3.WorkerDataBundle.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
+		x.InferenceForecastsBundle = nil
+	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
+		x.InferencesForecastsBundleSignature = nil
+	case "emissions.v3.WorkerDataBundle.pubkey":
+		x.Pubkey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WorkerDataBundle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.WorkerDataBundle.worker":
+		value := x.Worker
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerDataBundle.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.WorkerDataBundle.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
+		value := x.InferenceForecastsBundle
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
+		value := x.InferencesForecastsBundleSignature
+		return protoreflect.ValueOfBytes(value)
+	case "emissions.v3.WorkerDataBundle.pubkey":
+		value := x.Pubkey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundle.worker":
+		x.Worker = value.Interface().(string)
+	case "emissions.v3.WorkerDataBundle.nonce":
+		x.Nonce = value.Message().Interface().(*Nonce)
+	case "emissions.v3.WorkerDataBundle.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
+		x.InferenceForecastsBundle = value.Message().Interface().(*InferenceForecastBundle)
+	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
+		x.InferencesForecastsBundleSignature = value.Bytes()
+	case "emissions.v3.WorkerDataBundle.pubkey":
+		x.Pubkey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundle.nonce":
+		if x.Nonce == nil {
+			x.Nonce = new(Nonce)
+		}
+		return protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
+	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
+		if x.InferenceForecastsBundle == nil {
+			x.InferenceForecastsBundle = new(InferenceForecastBundle)
+		}
+		return protoreflect.ValueOfMessage(x.InferenceForecastsBundle.ProtoReflect())
+	case "emissions.v3.WorkerDataBundle.worker":
+		panic(fmt.Errorf("field worker of message emissions.v3.WorkerDataBundle is not mutable"))
+	case "emissions.v3.WorkerDataBundle.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.WorkerDataBundle is not mutable"))
+	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
+		panic(fmt.Errorf("field inferences_forecasts_bundle_signature of message emissions.v3.WorkerDataBundle is not mutable"))
+	case "emissions.v3.WorkerDataBundle.pubkey":
+		panic(fmt.Errorf("field pubkey of message emissions.v3.WorkerDataBundle is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WorkerDataBundle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundle.worker":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerDataBundle.nonce":
+		m := new(Nonce)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.WorkerDataBundle.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.WorkerDataBundle.inference_forecasts_bundle":
+		m := new(InferenceForecastBundle)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v3.WorkerDataBundle.inferences_forecasts_bundle_signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "emissions.v3.WorkerDataBundle.pubkey":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundle"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundle does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WorkerDataBundle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerDataBundle", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WorkerDataBundle) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundle) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WorkerDataBundle) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WorkerDataBundle)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Worker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != nil {
+			l = options.Size(x.Nonce)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.InferenceForecastsBundle != nil {
+			l = options.Size(x.InferenceForecastsBundle)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InferencesForecastsBundleSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Pubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerDataBundle)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Pubkey) > 0 {
+			i -= len(x.Pubkey)
+			copy(dAtA[i:], x.Pubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pubkey)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.InferencesForecastsBundleSignature) > 0 {
+			i -= len(x.InferencesForecastsBundleSignature)
+			copy(dAtA[i:], x.InferencesForecastsBundleSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InferencesForecastsBundleSignature)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.InferenceForecastsBundle != nil {
+			encoded, err := options.Marshal(x.InferenceForecastsBundle)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Nonce != nil {
+			encoded, err := options.Marshal(x.Nonce)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Worker) > 0 {
+			i -= len(x.Worker)
+			copy(dAtA[i:], x.Worker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerDataBundle)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundle: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Worker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Nonce == nil {
+					x.Nonce = &Nonce{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nonce); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceForecastsBundle", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InferenceForecastsBundle == nil {
+					x.InferenceForecastsBundle = &InferenceForecastBundle{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InferenceForecastsBundle); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferencesForecastsBundleSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InferencesForecastsBundleSignature = append(x.InferencesForecastsBundleSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.InferencesForecastsBundleSignature == nil {
+					x.InferencesForecastsBundleSignature = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_WorkerDataBundles_1_list)(nil)
+
+type _WorkerDataBundles_1_list struct {
+	list *[]*WorkerDataBundle
+}
+
+func (x *_WorkerDataBundles_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WorkerDataBundles_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_WorkerDataBundles_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WorkerDataBundle)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WorkerDataBundles_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WorkerDataBundle)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WorkerDataBundles_1_list) AppendMutable() protoreflect.Value {
+	v := new(WorkerDataBundle)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_WorkerDataBundles_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WorkerDataBundles_1_list) NewElement() protoreflect.Value {
+	v := new(WorkerDataBundle)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_WorkerDataBundles_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_WorkerDataBundles                     protoreflect.MessageDescriptor
+	fd_WorkerDataBundles_worker_data_bundles protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v3_worker_proto_init()
+	md_WorkerDataBundles = File_emissions_v3_worker_proto.Messages().ByName("WorkerDataBundles")
+	fd_WorkerDataBundles_worker_data_bundles = md_WorkerDataBundles.Fields().ByName("worker_data_bundles")
+}
+
+var _ protoreflect.Message = (*fastReflection_WorkerDataBundles)(nil)
+
+type fastReflection_WorkerDataBundles WorkerDataBundles
+
+func (x *WorkerDataBundles) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WorkerDataBundles)(x)
+}
+
+func (x *WorkerDataBundles) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_worker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WorkerDataBundles_messageType fastReflection_WorkerDataBundles_messageType
+var _ protoreflect.MessageType = fastReflection_WorkerDataBundles_messageType{}
+
+type fastReflection_WorkerDataBundles_messageType struct{}
+
+func (x fastReflection_WorkerDataBundles_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WorkerDataBundles)(nil)
+}
+func (x fastReflection_WorkerDataBundles_messageType) New() protoreflect.Message {
+	return new(fastReflection_WorkerDataBundles)
+}
+func (x fastReflection_WorkerDataBundles_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerDataBundles
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WorkerDataBundles) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerDataBundles
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WorkerDataBundles) Type() protoreflect.MessageType {
+	return _fastReflection_WorkerDataBundles_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WorkerDataBundles) New() protoreflect.Message {
+	return new(fastReflection_WorkerDataBundles)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WorkerDataBundles) Interface() protoreflect.ProtoMessage {
+	return (*WorkerDataBundles)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WorkerDataBundles) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.WorkerDataBundles) != 0 {
+		value := protoreflect.ValueOfList(&_WorkerDataBundles_1_list{list: &x.WorkerDataBundles})
+		if !f(fd_WorkerDataBundles_worker_data_bundles, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WorkerDataBundles) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
+		return len(x.WorkerDataBundles) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundles) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
+		x.WorkerDataBundles = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WorkerDataBundles) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
+		if len(x.WorkerDataBundles) == 0 {
+			return protoreflect.ValueOfList(&_WorkerDataBundles_1_list{})
+		}
+		listValue := &_WorkerDataBundles_1_list{list: &x.WorkerDataBundles}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundles) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
+		lv := value.List()
+		clv := lv.(*_WorkerDataBundles_1_list)
+		x.WorkerDataBundles = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundles) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
+		if x.WorkerDataBundles == nil {
+			x.WorkerDataBundles = []*WorkerDataBundle{}
+		}
+		value := &_WorkerDataBundles_1_list{list: &x.WorkerDataBundles}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WorkerDataBundles) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
+		list := []*WorkerDataBundle{}
+		return protoreflect.ValueOfList(&_WorkerDataBundles_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+		}
+		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WorkerDataBundles) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerDataBundles", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WorkerDataBundles) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WorkerDataBundles) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WorkerDataBundles) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WorkerDataBundles)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.WorkerDataBundles) > 0 {
+			for _, e := range x.WorkerDataBundles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerDataBundles)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.WorkerDataBundles) > 0 {
+			for iNdEx := len(x.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WorkerDataBundles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WorkerDataBundles)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 4:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundles: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundles: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceForecastsBundle", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4545,79 +5715,11 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.InferenceForecastsBundle == nil {
-					x.InferenceForecastsBundle = &InferenceForecastBundle{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InferenceForecastsBundle); err != nil {
+				x.WorkerDataBundles = append(x.WorkerDataBundles, &WorkerDataBundle{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WorkerDataBundles[len(x.WorkerDataBundles)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferencesForecastsBundleSignature", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.InferencesForecastsBundleSignature = append(x.InferencesForecastsBundleSignature[:0], dAtA[iNdEx:postIndex]...)
-				if x.InferencesForecastsBundleSignature == nil {
-					x.InferencesForecastsBundleSignature = []byte{}
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Pubkey = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4653,78 +5755,33 @@ func (x *fastReflection_WorkerDataBundle) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_WorkerDataBundles_1_list)(nil)
-
-type _WorkerDataBundles_1_list struct {
-	list *[]*WorkerDataBundle
-}
-
-func (x *_WorkerDataBundles_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_WorkerDataBundles_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_WorkerDataBundles_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WorkerDataBundle)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_WorkerDataBundles_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WorkerDataBundle)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_WorkerDataBundles_1_list) AppendMutable() protoreflect.Value {
-	v := new(WorkerDataBundle)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_WorkerDataBundles_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_WorkerDataBundles_1_list) NewElement() protoreflect.Value {
-	v := new(WorkerDataBundle)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_WorkerDataBundles_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_WorkerDataBundles                     protoreflect.MessageDescriptor
-	fd_WorkerDataBundles_worker_data_bundles protoreflect.FieldDescriptor
+	md_WorkerCommitment                    protoreflect.MessageDescriptor
+	fd_WorkerCommitment_topic_id           protoreflect.FieldDescriptor
+	fd_WorkerCommitment_nonce_block_height protoreflect.FieldDescriptor
+	fd_WorkerCommitment_worker             protoreflect.FieldDescriptor
+	fd_WorkerCommitment_commitment         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v3_worker_proto_init()
-	md_WorkerDataBundles = File_emissions_v3_worker_proto.Messages().ByName("WorkerDataBundles")
-	fd_WorkerDataBundles_worker_data_bundles = md_WorkerDataBundles.Fields().ByName("worker_data_bundles")
+	md_WorkerCommitment = File_emissions_v3_worker_proto.Messages().ByName("WorkerCommitment")
+	fd_WorkerCommitment_topic_id = md_WorkerCommitment.Fields().ByName("topic_id")
+	fd_WorkerCommitment_nonce_block_height = md_WorkerCommitment.Fields().ByName("nonce_block_height")
+	fd_WorkerCommitment_worker = md_WorkerCommitment.Fields().ByName("worker")
+	fd_WorkerCommitment_commitment = md_WorkerCommitment.Fields().ByName("commitment")
 }
 
-var _ protoreflect.Message = (*fastReflection_WorkerDataBundles)(nil)
+var _ protoreflect.Message = (*fastReflection_WorkerCommitment)(nil)
 
-type fastReflection_WorkerDataBundles WorkerDataBundles
+type fastReflection_WorkerCommitment WorkerCommitment
 
-func (x *WorkerDataBundles) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_WorkerDataBundles)(x)
+func (x *WorkerCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WorkerCommitment)(x)
 }
 
-func (x *WorkerDataBundles) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v3_worker_proto_msgTypes[8]
+func (x *WorkerCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v3_worker_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4735,43 +5792,43 @@ func (x *WorkerDataBundles) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_WorkerDataBundles_messageType fastReflection_WorkerDataBundles_messageType
-var _ protoreflect.MessageType = fastReflection_WorkerDataBundles_messageType{}
+var _fastReflection_WorkerCommitment_messageType fastReflection_WorkerCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_WorkerCommitment_messageType{}
 
-type fastReflection_WorkerDataBundles_messageType struct{}
+type fastReflection_WorkerCommitment_messageType struct{}
 
-func (x fastReflection_WorkerDataBundles_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_WorkerDataBundles)(nil)
+func (x fastReflection_WorkerCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WorkerCommitment)(nil)
 }
-func (x fastReflection_WorkerDataBundles_messageType) New() protoreflect.Message {
-	return new(fastReflection_WorkerDataBundles)
+func (x fastReflection_WorkerCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_WorkerCommitment)
 }
-func (x fastReflection_WorkerDataBundles_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_WorkerDataBundles
+func (x fastReflection_WorkerCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerCommitment
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_WorkerDataBundles) Descriptor() protoreflect.MessageDescriptor {
-	return md_WorkerDataBundles
+func (x *fastReflection_WorkerCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_WorkerCommitment
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_WorkerDataBundles) Type() protoreflect.MessageType {
-	return _fastReflection_WorkerDataBundles_messageType
+func (x *fastReflection_WorkerCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_WorkerCommitment_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_WorkerDataBundles) New() protoreflect.Message {
-	return new(fastReflection_WorkerDataBundles)
+func (x *fastReflection_WorkerCommitment) New() protoreflect.Message {
+	return new(fastReflection_WorkerCommitment)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_WorkerDataBundles) Interface() protoreflect.ProtoMessage {
-	return (*WorkerDataBundles)(x)
+func (x *fastReflection_WorkerCommitment) Interface() protoreflect.ProtoMessage {
+	return (*WorkerCommitment)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4779,10 +5836,28 @@ func (x *fastReflection_WorkerDataBundles) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_WorkerDataBundles) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.WorkerDataBundles) != 0 {
-		value := protoreflect.ValueOfList(&_WorkerDataBundles_1_list{list: &x.WorkerDataBundles})
-		if !f(fd_WorkerDataBundles_worker_data_bundles, value) {
+func (x *fastReflection_WorkerCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_WorkerCommitment_topic_id, value) {
+			return
+		}
+	}
+	if x.NonceBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NonceBlockHeight)
+		if !f(fd_WorkerCommitment_nonce_block_height, value) {
+			return
+		}
+	}
+	if x.Worker != "" {
+		value := protoreflect.ValueOfString(x.Worker)
+		if !f(fd_WorkerCommitment_worker, value) {
+			return
+		}
+	}
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commitment)
+		if !f(fd_WorkerCommitment_commitment, value) {
 			return
 		}
 	}
@@ -4799,15 +5874,21 @@ func (x *fastReflection_WorkerDataBundles) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_WorkerDataBundles) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_WorkerCommitment) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
-		return len(x.WorkerDataBundles) != 0
+	case "emissions.v3.WorkerCommitment.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v3.WorkerCommitment.nonce_block_height":
+		return x.NonceBlockHeight != int64(0)
+	case "emissions.v3.WorkerCommitment.worker":
+		return x.Worker != ""
+	case "emissions.v3.WorkerCommitment.commitment":
+		return len(x.Commitment) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerCommitment"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4817,15 +5898,21 @@ func (x *fastReflection_WorkerDataBundles) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundles) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_WorkerCommitment) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
-		x.WorkerDataBundles = nil
+	case "emissions.v3.WorkerCommitment.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v3.WorkerCommitment.nonce_block_height":
+		x.NonceBlockHeight = int64(0)
+	case "emissions.v3.WorkerCommitment.worker":
+		x.Worker = ""
+	case "emissions.v3.WorkerCommitment.commitment":
+		x.Commitment = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerCommitment"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4835,19 +5922,25 @@ func (x *fastReflection_WorkerDataBundles) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_WorkerDataBundles) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WorkerCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
-		if len(x.WorkerDataBundles) == 0 {
-			return protoreflect.ValueOfList(&_WorkerDataBundles_1_list{})
-		}
-		listValue := &_WorkerDataBundles_1_list{list: &x.WorkerDataBundles}
-		return protoreflect.ValueOfList(listValue)
+	case "emissions.v3.WorkerCommitment.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v3.WorkerCommitment.nonce_block_height":
+		value := x.NonceBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v3.WorkerCommitment.worker":
+		value := x.Worker
+		return protoreflect.ValueOfString(value)
+	case "emissions.v3.WorkerCommitment.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerCommitment"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerCommitment does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4861,17 +5954,21 @@ func (x *fastReflection_WorkerDataBundles) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundles) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_WorkerCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
-		lv := value.List()
-		clv := lv.(*_WorkerDataBundles_1_list)
-		x.WorkerDataBundles = *clv.list
+	case "emissions.v3.WorkerCommitment.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v3.WorkerCommitment.nonce_block_height":
+		x.NonceBlockHeight = value.Int()
+	case "emissions.v3.WorkerCommitment.worker":
+		x.Worker = value.Interface().(string)
+	case "emissions.v3.WorkerCommitment.commitment":
+		x.Commitment = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerCommitment"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4885,45 +5982,52 @@ func (x *fastReflection_WorkerDataBundles) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundles) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WorkerCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
-		if x.WorkerDataBundles == nil {
-			x.WorkerDataBundles = []*WorkerDataBundle{}
-		}
-		value := &_WorkerDataBundles_1_list{list: &x.WorkerDataBundles}
-		return protoreflect.ValueOfList(value)
+	case "emissions.v3.WorkerCommitment.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v3.WorkerCommitment is not mutable"))
+	case "emissions.v3.WorkerCommitment.nonce_block_height":
+		panic(fmt.Errorf("field nonce_block_height of message emissions.v3.WorkerCommitment is not mutable"))
+	case "emissions.v3.WorkerCommitment.worker":
+		panic(fmt.Errorf("field worker of message emissions.v3.WorkerCommitment is not mutable"))
+	case "emissions.v3.WorkerCommitment.commitment":
+		panic(fmt.Errorf("field commitment of message emissions.v3.WorkerCommitment is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerCommitment"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_WorkerDataBundles) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WorkerCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v3.WorkerDataBundles.worker_data_bundles":
-		list := []*WorkerDataBundle{}
-		return protoreflect.ValueOfList(&_WorkerDataBundles_1_list{list: &list})
+	case "emissions.v3.WorkerCommitment.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v3.WorkerCommitment.nonce_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v3.WorkerCommitment.worker":
+		return protoreflect.ValueOfString("")
+	case "emissions.v3.WorkerCommitment.commitment":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerDataBundles"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v3.WorkerCommitment"))
 		}
-		panic(fmt.Errorf("message emissions.v3.WorkerDataBundles does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v3.WorkerCommitment does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_WorkerDataBundles) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_WorkerCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerDataBundles", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v3.WorkerCommitment", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4931,7 +6035,7 @@ func (x *fastReflection_WorkerDataBundles) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_WorkerDataBundles) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_WorkerCommitment) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4942,7 +6046,7 @@ func (x *fastReflection_WorkerDataBundles) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WorkerDataBundles) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_WorkerCommitment) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4954,7 +6058,7 @@ func (x *fastReflection_WorkerDataBundles) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_WorkerDataBundles) IsValid() bool {
+func (x *fastReflection_WorkerCommitment) IsValid() bool {
 	return x != nil
 }
 
@@ -4964,9 +6068,9 @@ func (x *fastReflection_WorkerDataBundles) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_WorkerCommitment) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*WorkerDataBundles)
+		x := input.Message.Interface().(*WorkerCommitment)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4978,11 +6082,19 @@ func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.WorkerDataBundles) > 0 {
-			for _, e := range x.WorkerDataBundles {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.NonceBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NonceBlockHeight))
+		}
+		l = len(x.Worker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4994,7 +6106,7 @@ func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*WorkerDataBundles)
+		x := input.Message.Interface().(*WorkerCommitment)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5013,21 +6125,29 @@ func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.WorkerDataBundles) > 0 {
-			for iNdEx := len(x.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.WorkerDataBundles[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Worker) > 0 {
+			i -= len(x.Worker)
+			copy(dAtA[i:], x.Worker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Worker)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NonceBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NonceBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5040,7 +6160,7 @@ func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*WorkerDataBundles)
+		x := input.Message.Interface().(*WorkerCommitment)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5072,17 +6192,55 @@ func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundles: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerCommitment: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerDataBundles: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WorkerCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+				}
+				x.NonceBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NonceBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5092,24 +6250,56 @@ func (x *fastReflection_WorkerDataBundles) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WorkerDataBundles = append(x.WorkerDataBundles, &WorkerDataBundle{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WorkerDataBundles[len(x.WorkerDataBundles)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Worker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = append(x.Commitment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commitment == nil {
+					x.Commitment = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -5214,6 +6404,9 @@ type Inference struct {
 	Value       string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 	ExtraData   []byte `protobuf:"bytes,5,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
 	Proof       string `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	// One value per output of a topic whose output dimension is greater than 1,
+	// in which case value is left unset
+	Values []string `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`
 }
 
 func (x *Inference) Reset() {
@@ -5278,6 +6471,13 @@ func (x *Inference) GetProof() string {
 	return ""
 }
 
+func (x *Inference) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type Inferences struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5313,6 +6513,53 @@ func (x *Inferences) GetInferences() []*Inference {
 	return nil
 }
 
+// Value of the quantile of a distribution at a level in (0, 1)
+type Quantile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Quantile) Reset() {
+	*x = Quantile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_worker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quantile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quantile) ProtoMessage() {}
+
+// Deprecated: Use Quantile.ProtoReflect.Descriptor instead.
+func (*Quantile) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Quantile) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Quantile) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Forecasted loss of an inferer. The losses of a topic are defined over all of
+// its outputs, so forecast elements hold a single value whatever the output
+// dimension of the topic.
 type ForecastElement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5325,7 +6572,7 @@ type ForecastElement struct {
 func (x *ForecastElement) Reset() {
 	*x = ForecastElement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_worker_proto_msgTypes[3]
+		mi := &file_emissions_v3_worker_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5339,7 +6586,7 @@ func (*ForecastElement) ProtoMessage() {}
 
 // Deprecated: Use ForecastElement.ProtoReflect.Descriptor instead.
 func (*ForecastElement) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{3}
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ForecastElement) GetInferer() string {
@@ -5371,7 +6618,7 @@ type Forecast struct {
 func (x *Forecast) Reset() {
 	*x = Forecast{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_worker_proto_msgTypes[4]
+		mi := &file_emissions_v3_worker_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5385,7 +6632,7 @@ func (*Forecast) ProtoMessage() {}
 
 // Deprecated: Use Forecast.ProtoReflect.Descriptor instead.
 func (*Forecast) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{4}
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Forecast) GetTopicId() uint64 {
@@ -5434,7 +6681,7 @@ type Forecasts struct {
 func (x *Forecasts) Reset() {
 	*x = Forecasts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_worker_proto_msgTypes[5]
+		mi := &file_emissions_v3_worker_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5448,7 +6695,7 @@ func (*Forecasts) ProtoMessage() {}
 
 // Deprecated: Use Forecasts.ProtoReflect.Descriptor instead.
 func (*Forecasts) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{5}
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Forecasts) GetForecasts() []*Forecast {
@@ -5470,7 +6717,7 @@ type InferenceForecastBundle struct {
 func (x *InferenceForecastBundle) Reset() {
 	*x = InferenceForecastBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_worker_proto_msgTypes[6]
+		mi := &file_emissions_v3_worker_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5484,7 +6731,7 @@ func (*InferenceForecastBundle) ProtoMessage() {}
 
 // Deprecated: Use InferenceForecastBundle.ProtoReflect.Descriptor instead.
 func (*InferenceForecastBundle) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{6}
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InferenceForecastBundle) GetInference() *Inference {
@@ -5517,7 +6764,7 @@ type WorkerDataBundle struct {
 func (x *WorkerDataBundle) Reset() {
 	*x = WorkerDataBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_worker_proto_msgTypes[7]
+		mi := &file_emissions_v3_worker_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5531,7 +6778,7 @@ func (*WorkerDataBundle) ProtoMessage() {}
 
 // Deprecated: Use WorkerDataBundle.ProtoReflect.Descriptor instead.
 func (*WorkerDataBundle) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{7}
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WorkerDataBundle) GetWorker() string {
@@ -5587,7 +6834,7 @@ type WorkerDataBundles struct {
 func (x *WorkerDataBundles) Reset() {
 	*x = WorkerDataBundles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v3_worker_proto_msgTypes[8]
+		mi := &file_emissions_v3_worker_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5601,7 +6848,7 @@ func (*WorkerDataBundles) ProtoMessage() {}
 
 // Deprecated: Use WorkerDataBundles.ProtoReflect.Descriptor instead.
 func (*WorkerDataBundles) Descriptor() ([]byte, []int) {
-	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{8}
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WorkerDataBundles) GetWorkerDataBundles() []*WorkerDataBundle {
@@ -5611,6 +6858,68 @@ func (x *WorkerDataBundles) GetWorkerDataBundles() []*WorkerDataBundle {
 	return nil
 }
 
+// Hash a worker committed to in the submission window of a worker nonce, for
+// the inference forecast bundle it reveals in the reveal window of the nonce
+type WorkerCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId          uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64  `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	Worker           string `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	// sha256 of the serialized inference forecast bundle followed by a salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *WorkerCommitment) Reset() {
+	*x = WorkerCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v3_worker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerCommitment) ProtoMessage() {}
+
+// Deprecated: Use WorkerCommitment.ProtoReflect.Descriptor instead.
+func (*WorkerCommitment) Descriptor() ([]byte, []int) {
+	return file_emissions_v3_worker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkerCommitment) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *WorkerCommitment) GetNonceBlockHeight() int64 {
+	if x != nil {
+		return x.NonceBlockHeight
+	}
+	return 0
+}
+
+func (x *WorkerCommitment) GetWorker() string {
+	if x != nil {
+		return x.Worker
+	}
+	return ""
+}
+
+func (x *WorkerCommitment) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
 var File_emissions_v3_worker_proto protoreflect.FileDescriptor
 
 var file_emissions_v3_worker_proto_rawDesc = []byte{
@@ -5628,7 +6937,7 @@ var file_emissions_v3_worker_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xed, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xbe, 0x02, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x65, 0x72,
 	0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12,
 	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
@@ -5643,86 +6952,111 @@ var file_emissions_v3_worker_proto_rawDesc = []byte{
 	0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
 	0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
 	0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x45, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x80, 0x01,
-	0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0x12, 0x4f, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x45, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xae,
+	0x01, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
 	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
 	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x22, 0xd9, 0x01, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
-	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x11, 0x66,
-	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x45, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x45,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41, 0x0a, 0x09,
-	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x66, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65,
-	0x63, 0x61, 0x73, 0x74, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x22,
-	0x8a, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08, 0x66, 0x6f,
-	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc6, 0x02, 0x0a,
-	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12,
-	0x63, 0x0a, 0x1a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x65,
-	0x63, 0x61, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x18, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x42, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x25, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x5f, 0x62, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x22, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x46,
-	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x63, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x13, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63,
-	0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42,
-	0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
-	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
-	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2,
-	0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c,
-	0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x65, 0x63, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4d, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22,
+	0x80, 0x01, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x12, 0x4d, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xd9, 0x01, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4a, 0x0a,
+	0x11, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x41,
+	0x0a, 0x09, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x66,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x73, 0x22, 0x8a, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x35, 0x0a,
+	0x09, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc6,
+	0x02, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x63, 0x0a, 0x1a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x18, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x25, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x5f, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x22, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x63, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x13,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x33, 0x42, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78,
+	0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x33, 0x3b, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x33, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x0c, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x18, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5737,33 +7071,35 @@ func file_emissions_v3_worker_proto_rawDescGZIP() []byte {
 	return file_emissions_v3_worker_proto_rawDescData
 }
 
-var file_emissions_v3_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_emissions_v3_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_emissions_v3_worker_proto_goTypes = []interface{}{
 	(*TimestampedValue)(nil),        // 0: emissions.v3.TimestampedValue
 	(*Inference)(nil),               // 1: emissions.v3.Inference
 	(*Inferences)(nil),              // 2: emissions.v3.Inferences
-	(*ForecastElement)(nil),         // 3: emissions.v3.ForecastElement
-	(*Forecast)(nil),                // 4: emissions.v3.Forecast
-	(*Forecasts)(nil),               // 5: emissions.v3.Forecasts
-	(*InferenceForecastBundle)(nil), // 6: emissions.v3.InferenceForecastBundle
-	(*WorkerDataBundle)(nil),        // 7: emissions.v3.WorkerDataBundle
-	(*WorkerDataBundles)(nil),       // 8: emissions.v3.WorkerDataBundles
-	(*Nonce)(nil),                   // 9: emissions.v3.Nonce
+	(*Quantile)(nil),                // 3: emissions.v3.Quantile
+	(*ForecastElement)(nil),         // 4: emissions.v3.ForecastElement
+	(*Forecast)(nil),                // 5: emissions.v3.Forecast
+	(*Forecasts)(nil),               // 6: emissions.v3.Forecasts
+	(*InferenceForecastBundle)(nil), // 7: emissions.v3.InferenceForecastBundle
+	(*WorkerDataBundle)(nil),        // 8: emissions.v3.WorkerDataBundle
+	(*WorkerDataBundles)(nil),       // 9: emissions.v3.WorkerDataBundles
+	(*WorkerCommitment)(nil),        // 10: emissions.v3.WorkerCommitment
+	(*Nonce)(nil),                   // 11: emissions.v3.Nonce
 }
 var file_emissions_v3_worker_proto_depIdxs = []int32{
-	1, // 0: emissions.v3.Inferences.inferences:type_name -> emissions.v3.Inference
-	3, // 1: emissions.v3.Forecast.forecast_elements:type_name -> emissions.v3.ForecastElement
-	4, // 2: emissions.v3.Forecasts.forecasts:type_name -> emissions.v3.Forecast
-	1, // 3: emissions.v3.InferenceForecastBundle.inference:type_name -> emissions.v3.Inference
-	4, // 4: emissions.v3.InferenceForecastBundle.forecast:type_name -> emissions.v3.Forecast
-	9, // 5: emissions.v3.WorkerDataBundle.nonce:type_name -> emissions.v3.Nonce
-	6, // 6: emissions.v3.WorkerDataBundle.inference_forecasts_bundle:type_name -> emissions.v3.InferenceForecastBundle
-	7, // 7: emissions.v3.WorkerDataBundles.worker_data_bundles:type_name -> emissions.v3.WorkerDataBundle
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	1,  // 0: emissions.v3.Inferences.inferences:type_name -> emissions.v3.Inference
+	4,  // 1: emissions.v3.Forecast.forecast_elements:type_name -> emissions.v3.ForecastElement
+	5,  // 2: emissions.v3.Forecasts.forecasts:type_name -> emissions.v3.Forecast
+	1,  // 3: emissions.v3.InferenceForecastBundle.inference:type_name -> emissions.v3.Inference
+	5,  // 4: emissions.v3.InferenceForecastBundle.forecast:type_name -> emissions.v3.Forecast
+	11, // 5: emissions.v3.WorkerDataBundle.nonce:type_name -> emissions.v3.Nonce
+	7,  // 6: emissions.v3.WorkerDataBundle.inference_forecasts_bundle:type_name -> emissions.v3.InferenceForecastBundle
+	8,  // 7: emissions.v3.WorkerDataBundles.worker_data_bundles:type_name -> emissions.v3.WorkerDataBundle
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v3_worker_proto_init() }
@@ -5810,7 +7146,7 @@ func file_emissions_v3_worker_proto_init() {
 			}
 		}
 		file_emissions_v3_worker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForecastElement); i {
+			switch v := v.(*Quantile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5822,7 +7158,7 @@ func file_emissions_v3_worker_proto_init() {
 			}
 		}
 		file_emissions_v3_worker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Forecast); i {
+			switch v := v.(*ForecastElement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5834,7 +7170,7 @@ func file_emissions_v3_worker_proto_init() {
 			}
 		}
 		file_emissions_v3_worker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Forecasts); i {
+			switch v := v.(*Forecast); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5846,7 +7182,7 @@ func file_emissions_v3_worker_proto_init() {
 			}
 		}
 		file_emissions_v3_worker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InferenceForecastBundle); i {
+			switch v := v.(*Forecasts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5858,7 +7194,7 @@ func file_emissions_v3_worker_proto_init() {
 			}
 		}
 		file_emissions_v3_worker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkerDataBundle); i {
+			switch v := v.(*InferenceForecastBundle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5870,6 +7206,18 @@ func file_emissions_v3_worker_proto_init() {
 			}
 		}
 		file_emissions_v3_worker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerDataBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v3_worker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkerDataBundles); i {
 			case 0:
 				return &v.state
@@ -5881,6 +7229,18 @@ func file_emissions_v3_worker_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v3_worker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5888,7 +7248,7 @@ func file_emissions_v3_worker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v3_worker_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
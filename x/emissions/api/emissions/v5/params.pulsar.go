@@ -60,6 +60,18 @@ var (
 	fd_Params_max_string_length                         protoreflect.FieldDescriptor
 	fd_Params_initial_regret_quantile                   protoreflect.FieldDescriptor
 	fd_Params_p_norm_safe_div                           protoreflect.FieldDescriptor
+	fd_Params_max_prune_archived_topic_keys_end_block   protoreflect.FieldDescriptor
+	fd_Params_max_funding_subscription_drips_end_block  protoreflect.FieldDescriptor
+	fd_Params_max_topic_creator_commission              protoreflect.FieldDescriptor
+	fd_Params_node_rotation_cooldown                    protoreflect.FieldDescriptor
+	fd_Params_reputer_outlier_slash_epochs              protoreflect.FieldDescriptor
+	fd_Params_reputer_outlier_slash_fraction            protoreflect.FieldDescriptor
+	fd_Params_max_missed_epochs_jail                    protoreflect.FieldDescriptor
+	fd_Params_max_missed_epochs_deregister              protoreflect.FieldDescriptor
+	fd_Params_reputer_commission_update_cooldown        protoreflect.FieldDescriptor
+	fd_Params_max_delegate_to_self_stake_ratio          protoreflect.FieldDescriptor
+	fd_Params_max_delegate_stake_per_reputer            protoreflect.FieldDescriptor
+	fd_Params_max_auto_compound_delegations_per_payout  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -108,6 +120,18 @@ func init() {
 	fd_Params_max_string_length = md_Params.Fields().ByName("max_string_length")
 	fd_Params_initial_regret_quantile = md_Params.Fields().ByName("initial_regret_quantile")
 	fd_Params_p_norm_safe_div = md_Params.Fields().ByName("p_norm_safe_div")
+	fd_Params_max_prune_archived_topic_keys_end_block = md_Params.Fields().ByName("max_prune_archived_topic_keys_end_block")
+	fd_Params_max_funding_subscription_drips_end_block = md_Params.Fields().ByName("max_funding_subscription_drips_end_block")
+	fd_Params_max_topic_creator_commission = md_Params.Fields().ByName("max_topic_creator_commission")
+	fd_Params_node_rotation_cooldown = md_Params.Fields().ByName("node_rotation_cooldown")
+	fd_Params_reputer_outlier_slash_epochs = md_Params.Fields().ByName("reputer_outlier_slash_epochs")
+	fd_Params_reputer_outlier_slash_fraction = md_Params.Fields().ByName("reputer_outlier_slash_fraction")
+	fd_Params_max_missed_epochs_jail = md_Params.Fields().ByName("max_missed_epochs_jail")
+	fd_Params_max_missed_epochs_deregister = md_Params.Fields().ByName("max_missed_epochs_deregister")
+	fd_Params_reputer_commission_update_cooldown = md_Params.Fields().ByName("reputer_commission_update_cooldown")
+	fd_Params_max_delegate_to_self_stake_ratio = md_Params.Fields().ByName("max_delegate_to_self_stake_ratio")
+	fd_Params_max_delegate_stake_per_reputer = md_Params.Fields().ByName("max_delegate_stake_per_reputer")
+	fd_Params_max_auto_compound_delegations_per_payout = md_Params.Fields().ByName("max_auto_compound_delegations_per_payout")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -433,6 +457,78 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxPruneArchivedTopicKeysEndBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPruneArchivedTopicKeysEndBlock)
+		if !f(fd_Params_max_prune_archived_topic_keys_end_block, value) {
+			return
+		}
+	}
+	if x.MaxFundingSubscriptionDripsEndBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxFundingSubscriptionDripsEndBlock)
+		if !f(fd_Params_max_funding_subscription_drips_end_block, value) {
+			return
+		}
+	}
+	if x.MaxTopicCreatorCommission != "" {
+		value := protoreflect.ValueOfString(x.MaxTopicCreatorCommission)
+		if !f(fd_Params_max_topic_creator_commission, value) {
+			return
+		}
+	}
+	if x.NodeRotationCooldown != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NodeRotationCooldown)
+		if !f(fd_Params_node_rotation_cooldown, value) {
+			return
+		}
+	}
+	if x.ReputerOutlierSlashEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReputerOutlierSlashEpochs)
+		if !f(fd_Params_reputer_outlier_slash_epochs, value) {
+			return
+		}
+	}
+	if x.ReputerOutlierSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.ReputerOutlierSlashFraction)
+		if !f(fd_Params_reputer_outlier_slash_fraction, value) {
+			return
+		}
+	}
+	if x.MaxMissedEpochsJail != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedEpochsJail)
+		if !f(fd_Params_max_missed_epochs_jail, value) {
+			return
+		}
+	}
+	if x.MaxMissedEpochsDeregister != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedEpochsDeregister)
+		if !f(fd_Params_max_missed_epochs_deregister, value) {
+			return
+		}
+	}
+	if x.ReputerCommissionUpdateCooldown != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ReputerCommissionUpdateCooldown)
+		if !f(fd_Params_reputer_commission_update_cooldown, value) {
+			return
+		}
+	}
+	if x.MaxDelegateToSelfStakeRatio != "" {
+		value := protoreflect.ValueOfString(x.MaxDelegateToSelfStakeRatio)
+		if !f(fd_Params_max_delegate_to_self_stake_ratio, value) {
+			return
+		}
+	}
+	if x.MaxDelegateStakePerReputer != "" {
+		value := protoreflect.ValueOfString(x.MaxDelegateStakePerReputer)
+		if !f(fd_Params_max_delegate_stake_per_reputer, value) {
+			return
+		}
+	}
+	if x.MaxAutoCompoundDelegationsPerPayout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAutoCompoundDelegationsPerPayout)
+		if !f(fd_Params_max_auto_compound_delegations_per_payout, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -534,6 +630,30 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InitialRegretQuantile != ""
 	case "emissions.v5.Params.p_norm_safe_div":
 		return x.PNormSafeDiv != ""
+	case "emissions.v5.Params.max_prune_archived_topic_keys_end_block":
+		return x.MaxPruneArchivedTopicKeysEndBlock != uint64(0)
+	case "emissions.v5.Params.max_funding_subscription_drips_end_block":
+		return x.MaxFundingSubscriptionDripsEndBlock != uint64(0)
+	case "emissions.v5.Params.max_topic_creator_commission":
+		return x.MaxTopicCreatorCommission != ""
+	case "emissions.v5.Params.node_rotation_cooldown":
+		return x.NodeRotationCooldown != int64(0)
+	case "emissions.v5.Params.reputer_outlier_slash_epochs":
+		return x.ReputerOutlierSlashEpochs != uint64(0)
+	case "emissions.v5.Params.reputer_outlier_slash_fraction":
+		return x.ReputerOutlierSlashFraction != ""
+	case "emissions.v5.Params.max_missed_epochs_jail":
+		return x.MaxMissedEpochsJail != uint64(0)
+	case "emissions.v5.Params.max_missed_epochs_deregister":
+		return x.MaxMissedEpochsDeregister != uint64(0)
+	case "emissions.v5.Params.reputer_commission_update_cooldown":
+		return x.ReputerCommissionUpdateCooldown != int64(0)
+	case "emissions.v5.Params.max_delegate_to_self_stake_ratio":
+		return x.MaxDelegateToSelfStakeRatio != ""
+	case "emissions.v5.Params.max_delegate_stake_per_reputer":
+		return x.MaxDelegateStakePerReputer != ""
+	case "emissions.v5.Params.max_auto_compound_delegations_per_payout":
+		return x.MaxAutoCompoundDelegationsPerPayout != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -636,6 +756,30 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InitialRegretQuantile = ""
 	case "emissions.v5.Params.p_norm_safe_div":
 		x.PNormSafeDiv = ""
+	case "emissions.v5.Params.max_prune_archived_topic_keys_end_block":
+		x.MaxPruneArchivedTopicKeysEndBlock = uint64(0)
+	case "emissions.v5.Params.max_funding_subscription_drips_end_block":
+		x.MaxFundingSubscriptionDripsEndBlock = uint64(0)
+	case "emissions.v5.Params.max_topic_creator_commission":
+		x.MaxTopicCreatorCommission = ""
+	case "emissions.v5.Params.node_rotation_cooldown":
+		x.NodeRotationCooldown = int64(0)
+	case "emissions.v5.Params.reputer_outlier_slash_epochs":
+		x.ReputerOutlierSlashEpochs = uint64(0)
+	case "emissions.v5.Params.reputer_outlier_slash_fraction":
+		x.ReputerOutlierSlashFraction = ""
+	case "emissions.v5.Params.max_missed_epochs_jail":
+		x.MaxMissedEpochsJail = uint64(0)
+	case "emissions.v5.Params.max_missed_epochs_deregister":
+		x.MaxMissedEpochsDeregister = uint64(0)
+	case "emissions.v5.Params.reputer_commission_update_cooldown":
+		x.ReputerCommissionUpdateCooldown = int64(0)
+	case "emissions.v5.Params.max_delegate_to_self_stake_ratio":
+		x.MaxDelegateToSelfStakeRatio = ""
+	case "emissions.v5.Params.max_delegate_stake_per_reputer":
+		x.MaxDelegateStakePerReputer = ""
+	case "emissions.v5.Params.max_auto_compound_delegations_per_payout":
+		x.MaxAutoCompoundDelegationsPerPayout = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -781,6 +925,42 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "emissions.v5.Params.p_norm_safe_div":
 		value := x.PNormSafeDiv
 		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.max_prune_archived_topic_keys_end_block":
+		value := x.MaxPruneArchivedTopicKeysEndBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.max_funding_subscription_drips_end_block":
+		value := x.MaxFundingSubscriptionDripsEndBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.max_topic_creator_commission":
+		value := x.MaxTopicCreatorCommission
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.node_rotation_cooldown":
+		value := x.NodeRotationCooldown
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v5.Params.reputer_outlier_slash_epochs":
+		value := x.ReputerOutlierSlashEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.reputer_outlier_slash_fraction":
+		value := x.ReputerOutlierSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.max_missed_epochs_jail":
+		value := x.MaxMissedEpochsJail
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.max_missed_epochs_deregister":
+		value := x.MaxMissedEpochsDeregister
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v5.Params.reputer_commission_update_cooldown":
+		value := x.ReputerCommissionUpdateCooldown
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v5.Params.max_delegate_to_self_stake_ratio":
+		value := x.MaxDelegateToSelfStakeRatio
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.max_delegate_stake_per_reputer":
+		value := x.MaxDelegateStakePerReputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v5.Params.max_auto_compound_delegations_per_payout":
+		value := x.MaxAutoCompoundDelegationsPerPayout
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -887,6 +1067,30 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.InitialRegretQuantile = value.Interface().(string)
 	case "emissions.v5.Params.p_norm_safe_div":
 		x.PNormSafeDiv = value.Interface().(string)
+	case "emissions.v5.Params.max_prune_archived_topic_keys_end_block":
+		x.MaxPruneArchivedTopicKeysEndBlock = value.Uint()
+	case "emissions.v5.Params.max_funding_subscription_drips_end_block":
+		x.MaxFundingSubscriptionDripsEndBlock = value.Uint()
+	case "emissions.v5.Params.max_topic_creator_commission":
+		x.MaxTopicCreatorCommission = value.Interface().(string)
+	case "emissions.v5.Params.node_rotation_cooldown":
+		x.NodeRotationCooldown = value.Int()
+	case "emissions.v5.Params.reputer_outlier_slash_epochs":
+		x.ReputerOutlierSlashEpochs = value.Uint()
+	case "emissions.v5.Params.reputer_outlier_slash_fraction":
+		x.ReputerOutlierSlashFraction = value.Interface().(string)
+	case "emissions.v5.Params.max_missed_epochs_jail":
+		x.MaxMissedEpochsJail = value.Uint()
+	case "emissions.v5.Params.max_missed_epochs_deregister":
+		x.MaxMissedEpochsDeregister = value.Uint()
+	case "emissions.v5.Params.reputer_commission_update_cooldown":
+		x.ReputerCommissionUpdateCooldown = value.Int()
+	case "emissions.v5.Params.max_delegate_to_self_stake_ratio":
+		x.MaxDelegateToSelfStakeRatio = value.Interface().(string)
+	case "emissions.v5.Params.max_delegate_stake_per_reputer":
+		x.MaxDelegateStakePerReputer = value.Interface().(string)
+	case "emissions.v5.Params.max_auto_compound_delegations_per_payout":
+		x.MaxAutoCompoundDelegationsPerPayout = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -993,6 +1197,30 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field initial_regret_quantile of message emissions.v5.Params is not mutable"))
 	case "emissions.v5.Params.p_norm_safe_div":
 		panic(fmt.Errorf("field p_norm_safe_div of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_prune_archived_topic_keys_end_block":
+		panic(fmt.Errorf("field max_prune_archived_topic_keys_end_block of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_funding_subscription_drips_end_block":
+		panic(fmt.Errorf("field max_funding_subscription_drips_end_block of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_topic_creator_commission":
+		panic(fmt.Errorf("field max_topic_creator_commission of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.node_rotation_cooldown":
+		panic(fmt.Errorf("field node_rotation_cooldown of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.reputer_outlier_slash_epochs":
+		panic(fmt.Errorf("field reputer_outlier_slash_epochs of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.reputer_outlier_slash_fraction":
+		panic(fmt.Errorf("field reputer_outlier_slash_fraction of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_missed_epochs_jail":
+		panic(fmt.Errorf("field max_missed_epochs_jail of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_missed_epochs_deregister":
+		panic(fmt.Errorf("field max_missed_epochs_deregister of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.reputer_commission_update_cooldown":
+		panic(fmt.Errorf("field reputer_commission_update_cooldown of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_delegate_to_self_stake_ratio":
+		panic(fmt.Errorf("field max_delegate_to_self_stake_ratio of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_delegate_stake_per_reputer":
+		panic(fmt.Errorf("field max_delegate_stake_per_reputer of message emissions.v5.Params is not mutable"))
+	case "emissions.v5.Params.max_auto_compound_delegations_per_payout":
+		panic(fmt.Errorf("field max_auto_compound_delegations_per_payout of message emissions.v5.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -1092,6 +1320,30 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "emissions.v5.Params.p_norm_safe_div":
 		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.max_prune_archived_topic_keys_end_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.max_funding_subscription_drips_end_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.max_topic_creator_commission":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.node_rotation_cooldown":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v5.Params.reputer_outlier_slash_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.reputer_outlier_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.max_missed_epochs_jail":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.max_missed_epochs_deregister":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v5.Params.reputer_commission_update_cooldown":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v5.Params.max_delegate_to_self_stake_ratio":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.max_delegate_stake_per_reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v5.Params.max_auto_compound_delegations_per_payout":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v5.Params"))
@@ -1315,6 +1567,46 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxPruneArchivedTopicKeysEndBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPruneArchivedTopicKeysEndBlock))
+		}
+		if x.MaxFundingSubscriptionDripsEndBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxFundingSubscriptionDripsEndBlock))
+		}
+		l = len(x.MaxTopicCreatorCommission)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.NodeRotationCooldown != 0 {
+			n += 2 + runtime.Sov(uint64(x.NodeRotationCooldown))
+		}
+		if x.ReputerOutlierSlashEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReputerOutlierSlashEpochs))
+		}
+		l = len(x.ReputerOutlierSlashFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxMissedEpochsJail != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxMissedEpochsJail))
+		}
+		if x.MaxMissedEpochsDeregister != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxMissedEpochsDeregister))
+		}
+		if x.ReputerCommissionUpdateCooldown != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReputerCommissionUpdateCooldown))
+		}
+		l = len(x.MaxDelegateToSelfStakeRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxDelegateStakePerReputer)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxAutoCompoundDelegationsPerPayout != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxAutoCompoundDelegationsPerPayout))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1344,6 +1636,98 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxAutoCompoundDelegationsPerPayout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAutoCompoundDelegationsPerPayout))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe8
+		}
+		if len(x.MaxDelegateStakePerReputer) > 0 {
+			i -= len(x.MaxDelegateStakePerReputer)
+			copy(dAtA[i:], x.MaxDelegateStakePerReputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDelegateStakePerReputer)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.MaxDelegateToSelfStakeRatio) > 0 {
+			i -= len(x.MaxDelegateToSelfStakeRatio)
+			copy(dAtA[i:], x.MaxDelegateToSelfStakeRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDelegateToSelfStakeRatio)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.ReputerCommissionUpdateCooldown != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputerCommissionUpdateCooldown))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd0
+		}
+		if x.MaxMissedEpochsDeregister != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedEpochsDeregister))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc8
+		}
+		if x.MaxMissedEpochsJail != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedEpochsJail))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.ReputerOutlierSlashFraction) > 0 {
+			i -= len(x.ReputerOutlierSlashFraction)
+			copy(dAtA[i:], x.ReputerOutlierSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerOutlierSlashFraction)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xba
+		}
+		if x.ReputerOutlierSlashEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputerOutlierSlashEpochs))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.NodeRotationCooldown != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NodeRotationCooldown))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa8
+		}
+		if len(x.MaxTopicCreatorCommission) > 0 {
+			i -= len(x.MaxTopicCreatorCommission)
+			copy(dAtA[i:], x.MaxTopicCreatorCommission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTopicCreatorCommission)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.MaxFundingSubscriptionDripsEndBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFundingSubscriptionDripsEndBlock))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.MaxPruneArchivedTopicKeysEndBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPruneArchivedTopicKeysEndBlock))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x90
+		}
 		if len(x.PNormSafeDiv) > 0 {
 			i -= len(x.PNormSafeDiv)
 			copy(dAtA[i:], x.PNormSafeDiv)
@@ -2860,6 +3244,286 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.PNormSafeDiv = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 50:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPruneArchivedTopicKeysEndBlock", wireType)
+				}
+				x.MaxPruneArchivedTopicKeysEndBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPruneArchivedTopicKeysEndBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 51:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFundingSubscriptionDripsEndBlock", wireType)
+				}
+				x.MaxFundingSubscriptionDripsEndBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFundingSubscriptionDripsEndBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 52:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicCreatorCommission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTopicCreatorCommission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 53:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeRotationCooldown", wireType)
+				}
+				x.NodeRotationCooldown = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NodeRotationCooldown |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 54:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierSlashEpochs", wireType)
+				}
+				x.ReputerOutlierSlashEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputerOutlierSlashEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 55:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerOutlierSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 56:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochsJail", wireType)
+				}
+				x.MaxMissedEpochsJail = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedEpochsJail |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 57:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochsDeregister", wireType)
+				}
+				x.MaxMissedEpochsDeregister = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedEpochsDeregister |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 58:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerCommissionUpdateCooldown", wireType)
+				}
+				x.ReputerCommissionUpdateCooldown = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputerCommissionUpdateCooldown |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 59:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelegateToSelfStakeRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxDelegateToSelfStakeRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 60:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDelegateStakePerReputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxDelegateStakePerReputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 61:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundDelegationsPerPayout", wireType)
+				}
+				x.MaxAutoCompoundDelegationsPerPayout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAutoCompoundDelegationsPerPayout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2966,14 +3630,26 @@ type Params struct {
 	// Applied twice once for stakeRemovals and once for
 	// DelegateStakeRemovals, so actual max is this number times two
 	EpsilonSafeDiv string `protobuf:"bytes,43,opt,name=epsilon_safe_div,json=epsilonSafeDiv,proto3" json:"epsilon_safe_div,omitempty"`
-	// / a small tolerance quantity used to cap division by zero
+	/// a small tolerance quantity used to cap division by zero
 	DataSendingFee string `protobuf:"bytes,44,opt,name=data_sending_fee,json=dataSendingFee,proto3" json:"data_sending_fee,omitempty"`
 	// payload sending fee for reputer or worker
-	MaxElementsPerForecast  uint64 `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`      // max number of top forecasters by score to reward
-	MaxActiveTopicsPerBlock uint64 `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"` // max number of active topics per block
-	MaxStringLength         uint64 `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`                             // max permittible length of strings uploaded to the chain
-	InitialRegretQuantile   string `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3" json:"initial_regret_quantile,omitempty"`
-	PNormSafeDiv            string `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3" json:"p_norm_safe_div,omitempty"`
+	MaxElementsPerForecast              uint64 `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`      // max number of top forecasters by score to reward
+	MaxActiveTopicsPerBlock             uint64 `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"` // max number of active topics per block
+	MaxStringLength                     uint64 `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`                             // max permittible length of strings uploaded to the chain
+	InitialRegretQuantile               string `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3" json:"initial_regret_quantile,omitempty"`
+	PNormSafeDiv                        string `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3" json:"p_norm_safe_div,omitempty"`
+	MaxPruneArchivedTopicKeysEndBlock   uint64 `protobuf:"varint,50,opt,name=max_prune_archived_topic_keys_end_block,json=maxPruneArchivedTopicKeysEndBlock,proto3" json:"max_prune_archived_topic_keys_end_block,omitempty"`     // max number of state entries of archived topics to delete in an ABCI end block
+	MaxFundingSubscriptionDripsEndBlock uint64 `protobuf:"varint,51,opt,name=max_funding_subscription_drips_end_block,json=maxFundingSubscriptionDripsEndBlock,proto3" json:"max_funding_subscription_drips_end_block,omitempty"` // max number of topic funding subscriptions to drip in an ABCI end block
+	MaxTopicCreatorCommission           string `protobuf:"bytes,52,opt,name=max_topic_creator_commission,json=maxTopicCreatorCommission,proto3" json:"max_topic_creator_commission,omitempty"`
+	NodeRotationCooldown                int64  `protobuf:"varint,53,opt,name=node_rotation_cooldown,json=nodeRotationCooldown,proto3" json:"node_rotation_cooldown,omitempty"`                  // number of blocks to wait before a node moved to a new address can be moved again
+	ReputerOutlierSlashEpochs           uint64 `protobuf:"varint,54,opt,name=reputer_outlier_slash_epochs,json=reputerOutlierSlashEpochs,proto3" json:"reputer_outlier_slash_epochs,omitempty"` // number of consecutive epochs a reputer must be an outlier of a topic to be slashed
+	ReputerOutlierSlashFraction         string `protobuf:"bytes,55,opt,name=reputer_outlier_slash_fraction,json=reputerOutlierSlashFraction,proto3" json:"reputer_outlier_slash_fraction,omitempty"`
+	MaxMissedEpochsJail                 uint64 `protobuf:"varint,56,opt,name=max_missed_epochs_jail,json=maxMissedEpochsJail,proto3" json:"max_missed_epochs_jail,omitempty"`                                     // number of epochs an actor may miss in a row before being jailed from a topic, 0 to never jail
+	MaxMissedEpochsDeregister           uint64 `protobuf:"varint,57,opt,name=max_missed_epochs_deregister,json=maxMissedEpochsDeregister,proto3" json:"max_missed_epochs_deregister,omitempty"`                   // number of epochs an actor may miss in a row before being deregistered from a topic, 0 to never deregister
+	ReputerCommissionUpdateCooldown     int64  `protobuf:"varint,58,opt,name=reputer_commission_update_cooldown,json=reputerCommissionUpdateCooldown,proto3" json:"reputer_commission_update_cooldown,omitempty"` // number of blocks to wait before a reputer can change its commission rate in a topic again
+	MaxDelegateToSelfStakeRatio         string `protobuf:"bytes,59,opt,name=max_delegate_to_self_stake_ratio,json=maxDelegateToSelfStakeRatio,proto3" json:"max_delegate_to_self_stake_ratio,omitempty"`
+	MaxDelegateStakePerReputer          string `protobuf:"bytes,60,opt,name=max_delegate_stake_per_reputer,json=maxDelegateStakePerReputer,proto3" json:"max_delegate_stake_per_reputer,omitempty"`
+	MaxAutoCompoundDelegationsPerPayout uint64 `protobuf:"varint,61,opt,name=max_auto_compound_delegations_per_payout,json=maxAutoCompoundDelegationsPerPayout,proto3" json:"max_auto_compound_delegations_per_payout,omitempty"` // max number of auto-compound delegations of a topic restaked at each payout of its rewards, the rest restaked at the next payouts
 }
 
 func (x *Params) Reset() {
@@ -3297,6 +3973,90 @@ func (x *Params) GetPNormSafeDiv() string {
 	return ""
 }
 
+func (x *Params) GetMaxPruneArchivedTopicKeysEndBlock() uint64 {
+	if x != nil {
+		return x.MaxPruneArchivedTopicKeysEndBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxFundingSubscriptionDripsEndBlock() uint64 {
+	if x != nil {
+		return x.MaxFundingSubscriptionDripsEndBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTopicCreatorCommission() string {
+	if x != nil {
+		return x.MaxTopicCreatorCommission
+	}
+	return ""
+}
+
+func (x *Params) GetNodeRotationCooldown() int64 {
+	if x != nil {
+		return x.NodeRotationCooldown
+	}
+	return 0
+}
+
+func (x *Params) GetReputerOutlierSlashEpochs() uint64 {
+	if x != nil {
+		return x.ReputerOutlierSlashEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetReputerOutlierSlashFraction() string {
+	if x != nil {
+		return x.ReputerOutlierSlashFraction
+	}
+	return ""
+}
+
+func (x *Params) GetMaxMissedEpochsJail() uint64 {
+	if x != nil {
+		return x.MaxMissedEpochsJail
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMissedEpochsDeregister() uint64 {
+	if x != nil {
+		return x.MaxMissedEpochsDeregister
+	}
+	return 0
+}
+
+func (x *Params) GetReputerCommissionUpdateCooldown() int64 {
+	if x != nil {
+		return x.ReputerCommissionUpdateCooldown
+	}
+	return 0
+}
+
+func (x *Params) GetMaxDelegateToSelfStakeRatio() string {
+	if x != nil {
+		return x.MaxDelegateToSelfStakeRatio
+	}
+	return ""
+}
+
+func (x *Params) GetMaxDelegateStakePerReputer() string {
+	if x != nil {
+		return x.MaxDelegateStakePerReputer
+	}
+	return ""
+}
+
+func (x *Params) GetMaxAutoCompoundDelegationsPerPayout() uint64 {
+	if x != nil {
+		return x.MaxAutoCompoundDelegationsPerPayout
+	}
+	return 0
+}
+
 var File_emissions_v5_params_proto protoreflect.FileDescriptor
 
 var file_emissions_v5_params_proto_rawDesc = []byte{
@@ -3306,7 +4066,7 @@ var file_emissions_v5_params_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x1d,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x25,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
@@ -3529,32 +4289,98 @@ var file_emissions_v5_params_proto_rawDesc = []byte{
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
 	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
 	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
-	0x0c, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x53, 0x61, 0x66, 0x65, 0x44, 0x69, 0x76, 0x4a, 0x04, 0x08,
-	0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x1a, 0x10, 0x1b, 0x4a, 0x04, 0x08, 0x1b, 0x10, 0x1c, 0x4a,
-	0x04, 0x08, 0x27, 0x10, 0x28, 0x4a, 0x04, 0x08, 0x29, 0x10, 0x2a, 0x52, 0x14, 0x6d, 0x61, 0x78,
-	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x23,
-	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x52, 0x1c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
-	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x52, 0x24, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f,
-	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x35, 0x42, 0x0b, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x35,
-	0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x35, 0xa2, 0x02, 0x03, 0x45,
-	0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56,
-	0x35, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x35,
-	0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x35, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x35, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x0c, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x53, 0x61, 0x66, 0x65, 0x44, 0x69, 0x76, 0x12, 0x52, 0x0a,
+	0x27, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x32, 0x20, 0x01, 0x28, 0x04, 0x52, 0x21,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x55, 0x0a, 0x28, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x72,
+	0x69, 0x70, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x33, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x23, 0x6d, 0x61, 0x78, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x69, 0x70, 0x73,
+	0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x78, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x35, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x36, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x7c, 0x0a, 0x1e, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x37, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1b, 0x72, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x46,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x6a, 0x61, 0x69,
+	0x6c, 0x18, 0x38, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x4a, 0x61, 0x69, 0x6c, 0x12, 0x3f, 0x0a, 0x1c,
+	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x5f, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x39, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a,
+	0x22, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1f, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x7e, 0x0a, 0x20, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65,
+	0x6c, 0x66, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x3b,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1b, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x74, 0x0a, 0x1e, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x3c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x12, 0x55, 0x0a, 0x28, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x3d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x23, 0x6d, 0x61, 0x78, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65,
+	0x72, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x1a, 0x10, 0x1b, 0x4a, 0x04, 0x08, 0x1b, 0x10, 0x1c, 0x4a, 0x04, 0x08, 0x27, 0x10, 0x28, 0x4a,
+	0x04, 0x08, 0x29, 0x10, 0x2a, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x1b, 0x6d, 0x69, 0x6e,
+	0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x1c, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x52, 0x24, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x35, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x35, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x76, 0x35, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x35, 0xca, 0x02, 0x0c, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x35, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x35, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x3a, 0x56, 0x35, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -22,6 +22,7 @@ const (
 	QueryService_GetParams_FullMethodName                                   = "/emissions.v5.QueryService/GetParams"
 	QueryService_GetNextTopicId_FullMethodName                              = "/emissions.v5.QueryService/GetNextTopicId"
 	QueryService_GetTopic_FullMethodName                                    = "/emissions.v5.QueryService/GetTopic"
+	QueryService_GetTopics_FullMethodName                                   = "/emissions.v5.QueryService/GetTopics"
 	QueryService_GetWorkerLatestInferenceByTopicId_FullMethodName           = "/emissions.v5.QueryService/GetWorkerLatestInferenceByTopicId"
 	QueryService_GetInferencesAtBlock_FullMethodName                        = "/emissions.v5.QueryService/GetInferencesAtBlock"
 	QueryService_GetLatestTopicInferences_FullMethodName                    = "/emissions.v5.QueryService/GetLatestTopicInferences"
@@ -54,6 +55,12 @@ const (
 	QueryService_GetForecasterNetworkRegret_FullMethodName                  = "/emissions.v5.QueryService/GetForecasterNetworkRegret"
 	QueryService_GetOneInForecasterNetworkRegret_FullMethodName             = "/emissions.v5.QueryService/GetOneInForecasterNetworkRegret"
 	QueryService_IsWhitelistAdmin_FullMethodName                            = "/emissions.v5.QueryService/IsWhitelistAdmin"
+	QueryService_IsTopicWorkerWhitelistEnabled_FullMethodName               = "/emissions.v5.QueryService/IsTopicWorkerWhitelistEnabled"
+	QueryService_IsTopicReputerWhitelistEnabled_FullMethodName              = "/emissions.v5.QueryService/IsTopicReputerWhitelistEnabled"
+	QueryService_IsWhitelistedTopicWorker_FullMethodName                    = "/emissions.v5.QueryService/IsWhitelistedTopicWorker"
+	QueryService_IsWhitelistedTopicReputer_FullMethodName                   = "/emissions.v5.QueryService/IsWhitelistedTopicReputer"
+	QueryService_GetTopicWorkerWhitelist_FullMethodName                     = "/emissions.v5.QueryService/GetTopicWorkerWhitelist"
+	QueryService_GetTopicReputerWhitelist_FullMethodName                    = "/emissions.v5.QueryService/GetTopicReputerWhitelist"
 	QueryService_GetTopicLastWorkerCommitInfo_FullMethodName                = "/emissions.v5.QueryService/GetTopicLastWorkerCommitInfo"
 	QueryService_GetTopicLastReputerCommitInfo_FullMethodName               = "/emissions.v5.QueryService/GetTopicLastReputerCommitInfo"
 	QueryService_GetTopicRewardNonce_FullMethodName                         = "/emissions.v5.QueryService/GetTopicRewardNonce"
@@ -101,6 +108,20 @@ const (
 	QueryService_GetActiveReputersForTopic_FullMethodName                   = "/emissions.v5.QueryService/GetActiveReputersForTopic"
 	QueryService_GetActiveForecastersForTopic_FullMethodName                = "/emissions.v5.QueryService/GetActiveForecastersForTopic"
 	QueryService_GetActiveInferersForTopic_FullMethodName                   = "/emissions.v5.QueryService/GetActiveInferersForTopic"
+	QueryService_GetFundingSubscription_FullMethodName                      = "/emissions.v5.QueryService/GetFundingSubscription"
+	QueryService_GetTopicFundingSubscriptions_FullMethodName                = "/emissions.v5.QueryService/GetTopicFundingSubscriptions"
+	QueryService_GetFunderFundingSubscriptions_FullMethodName               = "/emissions.v5.QueryService/GetFunderFundingSubscriptions"
+	QueryService_GetTopicCreatorRewards_FullMethodName                      = "/emissions.v5.QueryService/GetTopicCreatorRewards"
+	QueryService_GetWorkerBond_FullMethodName                               = "/emissions.v5.QueryService/GetWorkerBond"
+	QueryService_GetWorkerBondRemovalInfo_FullMethodName                    = "/emissions.v5.QueryService/GetWorkerBondRemovalInfo"
+	QueryService_GetReputerSlashHistory_FullMethodName                      = "/emissions.v5.QueryService/GetReputerSlashHistory"
+	QueryService_GetActorLiveness_FullMethodName                            = "/emissions.v5.QueryService/GetActorLiveness"
+	QueryService_GetActorProfile_FullMethodName                             = "/emissions.v5.QueryService/GetActorProfile"
+	QueryService_GetReputerCommission_FullMethodName                        = "/emissions.v5.QueryService/GetReputerCommission"
+	QueryService_GetDelegatorPortfolio_FullMethodName                       = "/emissions.v5.QueryService/GetDelegatorPortfolio"
+	QueryService_GetDelegateStakeReceiptPool_FullMethodName                 = "/emissions.v5.QueryService/GetDelegateStakeReceiptPool"
+	QueryService_GetReputerDelegateStakeRatio_FullMethodName                = "/emissions.v5.QueryService/GetReputerDelegateStakeRatio"
+	QueryService_SimulateRewards_FullMethodName                             = "/emissions.v5.QueryService/SimulateRewards"
 )
 
 // QueryServiceClient is the client API for QueryService service.
@@ -113,6 +134,7 @@ type QueryServiceClient interface {
 	GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error)
 	GetNextTopicId(ctx context.Context, in *GetNextTopicIdRequest, opts ...grpc.CallOption) (*GetNextTopicIdResponse, error)
 	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
+	GetTopics(ctx context.Context, in *GetTopicsRequest, opts ...grpc.CallOption) (*GetTopicsResponse, error)
 	GetWorkerLatestInferenceByTopicId(ctx context.Context, in *GetWorkerLatestInferenceByTopicIdRequest, opts ...grpc.CallOption) (*GetWorkerLatestInferenceByTopicIdResponse, error)
 	GetInferencesAtBlock(ctx context.Context, in *GetInferencesAtBlockRequest, opts ...grpc.CallOption) (*GetInferencesAtBlockResponse, error)
 	GetLatestTopicInferences(ctx context.Context, in *GetLatestTopicInferencesRequest, opts ...grpc.CallOption) (*GetLatestTopicInferencesResponse, error)
@@ -145,6 +167,12 @@ type QueryServiceClient interface {
 	GetForecasterNetworkRegret(ctx context.Context, in *GetForecasterNetworkRegretRequest, opts ...grpc.CallOption) (*GetForecasterNetworkRegretResponse, error)
 	GetOneInForecasterNetworkRegret(ctx context.Context, in *GetOneInForecasterNetworkRegretRequest, opts ...grpc.CallOption) (*GetOneInForecasterNetworkRegretResponse, error)
 	IsWhitelistAdmin(ctx context.Context, in *IsWhitelistAdminRequest, opts ...grpc.CallOption) (*IsWhitelistAdminResponse, error)
+	IsTopicWorkerWhitelistEnabled(ctx context.Context, in *IsTopicWorkerWhitelistEnabledRequest, opts ...grpc.CallOption) (*IsTopicWorkerWhitelistEnabledResponse, error)
+	IsTopicReputerWhitelistEnabled(ctx context.Context, in *IsTopicReputerWhitelistEnabledRequest, opts ...grpc.CallOption) (*IsTopicReputerWhitelistEnabledResponse, error)
+	IsWhitelistedTopicWorker(ctx context.Context, in *IsWhitelistedTopicWorkerRequest, opts ...grpc.CallOption) (*IsWhitelistedTopicWorkerResponse, error)
+	IsWhitelistedTopicReputer(ctx context.Context, in *IsWhitelistedTopicReputerRequest, opts ...grpc.CallOption) (*IsWhitelistedTopicReputerResponse, error)
+	GetTopicWorkerWhitelist(ctx context.Context, in *GetTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*GetTopicWorkerWhitelistResponse, error)
+	GetTopicReputerWhitelist(ctx context.Context, in *GetTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*GetTopicReputerWhitelistResponse, error)
 	GetTopicLastWorkerCommitInfo(ctx context.Context, in *GetTopicLastWorkerCommitInfoRequest, opts ...grpc.CallOption) (*GetTopicLastWorkerCommitInfoResponse, error)
 	GetTopicLastReputerCommitInfo(ctx context.Context, in *GetTopicLastReputerCommitInfoRequest, opts ...grpc.CallOption) (*GetTopicLastReputerCommitInfoResponse, error)
 	GetTopicRewardNonce(ctx context.Context, in *GetTopicRewardNonceRequest, opts ...grpc.CallOption) (*GetTopicRewardNonceResponse, error)
@@ -192,6 +220,20 @@ type QueryServiceClient interface {
 	GetActiveReputersForTopic(ctx context.Context, in *GetActiveReputersForTopicRequest, opts ...grpc.CallOption) (*GetActiveReputersForTopicResponse, error)
 	GetActiveForecastersForTopic(ctx context.Context, in *GetActiveForecastersForTopicRequest, opts ...grpc.CallOption) (*GetActiveForecastersForTopicResponse, error)
 	GetActiveInferersForTopic(ctx context.Context, in *GetActiveInferersForTopicRequest, opts ...grpc.CallOption) (*GetActiveInferersForTopicResponse, error)
+	GetFundingSubscription(ctx context.Context, in *GetFundingSubscriptionRequest, opts ...grpc.CallOption) (*GetFundingSubscriptionResponse, error)
+	GetTopicFundingSubscriptions(ctx context.Context, in *GetTopicFundingSubscriptionsRequest, opts ...grpc.CallOption) (*GetTopicFundingSubscriptionsResponse, error)
+	GetFunderFundingSubscriptions(ctx context.Context, in *GetFunderFundingSubscriptionsRequest, opts ...grpc.CallOption) (*GetFunderFundingSubscriptionsResponse, error)
+	GetTopicCreatorRewards(ctx context.Context, in *GetTopicCreatorRewardsRequest, opts ...grpc.CallOption) (*GetTopicCreatorRewardsResponse, error)
+	GetWorkerBond(ctx context.Context, in *GetWorkerBondRequest, opts ...grpc.CallOption) (*GetWorkerBondResponse, error)
+	GetWorkerBondRemovalInfo(ctx context.Context, in *GetWorkerBondRemovalInfoRequest, opts ...grpc.CallOption) (*GetWorkerBondRemovalInfoResponse, error)
+	GetReputerSlashHistory(ctx context.Context, in *GetReputerSlashHistoryRequest, opts ...grpc.CallOption) (*GetReputerSlashHistoryResponse, error)
+	GetActorLiveness(ctx context.Context, in *GetActorLivenessRequest, opts ...grpc.CallOption) (*GetActorLivenessResponse, error)
+	GetActorProfile(ctx context.Context, in *GetActorProfileRequest, opts ...grpc.CallOption) (*GetActorProfileResponse, error)
+	GetReputerCommission(ctx context.Context, in *GetReputerCommissionRequest, opts ...grpc.CallOption) (*GetReputerCommissionResponse, error)
+	GetDelegatorPortfolio(ctx context.Context, in *GetDelegatorPortfolioRequest, opts ...grpc.CallOption) (*GetDelegatorPortfolioResponse, error)
+	GetDelegateStakeReceiptPool(ctx context.Context, in *GetDelegateStakeReceiptPoolRequest, opts ...grpc.CallOption) (*GetDelegateStakeReceiptPoolResponse, error)
+	GetReputerDelegateStakeRatio(ctx context.Context, in *GetReputerDelegateStakeRatioRequest, opts ...grpc.CallOption) (*GetReputerDelegateStakeRatioResponse, error)
+	SimulateRewards(ctx context.Context, in *SimulateRewardsRequest, opts ...grpc.CallOption) (*SimulateRewardsResponse, error)
 }
 
 type queryServiceClient struct {
@@ -232,6 +274,16 @@ func (c *queryServiceClient) GetTopic(ctx context.Context, in *GetTopicRequest,
 	return out, nil
 }
 
+func (c *queryServiceClient) GetTopics(ctx context.Context, in *GetTopicsRequest, opts ...grpc.CallOption) (*GetTopicsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetWorkerLatestInferenceByTopicId(ctx context.Context, in *GetWorkerLatestInferenceByTopicIdRequest, opts ...grpc.CallOption) (*GetWorkerLatestInferenceByTopicIdResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWorkerLatestInferenceByTopicIdResponse)
@@ -552,6 +604,66 @@ func (c *queryServiceClient) IsWhitelistAdmin(ctx context.Context, in *IsWhiteli
 	return out, nil
 }
 
+func (c *queryServiceClient) IsTopicWorkerWhitelistEnabled(ctx context.Context, in *IsTopicWorkerWhitelistEnabledRequest, opts ...grpc.CallOption) (*IsTopicWorkerWhitelistEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsTopicWorkerWhitelistEnabledResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsTopicWorkerWhitelistEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) IsTopicReputerWhitelistEnabled(ctx context.Context, in *IsTopicReputerWhitelistEnabledRequest, opts ...grpc.CallOption) (*IsTopicReputerWhitelistEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsTopicReputerWhitelistEnabledResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsTopicReputerWhitelistEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) IsWhitelistedTopicWorker(ctx context.Context, in *IsWhitelistedTopicWorkerRequest, opts ...grpc.CallOption) (*IsWhitelistedTopicWorkerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsWhitelistedTopicWorkerResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsWhitelistedTopicWorker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) IsWhitelistedTopicReputer(ctx context.Context, in *IsWhitelistedTopicReputerRequest, opts ...grpc.CallOption) (*IsWhitelistedTopicReputerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsWhitelistedTopicReputerResponse)
+	err := c.cc.Invoke(ctx, QueryService_IsWhitelistedTopicReputer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicWorkerWhitelist(ctx context.Context, in *GetTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*GetTopicWorkerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicWorkerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicReputerWhitelist(ctx context.Context, in *GetTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*GetTopicReputerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicReputerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) GetTopicLastWorkerCommitInfo(ctx context.Context, in *GetTopicLastWorkerCommitInfoRequest, opts ...grpc.CallOption) (*GetTopicLastWorkerCommitInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTopicLastWorkerCommitInfoResponse)
@@ -1022,6 +1134,146 @@ func (c *queryServiceClient) GetActiveInferersForTopic(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryServiceClient) GetFundingSubscription(ctx context.Context, in *GetFundingSubscriptionRequest, opts ...grpc.CallOption) (*GetFundingSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFundingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetFundingSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicFundingSubscriptions(ctx context.Context, in *GetTopicFundingSubscriptionsRequest, opts ...grpc.CallOption) (*GetTopicFundingSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicFundingSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicFundingSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetFunderFundingSubscriptions(ctx context.Context, in *GetFunderFundingSubscriptionsRequest, opts ...grpc.CallOption) (*GetFunderFundingSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFunderFundingSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetFunderFundingSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetTopicCreatorRewards(ctx context.Context, in *GetTopicCreatorRewardsRequest, opts ...grpc.CallOption) (*GetTopicCreatorRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicCreatorRewardsResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetTopicCreatorRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetWorkerBond(ctx context.Context, in *GetWorkerBondRequest, opts ...grpc.CallOption) (*GetWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerBondResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetWorkerBondRemovalInfo(ctx context.Context, in *GetWorkerBondRemovalInfoRequest, opts ...grpc.CallOption) (*GetWorkerBondRemovalInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerBondRemovalInfoResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetWorkerBondRemovalInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetReputerSlashHistory(ctx context.Context, in *GetReputerSlashHistoryRequest, opts ...grpc.CallOption) (*GetReputerSlashHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReputerSlashHistoryResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetReputerSlashHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetActorLiveness(ctx context.Context, in *GetActorLivenessRequest, opts ...grpc.CallOption) (*GetActorLivenessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActorLivenessResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetActorLiveness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetActorProfile(ctx context.Context, in *GetActorProfileRequest, opts ...grpc.CallOption) (*GetActorProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActorProfileResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetActorProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetReputerCommission(ctx context.Context, in *GetReputerCommissionRequest, opts ...grpc.CallOption) (*GetReputerCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetReputerCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetDelegatorPortfolio(ctx context.Context, in *GetDelegatorPortfolioRequest, opts ...grpc.CallOption) (*GetDelegatorPortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDelegatorPortfolioResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetDelegatorPortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetDelegateStakeReceiptPool(ctx context.Context, in *GetDelegateStakeReceiptPoolRequest, opts ...grpc.CallOption) (*GetDelegateStakeReceiptPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDelegateStakeReceiptPoolResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetDelegateStakeReceiptPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) GetReputerDelegateStakeRatio(ctx context.Context, in *GetReputerDelegateStakeRatioRequest, opts ...grpc.CallOption) (*GetReputerDelegateStakeRatioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReputerDelegateStakeRatioResponse)
+	err := c.cc.Invoke(ctx, QueryService_GetReputerDelegateStakeRatio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) SimulateRewards(ctx context.Context, in *SimulateRewardsRequest, opts ...grpc.CallOption) (*SimulateRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateRewardsResponse)
+	err := c.cc.Invoke(ctx, QueryService_SimulateRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 // All implementations must embed UnimplementedQueryServiceServer
 // for forward compatibility.
@@ -1032,6 +1284,7 @@ type QueryServiceServer interface {
 	GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error)
 	GetNextTopicId(context.Context, *GetNextTopicIdRequest) (*GetNextTopicIdResponse, error)
 	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
+	GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error)
 	GetWorkerLatestInferenceByTopicId(context.Context, *GetWorkerLatestInferenceByTopicIdRequest) (*GetWorkerLatestInferenceByTopicIdResponse, error)
 	GetInferencesAtBlock(context.Context, *GetInferencesAtBlockRequest) (*GetInferencesAtBlockResponse, error)
 	GetLatestTopicInferences(context.Context, *GetLatestTopicInferencesRequest) (*GetLatestTopicInferencesResponse, error)
@@ -1064,6 +1317,12 @@ type QueryServiceServer interface {
 	GetForecasterNetworkRegret(context.Context, *GetForecasterNetworkRegretRequest) (*GetForecasterNetworkRegretResponse, error)
 	GetOneInForecasterNetworkRegret(context.Context, *GetOneInForecasterNetworkRegretRequest) (*GetOneInForecasterNetworkRegretResponse, error)
 	IsWhitelistAdmin(context.Context, *IsWhitelistAdminRequest) (*IsWhitelistAdminResponse, error)
+	IsTopicWorkerWhitelistEnabled(context.Context, *IsTopicWorkerWhitelistEnabledRequest) (*IsTopicWorkerWhitelistEnabledResponse, error)
+	IsTopicReputerWhitelistEnabled(context.Context, *IsTopicReputerWhitelistEnabledRequest) (*IsTopicReputerWhitelistEnabledResponse, error)
+	IsWhitelistedTopicWorker(context.Context, *IsWhitelistedTopicWorkerRequest) (*IsWhitelistedTopicWorkerResponse, error)
+	IsWhitelistedTopicReputer(context.Context, *IsWhitelistedTopicReputerRequest) (*IsWhitelistedTopicReputerResponse, error)
+	GetTopicWorkerWhitelist(context.Context, *GetTopicWorkerWhitelistRequest) (*GetTopicWorkerWhitelistResponse, error)
+	GetTopicReputerWhitelist(context.Context, *GetTopicReputerWhitelistRequest) (*GetTopicReputerWhitelistResponse, error)
 	GetTopicLastWorkerCommitInfo(context.Context, *GetTopicLastWorkerCommitInfoRequest) (*GetTopicLastWorkerCommitInfoResponse, error)
 	GetTopicLastReputerCommitInfo(context.Context, *GetTopicLastReputerCommitInfoRequest) (*GetTopicLastReputerCommitInfoResponse, error)
 	GetTopicRewardNonce(context.Context, *GetTopicRewardNonceRequest) (*GetTopicRewardNonceResponse, error)
@@ -1111,6 +1370,20 @@ type QueryServiceServer interface {
 	GetActiveReputersForTopic(context.Context, *GetActiveReputersForTopicRequest) (*GetActiveReputersForTopicResponse, error)
 	GetActiveForecastersForTopic(context.Context, *GetActiveForecastersForTopicRequest) (*GetActiveForecastersForTopicResponse, error)
 	GetActiveInferersForTopic(context.Context, *GetActiveInferersForTopicRequest) (*GetActiveInferersForTopicResponse, error)
+	GetFundingSubscription(context.Context, *GetFundingSubscriptionRequest) (*GetFundingSubscriptionResponse, error)
+	GetTopicFundingSubscriptions(context.Context, *GetTopicFundingSubscriptionsRequest) (*GetTopicFundingSubscriptionsResponse, error)
+	GetFunderFundingSubscriptions(context.Context, *GetFunderFundingSubscriptionsRequest) (*GetFunderFundingSubscriptionsResponse, error)
+	GetTopicCreatorRewards(context.Context, *GetTopicCreatorRewardsRequest) (*GetTopicCreatorRewardsResponse, error)
+	GetWorkerBond(context.Context, *GetWorkerBondRequest) (*GetWorkerBondResponse, error)
+	GetWorkerBondRemovalInfo(context.Context, *GetWorkerBondRemovalInfoRequest) (*GetWorkerBondRemovalInfoResponse, error)
+	GetReputerSlashHistory(context.Context, *GetReputerSlashHistoryRequest) (*GetReputerSlashHistoryResponse, error)
+	GetActorLiveness(context.Context, *GetActorLivenessRequest) (*GetActorLivenessResponse, error)
+	GetActorProfile(context.Context, *GetActorProfileRequest) (*GetActorProfileResponse, error)
+	GetReputerCommission(context.Context, *GetReputerCommissionRequest) (*GetReputerCommissionResponse, error)
+	GetDelegatorPortfolio(context.Context, *GetDelegatorPortfolioRequest) (*GetDelegatorPortfolioResponse, error)
+	GetDelegateStakeReceiptPool(context.Context, *GetDelegateStakeReceiptPoolRequest) (*GetDelegateStakeReceiptPoolResponse, error)
+	GetReputerDelegateStakeRatio(context.Context, *GetReputerDelegateStakeRatioRequest) (*GetReputerDelegateStakeRatioResponse, error)
+	SimulateRewards(context.Context, *SimulateRewardsRequest) (*SimulateRewardsResponse, error)
 	mustEmbedUnimplementedQueryServiceServer()
 }
 
@@ -1130,6 +1403,9 @@ func (UnimplementedQueryServiceServer) GetNextTopicId(context.Context, *GetNextT
 func (UnimplementedQueryServiceServer) GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
 }
+func (UnimplementedQueryServiceServer) GetTopics(context.Context, *GetTopicsRequest) (*GetTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopics not implemented")
+}
 func (UnimplementedQueryServiceServer) GetWorkerLatestInferenceByTopicId(context.Context, *GetWorkerLatestInferenceByTopicIdRequest) (*GetWorkerLatestInferenceByTopicIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerLatestInferenceByTopicId not implemented")
 }
@@ -1226,6 +1502,24 @@ func (UnimplementedQueryServiceServer) GetOneInForecasterNetworkRegret(context.C
 func (UnimplementedQueryServiceServer) IsWhitelistAdmin(context.Context, *IsWhitelistAdminRequest) (*IsWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
 }
+func (UnimplementedQueryServiceServer) IsTopicWorkerWhitelistEnabled(context.Context, *IsTopicWorkerWhitelistEnabledRequest) (*IsTopicWorkerWhitelistEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTopicWorkerWhitelistEnabled not implemented")
+}
+func (UnimplementedQueryServiceServer) IsTopicReputerWhitelistEnabled(context.Context, *IsTopicReputerWhitelistEnabledRequest) (*IsTopicReputerWhitelistEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTopicReputerWhitelistEnabled not implemented")
+}
+func (UnimplementedQueryServiceServer) IsWhitelistedTopicWorker(context.Context, *IsWhitelistedTopicWorkerRequest) (*IsWhitelistedTopicWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistedTopicWorker not implemented")
+}
+func (UnimplementedQueryServiceServer) IsWhitelistedTopicReputer(context.Context, *IsWhitelistedTopicReputerRequest) (*IsWhitelistedTopicReputerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistedTopicReputer not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicWorkerWhitelist(context.Context, *GetTopicWorkerWhitelistRequest) (*GetTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicWorkerWhitelist not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicReputerWhitelist(context.Context, *GetTopicReputerWhitelistRequest) (*GetTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicReputerWhitelist not implemented")
+}
 func (UnimplementedQueryServiceServer) GetTopicLastWorkerCommitInfo(context.Context, *GetTopicLastWorkerCommitInfoRequest) (*GetTopicLastWorkerCommitInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastWorkerCommitInfo not implemented")
 }
@@ -1367,6 +1661,48 @@ func (UnimplementedQueryServiceServer) GetActiveForecastersForTopic(context.Cont
 func (UnimplementedQueryServiceServer) GetActiveInferersForTopic(context.Context, *GetActiveInferersForTopicRequest) (*GetActiveInferersForTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActiveInferersForTopic not implemented")
 }
+func (UnimplementedQueryServiceServer) GetFundingSubscription(context.Context, *GetFundingSubscriptionRequest) (*GetFundingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingSubscription not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicFundingSubscriptions(context.Context, *GetTopicFundingSubscriptionsRequest) (*GetTopicFundingSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicFundingSubscriptions not implemented")
+}
+func (UnimplementedQueryServiceServer) GetFunderFundingSubscriptions(context.Context, *GetFunderFundingSubscriptionsRequest) (*GetFunderFundingSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFunderFundingSubscriptions not implemented")
+}
+func (UnimplementedQueryServiceServer) GetTopicCreatorRewards(context.Context, *GetTopicCreatorRewardsRequest) (*GetTopicCreatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicCreatorRewards not implemented")
+}
+func (UnimplementedQueryServiceServer) GetWorkerBond(context.Context, *GetWorkerBondRequest) (*GetWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBond not implemented")
+}
+func (UnimplementedQueryServiceServer) GetWorkerBondRemovalInfo(context.Context, *GetWorkerBondRemovalInfoRequest) (*GetWorkerBondRemovalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBondRemovalInfo not implemented")
+}
+func (UnimplementedQueryServiceServer) GetReputerSlashHistory(context.Context, *GetReputerSlashHistoryRequest) (*GetReputerSlashHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerSlashHistory not implemented")
+}
+func (UnimplementedQueryServiceServer) GetActorLiveness(context.Context, *GetActorLivenessRequest) (*GetActorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorLiveness not implemented")
+}
+func (UnimplementedQueryServiceServer) GetActorProfile(context.Context, *GetActorProfileRequest) (*GetActorProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorProfile not implemented")
+}
+func (UnimplementedQueryServiceServer) GetReputerCommission(context.Context, *GetReputerCommissionRequest) (*GetReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerCommission not implemented")
+}
+func (UnimplementedQueryServiceServer) GetDelegatorPortfolio(context.Context, *GetDelegatorPortfolioRequest) (*GetDelegatorPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatorPortfolio not implemented")
+}
+func (UnimplementedQueryServiceServer) GetDelegateStakeReceiptPool(context.Context, *GetDelegateStakeReceiptPoolRequest) (*GetDelegateStakeReceiptPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateStakeReceiptPool not implemented")
+}
+func (UnimplementedQueryServiceServer) GetReputerDelegateStakeRatio(context.Context, *GetReputerDelegateStakeRatioRequest) (*GetReputerDelegateStakeRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerDelegateStakeRatio not implemented")
+}
+func (UnimplementedQueryServiceServer) SimulateRewards(context.Context, *SimulateRewardsRequest) (*SimulateRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRewards not implemented")
+}
 func (UnimplementedQueryServiceServer) mustEmbedUnimplementedQueryServiceServer() {}
 func (UnimplementedQueryServiceServer) testEmbeddedByValue()                      {}
 
@@ -1442,6 +1778,24 @@ func _QueryService_GetTopic_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_GetTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopics(ctx, req.(*GetTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_GetWorkerLatestInferenceByTopicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkerLatestInferenceByTopicIdRequest)
 	if err := dec(in); err != nil {
@@ -2018,6 +2372,114 @@ func _QueryService_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_IsTopicWorkerWhitelistEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsTopicWorkerWhitelistEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsTopicWorkerWhitelistEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsTopicWorkerWhitelistEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsTopicWorkerWhitelistEnabled(ctx, req.(*IsTopicWorkerWhitelistEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_IsTopicReputerWhitelistEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsTopicReputerWhitelistEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsTopicReputerWhitelistEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsTopicReputerWhitelistEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsTopicReputerWhitelistEnabled(ctx, req.(*IsTopicReputerWhitelistEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_IsWhitelistedTopicWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsWhitelistedTopicWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsWhitelistedTopicWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsWhitelistedTopicWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsWhitelistedTopicWorker(ctx, req.(*IsWhitelistedTopicWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_IsWhitelistedTopicReputer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsWhitelistedTopicReputerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).IsWhitelistedTopicReputer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_IsWhitelistedTopicReputer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).IsWhitelistedTopicReputer(ctx, req.(*IsWhitelistedTopicReputerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicWorkerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicWorkerWhitelist(ctx, req.(*GetTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicReputerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicReputerWhitelist(ctx, req.(*GetTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_GetTopicLastWorkerCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTopicLastWorkerCommitInfoRequest)
 	if err := dec(in); err != nil {
@@ -2864,6 +3326,258 @@ func _QueryService_GetActiveInferersForTopic_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_GetFundingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetFundingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetFundingSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetFundingSubscription(ctx, req.(*GetFundingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicFundingSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicFundingSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicFundingSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicFundingSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicFundingSubscriptions(ctx, req.(*GetTopicFundingSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetFunderFundingSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFunderFundingSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetFunderFundingSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetFunderFundingSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetFunderFundingSubscriptions(ctx, req.(*GetFunderFundingSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetTopicCreatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicCreatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetTopicCreatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetTopicCreatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetTopicCreatorRewards(ctx, req.(*GetTopicCreatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetWorkerBond(ctx, req.(*GetWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetWorkerBondRemovalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBondRemovalInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetWorkerBondRemovalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetWorkerBondRemovalInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetWorkerBondRemovalInfo(ctx, req.(*GetWorkerBondRemovalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetReputerSlashHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputerSlashHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetReputerSlashHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetReputerSlashHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetReputerSlashHistory(ctx, req.(*GetReputerSlashHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetActorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetActorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetActorLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetActorLiveness(ctx, req.(*GetActorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetActorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActorProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetActorProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetActorProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetActorProfile(ctx, req.(*GetActorProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetReputerCommission(ctx, req.(*GetReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetDelegatorPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegatorPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetDelegatorPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetDelegatorPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetDelegatorPortfolio(ctx, req.(*GetDelegatorPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetDelegateStakeReceiptPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegateStakeReceiptPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetDelegateStakeReceiptPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetDelegateStakeReceiptPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetDelegateStakeReceiptPool(ctx, req.(*GetDelegateStakeReceiptPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_GetReputerDelegateStakeRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReputerDelegateStakeRatioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).GetReputerDelegateStakeRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_GetReputerDelegateStakeRatio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).GetReputerDelegateStakeRatio(ctx, req.(*GetReputerDelegateStakeRatioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_SimulateRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).SimulateRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_SimulateRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).SimulateRewards(ctx, req.(*SimulateRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryService_ServiceDesc is the grpc.ServiceDesc for QueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2883,6 +3597,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopic",
 			Handler:    _QueryService_GetTopic_Handler,
 		},
+		{
+			MethodName: "GetTopics",
+			Handler:    _QueryService_GetTopics_Handler,
+		},
 		{
 			MethodName: "GetWorkerLatestInferenceByTopicId",
 			Handler:    _QueryService_GetWorkerLatestInferenceByTopicId_Handler,
@@ -3011,6 +3729,30 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsWhitelistAdmin",
 			Handler:    _QueryService_IsWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "IsTopicWorkerWhitelistEnabled",
+			Handler:    _QueryService_IsTopicWorkerWhitelistEnabled_Handler,
+		},
+		{
+			MethodName: "IsTopicReputerWhitelistEnabled",
+			Handler:    _QueryService_IsTopicReputerWhitelistEnabled_Handler,
+		},
+		{
+			MethodName: "IsWhitelistedTopicWorker",
+			Handler:    _QueryService_IsWhitelistedTopicWorker_Handler,
+		},
+		{
+			MethodName: "IsWhitelistedTopicReputer",
+			Handler:    _QueryService_IsWhitelistedTopicReputer_Handler,
+		},
+		{
+			MethodName: "GetTopicWorkerWhitelist",
+			Handler:    _QueryService_GetTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "GetTopicReputerWhitelist",
+			Handler:    _QueryService_GetTopicReputerWhitelist_Handler,
+		},
 		{
 			MethodName: "GetTopicLastWorkerCommitInfo",
 			Handler:    _QueryService_GetTopicLastWorkerCommitInfo_Handler,
@@ -3199,6 +3941,62 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetActiveInferersForTopic",
 			Handler:    _QueryService_GetActiveInferersForTopic_Handler,
 		},
+		{
+			MethodName: "GetFundingSubscription",
+			Handler:    _QueryService_GetFundingSubscription_Handler,
+		},
+		{
+			MethodName: "GetTopicFundingSubscriptions",
+			Handler:    _QueryService_GetTopicFundingSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetFunderFundingSubscriptions",
+			Handler:    _QueryService_GetFunderFundingSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetTopicCreatorRewards",
+			Handler:    _QueryService_GetTopicCreatorRewards_Handler,
+		},
+		{
+			MethodName: "GetWorkerBond",
+			Handler:    _QueryService_GetWorkerBond_Handler,
+		},
+		{
+			MethodName: "GetWorkerBondRemovalInfo",
+			Handler:    _QueryService_GetWorkerBondRemovalInfo_Handler,
+		},
+		{
+			MethodName: "GetReputerSlashHistory",
+			Handler:    _QueryService_GetReputerSlashHistory_Handler,
+		},
+		{
+			MethodName: "GetActorLiveness",
+			Handler:    _QueryService_GetActorLiveness_Handler,
+		},
+		{
+			MethodName: "GetActorProfile",
+			Handler:    _QueryService_GetActorProfile_Handler,
+		},
+		{
+			MethodName: "GetReputerCommission",
+			Handler:    _QueryService_GetReputerCommission_Handler,
+		},
+		{
+			MethodName: "GetDelegatorPortfolio",
+			Handler:    _QueryService_GetDelegatorPortfolio_Handler,
+		},
+		{
+			MethodName: "GetDelegateStakeReceiptPool",
+			Handler:    _QueryService_GetDelegateStakeReceiptPool_Handler,
+		},
+		{
+			MethodName: "GetReputerDelegateStakeRatio",
+			Handler:    _QueryService_GetReputerDelegateStakeRatio_Handler,
+		},
+		{
+			MethodName: "SimulateRewards",
+			Handler:    _QueryService_SimulateRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v5/query.proto",
@@ -19,22 +19,53 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MsgService_UpdateParams_FullMethodName              = "/emissions.v5.MsgService/UpdateParams"
-	MsgService_CreateNewTopic_FullMethodName            = "/emissions.v5.MsgService/CreateNewTopic"
-	MsgService_Register_FullMethodName                  = "/emissions.v5.MsgService/Register"
-	MsgService_RemoveRegistration_FullMethodName        = "/emissions.v5.MsgService/RemoveRegistration"
-	MsgService_AddStake_FullMethodName                  = "/emissions.v5.MsgService/AddStake"
-	MsgService_RemoveStake_FullMethodName               = "/emissions.v5.MsgService/RemoveStake"
-	MsgService_CancelRemoveStake_FullMethodName         = "/emissions.v5.MsgService/CancelRemoveStake"
-	MsgService_DelegateStake_FullMethodName             = "/emissions.v5.MsgService/DelegateStake"
-	MsgService_RewardDelegateStake_FullMethodName       = "/emissions.v5.MsgService/RewardDelegateStake"
-	MsgService_RemoveDelegateStake_FullMethodName       = "/emissions.v5.MsgService/RemoveDelegateStake"
-	MsgService_CancelRemoveDelegateStake_FullMethodName = "/emissions.v5.MsgService/CancelRemoveDelegateStake"
-	MsgService_FundTopic_FullMethodName                 = "/emissions.v5.MsgService/FundTopic"
-	MsgService_AddToWhitelistAdmin_FullMethodName       = "/emissions.v5.MsgService/AddToWhitelistAdmin"
-	MsgService_RemoveFromWhitelistAdmin_FullMethodName  = "/emissions.v5.MsgService/RemoveFromWhitelistAdmin"
-	MsgService_InsertWorkerPayload_FullMethodName       = "/emissions.v5.MsgService/InsertWorkerPayload"
-	MsgService_InsertReputerPayload_FullMethodName      = "/emissions.v5.MsgService/InsertReputerPayload"
+	MsgService_UpdateParams_FullMethodName                    = "/emissions.v5.MsgService/UpdateParams"
+	MsgService_CreateNewTopic_FullMethodName                  = "/emissions.v5.MsgService/CreateNewTopic"
+	MsgService_UpdateTopic_FullMethodName                     = "/emissions.v5.MsgService/UpdateTopic"
+	MsgService_ArchiveTopic_FullMethodName                    = "/emissions.v5.MsgService/ArchiveTopic"
+	MsgService_Register_FullMethodName                        = "/emissions.v5.MsgService/Register"
+	MsgService_RemoveRegistration_FullMethodName              = "/emissions.v5.MsgService/RemoveRegistration"
+	MsgService_UpdateNodeInfo_FullMethodName                  = "/emissions.v5.MsgService/UpdateNodeInfo"
+	MsgService_Unjail_FullMethodName                          = "/emissions.v5.MsgService/Unjail"
+	MsgService_AddStake_FullMethodName                        = "/emissions.v5.MsgService/AddStake"
+	MsgService_RemoveStake_FullMethodName                     = "/emissions.v5.MsgService/RemoveStake"
+	MsgService_CancelRemoveStake_FullMethodName               = "/emissions.v5.MsgService/CancelRemoveStake"
+	MsgService_AddWorkerBond_FullMethodName                   = "/emissions.v5.MsgService/AddWorkerBond"
+	MsgService_RemoveWorkerBond_FullMethodName                = "/emissions.v5.MsgService/RemoveWorkerBond"
+	MsgService_CancelRemoveWorkerBond_FullMethodName          = "/emissions.v5.MsgService/CancelRemoveWorkerBond"
+	MsgService_SlashWorkerBond_FullMethodName                 = "/emissions.v5.MsgService/SlashWorkerBond"
+	MsgService_DelegateStake_FullMethodName                   = "/emissions.v5.MsgService/DelegateStake"
+	MsgService_RewardDelegateStake_FullMethodName             = "/emissions.v5.MsgService/RewardDelegateStake"
+	MsgService_RemoveDelegateStake_FullMethodName             = "/emissions.v5.MsgService/RemoveDelegateStake"
+	MsgService_CancelRemoveDelegateStake_FullMethodName       = "/emissions.v5.MsgService/CancelRemoveDelegateStake"
+	MsgService_CreateReputerCommission_FullMethodName         = "/emissions.v5.MsgService/CreateReputerCommission"
+	MsgService_EditReputerCommission_FullMethodName           = "/emissions.v5.MsgService/EditReputerCommission"
+	MsgService_RedelegateStake_FullMethodName                 = "/emissions.v5.MsgService/RedelegateStake"
+	MsgService_SetDelegateRewardAutoCompound_FullMethodName   = "/emissions.v5.MsgService/SetDelegateRewardAutoCompound"
+	MsgService_ClaimAllDelegateRewards_FullMethodName         = "/emissions.v5.MsgService/ClaimAllDelegateRewards"
+	MsgService_TokenizeDelegateStake_FullMethodName           = "/emissions.v5.MsgService/TokenizeDelegateStake"
+	MsgService_RedeemDelegateStakeReceipt_FullMethodName      = "/emissions.v5.MsgService/RedeemDelegateStakeReceipt"
+	MsgService_FundTopic_FullMethodName                       = "/emissions.v5.MsgService/FundTopic"
+	MsgService_CreateFundingSubscription_FullMethodName       = "/emissions.v5.MsgService/CreateFundingSubscription"
+	MsgService_TopUpFundingSubscription_FullMethodName        = "/emissions.v5.MsgService/TopUpFundingSubscription"
+	MsgService_CancelFundingSubscription_FullMethodName       = "/emissions.v5.MsgService/CancelFundingSubscription"
+	MsgService_AddToWhitelistAdmin_FullMethodName             = "/emissions.v5.MsgService/AddToWhitelistAdmin"
+	MsgService_RemoveFromWhitelistAdmin_FullMethodName        = "/emissions.v5.MsgService/RemoveFromWhitelistAdmin"
+	MsgService_EnableTopicWorkerWhitelist_FullMethodName      = "/emissions.v5.MsgService/EnableTopicWorkerWhitelist"
+	MsgService_DisableTopicWorkerWhitelist_FullMethodName     = "/emissions.v5.MsgService/DisableTopicWorkerWhitelist"
+	MsgService_EnableTopicReputerWhitelist_FullMethodName     = "/emissions.v5.MsgService/EnableTopicReputerWhitelist"
+	MsgService_DisableTopicReputerWhitelist_FullMethodName    = "/emissions.v5.MsgService/DisableTopicReputerWhitelist"
+	MsgService_AddToTopicWorkerWhitelist_FullMethodName       = "/emissions.v5.MsgService/AddToTopicWorkerWhitelist"
+	MsgService_RemoveFromTopicWorkerWhitelist_FullMethodName  = "/emissions.v5.MsgService/RemoveFromTopicWorkerWhitelist"
+	MsgService_AddToTopicReputerWhitelist_FullMethodName      = "/emissions.v5.MsgService/AddToTopicReputerWhitelist"
+	MsgService_RemoveFromTopicReputerWhitelist_FullMethodName = "/emissions.v5.MsgService/RemoveFromTopicReputerWhitelist"
+	MsgService_InsertWorkerPayload_FullMethodName             = "/emissions.v5.MsgService/InsertWorkerPayload"
+	MsgService_InsertWorkerPayloads_FullMethodName            = "/emissions.v5.MsgService/InsertWorkerPayloads"
+	MsgService_CommitWorkerPayload_FullMethodName             = "/emissions.v5.MsgService/CommitWorkerPayload"
+	MsgService_RevealWorkerPayload_FullMethodName             = "/emissions.v5.MsgService/RevealWorkerPayload"
+	MsgService_InsertReputerPayload_FullMethodName            = "/emissions.v5.MsgService/InsertReputerPayload"
+	MsgService_CommitReputerPayload_FullMethodName            = "/emissions.v5.MsgService/CommitReputerPayload"
+	MsgService_RevealReputerPayload_FullMethodName            = "/emissions.v5.MsgService/RevealReputerPayload"
 )
 
 // MsgServiceClient is the client API for MsgService service.
@@ -45,20 +76,51 @@ const (
 type MsgServiceClient interface {
 	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
 	CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error)
+	UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error)
+	ArchiveTopic(ctx context.Context, in *ArchiveTopicRequest, opts ...grpc.CallOption) (*ArchiveTopicResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error)
+	UpdateNodeInfo(ctx context.Context, in *UpdateNodeInfoRequest, opts ...grpc.CallOption) (*UpdateNodeInfoResponse, error)
+	Unjail(ctx context.Context, in *UnjailRequest, opts ...grpc.CallOption) (*UnjailResponse, error)
 	AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error)
 	RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error)
 	CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error)
+	AddWorkerBond(ctx context.Context, in *AddWorkerBondRequest, opts ...grpc.CallOption) (*AddWorkerBondResponse, error)
+	RemoveWorkerBond(ctx context.Context, in *RemoveWorkerBondRequest, opts ...grpc.CallOption) (*RemoveWorkerBondResponse, error)
+	CancelRemoveWorkerBond(ctx context.Context, in *CancelRemoveWorkerBondRequest, opts ...grpc.CallOption) (*CancelRemoveWorkerBondResponse, error)
+	SlashWorkerBond(ctx context.Context, in *SlashWorkerBondRequest, opts ...grpc.CallOption) (*SlashWorkerBondResponse, error)
 	DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error)
 	RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error)
 	RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error)
+	CreateReputerCommission(ctx context.Context, in *CreateReputerCommissionRequest, opts ...grpc.CallOption) (*CreateReputerCommissionResponse, error)
+	EditReputerCommission(ctx context.Context, in *EditReputerCommissionRequest, opts ...grpc.CallOption) (*EditReputerCommissionResponse, error)
+	RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error)
+	SetDelegateRewardAutoCompound(ctx context.Context, in *SetDelegateRewardAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateRewardAutoCompoundResponse, error)
+	ClaimAllDelegateRewards(ctx context.Context, in *ClaimAllDelegateRewardsRequest, opts ...grpc.CallOption) (*ClaimAllDelegateRewardsResponse, error)
+	TokenizeDelegateStake(ctx context.Context, in *TokenizeDelegateStakeRequest, opts ...grpc.CallOption) (*TokenizeDelegateStakeResponse, error)
+	RedeemDelegateStakeReceipt(ctx context.Context, in *RedeemDelegateStakeReceiptRequest, opts ...grpc.CallOption) (*RedeemDelegateStakeReceiptResponse, error)
 	FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error)
+	CreateFundingSubscription(ctx context.Context, in *CreateFundingSubscriptionRequest, opts ...grpc.CallOption) (*CreateFundingSubscriptionResponse, error)
+	TopUpFundingSubscription(ctx context.Context, in *TopUpFundingSubscriptionRequest, opts ...grpc.CallOption) (*TopUpFundingSubscriptionResponse, error)
+	CancelFundingSubscription(ctx context.Context, in *CancelFundingSubscriptionRequest, opts ...grpc.CallOption) (*CancelFundingSubscriptionResponse, error)
 	AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error)
+	EnableTopicWorkerWhitelist(ctx context.Context, in *EnableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicWorkerWhitelistResponse, error)
+	DisableTopicWorkerWhitelist(ctx context.Context, in *DisableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicWorkerWhitelistResponse, error)
+	EnableTopicReputerWhitelist(ctx context.Context, in *EnableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicReputerWhitelistResponse, error)
+	DisableTopicReputerWhitelist(ctx context.Context, in *DisableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicReputerWhitelistResponse, error)
+	AddToTopicWorkerWhitelist(ctx context.Context, in *AddToTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicWorkerWhitelistResponse, error)
+	RemoveFromTopicWorkerWhitelist(ctx context.Context, in *RemoveFromTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicWorkerWhitelistResponse, error)
+	AddToTopicReputerWhitelist(ctx context.Context, in *AddToTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicReputerWhitelistResponse, error)
+	RemoveFromTopicReputerWhitelist(ctx context.Context, in *RemoveFromTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicReputerWhitelistResponse, error)
 	InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error)
+	InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error)
+	CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error)
 	InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error)
+	CommitReputerPayload(ctx context.Context, in *CommitReputerPayloadRequest, opts ...grpc.CallOption) (*CommitReputerPayloadResponse, error)
+	RevealReputerPayload(ctx context.Context, in *RevealReputerPayloadRequest, opts ...grpc.CallOption) (*RevealReputerPayloadResponse, error)
 }
 
 type msgServiceClient struct {
@@ -89,6 +151,26 @@ func (c *msgServiceClient) CreateNewTopic(ctx context.Context, in *CreateNewTopi
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTopicResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ArchiveTopic(ctx context.Context, in *ArchiveTopicRequest, opts ...grpc.CallOption) (*ArchiveTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveTopicResponse)
+	err := c.cc.Invoke(ctx, MsgService_ArchiveTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterResponse)
@@ -109,6 +191,26 @@ func (c *msgServiceClient) RemoveRegistration(ctx context.Context, in *RemoveReg
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateNodeInfo(ctx context.Context, in *UpdateNodeInfoRequest, opts ...grpc.CallOption) (*UpdateNodeInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNodeInfoResponse)
+	err := c.cc.Invoke(ctx, MsgService_UpdateNodeInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) Unjail(ctx context.Context, in *UnjailRequest, opts ...grpc.CallOption) (*UnjailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnjailResponse)
+	err := c.cc.Invoke(ctx, MsgService_Unjail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddStakeResponse)
@@ -139,6 +241,46 @@ func (c *msgServiceClient) CancelRemoveStake(ctx context.Context, in *CancelRemo
 	return out, nil
 }
 
+func (c *msgServiceClient) AddWorkerBond(ctx context.Context, in *AddWorkerBondRequest, opts ...grpc.CallOption) (*AddWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWorkerBondResponse)
+	err := c.cc.Invoke(ctx, MsgService_AddWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveWorkerBond(ctx context.Context, in *RemoveWorkerBondRequest, opts ...grpc.CallOption) (*RemoveWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWorkerBondResponse)
+	err := c.cc.Invoke(ctx, MsgService_RemoveWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CancelRemoveWorkerBond(ctx context.Context, in *CancelRemoveWorkerBondRequest, opts ...grpc.CallOption) (*CancelRemoveWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelRemoveWorkerBondResponse)
+	err := c.cc.Invoke(ctx, MsgService_CancelRemoveWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SlashWorkerBond(ctx context.Context, in *SlashWorkerBondRequest, opts ...grpc.CallOption) (*SlashWorkerBondResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlashWorkerBondResponse)
+	err := c.cc.Invoke(ctx, MsgService_SlashWorkerBond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DelegateStakeResponse)
@@ -179,6 +321,76 @@ func (c *msgServiceClient) CancelRemoveDelegateStake(ctx context.Context, in *Ca
 	return out, nil
 }
 
+func (c *msgServiceClient) CreateReputerCommission(ctx context.Context, in *CreateReputerCommissionRequest, opts ...grpc.CallOption) (*CreateReputerCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, MsgService_CreateReputerCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) EditReputerCommission(ctx context.Context, in *EditReputerCommissionRequest, opts ...grpc.CallOption) (*EditReputerCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, MsgService_EditReputerCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedelegateStakeResponse)
+	err := c.cc.Invoke(ctx, MsgService_RedelegateStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) SetDelegateRewardAutoCompound(ctx context.Context, in *SetDelegateRewardAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateRewardAutoCompoundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDelegateRewardAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, MsgService_SetDelegateRewardAutoCompound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) ClaimAllDelegateRewards(ctx context.Context, in *ClaimAllDelegateRewardsRequest, opts ...grpc.CallOption) (*ClaimAllDelegateRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimAllDelegateRewardsResponse)
+	err := c.cc.Invoke(ctx, MsgService_ClaimAllDelegateRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) TokenizeDelegateStake(ctx context.Context, in *TokenizeDelegateStakeRequest, opts ...grpc.CallOption) (*TokenizeDelegateStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenizeDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, MsgService_TokenizeDelegateStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RedeemDelegateStakeReceipt(ctx context.Context, in *RedeemDelegateStakeReceiptRequest, opts ...grpc.CallOption) (*RedeemDelegateStakeReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemDelegateStakeReceiptResponse)
+	err := c.cc.Invoke(ctx, MsgService_RedeemDelegateStakeReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FundTopicResponse)
@@ -189,6 +401,36 @@ func (c *msgServiceClient) FundTopic(ctx context.Context, in *FundTopicRequest,
 	return out, nil
 }
 
+func (c *msgServiceClient) CreateFundingSubscription(ctx context.Context, in *CreateFundingSubscriptionRequest, opts ...grpc.CallOption) (*CreateFundingSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFundingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, MsgService_CreateFundingSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) TopUpFundingSubscription(ctx context.Context, in *TopUpFundingSubscriptionRequest, opts ...grpc.CallOption) (*TopUpFundingSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpFundingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, MsgService_TopUpFundingSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CancelFundingSubscription(ctx context.Context, in *CancelFundingSubscriptionRequest, opts ...grpc.CallOption) (*CancelFundingSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFundingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, MsgService_CancelFundingSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddToWhitelistAdminResponse)
@@ -209,6 +451,86 @@ func (c *msgServiceClient) RemoveFromWhitelistAdmin(ctx context.Context, in *Rem
 	return out, nil
 }
 
+func (c *msgServiceClient) EnableTopicWorkerWhitelist(ctx context.Context, in *EnableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicWorkerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_EnableTopicWorkerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) DisableTopicWorkerWhitelist(ctx context.Context, in *DisableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicWorkerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_DisableTopicWorkerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) EnableTopicReputerWhitelist(ctx context.Context, in *EnableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicReputerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_EnableTopicReputerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) DisableTopicReputerWhitelist(ctx context.Context, in *DisableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicReputerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_DisableTopicReputerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) AddToTopicWorkerWhitelist(ctx context.Context, in *AddToTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicWorkerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_AddToTopicWorkerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveFromTopicWorkerWhitelist(ctx context.Context, in *RemoveFromTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicWorkerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_RemoveFromTopicWorkerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) AddToTopicReputerWhitelist(ctx context.Context, in *AddToTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicReputerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_AddToTopicReputerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveFromTopicReputerWhitelist(ctx context.Context, in *RemoveFromTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicReputerWhitelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, MsgService_RemoveFromTopicReputerWhitelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InsertWorkerPayloadResponse)
@@ -219,6 +541,36 @@ func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWo
 	return out, nil
 }
 
+func (c *msgServiceClient) InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertWorkerPayloadsResponse)
+	err := c.cc.Invoke(ctx, MsgService_InsertWorkerPayloads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_CommitWorkerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_RevealWorkerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InsertReputerPayloadResponse)
@@ -229,6 +581,26 @@ func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertR
 	return out, nil
 }
 
+func (c *msgServiceClient) CommitReputerPayload(ctx context.Context, in *CommitReputerPayloadRequest, opts ...grpc.CallOption) (*CommitReputerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_CommitReputerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) RevealReputerPayload(ctx context.Context, in *RevealReputerPayloadRequest, opts ...grpc.CallOption) (*RevealReputerPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, MsgService_RevealReputerPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 // All implementations must embed UnimplementedMsgServiceServer
 // for forward compatibility.
@@ -237,20 +609,51 @@ func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertR
 type MsgServiceServer interface {
 	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
 	CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error)
+	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
+	ArchiveTopic(context.Context, *ArchiveTopicRequest) (*ArchiveTopicResponse, error)
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error)
+	UpdateNodeInfo(context.Context, *UpdateNodeInfoRequest) (*UpdateNodeInfoResponse, error)
+	Unjail(context.Context, *UnjailRequest) (*UnjailResponse, error)
 	AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error)
 	RemoveStake(context.Context, *RemoveStakeRequest) (*RemoveStakeResponse, error)
 	CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error)
+	AddWorkerBond(context.Context, *AddWorkerBondRequest) (*AddWorkerBondResponse, error)
+	RemoveWorkerBond(context.Context, *RemoveWorkerBondRequest) (*RemoveWorkerBondResponse, error)
+	CancelRemoveWorkerBond(context.Context, *CancelRemoveWorkerBondRequest) (*CancelRemoveWorkerBondResponse, error)
+	SlashWorkerBond(context.Context, *SlashWorkerBondRequest) (*SlashWorkerBondResponse, error)
 	DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error)
 	RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error)
 	RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error)
+	CreateReputerCommission(context.Context, *CreateReputerCommissionRequest) (*CreateReputerCommissionResponse, error)
+	EditReputerCommission(context.Context, *EditReputerCommissionRequest) (*EditReputerCommissionResponse, error)
+	RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error)
+	SetDelegateRewardAutoCompound(context.Context, *SetDelegateRewardAutoCompoundRequest) (*SetDelegateRewardAutoCompoundResponse, error)
+	ClaimAllDelegateRewards(context.Context, *ClaimAllDelegateRewardsRequest) (*ClaimAllDelegateRewardsResponse, error)
+	TokenizeDelegateStake(context.Context, *TokenizeDelegateStakeRequest) (*TokenizeDelegateStakeResponse, error)
+	RedeemDelegateStakeReceipt(context.Context, *RedeemDelegateStakeReceiptRequest) (*RedeemDelegateStakeReceiptResponse, error)
 	FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error)
+	CreateFundingSubscription(context.Context, *CreateFundingSubscriptionRequest) (*CreateFundingSubscriptionResponse, error)
+	TopUpFundingSubscription(context.Context, *TopUpFundingSubscriptionRequest) (*TopUpFundingSubscriptionResponse, error)
+	CancelFundingSubscription(context.Context, *CancelFundingSubscriptionRequest) (*CancelFundingSubscriptionResponse, error)
 	AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error)
+	EnableTopicWorkerWhitelist(context.Context, *EnableTopicWorkerWhitelistRequest) (*EnableTopicWorkerWhitelistResponse, error)
+	DisableTopicWorkerWhitelist(context.Context, *DisableTopicWorkerWhitelistRequest) (*DisableTopicWorkerWhitelistResponse, error)
+	EnableTopicReputerWhitelist(context.Context, *EnableTopicReputerWhitelistRequest) (*EnableTopicReputerWhitelistResponse, error)
+	DisableTopicReputerWhitelist(context.Context, *DisableTopicReputerWhitelistRequest) (*DisableTopicReputerWhitelistResponse, error)
+	AddToTopicWorkerWhitelist(context.Context, *AddToTopicWorkerWhitelistRequest) (*AddToTopicWorkerWhitelistResponse, error)
+	RemoveFromTopicWorkerWhitelist(context.Context, *RemoveFromTopicWorkerWhitelistRequest) (*RemoveFromTopicWorkerWhitelistResponse, error)
+	AddToTopicReputerWhitelist(context.Context, *AddToTopicReputerWhitelistRequest) (*AddToTopicReputerWhitelistResponse, error)
+	RemoveFromTopicReputerWhitelist(context.Context, *RemoveFromTopicReputerWhitelistRequest) (*RemoveFromTopicReputerWhitelistResponse, error)
 	InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error)
+	InsertWorkerPayloads(context.Context, *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error)
+	CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error)
 	InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error)
+	CommitReputerPayload(context.Context, *CommitReputerPayloadRequest) (*CommitReputerPayloadResponse, error)
+	RevealReputerPayload(context.Context, *RevealReputerPayloadRequest) (*RevealReputerPayloadResponse, error)
 	mustEmbedUnimplementedMsgServiceServer()
 }
 
@@ -267,12 +670,24 @@ func (UnimplementedMsgServiceServer) UpdateParams(context.Context, *UpdateParams
 func (UnimplementedMsgServiceServer) CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
+func (UnimplementedMsgServiceServer) UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopic not implemented")
+}
+func (UnimplementedMsgServiceServer) ArchiveTopic(context.Context, *ArchiveTopicRequest) (*ArchiveTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTopic not implemented")
+}
 func (UnimplementedMsgServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
 func (UnimplementedMsgServiceServer) RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
 }
+func (UnimplementedMsgServiceServer) UpdateNodeInfo(context.Context, *UpdateNodeInfoRequest) (*UpdateNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeInfo not implemented")
+}
+func (UnimplementedMsgServiceServer) Unjail(context.Context, *UnjailRequest) (*UnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
 func (UnimplementedMsgServiceServer) AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
 }
@@ -282,6 +697,18 @@ func (UnimplementedMsgServiceServer) RemoveStake(context.Context, *RemoveStakeRe
 func (UnimplementedMsgServiceServer) CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
 }
+func (UnimplementedMsgServiceServer) AddWorkerBond(context.Context, *AddWorkerBondRequest) (*AddWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkerBond not implemented")
+}
+func (UnimplementedMsgServiceServer) RemoveWorkerBond(context.Context, *RemoveWorkerBondRequest) (*RemoveWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkerBond not implemented")
+}
+func (UnimplementedMsgServiceServer) CancelRemoveWorkerBond(context.Context, *CancelRemoveWorkerBondRequest) (*CancelRemoveWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveWorkerBond not implemented")
+}
+func (UnimplementedMsgServiceServer) SlashWorkerBond(context.Context, *SlashWorkerBondRequest) (*SlashWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWorkerBond not implemented")
+}
 func (UnimplementedMsgServiceServer) DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
 }
@@ -294,21 +721,90 @@ func (UnimplementedMsgServiceServer) RemoveDelegateStake(context.Context, *Remov
 func (UnimplementedMsgServiceServer) CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
 }
+func (UnimplementedMsgServiceServer) CreateReputerCommission(context.Context, *CreateReputerCommissionRequest) (*CreateReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReputerCommission not implemented")
+}
+func (UnimplementedMsgServiceServer) EditReputerCommission(context.Context, *EditReputerCommissionRequest) (*EditReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditReputerCommission not implemented")
+}
+func (UnimplementedMsgServiceServer) RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateStake not implemented")
+}
+func (UnimplementedMsgServiceServer) SetDelegateRewardAutoCompound(context.Context, *SetDelegateRewardAutoCompoundRequest) (*SetDelegateRewardAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateRewardAutoCompound not implemented")
+}
+func (UnimplementedMsgServiceServer) ClaimAllDelegateRewards(context.Context, *ClaimAllDelegateRewardsRequest) (*ClaimAllDelegateRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllDelegateRewards not implemented")
+}
+func (UnimplementedMsgServiceServer) TokenizeDelegateStake(context.Context, *TokenizeDelegateStakeRequest) (*TokenizeDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeDelegateStake not implemented")
+}
+func (UnimplementedMsgServiceServer) RedeemDelegateStakeReceipt(context.Context, *RedeemDelegateStakeReceiptRequest) (*RedeemDelegateStakeReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemDelegateStakeReceipt not implemented")
+}
 func (UnimplementedMsgServiceServer) FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
 }
+func (UnimplementedMsgServiceServer) CreateFundingSubscription(context.Context, *CreateFundingSubscriptionRequest) (*CreateFundingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFundingSubscription not implemented")
+}
+func (UnimplementedMsgServiceServer) TopUpFundingSubscription(context.Context, *TopUpFundingSubscriptionRequest) (*TopUpFundingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpFundingSubscription not implemented")
+}
+func (UnimplementedMsgServiceServer) CancelFundingSubscription(context.Context, *CancelFundingSubscriptionRequest) (*CancelFundingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFundingSubscription not implemented")
+}
 func (UnimplementedMsgServiceServer) AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
 }
 func (UnimplementedMsgServiceServer) RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
 }
+func (UnimplementedMsgServiceServer) EnableTopicWorkerWhitelist(context.Context, *EnableTopicWorkerWhitelistRequest) (*EnableTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTopicWorkerWhitelist not implemented")
+}
+func (UnimplementedMsgServiceServer) DisableTopicWorkerWhitelist(context.Context, *DisableTopicWorkerWhitelistRequest) (*DisableTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTopicWorkerWhitelist not implemented")
+}
+func (UnimplementedMsgServiceServer) EnableTopicReputerWhitelist(context.Context, *EnableTopicReputerWhitelistRequest) (*EnableTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTopicReputerWhitelist not implemented")
+}
+func (UnimplementedMsgServiceServer) DisableTopicReputerWhitelist(context.Context, *DisableTopicReputerWhitelistRequest) (*DisableTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTopicReputerWhitelist not implemented")
+}
+func (UnimplementedMsgServiceServer) AddToTopicWorkerWhitelist(context.Context, *AddToTopicWorkerWhitelistRequest) (*AddToTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicWorkerWhitelist not implemented")
+}
+func (UnimplementedMsgServiceServer) RemoveFromTopicWorkerWhitelist(context.Context, *RemoveFromTopicWorkerWhitelistRequest) (*RemoveFromTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicWorkerWhitelist not implemented")
+}
+func (UnimplementedMsgServiceServer) AddToTopicReputerWhitelist(context.Context, *AddToTopicReputerWhitelistRequest) (*AddToTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicReputerWhitelist not implemented")
+}
+func (UnimplementedMsgServiceServer) RemoveFromTopicReputerWhitelist(context.Context, *RemoveFromTopicReputerWhitelistRequest) (*RemoveFromTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicReputerWhitelist not implemented")
+}
 func (UnimplementedMsgServiceServer) InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
 }
+func (UnimplementedMsgServiceServer) InsertWorkerPayloads(context.Context, *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayloads not implemented")
+}
+func (UnimplementedMsgServiceServer) CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitWorkerPayload not implemented")
+}
+func (UnimplementedMsgServiceServer) RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealWorkerPayload not implemented")
+}
 func (UnimplementedMsgServiceServer) InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
 }
+func (UnimplementedMsgServiceServer) CommitReputerPayload(context.Context, *CommitReputerPayloadRequest) (*CommitReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReputerPayload not implemented")
+}
+func (UnimplementedMsgServiceServer) RevealReputerPayload(context.Context, *RevealReputerPayloadRequest) (*RevealReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealReputerPayload not implemented")
+}
 func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
 func (UnimplementedMsgServiceServer) testEmbeddedByValue()                    {}
 
@@ -366,31 +862,67 @@ func _MsgService_CreateNewTopic_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _MsgService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServiceServer).Register(ctx, in)
+		return srv.(MsgServiceServer).UpdateTopic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MsgService_Register_FullMethodName,
+		FullMethod: MsgService_UpdateTopic_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(MsgServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRegistrationRequest)
+func _MsgService_ArchiveTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTopicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveRegistration(ctx, in)
+		return srv.(MsgServiceServer).ArchiveTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ArchiveTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ArchiveTopic(ctx, req.(*ArchiveTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -402,6 +934,42 @@ func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_UpdateNodeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateNodeInfo(ctx, req.(*UpdateNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnjailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_Unjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).Unjail(ctx, req.(*UnjailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddStakeRequest)
 	if err := dec(in); err != nil {
@@ -456,6 +1024,78 @@ func _MsgService_CancelRemoveStake_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_AddWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AddWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddWorkerBond(ctx, req.(*AddWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RemoveWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveWorkerBond(ctx, req.(*RemoveWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelRemoveWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRemoveWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelRemoveWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CancelRemoveWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelRemoveWorkerBond(ctx, req.(*CancelRemoveWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SlashWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SlashWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SlashWorkerBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SlashWorkerBond(ctx, req.(*SlashWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DelegateStakeRequest)
 	if err := dec(in); err != nil {
@@ -528,6 +1168,132 @@ func _MsgService_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CreateReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CreateReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateReputerCommission(ctx, req.(*CreateReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_EditReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EditReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_EditReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EditReputerCommission(ctx, req.(*EditReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RedelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RedelegateStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, req.(*RedelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetDelegateRewardAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDelegateRewardAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetDelegateRewardAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_SetDelegateRewardAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetDelegateRewardAutoCompound(ctx, req.(*SetDelegateRewardAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ClaimAllDelegateRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAllDelegateRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ClaimAllDelegateRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_ClaimAllDelegateRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ClaimAllDelegateRewards(ctx, req.(*ClaimAllDelegateRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_TokenizeDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).TokenizeDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_TokenizeDelegateStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).TokenizeDelegateStake(ctx, req.(*TokenizeDelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RedeemDelegateStakeReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemDelegateStakeReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RedeemDelegateStakeReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RedeemDelegateStakeReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RedeemDelegateStakeReceipt(ctx, req.(*RedeemDelegateStakeReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FundTopicRequest)
 	if err := dec(in); err != nil {
@@ -546,6 +1312,60 @@ func _MsgService_FundTopic_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CreateFundingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFundingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateFundingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CreateFundingSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateFundingSubscription(ctx, req.(*CreateFundingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_TopUpFundingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpFundingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).TopUpFundingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_TopUpFundingSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).TopUpFundingSubscription(ctx, req.(*TopUpFundingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelFundingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFundingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelFundingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CancelFundingSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelFundingSubscription(ctx, req.(*CancelFundingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddToWhitelistAdminRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +1402,150 @@ func _MsgService_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_EnableTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EnableTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_EnableTopicWorkerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EnableTopicWorkerWhitelist(ctx, req.(*EnableTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DisableTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DisableTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_DisableTopicWorkerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DisableTopicWorkerWhitelist(ctx, req.(*DisableTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_EnableTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EnableTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_EnableTopicReputerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EnableTopicReputerWhitelist(ctx, req.(*EnableTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DisableTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DisableTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_DisableTopicReputerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DisableTopicReputerWhitelist(ctx, req.(*DisableTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddToTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AddToTopicWorkerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToTopicWorkerWhitelist(ctx, req.(*AddToTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveFromTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RemoveFromTopicWorkerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromTopicWorkerWhitelist(ctx, req.(*RemoveFromTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddToTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_AddToTopicReputerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToTopicReputerWhitelist(ctx, req.(*AddToTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveFromTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RemoveFromTopicReputerWhitelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromTopicReputerWhitelist(ctx, req.(*RemoveFromTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InsertWorkerPayloadRequest)
 	if err := dec(in); err != nil {
@@ -600,6 +1564,60 @@ func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_InsertWorkerPayloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertWorkerPayloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_InsertWorkerPayloads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, req.(*InsertWorkerPayloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CommitWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CommitWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, req.(*CommitWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevealWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RevealWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, req.(*RevealWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InsertReputerPayloadRequest)
 	if err := dec(in); err != nil {
@@ -618,6 +1636,42 @@ func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_CommitReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReputerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CommitReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_CommitReputerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CommitReputerPayload(ctx, req.(*CommitReputerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevealReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealReputerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevealReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_RevealReputerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevealReputerPayload(ctx, req.(*RevealReputerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -633,6 +1687,14 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateNewTopic",
 			Handler:    _MsgService_CreateNewTopic_Handler,
 		},
+		{
+			MethodName: "UpdateTopic",
+			Handler:    _MsgService_UpdateTopic_Handler,
+		},
+		{
+			MethodName: "ArchiveTopic",
+			Handler:    _MsgService_ArchiveTopic_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _MsgService_Register_Handler,
@@ -641,6 +1703,14 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRegistration",
 			Handler:    _MsgService_RemoveRegistration_Handler,
 		},
+		{
+			MethodName: "UpdateNodeInfo",
+			Handler:    _MsgService_UpdateNodeInfo_Handler,
+		},
+		{
+			MethodName: "Unjail",
+			Handler:    _MsgService_Unjail_Handler,
+		},
 		{
 			MethodName: "AddStake",
 			Handler:    _MsgService_AddStake_Handler,
@@ -653,6 +1723,22 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRemoveStake",
 			Handler:    _MsgService_CancelRemoveStake_Handler,
 		},
+		{
+			MethodName: "AddWorkerBond",
+			Handler:    _MsgService_AddWorkerBond_Handler,
+		},
+		{
+			MethodName: "RemoveWorkerBond",
+			Handler:    _MsgService_RemoveWorkerBond_Handler,
+		},
+		{
+			MethodName: "CancelRemoveWorkerBond",
+			Handler:    _MsgService_CancelRemoveWorkerBond_Handler,
+		},
+		{
+			MethodName: "SlashWorkerBond",
+			Handler:    _MsgService_SlashWorkerBond_Handler,
+		},
 		{
 			MethodName: "DelegateStake",
 			Handler:    _MsgService_DelegateStake_Handler,
@@ -669,10 +1755,50 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRemoveDelegateStake",
 			Handler:    _MsgService_CancelRemoveDelegateStake_Handler,
 		},
+		{
+			MethodName: "CreateReputerCommission",
+			Handler:    _MsgService_CreateReputerCommission_Handler,
+		},
+		{
+			MethodName: "EditReputerCommission",
+			Handler:    _MsgService_EditReputerCommission_Handler,
+		},
+		{
+			MethodName: "RedelegateStake",
+			Handler:    _MsgService_RedelegateStake_Handler,
+		},
+		{
+			MethodName: "SetDelegateRewardAutoCompound",
+			Handler:    _MsgService_SetDelegateRewardAutoCompound_Handler,
+		},
+		{
+			MethodName: "ClaimAllDelegateRewards",
+			Handler:    _MsgService_ClaimAllDelegateRewards_Handler,
+		},
+		{
+			MethodName: "TokenizeDelegateStake",
+			Handler:    _MsgService_TokenizeDelegateStake_Handler,
+		},
+		{
+			MethodName: "RedeemDelegateStakeReceipt",
+			Handler:    _MsgService_RedeemDelegateStakeReceipt_Handler,
+		},
 		{
 			MethodName: "FundTopic",
 			Handler:    _MsgService_FundTopic_Handler,
 		},
+		{
+			MethodName: "CreateFundingSubscription",
+			Handler:    _MsgService_CreateFundingSubscription_Handler,
+		},
+		{
+			MethodName: "TopUpFundingSubscription",
+			Handler:    _MsgService_TopUpFundingSubscription_Handler,
+		},
+		{
+			MethodName: "CancelFundingSubscription",
+			Handler:    _MsgService_CancelFundingSubscription_Handler,
+		},
 		{
 			MethodName: "AddToWhitelistAdmin",
 			Handler:    _MsgService_AddToWhitelistAdmin_Handler,
@@ -681,14 +1807,66 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromWhitelistAdmin",
 			Handler:    _MsgService_RemoveFromWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "EnableTopicWorkerWhitelist",
+			Handler:    _MsgService_EnableTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "DisableTopicWorkerWhitelist",
+			Handler:    _MsgService_DisableTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "EnableTopicReputerWhitelist",
+			Handler:    _MsgService_EnableTopicReputerWhitelist_Handler,
+		},
+		{
+			MethodName: "DisableTopicReputerWhitelist",
+			Handler:    _MsgService_DisableTopicReputerWhitelist_Handler,
+		},
+		{
+			MethodName: "AddToTopicWorkerWhitelist",
+			Handler:    _MsgService_AddToTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicWorkerWhitelist",
+			Handler:    _MsgService_RemoveFromTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "AddToTopicReputerWhitelist",
+			Handler:    _MsgService_AddToTopicReputerWhitelist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicReputerWhitelist",
+			Handler:    _MsgService_RemoveFromTopicReputerWhitelist_Handler,
+		},
 		{
 			MethodName: "InsertWorkerPayload",
 			Handler:    _MsgService_InsertWorkerPayload_Handler,
 		},
+		{
+			MethodName: "InsertWorkerPayloads",
+			Handler:    _MsgService_InsertWorkerPayloads_Handler,
+		},
+		{
+			MethodName: "CommitWorkerPayload",
+			Handler:    _MsgService_CommitWorkerPayload_Handler,
+		},
+		{
+			MethodName: "RevealWorkerPayload",
+			Handler:    _MsgService_RevealWorkerPayload_Handler,
+		},
 		{
 			MethodName: "InsertReputerPayload",
 			Handler:    _MsgService_InsertReputerPayload_Handler,
 		},
+		{
+			MethodName: "CommitReputerPayload",
+			Handler:    _MsgService_CommitReputerPayload_Handler,
+		},
+		{
+			MethodName: "RevealReputerPayload",
+			Handler:    _MsgService_RevealReputerPayload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v5/tx.proto",
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k *Keeper) livenessOf(isReputer bool) collections.Map[collections.Pair[TopicId, ActorId], types.ActorLiveness] {
+	if isReputer {
+		return k.reputerLiveness
+	}
+	return k.workerLiveness
+}
+
+// Returns the liveness of a worker or reputer in a topic, and whether it is tracked at all
+func (k *Keeper) GetActorLiveness(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+) (types.ActorLiveness, bool, error) {
+	liveness, err := k.livenessOf(isReputer).Get(ctx, collections.Join(topicId, actor))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.ActorLiveness{}, false, nil
+		}
+		return types.ActorLiveness{}, false, errors.Wrap(err, "error getting actor liveness")
+	}
+	return liveness, true, nil
+}
+
+func (k *Keeper) SetActorLiveness(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+	liveness types.ActorLiveness,
+) error {
+	if err := liveness.Validate(); err != nil {
+		return errors.Wrap(err, "actor liveness validation failed")
+	}
+	return k.livenessOf(isReputer).Set(ctx, collections.Join(topicId, actor), liveness)
+}
+
+func (k *Keeper) RemoveActorLiveness(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	return k.livenessOf(isReputer).Remove(ctx, collections.Join(topicId, actor))
+}
+
+// True if the worker or reputer was jailed from the topic for missing too many epochs
+func (k *Keeper) IsActorJailed(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) (bool, error) {
+	liveness, found, err := k.GetActorLiveness(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return false, err
+	}
+	return found && liveness.Jailed, nil
+}
+
+// Records that workers or reputers submitted a payload for the nonce of a topic
+func (k *Keeper) RecordActorSubmissions(
+	ctx context.Context,
+	topicId TopicId,
+	actors []ActorId,
+	isReputer bool,
+	nonceBlockHeight BlockHeight,
+) error {
+	for _, actor := range actors {
+		liveness, found, err := k.GetActorLiveness(ctx, topicId, actor, isReputer)
+		if err != nil {
+			return err
+		}
+		if !found {
+			liveness.ActiveSince = nonceBlockHeight
+		}
+		if nonceBlockHeight > liveness.LastSubmissionNonce {
+			liveness.LastSubmissionNonce = nonceBlockHeight
+		}
+		if err := k.SetActorLiveness(ctx, topicId, actor, isReputer, liveness); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Jails a worker or reputer from a topic: it is removed from the registered actors of the topic,
+// keeping its node info and stake, until it unjails itself
+func (k *Keeper) JailActor(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	liveness, _, err := k.GetActorLiveness(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return err
+	}
+	if err := k.removeTopicActor(ctx, topicId, actor, isReputer); err != nil {
+		return errors.Wrap(err, "error removing jailed actor from topic")
+	}
+	liveness.Jailed = true
+	liveness.JailedAtBlock = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.SetActorLiveness(ctx, topicId, actor, isReputer, liveness)
+}
+
+// Registers a jailed worker or reputer in the topic again. It is expected to submit from the next epoch on.
+func (k *Keeper) UnjailActor(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	liveness, found, err := k.GetActorLiveness(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return err
+	}
+	if !found || !liveness.Jailed {
+		return types.ErrActorNotJailed
+	}
+	if err := k.setTopicActor(ctx, topicId, actor, isReputer); err != nil {
+		return errors.Wrap(err, "error registering unjailed actor in topic")
+	}
+	liveness.Jailed = false
+	liveness.JailedAtBlock = 0
+	liveness.ActiveSince = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.SetActorLiveness(ctx, topicId, actor, isReputer, liveness)
+}
+
+// Removes a worker or reputer from a topic along with its liveness. It must register again to take part in the topic.
+func (k *Keeper) DeregisterActor(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	if err := k.removeTopicActor(ctx, topicId, actor, isReputer); err != nil {
+		return errors.Wrap(err, "error removing deregistered actor from topic")
+	}
+	return k.RemoveActorLiveness(ctx, topicId, actor, isReputer)
+}
+
+// Block height of the last nonce of a topic workers or reputers are expected to have submitted for.
+// Reputers only report losses for a nonce once its ground truth lag has passed.
+func LivenessReferenceBlock(topic types.Topic, isReputer bool) BlockHeight {
+	if isReputer {
+		return topic.EpochLastEnded - topic.GroundTruthLag
+	}
+	return topic.EpochLastEnded
+}
+
+// Jails the workers and reputers of a topic that missed max_missed_epochs_jail epochs in a row, and deregisters
+// those that missed max_missed_epochs_deregister epochs in a row, jailed or not. Registered actors tracked for
+// the first time, e.g. registered before liveness was tracked, start being expected to submit from now on.
+func (k *Keeper) SweepInactiveActorsOfTopic(ctx sdk.Context, topic types.Topic, moduleParams types.Params) error {
+	if moduleParams.MaxMissedEpochsJail == 0 && moduleParams.MaxMissedEpochsDeregister == 0 {
+		return nil
+	}
+	for _, isReputer := range []bool{false, true} {
+		if err := k.sweepInactiveActors(ctx, topic, isReputer, moduleParams); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) sweepInactiveActors(ctx sdk.Context, topic types.Topic, isReputer bool, moduleParams types.Params) error {
+	referenceBlock := LivenessReferenceBlock(topic, isReputer)
+	if referenceBlock < 0 {
+		return nil
+	}
+
+	// Collect the actors first as the sweep removes them from the topic
+	registered := make([]ActorId, 0)
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topic.Id)
+	iter, err := k.topicActorsOf(isReputer).Iterate(ctx, rng)
+	if err != nil {
+		return errors.Wrap(err, "error iterating topic actors")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return errors.Wrap(err, "error getting topic actors")
+	}
+	for _, key := range keys {
+		registered = append(registered, key.K2())
+	}
+	jailed := make([]ActorId, 0)
+	livenessIter, err := k.livenessOf(isReputer).Iterate(ctx, rng)
+	if err != nil {
+		return errors.Wrap(err, "error iterating actor liveness")
+	}
+	entries, err := livenessIter.KeyValues()
+	if err != nil {
+		return errors.Wrap(err, "error getting actor liveness")
+	}
+	for _, entry := range entries {
+		if entry.Value.Jailed {
+			jailed = append(jailed, entry.Key.K2())
+		}
+	}
+
+	for _, actor := range registered {
+		liveness, found, err := k.GetActorLiveness(ctx, topic.Id, actor, isReputer)
+		if err != nil {
+			return err
+		}
+		if !found {
+			liveness = types.ActorLiveness{
+				LastSubmissionNonce: 0,
+				ActiveSince:         referenceBlock,
+				Jailed:              false,
+				JailedAtBlock:       0,
+			}
+			if err := k.SetActorLiveness(ctx, topic.Id, actor, isReputer, liveness); err != nil {
+				return err
+			}
+			continue
+		}
+		missedEpochs := liveness.MissedEpochs(referenceBlock, topic.EpochLength)
+		if moduleParams.MaxMissedEpochsDeregister > 0 && missedEpochs >= moduleParams.MaxMissedEpochsDeregister {
+			if err := k.DeregisterActor(ctx, topic.Id, actor, isReputer); err != nil {
+				return err
+			}
+			types.EmitNewActorDeregisteredEvent(ctx, topic.Id, actor, isReputer, missedEpochs)
+		} else if moduleParams.MaxMissedEpochsJail > 0 && missedEpochs >= moduleParams.MaxMissedEpochsJail {
+			if err := k.JailActor(ctx, topic.Id, actor, isReputer); err != nil {
+				return err
+			}
+			types.EmitNewActorJailedEvent(ctx, topic.Id, actor, isReputer, missedEpochs)
+		}
+	}
+
+	if moduleParams.MaxMissedEpochsDeregister == 0 {
+		return nil
+	}
+	for _, actor := range jailed {
+		liveness, _, err := k.GetActorLiveness(ctx, topic.Id, actor, isReputer)
+		if err != nil {
+			return err
+		}
+		missedEpochs := liveness.MissedEpochs(referenceBlock, topic.EpochLength)
+		if missedEpochs >= moduleParams.MaxMissedEpochsDeregister {
+			if err := k.DeregisterActor(ctx, topic.Id, actor, isReputer); err != nil {
+				return err
+			}
+			types.EmitNewActorDeregisteredEvent(ctx, topic.Id, actor, isReputer, missedEpochs)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the score of the actor among the scores of a block, or nil if the actor was not scored at that block
+func scoreOfActor(scores types.Scores, actor ActorId) *types.Score {
+	for _, score := range scores.Scores {
+		if score != nil && score.Address == actor {
+			return score
+		}
+	}
+	return nil
+}
+
+// Returns the participation of a worker in a topic, whether or not it is registered in the topic
+func (k *Keeper) GetWorkerTopicProfile(ctx context.Context, topicId TopicId, worker ActorId) (types.WorkerTopicProfile, error) {
+	infererScoreEma, err := k.GetInfererScoreEma(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, err
+	}
+	var latestInfererScore *types.Score
+	if infererScoreEma.BlockHeight > 0 {
+		scores, err := k.GetWorkerInferenceScoresAtBlock(ctx, topicId, infererScoreEma.BlockHeight)
+		if err != nil {
+			return types.WorkerTopicProfile{}, err
+		}
+		latestInfererScore = scoreOfActor(scores, worker)
+	}
+	forecasterScoreEma, err := k.GetForecasterScoreEma(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, err
+	}
+	var latestForecasterScore *types.Score
+	if forecasterScoreEma.BlockHeight > 0 {
+		scores, err := k.GetWorkerForecastScoresAtBlock(ctx, topicId, forecasterScoreEma.BlockHeight)
+		if err != nil {
+			return types.WorkerTopicProfile{}, err
+		}
+		latestForecasterScore = scoreOfActor(scores, worker)
+	}
+
+	infererInclusions, err := k.GetCountInfererInclusionsInTopic(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, err
+	}
+	forecasterInclusions, err := k.GetCountForecasterInclusionsInTopic(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, err
+	}
+	bond, err := k.GetWorkerBond(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, err
+	}
+	inferenceRewardFraction, _, err := k.GetPreviousInferenceRewardFraction(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, err
+	}
+	forecastRewardFraction, _, err := k.GetPreviousForecastRewardFraction(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, err
+	}
+	isActiveInferer, err := k.IsActiveInferer(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, errors.Wrap(err, "error checking for active inferer")
+	}
+	isActiveForecaster, err := k.IsActiveForecaster(ctx, topicId, worker)
+	if err != nil {
+		return types.WorkerTopicProfile{}, errors.Wrap(err, "error checking for active forecaster")
+	}
+
+	return types.WorkerTopicProfile{
+		TopicId:                         topicId,
+		LatestInfererScore:              latestInfererScore,
+		InfererScoreEma:                 &infererScoreEma,
+		LatestForecasterScore:           latestForecasterScore,
+		ForecasterScoreEma:              &forecasterScoreEma,
+		InfererInclusions:               infererInclusions,
+		ForecasterInclusions:            forecasterInclusions,
+		Bond:                            bond,
+		PreviousInferenceRewardFraction: inferenceRewardFraction,
+		PreviousForecastRewardFraction:  forecastRewardFraction,
+		IsActiveInferer:                 isActiveInferer,
+		IsActiveForecaster:              isActiveForecaster,
+	}, nil
+}
+
+// Returns the participation of a reputer in a topic, whether or not it is registered in the topic
+func (k *Keeper) GetReputerTopicProfile(ctx context.Context, topicId TopicId, reputer ActorId) (types.ReputerTopicProfile, error) {
+	scoreEma, err := k.GetReputerScoreEma(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerTopicProfile{}, err
+	}
+	var latestScore *types.Score
+	if scoreEma.BlockHeight > 0 {
+		scores, err := k.GetReputersScoresAtBlock(ctx, topicId, scoreEma.BlockHeight)
+		if err != nil {
+			return types.ReputerTopicProfile{}, err
+		}
+		latestScore = scoreOfActor(scores, reputer)
+	}
+
+	stake, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerTopicProfile{}, err
+	}
+	delegatedStake, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerTopicProfile{}, err
+	}
+	rewardFraction, _, err := k.GetPreviousReputerRewardFraction(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerTopicProfile{}, err
+	}
+	isActive, err := k.IsActiveReputer(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerTopicProfile{}, errors.Wrap(err, "error checking for active reputer")
+	}
+	commissionRate, err := k.GetReputerCommissionRate(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerTopicProfile{}, err
+	}
+
+	return types.ReputerTopicProfile{
+		TopicId:                topicId,
+		LatestScore:            latestScore,
+		ScoreEma:               &scoreEma,
+		Stake:                  stake,
+		DelegatedStake:         delegatedStake,
+		PreviousRewardFraction: rewardFraction,
+		IsActive:               isActive,
+		CommissionRate:         commissionRate,
+	}, nil
+}
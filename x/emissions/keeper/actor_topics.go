@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+)
+
+func (k *Keeper) topicActorsOf(isReputer bool) collections.KeySet[collections.Pair[TopicId, ActorId]] {
+	if isReputer {
+		return k.topicReputers
+	}
+	return k.topicWorkers
+}
+
+func (k *Keeper) actorTopicsOf(isReputer bool) collections.KeySet[collections.Pair[ActorId, TopicId]] {
+	if isReputer {
+		return k.reputerTopics
+	}
+	return k.workerTopics
+}
+
+// Registers a worker or reputer in a topic, in both the topic -> actor set and its actor -> topic reverse index
+func (k *Keeper) setTopicActor(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	if err := k.topicActorsOf(isReputer).Set(ctx, collections.Join(topicId, actor)); err != nil {
+		return err
+	}
+	return k.actorTopicsOf(isReputer).Set(ctx, collections.Join(actor, topicId))
+}
+
+// Removes a worker or reputer from a topic, in both the topic -> actor set and its actor -> topic reverse index
+func (k *Keeper) removeTopicActor(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	if err := k.topicActorsOf(isReputer).Remove(ctx, collections.Join(topicId, actor)); err != nil {
+		return err
+	}
+	return k.actorTopicsOf(isReputer).Remove(ctx, collections.Join(actor, topicId))
+}
+
+// Moves the registration of a worker or reputer in a topic to another address.
+// A registration of the other address is dropped if the actor is not registered.
+func (k *Keeper) moveTopicActor(ctx context.Context, topicId TopicId, from, to ActorId, isReputer bool) error {
+	isRegistered, err := k.topicActorsOf(isReputer).Has(ctx, collections.Join(topicId, from))
+	if err != nil {
+		return errors.Wrap(err, "error getting topic actor key")
+	}
+	if !isRegistered {
+		return k.removeTopicActor(ctx, topicId, to, isReputer)
+	}
+	if err := k.removeTopicActor(ctx, topicId, from, isReputer); err != nil {
+		return errors.Wrap(err, "error removing topic actor key")
+	}
+	return k.setTopicActor(ctx, topicId, to, isReputer)
+}
+
+// Returns the ids of the topics a worker or reputer is registered in, in ascending order
+func (k *Keeper) GetActorTopicIds(ctx context.Context, actor ActorId, isReputer bool) ([]TopicId, error) {
+	rng := collections.NewPrefixedPairRange[ActorId, TopicId](actor)
+	iter, err := k.actorTopicsOf(isReputer).Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating actor topics")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting actor topics")
+	}
+	topicIds := make([]TopicId, 0, len(keys))
+	for _, key := range keys {
+		topicIds = append(topicIds, key.K2())
+	}
+	return topicIds, nil
+}
+
+// Adds the workers and reputers registered in topics to the actor -> topic reverse indexes,
+// e.g. actors registered before the indexes existed
+func (k *Keeper) IndexTopicActors(ctx context.Context) error {
+	for _, isReputer := range []bool{false, true} {
+		iter, err := k.topicActorsOf(isReputer).Iterate(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "error iterating topic actors")
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return errors.Wrap(err, "error getting topic actors")
+		}
+		for _, key := range keys {
+			if err := k.actorTopicsOf(isReputer).Set(ctx, collections.Join(key.K2(), key.K1())); err != nil {
+				return errors.Wrap(err, "error indexing topic actor")
+			}
+		}
+	}
+	return nil
+}
+
+// Removes at most `limit` workers or reputers from a topic along with their entries in the reverse index.
+// Boolean is true if actors remain registered in the topic afterwards, else false
+func (k *Keeper) removeTopicActorsPage(ctx context.Context, topicId TopicId, isReputer bool, limit uint64) (uint64, bool, error) {
+	actors, more, err := k.getTopicActorsPage(ctx, topicId, isReputer, limit)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, actor := range actors {
+		if err := k.removeTopicActor(ctx, topicId, actor, isReputer); err != nil {
+			return 0, false, errors.Wrap(err, "error removing topic actor")
+		}
+	}
+	return uint64(len(actors)), more, nil
+}
+
+// Returns at most `limit` workers or reputers registered in a topic.
+// Boolean is true if there are more actors registered in the topic, else false
+func (k *Keeper) getTopicActorsPage(ctx context.Context, topicId TopicId, isReputer bool, limit uint64) ([]ActorId, bool, error) {
+	actors := make([]ActorId, 0)
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	iter, err := k.topicActorsOf(isReputer).Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating topic actors")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(actors)) >= limit {
+			return actors, true, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error getting topic actor")
+		}
+		actors = append(actors, key.K2())
+	}
+	return actors, false, nil
+}
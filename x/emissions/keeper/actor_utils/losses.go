@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	keeper "github.com/allora-network/allora-chain/x/emissions/keeper"
 	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
 	"github.com/allora-network/allora-chain/x/emissions/types"
@@ -24,39 +25,9 @@ func CloseReputerNonce(
 	ctx sdk.Context,
 	topic types.Topic,
 	nonce types.Nonce) error {
-	/// All filters should be done in order of increasing computational complexity
-	// Check if the worker nonce is unfulfilled
-	workerNonceUnfulfilled, err := k.IsWorkerNonceUnfulfilled(ctx, topic.Id, &nonce)
-	if err != nil {
+	if err := checkReputerNonceClosable(k, ctx, topic, nonce); err != nil {
 		return err
 	}
-	// Throw if worker nonce is unfulfilled -- can't report losses on something not yet committed
-	if workerNonceUnfulfilled {
-		return errorsmod.Wrapf(
-			types.ErrNonceStillUnfulfilled,
-			"Reputer's worker nonce not yet fulfilled for reputer block: %v",
-			&nonce.BlockHeight,
-		)
-	}
-
-	// Check if the reputer nonce is unfulfilled
-	reputerNonceUnfulfilled, err := k.IsReputerNonceUnfulfilled(ctx, topic.Id, &nonce)
-	if err != nil {
-		return err
-	}
-	// Throw if already fulfilled -- can't return a response twice
-	if !reputerNonceUnfulfilled {
-		return errorsmod.Wrapf(
-			types.ErrUnfulfilledNonceNotFound,
-			"Reputer nonce already fulfilled: %v",
-			&nonce.BlockHeight,
-		)
-	}
-	// Check if the window time has passed
-	blockHeight := ctx.BlockHeight()
-	if blockHeight < nonce.BlockHeight+topic.GroundTruthLag {
-		return types.ErrReputerNonceWindowNotAvailable
-	}
 
 	params, err := k.GetParams(ctx)
 	if err != nil {
@@ -95,9 +66,15 @@ func CloseReputerNonce(
 			continue
 		}
 
+		// Delegated stake beyond the delegation limits carries no weight in the consensus
+		effectiveStake, err := k.GetReputerEffectiveStake(ctx, topic.Id, bundle.ValueBundle.Reputer)
+		if err != nil {
+			continue
+		}
+
 		/// Filtering done now, now write what we must for inclusion
 		lossBundlesByReputer = append(lossBundlesByReputer, filteredBundle)
-		stakesByReputer[bundle.ValueBundle.Reputer] = stake
+		stakesByReputer[bundle.ValueBundle.Reputer] = effectiveStake
 	}
 
 	// sort by reputer score descending
@@ -120,7 +97,104 @@ func CloseReputerNonce(
 
 	ctx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d Network Loss Bundle %v", &nonce.BlockHeight, networkLossBundle))
 
-	err = k.InsertNetworkLossBundleAtBlock(ctx, topic.Id, nonce.BlockHeight, networkLossBundle)
+	return setNetworkLossesAndFulfillReputerNonce(k, ctx, topic, nonce, params, networkLossBundle)
+}
+
+// Closes an open reputer nonce of a derived topic. No reputer reports losses for derived topics:
+// the chain computes the network losses against the ground truth given by the parent topic.
+func CloseDerivedReputerNonce(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	topic types.Topic,
+	nonce types.Nonce,
+) error {
+	if err := checkReputerNonceClosable(k, ctx, topic, nonce); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	groundTruth, err := synth.CalcDerivedGroundTruth(ctx, *k, topic, nonce.BlockHeight)
+	if err != nil {
+		return errorsmod.Wrapf(err, "error computing ground truth of derived topic %d", topic.Id)
+	}
+	networkLossBundle, err := synth.CalcChainComputedLosses(ctx, *k, topic, &types.ValueBundle{
+		TopicId:             topic.Id,
+		ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: nonce.BlockHeight}},
+		// network loss bundles are attributed to the "zero" address, see CalcNetworkLosses
+		Reputer:                       "allo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqas6usy",
+		ExtraData:                     nil,
+		CombinedValue:                 alloraMath.Dec{},
+		InfererValues:                 nil,
+		ForecasterValues:              nil,
+		NaiveValue:                    alloraMath.Dec{},
+		OneOutInfererValues:           nil,
+		OneOutForecasterValues:        nil,
+		OneInForecasterValues:         nil,
+		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   &groundTruth,
+		GroundTruthValues:             nil,
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "error computing losses of derived topic %d", topic.Id)
+	}
+
+	ctx.Logger().Debug(fmt.Sprintf("Derived Reputer Nonce %d Network Loss Bundle %v", &nonce.BlockHeight, networkLossBundle))
+
+	return setNetworkLossesAndFulfillReputerNonce(k, ctx, topic, nonce, params, *networkLossBundle)
+}
+
+// Checks that the losses of a reputer nonce can be set: the inferences of the nonce are final,
+// the nonce is still open and its ground truth has been revealed
+func checkReputerNonceClosable(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonce types.Nonce) error {
+	/// All filters should be done in order of increasing computational complexity
+	// Check if the worker nonce is unfulfilled
+	workerNonceUnfulfilled, err := k.IsWorkerNonceUnfulfilled(ctx, topic.Id, &nonce)
+	if err != nil {
+		return err
+	}
+	// Throw if worker nonce is unfulfilled -- can't report losses on something not yet committed
+	if workerNonceUnfulfilled {
+		return errorsmod.Wrapf(
+			types.ErrNonceStillUnfulfilled,
+			"Reputer's worker nonce not yet fulfilled for reputer block: %v",
+			&nonce.BlockHeight,
+		)
+	}
+
+	// Check if the reputer nonce is unfulfilled
+	reputerNonceUnfulfilled, err := k.IsReputerNonceUnfulfilled(ctx, topic.Id, &nonce)
+	if err != nil {
+		return err
+	}
+	// Throw if already fulfilled -- can't return a response twice
+	if !reputerNonceUnfulfilled {
+		return errorsmod.Wrapf(
+			types.ErrUnfulfilledNonceNotFound,
+			"Reputer nonce already fulfilled: %v",
+			&nonce.BlockHeight,
+		)
+	}
+	// Check if the window time has passed
+	if ctx.BlockHeight() < nonce.BlockHeight+topic.GroundTruthLag {
+		return types.ErrReputerNonceWindowNotAvailable
+	}
+	return nil
+}
+
+// Stores the network losses of a reputer nonce, updates the network regrets with them and closes the nonce
+func setNetworkLossesAndFulfillReputerNonce(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	topic types.Topic,
+	nonce types.Nonce,
+	params types.Params,
+	networkLossBundle types.ValueBundle,
+) error {
+	err := k.InsertNetworkLossBundleAtBlock(ctx, topic.Id, nonce.BlockHeight, networkLossBundle)
 	if err != nil {
 		return err
 	}
@@ -150,11 +224,17 @@ func CloseReputerNonce(
 		return err
 	}
 
+	err = k.DeleteChainLossNetworkInferences(ctx, topic.Id, nonce.BlockHeight)
+	if err != nil {
+		return err
+	}
+
 	err = k.SetTopicRewardNonce(ctx, topic.Id, nonce.BlockHeight)
 	if err != nil {
 		return err
 	}
 
+	blockHeight := ctx.BlockHeight()
 	err = k.SetReputerTopicLastCommit(ctx, topic.Id, blockHeight, &nonce)
 	if err != nil {
 		return err
@@ -305,6 +385,8 @@ func FilterUnacceptedWorkersFromReputerValueBundle(
 			OneOutForecasterValues:        acceptedOneOutForecasterValues,
 			OneInForecasterValues:         acceptedOneInForecasterValues,
 			OneOutInfererForecasterValues: acceptedOneOutInfererForecasterValues,
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 			NaiveValue:                    reputerValueBundle.ValueBundle.NaiveValue,
 			CombinedValue:                 reputerValueBundle.ValueBundle.CombinedValue,
 		},
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/header"
 	clog "cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
 	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
 
 	storetypes "cosmossdk.io/store/types"
@@ -65,10 +66,13 @@ func (a *ActorUtilsTestSuite) SetupTest() {
 		emissionstypes.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		emissionstypes.AlloraRewardsAccountName: {"minter"},
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: {"minter"},
-		"bonded_tokens_pool":     {"burner", "staking"},
-		"not_bonded_tokens_pool": {"burner", "staking"},
-		multiPerm:                {"burner", "minter", "staking"},
-		randomPerm:               {"random"},
+		emissionstypes.AlloraRequestsAccountName:                  {"minter"},
+		emissionstypes.AlloraWorkerBondsAccountName:               {"minter"},
+		emissionstypes.AlloraDelegateStakeReceiptsAccountName:     {"minter", "burner"},
+		"bonded_tokens_pool":                                      {"burner", "staking"},
+		"not_bonded_tokens_pool":                                  {"burner", "staking"},
+		multiPerm:                                                 {"burner", "minter", "staking"},
+		randomPerm:                                                {"random"},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -133,6 +137,16 @@ func (a *ActorUtilsTestSuite) SetupTest() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.0001"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.0001"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.0001"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	})
 	a.Require().NoError(err)
 }
@@ -269,6 +283,8 @@ func (a *ActorUtilsTestSuite) TestFilterUnacceptedWorkersFromReputerValueBundle(
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 		OneInForecasterValues: []*emissionstypes.WorkerAttributedValue{
 			{Worker: forecaster1, Value: alloraMath.NewDecFromInt64(1200)},
 			{Worker: forecaster5, Value: alloraMath.NewDecFromInt64(1300)}, // Should be filtered out
@@ -293,3 +309,82 @@ func (a *ActorUtilsTestSuite) TestFilterUnacceptedWorkersFromReputerValueBundle(
 	a.Require().Len(acceptedBundle.ValueBundle.OneOutInfererForecasterValues[0].OneOutInfererValues, 1)
 	a.Require().Len(acceptedBundle.ValueBundle.OneInForecasterValues, 1)
 }
+
+func (a *ActorUtilsTestSuite) TestCloseDerivedReputerNonce() {
+	require := a.Require()
+	k := a.emissionsKeeper
+	parentTopicId := uint64(1)
+	derivedTopicId := uint64(2)
+
+	parentTopic, err := k.GetTopic(a.ctx, parentTopicId)
+	require.NoError(err)
+	derivedTopic := parentTopic
+	derivedTopic.Id = derivedTopicId
+	derivedTopic.GroundTruthSource = &emissionstypes.GroundTruthSource{
+		ParentTopicId: parentTopicId,
+		Aggregation:   emissionstypes.GroundTruthAggregationDifference,
+	}
+	err = k.SetTopic(a.ctx, derivedTopicId, derivedTopic)
+	require.NoError(err)
+
+	inferencesOf := func(topicId uint64, blockHeight int64, values map[string]int64) emissionstypes.Inferences {
+		inferences := emissionstypes.Inferences{Inferences: make([]*emissionstypes.Inference, 0, len(values))}
+		for inferer, value := range values {
+			inferences.Inferences = append(inferences.Inferences, &emissionstypes.Inference{
+				TopicId:     topicId,
+				BlockHeight: blockHeight,
+				Inferer:     inferer,
+				Value:       alloraMath.NewDecFromInt64(value),
+				ExtraData:   nil,
+				Proof:       "",
+				Values:      nil,
+			})
+		}
+		return inferences
+	}
+	// The parent goes from 10 at the nonce to 12 once the ground truth lag of the derived topic has passed,
+	// its inferences after the lag being those of its latest nonce before
+	nonce := emissionstypes.Nonce{BlockHeight: 100}
+	err = k.InsertActiveInferences(a.ctx, parentTopicId, 100, inferencesOf(parentTopicId, 100, map[string]int64{a.addrsStr[0]: 10}))
+	require.NoError(err)
+	err = k.InsertActiveInferences(a.ctx, parentTopicId, 190, inferencesOf(parentTopicId, 190, map[string]int64{a.addrsStr[0]: 12}))
+	require.NoError(err)
+	err = k.InsertActiveInferences(a.ctx, parentTopicId, 210, inferencesOf(parentTopicId, 210, map[string]int64{a.addrsStr[0]: 20}))
+	require.NoError(err)
+	err = k.InsertActiveInferences(a.ctx, derivedTopicId, nonce.BlockHeight,
+		inferencesOf(derivedTopicId, nonce.BlockHeight, map[string]int64{a.addrsStr[1]: 2, a.addrsStr[2]: 4}))
+	require.NoError(err)
+	err = k.AddReputerNonce(a.ctx, derivedTopicId, &nonce)
+	require.NoError(err)
+
+	// The ground truth is not revealed before the lag has passed
+	err = actorutils.CloseDerivedReputerNonce(&k, a.ctx.WithBlockHeight(199), derivedTopic, nonce)
+	require.ErrorIs(err, emissionstypes.ErrReputerNonceWindowNotAvailable)
+
+	ctx := a.ctx.WithBlockHeight(210)
+	err = actorutils.CloseDerivedReputerNonce(&k, ctx, derivedTopic, nonce)
+	require.NoError(err)
+
+	isOpen, err := k.IsReputerNonceUnfulfilled(ctx, derivedTopicId, &nonce)
+	require.NoError(err)
+	require.False(isOpen)
+
+	// Ground truth of 12 - 10 = 2, against which the median network inference of 3 has a squared error of 1
+	networkLosses, err := k.GetNetworkLossBundleAtBlock(ctx, derivedTopicId, nonce.BlockHeight)
+	require.NoError(err)
+	require.NotNil(networkLosses.GroundTruth)
+	require.True(alloraMath.NewDecFromInt64(2).Equal(*networkLosses.GroundTruth))
+	require.True(networkLosses.CombinedValue.IsZero())
+	perfectLoss := alloraMath.NewDecFromInt64(-18)
+	squaredErrorOf2Loss, err := alloraMath.Log10(alloraMath.NewDecFromInt64(4))
+	require.NoError(err)
+	expectedInfererLosses := map[string]alloraMath.Dec{
+		a.addrsStr[1]: perfectLoss,
+		a.addrsStr[2]: squaredErrorOf2Loss,
+	}
+	require.Len(networkLosses.InfererValues, len(expectedInfererLosses))
+	for _, loss := range networkLosses.InfererValues {
+		expected := expectedInfererLosses[loss.Worker]
+		require.True(expected.Equal(loss.Value), "loss of %s: expected %s, got %s", loss.Worker, expected, loss.Value)
+	}
+}
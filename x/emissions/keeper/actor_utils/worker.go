@@ -30,6 +30,13 @@ func CloseWorkerNonce(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonc
 		return types.ErrWorkerNonceWindowNotAvailable
 	}
 
+	// Only revealed payloads made it to the active set, workers who committed to a payload
+	// without revealing it are penalized, even if nothing else was revealed
+	err = penalizeUnrevealedWorkerCommitments(ctx, k, topic, nonce)
+	if err != nil {
+		return err
+	}
+
 	// Get all active inferers for this topic
 	activeInfererAddresses, err := k.GetActiveInferersForTopic(ctx, topic.Id)
 	if err != nil {
@@ -71,6 +78,7 @@ func CloseWorkerNonce(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonc
 	if err != nil {
 		return err
 	}
+
 	// Update the unfulfilled worker nonce
 	_, err = k.FulfillWorkerNonce(ctx, topic.Id, &nonce)
 	if err != nil {
@@ -103,6 +111,30 @@ func CloseWorkerNonce(k *keeper.Keeper, ctx sdk.Context, topic types.Topic, nonc
 	return nil
 }
 
+// Penalizes the score EMA of the workers who committed to a payload for the nonce but did not reveal it,
+// then drops all commitments of the topic
+func penalizeUnrevealedWorkerCommitments(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	topic types.Topic,
+	nonce types.Nonce,
+) error {
+	commitments, err := k.GetWorkerCommitmentsOfTopic(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	for _, commitment := range commitments {
+		if commitment.NonceBlockHeight != nonce.BlockHeight {
+			continue
+		}
+		err := k.CalcAndSaveInfererScoreEmaForUnrevealedCommitment(ctx, topic, nonce.BlockHeight, commitment.Worker)
+		if err != nil {
+			return err
+		}
+	}
+	return k.ResetWorkerCommitmentsForTopic(ctx, topic.Id)
+}
+
 func closeActiveInferencesSet(
 	ctx sdk.Context,
 	k *keeper.Keeper,
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the network inferences, one per output, the losses of a reputer nonce of a topic with chain
+// computed losses are computed from, if they have been computed already
+func (k *Keeper) GetChainLossNetworkInferences(
+	ctx context.Context,
+	topicId TopicId,
+	reputerNonce BlockHeight,
+) ([]*types.ValueBundle, bool, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, uint64](topicId, reputerNonce)
+	iter, err := k.chainLossNetworkInferences.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating over chain loss network inferences")
+	}
+	values, err := iter.Values()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error getting chain loss network inferences")
+	}
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+	components := make([]*types.ValueBundle, 0, len(values))
+	for i := range values {
+		components = append(components, &values[i])
+	}
+	return components, true, nil
+}
+
+// Records the network inferences, one per output, the losses of a reputer nonce of a topic with chain
+// computed losses are computed from, so that every reputer of the nonce is scored against the same ones
+func (k *Keeper) SetChainLossNetworkInferences(
+	ctx context.Context,
+	topicId TopicId,
+	reputerNonce BlockHeight,
+	components []*types.ValueBundle,
+) error {
+	for i, component := range components {
+		if component == nil {
+			return errors.Wrapf(types.ErrInvalidValue, "network inferences of output %d missing", i)
+		}
+		if err := k.chainLossNetworkInferences.Set(ctx, collections.Join3(topicId, reputerNonce, uint64(i)), *component); err != nil {
+			return errors.Wrap(err, "error setting chain loss network inferences")
+		}
+	}
+	return nil
+}
+
+// Removes the network inferences recorded for the losses of a reputer nonce of a topic
+func (k *Keeper) DeleteChainLossNetworkInferences(ctx context.Context, topicId TopicId, reputerNonce BlockHeight) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, uint64](topicId, reputerNonce)
+	return k.chainLossNetworkInferences.Clear(ctx, rng)
+}
+
+// Removes the network inferences recorded for the losses of the reputer nonces of a topic up to a block
+func (k *Keeper) pruneChainLossNetworkInferences(ctx context.Context, topicId TopicId, blockHeight BlockHeight) error {
+	rng := (&collections.Range[collections.Triple[TopicId, BlockHeight, uint64]]{}).
+		Prefix(collections.TriplePrefix[TopicId, BlockHeight, uint64](topicId)).
+		EndExclusive(collections.Join3(topicId, blockHeight+1, uint64(0)))
+	return k.chainLossNetworkInferences.Clear(ctx, rng)
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// True if the pending rewards of the delegator are restaked into its delegations at each payout
+func (k *Keeper) IsDelegateRewardAutoCompound(ctx context.Context, delegator ActorId) (bool, error) {
+	return k.autoCompoundDelegators.Has(ctx, delegator)
+}
+
+// Sets whether the pending rewards of the delegator are restaked into its delegations at each payout.
+// The current delegations of the delegator are (un)indexed by topic along with it, later ones as they are placed
+func (k *Keeper) SetDelegateRewardAutoCompound(ctx context.Context, delegator ActorId, enabled bool) error {
+	if err := types.ValidateBech32(delegator); err != nil {
+		return errors.Wrap(err, "delegator is not valid")
+	}
+	delegations, err := k.GetDelegatorDelegations(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	for _, delegation := range delegations {
+		key := collections.Join3(delegation.K1(), delegation.K2(), delegator)
+		if enabled {
+			err = k.autoCompoundDelegations.Set(ctx, key)
+		} else {
+			err = k.autoCompoundDelegations.Remove(ctx, key)
+		}
+		if err != nil {
+			return errors.Wrap(err, "error indexing auto-compound delegation")
+		}
+	}
+	if !enabled {
+		return k.autoCompoundDelegators.Remove(ctx, delegator)
+	}
+	return k.autoCompoundDelegators.Set(ctx, delegator)
+}
+
+// Returns the (reputer, delegator) pairs of the delegations in a topic whose pending rewards are restaked at
+// each payout, ordered by reputer then delegator
+func (k *Keeper) GetAutoCompoundDelegationsInTopic(ctx context.Context, topicId TopicId) ([]collections.Pair[Reputer, Delegator], error) {
+	rng := collections.NewPrefixedTripleRange[TopicId, Reputer, Delegator](topicId)
+	iter, err := k.autoCompoundDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating auto-compound delegations")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting auto-compound delegations")
+	}
+	delegations := make([]collections.Pair[Reputer, Delegator], 0, len(keys))
+	for _, key := range keys {
+		delegations = append(delegations, collections.Join(key.K2(), key.K3()))
+	}
+	return delegations, nil
+}
+
+// Returns at most `limit` (reputer, delegator) pairs of the auto-compound delegations in a topic, resuming after
+// the last delegation returned by the previous call. Once the last delegation of the topic is returned, the next
+// call starts over from the first one
+func (k *Keeper) GetNextAutoCompoundDelegationsInTopic(
+	ctx context.Context,
+	topicId TopicId,
+	limit uint64,
+) ([]collections.Pair[Reputer, Delegator], error) {
+	rng := (&collections.Range[collections.Triple[TopicId, Reputer, Delegator]]{}).
+		Prefix(collections.TriplePrefix[TopicId, Reputer, Delegator](topicId))
+	cursor, err := k.autoCompoundCursors.Get(ctx, topicId)
+	if err == nil {
+		rng = rng.StartExclusive(collections.Join3(topicId, cursor.K1(), cursor.K2()))
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, errors.Wrap(err, "error getting auto-compound cursor")
+	}
+	iter, err := k.autoCompoundDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating auto-compound delegations")
+	}
+	defer iter.Close()
+
+	delegations := make([]collections.Pair[Reputer, Delegator], 0)
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(delegations)) >= limit {
+			last := delegations[len(delegations)-1]
+			if err := k.autoCompoundCursors.Set(ctx, topicId, last); err != nil {
+				return nil, errors.Wrap(err, "error setting auto-compound cursor")
+			}
+			return delegations, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting auto-compound delegation")
+		}
+		delegations = append(delegations, collections.Join(key.K2(), key.K3()))
+	}
+	if err := k.autoCompoundCursors.Remove(ctx, topicId); err != nil {
+		return nil, errors.Wrap(err, "error removing auto-compound cursor")
+	}
+	return delegations, nil
+}
+
+// Returns the delegators whose pending rewards are restaked at each payout
+func (k *Keeper) GetAutoCompoundDelegators(ctx context.Context) ([]ActorId, error) {
+	iter, err := k.autoCompoundDelegators.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating auto-compound delegators")
+	}
+	delegators, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting auto-compound delegators")
+	}
+	return delegators, nil
+}
+
+// Returns the reward earned by a delegation and not claimed yet, along with the reward debt that settles it
+func pendingDelegateReward(delegateInfo types.DelegatorInfo, share alloraMath.Dec) (alloraMath.Dec, alloraMath.Dec, error) {
+	rewardDebt, err := delegateInfo.Amount.Mul(share)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
+	}
+	pendingReward, err := rewardDebt.Sub(delegateInfo.RewardDebt)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
+	}
+	return pendingReward, rewardDebt, nil
+}
+
+// Returns the reward earned by a delegation and not claimed yet
+func (k *Keeper) GetDelegatePendingReward(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) (alloraMath.Dec, error) {
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	pendingReward, _, err := pendingDelegateReward(delegateInfo, share)
+	return pendingReward, err
+}
+
+// Settles the reward debt of a delegation, returning the whole pending reward of the delegation.
+// The reward is left to the caller to move out of the pending delegator rewards account.
+func (k *Keeper) settleDelegateReward(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) (cosmosMath.Int, error) {
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	pendingReward, rewardDebt, err := pendingDelegateReward(delegateInfo, share)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if !pendingReward.Gt(alloraMath.ZeroDec()) {
+		return cosmosMath.ZeroInt(), nil
+	}
+	pendingRewardInt, err := pendingReward.SdkIntTrim()
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	delegateInfo.RewardDebt = rewardDebt
+	if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, delegateInfo); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return pendingRewardInt, nil
+}
+
+// Pays out the pending reward of a delegation to the delegator, returning the amount paid
+func (k *Keeper) ClaimDelegateReward(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) (cosmosMath.Int, error) {
+	reward, err := k.settleDelegateReward(ctx, topicId, delegator, reputer)
+	if err != nil || reward.IsZero() {
+		return reward, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, reward))
+	err = k.SendCoinsFromModuleToAccount(ctx, types.AlloraPendingRewardForDelegatorAccountName, delegator, coins)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error sending pending reward to delegator")
+	}
+	return reward, nil
+}
+
+// Restakes the pending reward of a delegation into the same delegation, returning the amount restaked.
+// Only as much as the delegate stake limits of the reputer allow is restaked, the rest is paid out to the delegator.
+func (k *Keeper) CompoundDelegateReward(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) (cosmosMath.Int, error) {
+	reward, err := k.settleDelegateReward(ctx, topicId, delegator, reputer)
+	if err != nil || reward.IsZero() {
+		return reward, err
+	}
+	restaked := reward
+	headroom, limited, err := k.GetDelegateStakeHeadroom(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if limited {
+		restaked = cosmosMath.MinInt(reward, headroom)
+	}
+	if paidOut := reward.Sub(restaked); paidOut.IsPositive() {
+		err = k.sendCoinsToDelegator(ctx, types.AlloraPendingRewardForDelegatorAccountName, topicId, delegator, reputer, paidOut)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error paying out pending reward beyond delegate stake limits")
+		}
+	}
+	if !restaked.IsPositive() {
+		return cosmosMath.ZeroInt(), nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, restaked))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraPendingRewardForDelegatorAccountName, types.AlloraStakingAccountName, coins)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error sending pending reward to staking account")
+	}
+	if err := k.AddDelegateStake(ctx, topicId, delegator, reputer, restaked); err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error restaking pending reward")
+	}
+	return restaked, nil
+}
@@ -0,0 +1,429 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Address of the module account delegating the stake of every delegate stake receipt pool
+func (k *Keeper) delegateStakeReceiptsAddress() ActorId {
+	return k.authKeeper.GetModuleAddress(types.AlloraDelegateStakeReceiptsAccountName).String()
+}
+
+// Returns a delegate stake receipt pool by id
+func (k *Keeper) GetDelegateStakeReceiptPool(ctx context.Context, poolId uint64) (types.DelegateStakeReceiptPool, bool, error) {
+	pool, err := k.delegateStakeReceiptPools.Get(ctx, poolId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.DelegateStakeReceiptPool{}, false, nil
+		}
+		return types.DelegateStakeReceiptPool{}, false, errors.Wrap(err, "error getting delegate stake receipt pool")
+	}
+	return pool, true, nil
+}
+
+// Returns the delegate stake receipt pool of the stake delegated upon a reputer in a topic, if any
+func (k *Keeper) GetDelegateStakeReceiptPoolOf(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (types.DelegateStakeReceiptPool, bool, error) {
+	poolId, err := k.delegateStakeReceiptPoolIds.Get(ctx, collections.Join(topicId, reputer))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.DelegateStakeReceiptPool{}, false, nil
+		}
+		return types.DelegateStakeReceiptPool{}, false, errors.Wrap(err, "error getting delegate stake receipt pool id")
+	}
+	return k.GetDelegateStakeReceiptPool(ctx, poolId)
+}
+
+// Sets a delegate stake receipt pool along with its (topic, reputer) index
+func (k *Keeper) SetDelegateStakeReceiptPool(ctx context.Context, pool types.DelegateStakeReceiptPool) error {
+	if err := pool.Validate(); err != nil {
+		return errors.Wrap(err, "delegate stake receipt pool validation failed")
+	}
+	if err := k.delegateStakeReceiptPools.Set(ctx, pool.Id, pool); err != nil {
+		return errors.Wrap(err, "error setting delegate stake receipt pool")
+	}
+	return k.delegateStakeReceiptPoolIds.Set(ctx, collections.Join(pool.TopicId, pool.Reputer), pool.Id)
+}
+
+// Returns every delegate stake receipt pool, by ascending id
+func (k *Keeper) GetDelegateStakeReceiptPools(ctx context.Context) ([]types.DelegateStakeReceiptPool, error) {
+	iter, err := k.delegateStakeReceiptPools.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating delegate stake receipt pools")
+	}
+	pools, err := iter.Values()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting delegate stake receipt pools")
+	}
+	return pools, nil
+}
+
+// Returns the id the next delegate stake receipt pool will be created with
+func (k *Keeper) GetNextDelegateStakeReceiptPoolId(ctx context.Context) (uint64, error) {
+	return k.nextDelegateStakeReceiptPoolId.Peek(ctx)
+}
+
+// Sets the id the next delegate stake receipt pool will be created with
+func (k *Keeper) SetNextDelegateStakeReceiptPoolId(ctx context.Context, poolId uint64) error {
+	return k.nextDelegateStakeReceiptPoolId.Set(ctx, poolId)
+}
+
+// Returns the delegate stake receipt pool upon a reputer in a topic, creating it if there is none yet.
+// Pool ids start from 1
+func (k *Keeper) getOrCreateDelegateStakeReceiptPool(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (types.DelegateStakeReceiptPool, error) {
+	pool, found, err := k.GetDelegateStakeReceiptPoolOf(ctx, topicId, reputer)
+	if err != nil || found {
+		return pool, err
+	}
+	poolId, err := k.nextDelegateStakeReceiptPoolId.Next(ctx)
+	if err != nil {
+		return types.DelegateStakeReceiptPool{}, errors.Wrap(err, "error getting next delegate stake receipt pool id")
+	}
+	if poolId == 0 {
+		if poolId, err = k.nextDelegateStakeReceiptPoolId.Next(ctx); err != nil {
+			return types.DelegateStakeReceiptPool{}, errors.Wrap(err, "error getting next delegate stake receipt pool id")
+		}
+	}
+	pool = types.DelegateStakeReceiptPool{
+		Id:      poolId,
+		TopicId: topicId,
+		Reputer: reputer,
+		Shares:  cosmosMath.ZeroInt(),
+		Reserve: cosmosMath.ZeroInt(),
+	}
+	if err := k.SetDelegateStakeReceiptPool(ctx, pool); err != nil {
+		return types.DelegateStakeReceiptPool{}, err
+	}
+	return pool, nil
+}
+
+// Returns the stake a delegate stake receipt pool delegates and the reward pending upon it
+func (k *Keeper) GetDelegateStakeReceiptPoolStake(
+	ctx context.Context,
+	pool types.DelegateStakeReceiptPool,
+) (stake alloraMath.Dec, pendingReward alloraMath.Dec, err error) {
+	poolAddress := k.delegateStakeReceiptsAddress()
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, pool.TopicId, poolAddress, pool.Reputer)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
+	}
+	pendingReward, err = k.GetDelegatePendingReward(ctx, pool.TopicId, poolAddress, pool.Reputer)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
+	}
+	return delegateInfo.Amount, pendingReward, nil
+}
+
+// Sends coins owed to a delegator upon a reputer in a topic out of a module account.
+// Coins owed to the delegation of a delegate stake receipt pool are kept in the reserve of the pool,
+// as the module account of the pools does not receive bank transfers.
+func (k *Keeper) sendCoinsToDelegator(
+	ctx context.Context,
+	senderModule string,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	amount cosmosMath.Int,
+) error {
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	if delegator != k.delegateStakeReceiptsAddress() {
+		return k.SendCoinsFromModuleToAccount(ctx, senderModule, delegator, coins)
+	}
+	pool, found, err := k.GetDelegateStakeReceiptPoolOf(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	} else if !found {
+		return errors.Wrapf(types.ErrDelegateStakeReceiptPoolNotFound, "topic %d reputer %s", topicId, reputer)
+	}
+	if err := k.SendCoinsFromModuleToModule(ctx, senderModule, types.AlloraDelegateStakeReceiptsAccountName, coins); err != nil {
+		return errors.Wrap(err, "error sending coins to delegate stake receipt pool reserve")
+	}
+	pool.Reserve = pool.Reserve.Add(amount)
+	return k.SetDelegateStakeReceiptPool(ctx, pool)
+}
+
+// Settles the pending reward of the delegation of a delegate stake receipt pool into its reserve.
+// If `compound`, the reserve is then restaked into the delegation so that receipts accrue its rewards.
+// Only as much as the delegate stake limits of the reputer allow is restaked, the rest is left in the reserve.
+// Returns the pool as updated
+func (k *Keeper) settleDelegateStakeReceiptPool(
+	ctx context.Context,
+	pool types.DelegateStakeReceiptPool,
+	compound bool,
+) (types.DelegateStakeReceiptPool, error) {
+	poolAddress := k.delegateStakeReceiptsAddress()
+	reward, err := k.settleDelegateReward(ctx, pool.TopicId, poolAddress, pool.Reputer)
+	if err != nil {
+		return types.DelegateStakeReceiptPool{}, err
+	}
+	if reward.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, reward))
+		err = k.SendCoinsFromModuleToModule(ctx, types.AlloraPendingRewardForDelegatorAccountName, types.AlloraDelegateStakeReceiptsAccountName, coins)
+		if err != nil {
+			return types.DelegateStakeReceiptPool{}, errors.Wrap(err, "error sending pending reward to delegate stake receipt pool reserve")
+		}
+		pool.Reserve = pool.Reserve.Add(reward)
+	}
+	if compound && pool.Reserve.IsPositive() {
+		restaked := pool.Reserve
+		headroom, limited, err := k.GetDelegateStakeHeadroom(ctx, pool.TopicId, pool.Reputer)
+		if err != nil {
+			return types.DelegateStakeReceiptPool{}, err
+		}
+		if limited {
+			restaked = cosmosMath.MinInt(restaked, headroom)
+		}
+		if restaked.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, restaked))
+			err = k.SendCoinsFromModuleToModule(ctx, types.AlloraDelegateStakeReceiptsAccountName, types.AlloraStakingAccountName, coins)
+			if err != nil {
+				return types.DelegateStakeReceiptPool{}, errors.Wrap(err, "error sending delegate stake receipt pool reserve to staking account")
+			}
+			if err := k.AddDelegateStake(ctx, pool.TopicId, poolAddress, pool.Reputer, restaked); err != nil {
+				return types.DelegateStakeReceiptPool{}, errors.Wrap(err, "error restaking delegate stake receipt pool reserve")
+			}
+			pool.Reserve = pool.Reserve.Sub(restaked)
+		}
+	}
+	if err := k.SetDelegateStakeReceiptPool(ctx, pool); err != nil {
+		return types.DelegateStakeReceiptPool{}, err
+	}
+	return pool, nil
+}
+
+// Moves stake a delegator has delegated upon a reputer in a topic into the delegate stake receipt pool
+// of the reputer, minting receipts to the delegator as shares of the pool. The reward pending upon the
+// moved stake is paid out to the delegator.
+// Returns the pool and the receipts minted
+func (k *Keeper) TokenizeDelegateStake(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	amount cosmosMath.Int,
+) (types.DelegateStakeReceiptPool, cosmosMath.Int, error) {
+	pool, err := k.getOrCreateDelegateStakeReceiptPool(ctx, topicId, reputer)
+	if err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, err
+	}
+	pool, err = k.settleDelegateStakeReceiptPool(ctx, pool, true)
+	if err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, err
+	}
+	poolStake, _, err := k.GetDelegateStakeReceiptPoolStake(ctx, pool)
+	if err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, err
+	}
+	poolStakeInt, err := poolStake.SdkIntTrim()
+	if err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, errors.Wrap(err, "error trimming delegate stake receipt pool stake")
+	}
+
+	// Receipts are minted 1:1 with stake into a pool without receipts, else pro rata of the pool stake
+	// and of the reserve the delegate stake limits of the reputer kept from being restaked
+	shares := amount
+	if pool.Shares.IsPositive() {
+		poolValue := poolStakeInt.Add(pool.Reserve)
+		if !poolValue.IsPositive() {
+			return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, types.ErrDelegateStakeReceiptPoolDepleted
+		}
+		shares = amount.Mul(pool.Shares).Quo(poolValue)
+	}
+	if !shares.IsPositive() {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, errors.Wrap(types.ErrInvalidValue, "stake to tokenize is too small to mint any receipt")
+	}
+
+	if err := k.removeDelegateStake(ctx, topicId, delegator, reputer, amount); err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, errors.Wrap(err, "error removing stake from delegator")
+	}
+	if err := k.AddDelegateStake(ctx, topicId, k.delegateStakeReceiptsAddress(), reputer, amount); err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, errors.Wrap(err, "error adding stake to delegate stake receipt pool")
+	}
+	receipts := sdk.NewCoins(sdk.NewCoin(types.DelegateStakeReceiptDenom(pool.Id), shares))
+	if err := k.bankKeeper.MintCoins(ctx, types.AlloraDelegateStakeReceiptsAccountName, receipts); err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, errors.Wrap(err, "error minting delegate stake receipts")
+	}
+	err = k.SendCoinsFromModuleToAccount(ctx, types.AlloraDelegateStakeReceiptsAccountName, delegator, receipts)
+	if err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, errors.Wrap(err, "error sending delegate stake receipts to delegator")
+	}
+
+	pool.Shares = pool.Shares.Add(shares)
+	if err := k.SetDelegateStakeReceiptPool(ctx, pool); err != nil {
+		return types.DelegateStakeReceiptPool{}, cosmosMath.Int{}, err
+	}
+	return pool, shares, nil
+}
+
+// Burns receipts of a delegate stake receipt pool held by an account in exchange for their share of the
+// pool. The share of the pool stake is delegated back to the reputer of the pool on behalf of the holder,
+// unless the topic of the pool is archived in which case it is paid out along with the share of the reserve.
+// Returns the stake delegated and the coins paid out
+func (k *Keeper) RedeemDelegateStakeReceipt(
+	ctx context.Context,
+	holder ActorId,
+	poolId uint64,
+	shares cosmosMath.Int,
+) (delegated cosmosMath.Int, returned cosmosMath.Int, err error) {
+	pool, found, err := k.GetDelegateStakeReceiptPool(ctx, poolId)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	} else if !found {
+		return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrapf(types.ErrDelegateStakeReceiptPoolNotFound, "pool %d", poolId)
+	}
+	if shares.GT(pool.Shares) {
+		return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrapf(types.ErrInvalidValue, "pool %d has only %s receipts", poolId, pool.Shares)
+	}
+	archive, isArchived, err := k.GetTopicArchive(ctx, pool.TopicId)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	// Once the stake of an archived topic is returned, the pool holds nothing but its reserve
+	if !archive.StakeReturned {
+		pool, err = k.settleDelegateStakeReceiptPool(ctx, pool, !isArchived)
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+	}
+	poolStake, _, err := k.GetDelegateStakeReceiptPoolStake(ctx, pool)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	poolStakeInt, err := poolStake.SdkIntTrim()
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrap(err, "error trimming delegate stake receipt pool stake")
+	}
+	stakeOut := poolStakeInt.Mul(shares).Quo(pool.Shares)
+	reserveOut := pool.Reserve.Mul(shares).Quo(pool.Shares)
+
+	receipts := sdk.NewCoins(sdk.NewCoin(types.DelegateStakeReceiptDenom(pool.Id), shares))
+	err = k.SendCoinsFromAccountToModule(ctx, holder, types.AlloraDelegateStakeReceiptsAccountName, receipts)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrap(err, "error sending delegate stake receipts to be burnt")
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.AlloraDelegateStakeReceiptsAccountName, receipts); err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrap(err, "error burning delegate stake receipts")
+	}
+
+	delegated = cosmosMath.ZeroInt()
+	returned = cosmosMath.ZeroInt()
+	if stakeOut.IsPositive() {
+		err := k.removeDelegateStake(ctx, pool.TopicId, k.delegateStakeReceiptsAddress(), pool.Reputer, stakeOut)
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrap(err, "error removing stake from delegate stake receipt pool")
+		}
+		if isArchived {
+			coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, stakeOut))
+			if err := k.SendCoinsFromModuleToAccount(ctx, types.AlloraStakingAccountName, holder, coins); err != nil {
+				return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrap(err, "error returning stake of archived topic")
+			}
+			returned = returned.Add(stakeOut)
+		} else {
+			if err := k.AddDelegateStake(ctx, pool.TopicId, holder, pool.Reputer, stakeOut); err != nil {
+				return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrap(err, "error delegating redeemed stake")
+			}
+			delegated = stakeOut
+		}
+	}
+	if reserveOut.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, reserveOut))
+		err := k.SendCoinsFromModuleToAccount(ctx, types.AlloraDelegateStakeReceiptsAccountName, holder, coins)
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, errors.Wrap(err, "error paying out delegate stake receipt pool reserve")
+		}
+		pool.Reserve = pool.Reserve.Sub(reserveOut)
+		returned = returned.Add(reserveOut)
+	}
+
+	pool.Shares = pool.Shares.Sub(shares)
+	if archive.StakeReturned {
+		err = k.setDelegateStakeReceiptPoolOfArchivedTopic(ctx, pool)
+	} else {
+		err = k.SetDelegateStakeReceiptPool(ctx, pool)
+	}
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	return delegated, returned, nil
+}
+
+// Sets a delegate stake receipt pool of an archived topic whose stake has been returned.
+// The (topic, reputer) index is pruned along with the rest of the state of the topic,
+// the pool is only kept by id for its receipts to be redeemed
+func (k *Keeper) setDelegateStakeReceiptPoolOfArchivedTopic(ctx context.Context, pool types.DelegateStakeReceiptPool) error {
+	if err := pool.Validate(); err != nil {
+		return errors.Wrap(err, "delegate stake receipt pool validation failed")
+	}
+	if err := k.delegateStakeReceiptPools.Set(ctx, pool.Id, pool); err != nil {
+		return errors.Wrap(err, "error setting delegate stake receipt pool")
+	}
+	return nil
+}
+
+// Returns the whole stake of a delegate stake receipt pool upon a reputer in an archived topic into the
+// reserve of the pool, along with the reward pending upon it. Receipts are then redeemed out of the reserve.
+func (k *Keeper) returnDelegateStakeReceiptPoolOfArchivedTopic(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	amount cosmosMath.Int,
+) error {
+	pool, found, err := k.GetDelegateStakeReceiptPoolOf(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	} else if !found {
+		return errors.Wrapf(types.ErrDelegateStakeReceiptPoolNotFound, "topic %d reputer %s", topicId, reputer)
+	}
+	if _, err := k.settleDelegateStakeReceiptPool(ctx, pool, false); err != nil {
+		return err
+	}
+	poolAddress := k.delegateStakeReceiptsAddress()
+	if err := k.removeDelegateStake(ctx, topicId, poolAddress, reputer, amount); err != nil {
+		return errors.Wrap(err, "error removing stake of delegate stake receipt pool")
+	}
+	// Any fraction of stake left is dropped along with the entry of the delegation in the delegator index
+	if err := k.removeDelegation(ctx, topicId, poolAddress, reputer); err != nil {
+		return errors.Wrap(err, "error removing delegation of delegate stake receipt pool")
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraStakingAccountName, types.AlloraDelegateStakeReceiptsAccountName, coins)
+	if err != nil {
+		return errors.Wrap(err, "error returning stake to delegate stake receipt pool reserve")
+	}
+	// Removing the stake may have paid a remainder of reward into the reserve already
+	pool, _, err = k.GetDelegateStakeReceiptPoolOf(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	pool.Reserve = pool.Reserve.Add(amount)
+	return k.SetDelegateStakeReceiptPool(ctx, pool)
+}
+
+// Moves the delegate stake receipt pool upon a reputer in a topic to another reputer address.
+// The stake of the pool moves along with the other delegations upon the reputer
+func (k *Keeper) moveDelegateStakeReceiptPool(ctx context.Context, topicId TopicId, from, to ActorId) error {
+	pool, found, err := k.GetDelegateStakeReceiptPoolOf(ctx, topicId, from)
+	if err != nil || !found {
+		return err
+	}
+	if err := k.delegateStakeReceiptPoolIds.Remove(ctx, collections.Join(topicId, from)); err != nil {
+		return errors.Wrap(err, "error removing delegate stake receipt pool id")
+	}
+	pool.Reputer = to
+	return k.SetDelegateStakeReceiptPool(ctx, pool)
+}
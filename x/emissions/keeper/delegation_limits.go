@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the own stake of a reputer in a topic and the stake delegated upon it
+func (k *Keeper) GetReputerSelfAndDelegateStake(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (cosmosMath.Int, cosmosMath.Int, error) {
+	totalStake, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	delegateStake, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	return totalStake.Sub(delegateStake), delegateStake, nil
+}
+
+// Returns the max stake that can be delegated upon a reputer given its own stake, as allowed by
+// the max delegate to self stake ratio. The bool is false when the ratio is disabled.
+func maxDelegateStakeForSelfStake(selfStake cosmosMath.Int, maxRatio alloraMath.Dec) (cosmosMath.Int, bool, error) {
+	if !maxRatio.IsPositive() {
+		return cosmosMath.Int{}, false, nil
+	}
+	selfStakeDec, err := alloraMath.NewDecFromSdkInt(selfStake)
+	if err != nil {
+		return cosmosMath.Int{}, false, err
+	}
+	maxDelegateStake, err := selfStakeDec.Mul(maxRatio)
+	if err != nil {
+		return cosmosMath.Int{}, false, err
+	}
+	maxDelegateStakeInt, err := maxDelegateStake.SdkIntTrim()
+	if err != nil {
+		return cosmosMath.Int{}, false, err
+	}
+	return maxDelegateStakeInt, true, nil
+}
+
+// Returns the stake of a reputer in a topic counted in scoring: its own stake plus the stake delegated upon it,
+// the latter bounded by the max delegate stake per reputer and by the max ratio to its own stake.
+// Delegated stake beyond the limits, e.g. once the reputer removed some of its own stake, still earns rewards
+// but carries no weight in the consensus.
+func (k *Keeper) GetReputerEffectiveStake(ctx context.Context, topicId TopicId, reputer ActorId) (cosmosMath.Int, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error getting params")
+	}
+	selfStake, delegateStake, err := k.GetReputerSelfAndDelegateStake(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if moduleParams.MaxDelegateStakePerReputer.IsPositive() {
+		delegateStake = cosmosMath.MinInt(delegateStake, moduleParams.MaxDelegateStakePerReputer)
+	}
+	maxDelegateStake, limited, err := maxDelegateStakeForSelfStake(selfStake, moduleParams.MaxDelegateToSelfStakeRatio)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error computing max delegate stake for self stake")
+	}
+	if limited {
+		delegateStake = cosmosMath.MinInt(delegateStake, maxDelegateStake)
+	}
+	return selfStake.Add(delegateStake), nil
+}
+
+// Returns how much more stake can be delegated upon a reputer in a topic without exceeding the max delegate
+// stake per reputer nor the max ratio of delegated stake to the reputer's own stake.
+// The bool is false when neither limit is enabled, in which case there is no bound on the stake delegated.
+func (k *Keeper) GetDelegateStakeHeadroom(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (cosmosMath.Int, bool, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return cosmosMath.Int{}, false, errors.Wrap(err, "error getting params")
+	}
+	selfStake, delegateStake, err := k.GetReputerSelfAndDelegateStake(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, false, err
+	}
+	maxDelegateStake, limited, err := maxDelegateStakeForSelfStake(selfStake, moduleParams.MaxDelegateToSelfStakeRatio)
+	if err != nil {
+		return cosmosMath.Int{}, false, errors.Wrap(err, "error computing max delegate stake for self stake")
+	}
+	if moduleParams.MaxDelegateStakePerReputer.IsPositive() {
+		if limited {
+			maxDelegateStake = cosmosMath.MinInt(maxDelegateStake, moduleParams.MaxDelegateStakePerReputer)
+		} else {
+			maxDelegateStake = moduleParams.MaxDelegateStakePerReputer
+		}
+		limited = true
+	}
+	if !limited {
+		return cosmosMath.Int{}, false, nil
+	}
+	if delegateStake.GTE(maxDelegateStake) {
+		return cosmosMath.ZeroInt(), true, nil
+	}
+	return maxDelegateStake.Sub(delegateStake), true, nil
+}
+
+// Returns an error if delegating `amount` more upon a reputer in a topic would exceed the max delegate stake
+// per reputer or the max ratio of delegated stake to the reputer's own stake
+func (k *Keeper) CheckDelegateStakeLimits(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	amount cosmosMath.Int,
+) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting params")
+	}
+	selfStake, delegateStake, err := k.GetReputerSelfAndDelegateStake(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	newDelegateStake := delegateStake.Add(amount)
+	if moduleParams.MaxDelegateStakePerReputer.IsPositive() && newDelegateStake.GT(moduleParams.MaxDelegateStakePerReputer) {
+		return errors.Wrapf(
+			types.ErrDelegateStakeExceedsReputerCap,
+			"delegated stake %s, max %s", newDelegateStake, moduleParams.MaxDelegateStakePerReputer,
+		)
+	}
+	maxDelegateStake, limited, err := maxDelegateStakeForSelfStake(selfStake, moduleParams.MaxDelegateToSelfStakeRatio)
+	if err != nil {
+		return errors.Wrap(err, "error computing max delegate stake for self stake")
+	}
+	if limited && newDelegateStake.GT(maxDelegateStake) {
+		return errors.Wrapf(
+			types.ErrDelegateStakeExceedsSelfStakeRatio,
+			"delegated stake %s, max %s for own stake %s", newDelegateStake, maxDelegateStake, selfStake,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Sets a delegation in both the topic -> delegation map and its delegator -> delegation reverse index,
+// and in the auto-compound index if the delegator opted in to auto-compounding
+func (k *Keeper) setDelegation(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	stake types.DelegatorInfo,
+) error {
+	if err := k.delegatedStakes.Set(ctx, collections.Join3(topicId, delegator, reputer), stake); err != nil {
+		return err
+	}
+	if err := k.delegatorDelegations.Set(ctx, collections.Join3(delegator, topicId, reputer)); err != nil {
+		return err
+	}
+	if err := k.reputerDelegations.Set(ctx, collections.Join3(topicId, reputer, delegator)); err != nil {
+		return err
+	}
+	autoCompound, err := k.autoCompoundDelegators.Has(ctx, delegator)
+	if err != nil || !autoCompound {
+		return err
+	}
+	return k.autoCompoundDelegations.Set(ctx, collections.Join3(topicId, reputer, delegator))
+}
+
+// Removes a delegation from the topic -> delegation map and from its indexes
+func (k *Keeper) removeDelegation(ctx context.Context, topicId TopicId, delegator ActorId, reputer ActorId) error {
+	if err := k.delegatedStakes.Remove(ctx, collections.Join3(topicId, delegator, reputer)); err != nil {
+		return err
+	}
+	if err := k.delegatorDelegations.Remove(ctx, collections.Join3(delegator, topicId, reputer)); err != nil {
+		return err
+	}
+	if err := k.reputerDelegations.Remove(ctx, collections.Join3(topicId, reputer, delegator)); err != nil {
+		return err
+	}
+	return k.autoCompoundDelegations.Remove(ctx, collections.Join3(topicId, reputer, delegator))
+}
+
+// Returns the (topic, reputer) pairs a delegator delegates stake upon, ordered by topic then reputer
+func (k *Keeper) GetDelegatorDelegations(ctx context.Context, delegator ActorId) ([]collections.Pair[TopicId, ActorId], error) {
+	rng := collections.NewPrefixedTripleRange[ActorId, TopicId, ActorId](delegator)
+	return k.getDelegatorDelegations(ctx, rng)
+}
+
+// Returns the reputers a delegator delegates stake upon in a topic
+func (k *Keeper) GetDelegatorReputersInTopic(ctx context.Context, delegator ActorId, topicId TopicId) ([]ActorId, error) {
+	rng := collections.NewSuperPrefixedTripleRange[ActorId, TopicId, ActorId](delegator, topicId)
+	delegations, err := k.getDelegatorDelegations(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	reputers := make([]ActorId, 0, len(delegations))
+	for _, delegation := range delegations {
+		reputers = append(reputers, delegation.K2())
+	}
+	return reputers, nil
+}
+
+// Returns a page of the (topic, reputer) pairs a delegator delegates stake upon, ordered by topic then reputer.
+// The cursor of the pagination is the encoded (topic, reputer) pair to start the page from
+func (k *Keeper) GetDelegatorDelegationsPage(
+	ctx context.Context,
+	delegator ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]collections.Pair[TopicId, ActorId], *types.SimpleCursorPaginationResponse, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error getting params")
+	}
+	cursorCodec := collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)
+	limit := moduleParams.DefaultPageLimit
+	rng := (&collections.Range[collections.Triple[ActorId, TopicId, ActorId]]{}).
+		Prefix(collections.TriplePrefix[ActorId, TopicId, ActorId](delegator))
+	if pagination != nil {
+		if len(pagination.Key) > 0 {
+			_, cursor, err := cursorCodec.Decode(pagination.Key)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "error decoding pagination key")
+			}
+			rng = rng.StartInclusive(collections.Join3(delegator, cursor.K1(), cursor.K2()))
+		}
+		if pagination.Limit > 0 {
+			limit = pagination.Limit
+		}
+		if limit > moduleParams.MaxPageLimit {
+			limit = moduleParams.MaxPageLimit
+		}
+	}
+
+	iter, err := k.delegatorDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error iterating delegator delegations")
+	}
+	defer iter.Close()
+
+	delegations := make([]collections.Pair[TopicId, ActorId], 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting delegator delegation")
+		}
+		delegation := collections.Join(key.K2(), key.K3())
+		if uint64(len(delegations)) >= limit {
+			nextKey, err := collections.EncodeKeyWithPrefix(nil, cursorCodec, delegation)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "error encoding pagination key")
+			}
+			return delegations, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+		}
+		delegations = append(delegations, delegation)
+	}
+	return delegations, &types.SimpleCursorPaginationResponse{NextKey: nil}, nil
+}
+
+func (k *Keeper) getDelegatorDelegations(
+	ctx context.Context,
+	rng collections.Ranger[collections.Triple[ActorId, TopicId, ActorId]],
+) ([]collections.Pair[TopicId, ActorId], error) {
+	iter, err := k.delegatorDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating delegator delegations")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting delegator delegations")
+	}
+	delegations := make([]collections.Pair[TopicId, ActorId], 0, len(keys))
+	for _, key := range keys {
+		delegations = append(delegations, collections.Join(key.K2(), key.K3()))
+	}
+	return delegations, nil
+}
+
+// Adds the delegations of stake to the delegator -> delegation and reputer -> delegation
+// reverse indexes, e.g. delegations placed before the indexes existed
+func (k *Keeper) IndexDelegatorDelegations(ctx context.Context) error {
+	iter, err := k.delegatedStakes.Iterate(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "error iterating delegated stakes")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return errors.Wrap(err, "error getting delegated stakes")
+	}
+	for _, key := range keys {
+		if err := k.delegatorDelegations.Set(ctx, collections.Join3(key.K2(), key.K1(), key.K3())); err != nil {
+			return errors.Wrap(err, "error indexing delegation")
+		}
+		if err := k.reputerDelegations.Set(ctx, collections.Join3(key.K1(), key.K3(), key.K2())); err != nil {
+			return errors.Wrap(err, "error indexing delegation upon reputer")
+		}
+	}
+	return nil
+}
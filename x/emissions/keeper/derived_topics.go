@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the ids of the topics, archived ones excepted, whose ground truth source is the given topic
+func (k *Keeper) GetDerivedTopicIds(ctx context.Context, parentTopicId TopicId) ([]TopicId, error) {
+	topicIds := make([]TopicId, 0)
+	rng := collections.NewPrefixedPairRange[TopicId, TopicId](parentTopicId)
+	err := k.derivedTopics.Walk(ctx, rng, func(key collections.Pair[TopicId, TopicId]) (bool, error) {
+		isArchived, err := k.IsTopicArchived(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if !isArchived {
+			topicIds = append(topicIds, key.K2())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return topicIds, nil
+}
+
+// Checks that a topic can take its ground truth from the parent topic of the source: the parent must exist
+// and not be archived, and the topic must not be one of the ancestors of the parent, so that the topic
+// dependency graph stays acyclic. Ancestors are looked up with their pending updates applied, if any,
+// as those are applied later on.
+func (k *Keeper) ValidateGroundTruthSource(ctx context.Context, topicId TopicId, source *types.GroundTruthSource) error {
+	if err := source.Validate(); err != nil {
+		return err
+	}
+	parentExists, err := k.TopicExists(ctx, source.ParentTopicId)
+	if err != nil {
+		return err
+	} else if !parentExists {
+		return errors.Wrapf(types.ErrInvalidGroundTruthSource, "parent topic %d does not exist", source.ParentTopicId)
+	}
+	isArchived, err := k.IsTopicArchived(ctx, source.ParentTopicId)
+	if err != nil {
+		return err
+	} else if isArchived {
+		return errors.Wrapf(types.ErrInvalidGroundTruthSource, "parent topic %d is archived", source.ParentTopicId)
+	}
+
+	ancestorId := source.ParentTopicId
+	for depth := 0; depth < types.MaxDerivedTopicDepth; depth++ {
+		if ancestorId == topicId {
+			return errors.Wrapf(types.ErrTopicDependencyCycle, "topic %d would depend on itself", topicId)
+		}
+		ancestor, found, err := k.GetPendingTopicUpdate(ctx, ancestorId)
+		if err != nil {
+			return err
+		}
+		if !found {
+			ancestor, err = k.GetTopic(ctx, ancestorId)
+			if err != nil {
+				return err
+			}
+		}
+		if !ancestor.IsDerived() {
+			return nil
+		}
+		ancestorId = ancestor.GroundTruthSource.ParentTopicId
+	}
+	return errors.Wrapf(types.ErrTopicDependencyCycle, "topic %d would have more than %d ancestors", topicId, types.MaxDerivedTopicDepth)
+}
@@ -236,3 +236,54 @@ func (k *Keeper) CalcAndSaveReputerScoreEmaWithLastSavedTopicQuantile(
 	types.EmitNewActorEMAScoresSetEvent(ctx, types.ActorType_ACTOR_TYPE_REPUTER, emaScores, activeArr)
 	return nil
 }
+
+// Calculates and saves the EMA score of an inferer of a commit-reveal topic that did not reveal the payload
+// it committed to. The new score is the lowest of the last saved topic quantile score and of the lowest score
+// of the active set, so that committing without revealing scores no better than not submitting at all.
+func (k *Keeper) CalcAndSaveInfererScoreEmaForUnrevealedCommitment(
+	ctx sdk.Context,
+	topic types.Topic,
+	block types.BlockHeight,
+	inferer ActorId,
+) error {
+	penaltyScore, err := k.GetPreviousTopicQuantileInfererScoreEma(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	lowestScore, found, err := k.GetLowestInfererScoreEma(ctx, topic.Id)
+	if err != nil {
+		return err
+	}
+	if found && lowestScore.Score.Lt(penaltyScore) {
+		penaltyScore = lowestScore.Score
+	}
+	previousScore, err := k.GetInfererScoreEma(ctx, topic.Id, inferer)
+	if err != nil {
+		return errors.Wrapf(err, "Error getting inferer score ema")
+	}
+	firstTime := previousScore.BlockHeight == 0 && previousScore.Score.IsZero()
+	emaScoreDec, err := alloraMath.CalcEma(
+		topic.MeritSortitionAlpha,
+		penaltyScore,
+		previousScore.Score,
+		firstTime,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Error calculating ema")
+	}
+	emaScore := types.Score{
+		TopicId:     topic.Id,
+		BlockHeight: block,
+		Address:     inferer,
+		Score:       emaScoreDec,
+	}
+	err = k.SetInfererScoreEma(ctx, topic.Id, inferer, emaScore)
+	if err != nil {
+		return errors.Wrapf(err, "error setting latest inferer score")
+	}
+
+	emaScores := []types.Score{emaScore}
+	activeArr := map[string]bool{inferer: false}
+	types.EmitNewActorEMAScoresSetEvent(ctx, types.ActorType_ACTOR_TYPE_INFERER_UNSPECIFIED, emaScores, activeArr)
+	return nil
+}
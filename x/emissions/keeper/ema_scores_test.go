@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 )
@@ -27,6 +28,16 @@ func (s *KeeperTestSuite) TestCalcAndSaveInfererScoreEmaIfNewUpdate() {
 		ActiveInfererQuantile:    alloraMath.ZeroDec(),
 		ActiveForecasterQuantile: alloraMath.ZeroDec(),
 		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -81,6 +92,16 @@ func (s *KeeperTestSuite) TestCalcAndSaveForecasterScoreEmaIfNewUpdate() {
 		ActiveInfererQuantile:    alloraMath.ZeroDec(),
 		ActiveForecasterQuantile: alloraMath.ZeroDec(),
 		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -135,6 +156,16 @@ func (s *KeeperTestSuite) TestCalcAndSaveReputerScoreEmaIfNewUpdate() {
 		ActiveInfererQuantile:    alloraMath.ZeroDec(),
 		ActiveForecasterQuantile: alloraMath.ZeroDec(),
 		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	reputer := s.addrsStr[2]
 	block := types.BlockHeight(100)
@@ -189,6 +220,16 @@ func (s *KeeperTestSuite) TestCalcAndSaveInfererScoreEmaWithLastSavedTopicQuanti
 		ActiveInfererQuantile:    alloraMath.ZeroDec(),
 		ActiveForecasterQuantile: alloraMath.ZeroDec(),
 		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -236,6 +277,16 @@ func (s *KeeperTestSuite) TestCalcAndSaveForecasterScoreEmaWithLastSavedTopicQua
 		ActiveInfererQuantile:    alloraMath.ZeroDec(),
 		ActiveForecasterQuantile: alloraMath.ZeroDec(),
 		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	worker := s.addrsStr[1]
 	block := types.BlockHeight(100)
@@ -283,6 +334,16 @@ func (s *KeeperTestSuite) TestCalcAndSaveReputerScoreEmaWithLastSavedTopicQuanti
 		ActiveInfererQuantile:    alloraMath.ZeroDec(),
 		ActiveForecasterQuantile: alloraMath.ZeroDec(),
 		ActiveReputerQuantile:    alloraMath.ZeroDec(),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	reputer := s.addrsStr[2]
 	block := types.BlockHeight(100)
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the id the next funding subscription will get
+func (k *Keeper) GetNextFundingSubscriptionId(ctx context.Context) (uint64, error) {
+	return k.nextFundingSubscriptionId.Peek(ctx)
+}
+
+// Returns an active funding subscription
+func (k *Keeper) GetFundingSubscription(ctx context.Context, subscriptionId uint64) (types.FundingSubscription, error) {
+	subscription, err := k.fundingSubscriptions.Get(ctx, subscriptionId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.FundingSubscription{}, errors.Wrapf(types.ErrFundingSubscriptionNotFound, "subscription %d", subscriptionId)
+		}
+		return types.FundingSubscription{}, errors.Wrap(err, "error getting funding subscription")
+	}
+	return subscription, nil
+}
+
+// Stores a new funding subscription under the next subscription id, which is returned.
+// The escrow of the subscription is expected to be held by the requests module account already.
+func (k *Keeper) AddFundingSubscription(ctx context.Context, subscription types.FundingSubscription) (uint64, error) {
+	subscriptionId, err := k.nextFundingSubscriptionId.Next(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "error incrementing funding subscription id")
+	}
+	subscription.Id = subscriptionId
+	if err := k.SetFundingSubscription(ctx, subscription); err != nil {
+		return 0, err
+	}
+	return subscriptionId, nil
+}
+
+// Sets a funding subscription and keeps its indexes up to date
+func (k *Keeper) SetFundingSubscription(ctx context.Context, subscription types.FundingSubscription) error {
+	if err := subscription.Validate(); err != nil {
+		return errors.Wrap(err, "funding subscription validation failed")
+	}
+	previous, err := k.fundingSubscriptions.Get(ctx, subscription.Id)
+	if err == nil {
+		err = k.dueFundingSubscriptions.Remove(ctx, collections.Join(previous.NextDripBlockHeight, previous.Id))
+		if err != nil {
+			return errors.Wrap(err, "error removing previous drip of funding subscription")
+		}
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return errors.Wrap(err, "error getting funding subscription")
+	}
+
+	if err := k.fundingSubscriptions.Set(ctx, subscription.Id, subscription); err != nil {
+		return errors.Wrap(err, "error setting funding subscription")
+	}
+	if err := k.fundingSubscriptionsByTopic.Set(ctx, collections.Join(subscription.TopicId, subscription.Id)); err != nil {
+		return errors.Wrap(err, "error indexing funding subscription by topic")
+	}
+	if err := k.fundingSubscriptionsByFunder.Set(ctx, collections.Join(subscription.Funder, subscription.Id)); err != nil {
+		return errors.Wrap(err, "error indexing funding subscription by funder")
+	}
+	return k.dueFundingSubscriptions.Set(ctx, collections.Join(subscription.NextDripBlockHeight, subscription.Id))
+}
+
+// Removes a funding subscription and its indexes
+func (k *Keeper) RemoveFundingSubscription(ctx context.Context, subscription types.FundingSubscription) error {
+	if err := k.fundingSubscriptions.Remove(ctx, subscription.Id); err != nil {
+		return errors.Wrap(err, "error removing funding subscription")
+	}
+	if err := k.fundingSubscriptionsByTopic.Remove(ctx, collections.Join(subscription.TopicId, subscription.Id)); err != nil {
+		return errors.Wrap(err, "error removing funding subscription from topic index")
+	}
+	if err := k.fundingSubscriptionsByFunder.Remove(ctx, collections.Join(subscription.Funder, subscription.Id)); err != nil {
+		return errors.Wrap(err, "error removing funding subscription from funder index")
+	}
+	return k.dueFundingSubscriptions.Remove(ctx, collections.Join(subscription.NextDripBlockHeight, subscription.Id))
+}
+
+// Returns the ids of at most `limit` funding subscriptions whose next drip is due at or before the block,
+// in order of due block
+func (k *Keeper) GetDueFundingSubscriptionIds(ctx context.Context, blockHeight BlockHeight, limit uint64) ([]uint64, error) {
+	rng := new(collections.Range[collections.Pair[BlockHeight, uint64]]).
+		EndInclusive(collections.Join(blockHeight, uint64(math.MaxUint64)))
+	iter, err := k.dueFundingSubscriptions.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over due funding subscriptions")
+	}
+	defer iter.Close()
+
+	subscriptionIds := make([]uint64, 0)
+	for ; iter.Valid() && uint64(len(subscriptionIds)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting due funding subscription key")
+		}
+		subscriptionIds = append(subscriptionIds, key.K2())
+	}
+	return subscriptionIds, nil
+}
+
+// Adds the next drip of a funding subscription to the fee revenue of its topic, activating the topic
+// if its weight becomes sufficient, then schedules the following drip. The last drip may be smaller
+// than the others if the escrow does not cover a full one, and the subscription ends with it.
+// Subscriptions of archived topics end instead, their escrow is refunded.
+func (k *Keeper) DripFundingSubscription(ctx context.Context, subscriptionId uint64) error {
+	subscription, err := k.GetFundingSubscription(ctx, subscriptionId)
+	if err != nil {
+		return err
+	}
+	isArchived, err := k.IsTopicArchived(ctx, subscription.TopicId)
+	if err != nil {
+		return errors.Wrap(err, "error checking if topic is archived")
+	} else if isArchived {
+		_, err = k.RefundFundingSubscription(ctx, subscription)
+		return err
+	}
+	topic, err := k.GetTopic(ctx, subscription.TopicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting topic")
+	}
+
+	amount := cosmosMath.MinInt(subscription.AmountPerDrip, subscription.Escrow)
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraRequestsAccountName, minttypes.EcosystemModuleName, coins)
+	if err != nil {
+		return errors.Wrap(err, "error sending drip to ecosystem")
+	}
+	if err := k.AddTopicFeeRevenue(ctx, subscription.TopicId, amount); err != nil {
+		return errors.Wrap(err, "error adding topic fee revenue")
+	}
+	if err := k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, subscription.TopicId); err != nil {
+		return errors.Wrap(err, "error activating topic")
+	}
+
+	subscription.Escrow = subscription.Escrow.Sub(amount)
+	if subscription.Escrow.IsZero() {
+		return k.RemoveFundingSubscription(ctx, subscription)
+	}
+	interval := subscription.IntervalBlocks
+	if interval == 0 {
+		interval = topic.EpochLength
+	}
+	subscription.NextDripBlockHeight = sdk.UnwrapSDKContext(ctx).BlockHeight() + interval
+	return k.SetFundingSubscription(ctx, subscription)
+}
+
+// Ends a funding subscription and returns its unspent escrow to the funder
+func (k *Keeper) RefundFundingSubscription(ctx context.Context, subscription types.FundingSubscription) (cosmosMath.Int, error) {
+	if subscription.Escrow.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, subscription.Escrow))
+		err := k.SendCoinsFromModuleToAccount(ctx, types.AlloraRequestsAccountName, subscription.Funder, coins)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error refunding funding subscription escrow")
+		}
+	}
+	if err := k.RemoveFundingSubscription(ctx, subscription); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return subscription.Escrow, nil
+}
+
+// Returns a page of the active funding subscriptions of a topic, in ascending order of id
+func (k *Keeper) GetTopicFundingSubscriptions(
+	ctx context.Context,
+	topicId TopicId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.FundingSubscription, *types.SimpleCursorPaginationResponse, error) {
+	return getFundingSubscriptionsPage(ctx, k, k.fundingSubscriptionsByTopic, topicId, pagination)
+}
+
+// Returns a page of the active funding subscriptions of a funder, in ascending order of id
+func (k *Keeper) GetFunderFundingSubscriptions(
+	ctx context.Context,
+	funder ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.FundingSubscription, *types.SimpleCursorPaginationResponse, error) {
+	return getFundingSubscriptionsPage(ctx, k, k.fundingSubscriptionsByFunder, funder, pagination)
+}
+
+// The cursor of the pagination is the subscription id to start the page from
+func getFundingSubscriptionsPage[K any](
+	ctx context.Context,
+	k *Keeper,
+	index collections.KeySet[collections.Pair[K, uint64]],
+	prefix K,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.FundingSubscription, *types.SimpleCursorPaginationResponse, error) {
+	limit, cursor, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rng := collections.NewPrefixedPairRange[K, uint64](prefix).StartInclusive(cursor)
+	iter, err := index.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error iterating over funding subscriptions")
+	}
+	defer iter.Close()
+
+	subscriptions := make([]*types.FundingSubscription, 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting funding subscription key")
+		}
+		if uint64(len(subscriptions)) >= limit {
+			nextKey := make([]byte, 8)
+			binary.BigEndian.PutUint64(nextKey, key.K2())
+			return subscriptions, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+		}
+		subscription, err := k.GetFundingSubscription(ctx, key.K2())
+		if err != nil {
+			return nil, nil, err
+		}
+		subscriptions = append(subscriptions, &subscription)
+	}
+	return subscriptions, &types.SimpleCursorPaginationResponse{NextKey: nil}, nil
+}
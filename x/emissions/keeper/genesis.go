@@ -21,6 +21,12 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 	k.authKeeper.SetModuleAccount(ctx, alloraRewardsModuleAccount)
 	alloraPendingRewardsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraPendingRewardForDelegatorAccountName)
 	k.authKeeper.SetModuleAccount(ctx, alloraPendingRewardsModuleAccount)
+	alloraRequestsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraRequestsAccountName)
+	k.authKeeper.SetModuleAccount(ctx, alloraRequestsModuleAccount)
+	alloraWorkerBondsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraWorkerBondsAccountName)
+	k.authKeeper.SetModuleAccount(ctx, alloraWorkerBondsModuleAccount)
+	alloraDelegateStakeReceiptsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraDelegateStakeReceiptsAccountName)
+	k.authKeeper.SetModuleAccount(ctx, alloraDelegateStakeReceiptsModuleAccount)
 
 	// go through the genesis state object
 
@@ -78,7 +84,7 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 				if err := types.ValidateBech32(topicAndActorId.ActorId); err != nil {
 					return errors.Wrap(err, "error setting topicWorkers")
 				}
-				if err := k.topicWorkers.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
+				if err := k.setTopicActor(ctx, topicAndActorId.TopicId, topicAndActorId.ActorId, false); err != nil {
 					return errors.Wrap(err, "error setting topicWorkers")
 				}
 			}
@@ -94,7 +100,7 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 				if err := types.ValidateBech32(topicAndActorId.ActorId); err != nil {
 					return errors.Wrap(err, "error setting topicReputers")
 				}
-				if err := k.topicReputers.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
+				if err := k.setTopicActor(ctx, topicAndActorId.TopicId, topicAndActorId.ActorId, true); err != nil {
 					return errors.Wrap(err, "error setting topicReputers")
 				}
 			}
@@ -903,6 +909,256 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		}
 	}
 
+	// PendingTopicUpdates []*TopicIdAndTopic
+	if len(data.PendingTopicUpdates) != 0 {
+		for _, topic := range data.PendingTopicUpdates {
+			if topic != nil {
+				if err := k.SetPendingTopicUpdate(ctx, topic.TopicId, *topic.Topic); err != nil {
+					return errors.Wrap(err, "error setting pendingTopicUpdates")
+				}
+			}
+		}
+	}
+
+	// ArchivedTopics []*TopicIdAndTopicArchive
+	if len(data.ArchivedTopics) != 0 {
+		for _, archive := range data.ArchivedTopics {
+			if archive != nil {
+				if err := k.SetTopicArchive(ctx, archive.TopicId, *archive.TopicArchive); err != nil {
+					return errors.Wrap(err, "error setting archivedTopics")
+				}
+			}
+		}
+	}
+
+	// TopicWorkerWhitelistEnabled []uint64
+	if len(data.TopicWorkerWhitelistEnabled) != 0 {
+		for _, topicId := range data.TopicWorkerWhitelistEnabled {
+			if err := k.EnableTopicWorkerWhitelist(ctx, topicId); err != nil {
+				return errors.Wrap(err, "error setting topicWorkerWhitelistEnabled")
+			}
+		}
+	}
+
+	// TopicReputerWhitelistEnabled []uint64
+	if len(data.TopicReputerWhitelistEnabled) != 0 {
+		for _, topicId := range data.TopicReputerWhitelistEnabled {
+			if err := k.EnableTopicReputerWhitelist(ctx, topicId); err != nil {
+				return errors.Wrap(err, "error setting topicReputerWhitelistEnabled")
+			}
+		}
+	}
+
+	// TopicWorkerWhitelist []*TopicAndActorId
+	if len(data.TopicWorkerWhitelist) != 0 {
+		for _, topicAndActorId := range data.TopicWorkerWhitelist {
+			if topicAndActorId != nil {
+				if err := k.AddToTopicWorkerWhitelist(ctx, topicAndActorId.TopicId, topicAndActorId.ActorId); err != nil {
+					return errors.Wrap(err, "error setting topicWorkerWhitelist")
+				}
+			}
+		}
+	}
+
+	// TopicReputerWhitelist []*TopicAndActorId
+	if len(data.TopicReputerWhitelist) != 0 {
+		for _, topicAndActorId := range data.TopicReputerWhitelist {
+			if topicAndActorId != nil {
+				if err := k.AddToTopicReputerWhitelist(ctx, topicAndActorId.TopicId, topicAndActorId.ActorId); err != nil {
+					return errors.Wrap(err, "error setting topicReputerWhitelist")
+				}
+			}
+		}
+	}
+
+	// FundingSubscriptions []*FundingSubscription
+	if len(data.FundingSubscriptions) != 0 {
+		for _, subscription := range data.FundingSubscriptions {
+			if subscription != nil {
+				if err := k.SetFundingSubscription(ctx, *subscription); err != nil {
+					return errors.Wrap(err, "error setting fundingSubscriptions")
+				}
+			}
+		}
+	}
+	// NextFundingSubscriptionId uint64
+	if err := k.nextFundingSubscriptionId.Set(ctx, data.NextFundingSubscriptionId); err != nil {
+		return errors.Wrap(err, "error setting next funding subscription ID")
+	}
+
+	// TopicCreatorRewards []*TopicIdAndInt
+	if len(data.TopicCreatorRewards) != 0 {
+		for _, topicIdAndInt := range data.TopicCreatorRewards {
+			if topicIdAndInt != nil {
+				if err := types.ValidateTopicId(topicIdAndInt.TopicId); err != nil {
+					return errors.Wrap(err, "topic id validation failed")
+				}
+				if err := types.ValidateSdkIntRepresentingMonetaryValue(topicIdAndInt.Int); err != nil {
+					return errors.Wrap(err, "topic creator rewards validation failed")
+				}
+				if err := k.topicCreatorRewards.Set(ctx, topicIdAndInt.TopicId, topicIdAndInt.Int); err != nil {
+					return errors.Wrap(err, "error setting topicCreatorRewards")
+				}
+			}
+		}
+	}
+
+	// LastNodeRotations []*TopicIdActorIdBlockHeight
+	if len(data.LastNodeRotations) != 0 {
+		for _, rotation := range data.LastNodeRotations {
+			if rotation != nil {
+				if err := types.ValidateTopicId(rotation.TopicId); err != nil {
+					return errors.Wrap(err, "topic id validation failed")
+				}
+				if err := types.ValidateBech32(rotation.ActorId); err != nil {
+					return errors.Wrap(err, "actor id validation failed")
+				}
+				if err := types.ValidateBlockHeight(rotation.BlockHeight); err != nil {
+					return errors.Wrap(err, "block height validation failed")
+				}
+				if err := k.lastNodeRotations.Set(ctx, collections.Join(rotation.TopicId, rotation.ActorId), rotation.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting lastNodeRotations")
+				}
+			}
+		}
+	}
+
+	// WorkerCommitments []*WorkerCommitment
+	if len(data.WorkerCommitments) != 0 {
+		for _, commitment := range data.WorkerCommitments {
+			if commitment != nil {
+				if err := k.SetWorkerCommitment(ctx, *commitment); err != nil {
+					return errors.Wrap(err, "error setting workerCommitments")
+				}
+			}
+		}
+	}
+
+	// ReputerCommitments []*ReputerCommitment
+	if len(data.ReputerCommitments) != 0 {
+		for _, commitment := range data.ReputerCommitments {
+			if commitment != nil {
+				if err := k.SetReputerCommitment(ctx, *commitment); err != nil {
+					return errors.Wrap(err, "error setting reputerCommitments")
+				}
+			}
+		}
+	}
+
+	// WorkerBonds []*TopicIdActorIdInt
+	if len(data.WorkerBonds) != 0 {
+		for _, topicIdActorIdInt := range data.WorkerBonds {
+			if topicIdActorIdInt != nil {
+				if err := k.SetWorkerBond(ctx, topicIdActorIdInt.TopicId, topicIdActorIdInt.ActorId, topicIdActorIdInt.Int); err != nil {
+					return errors.Wrap(err, "error setting workerBonds")
+				}
+			}
+		}
+	}
+
+	// WorkerBondRemovals []*WorkerBondRemovalInfo
+	if len(data.WorkerBondRemovals) != 0 {
+		for _, removal := range data.WorkerBondRemovals {
+			if removal != nil {
+				if err := k.SetWorkerBondRemoval(ctx, *removal); err != nil {
+					return errors.Wrap(err, "error setting workerBondRemovals")
+				}
+			}
+		}
+	}
+
+	// ReputerOutlierStreaks []*TopicIdActorIdUint64
+	if len(data.ReputerOutlierStreaks) != 0 {
+		for _, topicIdActorIdUint64 := range data.ReputerOutlierStreaks {
+			if topicIdActorIdUint64 != nil {
+				if err := k.SetReputerOutlierStreak(ctx, topicIdActorIdUint64.TopicId, topicIdActorIdUint64.ActorId, topicIdActorIdUint64.Uint64); err != nil {
+					return errors.Wrap(err, "error setting reputerOutlierStreaks")
+				}
+			}
+		}
+	}
+
+	// ReputerSlashes []*ReputerSlash
+	if len(data.ReputerSlashes) != 0 {
+		for _, slash := range data.ReputerSlashes {
+			if slash != nil {
+				if err := k.SetReputerSlash(ctx, *slash); err != nil {
+					return errors.Wrap(err, "error setting reputerSlashes")
+				}
+			}
+		}
+	}
+
+	// WorkerLiveness []*TopicIdActorIdActorLiveness
+	if len(data.WorkerLiveness) != 0 {
+		for _, topicIdActorIdLiveness := range data.WorkerLiveness {
+			if topicIdActorIdLiveness != nil && topicIdActorIdLiveness.Liveness != nil {
+				if err := k.SetActorLiveness(ctx, topicIdActorIdLiveness.TopicId, topicIdActorIdLiveness.ActorId, false, *topicIdActorIdLiveness.Liveness); err != nil {
+					return errors.Wrap(err, "error setting workerLiveness")
+				}
+			}
+		}
+	}
+
+	// ReputerLiveness []*TopicIdActorIdActorLiveness
+	if len(data.ReputerLiveness) != 0 {
+		for _, topicIdActorIdLiveness := range data.ReputerLiveness {
+			if topicIdActorIdLiveness != nil && topicIdActorIdLiveness.Liveness != nil {
+				if err := k.SetActorLiveness(ctx, topicIdActorIdLiveness.TopicId, topicIdActorIdLiveness.ActorId, true, *topicIdActorIdLiveness.Liveness); err != nil {
+					return errors.Wrap(err, "error setting reputerLiveness")
+				}
+			}
+		}
+	}
+
+	// ReputerCommissions []*ReputerCommission
+	if len(data.ReputerCommissions) != 0 {
+		for _, commission := range data.ReputerCommissions {
+			if commission != nil {
+				if err := k.SetReputerCommission(ctx, *commission); err != nil {
+					return errors.Wrap(err, "error setting reputerCommissions")
+				}
+			}
+		}
+	}
+
+	// Redelegations []*Redelegation
+	if len(data.Redelegations) != 0 {
+		for _, redelegation := range data.Redelegations {
+			if redelegation != nil {
+				if err := k.SetRedelegation(ctx, *redelegation); err != nil {
+					return errors.Wrap(err, "error setting redelegations")
+				}
+			}
+		}
+	}
+
+	// AutoCompoundDelegators []string
+	if len(data.AutoCompoundDelegators) != 0 {
+		for _, delegator := range data.AutoCompoundDelegators {
+			if err := k.SetDelegateRewardAutoCompound(ctx, delegator, true); err != nil {
+				return errors.Wrap(err, "error setting autoCompoundDelegators")
+			}
+		}
+	}
+
+	// DelegateStakeReceiptPools []*DelegateStakeReceiptPool
+	if len(data.DelegateStakeReceiptPools) != 0 {
+		for _, pool := range data.DelegateStakeReceiptPools {
+			if pool != nil {
+				if err := k.SetDelegateStakeReceiptPool(ctx, *pool); err != nil {
+					return errors.Wrap(err, "error setting delegateStakeReceiptPools")
+				}
+			}
+		}
+	}
+	// NextDelegateStakeReceiptPoolId uint64
+	if data.NextDelegateStakeReceiptPoolId != 0 {
+		if err := k.SetNextDelegateStakeReceiptPoolId(ctx, data.NextDelegateStakeReceiptPoolId); err != nil {
+			return errors.Wrap(err, "error setting nextDelegateStakeReceiptPoolId")
+		}
+	}
+
 	return nil
 }
 
@@ -2170,6 +2426,315 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, errors.Wrap(err, "failed to get total sum previous topic weights")
 	}
 
+	pendingTopicUpdates := make([]*types.TopicIdAndTopic, 0)
+	pendingTopicUpdatesIter, err := k.pendingTopicUpdates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pending topic updates")
+	}
+	for ; pendingTopicUpdatesIter.Valid(); pendingTopicUpdatesIter.Next() {
+		keyValue, err := pendingTopicUpdatesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: pendingTopicUpdatesIter")
+		}
+		value := keyValue.Value
+		pendingTopicUpdates = append(pendingTopicUpdates, &types.TopicIdAndTopic{
+			TopicId: keyValue.Key,
+			Topic:   &value,
+		})
+	}
+
+	archivedTopics := make([]*types.TopicIdAndTopicArchive, 0)
+	archivedTopicsIter, err := k.topicArchives.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate archived topics")
+	}
+	for ; archivedTopicsIter.Valid(); archivedTopicsIter.Next() {
+		keyValue, err := archivedTopicsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: archivedTopicsIter")
+		}
+		value := keyValue.Value
+		archivedTopics = append(archivedTopics, &types.TopicIdAndTopicArchive{
+			TopicId:      keyValue.Key,
+			TopicArchive: &value,
+		})
+	}
+
+	topicWorkerWhitelistEnabled := make([]uint64, 0)
+	topicWorkerWhitelistEnabledIter, err := k.topicWorkerWhitelistEnabled.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topicWorkerWhitelistEnabled")
+	}
+	for ; topicWorkerWhitelistEnabledIter.Valid(); topicWorkerWhitelistEnabledIter.Next() {
+		key, err := topicWorkerWhitelistEnabledIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicWorkerWhitelistEnabledIter")
+		}
+		topicWorkerWhitelistEnabled = append(topicWorkerWhitelistEnabled, key)
+	}
+
+	topicReputerWhitelistEnabled := make([]uint64, 0)
+	topicReputerWhitelistEnabledIter, err := k.topicReputerWhitelistEnabled.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topicReputerWhitelistEnabled")
+	}
+	for ; topicReputerWhitelistEnabledIter.Valid(); topicReputerWhitelistEnabledIter.Next() {
+		key, err := topicReputerWhitelistEnabledIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicReputerWhitelistEnabledIter")
+		}
+		topicReputerWhitelistEnabled = append(topicReputerWhitelistEnabled, key)
+	}
+
+	topicWorkerWhitelist := make([]*types.TopicAndActorId, 0)
+	topicWorkerWhitelistIter, err := k.topicWorkerWhitelist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topicWorkerWhitelist")
+	}
+	for ; topicWorkerWhitelistIter.Valid(); topicWorkerWhitelistIter.Next() {
+		key, err := topicWorkerWhitelistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicWorkerWhitelistIter")
+		}
+		topicWorkerWhitelist = append(topicWorkerWhitelist, &types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		})
+	}
+
+	topicReputerWhitelist := make([]*types.TopicAndActorId, 0)
+	topicReputerWhitelistIter, err := k.topicReputerWhitelist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topicReputerWhitelist")
+	}
+	for ; topicReputerWhitelistIter.Valid(); topicReputerWhitelistIter.Next() {
+		key, err := topicReputerWhitelistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicReputerWhitelistIter")
+		}
+		topicReputerWhitelist = append(topicReputerWhitelist, &types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		})
+	}
+
+	fundingSubscriptions := make([]*types.FundingSubscription, 0)
+	fundingSubscriptionsIter, err := k.fundingSubscriptions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate funding subscriptions")
+	}
+	for ; fundingSubscriptionsIter.Valid(); fundingSubscriptionsIter.Next() {
+		value, err := fundingSubscriptionsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: fundingSubscriptionsIter")
+		}
+		fundingSubscriptions = append(fundingSubscriptions, &value)
+	}
+	nextFundingSubscriptionId, err := k.GetNextFundingSubscriptionId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get next funding subscription ID")
+	}
+
+	topicCreatorRewards := make([]*types.TopicIdAndInt, 0)
+	topicCreatorRewardsIter, err := k.topicCreatorRewards.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic creator rewards")
+	}
+	for ; topicCreatorRewardsIter.Valid(); topicCreatorRewardsIter.Next() {
+		keyValue, err := topicCreatorRewardsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: topicCreatorRewardsIter")
+		}
+		topicCreatorRewards = append(topicCreatorRewards, &types.TopicIdAndInt{
+			TopicId: keyValue.Key,
+			Int:     keyValue.Value,
+		})
+	}
+
+	lastNodeRotations := make([]*types.TopicIdActorIdBlockHeight, 0)
+	lastNodeRotationsIter, err := k.lastNodeRotations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate last node rotations")
+	}
+	for ; lastNodeRotationsIter.Valid(); lastNodeRotationsIter.Next() {
+		keyValue, err := lastNodeRotationsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: lastNodeRotationsIter")
+		}
+		lastNodeRotations = append(lastNodeRotations, &types.TopicIdActorIdBlockHeight{
+			TopicId:     keyValue.Key.K1(),
+			ActorId:     keyValue.Key.K2(),
+			BlockHeight: keyValue.Value,
+		})
+	}
+
+	workerCommitments := make([]*types.WorkerCommitment, 0)
+	workerCommitmentsIter, err := k.workerCommitments.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker commitments")
+	}
+	for ; workerCommitmentsIter.Valid(); workerCommitmentsIter.Next() {
+		commitment, err := workerCommitmentsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: workerCommitmentsIter")
+		}
+		workerCommitments = append(workerCommitments, &commitment)
+	}
+
+	reputerCommitments := make([]*types.ReputerCommitment, 0)
+	reputerCommitmentsIter, err := k.reputerCommitments.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer commitments")
+	}
+	for ; reputerCommitmentsIter.Valid(); reputerCommitmentsIter.Next() {
+		commitment, err := reputerCommitmentsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: reputerCommitmentsIter")
+		}
+		reputerCommitments = append(reputerCommitments, &commitment)
+	}
+
+	workerBonds := make([]*types.TopicIdActorIdInt, 0)
+	workerBondsIter, err := k.workerBonds.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker bonds")
+	}
+	for ; workerBondsIter.Valid(); workerBondsIter.Next() {
+		keyValue, err := workerBondsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: workerBondsIter")
+		}
+		workerBonds = append(workerBonds, &types.TopicIdActorIdInt{
+			TopicId: keyValue.Key.K1(),
+			ActorId: keyValue.Key.K2(),
+			Int:     keyValue.Value,
+		})
+	}
+
+	workerBondRemovals := make([]*types.WorkerBondRemovalInfo, 0)
+	workerBondRemovalsIter, err := k.workerBondRemovalsByBlock.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker bond removals")
+	}
+	for ; workerBondRemovalsIter.Valid(); workerBondRemovalsIter.Next() {
+		removal, err := workerBondRemovalsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: workerBondRemovalsIter")
+		}
+		workerBondRemovals = append(workerBondRemovals, &removal)
+	}
+
+	reputerOutlierStreaks := make([]*types.TopicIdActorIdUint64, 0)
+	reputerOutlierStreaksIter, err := k.reputerOutlierStreaks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer outlier streaks")
+	}
+	for ; reputerOutlierStreaksIter.Valid(); reputerOutlierStreaksIter.Next() {
+		keyValue, err := reputerOutlierStreaksIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerOutlierStreaksIter")
+		}
+		reputerOutlierStreaks = append(reputerOutlierStreaks, &types.TopicIdActorIdUint64{
+			TopicId: keyValue.Key.K1(),
+			ActorId: keyValue.Key.K2(),
+			Uint64:  keyValue.Value,
+		})
+	}
+
+	reputerSlashes := make([]*types.ReputerSlash, 0)
+	reputerSlashesIter, err := k.reputerSlashes.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer slashes")
+	}
+	for ; reputerSlashesIter.Valid(); reputerSlashesIter.Next() {
+		slash, err := reputerSlashesIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: reputerSlashesIter")
+		}
+		reputerSlashes = append(reputerSlashes, &slash)
+	}
+
+	workerLiveness := make([]*types.TopicIdActorIdActorLiveness, 0)
+	workerLivenessIter, err := k.workerLiveness.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker liveness")
+	}
+	for ; workerLivenessIter.Valid(); workerLivenessIter.Next() {
+		keyValue, err := workerLivenessIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: workerLivenessIter")
+		}
+		liveness := keyValue.Value
+		workerLiveness = append(workerLiveness, &types.TopicIdActorIdActorLiveness{
+			TopicId:  keyValue.Key.K1(),
+			ActorId:  keyValue.Key.K2(),
+			Liveness: &liveness,
+		})
+	}
+
+	reputerLiveness := make([]*types.TopicIdActorIdActorLiveness, 0)
+	reputerLivenessIter, err := k.reputerLiveness.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer liveness")
+	}
+	for ; reputerLivenessIter.Valid(); reputerLivenessIter.Next() {
+		keyValue, err := reputerLivenessIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerLivenessIter")
+		}
+		liveness := keyValue.Value
+		reputerLiveness = append(reputerLiveness, &types.TopicIdActorIdActorLiveness{
+			TopicId:  keyValue.Key.K1(),
+			ActorId:  keyValue.Key.K2(),
+			Liveness: &liveness,
+		})
+	}
+
+	reputerCommissions := make([]*types.ReputerCommission, 0)
+	reputerCommissionsIter, err := k.reputerCommissions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer commissions")
+	}
+	for ; reputerCommissionsIter.Valid(); reputerCommissionsIter.Next() {
+		commission, err := reputerCommissionsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: reputerCommissionsIter")
+		}
+		reputerCommissions = append(reputerCommissions, &commission)
+	}
+
+	redelegations := make([]*types.Redelegation, 0)
+	redelegationsIter, err := k.redelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate redelegations")
+	}
+	for ; redelegationsIter.Valid(); redelegationsIter.Next() {
+		redelegation, err := redelegationsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: redelegationsIter")
+		}
+		redelegations = append(redelegations, &redelegation)
+	}
+
+	autoCompoundDelegators, err := k.GetAutoCompoundDelegators(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get auto-compound delegators")
+	}
+
+	delegateStakeReceiptPools := make([]*types.DelegateStakeReceiptPool, 0)
+	pools, err := k.GetDelegateStakeReceiptPools(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get delegate stake receipt pools")
+	}
+	for _, pool := range pools {
+		delegateStakeReceiptPools = append(delegateStakeReceiptPools, &pool)
+	}
+
+	nextDelegateStakeReceiptPoolId, err := k.GetNextDelegateStakeReceiptPoolId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get next delegate stake receipt pool id")
+	}
+
 	return &types.GenesisState{
 		Params:                                      moduleParams,
 		NextTopicId:                                 nextTopicId,
@@ -2244,6 +2809,29 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		CountForecasterInclusionsInTopicActiveSet:      countForecasterInclusionsInTopicActiveSet,
 		TotalSumPreviousTopicWeights:                   totalSumPreviousTopicWeights,
 		RewardCurrentBlockEmission:                     rewardCurrentBlockEmission,
+		PendingTopicUpdates:                            pendingTopicUpdates,
+		ArchivedTopics:                                 archivedTopics,
+		TopicWorkerWhitelistEnabled:                    topicWorkerWhitelistEnabled,
+		TopicReputerWhitelistEnabled:                   topicReputerWhitelistEnabled,
+		TopicWorkerWhitelist:                           topicWorkerWhitelist,
+		TopicReputerWhitelist:                          topicReputerWhitelist,
+		FundingSubscriptions:                           fundingSubscriptions,
+		NextFundingSubscriptionId:                      nextFundingSubscriptionId,
+		TopicCreatorRewards:                            topicCreatorRewards,
+		LastNodeRotations:                              lastNodeRotations,
+		WorkerCommitments:                              workerCommitments,
+		ReputerCommitments:                             reputerCommitments,
+		WorkerBonds:                                    workerBonds,
+		WorkerBondRemovals:                             workerBondRemovals,
+		ReputerOutlierStreaks:                          reputerOutlierStreaks,
+		ReputerSlashes:                                 reputerSlashes,
+		WorkerLiveness:                                 workerLiveness,
+		ReputerLiveness:                                reputerLiveness,
+		ReputerCommissions:                             reputerCommissions,
+		Redelegations:                                  redelegations,
+		AutoCompoundDelegators:                         autoCompoundDelegators,
+		DelegateStakeReceiptPools:                      delegateStakeReceiptPools,
+		NextDelegateStakeReceiptPoolId:                 nextDelegateStakeReceiptPoolId,
 	}, nil
 }
 
@@ -0,0 +1,246 @@
+package inferencesynthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Computes the losses of a value bundle reporting the ground truth of a topic with chain computed losses.
+// Each value of the network inferences at the nonce of the bundle, synthesized once per nonce, is scored
+// against the ground truth with the loss method of the topic. For topics with several outputs, the loss of
+// a value is the mean of the losses of its outputs, except for probabilistic topics whose outputs, quantiles,
+// are scored together against a single ground truth. The returned bundle is the given one with its losses, as log10, filled in.
+func CalcChainComputedLosses(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	topic emissions.Topic,
+	bundle *emissions.ValueBundle,
+) (*emissions.ValueBundle, error) {
+	groundTruth, err := groundTruthOfTopic(topic, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := getChainLossNetworkInferences(ctx, k, topic, bundle.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	var logLoss func(prediction []alloraMath.Dec) (alloraMath.Dec, error)
+	if topic.IsProbabilistic() {
+		logLoss = func(quantiles []alloraMath.Dec) (alloraMath.Dec, error) {
+			return emissions.CalcQuantileLogLoss(topic.LossMethod, quantiles, topic.QuantileLevels, groundTruth[0])
+		}
+	} else {
+		if len(components) != len(groundTruth) {
+			return nil, errorsmod.Wrapf(emissions.ErrInvalidOutputDimension,
+				"network inferences have %d outputs, ground truth has %d", len(components), len(groundTruth))
+		}
+		lossFunction, err := emissions.GetLossFunction(topic.LossMethod)
+		if err != nil {
+			return nil, err
+		}
+		logLoss = func(prediction []alloraMath.Dec) (alloraMath.Dec, error) {
+			return emissions.CalcVectorLogLoss(lossFunction, prediction, groundTruth)
+		}
+	}
+	// Values of a worker in every output, as listed by valuesOf
+	workerPrediction := func(worker string, valuesOf func(*emissions.ValueBundle) map[string]alloraMath.Dec) ([]alloraMath.Dec, error) {
+		prediction := make([]alloraMath.Dec, 0, len(components))
+		for i, component := range components {
+			value, ok := valuesOf(component)[worker]
+			if !ok {
+				return nil, errorsmod.Wrapf(emissions.ErrInvalidOutputDimension, "no value of worker %s for output %d", worker, i)
+			}
+			prediction = append(prediction, value)
+		}
+		return prediction, nil
+	}
+	attributedLogLosses := func(
+		values []*emissions.WorkerAttributedValue,
+		valuesOf func(*emissions.ValueBundle) []*emissions.WorkerAttributedValue,
+	) ([]*emissions.WorkerAttributedValue, error) {
+		losses := make([]*emissions.WorkerAttributedValue, 0, len(values))
+		for _, value := range values {
+			prediction, err := workerPrediction(value.Worker, func(component *emissions.ValueBundle) map[string]alloraMath.Dec {
+				return attributedValuesByWorker(valuesOf(component))
+			})
+			if err != nil {
+				return nil, err
+			}
+			loss, err := logLoss(prediction)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "error computing loss of worker %s", value.Worker)
+			}
+			losses = append(losses, &emissions.WorkerAttributedValue{Worker: value.Worker, Value: loss})
+		}
+		return losses, nil
+	}
+	withheldLogLosses := func(
+		values []*emissions.WithheldWorkerAttributedValue,
+		valuesOf func(*emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue,
+	) ([]*emissions.WithheldWorkerAttributedValue, error) {
+		losses := make([]*emissions.WithheldWorkerAttributedValue, 0, len(values))
+		for _, value := range values {
+			prediction, err := workerPrediction(value.Worker, func(component *emissions.ValueBundle) map[string]alloraMath.Dec {
+				return withheldValuesByWorker(valuesOf(component))
+			})
+			if err != nil {
+				return nil, err
+			}
+			loss, err := logLoss(prediction)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "error computing one-out loss of worker %s", value.Worker)
+			}
+			losses = append(losses, &emissions.WithheldWorkerAttributedValue{Worker: value.Worker, Value: loss})
+		}
+		return losses, nil
+	}
+
+	first := components[0]
+	combinedPrediction := make([]alloraMath.Dec, 0, len(components))
+	naivePrediction := make([]alloraMath.Dec, 0, len(components))
+	for _, component := range components {
+		combinedPrediction = append(combinedPrediction, component.CombinedValue)
+		naivePrediction = append(naivePrediction, component.NaiveValue)
+	}
+	combinedLoss, err := logLoss(combinedPrediction)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error computing combined loss")
+	}
+	naiveLoss, err := logLoss(naivePrediction)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error computing naive loss")
+	}
+	infererLosses, err := attributedLogLosses(first.InfererValues, func(component *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+		return component.InfererValues
+	})
+	if err != nil {
+		return nil, err
+	}
+	forecasterLosses, err := attributedLogLosses(first.ForecasterValues, func(component *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+		return component.ForecasterValues
+	})
+	if err != nil {
+		return nil, err
+	}
+	oneInForecasterLosses, err := attributedLogLosses(first.OneInForecasterValues, func(component *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+		return component.OneInForecasterValues
+	})
+	if err != nil {
+		return nil, err
+	}
+	oneOutInfererLosses, err := withheldLogLosses(first.OneOutInfererValues, func(component *emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue {
+		return component.OneOutInfererValues
+	})
+	if err != nil {
+		return nil, err
+	}
+	oneOutForecasterLosses, err := withheldLogLosses(first.OneOutForecasterValues, func(component *emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue {
+		return component.OneOutForecasterValues
+	})
+	if err != nil {
+		return nil, err
+	}
+	oneOutInfererForecasterLosses := make([]*emissions.OneOutInfererForecasterValues, 0, len(first.OneOutInfererForecasterValues))
+	for _, values := range first.OneOutInfererForecasterValues {
+		forecaster := values.Forecaster
+		losses, err := withheldLogLosses(values.OneOutInfererValues, func(component *emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue {
+			for _, componentValues := range component.OneOutInfererForecasterValues {
+				if componentValues.Forecaster == forecaster {
+					return componentValues.OneOutInfererValues
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "error computing one-out inferer losses of forecaster %s", forecaster)
+		}
+		oneOutInfererForecasterLosses = append(oneOutInfererForecasterLosses, &emissions.OneOutInfererForecasterValues{
+			Forecaster:          forecaster,
+			OneOutInfererValues: losses,
+		})
+	}
+
+	return &emissions.ValueBundle{
+		TopicId:                       bundle.TopicId,
+		ReputerRequestNonce:           bundle.ReputerRequestNonce,
+		Reputer:                       bundle.Reputer,
+		ExtraData:                     bundle.ExtraData,
+		CombinedValue:                 combinedLoss,
+		InfererValues:                 infererLosses,
+		ForecasterValues:              forecasterLosses,
+		NaiveValue:                    naiveLoss,
+		OneOutInfererValues:           oneOutInfererLosses,
+		OneOutForecasterValues:        oneOutForecasterLosses,
+		OneInForecasterValues:         oneInForecasterLosses,
+		OneOutInfererForecasterValues: oneOutInfererForecasterLosses,
+		GroundTruth:                   bundle.GroundTruth,
+		GroundTruthValues:             bundle.GroundTruthValues,
+	}, nil
+}
+
+// Returns the network inferences, one per output, at a reputer nonce of a topic with chain computed losses.
+// They are synthesized on the first call for the nonce and recorded for the next ones: every reputer of the
+// nonce is then scored against the same network inferences, whose synthesis is not repeated per reputer.
+func getChainLossNetworkInferences(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	topic emissions.Topic,
+	inferencesNonce int64,
+) ([]*emissions.ValueBundle, error) {
+	components, found, err := k.GetChainLossNetworkInferences(ctx, topic.Id, inferencesNonce)
+	if err != nil {
+		return nil, err
+	} else if found {
+		return components, nil
+	}
+	networkInferences, err := GetNetworkInferences(ctx, k, topic.Id, &inferencesNonce)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "error getting network inferences at block %d", inferencesNonce)
+	}
+	// A topic with a single output is scored as a topic whose only output is its network inferences
+	components = networkInferences.ComponentNetworkInferences
+	if topic.OutputDimension <= 1 {
+		components = []*emissions.ValueBundle{networkInferences.NetworkInferences}
+	}
+	if err := k.SetChainLossNetworkInferences(ctx, topic.Id, inferencesNonce, components); err != nil {
+		return nil, errorsmod.Wrapf(err, "error recording network inferences at block %d", inferencesNonce)
+	}
+	return components, nil
+}
+
+// Returns the ground truth reported in the bundle, one value per output of the topic,
+// or a single value for topics with a single output and probabilistic topics
+func groundTruthOfTopic(topic emissions.Topic, bundle *emissions.ValueBundle) ([]alloraMath.Dec, error) {
+	if topic.OutputDimension <= 1 || topic.IsProbabilistic() {
+		if bundle.GroundTruth == nil {
+			return nil, errorsmod.Wrap(emissions.ErrInvalidChainComputedLossBundle, "ground truth missing")
+		}
+		return []alloraMath.Dec{*bundle.GroundTruth}, nil
+	}
+	if uint64(len(bundle.GroundTruthValues)) != topic.OutputDimension {
+		return nil, errorsmod.Wrapf(emissions.ErrInvalidChainComputedLossBundle,
+			"expected %d ground truth values, got %d", topic.OutputDimension, len(bundle.GroundTruthValues))
+	}
+	return bundle.GroundTruthValues, nil
+}
+
+func attributedValuesByWorker(values []*emissions.WorkerAttributedValue) map[string]alloraMath.Dec {
+	valuesByWorker := make(map[string]alloraMath.Dec, len(values))
+	for _, value := range values {
+		valuesByWorker[value.Worker] = value.Value
+	}
+	return valuesByWorker
+}
+
+func withheldValuesByWorker(values []*emissions.WithheldWorkerAttributedValue) map[string]alloraMath.Dec {
+	valuesByWorker := make(map[string]alloraMath.Dec, len(values))
+	for _, value := range values {
+		valuesByWorker[value.Worker] = value.Value
+	}
+	return valuesByWorker
+}
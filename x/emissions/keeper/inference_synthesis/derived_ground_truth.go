@@ -0,0 +1,59 @@
+package inferencesynthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Computes the ground truth of the inferences of a derived topic at the given nonce from the combined network
+// inferences of its parent topic, at the nonce and once the ground truth lag of the derived topic has passed.
+// Network inferences of the parent are synthesized from its stored inferences with its current regrets.
+func CalcDerivedGroundTruth(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	topic emissions.Topic,
+	nonceBlockHeight BlockHeight,
+) (alloraMath.Dec, error) {
+	source := topic.GroundTruthSource
+	if source == nil {
+		return alloraMath.Dec{}, errorsmod.Wrapf(emissions.ErrInvalidGroundTruthSource, "topic %d is not derived", topic.Id)
+	}
+	valueAfterLag, err := parentNetworkInferenceAt(ctx, k, source.ParentTopicId, nonceBlockHeight+topic.GroundTruthLag)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	var valueAtNonce alloraMath.Dec
+	if source.Aggregation != emissions.GroundTruthAggregationValueAtBlock {
+		valueAtNonce, err = parentNetworkInferenceAt(ctx, k, source.ParentTopicId, nonceBlockHeight)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+	}
+	return source.Aggregate(valueAtNonce, valueAfterLag)
+}
+
+// Returns the combined network inference of a topic at its latest inference nonce at or before the block
+func parentNetworkInferenceAt(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	parentTopicId TopicId,
+	block BlockHeight,
+) (alloraMath.Dec, error) {
+	inferenceBlockHeight, found, err := k.GetLatestInferenceBlockHeightAtOrBefore(ctx, parentTopicId, block)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	} else if !found {
+		return alloraMath.Dec{}, errorsmod.Wrapf(emissions.ErrNotFound,
+			"no inferences of parent topic %d at or before block %d", parentTopicId, block)
+	}
+	result, err := GetNetworkInferences(ctx, k, parentTopicId, &inferenceBlockHeight)
+	if err != nil {
+		return alloraMath.Dec{}, errorsmod.Wrapf(err,
+			"error getting network inferences of parent topic %d at block %d", parentTopicId, inferenceBlockHeight)
+	}
+	return result.NetworkInferences.CombinedValue, nil
+}
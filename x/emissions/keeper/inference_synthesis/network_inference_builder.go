@@ -870,5 +870,7 @@ func CalcNetworkInferences(
 		OneOutForecasterValues:        oneOutForecasterInferences,
 		OneInForecasterValues:         oneInForecasterInferences,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}, weights, err
 }
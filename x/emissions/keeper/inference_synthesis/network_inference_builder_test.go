@@ -74,10 +74,13 @@ func (s *InferenceSynthesisTestSuite) SetupTest() {
 		emissionstypes.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		emissionstypes.AlloraRewardsAccountName: {"minter"},
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: {"minter"},
-		"bonded_tokens_pool":     {"burner", "staking"},
-		"not_bonded_tokens_pool": {"burner", "staking"},
-		multiPerm:                {"burner", "minter", "staking"},
-		randomPerm:               {"random"},
+		emissionstypes.AlloraRequestsAccountName:                  {"minter"},
+		emissionstypes.AlloraWorkerBondsAccountName:               {"minter"},
+		emissionstypes.AlloraDelegateStakeReceiptsAccountName:     {"minter", "burner"},
+		"bonded_tokens_pool":                                      {"burner", "staking"},
+		"not_bonded_tokens_pool":                                  {"burner", "staking"},
+		multiPerm:                                                 {"burner", "minter", "staking"},
+		randomPerm:                                                {"random"},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -142,6 +145,16 @@ func (s *InferenceSynthesisTestSuite) SetupTest() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.01"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.01"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.01"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	})
 	s.Require().NoError(err)
 }
@@ -183,6 +196,16 @@ func (s *InferenceSynthesisTestSuite) mockTopic() emissionstypes.Topic {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.01"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.01"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.01"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -204,6 +227,8 @@ func (s *InferenceSynthesisTestSuite) mockEmptyValueBundle(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 }
 
@@ -223,6 +248,8 @@ func (s *InferenceSynthesisTestSuite) getValueBundleForCombinedLoss(topicId uint
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	return valueBundle
 }
@@ -494,6 +521,7 @@ func (s *InferenceSynthesisTestSuite) getEpochValueBundleByEpoch(epochNumber int
 			Proof:       "",
 			BlockHeight: blockHeight,
 			TopicId:     topicId,
+			Values:      nil,
 		})
 	}
 
@@ -21,6 +21,11 @@ type GetNetworkInferencesResult struct {
 	ForecasterToWeight   map[Forecaster]Weight
 	InferenceBlockHeight int64
 	LossBlockHeight      int64
+	// Network inferences of each output of a topic with an output dimension greater than 1,
+	// NetworkInferences then being those of the norm of the outputs
+	ComponentNetworkInferences []*emissions.ValueBundle
+	// Combined distribution of a probabilistic topic, the combined value of each quantile level
+	NetworkQuantiles []*emissions.Quantile
 }
 
 func GetNetworkInferences(
@@ -35,6 +40,124 @@ func GetNetworkInferences(
 		return nil, errorsmod.Wrap(err, "while getting inferences")
 	}
 
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "while getting topic")
+	}
+	if topic.OutputDimension <= 1 {
+		return calcNetworkInferencesOf(ctx, k, topicId, inferences, inferenceBlockHeight)
+	}
+
+	// Inferences with several outputs are synthesized once on a point summary of their outputs, which gives
+	// the weights of the workers, and once per output. Regrets and losses being defined over all outputs,
+	// the weights of the workers are the same in every synthesis. The summary is the norm of the outputs,
+	// or the central quantile for probabilistic topics.
+	summaryOf := func(inference *emissions.Inference) (alloraMath.Dec, error) {
+		return alloraMath.L2Norm(inference.Values)
+	}
+	if topic.IsProbabilistic() {
+		central := centralQuantileIndex(topic.QuantileLevels)
+		summaryOf = func(inference *emissions.Inference) (alloraMath.Dec, error) {
+			if len(inference.Values) <= central {
+				return alloraMath.Dec{}, errorsmod.Wrapf(emissions.ErrInvalidOutputDimension,
+					"inference of %s has %d values", inference.Inferer, len(inference.Values))
+			}
+			return inference.Values[central], nil
+		}
+	}
+	summaryInferences, err := projectInferences(inferences, summaryOf)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "while summarizing inferences")
+	}
+	result, err := calcNetworkInferencesOf(ctx, k, topicId, summaryInferences, inferenceBlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	result.ComponentNetworkInferences = make([]*emissions.ValueBundle, 0, topic.OutputDimension)
+	for component := uint64(0); component < topic.OutputDimension; component++ {
+		componentInferences, err := projectInferences(inferences, func(inference *emissions.Inference) (alloraMath.Dec, error) {
+			if uint64(len(inference.Values)) <= component {
+				return alloraMath.Dec{}, errorsmod.Wrapf(emissions.ErrInvalidOutputDimension,
+					"inference of %s has %d values", inference.Inferer, len(inference.Values))
+			}
+			return inference.Values[component], nil
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "while getting output %d of inferences", component)
+		}
+		componentResult, err := calcNetworkInferencesOf(ctx, k, topicId, componentInferences, inferenceBlockHeight)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "while calculating network inferences of output %d", component)
+		}
+		result.ComponentNetworkInferences = append(result.ComponentNetworkInferences, componentResult.NetworkInferences)
+	}
+	// The weights of the workers being the same at every level, combining quantiles that do not decrease
+	// with their level gives quantiles that do not either, hence a valid distribution
+	if topic.IsProbabilistic() {
+		result.NetworkQuantiles = make([]*emissions.Quantile, 0, len(topic.QuantileLevels))
+		for i, level := range topic.QuantileLevels {
+			result.NetworkQuantiles = append(result.NetworkQuantiles, &emissions.Quantile{
+				Level: level,
+				Value: result.ComponentNetworkInferences[i].CombinedValue,
+			})
+		}
+	}
+	return result, nil
+}
+
+// Returns the index of the level closest to the median, the lowest one on ties
+func centralQuantileIndex(levels []alloraMath.Dec) int {
+	half := alloraMath.MustNewDecFromString("0.5")
+	central := 0
+	var centralDistance alloraMath.Dec
+	for i, level := range levels {
+		diff, err := level.Sub(half)
+		if err != nil {
+			continue
+		}
+		distance, err := diff.Abs()
+		if err != nil {
+			continue
+		}
+		if i == 0 || distance.Lt(centralDistance) {
+			central, centralDistance = i, distance
+		}
+	}
+	return central
+}
+
+// Copies the inferences with their value replaced by the one given by valueOf
+func projectInferences(
+	inferences *emissions.Inferences,
+	valueOf func(inference *emissions.Inference) (alloraMath.Dec, error),
+) (*emissions.Inferences, error) {
+	projected := make([]*emissions.Inference, 0, len(inferences.Inferences))
+	for _, inference := range inferences.Inferences {
+		value, err := valueOf(inference)
+		if err != nil {
+			return nil, err
+		}
+		projected = append(projected, &emissions.Inference{
+			TopicId:     inference.TopicId,
+			BlockHeight: inference.BlockHeight,
+			Inferer:     inference.Inferer,
+			Value:       value,
+			ExtraData:   inference.ExtraData,
+			Proof:       inference.Proof,
+			Values:      nil,
+		})
+	}
+	return &emissions.Inferences{Inferences: projected}, nil
+}
+
+// Calculates the network inferences from inferences with a single value
+func calcNetworkInferencesOf(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	topicId TopicId,
+	inferences *emissions.Inferences,
+	inferenceBlockHeight BlockHeight,
+) (*GetNetworkInferencesResult, error) {
 	if len(inferences.Inferences) > 1 {
 		// If we have multiple inferences:
 		// 1. Try to get latest network loss
@@ -112,13 +235,17 @@ func calcNetworkInferencesMultipleByMedian(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	return &GetNetworkInferencesResult{
-		NetworkInferences:    networkInferences,
-		InfererToWeight:      nil,
-		ForecasterToWeight:   nil,
-		InferenceBlockHeight: inferenceBlockHeight,
-		LossBlockHeight:      0,
+		NetworkInferences:          networkInferences,
+		InfererToWeight:            nil,
+		ForecasterToWeight:         nil,
+		InferenceBlockHeight:       inferenceBlockHeight,
+		LossBlockHeight:            0,
+		ComponentNetworkInferences: nil,
+		NetworkQuantiles:           nil,
 	}, nil
 }
 
@@ -171,11 +298,13 @@ func calcNetworkInferencesMultiple(
 	}
 
 	return &GetNetworkInferencesResult{
-		NetworkInferences:    networkInferences,
-		InfererToWeight:      weights.Inferers,
-		ForecasterToWeight:   weights.Forecasters,
-		InferenceBlockHeight: inferenceBlockHeight,
-		LossBlockHeight:      networkLosses.ReputerRequestNonce.ReputerNonce.BlockHeight,
+		NetworkInferences:          networkInferences,
+		InfererToWeight:            weights.Inferers,
+		ForecasterToWeight:         weights.Forecasters,
+		InferenceBlockHeight:       inferenceBlockHeight,
+		LossBlockHeight:            networkLosses.ReputerRequestNonce.ReputerNonce.BlockHeight,
+		ComponentNetworkInferences: nil,
+		NetworkQuantiles:           nil,
 	}, nil
 }
 
@@ -210,13 +339,17 @@ func calcNetworkInferencesSingle(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	return &GetNetworkInferencesResult{
-		NetworkInferences:    networkInferences,
-		InfererToWeight:      nil,
-		ForecasterToWeight:   nil,
-		InferenceBlockHeight: inferenceBlockHeight,
-		LossBlockHeight:      0, // Loss data may actually be available but is not needed to calculate network inference in this case
+		NetworkInferences:          networkInferences,
+		InfererToWeight:            nil,
+		ForecasterToWeight:         nil,
+		InferenceBlockHeight:       inferenceBlockHeight,
+		LossBlockHeight:            0, // Loss data may actually be available but is not needed to calculate network inference in this case
+		ComponentNetworkInferences: nil,
+		NetworkQuantiles:           nil,
 	}, nil
 }
 
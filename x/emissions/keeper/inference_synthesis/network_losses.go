@@ -264,6 +264,8 @@ func CalcNetworkLosses(
 		OneOutForecasterValues:        oneOutForecasterLosses,
 		OneInForecasterValues:         oneInForecasterLosses,
 		OneOutInfererForecasterValues: oneOutInfererForecasterLosses,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	return output, nil
@@ -111,6 +111,8 @@ func (s *InferenceSynthesisTestSuite) getTestCasesOneWorker() []struct {
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature := s.signValueBundle(valueBundle, s.privKeys[1])
 	return []struct {
@@ -176,6 +178,8 @@ func (s *InferenceSynthesisTestSuite) getTestCasesOneWorker() []struct {
 					},
 				},
 				OneOutInfererForecasterValues: nil,
+				GroundTruth:                   nil,
+				GroundTruthValues:             nil,
 			},
 			expectedError: nil,
 		},
@@ -250,6 +254,8 @@ func (s *InferenceSynthesisTestSuite) getTestCasesTwoWorkers() []struct {
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature1 := s.signValueBundle(&valueBundle1, s.privKeys[1])
 
@@ -313,6 +319,8 @@ func (s *InferenceSynthesisTestSuite) getTestCasesTwoWorkers() []struct {
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature2 := s.signValueBundle(&valueBundle2, s.privKeys[2])
 	return []struct {
@@ -404,6 +412,8 @@ func (s *InferenceSynthesisTestSuite) getTestCasesTwoWorkers() []struct {
 					},
 				},
 				OneOutInfererForecasterValues: nil,
+				GroundTruth:                   nil,
+				GroundTruthValues:             nil,
 			},
 			expectedError: nil,
 		},
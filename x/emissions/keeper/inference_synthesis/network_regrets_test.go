@@ -42,6 +42,8 @@ func (s *InferenceSynthesisTestSuite) TestConvertValueBundleToNetworkLossesByWor
 			{Worker: s.addrsStr[2], Value: alloraMath.MustNewDecFromString("0.2")},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	result := inferencesynthesis.ConvertValueBundleToNetworkLossesByWorker(valueBundle)
@@ -156,6 +158,8 @@ func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsTwoWorkers() {
 		},
 		OneOutForecasterValues:        nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	regretVal := emissionstypes.TimestampedValue{
@@ -319,6 +323,8 @@ func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkRegretsThreeWorkers()
 		OneOutInfererValues:           nil,
 		OneOutForecasterValues:        nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	blockHeight := int64(42)
 	nonce := emissionstypes.Nonce{BlockHeight: blockHeight}
@@ -566,6 +572,8 @@ func (s *InferenceSynthesisTestSuite) TestHigherLossesLowerRegret() {
 			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	networkLossesValueBundle1 := emissionstypes.ValueBundle{
@@ -593,6 +601,8 @@ func (s *InferenceSynthesisTestSuite) TestHigherLossesLowerRegret() {
 			{Worker: worker2, Value: alloraMath.MustNewDecFromString("0.4")},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	resetRegrets := func() {
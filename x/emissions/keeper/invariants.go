@@ -18,6 +18,9 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-removals-length-same", StakingInvariantLenStakeRemovalsSame(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-sum-delegated-stakes", StakingInvariantDelegatedStakes(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "pending-reward-for-delegators-equal-reward-per-share-minus-reward-debt", StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "allora-worker-bonds-total-balance", WorkerBondsInvariantTotalBondEqualAlloraWorkerBondsBankBalance(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "delegate-stake-receipts-supply-equal-pool-shares", DelegateStakeReceiptsInvariantSupplyEqualPoolShares(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "delegate-stake-receipts-total-reserve-balance", DelegateStakeReceiptsInvariantTotalReserveEqualBankBalance(*k))
 }
 
 // AllInvariants is a convience function to run all invariants in the emissions module.
@@ -38,6 +41,15 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if res, stop := StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRewardDebt(k)(ctx); stop {
 			return res, stop
 		}
+		if res, stop := WorkerBondsInvariantTotalBondEqualAlloraWorkerBondsBankBalance(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := DelegateStakeReceiptsInvariantSupplyEqualPoolShares(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := DelegateStakeReceiptsInvariantTotalReserveEqualBankBalance(k)(ctx); stop {
+			return res, stop
+		}
 		return "", false
 	}
 }
@@ -426,3 +438,99 @@ func StakingInvariantPendingRewardForDelegatorsGreaterThanRewardPerShareMinusRew
 		return "", false
 	}
 }
+
+// WorkerBondsInvariantTotalBondEqualAlloraWorkerBondsBankBalance checks that
+// the sum of the worker bonds in all topics is equal to the balance
+// of the Allora worker bonds bank account.
+func WorkerBondsInvariantTotalBondEqualAlloraWorkerBondsBankBalance(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		iter, err := k.workerBonds.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to iterate worker bonds: %v", err))
+		}
+		bonds, err := iter.Values()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get worker bonds: %v", err))
+		}
+		totalBond := cosmosMath.ZeroInt()
+		for _, bond := range bonds {
+			totalBond = totalBond.Add(bond)
+		}
+		alloraWorkerBondsAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraWorkerBondsAccountName).GetAddress()
+		alloraWorkerBondsBalance := k.bankKeeper.GetBalance(
+			ctx,
+			alloraWorkerBondsAddr,
+			params.DefaultBondDenom).Amount
+		broken := !totalBond.Equal(alloraWorkerBondsBalance)
+		return sdk.FormatInvariant(
+			emissionstypes.ModuleName,
+			"emissions module total worker bond equal allora worker bonds bank balance",
+			fmt.Sprintf("TotalWorkerBond: %s | Allora Module Account Worker Bonds Balance: %s",
+				totalBond.String(),
+				alloraWorkerBondsBalance.String(),
+			),
+		), broken
+	}
+}
+
+// DelegateStakeReceiptsInvariantSupplyEqualPoolShares checks that
+// the bank supply of the receipts of every delegate stake receipt pool
+// is equal to the shares of the pool.
+func DelegateStakeReceiptsInvariantSupplyEqualPoolShares(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := k.GetDelegateStakeReceiptPools(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get delegate stake receipt pools: %v", err))
+		}
+		for _, pool := range pools {
+			denom := emissionstypes.DelegateStakeReceiptDenom(pool.Id)
+			supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+			if !supply.Equal(pool.Shares) {
+				return sdk.FormatInvariant(
+					emissionstypes.ModuleName,
+					"delegate stake receipts supply equal pool shares",
+					fmt.Sprintf("Pool: %d | Receipts Supply: %s | Pool Shares: %s",
+						pool.Id,
+						supply.String(),
+						pool.Shares.String(),
+					),
+				), true
+			}
+		}
+		return sdk.FormatInvariant(
+			emissionstypes.ModuleName,
+			"delegate stake receipts supply equal pool shares",
+			fmt.Sprintf("Pools: %d", len(pools)),
+		), false
+	}
+}
+
+// DelegateStakeReceiptsInvariantTotalReserveEqualBankBalance checks that
+// the sum of the reserves of the delegate stake receipt pools is equal to
+// the balance of the Allora delegate stake receipts bank account.
+func DelegateStakeReceiptsInvariantTotalReserveEqualBankBalance(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := k.GetDelegateStakeReceiptPools(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get delegate stake receipt pools: %v", err))
+		}
+		totalReserve := cosmosMath.ZeroInt()
+		for _, pool := range pools {
+			totalReserve = totalReserve.Add(pool.Reserve)
+		}
+		alloraDelegateStakeReceiptsAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraDelegateStakeReceiptsAccountName).GetAddress()
+		alloraDelegateStakeReceiptsBalance := k.bankKeeper.GetBalance(
+			ctx,
+			alloraDelegateStakeReceiptsAddr,
+			params.DefaultBondDenom).Amount
+		broken := !totalReserve.Equal(alloraDelegateStakeReceiptsBalance)
+		return sdk.FormatInvariant(
+			emissionstypes.ModuleName,
+			"emissions module total delegate stake receipt pool reserve equal allora delegate stake receipts bank balance",
+			fmt.Sprintf("TotalReserve: %s | Allora Module Account Delegate Stake Receipts Balance: %s",
+				totalReserve.String(),
+				alloraDelegateStakeReceiptsBalance.String(),
+			),
+		), broken
+	}
+}
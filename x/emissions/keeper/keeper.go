@@ -14,6 +14,7 @@ import (
 	alloraMath "github.com/allora-network/allora-chain/math"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 
 	coreStore "cosmossdk.io/core/store"
@@ -48,6 +49,13 @@ type Keeper struct {
 	nextTopicId collections.Sequence
 	// every topic that has been created indexed by their topicId starting from 1 (0 is reserved for the root network)
 	topics collections.Map[TopicId, types.Topic]
+	// secondary indexes of the topics, used to list the topics matching a filter
+	topicsByCreator    collections.KeySet[collections.Pair[ActorId, TopicId]]
+	topicsByTag        collections.KeySet[collections.Pair[string, TopicId]]
+	topicsByCategory   collections.KeySet[collections.Pair[string, TopicId]]
+	topicsByLossMethod collections.KeySet[collections.Pair[string, TopicId]]
+	// parent topic -> topics whose ground truth source is the parent topic
+	derivedTopics collections.KeySet[collections.Pair[TopicId, TopicId]]
 	// which topics are active at the current block height
 	activeTopics collections.KeySet[TopicId]
 	// topic to next possible churning block
@@ -63,6 +71,10 @@ type Keeper struct {
 	topicWorkers collections.KeySet[collections.Pair[TopicId, ActorId]]
 	// for a topic, what is every reputer node that has registered to it?
 	topicReputers collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// for an actor, what is every topic it is registered to as a worker? reverse index of topicWorkers
+	workerTopics collections.KeySet[collections.Pair[ActorId, TopicId]]
+	// for an actor, what is every topic it is registered to as a reputer? reverse index of topicReputers
+	reputerTopics collections.KeySet[collections.Pair[ActorId, TopicId]]
 	// map of (topic) -> nonce/block height
 	topicRewardNonce collections.Map[TopicId, BlockHeight]
 	// active topic inferers for a topic
@@ -77,6 +89,16 @@ type Keeper struct {
 	lowestForecasterScoreEma collections.Map[TopicId, types.Score]
 	// lowest reputer score ema for a topic
 	lowestReputerScoreEma collections.Map[TopicId, types.Score]
+	// topic -> topic as it will be once updates requested by its creator are applied at the next epoch boundary
+	pendingTopicUpdates collections.Map[TopicId, types.Topic]
+	// topic -> archival state of a topic retired through MsgArchiveTopic
+	topicArchives collections.Map[TopicId, types.TopicArchive]
+	// set of archived topics whose state has not yet been fully pruned
+	archivingTopics collections.KeySet[TopicId]
+	// topic -> (stage, key) of the last stake position visited by the current pass returning the stake of an archived topic
+	archivedStakeReturnCursors collections.Map[TopicId, collections.Triple[uint64, ActorId, ActorId]]
+	// set of archived topics for which returning a stake position failed during the current pass
+	archivedStakeReturnFailures collections.KeySet[TopicId]
 
 	/// SCORES
 
@@ -137,6 +159,9 @@ type Keeper struct {
 	delegateStakeRemovalsByBlock collections.Map[Quadruple[BlockHeight, TopicId, Delegator, Reputer], types.DelegateStakeRemovalInfo]
 	// key set of (delegator, reputer, topicId, blockHeight) to existence of a removal in the forwards map
 	delegateStakeRemovalsByActor collections.KeySet[Quadruple[Delegator, Reputer, TopicId, BlockHeight]]
+	// key set of (topicId, reputer, delegator, blockHeight) to existence of a removal in the forwards map,
+	// used to find the removals of stake delegated upon a reputer
+	delegateStakeRemovalsByReputer collections.KeySet[Quadruple[TopicId, Reputer, Delegator, BlockHeight]]
 
 	/// MISC GLOBAL STATE
 
@@ -176,6 +201,9 @@ type Keeper struct {
 	// map of (topic, block_height) -> ValueBundle (1 network wide bundle per timestep)
 	networkLossBundles collections.Map[collections.Pair[TopicId, BlockHeight], types.ValueBundle]
 
+	// map of (topic, reputer nonce, output) -> network inferences the losses of topics with chain computed losses are computed from
+	chainLossNetworkInferences collections.Map[collections.Triple[TopicId, BlockHeight, uint64], types.ValueBundle]
+
 	// Percentage of all rewards, paid out to staked reputers, during the previous reward cadence. Used by mint module
 	previousPercentageRewardToStakedReputers collections.Item[alloraMath.Dec]
 
@@ -224,11 +252,109 @@ type Keeper struct {
 	/// WHITELISTS
 
 	whitelistAdmins collections.KeySet[ActorId]
+	// topics whose worker whitelist is enforced
+	topicWorkerWhitelistEnabled collections.KeySet[TopicId]
+	// topics whose reputer whitelist is enforced
+	topicReputerWhitelistEnabled collections.KeySet[TopicId]
+	// (topic, worker) -> worker is whitelisted in the topic
+	topicWorkerWhitelist collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// (topic, reputer) -> reputer is whitelisted in the topic
+	topicReputerWhitelist collections.KeySet[collections.Pair[TopicId, ActorId]]
 
 	/// RECORD COMMITS
 
 	topicLastWorkerCommit  collections.Map[TopicId, types.TimestampedActorNonce]
 	topicLastReputerCommit collections.Map[TopicId, types.TimestampedActorNonce]
+
+	/// FUNDING SUBSCRIPTIONS
+
+	// the next funding subscription id to be used
+	nextFundingSubscriptionId collections.Sequence
+	// active funding subscriptions indexed by their id
+	fundingSubscriptions collections.Map[uint64, types.FundingSubscription]
+	// (topic, subscription) -> subscription funds the topic
+	fundingSubscriptionsByTopic collections.KeySet[collections.Pair[TopicId, uint64]]
+	// (funder, subscription) -> subscription was created by the funder
+	fundingSubscriptionsByFunder collections.KeySet[collections.Pair[ActorId, uint64]]
+	// (block, subscription) -> next drip of the subscription is due at the block
+	dueFundingSubscriptions collections.KeySet[collections.Pair[BlockHeight, uint64]]
+
+	/// TOPIC CREATOR REWARDS
+
+	// topic id -> total rewards paid to the topic creator as commission on the rewards of the topic
+	topicCreatorRewards collections.Map[TopicId, cosmosMath.Int]
+
+	/// NODE ROTATIONS
+
+	// (topic, node) -> block at which the node was last moved to its address in the topic
+	lastNodeRotations collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]
+
+	/// COMMIT-REVEAL
+
+	// (topic, worker) -> commitment of the worker to the payload it reveals for the open worker nonce
+	workerCommitments collections.Map[collections.Pair[TopicId, ActorId], types.WorkerCommitment]
+	// (topic, reputer nonce, reputer) -> commitment of the reputer to the loss bundle it reveals for an open reputer nonce
+	reputerCommitments collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], types.ReputerCommitment]
+
+	/// WORKER BONDS
+
+	// (topic, worker) -> bond held by the worker in the topic
+	workerBonds collections.Map[collections.Pair[TopicId, ActorId], cosmosMath.Int]
+	// (block removal completed, topic, worker) -> worker bond removal, in the style of stakeRemovalsByBlock
+	workerBondRemovalsByBlock collections.Map[collections.Triple[BlockHeight, TopicId, ActorId], types.WorkerBondRemovalInfo]
+	// (topic, worker) -> block at which the worker bond removal of the worker completes, at most one per worker
+	workerBondRemovalsByWorker collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]
+
+	/// REPUTER SLASHING
+
+	// (topic, reputer) -> number of consecutive epochs the reputer has been an outlier of the topic
+	reputerOutlierStreaks collections.Map[collections.Pair[TopicId, ActorId], uint64]
+	// (topic, reputer, block) -> slash of the stake upon the reputer for being a persistent outlier of the topic
+	reputerSlashes collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.ReputerSlash]
+
+	/// LIVENESS
+
+	// (topic, worker) -> liveness of the worker in the topic
+	workerLiveness collections.Map[collections.Pair[TopicId, ActorId], types.ActorLiveness]
+	// (topic, reputer) -> liveness of the reputer in the topic
+	reputerLiveness collections.Map[collections.Pair[TopicId, ActorId], types.ActorLiveness]
+
+	/// REPUTER COMMISSIONS
+
+	// (topic, reputer) -> commission of the reputer on the rewards of its delegators in the topic
+	reputerCommissions collections.Map[collections.Pair[TopicId, ActorId], types.ReputerCommission]
+
+	/// REDELEGATIONS
+
+	// (topic, reputer, delegator, source reputer) -> stake redelegated to the reputer from the source reputer
+	// that has not matured yet
+	redelegations collections.Map[Quadruple[TopicId, Reputer, Delegator, Reputer], types.Redelegation]
+	// (block matured, (topic, reputer, delegator, source reputer)) -> redelegations by the block they mature at
+	redelegationsByMaturity collections.KeySet[collections.Pair[BlockHeight, Quadruple[TopicId, Reputer, Delegator, Reputer]]]
+	// (topic, source reputer, delegator, reputer) -> redelegations by the reputer the stake was redelegated from
+	redelegationsBySrcReputer collections.KeySet[Quadruple[TopicId, Reputer, Delegator, Reputer]]
+
+	/// DELEGATOR REWARDS
+
+	// (delegator, topic, reputer) reverse index of delegatedStakes
+	delegatorDelegations collections.KeySet[collections.Triple[Delegator, TopicId, Reputer]]
+	// (topic, reputer, delegator) reverse index of delegatedStakes, used to find the delegations upon a reputer
+	reputerDelegations collections.KeySet[collections.Triple[TopicId, Reputer, Delegator]]
+	// delegators whose pending rewards are restaked into their delegations at each payout
+	autoCompoundDelegators collections.KeySet[Delegator]
+	// (topic, reputer, delegator) -> delegations of the auto-compound delegators, restaked when rewards of the topic are paid out
+	autoCompoundDelegations collections.KeySet[collections.Triple[TopicId, Reputer, Delegator]]
+	// topic -> (reputer, delegator) of the last auto-compound delegation restaked at a payout of the topic that did not reach them all
+	autoCompoundCursors collections.Map[TopicId, collections.Pair[Reputer, Delegator]]
+
+	/// DELEGATE STAKE RECEIPTS
+
+	// pool id -> pool of delegated stake tokenized into receipts
+	delegateStakeReceiptPools collections.Map[uint64, types.DelegateStakeReceiptPool]
+	// (topic, reputer) -> id of the pool of delegated stake upon the reputer in the topic
+	delegateStakeReceiptPoolIds collections.Map[collections.Pair[TopicId, Reputer], uint64]
+	// id of the next pool of delegated stake to be created
+	nextDelegateStakeReceiptPoolId collections.Sequence
 }
 
 func NewKeeper(
@@ -253,6 +379,11 @@ func NewKeeper(
 		topicStake:                               collections.NewMap(sb, types.TopicStakeKey, "topic_stake", collections.Uint64Key, sdk.IntValue),
 		nextTopicId:                              collections.NewSequence(sb, types.NextTopicIdKey, "next_TopicId"),
 		topics:                                   collections.NewMap(sb, types.TopicsKey, "topics", collections.Uint64Key, codec.CollValue[types.Topic](cdc)),
+		topicsByCreator:                          collections.NewKeySet(sb, types.TopicsByCreatorKey, "topics_by_creator", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		topicsByTag:                              collections.NewKeySet(sb, types.TopicsByTagKey, "topics_by_tag", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		topicsByCategory:                         collections.NewKeySet(sb, types.TopicsByCategoryKey, "topics_by_category", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		topicsByLossMethod:                       collections.NewKeySet(sb, types.TopicsByLossMethodKey, "topics_by_loss_method", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		derivedTopics:                            collections.NewKeySet(sb, types.DerivedTopicsKey, "derived_topics", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
 		activeTopics:                             collections.NewKeySet(sb, types.ActiveTopicsKey, "active_topics", collections.Uint64Key),
 		topicToNextPossibleChurningBlock:         collections.NewMap(sb, types.TopicToNextPossibleChurningBlockKey, "topic_to_next_possible_churning_block", collections.Uint64Key, collections.Int64Value),
 		blockToActiveTopics:                      collections.NewMap(sb, types.BlockToActiveTopicsKey, "block_to_active_topics", collections.Int64Key, codec.CollValue[types.TopicIds](cdc)),
@@ -260,11 +391,14 @@ func NewKeeper(
 		rewardableTopics:                         collections.NewKeySet(sb, types.RewardableTopicsKey, "rewardable_topics", collections.Uint64Key),
 		topicWorkers:                             collections.NewKeySet(sb, types.TopicWorkersKey, "topic_workers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
 		topicReputers:                            collections.NewKeySet(sb, types.TopicReputersKey, "topic_reputers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		workerTopics:                             collections.NewKeySet(sb, types.WorkerTopicsKey, "worker_topics", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		reputerTopics:                            collections.NewKeySet(sb, types.ReputerTopicsKey, "reputer_topics", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
 		stakeReputerAuthority:                    collections.NewMap(sb, types.StakeByReputerAndTopicIdKey, "stake_by_reputer_and_TopicId", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		stakeRemovalsByBlock:                     collections.NewMap(sb, types.StakeRemovalsByBlockKey, "stake_removals_by_block", collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey), codec.CollValue[types.StakeRemovalInfo](cdc)),
 		stakeRemovalsByActor:                     collections.NewKeySet(sb, types.StakeRemovalsByActorKey, "stake_removals_by_actor", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Int64Key)),
 		delegateStakeRemovalsByBlock:             collections.NewMap(sb, types.DelegateStakeRemovalsByBlockKey, "delegate_stake_removals_by_block", QuadrupleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegateStakeRemovalInfo](cdc)),
 		delegateStakeRemovalsByActor:             collections.NewKeySet(sb, types.DelegateStakeRemovalsByActorKey, "delegate_stake_removals_by_actor", QuadrupleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Int64Key)),
+		delegateStakeRemovalsByReputer:           collections.NewKeySet(sb, types.DelegateStakeRemovalsByReputerKey, "delegate_stake_removals_by_reputer", QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.Int64Key)),
 		stakeSumFromDelegator:                    collections.NewMap(sb, types.DelegatorStakeKey, "stake_from_delegator", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegatedStakes:                          collections.NewMap(sb, types.DelegateStakePlacementKey, "delegate_stake_placement", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegatorInfo](cdc)),
 		stakeFromDelegatorsUponReputer:           collections.NewMap(sb, types.TargetStakeKey, "stake_upon_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
@@ -280,6 +414,7 @@ func NewKeeper(
 		allForecasts:                             collections.NewMap(sb, types.AllForecastsKey, "forecasts_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Forecasts](cdc)),
 		allLossBundles:                           collections.NewMap(sb, types.AllLossBundlesKey, "value_bundles_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ReputerValueBundles](cdc)),
 		networkLossBundles:                       collections.NewMap(sb, types.NetworkLossBundlesKey, "value_bundles_network", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ValueBundle](cdc)),
+		chainLossNetworkInferences:               collections.NewMap(sb, types.ChainLossNetworkInferencesKey, "chain_loss_network_inferences", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.Uint64Key), codec.CollValue[types.ValueBundle](cdc)),
 		previousPercentageRewardToStakedReputers: collections.NewItem(sb, types.PreviousPercentageRewardToStakedReputersKey, "previous_percentage_reward_to_staked_reputers", alloraMath.DecValue),
 		latestInfererNetworkRegrets:              collections.NewMap(sb, types.InfererNetworkRegretsKey, "inferer_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestForecasterNetworkRegrets:           collections.NewMap(sb, types.ForecasterNetworkRegretsKey, "forecaster_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
@@ -290,6 +425,10 @@ func NewKeeper(
 		latestOneOutForecasterInfererNetworkRegrets:    collections.NewMap(sb, types.LatestOneOutForecasterInfererNetworkRegretsKey, "latest_one_out_forecaster_inferer_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		latestOneOutForecasterForecasterNetworkRegrets: collections.NewMap(sb, types.LatestOneOutForecasterForecasterNetworkRegretsKey, "latest_one_out_forecaster_forecaster_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
 		whitelistAdmins:                           collections.NewKeySet(sb, types.WhitelistAdminsKey, "whitelist_admins", collections.StringKey),
+		topicWorkerWhitelistEnabled:               collections.NewKeySet(sb, types.TopicWorkerWhitelistEnabledKey, "topic_worker_whitelist_enabled", collections.Uint64Key),
+		topicReputerWhitelistEnabled:              collections.NewKeySet(sb, types.TopicReputerWhitelistEnabledKey, "topic_reputer_whitelist_enabled", collections.Uint64Key),
+		topicWorkerWhitelist:                      collections.NewKeySet(sb, types.TopicWorkerWhitelistKey, "topic_worker_whitelist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicReputerWhitelist:                     collections.NewKeySet(sb, types.TopicReputerWhitelistKey, "topic_reputer_whitelist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
 		infererScoresByBlock:                      collections.NewMap(sb, types.InferenceScoresKey, "inferer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		forecasterScoresByBlock:                   collections.NewMap(sb, types.ForecastScoresKey, "forecaster_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		infererScoreEmas:                          collections.NewMap(sb, types.InfererScoreEmasKey, "latest_inferer_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
@@ -321,6 +460,39 @@ func NewKeeper(
 		lowestReputerScoreEma:                     collections.NewMap(sb, types.LowestReputerScoreEmaKey, "lowest_reputer_score_ema", collections.Uint64Key, codec.CollValue[types.Score](cdc)),
 		totalSumPreviousTopicWeights:              collections.NewItem(sb, types.TotalSumPreviousTopicWeightsKey, "total_sum_previous_topic_weights", alloraMath.DecValue),
 		rewardCurrentBlockEmission:                collections.NewItem(sb, types.RewardCurrentBlockEmissionKey, "reward_current_block_emission", sdk.IntValue),
+		pendingTopicUpdates:                       collections.NewMap(sb, types.PendingTopicUpdatesKey, "pending_topic_updates", collections.Uint64Key, codec.CollValue[types.Topic](cdc)),
+		topicArchives:                             collections.NewMap(sb, types.TopicArchivesKey, "topic_archives", collections.Uint64Key, codec.CollValue[types.TopicArchive](cdc)),
+		archivingTopics:                           collections.NewKeySet(sb, types.ArchivingTopicsKey, "archiving_topics", collections.Uint64Key),
+		archivedStakeReturnCursors:                collections.NewMap(sb, types.ArchivedStakeReturnCursorsKey, "archived_stake_return_cursors", collections.Uint64Key, collcodec.KeyToValueCodec(collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey))),
+		archivedStakeReturnFailures:               collections.NewKeySet(sb, types.ArchivedStakeReturnFailuresKey, "archived_stake_return_failures", collections.Uint64Key),
+		nextFundingSubscriptionId:                 collections.NewSequence(sb, types.NextFundingSubscriptionIdKey, "next_funding_subscription_id"),
+		fundingSubscriptions:                      collections.NewMap(sb, types.FundingSubscriptionsKey, "funding_subscriptions", collections.Uint64Key, codec.CollValue[types.FundingSubscription](cdc)),
+		fundingSubscriptionsByTopic:               collections.NewKeySet(sb, types.FundingSubscriptionsByTopicKey, "funding_subscriptions_by_topic", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		fundingSubscriptionsByFunder:              collections.NewKeySet(sb, types.FundingSubscriptionsByFunderKey, "funding_subscriptions_by_funder", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		dueFundingSubscriptions:                   collections.NewKeySet(sb, types.DueFundingSubscriptionsKey, "due_funding_subscriptions", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		topicCreatorRewards:                       collections.NewMap(sb, types.TopicCreatorRewardsKey, "topic_creator_rewards", collections.Uint64Key, sdk.IntValue),
+		lastNodeRotations:                         collections.NewMap(sb, types.LastNodeRotationsKey, "last_node_rotations", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Int64Value),
+		workerCommitments:                         collections.NewMap(sb, types.WorkerCommitmentsKey, "worker_commitments", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.WorkerCommitment](cdc)),
+		reputerCommitments:                        collections.NewMap(sb, types.ReputerCommitmentsKey, "reputer_commitments", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), codec.CollValue[types.ReputerCommitment](cdc)),
+		workerBonds:                               collections.NewMap(sb, types.WorkerBondsKey, "worker_bonds", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
+		workerBondRemovalsByBlock:                 collections.NewMap(sb, types.WorkerBondRemovalsByBlockKey, "worker_bond_removals_by_block", collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey), codec.CollValue[types.WorkerBondRemovalInfo](cdc)),
+		workerBondRemovalsByWorker:                collections.NewMap(sb, types.WorkerBondRemovalsByWorkerKey, "worker_bond_removals_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Int64Value),
+		reputerOutlierStreaks:                     collections.NewMap(sb, types.ReputerOutlierStreaksKey, "reputer_outlier_streaks", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Uint64Value),
+		reputerSlashes:                            collections.NewMap(sb, types.ReputerSlashesKey, "reputer_slashes", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Int64Key), codec.CollValue[types.ReputerSlash](cdc)),
+		workerLiveness:                            collections.NewMap(sb, types.WorkerLivenessKey, "worker_liveness", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ActorLiveness](cdc)),
+		reputerLiveness:                           collections.NewMap(sb, types.ReputerLivenessKey, "reputer_liveness", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ActorLiveness](cdc)),
+		reputerCommissions:                        collections.NewMap(sb, types.ReputerCommissionsKey, "reputer_commissions", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ReputerCommission](cdc)),
+		redelegations:                             collections.NewMap(sb, types.RedelegationsKey, "redelegations", QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.StringKey), codec.CollValue[types.Redelegation](cdc)),
+		redelegationsByMaturity:                   collections.NewKeySet(sb, types.RedelegationsByMaturityKey, "redelegations_by_maturity", collections.PairKeyCodec(collections.Int64Key, QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.StringKey))),
+		redelegationsBySrcReputer:                 collections.NewKeySet(sb, types.RedelegationsBySrcReputerKey, "redelegations_by_src_reputer", QuadrupleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.StringKey)),
+		delegatorDelegations:                      collections.NewKeySet(sb, types.DelegatorDelegationsKey, "delegator_delegations", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.StringKey)),
+		reputerDelegations:                        collections.NewKeySet(sb, types.ReputerDelegationsKey, "reputer_delegations", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
+		autoCompoundDelegators:                    collections.NewKeySet(sb, types.AutoCompoundDelegatorsKey, "auto_compound_delegators", collections.StringKey),
+		autoCompoundDelegations:                   collections.NewKeySet(sb, types.AutoCompoundDelegationsKey, "auto_compound_delegations", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
+		autoCompoundCursors:                       collections.NewMap(sb, types.AutoCompoundCursorsKey, "auto_compound_cursors", collections.Uint64Key, collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.StringKey, collections.StringKey))),
+		delegateStakeReceiptPools:                 collections.NewMap(sb, types.DelegateStakeReceiptPoolsKey, "delegate_stake_receipt_pools", collections.Uint64Key, codec.CollValue[types.DelegateStakeReceiptPool](cdc)),
+		delegateStakeReceiptPoolIds:               collections.NewMap(sb, types.DelegateStakeReceiptPoolIdsKey, "delegate_stake_receipt_pool_ids", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Uint64Value),
+		nextDelegateStakeReceiptPoolId:            collections.NewSequence(sb, types.NextDelegateStakeReceiptPoolIdKey, "next_delegate_stake_receipt_pool_id"),
 	}
 
 	schema, err := sb.Build()
@@ -1069,6 +1241,27 @@ func (k *Keeper) GetLatestTopicInferences(ctx context.Context, topicId TopicId)
 	}, 0, nil
 }
 
+// Returns the block height of the latest inferences of a topic at or before the given block.
+// Boolean is false if the topic has no inferences stored up to that block.
+func (k *Keeper) GetLatestInferenceBlockHeightAtOrBefore(ctx context.Context, topicId TopicId, block BlockHeight) (BlockHeight, bool, error) {
+	rng := collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId).EndInclusive(block).Descending()
+
+	iter, err := k.allInferences.Iterate(ctx, rng)
+	if err != nil {
+		return 0, false, errorsmod.Wrap(err, "error iterating over inferences")
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return 0, false, errorsmod.Wrap(err, "error getting key")
+	}
+	return key.K2(), true, nil
+}
+
 func (k *Keeper) GetForecastsAtBlock(ctx context.Context, topicId TopicId, block BlockHeight) (*types.Forecasts, error) {
 	key := collections.Join(topicId, block)
 	forecasts, err := k.allForecasts.Get(ctx, key)
@@ -1613,6 +1806,8 @@ func (k *Keeper) GetNetworkLossBundleAtBlock(ctx context.Context, topicId TopicI
 				OneOutForecasterValues:        nil,
 				OneInForecasterValues:         nil,
 				OneOutInfererForecasterValues: nil,
+				GroundTruth:                   nil,
+				GroundTruthValues:             nil,
 			}, nil
 		}
 		return nil, errorsmod.Wrap(err, "error getting network loss bundle at block")
@@ -1744,11 +1939,13 @@ func (k *Keeper) AddDelegateStake(
 			if err != nil {
 				return errorsmod.Wrap(err, "error trimming pending reward")
 			}
-			err = k.SendCoinsFromModuleToAccount(
+			err = k.sendCoinsToDelegator(
 				ctx,
 				types.AlloraPendingRewardForDelegatorAccountName,
+				topicId,
 				delegator,
-				sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingRewardInt)),
+				reputer,
+				pendingRewardInt,
 			)
 			if err != nil {
 				return errorsmod.Wrap(err, "error sending pending reward to delegator")
@@ -1811,6 +2008,26 @@ func (k *Keeper) RemoveReputerStake(
 	if stakeToRemove.IsZero() {
 		return nil
 	}
+	if err := k.removeReputerStake(ctx, topicId, reputer, stakeToRemove); err != nil {
+		return err
+	}
+
+	// remove stake withdrawal information
+	err := k.DeleteStakeRemoval(ctx, blockHeight, topicId, reputer)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Deleting stake removal from queue failed")
+	}
+
+	return nil
+}
+
+// Removes stake from the system for a given topic and reputer without touching the stake removal queue
+// subtracts from: totalStake, topicStake, stakeReputerAuthority
+func (k *Keeper) removeReputerStake(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	stakeToRemove cosmosMath.Int) error {
 	// Check reputerAuthority >= stake
 	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
 	if err != nil {
@@ -1861,12 +2078,6 @@ func (k *Keeper) RemoveReputerStake(
 		return errorsmod.Wrapf(err, "Setting total stake failed")
 	}
 
-	// remove stake withdrawal information
-	err = k.DeleteStakeRemoval(ctx, blockHeight, topicId, reputer)
-	if err != nil {
-		return errorsmod.Wrapf(err, "Deleting stake removal from queue failed")
-	}
-
 	return nil
 }
 
@@ -1886,7 +2097,28 @@ func (k *Keeper) RemoveDelegateStake(
 	if stakeToRemove.IsZero() {
 		return nil
 	}
+	if err := k.removeDelegateStake(ctx, topicId, delegator, reputer, stakeToRemove); err != nil {
+		return err
+	}
+	if err := k.DeleteDelegateStakeRemoval(ctx, stakeRemovalBlockHeight, topicId, reputer, delegator); err != nil {
+		return errorsmod.Wrapf(err, "Deleting delegate stake removal from queue failed")
+	}
+
+	return nil
+}
 
+// Removes delegate stake from the system for a given topic, delegator, and reputer
+// without touching the stake removal queue. Pending rewards of the delegator are paid out.
+// subtracts from: totalStake, topicStake, stakeReputerAuthority
+//
+//	stakeSumFromDelegator, delegatedStakes, stakeFromDelegatorsUponReputer
+func (k *Keeper) removeDelegateStake(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	stakeToRemove cosmosMath.Int,
+) error {
 	// stakeSumFromDelegator >= stake
 	stakeSumFromDelegator, err := k.GetStakeFromDelegatorInTopic(ctx, topicId, delegator)
 	if err != nil {
@@ -1930,11 +2162,13 @@ func (k *Keeper) RemoveDelegateStake(
 		if err != nil {
 			return errorsmod.Wrap(err, "error trimming pending reward")
 		}
-		err = k.SendCoinsFromModuleToAccount(
+		err = k.sendCoinsToDelegator(
 			ctx,
 			types.AlloraPendingRewardForDelegatorAccountName,
+			topicId,
 			delegator,
-			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingRewardInt)),
+			reputer,
+			pendingRewardInt,
 		)
 		if err != nil {
 			return errorsmod.Wrapf(err, "Sending pending reward to delegator failed")
@@ -2013,9 +2247,6 @@ func (k *Keeper) RemoveDelegateStake(
 	if err := k.SetTotalStake(ctx, totalStakeNew); err != nil {
 		return errorsmod.Wrapf(err, "Setting total stake failed")
 	}
-	if err := k.DeleteDelegateStakeRemoval(ctx, stakeRemovalBlockHeight, topicId, reputer, delegator); err != nil {
-		return errorsmod.Wrapf(err, "Deleting delegate stake removal from queue failed")
-	}
 
 	return nil
 }
@@ -2160,11 +2391,10 @@ func (k *Keeper) SetDelegateStakePlacement(ctx context.Context, topicId TopicId,
 	if err := stake.Validate(); err != nil {
 		return errorsmod.Wrap(err, "stake information is not valid")
 	}
-	key := collections.Join3(topicId, delegator, target)
 	if stake.Amount.IsZero() {
-		return k.delegatedStakes.Remove(ctx, key)
+		return k.removeDelegation(ctx, topicId, delegator, target)
 	}
-	return k.delegatedStakes.Set(ctx, key, stake)
+	return k.setDelegation(ctx, topicId, delegator, target, stake)
 }
 
 // Returns the share of reward by a specific topic and reputer
@@ -2369,7 +2599,12 @@ func (k *Keeper) SetDelegateStakeRemoval(ctx context.Context, removalInfo types.
 		return errorsmod.Wrap(err, "error setting delegate stake removal by block")
 	}
 	byActorKey := Join4(removalInfo.Delegator, removalInfo.Reputer, removalInfo.TopicId, removalInfo.BlockRemovalCompleted)
-	return k.delegateStakeRemovalsByActor.Set(ctx, byActorKey)
+	err = k.delegateStakeRemovalsByActor.Set(ctx, byActorKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error setting delegate stake removal by actor")
+	}
+	byReputerKey := Join4(removalInfo.TopicId, removalInfo.Reputer, removalInfo.Delegator, removalInfo.BlockRemovalCompleted)
+	return k.delegateStakeRemovalsByReputer.Set(ctx, byReputerKey)
 }
 
 // remove a stake removal from the queue
@@ -2393,7 +2628,12 @@ func (k *Keeper) DeleteDelegateStakeRemoval(
 		return errorsmod.Wrap(err, "error removing delegate stake removal by block")
 	}
 	byActorKey := Join4(delegator, reputer, topicId, blockHeight)
-	return k.delegateStakeRemovalsByActor.Remove(ctx, byActorKey)
+	err = k.delegateStakeRemovalsByActor.Remove(ctx, byActorKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "error removing delegate stake removal by actor")
+	}
+	byReputerKey := Join4(topicId, reputer, delegator, blockHeight)
+	return k.delegateStakeRemovalsByReputer.Remove(ctx, byReputerKey)
 }
 
 // get info about a removal
@@ -2486,6 +2726,36 @@ func (k *Keeper) GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
 	return ret, true, nil
 }
 
+// Returns true if a removal of stake delegated upon the reputer in the topic is pending
+func (k *Keeper) HasDelegateStakeRemovalUponReputer(ctx context.Context, topicId TopicId, reputer ActorId) (bool, error) {
+	rng := NewDoublePrefixedQuadrupleRange[TopicId, ActorId, ActorId, BlockHeight](topicId, reputer)
+	iter, err := k.delegateStakeRemovalsByReputer.Iterate(ctx, rng)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "error iterating over delegate stake removals by reputer")
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+// Adds the delegate stake removals to the reputer -> removal index, e.g. removals queued before the index existed
+func (k *Keeper) IndexDelegateStakeRemovals(ctx context.Context) error {
+	iter, err := k.delegateStakeRemovalsByBlock.Iterate(ctx, nil)
+	if err != nil {
+		return errorsmod.Wrap(err, "error iterating over delegate stake removals by block")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting delegate stake removals by block")
+	}
+	for _, key := range keys {
+		byReputerKey := Join4(key.K2(), key.K4(), key.K3(), key.K1())
+		if err := k.delegateStakeRemovalsByReputer.Set(ctx, byReputerKey); err != nil {
+			return errorsmod.Wrap(err, "error indexing delegate stake removal")
+		}
+	}
+	return nil
+}
+
 /// REPUTERS
 
 // Adds a new reputer to the reputer tracking data structures, reputers and topicReputers
@@ -2499,8 +2769,7 @@ func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer Act
 	if err := reputerInfo.Validate(); err != nil {
 		return errorsmod.Wrap(err, "reputer info validation failed")
 	}
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Set(ctx, topicKey)
+	err := k.setTopicActor(ctx, topicId, reputer, true)
 	if err != nil {
 		return errorsmod.Wrap(err, "error setting topic reputer")
 	}
@@ -2513,8 +2782,7 @@ func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer Act
 
 // Remove a reputer to the reputer tracking data structures and topicReputers
 func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Remove(ctx, topicKey)
+	err := k.removeTopicActor(ctx, topicId, reputer, true)
 	if err != nil {
 		return errorsmod.Wrap(err, "error removing topic reputer")
 	}
@@ -2538,8 +2806,7 @@ func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker Actor
 	if err := workerInfo.Validate(); err != nil {
 		return errorsmod.Wrap(err, "worker info validation failed")
 	}
-	topicKey := collections.Join(topicId, worker)
-	err := k.topicWorkers.Set(ctx, topicKey)
+	err := k.setTopicActor(ctx, topicId, worker, false)
 	if err != nil {
 		return errorsmod.Wrap(err, "error setting topic worker")
 	}
@@ -2552,8 +2819,7 @@ func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker Actor
 
 // Remove a worker to the worker tracking data structures and topicWorkers
 func (k *Keeper) RemoveWorker(ctx context.Context, topicId TopicId, worker ActorId) error {
-	topicKey := collections.Join(topicId, worker)
-	err := k.topicWorkers.Remove(ctx, topicKey)
+	err := k.removeTopicActor(ctx, topicId, worker, false)
 	if err != nil {
 		return errorsmod.Wrap(err, "error removing topic worker")
 	}
@@ -2668,6 +2934,9 @@ func (k *Keeper) SetTopic(ctx context.Context, topicId TopicId, topic types.Topi
 	if err := topic.Validate(params); err != nil {
 		return errorsmod.Wrap(err, "set topic validation failure")
 	}
+	if err := k.updateTopicIndexes(ctx, topicId, topic); err != nil {
+		return errorsmod.Wrap(err, "error updating topic indexes")
+	}
 	return k.topics.Set(ctx, topicId, topic)
 }
 
@@ -3473,6 +3742,159 @@ func (k *Keeper) RemoveWhitelistAdmin(ctx context.Context, admin ActorId) error
 	return k.whitelistAdmins.Remove(ctx, admin)
 }
 
+func (k Keeper) IsTopicWorkerWhitelistEnabled(ctx context.Context, topicId TopicId) (bool, error) {
+	return k.topicWorkerWhitelistEnabled.Has(ctx, topicId)
+}
+
+func (k *Keeper) EnableTopicWorkerWhitelist(ctx context.Context, topicId TopicId) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errorsmod.Wrap(err, "topic id validation failed")
+	}
+	return k.topicWorkerWhitelistEnabled.Set(ctx, topicId)
+}
+
+func (k *Keeper) DisableTopicWorkerWhitelist(ctx context.Context, topicId TopicId) error {
+	return k.topicWorkerWhitelistEnabled.Remove(ctx, topicId)
+}
+
+func (k Keeper) IsTopicReputerWhitelistEnabled(ctx context.Context, topicId TopicId) (bool, error) {
+	return k.topicReputerWhitelistEnabled.Has(ctx, topicId)
+}
+
+func (k *Keeper) EnableTopicReputerWhitelist(ctx context.Context, topicId TopicId) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errorsmod.Wrap(err, "topic id validation failed")
+	}
+	return k.topicReputerWhitelistEnabled.Set(ctx, topicId)
+}
+
+func (k *Keeper) DisableTopicReputerWhitelist(ctx context.Context, topicId TopicId) error {
+	return k.topicReputerWhitelistEnabled.Remove(ctx, topicId)
+}
+
+func (k Keeper) IsWhitelistedTopicWorker(ctx context.Context, topicId TopicId, worker ActorId) (bool, error) {
+	return k.topicWorkerWhitelist.Has(ctx, collections.Join(topicId, worker))
+}
+
+func (k *Keeper) AddToTopicWorkerWhitelist(ctx context.Context, topicId TopicId, worker ActorId) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errorsmod.Wrap(err, "topic id validation failed")
+	}
+	if err := types.ValidateBech32(worker); err != nil {
+		return errorsmod.Wrap(err, "error validating worker id")
+	}
+	return k.topicWorkerWhitelist.Set(ctx, collections.Join(topicId, worker))
+}
+
+func (k *Keeper) RemoveFromTopicWorkerWhitelist(ctx context.Context, topicId TopicId, worker ActorId) error {
+	return k.topicWorkerWhitelist.Remove(ctx, collections.Join(topicId, worker))
+}
+
+func (k Keeper) IsWhitelistedTopicReputer(ctx context.Context, topicId TopicId, reputer ActorId) (bool, error) {
+	return k.topicReputerWhitelist.Has(ctx, collections.Join(topicId, reputer))
+}
+
+func (k *Keeper) AddToTopicReputerWhitelist(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errorsmod.Wrap(err, "topic id validation failed")
+	}
+	if err := types.ValidateBech32(reputer); err != nil {
+		return errorsmod.Wrap(err, "error validating reputer id")
+	}
+	return k.topicReputerWhitelist.Set(ctx, collections.Join(topicId, reputer))
+}
+
+func (k *Keeper) RemoveFromTopicReputerWhitelist(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	return k.topicReputerWhitelist.Remove(ctx, collections.Join(topicId, reputer))
+}
+
+// Boolean is true if the worker may participate in the topic,
+// i.e. the topic does not enforce its worker whitelist or the worker is whitelisted in it
+func (k Keeper) CanActAsTopicWorker(ctx context.Context, topicId TopicId, worker ActorId) (bool, error) {
+	enabled, err := k.IsTopicWorkerWhitelistEnabled(ctx, topicId)
+	if err != nil {
+		return false, err
+	} else if !enabled {
+		return true, nil
+	}
+	return k.IsWhitelistedTopicWorker(ctx, topicId, worker)
+}
+
+// Boolean is true if the reputer may participate in the topic,
+// i.e. the topic does not enforce its reputer whitelist or the reputer is whitelisted in it
+func (k Keeper) CanActAsTopicReputer(ctx context.Context, topicId TopicId, reputer ActorId) (bool, error) {
+	enabled, err := k.IsTopicReputerWhitelistEnabled(ctx, topicId)
+	if err != nil {
+		return false, err
+	} else if !enabled {
+		return true, nil
+	}
+	return k.IsWhitelistedTopicReputer(ctx, topicId, reputer)
+}
+
+// Returns a page of the workers whitelisted in a topic, in ascending order of address
+func (k Keeper) GetTopicWorkerWhitelist(
+	ctx context.Context,
+	topicId TopicId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	return k.getTopicWhitelistPage(ctx, k.topicWorkerWhitelist, topicId, pagination)
+}
+
+// Returns a page of the reputers whitelisted in a topic, in ascending order of address
+func (k Keeper) GetTopicReputerWhitelist(
+	ctx context.Context,
+	topicId TopicId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	return k.getTopicWhitelistPage(ctx, k.topicReputerWhitelist, topicId, pagination)
+}
+
+// The cursor of the pagination is the address to start the page from
+func (k Keeper) getTopicWhitelistPage(
+	ctx context.Context,
+	whitelist collections.KeySet[collections.Pair[TopicId, ActorId]],
+	topicId TopicId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error getting params")
+	}
+	limit := moduleParams.DefaultPageLimit
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	if pagination != nil {
+		if len(pagination.Key) > 0 {
+			rng = rng.StartInclusive(string(pagination.Key))
+		}
+		if pagination.Limit > 0 {
+			limit = pagination.Limit
+		}
+		if limit > moduleParams.MaxPageLimit {
+			limit = moduleParams.MaxPageLimit
+		}
+	}
+
+	iter, err := whitelist.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error iterating over topic whitelist")
+	}
+	defer iter.Close()
+
+	actors := make([]ActorId, 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(err, "error getting topic whitelist key")
+		}
+		if uint64(len(actors)) >= limit {
+			return actors, &types.SimpleCursorPaginationResponse{NextKey: []byte(key.K2())}, nil
+		}
+		actors = append(actors, key.K2())
+	}
+	return actors, &types.SimpleCursorPaginationResponse{NextKey: nil}, nil
+}
+
 /// BANK KEEPER WRAPPERS
 
 // wrapper around bank keeper SendCoinsFromModuleToAccount
@@ -3571,6 +3993,10 @@ func (k *Keeper) PruneRecordsAfterRewards(ctx context.Context, topicId TopicId,
 	if err != nil {
 		return errorsmod.Wrap(err, "error pruning network losses")
 	}
+	err = k.pruneChainLossNetworkInferences(ctx, topicId, blockHeight)
+	if err != nil {
+		return errorsmod.Wrap(err, "error pruning chain loss network inferences")
+	}
 
 	return nil
 }
@@ -3661,12 +4087,26 @@ func (k *Keeper) BlockWithinWorkerSubmissionWindowOfNonce(topic types.Topic, non
 	return nonce.BlockHeight <= blockHeight && blockHeight < topic.WorkerSubmissionWindow+nonce.BlockHeight
 }
 
+// Return true if the block is within the reveal window of the nonce, which directly follows
+// the worker submission window in commit-reveal topics
+func (k *Keeper) BlockWithinWorkerRevealWindowOfNonce(topic types.Topic, nonce types.Nonce, blockHeight int64) bool {
+	revealStart := nonce.BlockHeight + topic.WorkerSubmissionWindow
+	return revealStart <= blockHeight && blockHeight < revealStart+topic.WorkerRevealWindow
+}
+
 // Return true if the nonce is within the worker submission window for the topic
 func (k *Keeper) BlockWithinReputerSubmissionWindowOfNonce(topic types.Topic, nonce types.ReputerRequestNonce, blockHeight int64) bool {
 	return nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag <= blockHeight &&
 		blockHeight <= nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag*2
 }
 
+// Return true if the block is within the reveal window of the nonce, which directly follows
+// the reputer submission window in commit-reveal topics
+func (k *Keeper) BlockWithinReputerRevealWindowOfNonce(topic types.Topic, nonce types.ReputerRequestNonce, blockHeight int64) bool {
+	revealStart := nonce.ReputerNonce.BlockHeight + topic.GroundTruthLag*2 + 1
+	return revealStart <= blockHeight && blockHeight < revealStart+topic.ReputerRevealWindow
+}
+
 func (k *Keeper) ValidateStringIsBech32(actor ActorId) error {
 	_, err := sdk.AccAddressFromBech32(actor)
 	if err != nil {
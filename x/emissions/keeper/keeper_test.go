@@ -79,6 +79,9 @@ func (s *KeeperTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRequestsAccountName:                  {"minter"},
+		types.AlloraWorkerBondsAccountName:               {"minter"},
+		types.AlloraDelegateStakeReceiptsAccountName:     {"minter", "burner"},
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -150,7 +153,7 @@ func (s *KeeperTestSuite) mockTopic() types.Topic {
 		Id:                       123,
 		Creator:                  s.addrsStr[9],
 		Metadata:                 "metadata",
-		LossMethod:               "method",
+		LossMethod:               "mse",
 		EpochLastEnded:           123,
 		EpochLength:              135,
 		GroundTruthLag:           135,
@@ -164,6 +167,16 @@ func (s *KeeperTestSuite) mockTopic() types.Topic {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -190,7 +203,7 @@ func (s *KeeperTestSuite) CreateOneTopic(epochLen int64) uint64 {
 	newTopicMsg := &types.CreateNewTopicRequest{
 		Creator:                  s.addrsStr[0],
 		Metadata:                 metadata,
-		LossMethod:               "method",
+		LossMethod:               "mse",
 		EpochLength:              epochLen,
 		GroundTruthLag:           epochLen,
 		WorkerSubmissionWindow:   epochLen,
@@ -202,6 +215,16 @@ func (s *KeeperTestSuite) CreateOneTopic(epochLen int64) uint64 {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(s.addrs[0], types.DefaultParams().CreateTopicFee)
@@ -1130,6 +1153,7 @@ func (s *KeeperTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data1"),
 		Proof:       "proof1",
+		Values:      nil,
 	}
 	inferences1 := types.Inferences{
 		Inferences: []*types.Inference{&newInference1},
@@ -1147,6 +1171,7 @@ func (s *KeeperTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("20"),
 		ExtraData:   []byte("data2"),
 		Proof:       "proof2",
+		Values:      nil,
 	}
 	inferences2 := types.Inferences{
 		Inferences: []*types.Inference{&newInference2},
@@ -1180,6 +1205,7 @@ func (s *KeeperTestSuite) TestGetWorkerLatestInferenceByTopicId() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data"),
 		Proof:       "proof123",
+		Values:      nil,
 	}
 	err = keeper.InsertInference(ctx, topicId, newInference1)
 	s.Require().NoError(err, "Inserting inferences should not fail")
@@ -1192,6 +1218,7 @@ func (s *KeeperTestSuite) TestGetWorkerLatestInferenceByTopicId() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data"),
 		Proof:       "proof123",
+		Values:      nil,
 	}
 	err = keeper.InsertInference(ctx, topicId, newInference2)
 	s.Require().NoError(err, "Inserting inferences should not fail")
@@ -1273,6 +1300,8 @@ func (s *KeeperTestSuite) TestInsertActiveReputerLosses() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature := s.signValueBundle(valueBundle, s.privKeys[0])
 	reputerLossBundles := types.ReputerValueBundles{
@@ -1328,6 +1357,8 @@ func (s *KeeperTestSuite) TestInsertNetworkLossBundleAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	err := s.emissionsKeeper.InsertNetworkLossBundleAtBlock(ctx, topicId, block, lossBundle)
@@ -1381,6 +1412,8 @@ func (s *KeeperTestSuite) TestGetLatestNetworkLossBundle() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	err = keeper.InsertNetworkLossBundleAtBlock(ctx, topicId, blockHeight1, lossBundle1)
 	s.Require().NoError(err, "Inserting first network loss bundle should not fail")
@@ -1402,6 +1435,8 @@ func (s *KeeperTestSuite) TestGetLatestNetworkLossBundle() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	err = keeper.InsertNetworkLossBundleAtBlock(ctx, topicId, blockHeight2, lossBundle2)
 	s.Require().NoError(err, "Inserting second network loss bundle should not fail")
@@ -2070,6 +2105,346 @@ func (s *KeeperTestSuite) TestInsertWorker() {
 	s.Require().Equal(workerInfo.NodeAddress, node.NodeAddress)
 }
 
+func (s *KeeperTestSuite) TestGetActorTopicIds() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	actor := "allo15lvs3m3urm4kts4tp2um5u3aeuz3whqrhz47r5"
+	newActor := "allo195jgulwj7vd292m0fth5gwqu4r2447dnarunmx"
+	nodeInfo := types.OffchainNode{Owner: actor, NodeAddress: actor}
+
+	for _, topicId := range []uint64{3, 1, 2} {
+		err := keeper.InsertWorker(ctx, topicId, actor, nodeInfo)
+		s.Require().NoError(err)
+	}
+	err := keeper.InsertReputer(ctx, 2, actor, nodeInfo)
+	s.Require().NoError(err)
+
+	topicIds, err := keeper.GetActorTopicIds(ctx, actor, false)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2, 3}, topicIds)
+	topicIds, err = keeper.GetActorTopicIds(ctx, actor, true)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2}, topicIds)
+
+	// The reverse index follows the actor out of and back into a topic
+	err = keeper.RemoveWorker(ctx, 1, actor)
+	s.Require().NoError(err)
+	err = keeper.JailActor(ctx, 2, actor, false)
+	s.Require().NoError(err)
+	topicIds, err = keeper.GetActorTopicIds(ctx, actor, false)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{3}, topicIds)
+	err = keeper.UnjailActor(ctx, 2, actor, false)
+	s.Require().NoError(err)
+	topicIds, err = keeper.GetActorTopicIds(ctx, actor, false)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2, 3}, topicIds)
+
+	// and to its new address when the node is rotated
+	err = keeper.MoveReputer(ctx, 2, actor, newActor, types.OffchainNode{Owner: actor, NodeAddress: newActor})
+	s.Require().NoError(err)
+	topicIds, err = keeper.GetActorTopicIds(ctx, actor, true)
+	s.Require().NoError(err)
+	s.Require().Empty(topicIds)
+	topicIds, err = keeper.GetActorTopicIds(ctx, newActor, true)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2}, topicIds)
+}
+
+func (s *KeeperTestSuite) TestMoveReputerMovesRedelegations() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	newReputer := s.addrsStr[1]
+	delegator := s.addrsStr[2]
+
+	err := keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	s.Require().NoError(err)
+	redelegation := types.Redelegation{
+		TopicId:          topicId,
+		Delegator:        delegator,
+		SrcReputer:       s.addrsStr[3],
+		DstReputer:       reputer,
+		Amount:           cosmosMath.NewInt(100),
+		BlockRedelegated: 10,
+		BlockMatured:     20,
+	}
+	err = keeper.SetRedelegation(ctx, redelegation)
+	s.Require().NoError(err)
+
+	err = keeper.MoveReputer(ctx, topicId, reputer, newReputer, types.OffchainNode{Owner: reputer, NodeAddress: newReputer})
+	s.Require().NoError(err)
+	_, found, err := keeper.GetRedelegation(ctx, topicId, delegator, s.addrsStr[3], reputer)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	moved, found, err := keeper.GetRedelegation(ctx, topicId, delegator, s.addrsStr[3], newReputer)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	redelegation.DstReputer = newReputer
+	s.Require().Equal(redelegation, moved)
+
+	// The moved redelegation is still pruned once it matures
+	err = keeper.DeleteMaturedRedelegations(ctx, 19, 10)
+	s.Require().NoError(err)
+	_, found, err = keeper.GetRedelegation(ctx, topicId, delegator, s.addrsStr[3], newReputer)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	err = keeper.DeleteMaturedRedelegations(ctx, 20, 10)
+	s.Require().NoError(err)
+	_, found, err = keeper.GetRedelegation(ctx, topicId, delegator, s.addrsStr[3], newReputer)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestMoveReputerMovesRedelegationsFromIt() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	newReputer := s.addrsStr[1]
+	delegator := s.addrsStr[2]
+	dstReputer := s.addrsStr[3]
+
+	err := keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	s.Require().NoError(err)
+	redelegation := types.Redelegation{
+		TopicId:          topicId,
+		Delegator:        delegator,
+		SrcReputer:       reputer,
+		DstReputer:       dstReputer,
+		Amount:           cosmosMath.NewInt(100),
+		BlockRedelegated: 10,
+		BlockMatured:     20,
+	}
+	err = keeper.SetRedelegation(ctx, redelegation)
+	s.Require().NoError(err)
+
+	err = keeper.MoveReputer(ctx, topicId, reputer, newReputer, types.OffchainNode{Owner: reputer, NodeAddress: newReputer})
+	s.Require().NoError(err)
+	_, found, err := keeper.GetRedelegation(ctx, topicId, delegator, reputer, dstReputer)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	moved, found, err := keeper.GetRedelegation(ctx, topicId, delegator, newReputer, dstReputer)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	redelegation.SrcReputer = newReputer
+	s.Require().Equal(redelegation, moved)
+	unmatured, err := keeper.GetUnmaturedRedelegationsToReputer(ctx, topicId, delegator, dstReputer)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Redelegation{redelegation}, unmatured)
+}
+
+func (s *KeeperTestSuite) TestMoveReputerBlockedByDelegateStakeRemovalUponIt() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	newReputer := s.addrsStr[1]
+	delegator := s.addrsStr[2]
+	otherReputer := s.addrsStr[3]
+
+	err := keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	s.Require().NoError(err)
+	removal := types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   10,
+		TopicId:               topicId,
+		Delegator:             delegator,
+		Reputer:               otherReputer,
+		Amount:                cosmosMath.NewInt(100),
+		BlockRemovalCompleted: 20,
+	}
+	err = keeper.SetDelegateStakeRemoval(ctx, removal)
+	s.Require().NoError(err)
+
+	// Removals of stake delegated upon other reputers do not block the move
+	err = keeper.MoveReputer(ctx, topicId, reputer, newReputer, types.OffchainNode{Owner: reputer, NodeAddress: newReputer})
+	s.Require().NoError(err)
+
+	removal.Reputer = newReputer
+	err = keeper.SetDelegateStakeRemoval(ctx, removal)
+	s.Require().NoError(err)
+	err = keeper.MoveReputer(ctx, topicId, newReputer, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	s.Require().ErrorIs(err, types.ErrNodeRotationBlocked)
+
+	err = keeper.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, newReputer, delegator)
+	s.Require().NoError(err)
+	err = keeper.MoveReputer(ctx, topicId, newReputer, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMoveReputerMovesDelegateStakeReceiptPool() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	newReputer := s.addrsStr[1]
+
+	err := keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	s.Require().NoError(err)
+	pool := types.DelegateStakeReceiptPool{
+		Id:      1,
+		TopicId: topicId,
+		Reputer: reputer,
+		Shares:  cosmosMath.NewInt(100),
+		Reserve: cosmosMath.NewInt(10),
+	}
+	err = keeper.SetDelegateStakeReceiptPool(ctx, pool)
+	s.Require().NoError(err)
+
+	err = keeper.MoveReputer(ctx, topicId, reputer, newReputer, types.OffchainNode{Owner: reputer, NodeAddress: newReputer})
+	s.Require().NoError(err)
+	_, found, err := keeper.GetDelegateStakeReceiptPoolOf(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	moved, found, err := keeper.GetDelegateStakeReceiptPoolOf(ctx, topicId, newReputer)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	pool.Reputer = newReputer
+	s.Require().Equal(pool, moved)
+}
+
+func (s *KeeperTestSuite) TestGetReputerEffectiveStake() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+
+	err := keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = keeper.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(400))
+	s.Require().NoError(err)
+
+	// Without limits all the delegated stake is effective
+	effectiveStake, err := keeper.GetReputerEffectiveStake(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(500), effectiveStake)
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.MaxDelegateToSelfStakeRatio = alloraMath.MustNewDecFromString("2.5")
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+	selfStake, delegateStake, err := keeper.GetReputerSelfAndDelegateStake(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(100), selfStake)
+	s.Require().Equal(cosmosMath.NewInt(400), delegateStake)
+	effectiveStake, err = keeper.GetReputerEffectiveStake(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(350), effectiveStake)
+
+	// The tighter of both limits applies
+	params.MaxDelegateStakePerReputer = cosmosMath.NewInt(200)
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+	effectiveStake, err = keeper.GetReputerEffectiveStake(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(300), effectiveStake)
+
+	// The effective stake drops along with the reputer's own stake
+	err = keeper.SetStakeRemoval(ctx, types.StakeRemovalInfo{
+		TopicId:               topicId,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(60),
+		BlockRemovalStarted:   ctx.BlockHeight(),
+		BlockRemovalCompleted: ctx.BlockHeight(),
+	})
+	s.Require().NoError(err)
+	err = keeper.RemoveReputerStake(ctx, ctx.BlockHeight(), topicId, reputer, cosmosMath.NewInt(60))
+	s.Require().NoError(err)
+	effectiveStake, err = keeper.GetReputerEffectiveStake(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(140), effectiveStake)
+}
+
+func (s *KeeperTestSuite) TestCompoundDelegateRewardRespectsDelegateStakeLimits() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+
+	err := keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = keeper.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(200))
+	s.Require().NoError(err)
+	moduleParams, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	moduleParams.MaxDelegateToSelfStakeRatio = alloraMath.MustNewDecFromString("2.5")
+	err = keeper.SetParams(ctx, moduleParams)
+	s.Require().NoError(err)
+	headroom, limited, err := keeper.GetDelegateStakeHeadroom(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().True(limited)
+	s.Require().Equal(cosmosMath.NewInt(50), headroom)
+
+	// A pending reward of 100, of which only 50 fit under the max ratio to the reputer's own stake
+	err = keeper.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.5"))
+	s.Require().NoError(err)
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(100)))
+	err = s.bankKeeper.MintCoins(ctx, types.AlloraPendingRewardForDelegatorAccountName, coins)
+	s.Require().NoError(err)
+	balanceBefore := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+
+	restaked, err := keeper.CompoundDelegateReward(ctx, topicId, delegator, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(50), restaked)
+	_, delegateStake, err := keeper.GetReputerSelfAndDelegateStake(ctx, topicId, reputer)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(250), delegateStake)
+	balanceAfter := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	s.Require().Equal(balanceBefore.Amount.Add(cosmosMath.NewInt(50)), balanceAfter.Amount)
+
+	// Without headroom left the whole reward is paid out
+	err = keeper.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.7"))
+	s.Require().NoError(err)
+	err = s.bankKeeper.MintCoins(ctx, types.AlloraPendingRewardForDelegatorAccountName, coins)
+	s.Require().NoError(err)
+	restaked, err = keeper.CompoundDelegateReward(ctx, topicId, delegator, reputer)
+	s.Require().NoError(err)
+	s.Require().True(restaked.IsZero())
+	balanceFinal := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	s.Require().Equal(balanceAfter.Amount.Add(cosmosMath.NewInt(50)), balanceFinal.Amount)
+}
+
+func (s *KeeperTestSuite) TestGetNextAutoCompoundDelegationsInTopic() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	delegators := []string{s.addrsStr[1], s.addrsStr[2], s.addrsStr[3]}
+
+	for _, delegator := range delegators {
+		err := keeper.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(100))
+		s.Require().NoError(err)
+		err = keeper.SetDelegateRewardAutoCompound(ctx, delegator, true)
+		s.Require().NoError(err)
+	}
+	all, err := keeper.GetAutoCompoundDelegationsInTopic(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Len(all, 3)
+
+	// Each call resumes where the previous one stopped, then starts over once all were returned
+	page, err := keeper.GetNextAutoCompoundDelegationsInTopic(ctx, topicId, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(all[:2], page)
+	page, err = keeper.GetNextAutoCompoundDelegationsInTopic(ctx, topicId, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(all[2:], page)
+	page, err = keeper.GetNextAutoCompoundDelegationsInTopic(ctx, topicId, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(all[:2], page)
+
+	// A delegation removed under the cursor does not stop the next call from resuming after it
+	err = keeper.SetDelegateRewardAutoCompound(ctx, all[1].K2(), false)
+	s.Require().NoError(err)
+	page, err = keeper.GetNextAutoCompoundDelegationsInTopic(ctx, topicId, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(all[2:], page)
+}
+
 func (s *KeeperTestSuite) TestRemoveWorker() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -2436,6 +2811,72 @@ func (s *KeeperTestSuite) TestUpdateAndGetTopicEpochLastEnded() {
 	s.Require().Equal(epochLastEnded, topic.EpochLastEnded, "The retrieved epoch last ended should match the updated value")
 }
 
+func (s *KeeperTestSuite) TestApplyPendingTopicUpdate() {
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+
+	initialTopic := s.mockTopic()
+	initialTopic.Id = topicId
+	err := keeper.SetTopic(ctx, topicId, initialTopic)
+	s.Require().NoError(err)
+
+	// No pending update is a no-op
+	err = keeper.ApplyPendingTopicUpdate(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Empty(ctx.EventManager().Events())
+
+	pending := initialTopic
+	pending.EpochLength = 200
+	pending.GroundTruthLag = 400
+	pending.WorkerSubmissionWindow = 50
+	pending.ActiveInfererQuantile = alloraMath.MustNewDecFromString("0.5")
+	err = keeper.SetPendingTopicUpdate(ctx, topicId, pending)
+	s.Require().NoError(err)
+
+	// The stored topic is untouched until the update is applied
+	topic, err := keeper.GetTopic(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(initialTopic, topic)
+
+	// Chain-managed fields written in the meantime must survive the update
+	err = keeper.UpdateTopicEpochLastEnded(ctx, topicId, 1000)
+	s.Require().NoError(err)
+
+	err = keeper.ApplyPendingTopicUpdate(ctx, topicId)
+	s.Require().NoError(err)
+
+	topic, err = keeper.GetTopic(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(200), topic.EpochLength)
+	s.Require().Equal(int64(400), topic.GroundTruthLag)
+	s.Require().Equal(int64(50), topic.WorkerSubmissionWindow)
+	s.Require().Equal(alloraMath.MustNewDecFromString("0.5"), topic.ActiveInfererQuantile)
+	s.Require().Equal(int64(1000), topic.EpochLastEnded)
+
+	_, found, err := keeper.GetPendingTopicUpdate(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().False(found, "Pending update should be consumed once applied")
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal("emissions.v4.EventTopicUpdated", events[0].Type)
+}
+
+func (s *KeeperTestSuite) TestSetPendingTopicUpdateInvalidTopicFails() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+
+	pending := s.mockTopic()
+	pending.WorkerSubmissionWindow = pending.EpochLength + 1
+	err := keeper.SetPendingTopicUpdate(ctx, pending.Id, pending)
+	s.Require().Error(err)
+
+	_, found, err := keeper.GetPendingTopicUpdate(ctx, pending.Id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
 func (s *KeeperTestSuite) TestTopicExists() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -2531,6 +2972,16 @@ func (s *KeeperTestSuite) TestAddTopicFeeRevenue() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.5"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.5"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.5"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	err := keeper.SetTopic(ctx, topicId, newTopic)
 	s.Require().NoError(err, "Setting a new topic should not fail")
@@ -3238,6 +3689,8 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	err = s.emissionsKeeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, block, networkLosses)
 	s.Require().NoError(err, "InsertNetworkLossBundleAtBlock should not return an error")
@@ -3280,6 +3733,35 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 	s.Require().Equal("0", networkBundles.NaiveValue.String(), "Must be pruned as evidenced by empty naive value")
 }
 
+func (s *KeeperTestSuite) TestPruneChainLossNetworkInferences() {
+	topicId := uint64(1)
+	components := []*types.ValueBundle{
+		{TopicId: topicId, CombinedValue: alloraMath.NewDecFromInt64(1), NaiveValue: alloraMath.NewDecFromInt64(2)},
+		{TopicId: topicId, CombinedValue: alloraMath.NewDecFromInt64(3), NaiveValue: alloraMath.NewDecFromInt64(4)},
+	}
+	for _, block := range []types.BlockHeight{100, 200, 300} {
+		err := s.emissionsKeeper.SetChainLossNetworkInferences(s.ctx, topicId, block, components)
+		s.Require().NoError(err)
+	}
+
+	stored, found, err := s.emissionsKeeper.GetChainLossNetworkInferences(s.ctx, topicId, 100)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Len(stored, 2)
+	s.Require().True(stored[1].CombinedValue.Equal(alloraMath.NewDecFromInt64(3)))
+
+	// Closing a nonce drops its network inferences, pruning drops those of the nonces up to the block
+	err = s.emissionsKeeper.DeleteChainLossNetworkInferences(s.ctx, topicId, 300)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.PruneRecordsAfterRewards(s.ctx, topicId, 100)
+	s.Require().NoError(err)
+	for block, expectedFound := range map[types.BlockHeight]bool{100: false, 200: true, 300: false} {
+		_, found, err := s.emissionsKeeper.GetChainLossNetworkInferences(s.ctx, topicId, block)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedFound, found, "block %d", block)
+	}
+}
+
 func (s *KeeperTestSuite) TestPruneWorkerNoncesLogicNoNonces() {
 	keeper := s.emissionsKeeper
 	topicId1 := uint64(1)
@@ -3707,6 +4189,7 @@ func (s *KeeperTestSuite) TestAppendInference() {
 		Value:       alloraMath.MustNewDecFromString("0.52"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 	err = k.AppendInference(ctx, topic, nonce.BlockHeight, &newInference, params.MaxTopInferersToReward)
 	s.Require().NoError(err)
@@ -3722,6 +4205,7 @@ func (s *KeeperTestSuite) TestAppendInference() {
 		Value:       alloraMath.MustNewDecFromString("0.52"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 
 	err = k.AppendInference(ctx, topic, nonce.BlockHeight, &newInference2, params.MaxTopInferersToReward)
@@ -3774,6 +4258,7 @@ func (s *KeeperTestSuite) TestAppendInference() {
 		Value:       alloraMath.MustNewDecFromString("0.52"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 	err = k.AppendInference(ctx, topic, nonce.BlockHeight, &newInference2, params.MaxTopInferersToReward)
 	s.Require().Error(err, types.ErrCantUpdateEmaMoreThanOncePerWindow.Error())
@@ -3831,6 +4316,18 @@ func mockUninitializedParams() types.Params {
 		MaxStringLength:                     uint64(0),
 		InitialRegretQuantile:               alloraMath.ZeroDec(),
 		PNormSafeDiv:                        alloraMath.ZeroDec(),
+		MaxPruneArchivedTopicKeysEndBlock:   uint64(1),
+		MaxFundingSubscriptionDripsEndBlock: uint64(1),
+		MaxTopicCreatorCommission:           alloraMath.ZeroDec(),
+		NodeRotationCooldown:                int64(0),
+		ReputerOutlierSlashEpochs:           uint64(1),
+		ReputerOutlierSlashFraction:         alloraMath.ZeroDec(),
+		MaxMissedEpochsJail:                 uint64(0),
+		MaxMissedEpochsDeregister:           uint64(0),
+		ReputerCommissionUpdateCooldown:     int64(0),
+		MaxDelegateToSelfStakeRatio:         alloraMath.ZeroDec(),
+		MaxDelegateStakePerReputer:          cosmosMath.ZeroInt(),
+		MaxAutoCompoundDelegationsPerPayout: uint64(1),
 	}
 }
 
@@ -4020,6 +4517,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature := s.signValueBundle(&valueBundleReputer1, s.privKeys[0])
 	reputerValueBundle1 := types.ReputerValueBundle{
@@ -4040,6 +4539,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer2, s.privKeys[1])
 	reputerValueBundle2 := types.ReputerValueBundle{
@@ -4060,6 +4561,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer3, s.privKeys[2])
 	reputerValueBundle3 := types.ReputerValueBundle{
@@ -4096,6 +4599,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer4, s.privKeys[3])
 	reputerValueBundle4 := types.ReputerValueBundle{
@@ -4123,6 +4628,8 @@ func (s *KeeperTestSuite) TestAppendReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature = s.signValueBundle(&valueBundleReputer5, s.privKeys[4])
 	reputerValueBundle5 := types.ReputerValueBundle{
@@ -4370,6 +4877,8 @@ func (s *KeeperTestSuite) TestRemoveReputerLoss() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature := s.signValueBundle(valueBundle, s.privKeys[0])
 	reputerLossBundle := types.ReputerValueBundle{
@@ -4452,6 +4961,7 @@ func (s *KeeperTestSuite) TestRemoveInference() {
 		Inferer:     inferer,
 		ExtraData:   []byte("data"),
 		Proof:       "",
+		Values:      nil,
 	}
 
 	// Insert the inference
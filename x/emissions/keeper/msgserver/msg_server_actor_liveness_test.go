@@ -0,0 +1,223 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestSweepInactiveActorsJailsAndDeregisters() {
+	ctx := s.ctx
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	_, liveWorker := getNewAddress()
+	_, idleWorker := getNewAddress()
+	for _, worker := range []string{liveWorker, idleWorker} {
+		err := s.emissionsKeeper.InsertWorker(ctx, topic.Id, worker, types.OffchainNode{Owner: worker, NodeAddress: worker})
+		require.NoError(err)
+	}
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxMissedEpochsJail = 2
+	moduleParams.MaxMissedEpochsDeregister = 4
+
+	// Actors registered before being tracked are expected to submit from the first sweep on
+	err = s.emissionsKeeper.SweepInactiveActorsOfTopic(ctx, topic, moduleParams)
+	require.NoError(err)
+	liveness, found, err := s.emissionsKeeper.GetActorLiveness(ctx, topic.Id, idleWorker, false)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(topic.EpochLastEnded, liveness.ActiveSince)
+
+	// Two epochs later, only the worker who kept submitting is still registered
+	topic.EpochLastEnded += 2 * topic.EpochLength
+	err = s.emissionsKeeper.RecordActorSubmissions(ctx, topic.Id, []string{liveWorker}, false, topic.EpochLastEnded)
+	require.NoError(err)
+	err = s.emissionsKeeper.SweepInactiveActorsOfTopic(ctx, topic, moduleParams)
+	require.NoError(err)
+
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topic.Id, liveWorker)
+	require.NoError(err)
+	require.True(isRegistered)
+	isRegistered, err = s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topic.Id, idleWorker)
+	require.NoError(err)
+	require.False(isRegistered)
+	isJailed, err := s.emissionsKeeper.IsActorJailed(ctx, topic.Id, idleWorker, false)
+	require.NoError(err)
+	require.True(isJailed)
+
+	// Jailed actors unjail themselves rather than registering again
+	_, err = s.msgServer.Register(ctx, &types.RegisterRequest{
+		Sender:    idleWorker,
+		TopicId:   topic.Id,
+		Owner:     idleWorker,
+		IsReputer: false,
+	})
+	require.ErrorIs(err, types.ErrActorJailed)
+
+	// Jailed actors still missing epochs are eventually deregistered
+	topic.EpochLastEnded += 2 * topic.EpochLength
+	err = s.emissionsKeeper.RecordActorSubmissions(ctx, topic.Id, []string{liveWorker}, false, topic.EpochLastEnded)
+	require.NoError(err)
+	err = s.emissionsKeeper.SweepInactiveActorsOfTopic(ctx, topic, moduleParams)
+	require.NoError(err)
+
+	_, found, err = s.emissionsKeeper.GetActorLiveness(ctx, topic.Id, idleWorker, false)
+	require.NoError(err)
+	require.False(found)
+	isRegistered, err = s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topic.Id, liveWorker)
+	require.NoError(err)
+	require.True(isRegistered)
+}
+
+func (s *MsgServerTestSuite) TestUnjail() {
+	ctx := s.ctx.WithBlockHeight(100)
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	_, reputer := getNewAddress()
+	err := s.emissionsKeeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+
+	_, err = s.msgServer.Unjail(ctx, &types.UnjailRequest{
+		Sender:    reputer,
+		TopicId:   topic.Id,
+		IsReputer: true,
+	})
+	require.ErrorIs(err, types.ErrActorNotJailed)
+
+	err = s.emissionsKeeper.JailActor(ctx, topic.Id, reputer, true)
+	require.NoError(err)
+	isRegistered, err := s.emissionsKeeper.IsReputerRegisteredInTopic(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.False(isRegistered)
+
+	// Jailing a reputer does not jail the worker of the same address
+	_, err = s.msgServer.Unjail(ctx, &types.UnjailRequest{
+		Sender:    reputer,
+		TopicId:   topic.Id,
+		IsReputer: false,
+	})
+	require.ErrorIs(err, types.ErrActorNotJailed)
+
+	ctx = ctx.WithBlockHeight(200)
+	_, err = s.msgServer.Unjail(ctx, &types.UnjailRequest{
+		Sender:    reputer,
+		TopicId:   topic.Id,
+		IsReputer: true,
+	})
+	require.NoError(err)
+
+	isRegistered, err = s.emissionsKeeper.IsReputerRegisteredInTopic(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.True(isRegistered)
+	liveness, found, err := s.emissionsKeeper.GetActorLiveness(ctx, topic.Id, reputer, true)
+	require.NoError(err)
+	require.True(found)
+	require.False(liveness.Jailed)
+	require.Equal(int64(200), liveness.ActiveSince)
+}
+
+func (s *MsgServerTestSuite) TestRemoveRegistrationOfJailedActor() {
+	ctx := s.ctx
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	_, worker := getNewAddress()
+	err := s.emissionsKeeper.InsertWorker(ctx, topic.Id, worker, types.OffchainNode{Owner: worker, NodeAddress: worker})
+	require.NoError(err)
+	err = s.emissionsKeeper.JailActor(ctx, topic.Id, worker, false)
+	require.NoError(err)
+
+	_, err = s.msgServer.RemoveRegistration(ctx, &types.RemoveRegistrationRequest{
+		Sender:    worker,
+		TopicId:   topic.Id,
+		IsReputer: false,
+	})
+	require.NoError(err)
+
+	isJailed, err := s.emissionsKeeper.IsActorJailed(ctx, topic.Id, worker, false)
+	require.NoError(err)
+	require.False(isJailed)
+	_, found, err := s.emissionsKeeper.GetActorLiveness(ctx, topic.Id, worker, false)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestWorkersOutsideActiveSetAreLive() {
+	ctx := s.ctx
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	nonce := types.Nonce{BlockHeight: topic.EpochLength}
+	ctx = ctx.WithBlockHeight(nonce.BlockHeight)
+	err := s.emissionsKeeper.AddWorkerNonce(ctx, topic.Id, &nonce)
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxTopInferersToReward = 1
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	// More workers submit than the active set holds
+	workers := make([]string, 0)
+	for i := 0; i < 3; i++ {
+		privateKey := secp256k1.GenPrivKey()
+		workerAddr := sdk.AccAddress(privateKey.PubKey().Address())
+		worker := workerAddr.String()
+		err := s.emissionsKeeper.InsertWorker(ctx, topic.Id, worker, types.OffchainNode{Owner: worker, NodeAddress: worker})
+		require.NoError(err)
+		s.MintTokensToAddress(workerAddr, cosmosMath.NewInt(1000))
+		msg := types.InsertWorkerPayloadRequest{
+			Sender: worker,
+			WorkerDataBundle: &types.WorkerDataBundle{
+				Worker:  worker,
+				Nonce:   &nonce,
+				TopicId: topic.Id,
+				InferenceForecastsBundle: &types.InferenceForecastBundle{
+					Inference: &types.Inference{
+						TopicId:     topic.Id,
+						BlockHeight: nonce.BlockHeight,
+						Inferer:     worker,
+						Value:       alloraMath.NewDecFromInt64(int64(100 + i)),
+						ExtraData:   nil,
+						Proof:       "",
+						Values:      nil,
+					},
+					Forecast: nil,
+				},
+				InferencesForecastsBundleSignature: []byte{},
+				Pubkey:                             "",
+			},
+		}
+		msg = s.signMsgInsertWorkerPayload(msg, privateKey)
+		_, err = s.msgServer.InsertWorkerPayload(ctx, &msg)
+		require.NoError(err)
+		workers = append(workers, worker)
+	}
+	activeInferers, err := s.emissionsKeeper.GetActiveInferersForTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Len(activeInferers, 1)
+
+	// Every worker whose payload was accepted is live, so none of them is jailed
+	for _, worker := range workers {
+		liveness, found, err := s.emissionsKeeper.GetActorLiveness(ctx, topic.Id, worker, false)
+		require.NoError(err)
+		require.True(found)
+		require.Equal(nonce.BlockHeight, liveness.LastSubmissionNonce)
+	}
+	moduleParams.MaxMissedEpochsJail = 1
+	topic.EpochLastEnded = nonce.BlockHeight
+	err = s.emissionsKeeper.SweepInactiveActorsOfTopic(ctx, topic, moduleParams)
+	require.NoError(err)
+	for _, worker := range workers {
+		isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topic.Id, worker)
+		require.NoError(err)
+		require.True(isRegistered)
+	}
+}
@@ -0,0 +1,100 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Function for delegators to call to tokenize stake they delegate upon a reputer in a topic into
+// transferable receipts of the delegate stake receipt pool of the reputer.
+func (ms msgServer) TokenizeDelegateStake(ctx context.Context, msg *types.TokenizeDelegateStakeRequest) (_ *types.TokenizeDelegateStakeResponse, err error) {
+	defer metrics.RecordMetrics("TokenizeDelegateStake", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	// Stake queued for removal from the reputer cannot be tokenized
+	if err := ms.checkDelegateStakeNotQueuedForRemoval(ctx, msg.TopicId, msg.Sender, msg.Reputer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	pool, shares, err := ms.k.TokenizeDelegateStake(ctx, msg.TopicId, msg.Sender, msg.Reputer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.TokenizeDelegateStakeResponse{
+		PoolId: pool.Id,
+		Denom:  types.DelegateStakeReceiptDenom(pool.Id),
+		Shares: shares,
+	}, nil
+}
+
+// Function for holders of delegate stake receipts to call to redeem them for their share of the pool,
+// delegated back to the reputer of the pool or paid out if the topic of the pool is archived.
+func (ms msgServer) RedeemDelegateStakeReceipt(ctx context.Context, msg *types.RedeemDelegateStakeReceiptRequest) (_ *types.RedeemDelegateStakeReceiptResponse, err error) {
+	defer metrics.RecordMetrics("RedeemDelegateStakeReceipt", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	delegated, returned, err := ms.k.RedeemDelegateStakeReceipt(ctx, msg.Sender, msg.PoolId, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RedeemDelegateStakeReceiptResponse{DelegatedAmount: delegated, ReturnedAmount: returned}, nil
+}
+
+// Checks that a delegator has at least `amount` of stake delegated upon a reputer in a topic
+// on top of the stake it has queued for removal from the reputer
+func (ms msgServer) checkDelegateStakeNotQueuedForRemoval(
+	ctx context.Context,
+	topicId uint64,
+	delegator string,
+	reputer string,
+	amount cosmosMath.Int,
+) error {
+	delegateStakePlaced, err := ms.k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return err
+	}
+	removal, found, err := ms.k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
+		sdk.UnwrapSDKContext(ctx), delegator, reputer, topicId,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "error during finding delegate stake removal")
+	}
+	if found {
+		amount = amount.Add(removal.Amount)
+	}
+	amountDec, err := alloraMath.NewDecFromSdkInt(amount)
+	if err != nil {
+		return err
+	}
+	if delegateStakePlaced.Amount.Lt(amountDec) {
+		return types.ErrInsufficientDelegateStakeToRemove
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) requireDelegateStakeReceiptInvariants() {
+	_, broken := keeper.DelegateStakeReceiptsInvariantSupplyEqualPoolShares(s.emissionsKeeper)(s.ctx)
+	s.Require().False(broken)
+	_, broken = keeper.DelegateStakeReceiptsInvariantTotalReserveEqualBankBalance(s.emissionsKeeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *MsgServerTestSuite) TestTokenizeAndRedeemDelegateStake() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	holderAddr := s.addrs[2]
+	holder := s.addrsStr[2]
+	poolAddr := s.accountKeeper.GetModuleAddress(types.AlloraDelegateStakeReceiptsAccountName).String()
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	err := k.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	topicStake, err := k.GetTopicStake(ctx, topic.Id)
+	require.NoError(err)
+
+	// Stake beyond what is delegated cannot be tokenized
+	tokenizeMsg := &types.TokenizeDelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(101),
+	}
+	_, err = s.msgServer.TokenizeDelegateStake(ctx, tokenizeMsg)
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRemove)
+
+	// Receipts are minted 1:1 into a new pool and the stake stays in the topic
+	tokenizeMsg.Amount = cosmosMath.NewInt(60)
+	response, err := s.msgServer.TokenizeDelegateStake(ctx, tokenizeMsg)
+	require.NoError(err)
+	require.Equal(uint64(1), response.PoolId)
+	require.Equal("delegatestake/1", response.Denom)
+	require.Equal(cosmosMath.NewInt(60), response.Shares)
+	require.Equal(cosmosMath.NewInt(60), s.bankKeeper.GetBalance(ctx, delegatorAddr, response.Denom).Amount)
+	placement, err := k.GetDelegateStakePlacement(ctx, topic.Id, delegator, reputer)
+	require.NoError(err)
+	require.True(placement.Amount.Equal(alloraMath.NewDecFromInt64(40)), placement.Amount.String())
+	poolPlacement, err := k.GetDelegateStakePlacement(ctx, topic.Id, poolAddr, reputer)
+	require.NoError(err)
+	require.True(poolPlacement.Amount.Equal(alloraMath.NewDecFromInt64(60)), poolPlacement.Amount.String())
+	topicStakeAfter, err := k.GetTopicStake(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(topicStake, topicStakeAfter)
+	s.requireDelegateStakeReceiptInvariants()
+
+	// Rewards of 0.5 per share accrue to the stake of the pool
+	err = k.SetDelegateRewardPerShare(ctx, topic.Id, reputer, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(err)
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(50))
+
+	// Receipts are transferable and redeemed by their holder for their share of the compounded pool stake
+	receipts := sdk.NewCoins(sdk.NewCoin(response.Denom, cosmosMath.NewInt(30)))
+	err = s.bankKeeper.SendCoins(ctx, delegatorAddr, holderAddr, receipts)
+	require.NoError(err)
+	redeemResponse, err := s.msgServer.RedeemDelegateStakeReceipt(ctx, &types.RedeemDelegateStakeReceiptRequest{
+		Sender: holder,
+		PoolId: response.PoolId,
+		Shares: cosmosMath.NewInt(30),
+	})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(45), redeemResponse.DelegatedAmount)
+	require.True(redeemResponse.ReturnedAmount.IsZero())
+	require.True(s.bankKeeper.GetBalance(ctx, holderAddr, response.Denom).IsZero())
+	holderPlacement, err := k.GetDelegateStakePlacement(ctx, topic.Id, holder, reputer)
+	require.NoError(err)
+	require.True(holderPlacement.Amount.Equal(alloraMath.NewDecFromInt64(45)), holderPlacement.Amount.String())
+	pool, found, err := k.GetDelegateStakeReceiptPool(ctx, response.PoolId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(30), pool.Shares)
+	s.requireDelegateStakeReceiptInvariants()
+
+	// Receipts of stake tokenized later are minted at the price of the pool
+	tokenizeMsg.Amount = cosmosMath.NewInt(30)
+	response, err = s.msgServer.TokenizeDelegateStake(ctx, tokenizeMsg)
+	require.NoError(err)
+	require.Equal(uint64(1), response.PoolId)
+	require.Equal(cosmosMath.NewInt(20), response.Shares)
+	s.requireDelegateStakeReceiptInvariants()
+
+	// Receipts cannot be redeemed beyond what the sender holds
+	_, err = s.msgServer.RedeemDelegateStakeReceipt(ctx, &types.RedeemDelegateStakeReceiptRequest{
+		Sender: holder,
+		PoolId: response.PoolId,
+		Shares: cosmosMath.NewInt(1),
+	})
+	require.Error(err)
+	_, err = s.msgServer.RedeemDelegateStakeReceipt(ctx, &types.RedeemDelegateStakeReceiptRequest{
+		Sender: delegator,
+		PoolId: 2,
+		Shares: cosmosMath.NewInt(1),
+	})
+	require.ErrorIs(err, types.ErrDelegateStakeReceiptPoolNotFound)
+}
+
+func (s *MsgServerTestSuite) TestDelegateStakeReceiptPoolCompoundsWithinDelegateStakeLimits() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	poolAddr := s.accountKeeper.GetModuleAddress(types.AlloraDelegateStakeReceiptsAccountName).String()
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	err := k.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	tokenizeMsg := &types.TokenizeDelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(60),
+	}
+	response, err := s.msgServer.TokenizeDelegateStake(ctx, tokenizeMsg)
+	require.NoError(err)
+
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxDelegateStakePerReputer = cosmosMath.NewInt(110)
+	err = k.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	// Rewards of 0.5 per share accrue to the stake of the pool, of which only 10 fit within the limit
+	err = k.SetDelegateRewardPerShare(ctx, topic.Id, reputer, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(err)
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(50))
+
+	// Receipts of stake tokenized later are minted at the price of both the pool stake and its reserve
+	tokenizeMsg.Amount = cosmosMath.NewInt(40)
+	response, err = s.msgServer.TokenizeDelegateStake(ctx, tokenizeMsg)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(26), response.Shares)
+	pool, found, err := k.GetDelegateStakeReceiptPool(ctx, response.PoolId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(20), pool.Reserve)
+	poolPlacement, err := k.GetDelegateStakePlacement(ctx, topic.Id, poolAddr, reputer)
+	require.NoError(err)
+	require.True(poolPlacement.Amount.Equal(alloraMath.NewDecFromInt64(110)), poolPlacement.Amount.String())
+	delegateStake, err := k.GetDelegateStakeUponReputer(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(110), delegateStake)
+	s.requireDelegateStakeReceiptInvariants()
+}
+
+func (s *MsgServerTestSuite) TestRedeemDelegateStakeReceiptOfArchivedTopic() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+	msgServer := s.msgServer
+
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+	delegatorAddr := s.addrs[2]
+	delegator := s.addrsStr[2]
+	delegateStake := cosmosMath.NewInt(50)
+
+	moduleParams, err := k.GetParams(ctx)
+	require.NoError(err)
+	topicId := s.commonStakingSetup(ctx, reputer, reputerAddr, worker, workerAddr, moduleParams.RegistrationFee)
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	_, err = msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{Sender: delegator, TopicId: topicId, Reputer: reputer, Amount: delegateStake})
+	require.NoError(err)
+	response, err := msgServer.TokenizeDelegateStake(ctx, &types.TokenizeDelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topicId,
+		Reputer: reputer,
+		Amount:  delegateStake,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: reputer, TopicId: topicId})
+	require.NoError(err)
+	archive, found, err := k.GetTopicArchive(ctx, topicId)
+	require.NoError(err)
+	require.True(found)
+	ctx = ctx.WithBlockHeight(archive.StakeRemovalsDueBlockHeight)
+
+	// The stake of the pool is returned into its reserve
+	_, done, err := k.ReturnArchivedTopicStake(ctx, topicId, 10)
+	require.NoError(err)
+	require.True(done)
+	pool, found, err := k.GetDelegateStakeReceiptPool(ctx, response.PoolId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(delegateStake, pool.Reserve)
+	s.requireDelegateStakeReceiptInvariants()
+
+	// The pool outlives the state of the topic, only its (topic, reputer) index is pruned
+	archive.StakeReturned = true
+	err = k.SetTopicArchive(ctx, topicId, archive)
+	require.NoError(err)
+	for done := false; !done; {
+		_, done, err = k.PruneArchivedTopicState(ctx, topicId, 100)
+		require.NoError(err)
+	}
+	_, found, err = k.GetDelegateStakeReceiptPoolOf(ctx, topicId, reputer)
+	require.NoError(err)
+	require.False(found)
+
+	// Receipts are then redeemed for coins
+	balanceBefore := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	redeemResponse, err := msgServer.RedeemDelegateStakeReceipt(ctx, &types.RedeemDelegateStakeReceiptRequest{
+		Sender: delegator,
+		PoolId: response.PoolId,
+		Shares: response.Shares,
+	})
+	require.NoError(err)
+	require.True(redeemResponse.DelegatedAmount.IsZero())
+	require.Equal(delegateStake, redeemResponse.ReturnedAmount)
+	balanceAfter := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	require.Equal(balanceBefore.Amount.Add(delegateStake), balanceAfter.Amount)
+	_, found, err = k.GetDelegateStakeReceiptPoolOf(ctx, topicId, reputer)
+	require.NoError(err)
+	require.False(found)
+	s.requireDelegateStakeReceiptInvariants()
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	appParams "github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,6 +28,116 @@ func (ms msgServer) FundTopic(ctx context.Context, msg *types.FundTopicRequest)
 		return nil, err
 	}
 
+	// Archived topics do not accept new funds anymore
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
 	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, msg.TopicId, msg.Amount)
 	return &types.FundTopicResponse{}, err
 }
+
+func (ms msgServer) CreateFundingSubscription(ctx context.Context, msg *types.CreateFundingSubscriptionRequest) (_ *types.CreateFundingSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("CreateFundingSubscription", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", msg.TopicId)
+	}
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	// Drips are no more frequent than the epochs of the shortest topics
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.IntervalBlocks != 0 && msg.IntervalBlocks < params.MinEpochLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFundingSubscription, "interval must be 0 or at least %d blocks", params.MinEpochLength)
+	}
+	interval := msg.IntervalBlocks
+	if interval == 0 {
+		interval = topic.EpochLength
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(appParams.DefaultBondDenom, msg.EscrowAmount))
+	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraRequestsAccountName, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionId, err := ms.k.AddFundingSubscription(ctx, types.FundingSubscription{
+		Id:                  0,
+		TopicId:             msg.TopicId,
+		Funder:              msg.Sender,
+		AmountPerDrip:       msg.AmountPerDrip,
+		IntervalBlocks:      msg.IntervalBlocks,
+		Escrow:              msg.EscrowAmount,
+		NextDripBlockHeight: sdk.UnwrapSDKContext(ctx).BlockHeight() + interval,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CreateFundingSubscriptionResponse{SubscriptionId: subscriptionId}, nil
+}
+
+func (ms msgServer) TopUpFundingSubscription(ctx context.Context, msg *types.TopUpFundingSubscriptionRequest) (_ *types.TopUpFundingSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("TopUpFundingSubscription", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	subscription, err := ms.k.GetFundingSubscription(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Funder != msg.Sender {
+		return nil, types.ErrNotFundingSubscriptionFunder
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(appParams.DefaultBondDenom, msg.Amount))
+	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraRequestsAccountName, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Escrow = subscription.Escrow.Add(msg.Amount)
+	if err := ms.k.SetFundingSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return &types.TopUpFundingSubscriptionResponse{}, nil
+}
+
+func (ms msgServer) CancelFundingSubscription(ctx context.Context, msg *types.CancelFundingSubscriptionRequest) (_ *types.CancelFundingSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("CancelFundingSubscription", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	subscription, err := ms.k.GetFundingSubscription(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Funder != msg.Sender {
+		return nil, types.ErrNotFundingSubscriptionFunder
+	}
+
+	refunded, err := ms.k.RefundFundingSubscription(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return &types.CancelFundingSubscriptionResponse{RefundedAmount: refunded}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *MsgServerTestSuite) TestFundTopicSimple() {
@@ -130,3 +131,189 @@ func (s *MsgServerTestSuite) TestHighWeightForHighFundedTopic() {
 
 	s.Require().Equal(topic2Weight.Gt(topicWeight), true, "Topic1 weight should be greater than Topic2 weight")
 }
+
+func (s *MsgServerTestSuite) createFundingSubscription(topicId uint64, amountPerDrip, escrow int64, interval int64) uint64 {
+	s.MintTokensToAddress(s.addrs[0], cosmosMath.NewInt(escrow))
+	response, err := s.msgServer.CreateFundingSubscription(s.ctx, &types.CreateFundingSubscriptionRequest{
+		Sender:         s.addrsStr[0],
+		TopicId:        topicId,
+		AmountPerDrip:  cosmosMath.NewInt(amountPerDrip),
+		IntervalBlocks: interval,
+		EscrowAmount:   cosmosMath.NewInt(escrow),
+	})
+	s.Require().NoError(err)
+	return response.SubscriptionId
+}
+
+func (s *MsgServerTestSuite) TestFundingSubscriptionDripsUntilEscrowRunsOut() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topic := s.CreateOneTopic()
+	startBlock := s.ctx.BlockHeight()
+	feeRevenueBefore, err := keeper.GetTopicFeeRevenue(s.ctx, topic.Id)
+	require.NoError(err)
+
+	// Drips once per epoch of the topic
+	subscriptionId := s.createFundingSubscription(topic.Id, 300, 1000, 0)
+
+	escrowAddr := authtypes.NewModuleAddress(types.AlloraRequestsAccountName)
+	require.Equal(cosmosMath.NewInt(1000), s.bankKeeper.GetBalance(s.ctx, escrowAddr, params.DefaultBondDenom).Amount)
+	require.True(s.bankKeeper.GetBalance(s.ctx, s.addrs[0], params.DefaultBondDenom).Amount.IsZero())
+
+	subscription, err := keeper.GetFundingSubscription(s.ctx, subscriptionId)
+	require.NoError(err)
+	require.Equal(startBlock+topic.EpochLength, subscription.NextDripBlockHeight)
+
+	due, err := keeper.GetDueFundingSubscriptionIds(s.ctx, startBlock+topic.EpochLength-1, 10)
+	require.NoError(err)
+	require.Empty(due)
+
+	for i, expectedEscrow := range []int64{700, 400, 100} {
+		dripBlock := startBlock + int64(i+1)*topic.EpochLength
+		due, err = keeper.GetDueFundingSubscriptionIds(s.ctx, dripBlock, 10)
+		require.NoError(err)
+		require.Equal([]uint64{subscriptionId}, due)
+
+		s.ctx = s.ctx.WithBlockHeight(dripBlock)
+		err = keeper.DripFundingSubscription(s.ctx, subscriptionId)
+		require.NoError(err)
+
+		subscription, err = keeper.GetFundingSubscription(s.ctx, subscriptionId)
+		require.NoError(err)
+		require.Equal(cosmosMath.NewInt(expectedEscrow), subscription.Escrow)
+		require.Equal(dripBlock+topic.EpochLength, subscription.NextDripBlockHeight)
+	}
+
+	// The last drip spends what is left of the escrow and ends the subscription
+	s.ctx = s.ctx.WithBlockHeight(startBlock + 4*topic.EpochLength)
+	err = keeper.DripFundingSubscription(s.ctx, subscriptionId)
+	require.NoError(err)
+	_, err = keeper.GetFundingSubscription(s.ctx, subscriptionId)
+	require.ErrorIs(err, types.ErrFundingSubscriptionNotFound)
+
+	feeRevenueAfter, err := keeper.GetTopicFeeRevenue(s.ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(feeRevenueBefore.AddRaw(1000), feeRevenueAfter)
+	require.True(s.bankKeeper.GetBalance(s.ctx, escrowAddr, params.DefaultBondDenom).Amount.IsZero())
+}
+
+func (s *MsgServerTestSuite) TestTopUpAndCancelFundingSubscription() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topic := s.CreateOneTopic()
+	subscriptionId := s.createFundingSubscription(topic.Id, 100, 1000, topic.EpochLength)
+
+	// Only the funder manages the subscription
+	s.MintTokensToAddress(s.addrs[1], cosmosMath.NewInt(500))
+	_, err := s.msgServer.TopUpFundingSubscription(s.ctx, &types.TopUpFundingSubscriptionRequest{
+		Sender:         s.addrsStr[1],
+		SubscriptionId: subscriptionId,
+		Amount:         cosmosMath.NewInt(500),
+	})
+	require.ErrorIs(err, types.ErrNotFundingSubscriptionFunder)
+	_, err = s.msgServer.CancelFundingSubscription(s.ctx, &types.CancelFundingSubscriptionRequest{
+		Sender:         s.addrsStr[1],
+		SubscriptionId: subscriptionId,
+	})
+	require.ErrorIs(err, types.ErrNotFundingSubscriptionFunder)
+
+	s.MintTokensToAddress(s.addrs[0], cosmosMath.NewInt(500))
+	_, err = s.msgServer.TopUpFundingSubscription(s.ctx, &types.TopUpFundingSubscriptionRequest{
+		Sender:         s.addrsStr[0],
+		SubscriptionId: subscriptionId,
+		Amount:         cosmosMath.NewInt(500),
+	})
+	require.NoError(err)
+	subscription, err := keeper.GetFundingSubscription(s.ctx, subscriptionId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1500), subscription.Escrow)
+
+	response, err := s.msgServer.CancelFundingSubscription(s.ctx, &types.CancelFundingSubscriptionRequest{
+		Sender:         s.addrsStr[0],
+		SubscriptionId: subscriptionId,
+	})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1500), response.RefundedAmount)
+	require.Equal(cosmosMath.NewInt(1500), s.bankKeeper.GetBalance(s.ctx, s.addrs[0], params.DefaultBondDenom).Amount)
+
+	subscriptions, _, err := keeper.GetTopicFundingSubscriptions(s.ctx, topic.Id, nil)
+	require.NoError(err)
+	require.Empty(subscriptions)
+	due, err := keeper.GetDueFundingSubscriptionIds(s.ctx, subscription.NextDripBlockHeight, 10)
+	require.NoError(err)
+	require.Empty(due)
+}
+
+func (s *MsgServerTestSuite) TestCreateFundingSubscriptionInvalidFails() {
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(s.addrs[0], cosmosMath.NewInt(1000))
+	msg := &types.CreateFundingSubscriptionRequest{
+		Sender:         s.addrsStr[0],
+		TopicId:        topic.Id,
+		AmountPerDrip:  cosmosMath.NewInt(100),
+		IntervalBlocks: 1,
+		EscrowAmount:   cosmosMath.NewInt(1000),
+	}
+
+	// Drips cannot be more frequent than the shortest epochs
+	_, err := s.msgServer.CreateFundingSubscription(s.ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidFundingSubscription)
+
+	msg.IntervalBlocks = 0
+	msg.EscrowAmount = cosmosMath.NewInt(50)
+	_, err = s.msgServer.CreateFundingSubscription(s.ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidFundingSubscription)
+
+	msg.EscrowAmount = cosmosMath.NewInt(1000)
+	err = s.emissionsKeeper.ArchiveTopic(s.ctx, topic.Id)
+	require.NoError(err)
+	_, err = s.msgServer.CreateFundingSubscription(s.ctx, msg)
+	require.ErrorIs(err, types.ErrTopicArchived)
+}
+
+func (s *MsgServerTestSuite) TestFundingSubscriptionOfArchivedTopicIsRefunded() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topic := s.CreateOneTopic()
+	subscriptionId := s.createFundingSubscription(topic.Id, 100, 1000, 0)
+	feeRevenueBefore, err := keeper.GetTopicFeeRevenue(s.ctx, topic.Id)
+	require.NoError(err)
+
+	err = keeper.ArchiveTopic(s.ctx, topic.Id)
+	require.NoError(err)
+	err = keeper.DripFundingSubscription(s.ctx, subscriptionId)
+	require.NoError(err)
+
+	_, err = keeper.GetFundingSubscription(s.ctx, subscriptionId)
+	require.ErrorIs(err, types.ErrFundingSubscriptionNotFound)
+	require.Equal(cosmosMath.NewInt(1000), s.bankKeeper.GetBalance(s.ctx, s.addrs[0], params.DefaultBondDenom).Amount)
+	feeRevenueAfter, err := keeper.GetTopicFeeRevenue(s.ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(feeRevenueBefore, feeRevenueAfter)
+}
+
+func (s *MsgServerTestSuite) TestFundingSubscriptionRefundedWhenArchivedTopicStakeReturned() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topic := s.CreateOneTopic()
+	subscriptionId := s.createFundingSubscription(topic.Id, 100, 1000, 0)
+
+	err := keeper.ArchiveTopic(s.ctx, topic.Id)
+	require.NoError(err)
+	_, done, err := keeper.ReturnArchivedTopicStake(s.ctx, topic.Id, 10)
+	require.NoError(err)
+	require.True(done)
+
+	_, err = keeper.GetFundingSubscription(s.ctx, subscriptionId)
+	require.ErrorIs(err, types.ErrFundingSubscriptionNotFound)
+	require.Equal(cosmosMath.NewInt(1000), s.bankKeeper.GetBalance(s.ctx, s.addrs[0], params.DefaultBondDenom).Amount)
+	subscriptions, _, err := keeper.GetTopicFundingSubscriptions(s.ctx, topic.Id, nil)
+	require.NoError(err)
+	require.Empty(subscriptions)
+}
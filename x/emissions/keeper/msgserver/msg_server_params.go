@@ -156,6 +156,42 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.UpdateParamsReq
 	if len(newParams.PNormSafeDiv) == 1 {
 		existingParams.PNormSafeDiv = newParams.PNormSafeDiv[0]
 	}
+	if len(newParams.MaxPruneArchivedTopicKeysEndBlock) == 1 {
+		existingParams.MaxPruneArchivedTopicKeysEndBlock = newParams.MaxPruneArchivedTopicKeysEndBlock[0]
+	}
+	if len(newParams.MaxFundingSubscriptionDripsEndBlock) == 1 {
+		existingParams.MaxFundingSubscriptionDripsEndBlock = newParams.MaxFundingSubscriptionDripsEndBlock[0]
+	}
+	if len(newParams.MaxTopicCreatorCommission) == 1 {
+		existingParams.MaxTopicCreatorCommission = newParams.MaxTopicCreatorCommission[0]
+	}
+	if len(newParams.NodeRotationCooldown) == 1 {
+		existingParams.NodeRotationCooldown = newParams.NodeRotationCooldown[0]
+	}
+	if len(newParams.ReputerOutlierSlashEpochs) == 1 {
+		existingParams.ReputerOutlierSlashEpochs = newParams.ReputerOutlierSlashEpochs[0]
+	}
+	if len(newParams.ReputerOutlierSlashFraction) == 1 {
+		existingParams.ReputerOutlierSlashFraction = newParams.ReputerOutlierSlashFraction[0]
+	}
+	if len(newParams.MaxMissedEpochsJail) == 1 {
+		existingParams.MaxMissedEpochsJail = newParams.MaxMissedEpochsJail[0]
+	}
+	if len(newParams.MaxMissedEpochsDeregister) == 1 {
+		existingParams.MaxMissedEpochsDeregister = newParams.MaxMissedEpochsDeregister[0]
+	}
+	if len(newParams.ReputerCommissionUpdateCooldown) == 1 {
+		existingParams.ReputerCommissionUpdateCooldown = newParams.ReputerCommissionUpdateCooldown[0]
+	}
+	if len(newParams.MaxDelegateToSelfStakeRatio) == 1 {
+		existingParams.MaxDelegateToSelfStakeRatio = newParams.MaxDelegateToSelfStakeRatio[0]
+	}
+	if len(newParams.MaxDelegateStakePerReputer) == 1 {
+		existingParams.MaxDelegateStakePerReputer = newParams.MaxDelegateStakePerReputer[0]
+	}
+	if len(newParams.MaxAutoCompoundDelegationsPerPayout) == 1 {
+		existingParams.MaxAutoCompoundDelegationsPerPayout = newParams.MaxAutoCompoundDelegationsPerPayout[0]
+	}
 	err = existingParams.Validate()
 	if err != nil {
 		return nil, err
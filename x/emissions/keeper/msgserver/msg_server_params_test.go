@@ -63,6 +63,18 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 		MaxStringLength:                     []uint64{1234},
 		InitialRegretQuantile:               []alloraMath.Dec{alloraMath.ZeroDec()},
 		PNormSafeDiv:                        []alloraMath.Dec{alloraMath.ZeroDec()},
+		MaxPruneArchivedTopicKeysEndBlock:   []uint64{1234},
+		MaxFundingSubscriptionDripsEndBlock: []uint64{4321},
+		MaxTopicCreatorCommission:           []alloraMath.Dec{alloraMath.MustNewDecFromString("0.05")},
+		NodeRotationCooldown:                []int64{1234},
+		ReputerOutlierSlashEpochs:           []uint64{5},
+		ReputerOutlierSlashFraction:         []alloraMath.Dec{alloraMath.MustNewDecFromString("0.02")},
+		MaxMissedEpochsJail:                 []uint64{20},
+		MaxMissedEpochsDeregister:           []uint64{200},
+		ReputerCommissionUpdateCooldown:     []int64{300},
+		MaxDelegateToSelfStakeRatio:         []alloraMath.Dec{alloraMath.MustNewDecFromString("10")},
+		MaxDelegateStakePerReputer:          []cosmosMath.Int{cosmosMath.NewInt(1000000)},
+		MaxAutoCompoundDelegationsPerPayout: []uint64{50},
 	}
 
 	updateMsg := &types.UpdateParamsRequest{
@@ -111,6 +123,18 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 	require.Equal(newParams.CRewardInference[0], updatedParams.CRewardInference)
 	require.Equal(newParams.CRewardForecast[0], updatedParams.CRewardForecast)
 	require.Equal(newParams.CNorm[0], updatedParams.CNorm)
+	require.Equal(newParams.MaxPruneArchivedTopicKeysEndBlock[0], updatedParams.MaxPruneArchivedTopicKeysEndBlock)
+	require.Equal(newParams.MaxFundingSubscriptionDripsEndBlock[0], updatedParams.MaxFundingSubscriptionDripsEndBlock)
+	require.Equal(newParams.MaxTopicCreatorCommission[0], updatedParams.MaxTopicCreatorCommission)
+	require.Equal(newParams.NodeRotationCooldown[0], updatedParams.NodeRotationCooldown)
+	require.Equal(newParams.ReputerOutlierSlashEpochs[0], updatedParams.ReputerOutlierSlashEpochs)
+	require.Equal(newParams.ReputerOutlierSlashFraction[0], updatedParams.ReputerOutlierSlashFraction)
+	require.Equal(newParams.MaxMissedEpochsJail[0], updatedParams.MaxMissedEpochsJail)
+	require.Equal(newParams.MaxMissedEpochsDeregister[0], updatedParams.MaxMissedEpochsDeregister)
+	require.Equal(newParams.ReputerCommissionUpdateCooldown[0], updatedParams.ReputerCommissionUpdateCooldown)
+	require.Equal(newParams.MaxDelegateToSelfStakeRatio[0], updatedParams.MaxDelegateToSelfStakeRatio)
+	require.Equal(newParams.MaxDelegateStakePerReputer[0], updatedParams.MaxDelegateStakePerReputer)
+	require.Equal(newParams.MaxAutoCompoundDelegationsPerPayout[0], updatedParams.MaxAutoCompoundDelegationsPerPayout)
 }
 
 func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
@@ -167,6 +191,18 @@ func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
 		MaxStringLength:                     nil,
 		InitialRegretQuantile:               nil,
 		PNormSafeDiv:                        nil,
+		MaxPruneArchivedTopicKeysEndBlock:   nil,
+		MaxFundingSubscriptionDripsEndBlock: nil,
+		MaxTopicCreatorCommission:           nil,
+		NodeRotationCooldown:                nil,
+		ReputerOutlierSlashEpochs:           nil,
+		ReputerOutlierSlashFraction:         nil,
+		MaxMissedEpochsJail:                 nil,
+		MaxMissedEpochsDeregister:           nil,
+		ReputerCommissionUpdateCooldown:     nil,
+		MaxDelegateToSelfStakeRatio:         nil,
+		MaxDelegateStakePerReputer:          nil,
+		MaxAutoCompoundDelegationsPerPayout: nil,
 	}
 
 	// Creating the UpdateParamsRequest message with a non-whitelisted user
@@ -29,6 +29,22 @@ func (ms msgServer) Register(ctx context.Context, msg *types.RegisterRequest) (_
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	// Archived topics do not accept new registrations anymore
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	// Jailed actors are still known to the topic, they unjail themselves instead of registering again
+	isJailed, err := ms.k.IsActorJailed(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	} else if isJailed {
+		return nil, types.ErrActorJailed
+	}
+
 	if msg.IsReputer {
 		isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
 		if err != nil {
@@ -37,6 +53,12 @@ func (ms msgServer) Register(ctx context.Context, msg *types.RegisterRequest) (_
 		if isRegistered {
 			return nil, errorsmod.Wrapf(types.ErrAddressAlreadyRegisteredInATopic, "reputer is already registered in this topic")
 		}
+		canRegister, err := ms.k.CanActAsTopicReputer(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !canRegister {
+			return nil, types.ErrNotInReputerWhitelist
+		}
 	} else {
 		isRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
 		if err != nil {
@@ -45,6 +67,12 @@ func (ms msgServer) Register(ctx context.Context, msg *types.RegisterRequest) (_
 		if isRegistered {
 			return nil, errorsmod.Wrapf(types.ErrAddressAlreadyRegisteredInATopic, "worker is already registered in this topic")
 		}
+		canRegister, err := ms.k.CanActAsTopicWorker(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !canRegister {
+			return nil, types.ErrNotInWorkerWhitelist
+		}
 	}
 
 	params, err := ms.k.GetParams(ctx)
@@ -96,6 +124,21 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.RemoveReg
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	// Jailed actors are not registered anymore, they can still leave the topic for good
+	isJailed, err := ms.k.IsActorJailed(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	} else if isJailed {
+		err = ms.k.DeregisterActor(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
+		if err != nil {
+			return nil, err
+		}
+		return &types.RemoveRegistrationResponse{
+			Success: true,
+			Message: fmt.Sprintf("Node successfully removed from topic %d", msg.TopicId),
+		}, nil
+	}
+
 	// Proceed based on whether requester is removing their reputer or worker registration
 	if msg.IsReputer {
 		isRegisteredInTopic, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
@@ -129,6 +172,11 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.RemoveReg
 		}
 	}
 
+	err = ms.k.RemoveActorLiveness(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return a successful response
 	return &types.RemoveRegistrationResponse{
 		Success: true,
@@ -136,6 +184,181 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.RemoveReg
 	}, nil
 }
 
+// Moves a worker or reputer registration of a topic to a new node address, along with the scores,
+// regrets and stake of the node in the topic, and/or hands the node over to a new owner.
+// Only the current owner of the node may do so, and a node may only be moved again
+// once the node rotation cooldown has passed. The owner of a node is shared by all its topics.
+func (ms msgServer) UpdateNodeInfo(ctx context.Context, msg *types.UpdateNodeInfoRequest) (_ *types.UpdateNodeInfoResponse, err error) {
+	defer metrics.RecordMetrics("UpdateNodeInfo", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var nodeInfo types.OffchainNode
+	if msg.IsReputer {
+		isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.NodeAddress)
+		if err != nil {
+			return nil, err
+		}
+		if !isRegistered {
+			return nil, types.ErrAddressIsNotRegisteredInThisTopic
+		}
+		nodeInfo, err = ms.k.GetReputerInfo(sdkCtx, msg.NodeAddress)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		isRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.NodeAddress)
+		if err != nil {
+			return nil, err
+		}
+		if !isRegistered {
+			return nil, types.ErrAddressIsNotRegisteredInThisTopic
+		}
+		nodeInfo, err = ms.k.GetWorkerInfo(sdkCtx, msg.NodeAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if nodeInfo.Owner != msg.Sender {
+		return nil, types.ErrNotNodeOwner
+	}
+	if msg.NewOwner != "" {
+		nodeInfo.Owner = msg.NewOwner
+	}
+
+	if msg.NewNodeAddress == "" || msg.NewNodeAddress == msg.NodeAddress {
+		if msg.IsReputer {
+			err = ms.k.InsertReputer(ctx, msg.TopicId, msg.NodeAddress, nodeInfo)
+		} else {
+			err = ms.k.InsertWorker(ctx, msg.TopicId, msg.NodeAddress, nodeInfo)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &types.UpdateNodeInfoResponse{}, nil
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastRotation, found, err := ms.k.GetLastNodeRotation(ctx, msg.TopicId, msg.NodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if found && sdkCtx.BlockHeight() < lastRotation+moduleParams.NodeRotationCooldown {
+		return nil, errorsmod.Wrapf(types.ErrNodeRotationCooldown,
+			"node can be moved again from block %d", lastRotation+moduleParams.NodeRotationCooldown)
+	}
+
+	nodeInfo.NodeAddress = msg.NewNodeAddress
+	if msg.IsReputer {
+		isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.NewNodeAddress)
+		if err != nil {
+			return nil, err
+		}
+		if isRegistered {
+			return nil, errorsmod.Wrapf(types.ErrAddressAlreadyRegisteredInATopic, "new reputer address is already registered in this topic")
+		}
+		canAct, err := ms.k.CanActAsTopicReputer(ctx, msg.TopicId, msg.NewNodeAddress)
+		if err != nil {
+			return nil, err
+		} else if !canAct {
+			return nil, types.ErrNotInReputerWhitelist
+		}
+		err = ms.k.MoveReputer(ctx, msg.TopicId, msg.NodeAddress, msg.NewNodeAddress, nodeInfo)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		isRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.NewNodeAddress)
+		if err != nil {
+			return nil, err
+		}
+		if isRegistered {
+			return nil, errorsmod.Wrapf(types.ErrAddressAlreadyRegisteredInATopic, "new worker address is already registered in this topic")
+		}
+		canAct, err := ms.k.CanActAsTopicWorker(ctx, msg.TopicId, msg.NewNodeAddress)
+		if err != nil {
+			return nil, err
+		} else if !canAct {
+			return nil, types.ErrNotInWorkerWhitelist
+		}
+		err = ms.k.MoveWorker(ctx, msg.TopicId, msg.NodeAddress, msg.NewNodeAddress, nodeInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.UpdateNodeInfoResponse{}, nil
+}
+
+// Registers a worker or reputer jailed from a topic for missing too many epochs in the topic again
+func (ms msgServer) Unjail(ctx context.Context, msg *types.UnjailRequest) (_ *types.UnjailResponse, err error) {
+	defer metrics.RecordMetrics("Unjail", time.Now(), &err)
+
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	// The whitelists of the topic may have changed while the actor was jailed
+	var canAct bool
+	if msg.IsReputer {
+		canAct, err = ms.k.CanActAsTopicReputer(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !canAct {
+			return nil, types.ErrNotInReputerWhitelist
+		}
+	} else {
+		canAct, err = ms.k.CanActAsTopicWorker(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !canAct {
+			return nil, types.ErrNotInWorkerWhitelist
+		}
+	}
+
+	err = ms.k.UnjailActor(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.UnjailResponse{}, nil
+}
+
 func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, address string) (success bool, fee sdk.Coin, err error) {
 	defer metrics.RecordMetrics("CheckBalanceForRegistration", time.Now(), &err)
 
@@ -320,6 +320,16 @@ func (s *MsgServerTestSuite) TestBlocklistedAddressUnableToRegister() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -365,3 +375,315 @@ func (s *MsgServerTestSuite) TestMsgRegisterReputerInvalidTopicNotExist() {
 	_, err := msgServer.Register(ctx, registerMsg)
 	require.ErrorIs(err, types.ErrTopicDoesNotExist, "Register should return an error")
 }
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoMovesWorker() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	worker := s.addrsStr[0]
+	newWorker := s.addrsStr[1]
+	owner := s.addrsStr[2]
+	otherWorker := s.addrsStr[3]
+
+	err := s.emissionsKeeper.InsertWorker(ctx, topic.Id, worker, types.OffchainNode{NodeAddress: worker, Owner: owner})
+	require.NoError(err)
+	score := types.Score{TopicId: topic.Id, BlockHeight: 1, Address: worker, Score: alloraMath.MustNewDecFromString("0.7")}
+	err = s.emissionsKeeper.SetInfererScoreEma(ctx, topic.Id, worker, score)
+	require.NoError(err)
+	regret := types.TimestampedValue{BlockHeight: 1, Value: alloraMath.MustNewDecFromString("0.3")}
+	err = s.emissionsKeeper.SetOneOutInfererInfererNetworkRegret(ctx, topic.Id, worker, otherWorker, regret)
+	require.NoError(err)
+
+	// Only the owner may move the worker
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         worker,
+		TopicId:        topic.Id,
+		IsReputer:      false,
+		NodeAddress:    worker,
+		NewNodeAddress: newWorker,
+		NewOwner:       "",
+	})
+	require.ErrorIs(err, types.ErrNotNodeOwner)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         owner,
+		TopicId:        topic.Id,
+		IsReputer:      false,
+		NodeAddress:    worker,
+		NewNodeAddress: newWorker,
+		NewOwner:       "",
+	})
+	require.NoError(err)
+
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topic.Id, worker)
+	require.NoError(err)
+	require.False(isRegistered)
+	isRegistered, err = s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topic.Id, newWorker)
+	require.NoError(err)
+	require.True(isRegistered)
+
+	movedScore, err := s.emissionsKeeper.GetInfererScoreEma(ctx, topic.Id, newWorker)
+	require.NoError(err)
+	require.Equal(newWorker, movedScore.Address)
+	require.True(score.Score.Equal(movedScore.Score))
+
+	movedRegret, _, err := s.emissionsKeeper.GetOneOutInfererInfererNetworkRegret(ctx, topic.Id, newWorker, otherWorker)
+	require.NoError(err)
+	require.True(regret.Value.Equal(movedRegret.Value))
+
+	nodeInfo, err := s.emissionsKeeper.GetWorkerInfo(ctx, newWorker)
+	require.NoError(err)
+	require.Equal(newWorker, nodeInfo.NodeAddress)
+	require.Equal(owner, nodeInfo.Owner)
+
+	// Moving again has to wait for the cooldown
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         owner,
+		TopicId:        topic.Id,
+		IsReputer:      false,
+		NodeAddress:    newWorker,
+		NewNodeAddress: worker,
+		NewOwner:       "",
+	})
+	require.ErrorIs(err, types.ErrNodeRotationCooldown)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + moduleParams.NodeRotationCooldown)
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         owner,
+		TopicId:        topic.Id,
+		IsReputer:      false,
+		NodeAddress:    newWorker,
+		NewNodeAddress: worker,
+		NewOwner:       "",
+	})
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoMovesWorkerBond() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	workerAddr, worker := getNewAddress()
+	_, newWorker := getNewAddress()
+	otherWorkerAddr, otherWorker := getNewAddress()
+	owner := s.addrsStr[2]
+	for _, addr := range []string{worker, otherWorker} {
+		err := s.emissionsKeeper.InsertWorker(ctx, topicId, addr, types.OffchainNode{Owner: owner, NodeAddress: addr})
+		require.NoError(err)
+	}
+	s.MintTokensToAddress(workerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(otherWorkerAddr, cosmosMath.NewInt(1000))
+
+	_, err := msgServer.AddWorkerBond(ctx, &types.AddWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	_, err = msgServer.RemoveWorkerBond(ctx, &types.RemoveWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(40),
+	})
+	require.NoError(err)
+	removal, found, err := s.emissionsKeeper.GetWorkerBondRemoval(ctx, topicId, worker)
+	require.NoError(err)
+	require.True(found)
+
+	// The worker cannot be moved onto an address that still holds a bond of its own
+	_, err = msgServer.AddWorkerBond(ctx, &types.AddWorkerBondRequest{
+		Sender:  otherWorker,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(10),
+	})
+	require.NoError(err)
+	_, err = msgServer.RemoveRegistration(ctx, &types.RemoveRegistrationRequest{
+		Sender:    otherWorker,
+		TopicId:   topicId,
+		IsReputer: false,
+	})
+	require.NoError(err)
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         owner,
+		TopicId:        topicId,
+		IsReputer:      false,
+		NodeAddress:    worker,
+		NewNodeAddress: otherWorker,
+		NewOwner:       "",
+	})
+	require.ErrorIs(err, types.ErrNodeRotationBlocked)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         owner,
+		TopicId:        topicId,
+		IsReputer:      false,
+		NodeAddress:    worker,
+		NewNodeAddress: newWorker,
+		NewOwner:       "",
+	})
+	require.NoError(err)
+
+	oldBond, err := s.emissionsKeeper.GetWorkerBond(ctx, topicId, worker)
+	require.NoError(err)
+	require.True(oldBond.IsZero())
+	newBond, err := s.emissionsKeeper.GetWorkerBond(ctx, topicId, newWorker)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), newBond)
+
+	_, found, err = s.emissionsKeeper.GetWorkerBondRemoval(ctx, topicId, worker)
+	require.NoError(err)
+	require.False(found)
+	movedRemoval, found, err := s.emissionsKeeper.GetWorkerBondRemoval(ctx, topicId, newWorker)
+	require.NoError(err)
+	require.True(found)
+	removal.Worker = newWorker
+	require.Equal(removal, movedRemoval)
+
+	// The queued removal completes for the new address
+	removals, _, err := s.emissionsKeeper.GetWorkerBondRemovalsUpUntilBlock(ctx, removal.BlockRemovalCompleted, 10)
+	require.NoError(err)
+	require.Equal([]types.WorkerBondRemovalInfo{movedRemoval}, removals)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoRefusesNodeOfAnotherOwner() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	otherTopicId := s.CreateOneTopic().Id
+	alice := s.addrsStr[0]
+	bob := s.addrsStr[1]
+	_, aliceWorker := getNewAddress()
+	_, bobWorker := getNewAddress()
+	_, bobReputer := getNewAddress()
+	err := s.emissionsKeeper.InsertWorker(ctx, topicId, aliceWorker, types.OffchainNode{Owner: alice, NodeAddress: aliceWorker})
+	require.NoError(err)
+	bobWorkerInfo := types.OffchainNode{Owner: bob, NodeAddress: bobWorker}
+	err = s.emissionsKeeper.InsertWorker(ctx, otherTopicId, bobWorker, bobWorkerInfo)
+	require.NoError(err)
+	err = s.emissionsKeeper.InsertReputer(ctx, otherTopicId, bobReputer, types.OffchainNode{Owner: bob, NodeAddress: bobReputer})
+	require.NoError(err)
+
+	// The nodes of Bob are registered in another topic only, yet cannot be taken over by Alice
+	for _, newNodeAddress := range []string{bobWorker, bobReputer} {
+		_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+			Sender:         alice,
+			TopicId:        topicId,
+			IsReputer:      false,
+			NodeAddress:    aliceWorker,
+			NewNodeAddress: newNodeAddress,
+			NewOwner:       "",
+		})
+		require.ErrorIs(err, types.ErrNotNodeOwner)
+	}
+	nodeInfo, err := s.emissionsKeeper.GetWorkerInfo(ctx, bobWorker)
+	require.NoError(err)
+	require.Equal(bobWorkerInfo, nodeInfo)
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, aliceWorker)
+	require.NoError(err)
+	require.True(isRegistered)
+
+	_, newAliceWorker := getNewAddress()
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         alice,
+		TopicId:        topicId,
+		IsReputer:      false,
+		NodeAddress:    aliceWorker,
+		NewNodeAddress: newAliceWorker,
+		NewOwner:       "",
+	})
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoMovesReputerStake() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	reputer := s.addrsStr[0]
+	newReputer := s.addrsStr[1]
+	owner := s.addrsStr[2]
+	newOwner := s.addrsStr[3]
+	delegator := s.addrsStr[4]
+
+	err := s.emissionsKeeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{NodeAddress: reputer, Owner: owner})
+	require.NoError(err)
+	stake := cosmosMath.NewInt(1000)
+	err = s.emissionsKeeper.AddReputerStake(ctx, topic.Id, reputer, stake)
+	require.NoError(err)
+	delegatedStake := cosmosMath.NewInt(500)
+	err = s.emissionsKeeper.AddDelegateStake(ctx, topic.Id, delegator, reputer, delegatedStake)
+	require.NoError(err)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         owner,
+		TopicId:        topic.Id,
+		IsReputer:      true,
+		NodeAddress:    reputer,
+		NewNodeAddress: newReputer,
+		NewOwner:       newOwner,
+	})
+	require.NoError(err)
+
+	isRegistered, err := s.emissionsKeeper.IsReputerRegisteredInTopic(ctx, topic.Id, newReputer)
+	require.NoError(err)
+	require.True(isRegistered)
+
+	movedStake, err := s.emissionsKeeper.GetStakeReputerAuthority(ctx, topic.Id, newReputer)
+	require.NoError(err)
+	require.Equal(stake.Add(delegatedStake), movedStake)
+	oldStake, err := s.emissionsKeeper.GetStakeReputerAuthority(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.True(oldStake.IsZero())
+
+	movedDelegatedStake, err := s.emissionsKeeper.GetDelegateStakeUponReputer(ctx, topic.Id, newReputer)
+	require.NoError(err)
+	require.Equal(delegatedStake, movedDelegatedStake)
+	placement, err := s.emissionsKeeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, newReputer)
+	require.NoError(err)
+	require.True(placement.Amount.Equal(alloraMath.NewDecFromInt64(500)))
+
+	nodeInfo, err := s.emissionsKeeper.GetReputerInfo(ctx, newReputer)
+	require.NoError(err)
+	require.Equal(newOwner, nodeInfo.Owner)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoBlockedByPendingInference() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	worker := s.addrsStr[0]
+	newWorker := s.addrsStr[1]
+
+	err := s.emissionsKeeper.InsertWorker(ctx, topic.Id, worker, types.OffchainNode{NodeAddress: worker, Owner: worker})
+	require.NoError(err)
+	err = s.emissionsKeeper.InsertInference(ctx, topic.Id, types.Inference{
+		TopicId:     topic.Id,
+		BlockHeight: ctx.BlockHeight(),
+		Inferer:     worker,
+		Value:       alloraMath.NewDecFromInt64(100),
+		ExtraData:   nil,
+		Proof:       "",
+		Values:      nil,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         worker,
+		TopicId:        topic.Id,
+		IsReputer:      false,
+		NodeAddress:    worker,
+		NewNodeAddress: newWorker,
+		NewOwner:       "",
+	})
+	require.ErrorIs(err, types.ErrNodeRotationBlocked)
+
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topic.Id, worker)
+	require.NoError(err)
+	require.True(isRegistered)
+}
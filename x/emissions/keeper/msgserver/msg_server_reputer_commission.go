@@ -0,0 +1,74 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Function for reputers to call to set the commission they charge on the rewards of their delegators in a topic.
+// Like the commission of a validator, its max rate and max change rate cannot be changed afterwards.
+func (ms msgServer) CreateReputerCommission(ctx context.Context, msg *types.CreateReputerCommissionRequest) (_ *types.CreateReputerCommissionResponse, err error) {
+	defer metrics.RecordMetrics("CreateReputerCommission", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isReputerRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	err = ms.k.CreateReputerCommission(ctx, msg.TopicId, msg.Sender, msg.Rate, msg.MaxRate, msg.MaxChangeRate)
+	if err != nil {
+		return nil, err
+	}
+	return &types.CreateReputerCommissionResponse{}, nil
+}
+
+// Function for reputers to call to change their commission rate in a topic, within the limits of their commission
+// and at most once per reputer_commission_update_cooldown blocks.
+func (ms msgServer) EditReputerCommission(ctx context.Context, msg *types.EditReputerCommissionRequest) (_ *types.EditReputerCommissionResponse, err error) {
+	defer metrics.RecordMetrics("EditReputerCommission", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.EditReputerCommissionRate(ctx, msg.TopicId, msg.Sender, msg.Rate, moduleParams.ReputerCommissionUpdateCooldown)
+	if err != nil {
+		return nil, err
+	}
+	return &types.EditReputerCommissionResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package msgserver_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *MsgServerTestSuite) TestCreateAndEditReputerCommission() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	_, reputer := getNewAddress()
+	createMsg := &types.CreateReputerCommissionRequest{
+		Sender:        reputer,
+		TopicId:       topicId,
+		Rate:          alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.05"),
+	}
+
+	// Only reputers registered in the topic can create a commission
+	_, err := msgServer.CreateReputerCommission(ctx, createMsg)
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+
+	err = s.emissionsKeeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+	_, err = msgServer.CreateReputerCommission(ctx, createMsg)
+	require.NoError(err)
+	_, err = msgServer.CreateReputerCommission(ctx, createMsg)
+	require.ErrorIs(err, types.ErrReputerCommissionExists)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	cooldown := moduleParams.ReputerCommissionUpdateCooldown
+
+	editMsg := &types.EditReputerCommissionRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Rate:    alloraMath.MustNewDecFromString("0.15"),
+	}
+	_, err = msgServer.EditReputerCommission(ctx, editMsg)
+	require.ErrorIs(err, types.ErrReputerCommissionUpdateCooldown)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + cooldown)
+	editMsg.Rate = alloraMath.MustNewDecFromString("0.16")
+	_, err = msgServer.EditReputerCommission(ctx, editMsg)
+	require.ErrorIs(err, types.ErrInvalidReputerCommission, "rate cannot change by more than the max change rate")
+	editMsg.Rate = alloraMath.MustNewDecFromString("0.15")
+	_, err = msgServer.EditReputerCommission(ctx, editMsg)
+	require.NoError(err)
+
+	rate, err := s.emissionsKeeper.GetReputerCommissionRate(ctx, topicId, reputer)
+	require.NoError(err)
+	require.True(rate.Equal(editMsg.Rate), rate.String())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + cooldown)
+	editMsg.Rate = alloraMath.MustNewDecFromString("0.21")
+	_, err = msgServer.EditReputerCommission(ctx, editMsg)
+	require.ErrorIs(err, types.ErrInvalidReputerCommission, "rate cannot be more than the max rate")
+
+	// Reputers without a commission cannot edit one
+	_, other := getNewAddress()
+	editMsg.Sender = other
+	editMsg.Rate = alloraMath.MustNewDecFromString("0.1")
+	_, err = msgServer.EditReputerCommission(ctx, editMsg)
+	require.ErrorIs(err, types.ErrReputerCommissionNotFound)
+}
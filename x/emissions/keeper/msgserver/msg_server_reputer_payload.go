@@ -5,6 +5,7 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 
 	"github.com/allora-network/allora-chain/x/emissions/types"
@@ -15,84 +16,291 @@ import (
 func (ms msgServer) InsertReputerPayload(ctx context.Context, msg *types.InsertReputerPayloadRequest) (_ *types.InsertReputerPayloadResponse, err error) {
 	defer metrics.RecordMetrics("InsertReputerPayload", time.Now(), &err)
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err = ms.k.ValidateStringIsBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	blockHeight := sdkCtx.BlockHeight()
-	err = msg.ReputerValueBundle.Validate()
+	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
-		return nil, errorsmod.Wrapf(err,
-			"Reputer invalid data for block: %d", blockHeight)
+		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+	}
+	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.checkReputerValueBundle(ctx, moduleParams, msg.ReputerValueBundle, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before accepting data, transfer fee amount from sender to ecosystem bucket
+	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, topic.Id, moduleParams.DataSendingFee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.appendReputerValueBundle(ctx, moduleParams, topic, msg.ReputerValueBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.InsertReputerPayloadResponse{}, err
+}
+
+// Commits a reputer to the loss bundle it reveals once the submission window of the reputer nonce is over,
+// so that reputers cannot see the losses of the others before reporting theirs.
+// The data sending fee is paid on commit.
+func (ms msgServer) CommitReputerPayload(ctx context.Context, msg *types.CommitReputerPayloadRequest) (_ *types.CommitReputerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("CommitReputerPayload", time.Now(), &err)
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
 	}
 
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
-		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+		return nil, err
+	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+	nonce := types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: msg.NonceBlockHeight}}
+	err = ms.checkReputerCanReport(ctx, moduleParams, topic, nonce, msg.Sender, false)
+	if err != nil {
+		return nil, err
+	}
+	if !topic.IsReputerCommitReveal() {
+		return nil, types.ErrCommitRevealNotEnabled
+	}
+
+	_, found, err := ms.k.GetReputerCommitment(ctx, msg.TopicId, msg.NonceBlockHeight, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if found {
+		return nil, types.ErrCommitmentExists
+	}
+
+	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, msg.TopicId, moduleParams.DataSendingFee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.SetReputerCommitment(ctx, types.ReputerCommitment{
+		TopicId:          msg.TopicId,
+		NonceBlockHeight: msg.NonceBlockHeight,
+		Reputer:          msg.Sender,
+		Commitment:       msg.Commitment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CommitReputerPayloadResponse{}, nil
+}
+
+// Reveals the loss bundle a reputer committed to, which is then processed as a plaintext loss bundle would be.
+// Reputers who do not reveal their loss bundle by the end of the reveal window do not take part in the nonce.
+func (ms msgServer) RevealReputerPayload(ctx context.Context, msg *types.RevealReputerPayloadRequest) (_ *types.RevealReputerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("RevealReputerPayload", time.Now(), &err)
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
 	}
 	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	nonce := msg.ReputerValueBundle.ValueBundle.ReputerRequestNonce
-	topicId := msg.ReputerValueBundle.ValueBundle.TopicId
+	bundle := msg.ReputerValueBundle
+	topic, err := ms.checkReputerValueBundle(ctx, moduleParams, bundle, true)
+	if err != nil {
+		return nil, err
+	}
 
-	topic, err := ms.k.GetTopic(ctx, topicId)
+	valueBundle := bundle.ValueBundle
+	nonceBlockHeight := valueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight
+	commitment, found, err := ms.k.GetReputerCommitment(ctx, valueBundle.TopicId, nonceBlockHeight, valueBundle.Reputer)
 	if err != nil {
-		return nil, types.ErrInvalidTopicId
+		return nil, err
+	} else if !found {
+		return nil, types.ErrCommitmentNotFound
+	}
+	matches, err := commitment.Matches(valueBundle, msg.Salt)
+	if err != nil {
+		return nil, err
+	} else if !matches {
+		return nil, types.ErrCommitmentMismatch
 	}
 
-	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
+	err = ms.appendReputerValueBundle(ctx, moduleParams, topic, bundle)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.RemoveReputerCommitment(ctx, valueBundle.TopicId, nonceBlockHeight, valueBundle.Reputer)
 	if err != nil {
 		return nil, err
+	}
+	return &types.RevealReputerPayloadResponse{}, nil
+}
+
+// Checks a reputer value bundle can be accepted for its reputer nonce, and returns the topic of the bundle.
+// Bundles revealing a commitment are checked against the reveal window instead of the submission window.
+func (ms msgServer) checkReputerValueBundle(
+	ctx context.Context,
+	moduleParams types.Params,
+	bundle *types.ReputerValueBundle,
+	reveal bool,
+) (types.Topic, error) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	err := bundle.Validate()
+	if err != nil {
+		return types.Topic{}, errorsmod.Wrapf(err,
+			"Reputer invalid data for block: %d", blockHeight)
+	}
+
+	topic, err := ms.k.GetTopic(ctx, bundle.ValueBundle.TopicId)
+	if err != nil {
+		return types.Topic{}, types.ErrInvalidTopicId
+	}
+	err = ms.checkReputerCanReport(ctx, moduleParams, topic, *bundle.ValueBundle.ReputerRequestNonce, bundle.ValueBundle.Reputer, reveal)
+	if err != nil {
+		return types.Topic{}, err
+	}
+
+	// Loss bundles of commit-reveal topics are only accepted as reveals of a commitment
+	if reveal && !topic.IsReputerCommitReveal() {
+		return types.Topic{}, types.ErrCommitRevealNotEnabled
+	} else if !reveal && topic.IsReputerCommitReveal() {
+		return types.Topic{}, types.ErrCommitRevealRequired
+	}
+
+	// Reputers of topics with chain computed losses report only the ground truth, from which
+	// the chain computes the losses itself instead of trusting those of the reputer
+	if topic.ChainComputedLosses {
+		if !bundle.ValueBundle.ReportsGroundTruth() || !bundle.ValueBundle.HasNoLosses() {
+			return types.Topic{}, errorsmod.Wrap(types.ErrInvalidChainComputedLossBundle, "bundle must report the ground truth and no losses")
+		}
+	} else if bundle.ValueBundle.ReportsGroundTruth() {
+		return types.Topic{}, types.ErrGroundTruthNotAccepted
+	}
+	return topic, nil
+}
+
+// Checks a reputer can report losses for a reputer nonce of a topic at the current block, in the
+// submission window of the nonce or in its reveal window
+func (ms msgServer) checkReputerCanReport(
+	ctx context.Context,
+	moduleParams types.Params,
+	topic types.Topic,
+	nonce types.ReputerRequestNonce,
+	reputer string,
+	reveal bool,
+) error {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	topicId := topic.Id
+
+	// Archived topics do not accept new submissions anymore
+	isArchived, err := ms.k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return err
+	} else if isArchived {
+		return types.ErrTopicArchived
+	}
+	// Losses of derived topics are computed from their parent topic when reputer nonces close
+	if topic.IsDerived() {
+		return types.ErrDerivedTopic
+	}
+
+	workerNonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
+	if err != nil {
+		return err
 	} else if workerNonceUnfulfilled {
-		return nil, errorsmod.Wrapf(types.ErrNonceStillUnfulfilled, "worker nonce")
+		return errorsmod.Wrapf(types.ErrNonceStillUnfulfilled, "worker nonce")
 	}
 
 	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, topicId, nonce.ReputerNonce)
 	if err != nil {
-		return nil, err
+		return err
 	} else if !reputerNonceUnfulfilled {
-		return nil, errorsmod.Wrapf(types.ErrUnfulfilledNonceNotFound, "reputer nonce")
+		return errorsmod.Wrapf(types.ErrUnfulfilledNonceNotFound, "reputer nonce")
 	}
 
-	if !ms.k.BlockWithinReputerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
-		return nil, errorsmod.Wrapf(
+	if reveal {
+		if !ms.k.BlockWithinReputerRevealWindowOfNonce(topic, nonce, blockHeight) {
+			revealStart := nonce.ReputerNonce.BlockHeight + topic.GroundTruthLag*2 + 1
+			return errorsmod.Wrapf(
+				types.ErrRevealWindowNotAvailable,
+				"Reveal window not open for topic: %d, current block %d, start window: %d, end window: %d",
+				topicId, blockHeight, revealStart, revealStart+topic.ReputerRevealWindow,
+			)
+		}
+	} else if !ms.k.BlockWithinReputerSubmissionWindowOfNonce(topic, nonce, blockHeight) {
+		return errorsmod.Wrapf(
 			types.ErrReputerNonceWindowNotAvailable,
 			"Reputer window not open for topic: %d, current block %d, start window: %d, end window: %d",
 			topicId, blockHeight, nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag, nonce.ReputerNonce.BlockHeight+topic.GroundTruthLag*2,
 		)
 	}
 
-	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, topicId, msg.ReputerValueBundle.ValueBundle.Reputer)
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, topicId, reputer)
 	if err != nil {
-		return nil, err
+		return err
 	} else if !isRegistered {
-		return nil, errorsmod.Wrapf(types.ErrAddressNotRegistered, "reputer is not registered in this topic")
+		return errorsmod.Wrapf(types.ErrAddressNotRegistered, "reputer is not registered in this topic")
+	}
+
+	// Reputers registered before the reputer whitelist was enabled or who have been removed from it are refused as well
+	canReport, err := ms.k.CanActAsTopicReputer(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	} else if !canReport {
+		return types.ErrNotInReputerWhitelist
 	}
 
 	// Check that the reputer enough stake in the topic
-	stake, err := ms.k.GetStakeReputerAuthority(ctx, topicId, msg.ReputerValueBundle.ValueBundle.Reputer)
+	stake, err := ms.k.GetStakeReputerAuthority(ctx, topicId, reputer)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if stake.LT(moduleParams.RequiredMinimumStake) {
-		return nil, errorsmod.Wrapf(types.ErrInsufficientStake, "reputer does not have sufficient stake in the topic")
+		return errorsmod.Wrapf(types.ErrInsufficientStake, "reputer does not have sufficient stake in the topic")
 	}
+	return nil
+}
 
-	// Before accepting data, transfer fee amount from sender to ecosystem bucket
-	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, topicId, moduleParams.DataSendingFee)
-	if err != nil {
-		return nil, err
+// Appends a checked reputer value bundle to the losses of its reputer nonce, computing the losses
+// first in topics with chain computed losses. The reputer is live for the nonce as soon as its bundle
+// is accepted, whether or not it makes it to the active set.
+func (ms msgServer) appendReputerValueBundle(
+	ctx context.Context,
+	moduleParams types.Params,
+	topic types.Topic,
+	bundle *types.ReputerValueBundle,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reputerValueBundle := bundle
+	if topic.ChainComputedLosses {
+		valueBundle, err := synth.CalcChainComputedLosses(sdkCtx, ms.k, topic, bundle.ValueBundle)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error computing losses from ground truth for topic: %d", topic.Id)
+		}
+		reputerValueBundle = &types.ReputerValueBundle{
+			ValueBundle: valueBundle,
+			Signature:   bundle.Signature,
+			Pubkey:      bundle.Pubkey,
+		}
 	}
-
-	err = ms.k.AppendReputerLoss(sdkCtx, topic, moduleParams, nonce.ReputerNonce.BlockHeight, msg.ReputerValueBundle)
+	nonceBlockHeight := bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight
+	err := ms.k.AppendReputerLoss(sdkCtx, topic, moduleParams, nonceBlockHeight, reputerValueBundle)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	return &types.InsertReputerPayloadResponse{}, err
+	return ms.k.RecordActorSubmissions(ctx, topic.Id, []string{bundle.ValueBundle.Reputer}, true, nonceBlockHeight)
 }
@@ -1,8 +1,10 @@
 package msgserver_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -115,6 +117,8 @@ func (s *MsgServerTestSuite) setUpMsgReputerPayload(
 			},
 		},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	return reputerValueBundle, expectedInferences, expectedForecasts, topicId
@@ -242,3 +246,323 @@ func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadReputerNotMatchSignature
 	_, err = s.msgServer.InsertReputerPayload(ctx, lossesMsg)
 	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
+
+// Sets up a reputer payload reporting the ground truth instead of losses, within the reputer window
+func (s *MsgServerTestSuite) setUpChainComputedLossesReputerPayload(
+	chainComputedLosses bool,
+	groundTruth alloraMath.Dec,
+) (reputerValueBundle types.ValueBundle, topicId uint64) {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId := s.setUpMsgReputerPayload(s.addrsStr[0], s.addrs[0], s.addrsStr[1], s.addrs[1])
+	err := keeper.InsertActiveForecasts(s.ctx, topicId, block, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertActiveInferences(s.ctx, topicId, block, expectedInferences)
+	require.NoError(err)
+
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.ChainComputedLosses = chainComputedLosses
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	s.ctx = sdk.UnwrapSDKContext(s.ctx).WithBlockHeight(block + topic.GroundTruthLag*2)
+
+	reputerValueBundle.CombinedValue = alloraMath.Dec{}
+	reputerValueBundle.NaiveValue = alloraMath.Dec{}
+	reputerValueBundle.InfererValues = nil
+	reputerValueBundle.ForecasterValues = nil
+	reputerValueBundle.OneOutInfererValues = nil
+	reputerValueBundle.OneOutForecasterValues = nil
+	reputerValueBundle.OneInForecasterValues = nil
+	reputerValueBundle.GroundTruth = &groundTruth
+	return reputerValueBundle, topicId
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadChainComputedLosses() {
+	require := s.Require()
+
+	reputerValueBundle, topicId := s.setUpChainComputedLossesReputerPayload(true, alloraMath.NewDecFromInt64(3))
+	err := s.constructAndInsertReputerPayload(s.addrs[0], s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.NoError(err)
+
+	// The single inference of 1 has a squared error of 4 against the ground truth of 3
+	expectedLoss, err := alloraMath.Log10(alloraMath.NewDecFromInt64(4))
+	require.NoError(err)
+	stored, err := s.emissionsKeeper.GetReputerLatestLossByTopicId(s.ctx, topicId, s.addrsStr[0])
+	require.NoError(err)
+	require.True(expectedLoss.Equal(stored.ValueBundle.CombinedValue))
+	require.True(expectedLoss.Equal(stored.ValueBundle.NaiveValue))
+	require.Len(stored.ValueBundle.InfererValues, 1)
+	require.Equal(s.addrsStr[1], stored.ValueBundle.InfererValues[0].Worker)
+	require.True(expectedLoss.Equal(stored.ValueBundle.InfererValues[0].Value))
+	require.True(alloraMath.NewDecFromInt64(3).Equal(*stored.ValueBundle.GroundTruth))
+
+	// The stored bundle remains verifiable against the signature of the reputer
+	require.NoError(stored.Validate())
+
+	// The network inferences of the nonce are recorded for the next reputers to be scored against
+	networkInferences, found, err := s.emissionsKeeper.GetChainLossNetworkInferences(s.ctx, topicId, block)
+	require.NoError(err)
+	require.True(found)
+	require.Len(networkInferences, 1)
+	require.True(alloraMath.NewDecFromInt64(1).Equal(networkInferences[0].CombinedValue))
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadChainComputedLossesRejectsReportedLosses() {
+	require := s.Require()
+
+	reputerValueBundle, _ := s.setUpChainComputedLossesReputerPayload(true, alloraMath.NewDecFromInt64(3))
+	// Losses are not part of the signed bytes of a bundle reporting the ground truth
+	lossesMsg := &types.InsertReputerPayloadRequest{
+		Sender: s.addrsStr[0],
+		ReputerValueBundle: &types.ReputerValueBundle{
+			ValueBundle: &reputerValueBundle,
+			Signature:   s.signValueBundle(&reputerValueBundle, s.privKeys[0]),
+			Pubkey:      s.pubKeyHexStr[0],
+		},
+	}
+	reputerValueBundle.CombinedValue = alloraMath.NewDecFromInt64(-5)
+	_, err := s.msgServer.InsertReputerPayload(s.ctx, lossesMsg)
+	require.ErrorIs(err, types.ErrInvalidChainComputedLossBundle)
+
+	reputerValueBundle.CombinedValue = alloraMath.Dec{}
+	reputerValueBundle.GroundTruth = nil
+	err = s.constructAndInsertReputerPayload(s.addrs[0], s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.ErrorIs(err, types.ErrInvalidChainComputedLossBundle)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadGroundTruthNotAccepted() {
+	reputerValueBundle, _ := s.setUpChainComputedLossesReputerPayload(false, alloraMath.NewDecFromInt64(3))
+	err := s.constructAndInsertReputerPayload(s.addrs[0], s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	s.Require().ErrorIs(err, types.ErrGroundTruthNotAccepted)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadChainComputedLossesOfVectorTopic() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId := s.setUpMsgReputerPayload(s.addrsStr[0], s.addrs[0], s.addrsStr[1], s.addrs[1])
+	expectedInferences.Inferences[0].Value = alloraMath.Dec{}
+	expectedInferences.Inferences[0].Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(2)}
+	err := keeper.InsertActiveForecasts(s.ctx, topicId, block, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertActiveInferences(s.ctx, topicId, block, expectedInferences)
+	require.NoError(err)
+
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.ChainComputedLosses = true
+	topic.OutputDimension = 2
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	s.ctx = sdk.UnwrapSDKContext(s.ctx).WithBlockHeight(block + topic.GroundTruthLag*2)
+
+	reputerValueBundle.CombinedValue = alloraMath.Dec{}
+	reputerValueBundle.NaiveValue = alloraMath.Dec{}
+	reputerValueBundle.InfererValues = nil
+	reputerValueBundle.ForecasterValues = nil
+	reputerValueBundle.OneOutInfererValues = nil
+	reputerValueBundle.OneOutForecasterValues = nil
+	reputerValueBundle.OneInForecasterValues = nil
+
+	// A single ground truth value does not cover every output of the topic
+	groundTruth := alloraMath.NewDecFromInt64(3)
+	reputerValueBundle.GroundTruth = &groundTruth
+	err = s.constructAndInsertReputerPayload(s.addrs[0], s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.ErrorIs(err, types.ErrInvalidChainComputedLossBundle)
+
+	reputerValueBundle.GroundTruth = nil
+	reputerValueBundle.GroundTruthValues = []alloraMath.Dec{alloraMath.NewDecFromInt64(3), alloraMath.NewDecFromInt64(2)}
+	err = s.constructAndInsertReputerPayload(s.addrs[0], s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.NoError(err)
+
+	// Squared errors of 4 and 0 on the two outputs, averaged over the outputs
+	expectedLoss, err := alloraMath.Log10(alloraMath.NewDecFromInt64(2))
+	require.NoError(err)
+	stored, err := keeper.GetReputerLatestLossByTopicId(s.ctx, topicId, s.addrsStr[0])
+	require.NoError(err)
+	require.True(expectedLoss.Equal(stored.ValueBundle.CombinedValue))
+	require.Len(stored.ValueBundle.InfererValues, 1)
+	require.True(expectedLoss.Equal(stored.ValueBundle.InfererValues[0].Value))
+	require.NoError(stored.Validate())
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadChainComputedLossesOfProbabilisticTopic() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId := s.setUpMsgReputerPayload(s.addrsStr[0], s.addrs[0], s.addrsStr[1], s.addrs[1])
+	expectedInferences.Inferences[0].Value = alloraMath.Dec{}
+	expectedInferences.Inferences[0].Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(3)}
+	err := keeper.InsertActiveForecasts(s.ctx, topicId, block, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertActiveInferences(s.ctx, topicId, block, expectedInferences)
+	require.NoError(err)
+
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.ChainComputedLosses = true
+	topic.LossMethod = types.LossMethodPinball
+	topic.OutputDimension = 2
+	topic.QuantileLevels = []alloraMath.Dec{alloraMath.MustNewDecFromString("0.25"), alloraMath.MustNewDecFromString("0.75")}
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	s.ctx = sdk.UnwrapSDKContext(s.ctx).WithBlockHeight(block + topic.GroundTruthLag*2)
+
+	reputerValueBundle.CombinedValue = alloraMath.Dec{}
+	reputerValueBundle.NaiveValue = alloraMath.Dec{}
+	reputerValueBundle.InfererValues = nil
+	reputerValueBundle.ForecasterValues = nil
+	reputerValueBundle.OneOutInfererValues = nil
+	reputerValueBundle.OneOutForecasterValues = nil
+	reputerValueBundle.OneInForecasterValues = nil
+
+	// The quantiles are scored against a single observation
+	groundTruth := alloraMath.NewDecFromInt64(2)
+	reputerValueBundle.GroundTruth = &groundTruth
+	err = s.constructAndInsertReputerPayload(s.addrs[0], s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.NoError(err)
+
+	// Pinball losses of 0.25 * 1 and 0.25 * 1, averaged over the levels
+	expectedLoss, err := alloraMath.Log10(alloraMath.MustNewDecFromString("0.25"))
+	require.NoError(err)
+	stored, err := keeper.GetReputerLatestLossByTopicId(s.ctx, topicId, s.addrsStr[0])
+	require.NoError(err)
+	require.True(expectedLoss.Equal(stored.ValueBundle.CombinedValue))
+	require.Len(stored.ValueBundle.InfererValues, 1)
+	require.True(expectedLoss.Equal(stored.ValueBundle.InfererValues[0].Value))
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertReputerPayloadDerivedTopicFails() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerValueBundle, _, _, topicId := s.setUpMsgReputerPayload(s.addrsStr[0], s.addrs[0], s.addrsStr[1], s.addrs[1])
+	parent := s.CreateOneTopic()
+
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.AllowNegative = true
+	topic.GroundTruthSource = &types.GroundTruthSource{
+		ParentTopicId: parent.Id,
+		Aggregation:   types.GroundTruthAggregationDifference,
+	}
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	s.ctx = sdk.UnwrapSDKContext(s.ctx).WithBlockHeight(block + topic.GroundTruthLag*2)
+
+	// Losses of derived topics are computed by the chain only
+	err = s.constructAndInsertReputerPayload(s.addrs[0], s.privKeys[0], s.pubKeyHexStr[0], &reputerValueBundle)
+	require.ErrorIs(err, types.ErrDerivedTopic)
+}
+
+func (s *MsgServerTestSuite) TestMsgCommitRevealReputerPayload() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+
+	valueBundle, expectedInferences, expectedForecasts, topicId := s.setUpMsgReputerPayload(reputer, reputerAddr, worker, workerAddr)
+	err := keeper.InsertActiveForecasts(s.ctx, topicId, block, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertActiveInferences(s.ctx, topicId, block, expectedInferences)
+	require.NoError(err)
+
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.ReputerRevealWindow = 5
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+
+	// A second reputer commits without revealing
+	lateReputer := s.addrsStr[5]
+	err = keeper.InsertReputer(s.ctx, topicId, lateReputer, types.OffchainNode{Owner: lateReputer, NodeAddress: lateReputer})
+	require.NoError(err)
+	params, err := keeper.GetParams(s.ctx)
+	require.NoError(err)
+	err = keeper.AddReputerStake(s.ctx, topicId, lateReputer, params.RequiredMinimumStake)
+	require.NoError(err)
+	s.MintTokensToAddress(s.addrs[5], cosmosMath.NewInt(11000))
+
+	bundle := &types.ReputerValueBundle{
+		ValueBundle: &valueBundle,
+		Signature:   s.signValueBundle(&valueBundle, s.privKeys[0]),
+		Pubkey:      s.pubKeyHexStr[0],
+	}
+	ctx := s.ctx.WithBlockHeight(block + topic.GroundTruthLag)
+	_, err = s.msgServer.InsertReputerPayload(ctx, &types.InsertReputerPayloadRequest{
+		Sender:             reputer,
+		ReputerValueBundle: bundle,
+	})
+	require.ErrorIs(err, types.ErrCommitRevealRequired)
+
+	salt := []byte("a salt nobody guesses")
+	commitment, err := types.ReputerPayloadCommitment(&valueBundle, salt)
+	require.NoError(err)
+	commitMsg := &types.CommitReputerPayloadRequest{
+		Sender:           reputer,
+		TopicId:          topicId,
+		NonceBlockHeight: block,
+		Commitment:       commitment,
+	}
+	_, err = s.msgServer.CommitReputerPayload(ctx, commitMsg)
+	require.NoError(err)
+	_, err = s.msgServer.CommitReputerPayload(ctx, commitMsg)
+	require.ErrorIs(err, types.ErrCommitmentExists)
+	_, err = s.msgServer.CommitReputerPayload(ctx, &types.CommitReputerPayloadRequest{
+		Sender:           lateReputer,
+		TopicId:          topicId,
+		NonceBlockHeight: block,
+		Commitment:       commitment,
+	})
+	require.NoError(err)
+
+	// Reveals are only accepted once the submission window has closed
+	revealMsg := &types.RevealReputerPayloadRequest{
+		Sender:             reputer,
+		ReputerValueBundle: bundle,
+		Salt:               salt,
+	}
+	_, err = s.msgServer.RevealReputerPayload(ctx, revealMsg)
+	require.ErrorIs(err, types.ErrRevealWindowNotAvailable)
+
+	ctx = ctx.WithBlockHeight(block + topic.GroundTruthLag*2 + 1)
+	_, err = s.msgServer.RevealReputerPayload(ctx, &types.RevealReputerPayloadRequest{
+		Sender:             reputer,
+		ReputerValueBundle: bundle,
+		Salt:               []byte("another salt"),
+	})
+	require.ErrorIs(err, types.ErrCommitmentMismatch)
+
+	_, err = s.msgServer.RevealReputerPayload(ctx, revealMsg)
+	require.NoError(err)
+	_, found, err := keeper.GetReputerCommitment(ctx, topicId, block, reputer)
+	require.NoError(err)
+	require.False(found)
+
+	// Only the reputer who revealed takes part in the nonce
+	activeReputers, err := keeper.GetActiveReputersForTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal([]string{reputer}, activeReputers)
+
+	// The nonce stays open until the end of the reveal window, its commitments are dropped when it closes
+	revealEnd := block + topic.GroundTruthLag*2 + 1 + topic.ReputerRevealWindow
+	err = rewards.UpdateReputerNonce(ctx.WithBlockHeight(revealEnd-1), keeper, topic, revealEnd-1)
+	require.NoError(err)
+	isOpen, err := keeper.IsReputerNonceUnfulfilled(ctx, topicId, &types.Nonce{BlockHeight: block})
+	require.NoError(err)
+	require.True(isOpen)
+
+	ctx = ctx.WithBlockHeight(revealEnd)
+	_ = rewards.UpdateReputerNonce(ctx, keeper, topic, revealEnd)
+	isOpen, err = keeper.IsReputerNonceUnfulfilled(ctx, topicId, &types.Nonce{BlockHeight: block})
+	require.NoError(err)
+	require.False(isOpen)
+	commitments, err := keeper.GetReputerCommitmentsOfNonce(ctx, topicId, block)
+	require.NoError(err)
+	require.Empty(commitments)
+}
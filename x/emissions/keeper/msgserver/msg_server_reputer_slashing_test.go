@@ -0,0 +1,383 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+)
+
+func (s *MsgServerTestSuite) TestReputerOutlierStreakSlashesReputerAndDelegators() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	topic.ReputerOutlierThreshold = alloraMath.MustNewDecFromString("0.5")
+	err := s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	require.NoError(err)
+
+	reputerAddr, reputer := getNewAddress()
+	delegatorAddr, delegator := getNewAddress()
+	err = s.emissionsKeeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000))
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{
+		Sender:  reputer,
+		TopicId: topic.Id,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	_, err = msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(500),
+	})
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.ReputerOutlierSlashEpochs = 2
+	moduleParams.ReputerOutlierSlashFraction = alloraMath.MustNewDecFromString("0.1")
+
+	ecosystemAddr := s.accountKeeper.GetModuleAddress(minttypes.EcosystemModuleName)
+	ecosystemBalanceBefore := s.bankKeeper.GetBalance(ctx, ecosystemAddr, params.DefaultBondDenom).Amount
+
+	outlierDistance := alloraMath.MustNewDecFromString("0.8")
+	err = s.emissionsKeeper.UpdateReputerOutlierStreak(ctx, topic, reputer, outlierDistance, moduleParams)
+	require.NoError(err)
+	streak, err := s.emissionsKeeper.GetReputerOutlierStreak(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(uint64(1), streak)
+
+	// A reputer within the threshold ends its streak
+	err = s.emissionsKeeper.UpdateReputerOutlierStreak(ctx, topic, reputer, alloraMath.MustNewDecFromString("0.5"), moduleParams)
+	require.NoError(err)
+	streak, err = s.emissionsKeeper.GetReputerOutlierStreak(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(uint64(0), streak)
+
+	// Reaching the streak length slashes the reputer and its delegators alike
+	for i := 0; i < 2; i++ {
+		err = s.emissionsKeeper.UpdateReputerOutlierStreak(ctx, topic, reputer, outlierDistance, moduleParams)
+		require.NoError(err)
+	}
+	streak, err = s.emissionsKeeper.GetReputerOutlierStreak(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(uint64(0), streak)
+
+	reputerAuthority, err := s.emissionsKeeper.GetStakeReputerAuthority(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1350), reputerAuthority)
+	delegateStake, err := s.emissionsKeeper.GetDelegateStakeUponReputer(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(450), delegateStake)
+	placement, err := s.emissionsKeeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, reputer)
+	require.NoError(err)
+	require.True(placement.Amount.Equal(alloraMath.NewDecFromInt64(450)))
+	topicStake, err := s.emissionsKeeper.GetTopicStake(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1350), topicStake)
+
+	ecosystemBalanceAfter := s.bankKeeper.GetBalance(ctx, ecosystemAddr, params.DefaultBondDenom).Amount
+	require.Equal(cosmosMath.NewInt(150), ecosystemBalanceAfter.Sub(ecosystemBalanceBefore))
+
+	slashes, err := s.emissionsKeeper.GetReputerSlashHistory(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Len(slashes, 1)
+	require.Equal(cosmosMath.NewInt(100), slashes[0].ReputerStakeSlashed)
+	require.Equal(cosmosMath.NewInt(50), slashes[0].DelegateStakeSlashed)
+	require.True(slashes[0].Distance.Equal(outlierDistance))
+}
+
+func (s *MsgServerTestSuite) TestReputerOutlierStreakSurvivesNodeRotation() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	topic.ReputerOutlierThreshold = alloraMath.MustNewDecFromString("0.5")
+	err := s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	require.NoError(err)
+
+	_, owner := getNewAddress()
+	reputerAddr, reputer := getNewAddress()
+	_, newReputer := getNewAddress()
+	err = s.emissionsKeeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: owner, NodeAddress: reputer})
+	require.NoError(err)
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000))
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{
+		Sender:  reputer,
+		TopicId: topic.Id,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.ReputerOutlierSlashEpochs = 3
+	ctx = ctx.WithBlockHeight(100)
+	moduleParams.ReputerOutlierSlashFraction = alloraMath.MustNewDecFromString("0.1")
+	outlierDistance := alloraMath.MustNewDecFromString("0.8")
+
+	err = s.emissionsKeeper.SetReputerSlash(ctx, types.ReputerSlash{
+		TopicId:              topic.Id,
+		Reputer:              reputer,
+		BlockHeight:          1,
+		Distance:             outlierDistance,
+		ReputerStakeSlashed:  cosmosMath.NewInt(10),
+		DelegateStakeSlashed: cosmosMath.ZeroInt(),
+	})
+	require.NoError(err)
+	for i := 0; i < 2; i++ {
+		err = s.emissionsKeeper.UpdateReputerOutlierStreak(ctx, topic, reputer, outlierDistance, moduleParams)
+		require.NoError(err)
+	}
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.UpdateNodeInfoRequest{
+		Sender:         owner,
+		TopicId:        topic.Id,
+		IsReputer:      true,
+		NodeAddress:    reputer,
+		NewNodeAddress: newReputer,
+		NewOwner:       owner,
+	})
+	require.NoError(err)
+
+	// The streak and the slashes carry over to the new address
+	streak, err := s.emissionsKeeper.GetReputerOutlierStreak(ctx, topic.Id, newReputer)
+	require.NoError(err)
+	require.Equal(uint64(2), streak)
+	streak, err = s.emissionsKeeper.GetReputerOutlierStreak(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(uint64(0), streak)
+	slashes, err := s.emissionsKeeper.GetReputerSlashHistory(ctx, topic.Id, newReputer)
+	require.NoError(err)
+	require.Len(slashes, 1)
+	require.Equal(newReputer, slashes[0].Reputer)
+	slashes, err = s.emissionsKeeper.GetReputerSlashHistory(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Empty(slashes)
+
+	// The streak goes on under the new address up to the slash
+	err = s.emissionsKeeper.UpdateReputerOutlierStreak(ctx, topic, newReputer, outlierDistance, moduleParams)
+	require.NoError(err)
+	stake, err := s.emissionsKeeper.GetStakeReputerAuthority(ctx, topic.Id, newReputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(900), stake)
+	slashes, err = s.emissionsKeeper.GetReputerSlashHistory(ctx, topic.Id, newReputer)
+	require.NoError(err)
+	require.Len(slashes, 2)
+}
+
+func (s *MsgServerTestSuite) TestReputerSlashCapsQueuedStakeRemoval() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	reputerAddr, reputer := getNewAddress()
+	err := s.emissionsKeeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000))
+
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	_, err = msgServer.RemoveStake(ctx, &types.RemoveStakeRequest{
+		Sender:  reputer,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+
+	_, err = s.emissionsKeeper.SlashReputer(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.25"), alloraMath.OneDec())
+	require.NoError(err)
+
+	removal, found, err := s.emissionsKeeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(750), removal.Amount)
+}
+
+func (s *MsgServerTestSuite) TestReputerSlashOnlySlashesDelegationsUponReputer() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	delegatorAddr, delegator := getNewAddress()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	reputers := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		reputerAddr, reputer := getNewAddress()
+		err := s.emissionsKeeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+		require.NoError(err)
+		s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(1000))
+		_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{
+			Sender:  reputer,
+			TopicId: topicId,
+			Amount:  cosmosMath.NewInt(1000),
+		})
+		require.NoError(err)
+		_, err = msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+			Sender:  delegator,
+			TopicId: topicId,
+			Reputer: reputer,
+			Amount:  cosmosMath.NewInt(400),
+		})
+		require.NoError(err)
+		reputers = append(reputers, reputer)
+	}
+
+	slash, err := s.emissionsKeeper.SlashReputer(ctx, topicId, reputers[0], alloraMath.MustNewDecFromString("0.25"), alloraMath.OneDec())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), slash.DelegateStakeSlashed)
+
+	slashedPlacement, err := s.emissionsKeeper.GetDelegateStakePlacement(ctx, topicId, delegator, reputers[0])
+	require.NoError(err)
+	require.True(slashedPlacement.Amount.Equal(alloraMath.NewDecFromInt64(300)))
+	otherPlacement, err := s.emissionsKeeper.GetDelegateStakePlacement(ctx, topicId, delegator, reputers[1])
+	require.NoError(err)
+	require.True(otherPlacement.Amount.Equal(alloraMath.NewDecFromInt64(400)))
+	otherDelegateStake, err := s.emissionsKeeper.GetDelegateStakeUponReputer(ctx, topicId, reputers[1])
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(400), otherDelegateStake)
+}
+
+func (s *MsgServerTestSuite) TestReputerSlashReachesUnmaturedRedelegations() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	delegatorAddr, delegator := getNewAddress()
+	_, srcReputer := getNewAddress()
+	_, dstReputer := getNewAddress()
+	for _, reputer := range []string{srcReputer, dstReputer} {
+		err := s.emissionsKeeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+		require.NoError(err)
+	}
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+
+	_, err := msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topicId,
+		Reputer: srcReputer,
+		Amount:  cosmosMath.NewInt(400),
+	})
+	require.NoError(err)
+	_, err = msgServer.RedelegateStake(ctx, &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topicId,
+		SrcReputer: srcReputer,
+		DstReputer: dstReputer,
+		Amount:     cosmosMath.NewInt(200),
+	})
+	require.NoError(err)
+
+	// The stake redelegated away is slashed at the destination reputer as if it had stayed
+	fraction := alloraMath.MustNewDecFromString("0.25")
+	slash, err := s.emissionsKeeper.SlashReputer(ctx, topicId, srcReputer, fraction, alloraMath.OneDec())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), slash.DelegateStakeSlashed)
+
+	srcPlacement, err := s.emissionsKeeper.GetDelegateStakePlacement(ctx, topicId, delegator, srcReputer)
+	require.NoError(err)
+	require.True(srcPlacement.Amount.Equal(alloraMath.NewDecFromInt64(150)), srcPlacement.Amount.String())
+	dstPlacement, err := s.emissionsKeeper.GetDelegateStakePlacement(ctx, topicId, delegator, dstReputer)
+	require.NoError(err)
+	require.True(dstPlacement.Amount.Equal(alloraMath.NewDecFromInt64(150)), dstPlacement.Amount.String())
+	dstDelegateStake, err := s.emissionsKeeper.GetDelegateStakeUponReputer(ctx, topicId, dstReputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(150), dstDelegateStake)
+	topicStake, err := s.emissionsKeeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(300), topicStake)
+	redelegation, found, err := s.emissionsKeeper.GetRedelegation(ctx, topicId, delegator, srcReputer, dstReputer)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(150), redelegation.Amount)
+
+	// Once matured, the redelegated stake is no longer slashed along with the source reputer
+	ctx = ctx.WithBlockHeight(redelegation.BlockMatured)
+	slash, err = s.emissionsKeeper.SlashReputer(ctx, topicId, srcReputer, fraction, alloraMath.OneDec())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(37), slash.DelegateStakeSlashed)
+	dstPlacement, err = s.emissionsKeeper.GetDelegateStakePlacement(ctx, topicId, delegator, dstReputer)
+	require.NoError(err)
+	require.True(dstPlacement.Amount.Equal(alloraMath.NewDecFromInt64(150)), dstPlacement.Amount.String())
+}
+
+func (s *MsgServerTestSuite) TestReputerSlashReachesOnlyItsOwnRedelegations() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	delegatorAddr, delegator := getNewAddress()
+	_, reputerA := getNewAddress()
+	_, reputerB := getNewAddress()
+	_, reputerC := getNewAddress()
+	for _, reputer := range []string{reputerA, reputerB, reputerC} {
+		err := s.emissionsKeeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+		require.NoError(err)
+	}
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	for _, reputer := range []string{reputerA, reputerB} {
+		_, err := msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+			Sender:  delegator,
+			TopicId: topicId,
+			Reputer: reputer,
+			Amount:  cosmosMath.NewInt(400),
+		})
+		require.NoError(err)
+	}
+
+	// Stake moved to the same reputer from two sources is recorded apart for each of them
+	_, err := msgServer.RedelegateStake(ctx, &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topicId,
+		SrcReputer: reputerA,
+		DstReputer: reputerC,
+		Amount:     cosmosMath.NewInt(200),
+	})
+	require.NoError(err)
+	_, err = msgServer.RedelegateStake(ctx, &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topicId,
+		SrcReputer: reputerB,
+		DstReputer: reputerC,
+		Amount:     cosmosMath.NewInt(4),
+	})
+	require.NoError(err)
+	fromA, found, err := s.emissionsKeeper.GetRedelegation(ctx, topicId, delegator, reputerA, reputerC)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(200), fromA.Amount)
+	fromB, found, err := s.emissionsKeeper.GetRedelegation(ctx, topicId, delegator, reputerB, reputerC)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(4), fromB.Amount)
+
+	// Slashing the second source only reaches the stake redelegated from it
+	fraction := alloraMath.MustNewDecFromString("0.5")
+	_, err = s.emissionsKeeper.SlashReputer(ctx, topicId, reputerB, fraction, alloraMath.OneDec())
+	require.NoError(err)
+	placementC, err := s.emissionsKeeper.GetDelegateStakePlacement(ctx, topicId, delegator, reputerC)
+	require.NoError(err)
+	require.True(placementC.Amount.Equal(alloraMath.NewDecFromInt64(202)), placementC.Amount.String())
+
+	// Slashing the first source still reaches the stake redelegated from it
+	slash, err := s.emissionsKeeper.SlashReputer(ctx, topicId, reputerA, fraction, alloraMath.OneDec())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(200), slash.DelegateStakeSlashed)
+	placementC, err = s.emissionsKeeper.GetDelegateStakePlacement(ctx, topicId, delegator, reputerC)
+	require.NoError(err)
+	require.True(placementC.Amount.Equal(alloraMath.NewDecFromInt64(102)), placementC.Amount.String())
+	fromB, found, err = s.emissionsKeeper.GetRedelegation(ctx, topicId, delegator, reputerB, reputerC)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(2), fromB.Amount)
+}
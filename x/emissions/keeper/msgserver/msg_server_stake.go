@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
@@ -30,6 +31,14 @@ func (ms msgServer) AddStake(ctx context.Context, msg *types.AddStakeRequest) (_
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	// Archived topics do not accept new stake anymore
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
 	// Check sender is registered in topic
 	isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
 	if err != nil {
@@ -53,7 +62,7 @@ func (ms msgServer) AddStake(ctx context.Context, msg *types.AddStakeRequest) (_
 		return nil, err
 	}
 
-	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.TopicId)
+	err = ms.k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, msg.TopicId)
 	return &types.AddStakeResponse{}, err
 }
 
@@ -74,6 +83,14 @@ func (ms msgServer) RemoveStake(ctx context.Context, msg *types.RemoveStakeReque
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	// No stake removals are queued on archived topics, their remaining stake is returned by the archival
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
 	// Check the sender has enough stake already placed on the topic to remove the stake
 	stakePlaced, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, msg.Sender)
 	if err != nil {
@@ -167,6 +184,14 @@ func (ms msgServer) DelegateStake(ctx context.Context, msg *types.DelegateStakeR
 		return nil, types.ErrTopicDoesNotExist
 	}
 
+	// Archived topics do not accept new stake anymore
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
 	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
 	if err != nil {
 		return nil, err
@@ -174,6 +199,18 @@ func (ms msgServer) DelegateStake(ctx context.Context, msg *types.DelegateStakeR
 		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "reputer address")
 	}
 
+	// Stake can only be delegated to reputers allowed to report on the topic
+	canReport, err := ms.k.CanActAsTopicReputer(ctx, msg.TopicId, msg.Reputer)
+	if err != nil {
+		return nil, err
+	} else if !canReport {
+		return nil, errorsmod.Wrap(types.ErrNotInReputerWhitelist, "reputer address")
+	}
+
+	if err := ms.k.CheckDelegateStakeLimits(ctx, msg.TopicId, msg.Reputer, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Check the sender has enough funds to delegate the stake
 	// bank module does this for us in module SendCoins / subUnlockedCoins so we don't need to check here
 	// Send the funds
@@ -189,7 +226,7 @@ func (ms msgServer) DelegateStake(ctx context.Context, msg *types.DelegateStakeR
 		return nil, err
 	}
 
-	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.TopicId)
+	err = ms.k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, msg.TopicId)
 	return &types.DelegateStakeResponse{}, err
 }
 
@@ -210,13 +247,16 @@ func (ms msgServer) RemoveDelegateStake(ctx context.Context, msg *types.RemoveDe
 		return nil, types.ErrTopicDoesNotExist
 	}
 
-	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
+	// No stake removals are queued on archived topics, their remaining stake is returned by the archival
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
 	if err != nil {
 		return nil, err
-	} else if !isRegistered {
-		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "reputer address")
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
 	}
 
+	// The reputer need not be registered anymore, delegators can leave reputers that were jailed or deregistered
+
 	// Check the delegator has enough stake already placed on the topic to remove the stake
 	delegateStakePlaced, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.Reputer)
 	if err != nil {
@@ -339,47 +379,144 @@ func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.RewardDe
 		return nil, types.ErrTopicDoesNotExist
 	}
 
-	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
+	// The reputer need not be registered anymore, delegators can claim rewards earned upon reputers that were jailed or deregistered
+	_, err = ms.k.ClaimDelegateReward(ctx, msg.TopicId, msg.Sender, msg.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RewardDelegateStakeResponse{}, nil
+}
+
+// Moves stake delegated upon a reputer to another reputer of the topic at once, without waiting for a stake removal.
+// The redelegated stake cannot be redelegated again before remove_stake_delay_window blocks have passed.
+func (ms msgServer) RedelegateStake(ctx context.Context, msg *types.RedelegateStakeRequest) (_ *types.RedelegateStakeResponse, err error) {
+	defer metrics.RecordMetrics("RedelegateStake", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	// The source reputer need not be registered anymore, as for removals of delegated stake
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.DstReputer)
 	if err != nil {
 		return nil, err
 	} else if !isRegistered {
-		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "reputer address")
+		return nil, errorsmod.Wrap(types.ErrAddressIsNotRegisteredInThisTopic, "destination reputer address")
+	}
+
+	canReport, err := ms.k.CanActAsTopicReputer(ctx, msg.TopicId, msg.DstReputer)
+	if err != nil {
+		return nil, err
+	} else if !canReport {
+		return nil, errorsmod.Wrap(types.ErrNotInReputerWhitelist, "destination reputer address")
 	}
 
-	delegateInfo, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.Reputer)
+	// Stake queued for removal from the source reputer cannot be redelegated
+	delegateStakePlaced, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.SrcReputer)
 	if err != nil {
 		return nil, err
 	}
-	share, err := ms.k.GetDelegateRewardPerShare(ctx, msg.TopicId, msg.Reputer)
+	removal, found, err := ms.k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
+		sdk.UnwrapSDKContext(ctx), msg.Sender, msg.SrcReputer, msg.TopicId,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error during finding delegate stake removal")
+	}
+	amount := msg.Amount
+	if found {
+		amount = amount.Add(removal.Amount)
+	}
+	amountDec, err := alloraMath.NewDecFromSdkInt(amount)
 	if err != nil {
 		return nil, err
 	}
-	pendingReward, err := delegateInfo.Amount.Mul(share)
+	if delegateStakePlaced.Amount.Lt(amountDec) {
+		return nil, types.ErrInsufficientDelegateStakeToRemove
+	}
+
+	if err := ms.k.CheckDelegateStakeLimits(ctx, msg.TopicId, msg.DstReputer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, err
 	}
-	pendingReward, err = pendingReward.Sub(delegateInfo.RewardDebt)
+	err = ms.k.RedelegateStake(
+		ctx,
+		msg.TopicId,
+		msg.Sender,
+		msg.SrcReputer,
+		msg.DstReputer,
+		msg.Amount,
+		moduleParams.RemoveStakeDelayWindow,
+	)
 	if err != nil {
 		return nil, err
 	}
-	if pendingReward.Gt(alloraMath.NewDecFromInt64(0)) {
-		pendingRewardInt, err := pendingReward.SdkIntTrim()
-		if err != nil {
-			return nil, err
-		}
-		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingRewardInt))
-		err = ms.k.SendCoinsFromModuleToAccount(ctx, types.AlloraPendingRewardForDelegatorAccountName, msg.Sender, coins)
+	return &types.RedelegateStakeResponse{}, nil
+}
+
+// Sets whether the pending rewards of the sender are restaked into its delegations at each payout, instead of
+// waiting to be claimed
+func (ms msgServer) SetDelegateRewardAutoCompound(ctx context.Context, msg *types.SetDelegateRewardAutoCompoundRequest) (_ *types.SetDelegateRewardAutoCompoundResponse, err error) {
+	defer metrics.RecordMetrics("SetDelegateRewardAutoCompound", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Opting in is tied to the delegations of the sender, which are indexed for compounding along with it
+	if msg.Enabled {
+		delegations, err := ms.k.GetDelegatorDelegations(ctx, msg.Sender)
 		if err != nil {
 			return nil, err
 		}
-		delegateInfo.RewardDebt, err = delegateInfo.Amount.Mul(share)
-		if err != nil {
-			return nil, err
+		if len(delegations) == 0 {
+			return nil, types.ErrNoDelegationToAutoCompound
 		}
-		err = ms.k.SetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.Reputer, delegateInfo)
+	}
+
+	err = ms.k.SetDelegateRewardAutoCompound(ctx, msg.Sender, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SetDelegateRewardAutoCompoundResponse{}, nil
+}
+
+// Pays out the pending rewards of every delegation of the sender, across all topics and reputers
+func (ms msgServer) ClaimAllDelegateRewards(ctx context.Context, msg *types.ClaimAllDelegateRewardsRequest) (_ *types.ClaimAllDelegateRewardsResponse, err error) {
+	defer metrics.RecordMetrics("ClaimAllDelegateRewards", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	delegations, err := ms.k.GetDelegatorDelegations(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	total := cosmosMath.ZeroInt()
+	for _, delegation := range delegations {
+		reward, err := ms.k.ClaimDelegateReward(ctx, delegation.K1(), msg.Sender, delegation.K2())
 		if err != nil {
 			return nil, err
 		}
+		total = total.Add(reward)
 	}
-	return &types.RewardDelegateStakeResponse{}, err
+	return &types.ClaimAllDelegateRewardsResponse{Amount: total}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"cosmossdk.io/collections"
 	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -43,6 +44,16 @@ func (s *MsgServerTestSuite) commonStakingSetup(
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	reputerInitialBalance := types.DefaultParams().CreateTopicFee.Add(reputerInitialBalanceUint)
@@ -1415,6 +1426,8 @@ func (s *MsgServerTestSuite) TestRewardDelegateStake() {
 			OneOutForecasterValues:        nil,
 			OneInForecasterValues:         nil,
 			OneOutInfererForecasterValues: nil,
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 		},
 		Signature: []byte{},
 	}
@@ -1481,6 +1494,8 @@ func (s *MsgServerTestSuite) TestRewardDelegateStake() {
 			OneOutForecasterValues:        nil,
 			OneInForecasterValues:         nil,
 			OneOutInfererForecasterValues: nil,
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 		},
 		Signature: []byte{},
 		Pubkey:    s.pubKeyHexStr[1],
@@ -1567,6 +1582,8 @@ func (s *MsgServerTestSuite) insertValueBundlesAndGetRewards(
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature := s.signValueBundle(valueBundle, reputerPrivKey)
 	reputerValueBundle := &types.ReputerValueBundle{
@@ -2086,3 +2103,307 @@ func (s *MsgServerTestSuite) TestCancelRemoveDelegateStakeNotExist() {
 	require.Error(err)
 	require.True(errors.Is(err, types.ErrStakeRemovalNotFound), "Expected delegate stake removal not found error")
 }
+
+func (s *MsgServerTestSuite) TestRedelegateStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer1 := s.addrsStr[1]
+	reputer2 := s.addrsStr[2]
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	for _, reputer := range []string{reputer1, reputer2} {
+		err := keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+		require.NoError(err)
+	}
+
+	_, err := s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer1,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	topicStake, err := keeper.GetTopicStake(ctx, topic.Id)
+	require.NoError(err)
+
+	// Rewards of 0.5 per share are pending upon the first reputer
+	err = keeper.SetDelegateRewardPerShare(ctx, topic.Id, reputer1, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(err)
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(50))
+	balanceBefore := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+
+	msg := &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topic.Id,
+		SrcReputer: reputer1,
+		DstReputer: reputer2,
+		Amount:     cosmosMath.NewInt(60),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.NoError(err)
+
+	// The pending rewards are paid out and the stake is moved without leaving the topic
+	balanceAfter := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	require.Equal(balanceBefore.Amount.Add(cosmosMath.NewInt(50)), balanceAfter.Amount)
+	topicStakeAfter, err := keeper.GetTopicStake(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(topicStake, topicStakeAfter)
+	placement1, err := keeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, reputer1)
+	require.NoError(err)
+	require.True(placement1.Amount.Equal(alloraMath.NewDecFromInt64(40)), placement1.Amount.String())
+	require.True(placement1.RewardDebt.Equal(alloraMath.NewDecFromInt64(20)), placement1.RewardDebt.String())
+	placement2, err := keeper.GetDelegateStakePlacement(ctx, topic.Id, delegator, reputer2)
+	require.NoError(err)
+	require.True(placement2.Amount.Equal(alloraMath.NewDecFromInt64(60)), placement2.Amount.String())
+	stakeUpon2, err := keeper.GetDelegateStakeUponReputer(ctx, topic.Id, reputer2)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(60), stakeUpon2)
+
+	// The redelegated stake cannot hop to another reputer before it matures
+	hopMsg := &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topic.Id,
+		SrcReputer: reputer2,
+		DstReputer: reputer1,
+		Amount:     cosmosMath.NewInt(10),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, hopMsg)
+	require.ErrorIs(err, types.ErrRedelegationNotMatured)
+
+	// More stake redelegated to the same reputer matures along with the previous redelegation
+	msg.Amount = cosmosMath.NewInt(10)
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.NoError(err)
+	redelegation, found, err := keeper.GetRedelegation(ctx, topic.Id, delegator, reputer1, reputer2)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(70), redelegation.Amount)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow)
+	require.Equal(ctx.BlockHeight(), redelegation.BlockMatured)
+	err = keeper.DeleteMaturedRedelegations(ctx, ctx.BlockHeight(), 10)
+	require.NoError(err)
+	_, found, err = keeper.GetRedelegation(ctx, topic.Id, delegator, reputer1, reputer2)
+	require.NoError(err)
+	require.False(found)
+
+	_, err = s.msgServer.RedelegateStake(ctx, hopMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestRedelegateStakeErrors() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer1 := s.addrsStr[1]
+	reputer2 := s.addrsStr[2]
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	err := keeper.InsertReputer(ctx, topic.Id, reputer1, types.OffchainNode{Owner: reputer1, NodeAddress: reputer1})
+	require.NoError(err)
+
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer1,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+
+	msg := &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topic.Id,
+		SrcReputer: reputer1,
+		DstReputer: reputer1,
+		Amount:     cosmosMath.NewInt(20),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	msg.DstReputer = reputer2
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+
+	err = keeper.InsertReputer(ctx, topic.Id, reputer2, types.OffchainNode{Owner: reputer2, NodeAddress: reputer2})
+	require.NoError(err)
+
+	// Stake queued for removal cannot be redelegated
+	_, err = s.msgServer.RemoveDelegateStake(ctx, &types.RemoveDelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer1,
+		Amount:  cosmosMath.NewInt(90),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRemove)
+
+	msg.Amount = cosmosMath.NewInt(10)
+	_, err = s.msgServer.RedelegateStake(ctx, msg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestDelegateStakeLimits() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer1 := s.addrsStr[1]
+	reputer2 := s.addrsStr[2]
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(10000))
+	for reputer, selfStake := range map[string]int64{reputer1: 100, reputer2: 1000} {
+		err := keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+		require.NoError(err)
+		err = keeper.AddReputerStake(ctx, topic.Id, reputer, cosmosMath.NewInt(selfStake))
+		require.NoError(err)
+	}
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxDelegateToSelfStakeRatio = alloraMath.MustNewDecFromString("2")
+	moduleParams.MaxDelegateStakePerReputer = cosmosMath.NewInt(300)
+	err = keeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	delegate := func(reputer string, amount int64) error {
+		_, err := s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+			Sender:  delegator,
+			TopicId: topic.Id,
+			Reputer: reputer,
+			Amount:  cosmosMath.NewInt(amount),
+		})
+		return err
+	}
+
+	// At most twice the own stake of the reputer can be delegated upon it
+	require.NoError(delegate(reputer1, 150))
+	require.ErrorIs(delegate(reputer1, 60), types.ErrDelegateStakeExceedsSelfStakeRatio)
+	require.NoError(delegate(reputer1, 50))
+
+	// No more than the cap can be delegated upon a reputer, whatever its own stake
+	require.ErrorIs(delegate(reputer2, 301), types.ErrDelegateStakeExceedsReputerCap)
+	require.NoError(delegate(reputer2, 300))
+	stakeUpon2, err := keeper.GetDelegateStakeUponReputer(ctx, topic.Id, reputer2)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(300), stakeUpon2)
+
+	// Redelegations are held to the limits of the destination reputer
+	_, err = s.msgServer.RedelegateStake(ctx, &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topic.Id,
+		SrcReputer: reputer2,
+		DstReputer: reputer1,
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.ErrorIs(err, types.ErrDelegateStakeExceedsSelfStakeRatio)
+}
+
+func (s *MsgServerTestSuite) TestClaimAllDelegateRewards() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer1 := s.addrsStr[1]
+	reputer2 := s.addrsStr[2]
+	topic1 := s.CreateOneTopic()
+	topic2 := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+
+	// The delegator delegates upon two reputers in the first topic and one in the second
+	for _, delegation := range []struct {
+		topicId uint64
+		reputer string
+	}{
+		{topicId: topic1.Id, reputer: reputer1},
+		{topicId: topic1.Id, reputer: reputer2},
+		{topicId: topic2.Id, reputer: reputer1},
+	} {
+		err := keeper.InsertReputer(ctx, delegation.topicId, delegation.reputer, types.OffchainNode{Owner: delegation.reputer, NodeAddress: delegation.reputer})
+		require.NoError(err)
+		_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+			Sender:  delegator,
+			TopicId: delegation.topicId,
+			Reputer: delegation.reputer,
+			Amount:  cosmosMath.NewInt(100),
+		})
+		require.NoError(err)
+		err = keeper.SetDelegateRewardPerShare(ctx, delegation.topicId, delegation.reputer, alloraMath.MustNewDecFromString("0.1"))
+		require.NoError(err)
+	}
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(30))
+
+	delegations, err := keeper.GetDelegatorDelegations(ctx, delegator)
+	require.NoError(err)
+	require.Len(delegations, 3)
+
+	balanceBefore := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	response, err := s.msgServer.ClaimAllDelegateRewards(ctx, &types.ClaimAllDelegateRewardsRequest{Sender: delegator})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(30), response.Amount)
+	balanceAfter := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	require.Equal(balanceBefore.Amount.Add(cosmosMath.NewInt(30)), balanceAfter.Amount)
+
+	// Nothing is left to claim once the reward debts are settled
+	response, err = s.msgServer.ClaimAllDelegateRewards(ctx, &types.ClaimAllDelegateRewardsRequest{Sender: delegator})
+	require.NoError(err)
+	require.True(response.Amount.IsZero())
+}
+
+func (s *MsgServerTestSuite) TestSetDelegateRewardAutoCompound() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+
+	// Only delegators with delegations can opt in
+	_, err := s.msgServer.SetDelegateRewardAutoCompound(ctx, &types.SetDelegateRewardAutoCompoundRequest{
+		Sender:  delegator,
+		Enabled: true,
+	})
+	require.ErrorIs(err, types.ErrNoDelegationToAutoCompound)
+
+	topic := s.CreateOneTopic()
+	err = k.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+	s.MintTokensToAddress(s.addrs[0], cosmosMath.NewInt(1000))
+	_, err = s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+
+	for _, enabled := range []bool{true, false} {
+		_, err := s.msgServer.SetDelegateRewardAutoCompound(ctx, &types.SetDelegateRewardAutoCompoundRequest{
+			Sender:  delegator,
+			Enabled: enabled,
+		})
+		require.NoError(err)
+		isAutoCompound, err := k.IsDelegateRewardAutoCompound(ctx, delegator)
+		require.NoError(err)
+		require.Equal(enabled, isAutoCompound)
+		delegations, err := k.GetAutoCompoundDelegationsInTopic(ctx, topic.Id)
+		require.NoError(err)
+		if enabled {
+			require.Equal([]collections.Pair[string, string]{collections.Join(reputer, delegator)}, delegations)
+		} else {
+			require.Empty(delegations)
+		}
+	}
+}
@@ -32,6 +32,14 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	if uint64(msg.GroundTruthLag) > params.MaxUnfulfilledReputerRequests*uint64(msg.EpochLength) {
 		return nil, types.ErrGroundTruthLagTooBig
 	}
+	if msg.CreatorCommission.Gt(params.MaxTopicCreatorCommission) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCreatorCommission, "cannot exceed %s", params.MaxTopicCreatorCommission)
+	}
+	if msg.GroundTruthSource != nil {
+		if err := ms.k.ValidateGroundTruthSource(ctx, topicId, msg.GroundTruthSource); err != nil {
+			return nil, err
+		}
+	}
 
 	// Before creating topic, transfer fee amount from creator to ecosystem bucket
 	err = checkBalanceAndSendFee(ctx, ms, msg.Creator, params.CreateTopicFee)
@@ -43,6 +51,16 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 		Id:                       topicId,
 		Creator:                  msg.Creator,
 		Metadata:                 msg.Metadata,
+		TypedMetadata:            msg.TypedMetadata,
+		ChainComputedLosses:      msg.ChainComputedLosses,
+		OutputDimension:          msg.OutputDimension,
+		QuantileLevels:           msg.QuantileLevels,
+		GroundTruthSource:        msg.GroundTruthSource,
+		CreatorCommission:        msg.CreatorCommission,
+		WorkerRevealWindow:       msg.WorkerRevealWindow,
+		ReputerRevealWindow:      msg.ReputerRevealWindow,
+		MinWorkerBond:            msg.MinWorkerBond,
+		ReputerOutlierThreshold:  msg.ReputerOutlierThreshold,
 		LossMethod:               msg.LossMethod,
 		EpochLastEnded:           0,
 		EpochLength:              msg.EpochLength,
@@ -69,3 +87,170 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.CreateNewTopi
 	err = ms.k.AddTopicFeeRevenue(ctx, topicId, params.CreateTopicFee)
 	return &types.CreateNewTopicResponse{TopicId: topicId}, err
 }
+
+func (ms msgServer) UpdateTopic(ctx context.Context, msg *types.UpdateTopicRequest) (_ *types.UpdateTopicResponse, err error) {
+	defer metrics.RecordMetrics("UpdateTopic", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	// Only the topic creator or a whitelist admin can update the topic
+	if msg.Sender != topic.Creator {
+		isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !isAdmin {
+			return nil, types.ErrNotTopicCreatorOrAdmin
+		}
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+	}
+
+	// Updates accumulate on top of any update still waiting for the epoch boundary
+	pending, found, err := ms.k.GetPendingTopicUpdate(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		topic = pending
+	}
+
+	if len(msg.Metadata) == 1 {
+		topic.Metadata = msg.Metadata[0]
+	}
+	if len(msg.TypedMetadata) == 1 {
+		topic.TypedMetadata = msg.TypedMetadata[0]
+	}
+	if len(msg.EpochLength) == 1 {
+		topic.EpochLength = msg.EpochLength[0]
+	}
+	if len(msg.GroundTruthLag) == 1 {
+		topic.GroundTruthLag = msg.GroundTruthLag[0]
+	}
+	if len(msg.WorkerSubmissionWindow) == 1 {
+		topic.WorkerSubmissionWindow = msg.WorkerSubmissionWindow[0]
+	}
+	if len(msg.PNorm) == 1 {
+		topic.PNorm = msg.PNorm[0]
+	}
+	if len(msg.AlphaRegret) == 1 {
+		topic.AlphaRegret = msg.AlphaRegret[0]
+	}
+	if len(msg.Epsilon) == 1 {
+		topic.Epsilon = msg.Epsilon[0]
+	}
+	if len(msg.MeritSortitionAlpha) == 1 {
+		topic.MeritSortitionAlpha = msg.MeritSortitionAlpha[0]
+	}
+	if len(msg.ActiveInfererQuantile) == 1 {
+		topic.ActiveInfererQuantile = msg.ActiveInfererQuantile[0]
+	}
+	if len(msg.ActiveForecasterQuantile) == 1 {
+		topic.ActiveForecasterQuantile = msg.ActiveForecasterQuantile[0]
+	}
+	if len(msg.ActiveReputerQuantile) == 1 {
+		topic.ActiveReputerQuantile = msg.ActiveReputerQuantile[0]
+	}
+	if len(msg.GroundTruthSource) == 1 {
+		if err := ms.k.ValidateGroundTruthSource(ctx, msg.TopicId, msg.GroundTruthSource[0]); err != nil {
+			return nil, err
+		}
+		topic.GroundTruthSource = msg.GroundTruthSource[0]
+	}
+	if len(msg.CreatorCommission) == 1 {
+		if msg.CreatorCommission[0].Gt(params.MaxTopicCreatorCommission) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidCreatorCommission, "cannot exceed %s", params.MaxTopicCreatorCommission)
+		}
+		topic.CreatorCommission = msg.CreatorCommission[0]
+	}
+	if len(msg.WorkerRevealWindow) == 1 {
+		topic.WorkerRevealWindow = msg.WorkerRevealWindow[0]
+	}
+	if len(msg.ReputerRevealWindow) == 1 {
+		topic.ReputerRevealWindow = msg.ReputerRevealWindow[0]
+	}
+	if len(msg.MinWorkerBond) == 1 {
+		topic.MinWorkerBond = msg.MinWorkerBond[0]
+	}
+	if len(msg.ReputerOutlierThreshold) == 1 {
+		topic.ReputerOutlierThreshold = msg.ReputerOutlierThreshold[0]
+	}
+
+	if topic.EpochLength < params.MinEpochLength {
+		return nil, types.ErrTopicCadenceBelowMinimum
+	}
+	if uint64(topic.GroundTruthLag) > params.MaxUnfulfilledReputerRequests*uint64(topic.EpochLength) {
+		return nil, types.ErrGroundTruthLagTooBig
+	}
+	if err := topic.Validate(params); err != nil {
+		return nil, err
+	}
+
+	// The update is applied at the next epoch boundary of the topic
+	if err := ms.k.SetPendingTopicUpdate(ctx, msg.TopicId, topic); err != nil {
+		return nil, err
+	}
+	return &types.UpdateTopicResponse{}, nil
+}
+
+// ArchiveTopic retires a topic. New submissions, registrations and stake are refused right away,
+// while stake removals already queued for the topic are left to complete. Afterwards the remaining
+// stake is returned to reputers and delegators and the topic's state is pruned by the ABCI endBlocker.
+func (ms msgServer) ArchiveTopic(ctx context.Context, msg *types.ArchiveTopicRequest) (_ *types.ArchiveTopicResponse, err error) {
+	defer metrics.RecordMetrics("ArchiveTopic", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	// Only the topic creator or a whitelist admin can archive the topic
+	if msg.Sender != topic.Creator {
+		isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+		if err != nil {
+			return nil, err
+		} else if !isAdmin {
+			return nil, types.ErrNotTopicCreatorOrAdmin
+		}
+	}
+
+	// Derived topics would be left without ground truth
+	derivedTopicIds, err := ms.k.GetDerivedTopicIds(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if len(derivedTopicIds) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrTopicHasDerivedTopics, "topics %v", derivedTopicIds)
+	}
+
+	if err := ms.k.ArchiveTopic(ctx, msg.TopicId); err != nil {
+		return nil, err
+	}
+	return &types.ArchiveTopicResponse{}, nil
+}
@@ -1,10 +1,15 @@
 package msgserver_test
 
 import (
+	"fmt"
 	"strings"
 
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 /// Topics tests
@@ -32,6 +37,16 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopic() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -76,6 +91,16 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithEpsilonZeroFails() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -125,6 +150,16 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicTooLongMetadataFails() {
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		Metadata:                 strings.Repeat("a", 257),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -157,6 +192,16 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicTooLongLossMethodFails() {
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		LossMethod:               strings.Repeat("a", 257),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
@@ -166,3 +211,934 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicTooLongLossMethodFails() {
 	require.Nil(result)
 	require.ErrorContains(err, "loss method invalid")
 }
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicUnregisteredLossMethodFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := s.addrs[0]
+	sender := s.addrsStr[0]
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  sender,
+		Metadata:                 "Some metadata for the new topic",
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		WorkerSubmissionWindow:   10,
+		AllowNegative:            false,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		LossMethod:               "rmse",
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrUnregisteredLossMethod)
+	require.Nil(result)
+
+	// Quantile loss methods are reserved to probabilistic topics
+	newTopicMsg.LossMethod = types.LossMethodPinball
+	result, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrUnregisteredLossMethod)
+	require.Nil(result)
+
+	newTopicMsg.LossMethod = types.LossMethodHuber
+	newTopicMsg.ChainComputedLosses = true
+	result, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(types.LossMethodHuber, topic.LossMethod)
+	require.True(topic.ChainComputedLosses)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithCreatorCommission() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := s.addrs[0]
+	sender := s.addrsStr[0]
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  sender,
+		Metadata:                 "test",
+		LossMethod:               "mse",
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		WorkerSubmissionWindow:   10,
+		AllowNegative:            false,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.MustNewDecFromString("0.15"),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+	}
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee.MulRaw(2))
+
+	// The commission cannot exceed the max topic creator commission
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidCreatorCommission)
+
+	newTopicMsg.CreatorCommission = alloraMath.MustNewDecFromString("1")
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidCreatorCommission)
+
+	newTopicMsg.CreatorCommission = alloraMath.MustNewDecFromString("0.05")
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(alloraMath.MustNewDecFromString("0.05"), topic.CreatorCommission)
+
+	// Updates are held to the same cap
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:                  sender,
+		TopicId:                 result.TopicId,
+		CreatorCommission:       []alloraMath.Dec{alloraMath.MustNewDecFromString("0.2")},
+		WorkerRevealWindow:      nil,
+		ReputerRevealWindow:     nil,
+		MinWorkerBond:           nil,
+		ReputerOutlierThreshold: nil,
+	})
+	require.ErrorIs(err, types.ErrInvalidCreatorCommission)
+
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{
+		Sender:                  sender,
+		TopicId:                 result.TopicId,
+		CreatorCommission:       []alloraMath.Dec{alloraMath.MustNewDecFromString("0.1")},
+		WorkerRevealWindow:      nil,
+		ReputerRevealWindow:     nil,
+		MinWorkerBond:           nil,
+		ReputerOutlierThreshold: nil,
+	})
+	require.NoError(err)
+	pending, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(alloraMath.MustNewDecFromString("0.1"), pending.CreatorCommission)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewProbabilisticTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := s.addrs[0]
+	sender := s.addrsStr[0]
+
+	levels := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("0.9"),
+	}
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  sender,
+		Metadata:                 "Some metadata for the new topic",
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		WorkerSubmissionWindow:   10,
+		AllowNegative:            false,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		LossMethod:               types.LossMethodMSE,
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          3,
+		QuantileLevels:           levels,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	// Quantiles are scored by a proper scoring rule only
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidQuantileLevels)
+
+	// One quantile per output
+	newTopicMsg.LossMethod = types.LossMethodPinball
+	newTopicMsg.OutputDimension = 2
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidQuantileLevels)
+
+	newTopicMsg.OutputDimension = 3
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(topic.IsProbabilistic())
+	require.Equal(levels, topic.QuantileLevels)
+}
+
+func (s *MsgServerTestSuite) createDerivedTopic(parentTopicId uint64, aggregation string) (uint64, error) {
+	creator := 9
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  s.addrsStr[creator],
+		Metadata:                 "Derived topic",
+		LossMethod:               types.LossMethodMSE,
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		AllowNegative:            true,
+		WorkerSubmissionWindow:   10,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource: &types.GroundTruthSource{
+			ParentTopicId: parentTopicId,
+			Aggregation:   aggregation,
+		},
+		CreatorCommission:       alloraMath.ZeroDec(),
+		WorkerRevealWindow:      0,
+		ReputerRevealWindow:     0,
+		MinWorkerBond:           cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold: alloraMath.ZeroDec(),
+	}
+
+	s.MintTokensToAddress(s.addrs[creator], types.DefaultParams().CreateTopicFee)
+
+	result, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
+	if err != nil {
+		return 0, err
+	}
+	return result.TopicId, nil
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewDerivedTopic() {
+	ctx := s.ctx
+	require := s.Require()
+
+	parent := s.CreateOneTopic()
+
+	// The parent topic has to exist
+	_, err := s.createDerivedTopic(parent.Id+100, types.GroundTruthAggregationDifference)
+	require.ErrorIs(err, types.ErrInvalidGroundTruthSource)
+
+	_, err = s.createDerivedTopic(parent.Id, "median")
+	require.ErrorIs(err, types.ErrInvalidGroundTruthSource)
+
+	childId, err := s.createDerivedTopic(parent.Id, types.GroundTruthAggregationDifference)
+	require.NoError(err)
+
+	child, err := s.emissionsKeeper.GetTopic(ctx, childId)
+	require.NoError(err)
+	require.True(child.IsDerived())
+	require.Equal(parent.Id, child.GroundTruthSource.ParentTopicId)
+
+	derived, err := s.emissionsKeeper.GetDerivedTopicIds(ctx, parent.Id)
+	require.NoError(err)
+	require.Equal([]uint64{childId}, derived)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicGroundTruthSourceCycleFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	parent := s.CreateOneTopic()
+	childId, err := s.createDerivedTopic(parent.Id, types.GroundTruthAggregationValueAtBlock)
+	require.NoError(err)
+	grandchildId, err := s.createDerivedTopic(childId, types.GroundTruthAggregationRatio)
+	require.NoError(err)
+
+	// The root topic cannot derive its ground truth from one of its descendants
+	msg := &types.UpdateTopicRequest{
+		Sender:  parent.Creator,
+		TopicId: parent.Id,
+		GroundTruthSource: []*types.GroundTruthSource{{
+			ParentTopicId: grandchildId,
+			Aggregation:   types.GroundTruthAggregationValueAtBlock,
+		}},
+		CreatorCommission:       nil,
+		WorkerRevealWindow:      nil,
+		ReputerRevealWindow:     nil,
+		MinWorkerBond:           nil,
+		ReputerOutlierThreshold: nil,
+	}
+	_, err = msgServer.UpdateTopic(ctx, msg)
+	require.ErrorIs(err, types.ErrTopicDependencyCycle)
+
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, parent.Id)
+	require.NoError(err)
+	require.False(found)
+
+	// Nor from itself
+	msg.GroundTruthSource[0].ParentTopicId = parent.Id
+	_, err = msgServer.UpdateTopic(ctx, msg)
+	require.ErrorIs(err, types.ErrTopicDependencyCycle)
+}
+
+func (s *MsgServerTestSuite) TestMsgArchiveTopicWithDerivedTopicsFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	parent := s.CreateOneTopic()
+	childId, err := s.createDerivedTopic(parent.Id, types.GroundTruthAggregationDifference)
+	require.NoError(err)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: parent.Creator, TopicId: parent.Id})
+	require.ErrorIs(err, types.ErrTopicHasDerivedTopics)
+
+	// Once the derived topic is archived the parent can follow
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: parent.Creator, TopicId: childId})
+	require.NoError(err)
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: parent.Creator, TopicId: parent.Id})
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithTypedMetadata() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := s.addrs[0]
+	sender := s.addrsStr[0]
+
+	typedMetadata := &types.TopicMetadata{
+		Target:                   "ETH/USD",
+		Unit:                     "USD",
+		PredictionHorizonSeconds: 300,
+		Tags:                     []string{"crypto", "eth"},
+		Category:                 "price",
+	}
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  sender,
+		Metadata:                 "ETH price in 5 minutes",
+		LossMethod:               "mse",
+		EpochLength:              10800,
+		GroundTruthLag:           10800,
+		WorkerSubmissionWindow:   10,
+		AllowNegative:            false,
+		AlphaRegret:              alloraMath.NewDecFromInt64(1),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            typedMetadata,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+	}
+
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(typedMetadata, topic.TypedMetadata)
+
+	filter := keeper.TopicFilter{Tag: "eth", Category: "", Creator: "", LossMethod: "", IsActive: nil}
+	topics, _, err := s.emissionsKeeper.GetTopics(ctx, filter, nil)
+	require.NoError(err)
+	require.Len(topics, 1)
+	require.Equal(result.TopicId, topics[0].Id)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicInvalidTypedMetadataFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	senderAddr := s.addrs[0]
+	sender := s.addrsStr[0]
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee)
+
+	tooManyTags := make([]string, types.MaxTopicMetadataTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = fmt.Sprintf("tag%d", i)
+	}
+	testCases := []struct {
+		name          string
+		typedMetadata *types.TopicMetadata
+		expectedErr   string
+	}{
+		{
+			name:          "uppercase tag",
+			typedMetadata: &types.TopicMetadata{Target: "", Unit: "", PredictionHorizonSeconds: 0, Tags: []string{"ETH"}, Category: ""},
+			expectedErr:   "label can only contain",
+		},
+		{
+			name:          "empty tag",
+			typedMetadata: &types.TopicMetadata{Target: "", Unit: "", PredictionHorizonSeconds: 0, Tags: []string{""}, Category: ""},
+			expectedErr:   "label must have between",
+		},
+		{
+			name:          "duplicate tag",
+			typedMetadata: &types.TopicMetadata{Target: "", Unit: "", PredictionHorizonSeconds: 0, Tags: []string{"eth", "eth"}, Category: ""},
+			expectedErr:   "duplicate tag",
+		},
+		{
+			name:          "too many tags",
+			typedMetadata: &types.TopicMetadata{Target: "", Unit: "", PredictionHorizonSeconds: 0, Tags: tooManyTags, Category: ""},
+			expectedErr:   "tags are allowed",
+		},
+		{
+			name:          "category with spaces",
+			typedMetadata: &types.TopicMetadata{Target: "", Unit: "", PredictionHorizonSeconds: 0, Tags: nil, Category: "price prediction"},
+			expectedErr:   "category",
+		},
+		{
+			name:          "negative prediction horizon",
+			typedMetadata: &types.TopicMetadata{Target: "", Unit: "", PredictionHorizonSeconds: -1, Tags: nil, Category: ""},
+			expectedErr:   "prediction horizon cannot be negative",
+		},
+		{
+			name:          "target too long",
+			typedMetadata: &types.TopicMetadata{Target: strings.Repeat("a", 257), Unit: "", PredictionHorizonSeconds: 0, Tags: nil, Category: ""},
+			expectedErr:   "target too long",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			newTopicMsg := &types.CreateNewTopicRequest{
+				Creator:                  sender,
+				Metadata:                 "Some metadata for the new topic",
+				LossMethod:               "mse",
+				EpochLength:              10800,
+				GroundTruthLag:           10800,
+				WorkerSubmissionWindow:   10,
+				AllowNegative:            false,
+				AlphaRegret:              alloraMath.NewDecFromInt64(1),
+				PNorm:                    alloraMath.NewDecFromInt64(3),
+				Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+				MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+				ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+				ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+				ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+				TypedMetadata:            tc.typedMetadata,
+				ChainComputedLosses:      false,
+				OutputDimension:          0,
+				QuantileLevels:           nil,
+				GroundTruthSource:        nil,
+				CreatorCommission:        alloraMath.ZeroDec(),
+				WorkerRevealWindow:       0,
+				ReputerRevealWindow:      0,
+				MinWorkerBond:            cosmosMath.ZeroInt(),
+				ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+			}
+			result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+			require.ErrorContains(err, tc.expectedErr)
+			require.Nil(result)
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicTypedMetadata() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	typedMetadata := &types.TopicMetadata{
+		Target:                   "BTC/USD",
+		Unit:                     "USD",
+		PredictionHorizonSeconds: 3600,
+		Tags:                     []string{"btc"},
+		Category:                 "price",
+	}
+
+	msg := &types.UpdateTopicRequest{
+		Sender:                  topic.Creator,
+		TopicId:                 topic.Id,
+		TypedMetadata:           []*types.TopicMetadata{typedMetadata},
+		GroundTruthSource:       nil,
+		CreatorCommission:       nil,
+		WorkerRevealWindow:      nil,
+		ReputerRevealWindow:     nil,
+		MinWorkerBond:           nil,
+		ReputerOutlierThreshold: nil,
+	}
+	_, err := msgServer.UpdateTopic(ctx, msg)
+	require.NoError(err)
+
+	// The topic is only listed under its new tag once the update is applied
+	filter := keeper.TopicFilter{Tag: "btc", Category: "", Creator: "", LossMethod: "", IsActive: nil}
+	topics, _, err := s.emissionsKeeper.GetTopics(ctx, filter, nil)
+	require.NoError(err)
+	require.Empty(topics)
+
+	err = s.emissionsKeeper.ApplyPendingTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+
+	stored, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(typedMetadata, stored.TypedMetadata)
+	topics, _, err = s.emissionsKeeper.GetTopics(ctx, filter, nil)
+	require.NoError(err)
+	require.Len(topics, 1)
+
+	msg.TypedMetadata = []*types.TopicMetadata{{Target: "", Unit: "", PredictionHorizonSeconds: 0, Tags: []string{"Not A Tag"}, Category: ""}}
+	_, err = msgServer.UpdateTopic(ctx, msg)
+	require.ErrorContains(err, "label can only contain")
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+
+	msg := &types.UpdateTopicRequest{
+		Sender:                 topic.Creator,
+		TopicId:                topic.Id,
+		EpochLength:            []int64{7200},
+		GroundTruthLag:         []int64{7200},
+		WorkerSubmissionWindow: []int64{20},
+		MeritSortitionAlpha:    []alloraMath.Dec{alloraMath.MustNewDecFromString("0.3")},
+	}
+	_, err := msgServer.UpdateTopic(ctx, msg)
+	require.NoError(err)
+
+	// The topic itself only changes at the next epoch boundary
+	stored, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	require.NoError(err)
+	require.Equal(topic.EpochLength, stored.EpochLength)
+	require.Equal(topic.WorkerSubmissionWindow, stored.WorkerSubmissionWindow)
+
+	pending, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(int64(7200), pending.EpochLength)
+	require.Equal(int64(7200), pending.GroundTruthLag)
+	require.Equal(int64(20), pending.WorkerSubmissionWindow)
+	require.Equal(alloraMath.MustNewDecFromString("0.3"), pending.MeritSortitionAlpha)
+	require.Equal(topic.ActiveInfererQuantile, pending.ActiveInfererQuantile)
+
+	// A second update builds on top of the pending one
+	msg = &types.UpdateTopicRequest{
+		Sender:                topic.Creator,
+		TopicId:               topic.Id,
+		ActiveReputerQuantile: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")},
+	}
+	_, err = msgServer.UpdateTopic(ctx, msg)
+	require.NoError(err)
+
+	pending, found, err = s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(int64(7200), pending.EpochLength)
+	require.Equal(alloraMath.MustNewDecFromString("0.5"), pending.ActiveReputerQuantile)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicByWhitelistAdmin() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	require.NotEqual(s.addrsStr[0], topic.Creator)
+
+	msg := &types.UpdateTopicRequest{
+		Sender:   s.addrsStr[0],
+		TopicId:  topic.Id,
+		Metadata: []string{"new metadata"},
+	}
+	_, err := msgServer.UpdateTopic(ctx, msg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicNotCreatorOrAdminFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+
+	msg := &types.UpdateTopicRequest{
+		Sender:      nonAdminAccounts[0].String(),
+		TopicId:     topic.Id,
+		EpochLength: []int64{7200},
+	}
+	_, err := msgServer.UpdateTopic(ctx, msg)
+	require.ErrorIs(err, types.ErrNotTopicCreatorOrAdmin)
+
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateTopicInvalidValuesFail() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+
+	testCases := []struct {
+		name        string
+		msg         *types.UpdateTopicRequest
+		expectedErr string
+	}{
+		{
+			name:        "no field updated",
+			msg:         &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id},
+			expectedErr: "at least one topic field must be updated",
+		},
+		{
+			name:        "more than one value for a field",
+			msg:         &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id, EpochLength: []int64{7200, 3600}},
+			expectedErr: "at most one value",
+		},
+		{
+			name:        "worker submission window above epoch length",
+			msg:         &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id, WorkerSubmissionWindow: []int64{topic.EpochLength + 1}},
+			expectedErr: "worker submission window cannot be higher than epoch length",
+		},
+		{
+			name:        "ground truth lag below epoch length",
+			msg:         &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id, EpochLength: []int64{20000}},
+			expectedErr: "ground truth lag cannot be lower than epoch length",
+		},
+		{
+			name:        "quantile above one",
+			msg:         &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id, ActiveForecasterQuantile: []alloraMath.Dec{alloraMath.MustNewDecFromString("1.5")}},
+			expectedErr: "active forecaster quantile must be between 0 and 1 inclusive",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.UpdateTopic(ctx, tc.msg)
+			require.ErrorContains(err, tc.expectedErr)
+		})
+	}
+
+	msg := &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id, EpochLength: []int64{1}, GroundTruthLag: []int64{1}, WorkerSubmissionWindow: []int64{1}}
+	_, err := msgServer.UpdateTopic(ctx, msg)
+	require.ErrorIs(err, types.ErrTopicCadenceBelowMinimum)
+
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id + 1, Metadata: []string{"x"}})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestMsgArchiveTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	err := s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	require.NoError(err)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+
+	archived, err := s.emissionsKeeper.IsTopicArchived(ctx, topic.Id)
+	require.NoError(err)
+	require.True(archived)
+	isActive, err := s.emissionsKeeper.IsTopicActive(ctx, topic.Id)
+	require.NoError(err)
+	require.False(isActive)
+
+	archive, found, err := s.emissionsKeeper.GetTopicArchive(ctx, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(topic.Id, archive.Topic.Id)
+	require.Equal(ctx.BlockHeight()+types.DefaultParams().RemoveStakeDelayWindow, archive.StakeRemovalsDueBlockHeight)
+	require.False(archive.StakeReturned)
+	require.False(archive.Pruned)
+
+	// Archived topics can be neither archived again nor updated
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrTopicArchived)
+	_, err = msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{Sender: topic.Creator, TopicId: topic.Id, Metadata: []string{"x"}})
+	require.ErrorIs(err, types.ErrTopicArchived)
+
+	// No new participants, stake nor funds are accepted anymore
+	_, err = msgServer.Register(ctx, &types.RegisterRequest{Sender: s.addrsStr[1], Owner: s.addrsStr[1], TopicId: topic.Id, IsReputer: true})
+	require.ErrorIs(err, types.ErrTopicArchived)
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{Sender: s.addrsStr[1], TopicId: topic.Id, Amount: cosmosMath.NewInt(10)})
+	require.ErrorIs(err, types.ErrTopicArchived)
+	_, err = msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{Sender: s.addrsStr[2], TopicId: topic.Id, Reputer: s.addrsStr[1], Amount: cosmosMath.NewInt(10)})
+	require.ErrorIs(err, types.ErrTopicArchived)
+	_, err = msgServer.FundTopic(ctx, &types.FundTopicRequest{Sender: s.addrsStr[1], TopicId: topic.Id, Amount: cosmosMath.NewInt(10)})
+	require.ErrorIs(err, types.ErrTopicArchived)
+}
+
+func (s *MsgServerTestSuite) TestMsgArchiveTopicNotCreatorOrAdminFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+
+	_, err := msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: nonAdminAccounts[0].String(), TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrNotTopicCreatorOrAdmin)
+
+	archived, err := s.emissionsKeeper.IsTopicArchived(ctx, topic.Id)
+	require.NoError(err)
+	require.False(archived)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: topic.Creator, TopicId: topic.Id + 1})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestArchivedTopicStakeReturnedAndStatePruned() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+	delegatorAddr := s.addrs[2]
+	delegator := s.addrsStr[2]
+	reputerStake := cosmosMath.NewInt(100)
+	delegateStake := cosmosMath.NewInt(50)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	registrationInitialBalance := moduleParams.RegistrationFee.Add(reputerStake)
+
+	topicId := s.commonStakingSetup(ctx, reputer, reputerAddr, worker, workerAddr, registrationInitialBalance)
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{Sender: reputer, TopicId: topicId, Amount: reputerStake})
+	require.NoError(err)
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	_, err = msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{Sender: delegator, TopicId: topicId, Reputer: reputer, Amount: delegateStake})
+	require.NoError(err)
+	_, err = msgServer.RemoveStake(ctx, &types.RemoveStakeRequest{Sender: reputer, TopicId: topicId, Amount: cosmosMath.NewInt(30)})
+	require.NoError(err)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: reputer, TopicId: topicId})
+	require.NoError(err)
+
+	// Stake removals can no longer be queued, the whole stake is returned by the archival instead
+	_, err = msgServer.RemoveDelegateStake(ctx, &types.RemoveDelegateStakeRequest{Sender: delegator, TopicId: topicId, Reputer: reputer, Amount: delegateStake})
+	require.ErrorIs(err, types.ErrTopicArchived)
+
+	archive, found, err := keeper.GetTopicArchive(ctx, topicId)
+	require.NoError(err)
+	require.True(found)
+	ctx = ctx.WithBlockHeight(archive.StakeRemovalsDueBlockHeight)
+
+	reputerBalanceBefore := s.bankKeeper.GetBalance(ctx, reputerAddr, params.DefaultBondDenom)
+	delegatorBalanceBefore := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+
+	// Delegators get their stake back first
+	processed, done, err := keeper.ReturnArchivedTopicStake(ctx, topicId, 1)
+	require.NoError(err)
+	require.Equal(uint64(1), processed)
+	require.False(done)
+	delegatorBalanceAfter := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	require.Equal(delegatorBalanceBefore.Amount.Add(delegateStake), delegatorBalanceAfter.Amount)
+
+	processed, done, err = keeper.ReturnArchivedTopicStake(ctx, topicId, 10)
+	require.NoError(err)
+	require.Equal(uint64(1), processed)
+	require.True(done)
+	reputerBalanceAfter := s.bankKeeper.GetBalance(ctx, reputerAddr, params.DefaultBondDenom)
+	require.Equal(reputerBalanceBefore.Amount.Add(reputerStake), reputerBalanceAfter.Amount)
+
+	topicStake, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.True(topicStake.IsZero())
+	_, found, err = keeper.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	require.NoError(err)
+	require.False(found)
+
+	// State is pruned in bounded chunks, the topic itself going last
+	iterations := 0
+	for {
+		deleted, done, err := keeper.PruneArchivedTopicState(ctx, topicId, 2)
+		require.NoError(err)
+		require.LessOrEqual(deleted, uint64(2))
+		iterations++
+		if done {
+			break
+		}
+		topicExists, err := keeper.TopicExists(ctx, topicId)
+		require.NoError(err)
+		require.True(topicExists)
+	}
+	require.Greater(iterations, 1)
+
+	topicExists, err := keeper.TopicExists(ctx, topicId)
+	require.NoError(err)
+	require.False(topicExists)
+	isRegistered, err := keeper.IsReputerRegisteredInTopic(ctx, topicId, reputer)
+	require.NoError(err)
+	require.False(isRegistered)
+	reputerTopicIds, err := keeper.GetActorTopicIds(ctx, reputer, true)
+	require.NoError(err)
+	require.NotContains(reputerTopicIds, topicId)
+	archived, err := keeper.IsTopicArchived(ctx, topicId)
+	require.NoError(err)
+	require.True(archived)
+}
+
+func (s *MsgServerTestSuite) TestArchivedTopicStakeKeptUntilEveryReturnSucceeds() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+	delegatorAddr := s.addrs[2]
+	delegator := s.addrsStr[2]
+	reputerStake := cosmosMath.NewInt(100)
+	delegateStake := cosmosMath.NewInt(500)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	registrationInitialBalance := moduleParams.RegistrationFee.Add(reputerStake)
+
+	topicId := s.commonStakingSetup(ctx, reputer, reputerAddr, worker, workerAddr, registrationInitialBalance)
+	_, err = msgServer.AddStake(ctx, &types.AddStakeRequest{Sender: reputer, TopicId: topicId, Amount: reputerStake})
+	require.NoError(err)
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	_, err = msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{Sender: delegator, TopicId: topicId, Reputer: reputer, Amount: delegateStake})
+	require.NoError(err)
+	_, err = msgServer.ArchiveTopic(ctx, &types.ArchiveTopicRequest{Sender: reputer, TopicId: topicId})
+	require.NoError(err)
+
+	// Leave only enough in the staking account to return the stake of the reputer
+	stakingAccount := s.accountKeeper.GetModuleAddress(types.AlloraStakingAccountName)
+	stakingBalance := s.bankKeeper.GetBalance(ctx, stakingAccount, params.DefaultBondDenom)
+	drained := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, stakingBalance.Amount.Sub(reputerStake)))
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.AlloraStakingAccountName, s.addrs[3], drained)
+	require.NoError(err)
+	reputerBalanceBefore := s.bankKeeper.GetBalance(ctx, reputerAddr, params.DefaultBondDenom)
+
+	// The failed delegation is stepped over rather than retried first on every call
+	processed, done, err := keeper.ReturnArchivedTopicStake(ctx, topicId, 1)
+	require.NoError(err)
+	require.Equal(uint64(1), processed)
+	require.False(done)
+	processed, done, err = keeper.ReturnArchivedTopicStake(ctx, topicId, 1)
+	require.NoError(err)
+	require.Equal(uint64(1), processed)
+	require.False(done)
+	reputerBalanceAfter := s.bankKeeper.GetBalance(ctx, reputerAddr, params.DefaultBondDenom)
+	require.Equal(reputerBalanceBefore.Amount.Add(reputerStake), reputerBalanceAfter.Amount)
+
+	// A pass with a failure never completes the return, the next pass retries the unpaid stake
+	processed, done, err = keeper.ReturnArchivedTopicStake(ctx, topicId, 1)
+	require.NoError(err)
+	require.Equal(uint64(1), processed)
+	require.False(done)
+	delegateStakeUponReputer, err := keeper.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	require.NoError(err)
+	require.Equal(delegateStake, delegateStakeUponReputer)
+
+	err = s.bankKeeper.SendCoinsFromAccountToModule(ctx, s.addrs[3], types.AlloraStakingAccountName, drained)
+	require.NoError(err)
+	delegatorBalanceBefore := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	// The pass with the failure ends first, then the next one returns the stake left
+	_, done, err = keeper.ReturnArchivedTopicStake(ctx, topicId, 10)
+	require.NoError(err)
+	require.False(done)
+	_, done, err = keeper.ReturnArchivedTopicStake(ctx, topicId, 10)
+	require.NoError(err)
+	require.True(done)
+	delegatorBalanceAfter := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	require.Equal(delegatorBalanceBefore.Amount.Add(delegateStake), delegatorBalanceAfter.Amount)
+	topicStake, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.True(topicStake.IsZero())
+}
+
+func (s *MsgServerTestSuite) TestArchivedTopicRedelegationsDeleted() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer1 := s.addrsStr[1]
+	reputer2 := s.addrsStr[2]
+	topic := s.CreateOneTopic()
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+	for _, reputer := range []string{reputer1, reputer2} {
+		err := keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+		require.NoError(err)
+	}
+	_, err := s.msgServer.DelegateStake(ctx, &types.DelegateStakeRequest{
+		Sender:  delegator,
+		TopicId: topic.Id,
+		Reputer: reputer1,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RedelegateStake(ctx, &types.RedelegateStakeRequest{
+		Sender:     delegator,
+		TopicId:    topic.Id,
+		SrcReputer: reputer1,
+		DstReputer: reputer2,
+		Amount:     cosmosMath.NewInt(60),
+	})
+	require.NoError(err)
+	redelegation, found, err := keeper.GetRedelegation(ctx, topic.Id, delegator, reputer1, reputer2)
+	require.NoError(err)
+	require.True(found)
+
+	err = keeper.ArchiveTopic(ctx, topic.Id)
+	require.NoError(err)
+	archive, found, err := keeper.GetTopicArchive(ctx, topic.Id)
+	require.NoError(err)
+	require.True(found)
+	ctx = ctx.WithBlockHeight(archive.StakeRemovalsDueBlockHeight)
+
+	_, done, err := keeper.ReturnArchivedTopicStake(ctx, topic.Id, 10)
+	require.NoError(err)
+	require.True(done)
+	_, found, err = keeper.GetRedelegation(ctx, topic.Id, delegator, reputer1, reputer2)
+	require.NoError(err)
+	require.False(found)
+
+	// Nothing is left maturing for the archived topic
+	err = keeper.DeleteMaturedRedelegations(ctx, redelegation.BlockMatured, 10)
+	require.NoError(err)
+	stakeUpon2, err := keeper.GetDelegateStakeUponReputer(ctx, topic.Id, reputer2)
+	require.NoError(err)
+	require.True(stakeUpon2.IsZero())
+}
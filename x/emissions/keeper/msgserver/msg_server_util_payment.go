@@ -14,48 +14,6 @@ import (
 type TopicId = uint64
 type Allo = cosmosMath.Int
 
-func activateTopicIfWeightAtLeastGlobalMin(
-	ctx context.Context,
-	ms msgServer,
-	topicId TopicId,
-) error {
-	isActivated, err := ms.k.IsTopicActive(ctx, topicId)
-	if err != nil {
-		return errors.Wrapf(err, "error getting topic activation status")
-	}
-	if !isActivated {
-		params, err := ms.k.GetParams(ctx)
-		if err != nil {
-			return errors.Wrapf(err, "error getting params")
-		}
-		topic, err := ms.k.GetTopic(ctx, topicId)
-		if err != nil {
-			return errors.Wrapf(err, "error getting topic")
-		}
-
-		newTopicWeight, _, err := ms.k.GetCurrentTopicWeight(
-			ctx,
-			topicId,
-			topic.EpochLength,
-			params.TopicRewardAlpha,
-			params.TopicRewardStakeImportance,
-			params.TopicRewardFeeRevenueImportance,
-		)
-		if err != nil {
-			return errors.Wrapf(err, "error getting current topic weight")
-		}
-
-		if newTopicWeight.Gte(params.MinTopicWeight) {
-			err = ms.k.ActivateTopic(ctx, topicId)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
-
 // Check if user has enough balance to send the fee, then send the fee to EcoSystem bucket
 func checkBalanceAndSendFee(
 	ctx context.Context,
@@ -107,7 +65,7 @@ func sendEffectiveRevenueActivateTopicIfWeightSufficient(
 		return err
 	}
 
-	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, topicId)
+	err = ms.k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, topicId)
 	if err != nil {
 		sdkCtx.Logger().Error("Failed to activate topic", err)
 		return err
@@ -55,3 +55,134 @@ func (ms msgServer) RemoveFromWhitelistAdmin(ctx context.Context, msg *types.Rem
 	err = ms.k.RemoveWhitelistAdmin(ctx, msg.Address)
 	return &types.RemoveFromWhitelistAdminResponse{}, err
 }
+
+// Checks that the topic exists, is not archived and that the sender is its creator,
+// who is the only one allowed to manage the whitelists of the topic
+func checkSenderIsCreatorOfTopic(ctx context.Context, ms msgServer, sender string, topicId uint64) error {
+	isArchived, err := ms.k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return err
+	} else if isArchived {
+		return types.ErrTopicArchived
+	}
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return types.ErrTopicDoesNotExist
+	}
+	if sender != topic.Creator {
+		return types.ErrNotTopicCreator
+	}
+	return nil
+}
+
+func (ms msgServer) EnableTopicWorkerWhitelist(ctx context.Context, msg *types.EnableTopicWorkerWhitelistRequest) (_ *types.EnableTopicWorkerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("EnableTopicWorkerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Enable the worker whitelist of the topic
+	err = ms.k.EnableTopicWorkerWhitelist(ctx, msg.TopicId)
+	return &types.EnableTopicWorkerWhitelistResponse{}, err
+}
+
+func (ms msgServer) DisableTopicWorkerWhitelist(ctx context.Context, msg *types.DisableTopicWorkerWhitelistRequest) (_ *types.DisableTopicWorkerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("DisableTopicWorkerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Disable the worker whitelist of the topic
+	err = ms.k.DisableTopicWorkerWhitelist(ctx, msg.TopicId)
+	return &types.DisableTopicWorkerWhitelistResponse{}, err
+}
+
+func (ms msgServer) EnableTopicReputerWhitelist(ctx context.Context, msg *types.EnableTopicReputerWhitelistRequest) (_ *types.EnableTopicReputerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("EnableTopicReputerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Enable the reputer whitelist of the topic
+	err = ms.k.EnableTopicReputerWhitelist(ctx, msg.TopicId)
+	return &types.EnableTopicReputerWhitelistResponse{}, err
+}
+
+func (ms msgServer) DisableTopicReputerWhitelist(ctx context.Context, msg *types.DisableTopicReputerWhitelistRequest) (_ *types.DisableTopicReputerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("DisableTopicReputerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Disable the reputer whitelist of the topic
+	err = ms.k.DisableTopicReputerWhitelist(ctx, msg.TopicId)
+	return &types.DisableTopicReputerWhitelistResponse{}, err
+}
+
+func (ms msgServer) AddToTopicWorkerWhitelist(ctx context.Context, msg *types.AddToTopicWorkerWhitelistRequest) (_ *types.AddToTopicWorkerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("AddToTopicWorkerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Add the address to the worker whitelist of the topic
+	err = ms.k.AddToTopicWorkerWhitelist(ctx, msg.TopicId, msg.Address)
+	return &types.AddToTopicWorkerWhitelistResponse{}, err
+}
+
+func (ms msgServer) RemoveFromTopicWorkerWhitelist(ctx context.Context, msg *types.RemoveFromTopicWorkerWhitelistRequest) (_ *types.RemoveFromTopicWorkerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("RemoveFromTopicWorkerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Remove the address from the worker whitelist of the topic
+	err = ms.k.RemoveFromTopicWorkerWhitelist(ctx, msg.TopicId, msg.Address)
+	return &types.RemoveFromTopicWorkerWhitelistResponse{}, err
+}
+
+func (ms msgServer) AddToTopicReputerWhitelist(ctx context.Context, msg *types.AddToTopicReputerWhitelistRequest) (_ *types.AddToTopicReputerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("AddToTopicReputerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Add the address to the reputer whitelist of the topic
+	err = ms.k.AddToTopicReputerWhitelist(ctx, msg.TopicId, msg.Address)
+	return &types.AddToTopicReputerWhitelistResponse{}, err
+}
+
+func (ms msgServer) RemoveFromTopicReputerWhitelist(ctx context.Context, msg *types.RemoveFromTopicReputerWhitelistRequest) (_ *types.RemoveFromTopicReputerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("RemoveFromTopicReputerWhitelist", time.Now(), &err)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := checkSenderIsCreatorOfTopic(ctx, ms, msg.Sender, msg.TopicId); err != nil {
+		return nil, err
+	}
+	// Remove the address from the reputer whitelist of the topic
+	err = ms.k.RemoveFromTopicReputerWhitelist(ctx, msg.TopicId, msg.Address)
+	return &types.RemoveFromTopicReputerWhitelistResponse{}, err
+}
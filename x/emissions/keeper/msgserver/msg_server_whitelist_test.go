@@ -1,7 +1,9 @@
 package msgserver_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 )
 
 func (s *MsgServerTestSuite) TestAddWhitelistAdmin() {
@@ -86,3 +88,222 @@ func (s *MsgServerTestSuite) TestRemoveWhitelistAdminInvalidUnauthorized() {
 	_, err := s.msgServer.RemoveFromWhitelistAdmin(ctx, msg)
 	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
 }
+
+func (s *MsgServerTestSuite) TestTopicWhitelistsManagedByTopicCreator() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	worker := s.addrsStr[1]
+	reputer := s.addrsStr[2]
+
+	_, err := msgServer.EnableTopicWorkerWhitelist(ctx, &types.EnableTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+	_, err = msgServer.EnableTopicReputerWhitelist(ctx, &types.EnableTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: worker})
+	require.NoError(err)
+	_, err = msgServer.AddToTopicReputerWhitelist(ctx, &types.AddToTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: reputer})
+	require.NoError(err)
+
+	enabled, err := s.emissionsKeeper.IsTopicWorkerWhitelistEnabled(ctx, topic.Id)
+	require.NoError(err)
+	require.True(enabled)
+	enabled, err = s.emissionsKeeper.IsTopicReputerWhitelistEnabled(ctx, topic.Id)
+	require.NoError(err)
+	require.True(enabled)
+	isWhitelisted, err := s.emissionsKeeper.IsWhitelistedTopicWorker(ctx, topic.Id, worker)
+	require.NoError(err)
+	require.True(isWhitelisted)
+	isWhitelisted, err = s.emissionsKeeper.IsWhitelistedTopicReputer(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.True(isWhitelisted)
+
+	// Whitelists are per role
+	isWhitelisted, err = s.emissionsKeeper.IsWhitelistedTopicReputer(ctx, topic.Id, worker)
+	require.NoError(err)
+	require.False(isWhitelisted)
+
+	_, err = msgServer.RemoveFromTopicWorkerWhitelist(ctx, &types.RemoveFromTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: worker})
+	require.NoError(err)
+	_, err = msgServer.RemoveFromTopicReputerWhitelist(ctx, &types.RemoveFromTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: reputer})
+	require.NoError(err)
+	_, err = msgServer.DisableTopicWorkerWhitelist(ctx, &types.DisableTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+	_, err = msgServer.DisableTopicReputerWhitelist(ctx, &types.DisableTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+
+	enabled, err = s.emissionsKeeper.IsTopicWorkerWhitelistEnabled(ctx, topic.Id)
+	require.NoError(err)
+	require.False(enabled)
+	enabled, err = s.emissionsKeeper.IsTopicReputerWhitelistEnabled(ctx, topic.Id)
+	require.NoError(err)
+	require.False(enabled)
+	isWhitelisted, err = s.emissionsKeeper.IsWhitelistedTopicWorker(ctx, topic.Id, worker)
+	require.NoError(err)
+	require.False(isWhitelisted)
+	isWhitelisted, err = s.emissionsKeeper.IsWhitelistedTopicReputer(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.False(isWhitelisted)
+}
+
+func (s *MsgServerTestSuite) TestTopicWhitelistsNotTopicCreatorFails() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	// Whitelist admins cannot manage the whitelists of a topic either
+	sender := s.addrsStr[0]
+	require.NotEqual(topic.Creator, sender)
+
+	_, err := msgServer.EnableTopicWorkerWhitelist(ctx, &types.EnableTopicWorkerWhitelistRequest{Sender: sender, TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+	_, err = msgServer.EnableTopicReputerWhitelist(ctx, &types.EnableTopicReputerWhitelistRequest{Sender: sender, TopicId: topic.Id})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{Sender: sender, TopicId: topic.Id, Address: sender})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+	_, err = msgServer.AddToTopicReputerWhitelist(ctx, &types.AddToTopicReputerWhitelistRequest{Sender: sender, TopicId: topic.Id, Address: sender})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+
+	_, err = msgServer.EnableTopicWorkerWhitelist(ctx, &types.EnableTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id + 1})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: "invalid"})
+	require.Error(err)
+
+	isWhitelisted, err := s.emissionsKeeper.IsWhitelistedTopicWorker(ctx, topic.Id, sender)
+	require.NoError(err)
+	require.False(isWhitelisted)
+}
+
+func (s *MsgServerTestSuite) TestRegisterEnforcesTopicWhitelists() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+	reputerAddr := s.addrs[2]
+	reputer := s.addrsStr[2]
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	s.MintTokensToAddress(workerAddr, moduleParams.RegistrationFee)
+	s.MintTokensToAddress(reputerAddr, moduleParams.RegistrationFee)
+
+	_, err = msgServer.EnableTopicWorkerWhitelist(ctx, &types.EnableTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+	_, err = msgServer.EnableTopicReputerWhitelist(ctx, &types.EnableTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+
+	workerRegMsg := &types.RegisterRequest{Sender: worker, Owner: worker, TopicId: topic.Id, IsReputer: false}
+	reputerRegMsg := &types.RegisterRequest{Sender: reputer, Owner: reputer, TopicId: topic.Id, IsReputer: true}
+
+	_, err = msgServer.Register(ctx, workerRegMsg)
+	require.ErrorIs(err, types.ErrNotInWorkerWhitelist)
+	_, err = msgServer.Register(ctx, reputerRegMsg)
+	require.ErrorIs(err, types.ErrNotInReputerWhitelist)
+
+	// Being whitelisted for one role does not allow to register for the other
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: reputer})
+	require.NoError(err)
+	_, err = msgServer.Register(ctx, reputerRegMsg)
+	require.ErrorIs(err, types.ErrNotInReputerWhitelist)
+
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: worker})
+	require.NoError(err)
+	_, err = msgServer.AddToTopicReputerWhitelist(ctx, &types.AddToTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: reputer})
+	require.NoError(err)
+
+	_, err = msgServer.Register(ctx, workerRegMsg)
+	require.NoError(err)
+	_, err = msgServer.Register(ctx, reputerRegMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestInsertWorkerPayloadEnforcesTopicWorkerWhitelist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+
+	// The worker registered before the whitelist was enabled
+	_, err = msgServer.EnableTopicWorkerWhitelist(ctx, &types.EnableTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topicId})
+	require.NoError(err)
+
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrNotInWorkerWhitelist)
+
+	_, err = msgServer.AddToTopicWorkerWhitelist(ctx, &types.AddToTopicWorkerWhitelistRequest{Sender: topic.Creator, TopicId: topicId, Address: workerMsg.WorkerDataBundle.Worker})
+	require.NoError(err)
+
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestInsertReputerPayloadEnforcesTopicReputerWhitelist() {
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerPrivateKey := s.privKeys[0]
+	reputerPublicKeyHex := s.pubKeyHexStr[0]
+	reputerAddr := s.addrs[0]
+	reputer := s.addrsStr[0]
+	workerAddr := s.addrs[1]
+	worker := s.addrsStr[1]
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId := s.setUpMsgReputerPayload(reputer, reputerAddr, worker, workerAddr)
+	err := keeper.InsertActiveForecasts(s.ctx, topicId, block, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertActiveInferences(s.ctx, topicId, block, expectedInferences)
+	require.NoError(err)
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(block + topic.GroundTruthLag*2)
+
+	_, err = s.msgServer.EnableTopicReputerWhitelist(s.ctx, &types.EnableTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topicId})
+	require.NoError(err)
+
+	err = s.constructAndInsertReputerPayload(reputerAddr, reputerPrivateKey, reputerPublicKeyHex, &reputerValueBundle)
+	require.ErrorIs(err, types.ErrNotInReputerWhitelist)
+
+	_, err = s.msgServer.AddToTopicReputerWhitelist(s.ctx, &types.AddToTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topicId, Address: reputer})
+	require.NoError(err)
+
+	err = s.constructAndInsertReputerPayload(reputerAddr, reputerPrivateKey, reputerPublicKeyHex, &reputerValueBundle)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestDelegateStakeEnforcesTopicReputerWhitelist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topic := s.CreateOneTopic()
+	delegatorAddr := s.addrs[0]
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(1000))
+
+	err := s.emissionsKeeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{Owner: reputer, NodeAddress: reputer})
+	require.NoError(err)
+	_, err = msgServer.EnableTopicReputerWhitelist(ctx, &types.EnableTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id})
+	require.NoError(err)
+
+	msg := &types.DelegateStakeRequest{Sender: delegator, TopicId: topic.Id, Reputer: reputer, Amount: cosmosMath.NewInt(50)}
+	_, err = msgServer.DelegateStake(ctx, msg)
+	require.ErrorIs(err, types.ErrNotInReputerWhitelist)
+
+	// Delegators themselves do not need to be whitelisted
+	_, err = msgServer.AddToTopicReputerWhitelist(ctx, &types.AddToTopicReputerWhitelistRequest{Sender: topic.Creator, TopicId: topic.Id, Address: reputer})
+	require.NoError(err)
+	_, err = msgServer.DelegateStake(ctx, msg)
+	require.NoError(err)
+
+	stake, err := s.emissionsKeeper.GetDelegateStakeUponReputer(ctx, topic.Id, reputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(50), stake)
+}
@@ -0,0 +1,156 @@
+package msgserver
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Function for workers to call to add to their bond in a topic.
+func (ms msgServer) AddWorkerBond(ctx context.Context, msg *types.AddWorkerBondRequest) (_ *types.AddWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("AddWorkerBond", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	// Archived topics do not accept new bonds anymore
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isWorkerRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, msg.Amount))
+	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraWorkerBondsAccountName, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.AddWorkerBond(ctx, msg.TopicId, msg.Sender, msg.Amount)
+	return &types.AddWorkerBondResponse{}, err
+}
+
+// RemoveWorkerBond kicks off the removal of a worker bond. Like stake removals, bond removals are placed into
+// a delayed queue and paid out by the ABCI endBlocker once the delay has passed.
+// If this function is called twice, it will overwrite the previous bond removal and the delay will reset.
+func (ms msgServer) RemoveWorkerBond(ctx context.Context, msg *types.RemoveWorkerBondRequest) (_ *types.RemoveWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("RemoveWorkerBond", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	// No bond removals are queued on archived topics, their bonds are returned by the archival
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+
+	bond, err := ms.k.GetWorkerBond(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.GT(bond) {
+		return nil, types.ErrInsufficientWorkerBondToRemove
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	removal := types.WorkerBondRemovalInfo{
+		BlockRemovalStarted:   blockHeight,
+		BlockRemovalCompleted: blockHeight + moduleParams.RemoveStakeDelayWindow,
+		TopicId:               msg.TopicId,
+		Worker:                msg.Sender,
+		Amount:                msg.Amount,
+	}
+	err = ms.k.SetWorkerBondRemoval(ctx, removal)
+	return &types.RemoveWorkerBondResponse{}, err
+}
+
+// Cancels a request to remove a worker bond, during the delay window
+func (ms msgServer) CancelRemoveWorkerBond(ctx context.Context, msg *types.CancelRemoveWorkerBondRequest) (_ *types.CancelRemoveWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("CancelRemoveWorkerBond", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	err = ms.k.DeleteWorkerBondRemoval(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to delete worker bond removal")
+	}
+	return &types.CancelRemoveWorkerBondResponse{}, nil
+}
+
+// Slashes the bond of a worker for proven misbehavior. Only whitelist admins can slash worker bonds,
+// the slashed amount is sent to the ecosystem bucket.
+func (ms msgServer) SlashWorkerBond(ctx context.Context, msg *types.SlashWorkerBondRequest) (_ *types.SlashWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("SlashWorkerBond", time.Now(), &err)
+
+	if err = msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !isAdmin {
+		return nil, types.ErrNotWhitelistAdmin
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if !topicExists {
+		return nil, types.ErrTopicDoesNotExist
+	}
+
+	slashed, err := ms.k.SlashWorkerBond(ctx, msg.TopicId, msg.Worker, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	sdk.UnwrapSDKContext(ctx).Logger().Info("Slashed worker bond",
+		"topic", msg.TopicId, "worker", msg.Worker, "amount", slashed.String(), "reason", msg.Reason)
+	return &types.SlashWorkerBondResponse{}, nil
+}
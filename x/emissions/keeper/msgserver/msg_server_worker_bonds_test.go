@@ -0,0 +1,147 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadRequiresWorkerBond() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	worker := workerMsg.WorkerDataBundle.Worker
+	ctx = ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.MinWorkerBond = cosmosMath.NewInt(100)
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrInsufficientWorkerBond)
+
+	s.MintTokensToAddress(sdk.MustAccAddressFromBech32(worker), cosmosMath.NewInt(1000))
+	_, err = msgServer.AddWorkerBond(ctx, &types.AddWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(99),
+	})
+	require.NoError(err)
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrInsufficientWorkerBond)
+
+	_, err = msgServer.AddWorkerBond(ctx, &types.AddWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  cosmosMath.OneInt(),
+	})
+	require.NoError(err)
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestWorkerBondRemovalAndSlashing() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic().Id
+	workerAddr, worker := getNewAddress()
+	err := s.emissionsKeeper.InsertWorker(ctx, topicId, worker, types.OffchainNode{Owner: worker, NodeAddress: worker})
+	require.NoError(err)
+	s.MintTokensToAddress(workerAddr, cosmosMath.NewInt(1000))
+
+	// Only workers registered in the topic can bond
+	_, unregistered := getNewAddress()
+	_, err = msgServer.AddWorkerBond(ctx, &types.AddWorkerBondRequest{
+		Sender:  unregistered,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+
+	_, err = msgServer.AddWorkerBond(ctx, &types.AddWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(900), s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom).Amount)
+
+	_, err = msgServer.RemoveWorkerBond(ctx, &types.RemoveWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(101),
+	})
+	require.ErrorIs(err, types.ErrInsufficientWorkerBondToRemove)
+	_, err = msgServer.RemoveWorkerBond(ctx, &types.RemoveWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(40),
+	})
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	removalBlock := ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow
+	removal, found, err := s.emissionsKeeper.GetWorkerBondRemoval(ctx, topicId, worker)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(types.WorkerBondRemovalInfo{
+		BlockRemovalStarted:   ctx.BlockHeight(),
+		BlockRemovalCompleted: removalBlock,
+		TopicId:               topicId,
+		Worker:                worker,
+		Amount:                cosmosMath.NewInt(40),
+	}, removal)
+
+	// Only whitelist admins can slash, the pending removal is capped to the bond left after the slash
+	slashMsg := &types.SlashWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+		Worker:  worker,
+		Amount:  cosmosMath.NewInt(80),
+		Reason:  "equivocating inferences",
+	}
+	_, err = msgServer.SlashWorkerBond(ctx, slashMsg)
+	require.ErrorIs(err, types.ErrNotWhitelistAdmin)
+	slashMsg.Sender = s.addrsStr[0]
+	_, err = msgServer.SlashWorkerBond(ctx, slashMsg)
+	require.NoError(err)
+
+	bond, err := s.emissionsKeeper.GetWorkerBond(ctx, topicId, worker)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(20), bond)
+	removal, found, err = s.emissionsKeeper.GetWorkerBondRemoval(ctx, topicId, worker)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(20), removal.Amount)
+	_, broken := keeper.WorkerBondsInvariantTotalBondEqualAlloraWorkerBondsBankBalance(s.emissionsKeeper)(ctx)
+	require.False(broken)
+
+	// The removal is paid out once the delay has passed
+	ctx = ctx.WithBlockHeight(removalBlock)
+	err = s.appModule.EndBlock(ctx)
+	require.NoError(err)
+
+	bond, err = s.emissionsKeeper.GetWorkerBond(ctx, topicId, worker)
+	require.NoError(err)
+	require.True(bond.IsZero())
+	_, found, err = s.emissionsKeeper.GetWorkerBondRemoval(ctx, topicId, worker)
+	require.NoError(err)
+	require.False(found)
+	require.Equal(cosmosMath.NewInt(920), s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom).Amount)
+
+	_, err = msgServer.CancelRemoveWorkerBond(ctx, &types.CancelRemoveWorkerBondRequest{
+		Sender:  worker,
+		TopicId: topicId,
+	})
+	require.ErrorIs(err, types.ErrWorkerBondRemovalNotFound)
+}
@@ -5,10 +5,13 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	appParams "github.com/allora-network/allora-chain/app/params"
 	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // A tx function that accepts a individual inference and forecast and possibly returns an error
@@ -18,87 +21,400 @@ import (
 // Signatures, anti-sybil procedures, and "skimming of only the top few workers by EMA score descending" should be done here.
 func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWorkerPayloadRequest) (_ *types.InsertWorkerPayloadResponse, err error) {
 	defer metrics.RecordMetrics("InsertWorkerPayload", time.Now(), &err)
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err = ms.k.ValidateStringIsBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	blockHeight := sdkCtx.BlockHeight()
-	err = msg.WorkerDataBundle.Validate()
+	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
-		return nil, errorsmod.Wrapf(err,
-			"Worker invalid data for block: %d", blockHeight)
+		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
+	}
+	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.checkWorkerDataBundle(ctx, moduleParams, msg.WorkerDataBundle, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, topic.Id, moduleParams.DataSendingFee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.appendWorkerDataBundle(ctx, moduleParams, topic, msg.WorkerDataBundle)
+	if err != nil {
+		return nil, err
+	}
+	return &types.InsertWorkerPayloadResponse{}, nil
+}
+
+// Same as InsertWorkerPayload for many bundles, possibly of different topics. Each bundle is accepted or
+// refused on its own, a refused bundle leaving no state behind and being reported with its error in the response.
+// The data sending fee is due for every accepted bundle and sent in a single transfer once all bundles are processed;
+// bundles the balance of the sender cannot cover anymore are refused.
+func (ms msgServer) InsertWorkerPayloads(ctx context.Context, msg *types.InsertWorkerPayloadsRequest) (_ *types.InsertWorkerPayloadsResponse, err error) {
+	defer metrics.RecordMetrics("InsertWorkerPayloads", time.Now(), &err)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
 	}
 
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "Error getting params for sender: %v", &msg.Sender)
 	}
-	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	senderAddress, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
+	balance := ms.k.GetBankBalance(ctx, senderAddress, appParams.DefaultBondDenom).Amount
 
-	nonce := msg.WorkerDataBundle.Nonce
-	topicId := msg.WorkerDataBundle.TopicId
+	totalFee := cosmosMath.ZeroInt()
+	feeTopicIds := make([]TopicId, 0)
+	feeByTopic := make(map[TopicId]Allo)
+	results := make([]*types.WorkerPayloadResult, 0, len(msg.WorkerDataBundles))
+	for _, bundle := range msg.WorkerDataBundles {
+		result := &types.WorkerPayloadResult{
+			TopicId: 0,
+			Worker:  "",
+			Success: false,
+			Error:   "",
+		}
+		if bundle != nil {
+			result.TopicId = bundle.TopicId
+			result.Worker = bundle.Worker
+		}
+		results = append(results, result)
 
-	topic, err := ms.k.GetTopic(ctx, topicId)
+		if balance.LT(totalFee.Add(moduleParams.DataSendingFee)) {
+			result.Error = errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "sender has insufficient balance to cover fees").Error()
+			continue
+		}
+		// Each bundle writes to its own branch of the state, only kept if the bundle is accepted
+		cacheCtx, write := sdkCtx.CacheContext()
+		err := checkInputLength(moduleParams.MaxSerializedMsgLength, bundle)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		topic, err := ms.checkWorkerDataBundle(cacheCtx, moduleParams, bundle, false)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		err = ms.appendWorkerDataBundle(cacheCtx, moduleParams, topic, bundle)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		write()
+		result.Success = true
+
+		totalFee = totalFee.Add(moduleParams.DataSendingFee)
+		if _, ok := feeByTopic[topic.Id]; !ok {
+			feeTopicIds = append(feeTopicIds, topic.Id)
+			feeByTopic[topic.Id] = cosmosMath.ZeroInt()
+		}
+		feeByTopic[topic.Id] = feeByTopic[topic.Id].Add(moduleParams.DataSendingFee)
+	}
+
+	if totalFee.IsPositive() {
+		err = checkBalanceAndSendFee(ctx, ms, msg.Sender, totalFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, topicId := range feeTopicIds {
+		err = ms.k.AddTopicFeeRevenue(ctx, topicId, feeByTopic[topicId])
+		if err != nil {
+			return nil, err
+		}
+		err = ms.k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, topicId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &types.InsertWorkerPayloadsResponse{Results: results}, nil
+}
+
+// Commits a worker of a commit-reveal topic to the payload it reveals in the reveal window of the nonce,
+// so that its inference and forecast are not visible to other workers before the submission window closes.
+// The data sending fee is paid on commitment, and a worker commits at most once per nonce.
+func (ms msgServer) CommitWorkerPayload(ctx context.Context, msg *types.CommitWorkerPayloadRequest) (_ *types.CommitWorkerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("CommitWorkerPayload", time.Now(), &err)
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
 	if err != nil {
 		return nil, types.ErrInvalidTopicId
 	}
-	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce)
+	isArchived, err := ms.k.IsTopicArchived(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	} else if isArchived {
+		return nil, types.ErrTopicArchived
+	}
+	if !topic.IsWorkerCommitReveal() {
+		return nil, types.ErrCommitRevealNotEnabled
+	}
+
+	nonce := types.Nonce{BlockHeight: msg.NonceBlockHeight}
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, &nonce)
 	if err != nil {
 		return nil, err
 	} else if !nonceUnfulfilled {
 		return nil, types.ErrUnfulfilledNonceNotFound
 	}
-
-	if !ms.k.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if !ms.k.BlockWithinWorkerSubmissionWindowOfNonce(topic, nonce, blockHeight) {
 		return nil, errorsmod.Wrapf(
 			types.ErrWorkerNonceWindowNotAvailable,
 			"Worker window not open for topic: %d, current block %d, start window: %d, end window: %d",
-			topicId, blockHeight, nonce.BlockHeight, nonce.BlockHeight+topic.WorkerSubmissionWindow,
+			msg.TopicId, blockHeight, nonce.BlockHeight, nonce.BlockHeight+topic.WorkerSubmissionWindow,
 		)
 	}
 
-	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, msg.WorkerDataBundle.Worker)
+	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
 	if err != nil {
 		return nil, err
 	} else if !isWorkerRegistered {
 		return nil, errorsmod.Wrapf(types.ErrAddressNotRegistered, "worker is not registered in this topic")
 	}
+	canWork, err := ms.k.CanActAsTopicWorker(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !canWork {
+		return nil, types.ErrNotInWorkerWhitelist
+	}
+	hasBond, err := ms.k.HasSufficientWorkerBond(ctx, topic, msg.Sender)
+	if err != nil {
+		return nil, err
+	} else if !hasBond {
+		return nil, types.ErrInsufficientWorkerBond
+	}
 
-	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, topicId, moduleParams.DataSendingFee)
+	previous, found, err := ms.k.GetWorkerCommitment(ctx, msg.TopicId, msg.Sender)
 	if err != nil {
 		return nil, err
+	} else if found && previous.NonceBlockHeight == msg.NonceBlockHeight {
+		return nil, types.ErrCommitmentExists
 	}
 
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = sendEffectiveRevenueActivateTopicIfWeightSufficient(ctx, ms, msg.Sender, msg.TopicId, moduleParams.DataSendingFee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.SetWorkerCommitment(ctx, types.WorkerCommitment{
+		TopicId:          msg.TopicId,
+		NonceBlockHeight: msg.NonceBlockHeight,
+		Worker:           msg.Sender,
+		Commitment:       msg.Commitment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CommitWorkerPayloadResponse{}, nil
+}
+
+// Reveals the payload a worker committed to, which is then processed as a plaintext payload would be.
+// Workers who do not reveal their payload by the end of the reveal window are penalized when the nonce closes.
+func (ms msgServer) RevealWorkerPayload(ctx context.Context, msg *types.RevealWorkerPayloadRequest) (_ *types.RevealWorkerPayloadResponse, err error) {
+	defer metrics.RecordMetrics("RevealWorkerPayload", time.Now(), &err)
+	err = msg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = checkInputLength(moduleParams.MaxSerializedMsgLength, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := msg.WorkerDataBundle
+	topic, err := ms.checkWorkerDataBundle(ctx, moduleParams, bundle, true)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment, found, err := ms.k.GetWorkerCommitment(ctx, bundle.TopicId, bundle.Worker)
+	if err != nil {
+		return nil, err
+	} else if !found || commitment.NonceBlockHeight != bundle.Nonce.BlockHeight {
+		return nil, types.ErrCommitmentNotFound
+	}
+	matches, err := commitment.Matches(bundle.InferenceForecastsBundle, msg.Salt)
+	if err != nil {
+		return nil, err
+	} else if !matches {
+		return nil, types.ErrCommitmentMismatch
+	}
+
+	err = ms.appendWorkerDataBundle(ctx, moduleParams, topic, bundle)
+	if err != nil {
+		return nil, err
+	}
+	err = ms.k.RemoveWorkerCommitment(ctx, bundle.TopicId, bundle.Worker)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RevealWorkerPayloadResponse{}, nil
+}
+
+// Checks a worker data bundle can be accepted in the current worker window of its topic, which is returned.
+// Bundles revealing a commitment are checked against the reveal window instead.
+func (ms msgServer) checkWorkerDataBundle(
+	ctx context.Context,
+	moduleParams types.Params,
+	bundle *types.WorkerDataBundle,
+	reveal bool,
+) (types.Topic, error) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	err := bundle.Validate()
+	if err != nil {
+		return types.Topic{}, errorsmod.Wrapf(err,
+			"Worker invalid data for block: %d", blockHeight)
+	}
+
+	nonce := bundle.Nonce
+	topicId := bundle.TopicId
+
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return types.Topic{}, types.ErrInvalidTopicId
+	}
+
+	// Archived topics do not accept new submissions anymore
+	isArchived, err := ms.k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return types.Topic{}, err
+	} else if isArchived {
+		return types.Topic{}, types.ErrTopicArchived
+	}
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, topicId, nonce)
+	if err != nil {
+		return types.Topic{}, err
+	} else if !nonceUnfulfilled {
+		return types.Topic{}, types.ErrUnfulfilledNonceNotFound
+	}
+
+	// Payloads of commit-reveal topics are only accepted as reveals of a commitment, in the reveal window
+	if reveal {
+		if !topic.IsWorkerCommitReveal() {
+			return types.Topic{}, types.ErrCommitRevealNotEnabled
+		}
+		if !ms.k.BlockWithinWorkerRevealWindowOfNonce(topic, *nonce, blockHeight) {
+			revealStart := nonce.BlockHeight + topic.WorkerSubmissionWindow
+			return types.Topic{}, errorsmod.Wrapf(
+				types.ErrRevealWindowNotAvailable,
+				"Reveal window not open for topic: %d, current block %d, start window: %d, end window: %d",
+				topicId, blockHeight, revealStart, revealStart+topic.WorkerRevealWindow,
+			)
+		}
+	} else {
+		if topic.IsWorkerCommitReveal() {
+			return types.Topic{}, types.ErrCommitRevealRequired
+		}
+		if !ms.k.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+			return types.Topic{}, errorsmod.Wrapf(
+				types.ErrWorkerNonceWindowNotAvailable,
+				"Worker window not open for topic: %d, current block %d, start window: %d, end window: %d",
+				topicId, blockHeight, nonce.BlockHeight, nonce.BlockHeight+topic.WorkerSubmissionWindow,
+			)
+		}
+	}
+
+	isWorkerRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, bundle.Worker)
+	if err != nil {
+		return types.Topic{}, err
+	} else if !isWorkerRegistered {
+		return types.Topic{}, errorsmod.Wrapf(types.ErrAddressNotRegistered, "worker is not registered in this topic")
+	}
+
+	// Workers registered before the worker whitelist was enabled or who have been removed from it are refused as well
+	canWork, err := ms.k.CanActAsTopicWorker(ctx, topicId, bundle.Worker)
+	if err != nil {
+		return types.Topic{}, err
+	} else if !canWork {
+		return types.Topic{}, types.ErrNotInWorkerWhitelist
+	}
+
+	// Topics requiring a worker bond only accept payloads of workers holding at least the minimum bond
+	hasBond, err := ms.k.HasSufficientWorkerBond(ctx, topic, bundle.Worker)
+	if err != nil {
+		return types.Topic{}, err
+	} else if !hasBond {
+		return types.Topic{}, types.ErrInsufficientWorkerBond
+	}
+
+	// Inferences of topics with several outputs carry one value per output, quantiles of
+	// probabilistic topics not decreasing with their level
+	if inference := bundle.InferenceForecastsBundle.Inference; inference != nil {
+		if err := inference.ValidateOutputDimension(topic.OutputDimension); err != nil {
+			return types.Topic{}, err
+		}
+		if topic.IsProbabilistic() {
+			if err := inference.ValidateQuantiles(); err != nil {
+				return types.Topic{}, err
+			}
+		}
+	}
+	return topic, nil
+}
+
+// Appends the inference and forecast of a checked worker data bundle to the current worker window of its topic.
+// The worker is live for the nonce as soon as its bundle is accepted, whether or not it makes it to the active set.
+func (ms msgServer) appendWorkerDataBundle(
+	ctx context.Context,
+	moduleParams types.Params,
+	topic types.Topic,
+	bundle *types.WorkerDataBundle,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	nonce := bundle.Nonce
+	topicId := topic.Id
+
 	// Process Inferences
-	if msg.WorkerDataBundle.InferenceForecastsBundle.Inference != nil {
-		inference := msg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	if bundle.InferenceForecastsBundle.Inference != nil {
+		inference := bundle.InferenceForecastsBundle.Inference
 		if inference == nil {
-			return nil, errorsmod.Wrapf(types.ErrNoValidInferences, "Inference not found")
+			return errorsmod.Wrapf(types.ErrNoValidInferences, "Inference not found")
 		}
-		if inference.TopicId != msg.WorkerDataBundle.TopicId {
-			return nil, errorsmod.Wrapf(types.ErrInvalidTopicId,
+		if inference.TopicId != bundle.TopicId {
+			return errorsmod.Wrapf(types.ErrInvalidTopicId,
 				"inferer not using the same topic as bundle")
 		}
 
-		err = ms.k.AppendInference(sdkCtx, topic, nonce.BlockHeight, inference, moduleParams.MaxTopInferersToReward)
+		err := ms.k.AppendInference(sdkCtx, topic, nonce.BlockHeight, inference, moduleParams.MaxTopInferersToReward)
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "Error appending inference")
+			return errorsmod.Wrapf(err, "Error appending inference")
 		}
 	}
 
 	// Process Forecasts
-	if msg.WorkerDataBundle.InferenceForecastsBundle.Forecast != nil {
-		forecast := msg.WorkerDataBundle.InferenceForecastsBundle.Forecast
+	if bundle.InferenceForecastsBundle.Forecast != nil {
+		forecast := bundle.InferenceForecastsBundle.Forecast
 		if len(forecast.ForecastElements) == 0 {
-			return nil, errorsmod.Wrapf(types.ErrNoValidForecastElements, "No valid forecast elements found in Forecast")
+			return errorsmod.Wrapf(types.ErrNoValidForecastElements, "No valid forecast elements found in Forecast")
 		}
-		if forecast.TopicId != msg.WorkerDataBundle.TopicId {
-			return nil, errorsmod.Wrapf(types.ErrInvalidTopicId, "forecaster not using the same topic as bundle")
+		if forecast.TopicId != bundle.TopicId {
+			return errorsmod.Wrapf(types.ErrInvalidTopicId, "forecaster not using the same topic as bundle")
 		}
 
 		// Limit forecast elements to top inferers
@@ -125,10 +441,10 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 			// Check if the forecasted inferer is registered in the topic
 			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, el.Inferer)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if !isInfererRegistered {
-				return nil, errorsmod.Wrapf(err,
+				return errorsmod.Wrapf(err,
 					"Error forecasted inferer address is not registered in this topic")
 			}
 
@@ -142,12 +458,12 @@ func (ms msgServer) InsertWorkerPayload(ctx context.Context, msg *types.InsertWo
 
 		if len(acceptedForecastElements) > 0 {
 			forecast.ForecastElements = acceptedForecastElements
-			err = ms.k.AppendForecast(sdkCtx, topic, nonce.BlockHeight, forecast, moduleParams.MaxTopForecastersToReward)
+			err := ms.k.AppendForecast(sdkCtx, topic, nonce.BlockHeight, forecast, moduleParams.MaxTopForecastersToReward)
 			if err != nil {
-				return nil, errorsmod.Wrapf(err,
+				return errorsmod.Wrapf(err,
 					"Error appending forecast")
 			}
 		}
 	}
-	return &types.InsertWorkerPayloadResponse{}, nil
+	return ms.k.RecordActorSubmissions(ctx, topicId, []string{bundle.Worker}, false, nonce.BlockHeight)
 }
@@ -3,7 +3,10 @@ package msgserver_test
 import (
 	"encoding/hex"
 
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -74,6 +77,7 @@ func (s *MsgServerTestSuite) setUpMsgInsertWorkerPayloadWithBlockHeight(
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:     topic.Id,
@@ -547,6 +551,7 @@ func (s *MsgServerTestSuite) TestInsertingHugeBundleWorkerPayloadFails() {
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:          topicId,
@@ -623,6 +628,7 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadVerifyFailed() {
 					Value:       alloraMath.NewDecFromInt64(100),
 					ExtraData:   nil,
 					Proof:       "",
+					Values:      nil,
 				},
 				Forecast: &types.Forecast{
 					TopicId:     topicId,
@@ -797,3 +803,248 @@ func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadWorkerNotMatchSignature()
 	_, err := msgServer.InsertWorkerPayload(ctx, &workerMsg)
 	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadVectorInference() {
+	require := s.Require()
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.OutputDimension = 2
+	err = s.emissionsKeeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	ctx := s.ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+
+	// A single value is refused
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrInvalidOutputDimension)
+
+	// As is a number of values other than the output dimension
+	inference := workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	inference.Value = alloraMath.Dec{}
+	inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(2), alloraMath.NewDecFromInt64(3)}
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrInvalidOutputDimension)
+
+	inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(2)}
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	stored, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, workerMsg.WorkerDataBundle.Worker)
+	require.NoError(err)
+	require.Equal(inference.Values, stored.Values)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadValuesOnSingleOutputTopicFails() {
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, _ := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+
+	inference := workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	inference.Value = alloraMath.Dec{}
+	inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(2)}
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+
+	ctx := s.ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	_, err := s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidOutputDimension)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadCrossingQuantilesFails() {
+	require := s.Require()
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.LossMethod = types.LossMethodPinball
+	topic.OutputDimension = 2
+	topic.QuantileLevels = []alloraMath.Dec{alloraMath.MustNewDecFromString("0.25"), alloraMath.MustNewDecFromString("0.75")}
+	err = s.emissionsKeeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	ctx := s.ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+
+	inference := workerMsg.WorkerDataBundle.InferenceForecastsBundle.Inference
+	inference.Value = alloraMath.Dec{}
+	inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(2), alloraMath.NewDecFromInt64(1)}
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrCrossingQuantiles)
+
+	inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(2)}
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = s.msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadsAcceptsBundlesIndependently() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+
+	// The bundle of the second topic is not signed and gets refused
+	unsignedWorkerPrivateKey := secp256k1.GenPrivKey()
+	unsignedWorkerMsg, unsignedTopicId := s.setUpMsgInsertWorkerPayload(unsignedWorkerPrivateKey)
+
+	sender := s.addrs[5]
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	s.MintTokensToAddress(sender, moduleParams.DataSendingFee.MulRaw(10))
+	balanceBefore := s.bankKeeper.GetBalance(ctx, sender, params.DefaultBondDenom)
+	revenueBefore, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+
+	ctx = ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	response, err := msgServer.InsertWorkerPayloads(ctx, &types.InsertWorkerPayloadsRequest{
+		Sender: sender.String(),
+		WorkerDataBundles: []*types.WorkerDataBundle{
+			workerMsg.WorkerDataBundle,
+			unsignedWorkerMsg.WorkerDataBundle,
+		},
+	})
+	require.NoError(err)
+	require.Len(response.Results, 2)
+	require.True(response.Results[0].Success)
+	require.Empty(response.Results[0].Error)
+	require.Equal(topicId, response.Results[0].TopicId)
+	require.False(response.Results[1].Success)
+	require.NotEmpty(response.Results[1].Error)
+	require.Equal(unsignedTopicId, response.Results[1].TopicId)
+
+	inference, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, workerMsg.WorkerDataBundle.Worker)
+	require.NoError(err)
+	require.NotNil(inference)
+	_, err = s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, unsignedTopicId, unsignedWorkerMsg.WorkerDataBundle.Worker)
+	require.Error(err)
+
+	// Only the accepted bundle is charged
+	balanceAfter := s.bankKeeper.GetBalance(ctx, sender, params.DefaultBondDenom)
+	require.Equal(balanceBefore.Amount.Sub(moduleParams.DataSendingFee), balanceAfter.Amount)
+	revenueAfter, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	require.Equal(revenueBefore.Add(moduleParams.DataSendingFee), revenueAfter)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertWorkerPayloadsRefusesBundlesBeyondBalance() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, _ := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, sender := getNewAddress()
+	ctx = ctx.WithBlockHeight(workerMsg.WorkerDataBundle.Nonce.BlockHeight)
+	response, err := msgServer.InsertWorkerPayloads(ctx, &types.InsertWorkerPayloadsRequest{
+		Sender:            sender,
+		WorkerDataBundles: []*types.WorkerDataBundle{workerMsg.WorkerDataBundle},
+	})
+	require.NoError(err)
+	require.Len(response.Results, 1)
+	require.False(response.Results[0].Success)
+	require.Contains(response.Results[0].Error, sdkerrors.ErrInsufficientFunds.Error())
+
+	_, err = msgServer.InsertWorkerPayloads(ctx, &types.InsertWorkerPayloadsRequest{
+		Sender:            sender,
+		WorkerDataBundles: []*types.WorkerDataBundle{},
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *MsgServerTestSuite) TestMsgCommitRevealWorkerPayload() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertWorkerPayload(workerMsg, workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundle
+	nonce := *bundle.Nonce
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.WorkerRevealWindow = 5
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	// A second worker commits without revealing
+	lateWorker := s.addrsStr[7]
+	err = s.emissionsKeeper.InsertWorker(ctx, topicId, lateWorker, types.OffchainNode{Owner: lateWorker, NodeAddress: lateWorker})
+	require.NoError(err)
+	s.MintTokensToAddress(s.addrs[7], cosmosMath.NewInt(11000))
+	lateWorkerScore := types.Score{TopicId: topicId, BlockHeight: 0, Address: lateWorker, Score: alloraMath.MustNewDecFromString("0.5")}
+	err = s.emissionsKeeper.SetInfererScoreEma(ctx, topicId, lateWorker, lateWorkerScore)
+	require.NoError(err)
+
+	ctx = ctx.WithBlockHeight(nonce.BlockHeight)
+	_, err = msgServer.InsertWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrCommitRevealRequired)
+
+	salt := []byte("a salt nobody guesses")
+	commitment, err := types.WorkerPayloadCommitment(bundle.InferenceForecastsBundle, salt)
+	require.NoError(err)
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.CommitWorkerPayloadRequest{
+		Sender:           bundle.Worker,
+		TopicId:          topicId,
+		NonceBlockHeight: nonce.BlockHeight,
+		Commitment:       commitment,
+	})
+	require.NoError(err)
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.CommitWorkerPayloadRequest{
+		Sender:           bundle.Worker,
+		TopicId:          topicId,
+		NonceBlockHeight: nonce.BlockHeight,
+		Commitment:       commitment,
+	})
+	require.ErrorIs(err, types.ErrCommitmentExists)
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.CommitWorkerPayloadRequest{
+		Sender:           lateWorker,
+		TopicId:          topicId,
+		NonceBlockHeight: nonce.BlockHeight,
+		Commitment:       commitment,
+	})
+	require.NoError(err)
+
+	// Reveals are only accepted once the submission window has closed
+	revealMsg := &types.RevealWorkerPayloadRequest{
+		Sender:           bundle.Worker,
+		WorkerDataBundle: bundle,
+		Salt:             salt,
+	}
+	_, err = msgServer.RevealWorkerPayload(ctx, revealMsg)
+	require.ErrorIs(err, types.ErrRevealWindowNotAvailable)
+
+	ctx = ctx.WithBlockHeight(nonce.BlockHeight + topic.WorkerSubmissionWindow)
+	_, err = msgServer.RevealWorkerPayload(ctx, &types.RevealWorkerPayloadRequest{
+		Sender:           bundle.Worker,
+		WorkerDataBundle: bundle,
+		Salt:             []byte("another salt"),
+	})
+	require.ErrorIs(err, types.ErrCommitmentMismatch)
+
+	_, err = msgServer.RevealWorkerPayload(ctx, revealMsg)
+	require.NoError(err)
+	inference, err := s.emissionsKeeper.GetWorkerLatestInferenceByTopicId(ctx, topicId, bundle.Worker)
+	require.NoError(err)
+	require.Equal(bundle.InferenceForecastsBundle.Inference.Value, inference.Value)
+	_, found, err := s.emissionsKeeper.GetWorkerCommitment(ctx, topicId, bundle.Worker)
+	require.NoError(err)
+	require.False(found)
+
+	// Closing the nonce penalizes the worker who did not reveal
+	ctx = ctx.WithBlockHeight(nonce.BlockHeight + topic.WorkerSubmissionWindow + topic.WorkerRevealWindow)
+	err = actorutils.CloseWorkerNonce(&s.emissionsKeeper, ctx, topic, nonce)
+	require.NoError(err)
+	penalizedScore, err := s.emissionsKeeper.GetInfererScoreEma(ctx, topicId, lateWorker)
+	require.NoError(err)
+	require.True(penalizedScore.Score.Lt(lateWorkerScore.Score))
+	commitments, err := s.emissionsKeeper.GetWorkerCommitmentsOfTopic(ctx, topicId)
+	require.NoError(err)
+	require.Empty(commitments)
+}
@@ -0,0 +1,607 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the block at which a node was last moved to its address in a topic, if ever
+func (k *Keeper) GetLastNodeRotation(ctx context.Context, topicId TopicId, node ActorId) (BlockHeight, bool, error) {
+	block, err := k.lastNodeRotations.Get(ctx, collections.Join(topicId, node))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, errors.Wrap(err, "error getting last node rotation")
+	}
+	return block, true, nil
+}
+
+// Moves a worker registered in a topic to a new address. The registration, score EMAs, reward
+// fractions, regrets, inclusion counts, liveness and bond of the worker in the topic are moved along,
+// as well as a queued removal of its bond.
+// Inferences and forecasts already included in a closed worker nonce are still scored and
+// rewarded under the previous address, so the move is refused while the worker has a
+// submission or a commitment in an open worker window. It is also refused while the new
+// address holds a bond in the topic, which would be merged with the moved bond.
+func (k *Keeper) MoveWorker(ctx context.Context, topicId TopicId, from, to ActorId, nodeInfo types.OffchainNode) error {
+	if err := k.validateNodeMove(ctx, topicId, from, to, nodeInfo, false); err != nil {
+		return err
+	}
+	hasInference, err := k.inferences.Has(ctx, collections.Join(topicId, from))
+	if err != nil {
+		return errors.Wrap(err, "error checking for inference of worker")
+	}
+	hasForecast, err := k.forecasts.Has(ctx, collections.Join(topicId, from))
+	if err != nil {
+		return errors.Wrap(err, "error checking for forecast of worker")
+	}
+	hasCommitment, err := k.workerCommitments.Has(ctx, collections.Join(topicId, from))
+	if err != nil {
+		return errors.Wrap(err, "error checking for commitment of worker")
+	}
+	if hasInference || hasForecast || hasCommitment {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "worker has a submission in an open worker window")
+	}
+	toBond, err := k.GetWorkerBond(ctx, topicId, to)
+	if err != nil {
+		return errors.Wrap(err, "error getting bond of new worker address")
+	}
+	_, toHasBondRemoval, err := k.GetWorkerBondRemoval(ctx, topicId, to)
+	if err != nil {
+		return errors.Wrap(err, "error getting bond removal of new worker address")
+	}
+	if !toBond.IsZero() || toHasBondRemoval {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "new worker address holds a bond in the topic")
+	}
+
+	if err := k.moveTopicActor(ctx, topicId, from, to, false); err != nil {
+		return err
+	}
+	for _, keySet := range []collections.KeySet[collections.Pair[TopicId, ActorId]]{
+		k.activeInferers,
+		k.activeForecasters,
+	} {
+		if err := moveTopicActorKey(ctx, keySet, topicId, from, to); err != nil {
+			return err
+		}
+	}
+	for _, scores := range []collections.Map[collections.Pair[TopicId, ActorId], types.Score]{
+		k.infererScoreEmas,
+		k.forecasterScoreEmas,
+	} {
+		if err := moveTopicActorScore(ctx, scores, topicId, from, to); err != nil {
+			return err
+		}
+	}
+	for _, fractions := range []collections.Map[collections.Pair[TopicId, ActorId], alloraMath.Dec]{
+		k.previousInferenceRewardFraction,
+		k.previousForecastRewardFraction,
+	} {
+		if err := moveTopicActorValue(ctx, fractions, topicId, from, to); err != nil {
+			return err
+		}
+	}
+	for _, regrets := range []collections.Map[collections.Pair[TopicId, ActorId], types.TimestampedValue]{
+		k.latestInfererNetworkRegrets,
+		k.latestForecasterNetworkRegrets,
+		k.latestNaiveInfererNetworkRegrets,
+	} {
+		if err := moveTopicActorValue(ctx, regrets, topicId, from, to); err != nil {
+			return err
+		}
+	}
+	for _, regrets := range []collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.TimestampedValue]{
+		k.latestOneInForecasterNetworkRegrets,
+		k.latestOneOutInfererInfererNetworkRegrets,
+		k.latestOneOutInfererForecasterNetworkRegrets,
+		k.latestOneOutForecasterInfererNetworkRegrets,
+		k.latestOneOutForecasterForecasterNetworkRegrets,
+	} {
+		if err := moveTopicActorPairRegrets(ctx, regrets, topicId, from, to); err != nil {
+			return err
+		}
+	}
+	for _, counts := range []collections.Map[collections.Pair[TopicId, ActorId], uint64]{
+		k.countInfererInclusionsInTopicActiveSet,
+		k.countForecasterInclusionsInTopicActiveSet,
+	} {
+		if err := moveTopicActorValue(ctx, counts, topicId, from, to); err != nil {
+			return err
+		}
+	}
+	if err := moveTopicActorValue(ctx, k.workerLiveness, topicId, from, to); err != nil {
+		return err
+	}
+	if err := k.moveWorkerBond(ctx, topicId, from, to); err != nil {
+		return err
+	}
+
+	for _, lowestScore := range []collections.Map[TopicId, types.Score]{
+		k.lowestInfererScoreEma,
+		k.lowestForecasterScoreEma,
+	} {
+		if err := moveLowestTopicScore(ctx, lowestScore, topicId, from, to); err != nil {
+			return err
+		}
+	}
+
+	if err := k.workers.Set(ctx, to, nodeInfo); err != nil {
+		return errors.Wrap(err, "error setting worker")
+	}
+	return k.setLastNodeRotation(ctx, topicId, from, to)
+}
+
+// Moves a reputer registered in a topic to a new address. The registration, score EMA, listening
+// coefficient, reward fraction, liveness, outlier streak and slash history of the reputer in the topic
+// are moved along, as well as its stake, the stake delegated upon it and the redelegations to or from it.
+// Losses already included in a closed reputer nonce are still rewarded under the previous address, so
+// the move is refused while the reputer has a loss bundle awaiting its reputer nonce to close, or a
+// commitment to one not revealed yet. It is also refused while stake removals from the reputer are
+// pending, as they are keyed by the previous address.
+func (k *Keeper) MoveReputer(ctx context.Context, topicId TopicId, from, to ActorId, nodeInfo types.OffchainNode) error {
+	if err := k.validateNodeMove(ctx, topicId, from, to, nodeInfo, true); err != nil {
+		return err
+	}
+	hasLossBundle, err := k.lossBundles.Has(ctx, collections.Join(topicId, from))
+	if err != nil {
+		return errors.Wrap(err, "error checking for loss bundle of reputer")
+	}
+	if hasLossBundle {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "reputer has a loss bundle awaiting its reputer nonce to close")
+	}
+	hasCommitment, err := k.HasPendingReputerCommitment(ctx, topicId, from)
+	if err != nil {
+		return err
+	}
+	if hasCommitment {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "reputer has a commitment awaiting its reveal")
+	}
+	if err := k.checkNoPendingStakeRemovals(ctx, topicId, from); err != nil {
+		return err
+	}
+	// The stake of the new address, if any, would be merged with the moved stake
+	toStake, err := k.GetStakeReputerAuthority(ctx, topicId, to)
+	if err != nil {
+		return errors.Wrap(err, "error getting stake of new reputer address")
+	}
+	toDelegatedStake, err := k.GetDelegateStakeUponReputer(ctx, topicId, to)
+	if err != nil {
+		return errors.Wrap(err, "error getting delegated stake of new reputer address")
+	}
+	if !toStake.IsZero() || !toDelegatedStake.IsZero() {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "new reputer address holds stake in the topic")
+	}
+
+	if err := k.moveTopicActor(ctx, topicId, from, to, true); err != nil {
+		return err
+	}
+	if err := moveTopicActorKey(ctx, k.activeReputers, topicId, from, to); err != nil {
+		return err
+	}
+	if err := moveTopicActorScore(ctx, k.reputerScoreEmas, topicId, from, to); err != nil {
+		return err
+	}
+	if err := moveTopicActorValue(ctx, k.reputerListeningCoefficient, topicId, from, to); err != nil {
+		return err
+	}
+	if err := moveTopicActorValue(ctx, k.previousReputerRewardFraction, topicId, from, to); err != nil {
+		return err
+	}
+	if err := moveTopicActorValue(ctx, k.reputerLiveness, topicId, from, to); err != nil {
+		return err
+	}
+	// Otherwise a reputer about to be slashed could restart its outlier streak by rotating its key
+	if err := moveTopicActorValue(ctx, k.reputerOutlierStreaks, topicId, from, to); err != nil {
+		return err
+	}
+	if err := k.moveReputerSlashHistory(ctx, topicId, from, to); err != nil {
+		return err
+	}
+	if err := k.moveReputerCommission(ctx, topicId, from, to); err != nil {
+		return err
+	}
+	for _, stakes := range []collections.Map[collections.Pair[TopicId, ActorId], cosmosMath.Int]{
+		k.stakeReputerAuthority,
+		k.stakeFromDelegatorsUponReputer,
+	} {
+		if err := moveTopicActorValue(ctx, stakes, topicId, from, to); err != nil {
+			return err
+		}
+	}
+	if err := moveTopicActorValue(ctx, k.delegateRewardPerShare, topicId, from, to); err != nil {
+		return err
+	}
+	if err := k.moveDelegatedStakes(ctx, topicId, from, to); err != nil {
+		return err
+	}
+	if err := k.moveRedelegations(ctx, topicId, from, to); err != nil {
+		return err
+	}
+	if err := k.moveDelegateStakeReceiptPool(ctx, topicId, from, to); err != nil {
+		return err
+	}
+
+	if err := moveLowestTopicScore(ctx, k.lowestReputerScoreEma, topicId, from, to); err != nil {
+		return err
+	}
+
+	if err := k.reputers.Set(ctx, to, nodeInfo); err != nil {
+		return errors.Wrap(err, "error setting reputer")
+	}
+	return k.setLastNodeRotation(ctx, topicId, from, to)
+}
+
+func (k *Keeper) validateNodeMove(
+	ctx context.Context,
+	topicId TopicId,
+	from, to ActorId,
+	nodeInfo types.OffchainNode,
+	isReputer bool,
+) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errors.Wrap(err, "topic id validation failed")
+	}
+	if err := types.ValidateBech32(to); err != nil {
+		return errors.Wrap(err, "new node address validation failed")
+	}
+	if err := nodeInfo.Validate(); err != nil {
+		return errors.Wrap(err, "node info validation failed")
+	}
+	if from == to || nodeInfo.NodeAddress != to {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "node info must hold the new node address")
+	}
+	// Node infos are shared by every topic, so moving onto the node of another owner would hand
+	// its registrations, bond and stake in the other topics over to the owner of the moved node
+	nodes := k.workers
+	if isReputer {
+		nodes = k.reputers
+	}
+	fromNode, err := nodes.Get(ctx, from)
+	if err != nil {
+		return errors.Wrap(err, "error getting node info of node to move")
+	}
+	for _, nodes := range []collections.Map[ActorId, types.OffchainNode]{k.workers, k.reputers} {
+		toNode, err := nodes.Get(ctx, to)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				continue
+			}
+			return errors.Wrap(err, "error getting node info of new node address")
+		}
+		if toNode.Owner != fromNode.Owner {
+			return errors.Wrap(types.ErrNotNodeOwner, "new node address is a node of another owner")
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) setLastNodeRotation(ctx context.Context, topicId TopicId, from, to ActorId) error {
+	if err := k.lastNodeRotations.Remove(ctx, collections.Join(topicId, from)); err != nil {
+		return errors.Wrap(err, "error removing last node rotation")
+	}
+	block := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.lastNodeRotations.Set(ctx, collections.Join(topicId, to), block)
+}
+
+// Stake removals are keyed by reputer, so none may be pending for the reputer to move,
+// be they removals of its own stake or of stake delegated upon it
+func (k *Keeper) checkNoPendingStakeRemovals(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	rng := collections.NewSuperPrefixedTripleRange[ActorId, TopicId, BlockHeight](reputer, topicId)
+	iter, err := k.stakeRemovalsByActor.Iterate(ctx, rng)
+	if err != nil {
+		return errors.Wrap(err, "error iterating over stake removals of reputer")
+	}
+	hasRemoval := iter.Valid()
+	iter.Close()
+	if hasRemoval {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "reputer has a pending stake removal")
+	}
+
+	hasDelegateRemoval, err := k.HasDelegateStakeRemovalUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	if hasDelegateRemoval {
+		return errors.Wrap(types.ErrNodeRotationBlocked, "reputer has a pending removal of stake delegated upon it")
+	}
+	return nil
+}
+
+// Moves the stake delegated upon a reputer in a topic to another reputer address
+func (k *Keeper) moveDelegatedStakes(ctx context.Context, topicId TopicId, from, to ActorId) error {
+	delegations := make(map[ActorId]map[ActorId]types.DelegatorInfo)
+	delegators := make([]ActorId, 0)
+	for _, reputer := range []ActorId{from, to} {
+		rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, reputer)
+		iter, err := k.reputerDelegations.Iterate(ctx, rng)
+		if err != nil {
+			return errors.Wrap(err, "error iterating over delegations upon reputer")
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return errors.Wrap(err, "error getting delegations upon reputer")
+		}
+		delegations[reputer] = make(map[ActorId]types.DelegatorInfo, len(keys))
+		for _, key := range keys {
+			stake, err := k.delegatedStakes.Get(ctx, collections.Join3(topicId, key.K3(), reputer))
+			if err != nil {
+				return errors.Wrap(err, "error getting delegated stake")
+			}
+			if reputer == from {
+				delegators = append(delegators, key.K3())
+			}
+			delegations[reputer][key.K3()] = stake
+			if err := k.removeDelegation(ctx, topicId, key.K3(), reputer); err != nil {
+				return errors.Wrap(err, "error removing delegated stake")
+			}
+		}
+	}
+	for _, delegator := range delegators {
+		if err := k.setDelegation(ctx, topicId, delegator, to, delegations[from][delegator]); err != nil {
+			return errors.Wrap(err, "error setting delegated stake")
+		}
+	}
+	return nil
+}
+
+// Moves the redelegations of stake to and from a reputer in a topic to another reputer address
+func (k *Keeper) moveRedelegations(ctx context.Context, topicId TopicId, from, to ActorId) error {
+	redelegations := make(map[ActorId][]types.Redelegation)
+	for _, reputer := range []ActorId{from, to} {
+		rng := NewDoublePrefixedQuadrupleRange[TopicId, ActorId, ActorId, ActorId](topicId, reputer)
+		iter, err := k.redelegations.Iterate(ctx, rng)
+		if err != nil {
+			return errors.Wrap(err, "error iterating over redelegations to reputer")
+		}
+		redelegations[reputer], err = iter.Values()
+		if err != nil {
+			return errors.Wrap(err, "error getting redelegations to reputer")
+		}
+		for _, redelegation := range redelegations[reputer] {
+			err := k.DeleteRedelegation(ctx, topicId, redelegation.Delegator, redelegation.SrcReputer, reputer)
+			if err != nil {
+				return errors.Wrap(err, "error removing redelegation")
+			}
+		}
+	}
+	for _, redelegation := range redelegations[from] {
+		if redelegation.SrcReputer == to {
+			continue
+		}
+		redelegation.DstReputer = to
+		if err := k.SetRedelegation(ctx, redelegation); err != nil {
+			return errors.Wrap(err, "error setting redelegation")
+		}
+	}
+
+	// Stake redelegated away from the reputer is still slashed along with it until it matures
+	redelegations = make(map[ActorId][]types.Redelegation)
+	for _, reputer := range []ActorId{from, to} {
+		rng := NewDoublePrefixedQuadrupleRange[TopicId, ActorId, ActorId, ActorId](topicId, reputer)
+		iter, err := k.redelegationsBySrcReputer.Iterate(ctx, rng)
+		if err != nil {
+			return errors.Wrap(err, "error iterating over redelegations from reputer")
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return errors.Wrap(err, "error getting redelegations from reputer")
+		}
+		for _, key := range keys {
+			redelegation, err := k.redelegations.Get(ctx, Join4(topicId, key.K4(), key.K3(), reputer))
+			if err != nil {
+				return errors.Wrap(err, "error getting redelegation")
+			}
+			redelegations[reputer] = append(redelegations[reputer], redelegation)
+			if err := k.DeleteRedelegation(ctx, topicId, key.K3(), reputer, key.K4()); err != nil {
+				return errors.Wrap(err, "error removing redelegation")
+			}
+		}
+	}
+	for _, redelegation := range redelegations[from] {
+		if redelegation.DstReputer == to {
+			continue
+		}
+		redelegation.SrcReputer = to
+		if err := k.SetRedelegation(ctx, redelegation); err != nil {
+			return errors.Wrap(err, "error setting redelegation")
+		}
+	}
+	return nil
+}
+
+func moveTopicActorKey(
+	ctx context.Context,
+	keySet collections.KeySet[collections.Pair[TopicId, ActorId]],
+	topicId TopicId,
+	from, to ActorId,
+) error {
+	has, err := keySet.Has(ctx, collections.Join(topicId, from))
+	if err != nil {
+		return errors.Wrap(err, "error getting topic actor key")
+	}
+	if !has {
+		return keySet.Remove(ctx, collections.Join(topicId, to))
+	}
+	if err := keySet.Remove(ctx, collections.Join(topicId, from)); err != nil {
+		return errors.Wrap(err, "error removing topic actor key")
+	}
+	return keySet.Set(ctx, collections.Join(topicId, to))
+}
+
+// Moves the value of an actor in a topic to another address. A value left under the other
+// address by a previous registration of it is dropped if the actor has no value to move.
+func moveTopicActorValue[V any](
+	ctx context.Context,
+	m collections.Map[collections.Pair[TopicId, ActorId], V],
+	topicId TopicId,
+	from, to ActorId,
+) error {
+	value, err := m.Get(ctx, collections.Join(topicId, from))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return m.Remove(ctx, collections.Join(topicId, to))
+		}
+		return errors.Wrapf(err, "error getting %s", m.GetName())
+	}
+	if err := m.Remove(ctx, collections.Join(topicId, from)); err != nil {
+		return errors.Wrapf(err, "error removing %s", m.GetName())
+	}
+	return m.Set(ctx, collections.Join(topicId, to), value)
+}
+
+// Same as moveTopicActorValue for scores, which also hold the address they belong to
+func moveTopicActorScore(
+	ctx context.Context,
+	m collections.Map[collections.Pair[TopicId, ActorId], types.Score],
+	topicId TopicId,
+	from, to ActorId,
+) error {
+	if err := moveTopicActorValue(ctx, m, topicId, from, to); err != nil {
+		return err
+	}
+	score, err := m.Get(ctx, collections.Join(topicId, to))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil
+		}
+		return errors.Wrapf(err, "error getting %s", m.GetName())
+	}
+	score.Address = to
+	return m.Set(ctx, collections.Join(topicId, to), score)
+}
+
+// Same as moveTopicActorValue for the commission of a reputer, which also holds the address it belongs to
+func (k *Keeper) moveReputerCommission(ctx context.Context, topicId TopicId, from, to ActorId) error {
+	if err := moveTopicActorValue(ctx, k.reputerCommissions, topicId, from, to); err != nil {
+		return err
+	}
+	commission, found, err := k.GetReputerCommission(ctx, topicId, to)
+	if err != nil || !found {
+		return err
+	}
+	commission.Reputer = to
+	return k.SetReputerCommission(ctx, commission)
+}
+
+// Moves the bond of a worker in a topic to another address, along with its queued removal if any
+func (k *Keeper) moveWorkerBond(ctx context.Context, topicId TopicId, from, to ActorId) error {
+	bond, err := k.GetWorkerBond(ctx, topicId, from)
+	if err != nil {
+		return err
+	}
+	if bond.IsZero() {
+		return nil
+	}
+	removal, found, err := k.GetWorkerBondRemoval(ctx, topicId, from)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.DeleteWorkerBondRemoval(ctx, topicId, from); err != nil {
+			return err
+		}
+	}
+	if err := k.SetWorkerBond(ctx, topicId, from, cosmosMath.ZeroInt()); err != nil {
+		return err
+	}
+	if err := k.SetWorkerBond(ctx, topicId, to, bond); err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	removal.Worker = to
+	return k.SetWorkerBondRemoval(ctx, removal)
+}
+
+// Moves the slashes of a reputer in a topic to another address, which also hold the address they belong to
+func (k *Keeper) moveReputerSlashHistory(ctx context.Context, topicId TopicId, from, to ActorId) error {
+	slashes, err := k.GetReputerSlashHistory(ctx, topicId, from)
+	if err != nil {
+		return err
+	}
+	for _, slash := range slashes {
+		if err := k.reputerSlashes.Remove(ctx, collections.Join3(topicId, from, slash.BlockHeight)); err != nil {
+			return errors.Wrap(err, "error removing reputer slash")
+		}
+		slash.Reputer = to
+		if err := k.SetReputerSlash(ctx, slash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Points the lowest score of the active set of a topic to the new address if it belongs to the moved actor
+func moveLowestTopicScore(
+	ctx context.Context,
+	m collections.Map[TopicId, types.Score],
+	topicId TopicId,
+	from, to ActorId,
+) error {
+	score, err := m.Get(ctx, topicId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil
+		}
+		return errors.Wrapf(err, "error getting %s", m.GetName())
+	}
+	if score.Address != from {
+		return nil
+	}
+	score.Address = to
+	return m.Set(ctx, topicId, score)
+}
+
+// Moves the regrets of a topic relating a worker to another worker, the worker being either of them
+func moveTopicActorPairRegrets(
+	ctx context.Context,
+	m collections.Map[collections.Triple[TopicId, ActorId, ActorId], types.TimestampedValue],
+	topicId TopicId,
+	from, to ActorId,
+) error {
+	iter, err := m.Iterate(ctx, collections.NewPrefixedTripleRange[TopicId, ActorId, ActorId](topicId))
+	if err != nil {
+		return errors.Wrapf(err, "error iterating over %s", m.GetName())
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return errors.Wrapf(err, "error getting %s", m.GetName())
+	}
+	moved := make([]collections.KeyValue[collections.Triple[TopicId, ActorId, ActorId], types.TimestampedValue], 0)
+	for _, kv := range kvs {
+		worker1, worker2 := kv.Key.K2(), kv.Key.K3()
+		if worker1 != from && worker1 != to && worker2 != from && worker2 != to {
+			continue
+		}
+		if err := m.Remove(ctx, kv.Key); err != nil {
+			return errors.Wrapf(err, "error removing %s", m.GetName())
+		}
+		if worker1 == to || worker2 == to {
+			continue
+		}
+		if worker1 == from {
+			worker1 = to
+		}
+		if worker2 == from {
+			worker2 = to
+		}
+		moved = append(moved, collections.KeyValue[collections.Triple[TopicId, ActorId, ActorId], types.TimestampedValue]{
+			Key:   collections.Join3(topicId, worker1, worker2),
+			Value: kv.Value,
+		})
+	}
+	for _, kv := range moved {
+		if err := m.Set(ctx, kv.Key, kv.Value); err != nil {
+			return errors.Wrapf(err, "error setting %s", m.GetName())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package queryserver
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (qs queryServer) GetFundingSubscription(ctx context.Context, req *types.GetFundingSubscriptionRequest) (_ *types.GetFundingSubscriptionResponse, err error) {
+	defer metrics.RecordMetrics("GetFundingSubscription", time.Now(), &err)
+
+	subscription, err := qs.k.GetFundingSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		if errors.IsOf(err, types.ErrFundingSubscriptionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetFundingSubscriptionResponse{Subscription: &subscription}, nil
+}
+
+func (qs queryServer) GetTopicFundingSubscriptions(ctx context.Context, req *types.GetTopicFundingSubscriptionsRequest) (_ *types.GetTopicFundingSubscriptionsResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicFundingSubscriptions", time.Now(), &err)
+
+	subscriptions, pagination, err := qs.k.GetTopicFundingSubscriptions(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetTopicFundingSubscriptionsResponse{Subscriptions: subscriptions, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetFunderFundingSubscriptions(ctx context.Context, req *types.GetFunderFundingSubscriptionsRequest) (_ *types.GetFunderFundingSubscriptionsResponse, err error) {
+	defer metrics.RecordMetrics("GetFunderFundingSubscriptions", time.Now(), &err)
+	if err := qs.k.ValidateStringIsBech32(req.Funder); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	subscriptions, pagination, err := qs.k.GetFunderFundingSubscriptions(ctx, req.Funder, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetFunderFundingSubscriptionsResponse{Subscriptions: subscriptions, Pagination: pagination}, nil
+}
@@ -0,0 +1,71 @@
+package queryserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *QueryServerTestSuite) TestGetFundingSubscriptions() {
+	ctx, msgServer, queryServer := s.ctx, s.msgServer, s.queryServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	otherTopicId := s.CreateOneTopic()
+
+	subscriptionIds := make([]uint64, 0)
+	for _, id := range []uint64{topicId, topicId, otherTopicId} {
+		s.MintTokensToAddress(s.addrs[0], cosmosMath.NewInt(1000))
+		response, err := msgServer.CreateFundingSubscription(ctx, &types.CreateFundingSubscriptionRequest{
+			Sender:         s.addrsStr[0],
+			TopicId:        id,
+			AmountPerDrip:  cosmosMath.NewInt(100),
+			IntervalBlocks: 0,
+			EscrowAmount:   cosmosMath.NewInt(1000),
+		})
+		require.NoError(err)
+		subscriptionIds = append(subscriptionIds, response.SubscriptionId)
+	}
+
+	subscription, err := queryServer.GetFundingSubscription(ctx, &types.GetFundingSubscriptionRequest{SubscriptionId: subscriptionIds[2]})
+	require.NoError(err)
+	require.Equal(otherTopicId, subscription.Subscription.TopicId)
+	require.Equal(s.addrsStr[0], subscription.Subscription.Funder)
+
+	_, err = queryServer.GetFundingSubscription(ctx, &types.GetFundingSubscriptionRequest{SubscriptionId: 100})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// The subscriptions of a topic are listed page by page
+	page, err := queryServer.GetTopicFundingSubscriptions(ctx, &types.GetTopicFundingSubscriptionsRequest{
+		TopicId:    topicId,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: nil, Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(page.Subscriptions, 1)
+	require.Equal(subscriptionIds[0], page.Subscriptions[0].Id)
+	require.NotEmpty(page.Pagination.NextKey)
+
+	page, err = queryServer.GetTopicFundingSubscriptions(ctx, &types.GetTopicFundingSubscriptionsRequest{
+		TopicId:    topicId,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: page.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(page.Subscriptions, 1)
+	require.Equal(subscriptionIds[1], page.Subscriptions[0].Id)
+	require.Empty(page.Pagination.NextKey)
+
+	funderPage, err := queryServer.GetFunderFundingSubscriptions(ctx, &types.GetFunderFundingSubscriptionsRequest{
+		Funder:     s.addrsStr[0],
+		Pagination: nil,
+	})
+	require.NoError(err)
+	require.Len(funderPage.Subscriptions, 3)
+
+	funderPage, err = queryServer.GetFunderFundingSubscriptions(ctx, &types.GetFunderFundingSubscriptionsRequest{
+		Funder:     s.addrsStr[1],
+		Pagination: nil,
+	})
+	require.NoError(err)
+	require.Empty(funderPage.Subscriptions)
+}
@@ -95,7 +95,11 @@ func (qs queryServer) GetNetworkInferencesAtBlock(ctx context.Context, req *emis
 		return nil, err
 	}
 
-	return &emissionstypes.GetNetworkInferencesAtBlockResponse{NetworkInferences: result.NetworkInferences}, nil
+	return &emissionstypes.GetNetworkInferencesAtBlockResponse{
+		NetworkInferences:          result.NetworkInferences,
+		ComponentNetworkInferences: result.ComponentNetworkInferences,
+		NetworkQuantiles:           result.NetworkQuantiles,
+	}, nil
 }
 
 // Return full set of inferences in I_i from the chain, as well as weights and forecast implied inferences
@@ -147,6 +151,8 @@ func (qs queryServer) GetLatestNetworkInferences(ctx context.Context, req *emiss
 		LossBlockHeight:                  result.LossBlockHeight,
 		ConfidenceIntervalRawPercentiles: ciRawPercentiles,
 		ConfidenceIntervalValues:         ciValues,
+		ComponentNetworkInferences:       result.ComponentNetworkInferences,
+		NetworkQuantiles:                 result.NetworkQuantiles,
 	}, nil
 }
 
@@ -203,6 +209,8 @@ func (qs queryServer) GetLatestAvailableNetworkInferences(ctx context.Context, r
 		LossBlockHeight:                  lastReputerCommit.Nonce.BlockHeight,
 		ConfidenceIntervalRawPercentiles: ciRawPercentiles,
 		ConfidenceIntervalValues:         ciValues,
+		ComponentNetworkInferences:       result.ComponentNetworkInferences,
+		NetworkQuantiles:                 result.NetworkQuantiles,
 	}, nil
 }
 
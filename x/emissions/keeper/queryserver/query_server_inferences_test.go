@@ -1,6 +1,8 @@
 package queryserver_test
 
 import (
+	"slices"
+
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
@@ -88,6 +90,7 @@ func (s *QueryServerTestSuite) TestGetWorkerLatestInferenceByTopicId() {
 		Value:       alloraMath.MustNewDecFromString("123.456"),
 		ExtraData:   nil,
 		Proof:       "",
+		Values:      nil,
 	}
 	err = keeper.InsertInference(ctx, topicId, inference)
 	s.Require().NoError(err, "Inserting inferences should succeed")
@@ -140,6 +143,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature1 := s.signValueBundle(&valueBundle1, s.privKeys[5])
 
@@ -156,6 +161,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature2 := s.signValueBundle(&valueBundle2, s.privKeys[6])
 	valueBundle3 := types.ValueBundle{
@@ -171,6 +178,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature3 := s.signValueBundle(&valueBundle3, s.privKeys[7])
 	valueBundle4 := types.ValueBundle{
@@ -186,6 +195,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature4 := s.signValueBundle(&valueBundle4, s.privKeys[8])
 	valueBundle5 := types.ValueBundle{
@@ -201,6 +212,8 @@ func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature5 := s.signValueBundle(&valueBundle5, s.privKeys[9])
 	reputerLossBundles := types.ReputerValueBundles{
@@ -331,6 +344,8 @@ func (s *QueryServerTestSuite) TestGetLatestNetworkInferences() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	})
 	require.NoError(err)
 
@@ -646,6 +661,7 @@ func (s *QueryServerTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("10"),
 		ExtraData:   []byte("data1"),
 		Proof:       "proof1",
+		Values:      nil,
 	}
 	inferences1 := types.Inferences{
 		Inferences: []*types.Inference{&newInference1},
@@ -663,6 +679,7 @@ func (s *QueryServerTestSuite) TestGetLatestTopicInferences() {
 		Value:       alloraMath.MustNewDecFromString("20"),
 		ExtraData:   []byte("data2"),
 		Proof:       "proof2",
+		Values:      nil,
 	}
 	inferences2 := types.Inferences{
 		Inferences: []*types.Inference{&newInference2},
@@ -718,6 +735,8 @@ func (s *QueryServerTestSuite) TestGetLatestAvailableNetworkInference() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	// Set Loss bundles
 	err = keeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, lossBlockHeight, lossBundle)
@@ -925,6 +944,8 @@ func (s *QueryServerTestSuite) TestTestGetLatestAvailableNetworkInferenceWithMis
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	err = keeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, lossBlockHeight, lossBundle)
 	require.NoError(err)
@@ -1126,3 +1147,125 @@ func (s *QueryServerTestSuite) TestGetActiveInferersForTopic() {
 	s.Require().NotNil(emptyResponse)
 	s.Require().Empty(emptyResponse.Inferers)
 }
+
+func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlockOfVectorTopic() {
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.OutputDimension = 2
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+
+	blockHeight := int64(10)
+	inferers := []string{s.addrsStr[5], s.addrsStr[6], s.addrsStr[7]}
+	values := [][]alloraMath.Dec{
+		{alloraMath.MustNewDecFromString("1"), alloraMath.MustNewDecFromString("-4")},
+		{alloraMath.MustNewDecFromString("2"), alloraMath.MustNewDecFromString("-5")},
+		{alloraMath.MustNewDecFromString("3"), alloraMath.MustNewDecFromString("-6")},
+	}
+	inferences := types.Inferences{Inferences: make([]*types.Inference, 0, len(inferers))}
+	for i, inferer := range inferers {
+		inferences.Inferences = append(inferences.Inferences, &types.Inference{
+			TopicId:     topicId,
+			BlockHeight: blockHeight,
+			Inferer:     inferer,
+			Value:       alloraMath.Dec{},
+			ExtraData:   nil,
+			Proof:       "",
+			Values:      values[i],
+		})
+	}
+	err = keeper.InsertActiveInferences(s.ctx, topicId, blockHeight, inferences)
+	require.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(blockHeight + 10)
+	err = keeper.UpdateTopicEpochLastEnded(s.ctx, topicId, blockHeight+10)
+	require.NoError(err)
+
+	response, err := queryServer.GetNetworkInferencesAtBlock(s.ctx, &types.GetNetworkInferencesAtBlockRequest{
+		TopicId:                  topicId,
+		BlockHeightLastInference: blockHeight,
+	})
+	require.NoError(err)
+	require.Len(response.ComponentNetworkInferences, 2)
+
+	// Every output is synthesized from the matching value of each inference
+	for component, networkInferences := range response.ComponentNetworkInferences {
+		require.Len(networkInferences.InfererValues, len(inferers))
+		for _, infererValue := range networkInferences.InfererValues {
+			i := slices.Index(inferers, infererValue.Worker)
+			require.NotEqual(-1, i)
+			require.True(values[i][component].Equal(infererValue.Value))
+		}
+	}
+	// The network inferences are synthesized from the norms of the inferences
+	require.NotNil(response.NetworkInferences)
+	for _, infererValue := range response.NetworkInferences.InfererValues {
+		i := slices.Index(inferers, infererValue.Worker)
+		norm, err := alloraMath.L2Norm(values[i])
+		require.NoError(err)
+		require.True(norm.Equal(infererValue.Value))
+	}
+}
+
+func (s *QueryServerTestSuite) TestGetNetworkInferencesAtBlockOfProbabilisticTopic() {
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	topic, err := keeper.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	levels := []alloraMath.Dec{alloraMath.MustNewDecFromString("0.25"), alloraMath.MustNewDecFromString("0.75")}
+	topic.LossMethod = types.LossMethodPinball
+	topic.OutputDimension = 2
+	topic.QuantileLevels = levels
+	err = keeper.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+
+	blockHeight := int64(10)
+	inferers := []string{s.addrsStr[5], s.addrsStr[6], s.addrsStr[7]}
+	quantiles := [][]alloraMath.Dec{
+		{alloraMath.MustNewDecFromString("1"), alloraMath.MustNewDecFromString("4")},
+		{alloraMath.MustNewDecFromString("2"), alloraMath.MustNewDecFromString("5")},
+		{alloraMath.MustNewDecFromString("3"), alloraMath.MustNewDecFromString("9")},
+	}
+	inferences := types.Inferences{Inferences: make([]*types.Inference, 0, len(inferers))}
+	for i, inferer := range inferers {
+		inferences.Inferences = append(inferences.Inferences, &types.Inference{
+			TopicId:     topicId,
+			BlockHeight: blockHeight,
+			Inferer:     inferer,
+			Value:       alloraMath.Dec{},
+			ExtraData:   nil,
+			Proof:       "",
+			Values:      quantiles[i],
+		})
+	}
+	err = keeper.InsertActiveInferences(s.ctx, topicId, blockHeight, inferences)
+	require.NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(blockHeight + 10)
+	err = keeper.UpdateTopicEpochLastEnded(s.ctx, topicId, blockHeight+10)
+	require.NoError(err)
+
+	response, err := queryServer.GetNetworkInferencesAtBlock(s.ctx, &types.GetNetworkInferencesAtBlockRequest{
+		TopicId:                  topicId,
+		BlockHeightLastInference: blockHeight,
+	})
+	require.NoError(err)
+
+	// Without network losses, each quantile is the median of the quantiles of the inferers at its level
+	require.Len(response.NetworkQuantiles, len(levels))
+	expected := []alloraMath.Dec{alloraMath.MustNewDecFromString("2"), alloraMath.MustNewDecFromString("5")}
+	for i, quantile := range response.NetworkQuantiles {
+		require.True(levels[i].Equal(quantile.Level))
+		require.True(expected[i].Equal(quantile.Value), "level %s: expected %s, got %s", quantile.Level, expected[i], quantile.Value)
+	}
+	// The point inference is synthesized from the central quantiles, the lowest one on ties
+	require.True(alloraMath.MustNewDecFromString("2").Equal(response.NetworkInferences.CombinedValue))
+}
@@ -32,6 +32,8 @@ func (s *QueryServerTestSuite) TestGetNetworkLossBundleAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	err := keeper.InsertNetworkLossBundleAtBlock(ctx, topicId, blockHeight, *expectedBundle)
@@ -125,6 +127,8 @@ func (s *QueryServerTestSuite) TestGetReputerLossBundlesAtBlock() {
 		OneOutForecasterValues:        nil,
 		OneInForecasterValues:         nil,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	signature := s.signValueBundle(&valueBundle, s.privKeys[0])
 	reputerLossBundles := types.ReputerValueBundles{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -70,3 +71,65 @@ func (qs queryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *typ
 
 	return &types.IsReputerRegisteredInTopicIdResponse{IsRegistered: isRegistered}, nil
 }
+
+// Returns the liveness of a worker or reputer in a topic and the number of epochs it missed in a row
+func (qs queryServer) GetActorLiveness(ctx context.Context, req *types.GetActorLivenessRequest) (_ *types.GetActorLivenessResponse, err error) {
+	defer metrics.RecordMetrics("GetActorLiveness", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Actor); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	liveness, found, err := qs.k.GetActorLiveness(ctx, req.TopicId, req.Actor, req.IsReputer)
+	if err != nil {
+		return nil, err
+	}
+	var missedEpochs uint64
+	if found {
+		missedEpochs = liveness.MissedEpochs(keeper.LivenessReferenceBlock(topic, req.IsReputer), topic.EpochLength)
+	}
+
+	return &types.GetActorLivenessResponse{Liveness: &liveness, MissedEpochs: missedEpochs, Found: found}, nil
+}
+
+// Returns the participation of an actor in every topic it is registered in, as a worker and as a reputer
+func (qs queryServer) GetActorProfile(ctx context.Context, req *types.GetActorProfileRequest) (_ *types.GetActorProfileResponse, err error) {
+	defer metrics.RecordMetrics("GetActorProfile", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Actor); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	workerTopicIds, err := qs.k.GetActorTopicIds(ctx, req.Actor, false)
+	if err != nil {
+		return nil, err
+	}
+	workerTopics := make([]*types.WorkerTopicProfile, 0, len(workerTopicIds))
+	for _, topicId := range workerTopicIds {
+		profile, err := qs.k.GetWorkerTopicProfile(ctx, topicId, req.Actor)
+		if err != nil {
+			return nil, err
+		}
+		workerTopics = append(workerTopics, &profile)
+	}
+
+	reputerTopicIds, err := qs.k.GetActorTopicIds(ctx, req.Actor, true)
+	if err != nil {
+		return nil, err
+	}
+	reputerTopics := make([]*types.ReputerTopicProfile, 0, len(reputerTopicIds))
+	for _, topicId := range reputerTopicIds {
+		profile, err := qs.k.GetReputerTopicProfile(ctx, topicId, req.Actor)
+		if err != nil {
+			return nil, err
+		}
+		reputerTopics = append(reputerTopics, &profile)
+	}
+
+	return &types.GetActorProfileResponse{WorkerTopics: workerTopics, ReputerTopics: reputerTopics}, nil
+}
@@ -1,7 +1,9 @@
 package queryserver_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	minttypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -188,3 +190,113 @@ func (s *QueryServerTestSuite) TestRegisteredReputerIsRegisteredInTopicId() {
 	require.NoError(err, "IsReputerRegisteredInTopicId should not return an error")
 	require.True(queryResp.IsRegistered, "Query response should confirm reputer is registered")
 }
+
+func (s *QueryServerTestSuite) TestGetActorLiveness() {
+	ctx := s.ctx
+	require := s.Require()
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, s.CreateOneTopic())
+	require.NoError(err)
+	topic.EpochLastEnded = 3 * topic.EpochLength
+	err = s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	require.NoError(err)
+	worker := s.addrsStr[1]
+
+	response, err := s.queryServer.GetActorLiveness(ctx, &types.GetActorLivenessRequest{
+		TopicId:   topic.Id,
+		Actor:     worker,
+		IsReputer: false,
+	})
+	require.NoError(err)
+	require.False(response.Found)
+	require.Equal(uint64(0), response.MissedEpochs)
+
+	err = s.emissionsKeeper.RecordActorSubmissions(ctx, topic.Id, []string{worker}, false, topic.EpochLength)
+	require.NoError(err)
+	response, err = s.queryServer.GetActorLiveness(ctx, &types.GetActorLivenessRequest{
+		TopicId:   topic.Id,
+		Actor:     worker,
+		IsReputer: false,
+	})
+	require.NoError(err)
+	require.True(response.Found)
+	require.Equal(topic.EpochLength, response.Liveness.LastSubmissionNonce)
+	require.Equal(uint64(2), response.MissedEpochs)
+
+	// Reputers are not expected to have reported losses for nonces within the ground truth lag yet
+	err = s.emissionsKeeper.RecordActorSubmissions(ctx, topic.Id, []string{worker}, true, topic.EpochLength)
+	require.NoError(err)
+	response, err = s.queryServer.GetActorLiveness(ctx, &types.GetActorLivenessRequest{
+		TopicId:   topic.Id,
+		Actor:     worker,
+		IsReputer: true,
+	})
+	require.NoError(err)
+	require.True(response.Found)
+	require.Equal(uint64(1), response.MissedEpochs)
+}
+
+func (s *QueryServerTestSuite) TestGetActorProfile() {
+	ctx := s.ctx
+	require := s.Require()
+
+	workerTopicId := s.CreateOneTopic()
+	bothTopicId := s.CreateOneTopic()
+	actor := s.addrsStr[1]
+	nodeInfo := types.OffchainNode{Owner: actor, NodeAddress: actor}
+	for _, topicId := range []uint64{workerTopicId, bothTopicId} {
+		err := s.emissionsKeeper.InsertWorker(ctx, topicId, actor, nodeInfo)
+		require.NoError(err)
+	}
+	err := s.emissionsKeeper.InsertReputer(ctx, bothTopicId, actor, nodeInfo)
+	require.NoError(err)
+
+	// Scored inferer of the first topic
+	latestScore := types.Score{TopicId: workerTopicId, BlockHeight: 10, Address: actor, Score: alloraMath.MustNewDecFromString("0.3")}
+	err = s.emissionsKeeper.InsertWorkerInferenceScore(ctx, workerTopicId, 10, latestScore)
+	require.NoError(err)
+	scoreEma := types.Score{TopicId: workerTopicId, BlockHeight: 10, Address: actor, Score: alloraMath.MustNewDecFromString("0.2")}
+	err = s.emissionsKeeper.SetInfererScoreEma(ctx, workerTopicId, actor, scoreEma)
+	require.NoError(err)
+	err = s.emissionsKeeper.IncrementCountInfererInclusionsInTopic(ctx, workerTopicId, actor)
+	require.NoError(err)
+	err = s.emissionsKeeper.AddActiveInferer(ctx, workerTopicId, actor)
+	require.NoError(err)
+
+	// Staked reputer of the second topic
+	err = s.emissionsKeeper.AddReputerStake(ctx, bothTopicId, actor, cosmosMath.NewInt(100))
+	require.NoError(err)
+	err = s.emissionsKeeper.SetPreviousReputerRewardFraction(ctx, bothTopicId, actor, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(err)
+
+	response, err := s.queryServer.GetActorProfile(ctx, &types.GetActorProfileRequest{Actor: actor})
+	require.NoError(err)
+	require.Len(response.WorkerTopics, 2)
+	require.Len(response.ReputerTopics, 1)
+
+	workerProfile := response.WorkerTopics[0]
+	require.Equal(workerTopicId, workerProfile.TopicId)
+	require.Equal(&latestScore, workerProfile.LatestInfererScore)
+	require.Equal(&scoreEma, workerProfile.InfererScoreEma)
+	require.Nil(workerProfile.LatestForecasterScore)
+	require.Equal(uint64(1), workerProfile.InfererInclusions)
+	require.True(workerProfile.IsActiveInferer)
+	require.False(workerProfile.IsActiveForecaster)
+	require.Equal(bothTopicId, response.WorkerTopics[1].TopicId)
+	require.False(response.WorkerTopics[1].IsActiveInferer)
+
+	reputerProfile := response.ReputerTopics[0]
+	require.Equal(bothTopicId, reputerProfile.TopicId)
+	require.Equal(cosmosMath.NewInt(100), reputerProfile.Stake)
+	require.True(reputerProfile.DelegatedStake.IsZero())
+	require.True(reputerProfile.PreviousRewardFraction.Equal(alloraMath.MustNewDecFromString("0.5")))
+	require.False(reputerProfile.IsActive)
+
+	// Topics the actor left are no longer part of its profile
+	err = s.emissionsKeeper.RemoveWorker(ctx, workerTopicId, actor)
+	require.NoError(err)
+	response, err = s.queryServer.GetActorProfile(ctx, &types.GetActorProfileRequest{Actor: actor})
+	require.NoError(err)
+	require.Len(response.WorkerTopics, 1)
+	require.Equal(bothTopicId, response.WorkerTopics[0].TopicId)
+}
@@ -4,8 +4,14 @@ import (
 	"context"
 	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (qs queryServer) GetPreviousReputerRewardFraction(ctx context.Context, req *types.GetPreviousReputerRewardFractionRequest) (_ *types.GetPreviousReputerRewardFractionResponse, err error) {
@@ -62,3 +68,56 @@ func (qs queryServer) GetTotalRewardToDistribute(ctx context.Context, req *types
 
 	return &types.GetTotalRewardToDistributeResponse{TotalReward: totalReward}, nil
 }
+
+func (qs queryServer) GetTopicCreatorRewards(ctx context.Context, req *types.GetTopicCreatorRewardsRequest) (_ *types.GetTopicCreatorRewardsResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicCreatorRewards", time.Now(), &err)
+
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, types.ErrTopicDoesNotExist.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	params, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	effectiveCommission, err := topic.EffectiveCreatorCommission(params.MaxTopicCreatorCommission)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	totalRewards, err := qs.k.GetTopicCreatorRewards(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetTopicCreatorRewardsResponse{
+		Creator:             topic.Creator,
+		CreatorCommission:   topic.CreatorCommission,
+		EffectiveCommission: effectiveCommission,
+		TotalRewards:        totalRewards,
+	}, nil
+}
+
+func (qs queryServer) SimulateRewards(ctx context.Context, req *types.SimulateRewardsRequest) (_ *types.SimulateRewardsResponse, err error) {
+	defer metrics.RecordMetrics("SimulateRewards", time.Now(), &err)
+
+	exists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !exists {
+		return nil, status.Error(codes.NotFound, types.ErrTopicDoesNotExist.Error())
+	}
+
+	// Simulated on a branch of the state, so nothing computed here is committed
+	simulation, err := rewards.SimulateTopicRewards(sdk.UnwrapSDKContext(ctx), qs.k, req.TopicId)
+	if err != nil {
+		if errors.IsOf(err, types.ErrInvalidReward) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return simulation, nil
+}
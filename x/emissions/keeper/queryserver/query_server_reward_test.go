@@ -1,8 +1,11 @@
 package queryserver_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *QueryServerTestSuite) TestGetPreviousReputerRewardFraction() {
@@ -136,3 +139,48 @@ func (s *QueryServerTestSuite) TestGetPreviousPercentageRewardToStakedReputers()
 		previousPercentageReward.String(),
 	)
 }
+
+func (s *QueryServerTestSuite) TestGetTopicCreatorRewards() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+
+	topic, err := keeper.GetTopic(ctx, topicId)
+	s.Require().NoError(err)
+	topic.CreatorCommission = alloraMath.MustNewDecFromString("0.08")
+	err = keeper.SetTopic(ctx, topicId, topic)
+	s.Require().NoError(err)
+	err = keeper.AddTopicCreatorRewards(ctx, topicId, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = keeper.AddTopicCreatorRewards(ctx, topicId, cosmosMath.NewInt(50))
+	s.Require().NoError(err)
+
+	// Lowering the max commission caps the commission paid out of existing topics
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.MaxTopicCreatorCommission = alloraMath.MustNewDecFromString("0.05")
+	err = keeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetTopicCreatorRewards(ctx, &types.GetTopicCreatorRewardsRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Equal(topic.Creator, response.Creator)
+	s.Require().True(response.CreatorCommission.Equal(alloraMath.MustNewDecFromString("0.08")))
+	s.Require().True(response.EffectiveCommission.Equal(alloraMath.MustNewDecFromString("0.05")))
+	s.Require().Equal(cosmosMath.NewInt(150), response.TotalRewards)
+
+	_, err = s.queryServer.GetTopicCreatorRewards(ctx, &types.GetTopicCreatorRewardsRequest{TopicId: topicId + 1})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *QueryServerTestSuite) TestSimulateRewards() {
+	ctx := s.ctx
+	topicId := s.CreateOneTopic()
+
+	// No loss bundles were closed yet, so there is no reward nonce to simulate
+	_, err := s.queryServer.SimulateRewards(ctx, &types.SimulateRewardsRequest{TopicId: topicId})
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.queryServer.SimulateRewards(ctx, &types.SimulateRewardsRequest{TopicId: topicId + 1})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/errors"
 	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -369,3 +370,250 @@ func (qs queryServer) GetDelegateStakeRemoval(ctx context.Context, req *types.Ge
 
 	return &types.GetDelegateStakeRemovalResponse{StakeRemovalInfo: &delegateStakeRemoval}, nil
 }
+
+func (qs queryServer) GetWorkerBond(ctx context.Context, req *types.GetWorkerBondRequest) (_ *types.GetWorkerBondResponse, err error) {
+	defer metrics.RecordMetrics("GetWorkerBond", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Worker); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	bond, err := qs.k.GetWorkerBond(ctx, req.TopicId, req.Worker)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetWorkerBondResponse{Amount: bond}, nil
+}
+
+func (qs queryServer) GetWorkerBondRemovalInfo(ctx context.Context, req *types.GetWorkerBondRemovalInfoRequest) (_ *types.GetWorkerBondRemovalInfoResponse, err error) {
+	defer metrics.RecordMetrics("GetWorkerBondRemovalInfo", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Worker); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	removal, found, err := qs.k.GetWorkerBondRemoval(ctx, req.TopicId, req.Worker)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "no worker bond removal found")
+	}
+	return &types.GetWorkerBondRemovalInfoResponse{Removal: &removal}, nil
+}
+
+func (qs queryServer) GetReputerSlashHistory(ctx context.Context, req *types.GetReputerSlashHistoryRequest) (_ *types.GetReputerSlashHistoryResponse, err error) {
+	defer metrics.RecordMetrics("GetReputerSlashHistory", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Reputer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	slashes, err := qs.k.GetReputerSlashHistory(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	outlierStreak, err := qs.k.GetReputerOutlierStreak(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	slashPointers := make([]*types.ReputerSlash, len(slashes))
+	for i := range slashes {
+		slashPointers[i] = &slashes[i]
+	}
+	return &types.GetReputerSlashHistoryResponse{Slashes: slashPointers, OutlierStreak: outlierStreak}, nil
+}
+
+func (qs queryServer) GetReputerCommission(ctx context.Context, req *types.GetReputerCommissionRequest) (_ *types.GetReputerCommissionResponse, err error) {
+	defer metrics.RecordMetrics("GetReputerCommission", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Reputer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	commission, found, err := qs.k.GetReputerCommission(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetReputerCommissionResponse{Commission: &commission, Found: found}, nil
+}
+
+// Returns a page of the delegations of a delegator with their pending rewards and in-flight removals.
+// The first page, requested without a pagination key, also returns totals over all the delegations of
+// the delegator, which are not computed again for the next pages as they would iterate every delegation.
+func (qs queryServer) GetDelegatorPortfolio(ctx context.Context, req *types.GetDelegatorPortfolioRequest) (_ *types.GetDelegatorPortfolioResponse, err error) {
+	defer metrics.RecordMetrics("GetDelegatorPortfolio", time.Now(), &err)
+
+	if err := qs.k.ValidateStringIsBech32(req.Delegator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	page, pageRes, err := qs.k.GetDelegatorDelegationsPage(ctx, req.Delegator, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*types.DelegationPosition, 0, len(page))
+	for _, delegation := range page {
+		position, err := qs.getDelegationPosition(ctx, delegation.K1(), req.Delegator, delegation.K2())
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+
+	totalAmount := alloraMath.ZeroDec()
+	totalPendingReward := alloraMath.ZeroDec()
+	totalPendingRemoval := cosmosMath.ZeroInt()
+	if req.Pagination == nil || len(req.Pagination.Key) == 0 {
+		delegations, err := qs.k.GetDelegatorDelegations(ctx, req.Delegator)
+		if err != nil {
+			return nil, err
+		}
+		for _, delegation := range delegations {
+			position, err := qs.getDelegationPosition(ctx, delegation.K1(), req.Delegator, delegation.K2())
+			if err != nil {
+				return nil, err
+			}
+			totalAmount, err = totalAmount.Add(position.Amount)
+			if err != nil {
+				return nil, err
+			}
+			totalPendingReward, err = totalPendingReward.Add(position.PendingReward)
+			if err != nil {
+				return nil, err
+			}
+			if position.PendingRemoval != nil {
+				totalPendingRemoval = totalPendingRemoval.Add(position.PendingRemoval.Amount)
+			}
+		}
+	}
+
+	autoCompound, err := qs.k.IsDelegateRewardAutoCompound(ctx, req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetDelegatorPortfolioResponse{
+		Delegations:         positions,
+		Pagination:          pageRes,
+		TotalAmount:         totalAmount,
+		TotalPendingReward:  totalPendingReward,
+		TotalPendingRemoval: totalPendingRemoval,
+		AutoCompound:        autoCompound,
+	}, nil
+}
+
+func (qs queryServer) getDelegationPosition(
+	ctx context.Context,
+	topicId uint64,
+	delegator string,
+	reputer string,
+) (*types.DelegationPosition, error) {
+	delegateInfo, err := qs.k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return nil, err
+	}
+	pendingReward, err := qs.k.GetDelegatePendingReward(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return nil, err
+	}
+	position := &types.DelegationPosition{
+		TopicId:        topicId,
+		Reputer:        reputer,
+		Amount:         delegateInfo.Amount,
+		PendingReward:  pendingReward,
+		PendingRemoval: nil,
+		Redelegations:  nil,
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	removal, found, err := qs.k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(sdkCtx, delegator, reputer, topicId)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		position.PendingRemoval = &removal
+	}
+	redelegations, err := qs.k.GetUnmaturedRedelegationsToReputer(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return nil, err
+	}
+	for i := range redelegations {
+		position.Redelegations = append(position.Redelegations, &redelegations[i])
+	}
+	return position, nil
+}
+
+func (qs queryServer) GetDelegateStakeReceiptPool(ctx context.Context, req *types.GetDelegateStakeReceiptPoolRequest) (_ *types.GetDelegateStakeReceiptPoolResponse, err error) {
+	defer metrics.RecordMetrics("GetDelegateStakeReceiptPool", time.Now(), &err)
+
+	pool, found, err := qs.k.GetDelegateStakeReceiptPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &types.GetDelegateStakeReceiptPoolResponse{
+			Pool:          nil,
+			Found:         false,
+			Stake:         alloraMath.ZeroDec(),
+			PendingReward: alloraMath.ZeroDec(),
+		}, nil
+	}
+	stake, pendingReward, err := qs.k.GetDelegateStakeReceiptPoolStake(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetDelegateStakeReceiptPoolResponse{
+		Pool:          &pool,
+		Found:         true,
+		Stake:         stake,
+		PendingReward: pendingReward,
+	}, nil
+}
+
+// Returns the own and delegated stake of a reputer in a topic along with the limits on its delegated stake
+func (qs queryServer) GetReputerDelegateStakeRatio(ctx context.Context, req *types.GetReputerDelegateStakeRatioRequest) (_ *types.GetReputerDelegateStakeRatioResponse, err error) {
+	defer metrics.RecordMetrics("GetReputerDelegateStakeRatio", time.Now(), &err)
+
+	moduleParams, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selfStake, delegateStake, err := qs.k.GetReputerSelfAndDelegateStake(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	ratio := alloraMath.ZeroDec()
+	if selfStake.IsPositive() {
+		selfStakeDec, err := alloraMath.NewDecFromSdkInt(selfStake)
+		if err != nil {
+			return nil, err
+		}
+		delegateStakeDec, err := alloraMath.NewDecFromSdkInt(delegateStake)
+		if err != nil {
+			return nil, err
+		}
+		ratio, err = delegateStakeDec.Quo(selfStakeDec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	effectiveStake, err := qs.k.GetReputerEffectiveStake(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetReputerDelegateStakeRatioResponse{
+		SelfStake:                   selfStake,
+		DelegateStake:               delegateStake,
+		DelegateToSelfStakeRatio:    ratio,
+		MaxDelegateToSelfStakeRatio: moduleParams.MaxDelegateToSelfStakeRatio,
+		MaxDelegateStakePerReputer:  moduleParams.MaxDelegateStakePerReputer,
+		EffectiveStake:              effectiveStake,
+	}, nil
+}
@@ -489,3 +489,184 @@ func (s *QueryServerTestSuite) TestGetStakeRemovalForReputerAndTopicId() {
 	s.Require().NoError(err)
 	s.Require().Equal(&stakeRemovalInfo, response.StakeRemovalInfo)
 }
+
+func (s *QueryServerTestSuite) TestGetReputerSlashHistory() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	reputer := s.addrsStr[2]
+	topicId := uint64(1)
+
+	slash := types.ReputerSlash{
+		TopicId:              topicId,
+		Reputer:              reputer,
+		BlockHeight:          10,
+		Distance:             alloraMath.MustNewDecFromString("0.8"),
+		ReputerStakeSlashed:  cosmosMath.NewInt(100),
+		DelegateStakeSlashed: cosmosMath.NewInt(50),
+	}
+	err := k.SetReputerSlash(ctx, slash)
+	s.Require().NoError(err)
+	err = k.SetReputerOutlierStreak(ctx, topicId, reputer, 2)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetReputerSlashHistory(ctx, &types.GetReputerSlashHistoryRequest{
+		TopicId: topicId,
+		Reputer: reputer,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.ReputerSlash{&slash}, response.Slashes)
+	s.Require().Equal(uint64(2), response.OutlierStreak)
+
+	_, err = s.queryServer.GetReputerSlashHistory(ctx, &types.GetReputerSlashHistoryRequest{
+		TopicId: topicId,
+		Reputer: "invalid",
+	})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetReputerCommission() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	reputer := s.addrsStr[2]
+	topicId := uint64(1)
+
+	req := &types.GetReputerCommissionRequest{
+		TopicId: topicId,
+		Reputer: reputer,
+	}
+	response, err := s.queryServer.GetReputerCommission(ctx, req)
+	s.Require().NoError(err)
+	s.Require().False(response.Found)
+
+	commission := types.ReputerCommission{
+		TopicId:           topicId,
+		Reputer:           reputer,
+		Rate:              alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:           alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate:     alloraMath.MustNewDecFromString("0.01"),
+		UpdateBlockHeight: 10,
+	}
+	err = k.SetReputerCommission(ctx, commission)
+	s.Require().NoError(err)
+
+	response, err = s.queryServer.GetReputerCommission(ctx, req)
+	s.Require().NoError(err)
+	s.Require().True(response.Found)
+	s.Require().Equal(&commission, response.Commission)
+}
+
+func (s *QueryServerTestSuite) TestGetDelegatorPortfolio() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	delegator := s.addrsStr[0]
+	reputer1 := s.addrsStr[1]
+	reputer2 := s.addrsStr[2]
+
+	placements := []struct {
+		topicId    uint64
+		reputer    string
+		amount     string
+		rewardDebt string
+	}{
+		{topicId: 1, reputer: reputer1, amount: "100", rewardDebt: "50"},
+		{topicId: 1, reputer: reputer2, amount: "200", rewardDebt: "0"},
+		{topicId: 2, reputer: reputer1, amount: "300", rewardDebt: "300"},
+	}
+	for _, p := range placements {
+		err := k.SetDelegateStakePlacement(ctx, p.topicId, delegator, p.reputer, types.DelegatorInfo{
+			Amount:     alloraMath.MustNewDecFromString(p.amount),
+			RewardDebt: alloraMath.MustNewDecFromString(p.rewardDebt),
+		})
+		s.Require().NoError(err)
+		err = k.SetDelegateRewardPerShare(ctx, p.topicId, p.reputer, alloraMath.OneDec())
+		s.Require().NoError(err)
+	}
+	removal := types.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   0,
+		BlockRemovalCompleted: 100,
+		TopicId:               2,
+		Delegator:             delegator,
+		Reputer:               reputer1,
+		Amount:                cosmosMath.NewInt(30),
+	}
+	err := k.SetDelegateStakeRemoval(ctx, removal)
+	s.Require().NoError(err)
+	err = k.SetDelegateRewardAutoCompound(ctx, delegator, true)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetDelegatorPortfolio(ctx, &types.GetDelegatorPortfolioRequest{
+		Delegator:  delegator,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: nil, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Delegations, 2)
+	s.Require().NotNil(response.Pagination.NextKey)
+	// reputers within a topic are ordered by address
+	pendingRewards := map[string]string{reputer1: "50", reputer2: "200"}
+	for _, position := range response.Delegations {
+		s.Require().Equal(uint64(1), position.TopicId)
+		s.Require().True(alloraMath.MustNewDecFromString(pendingRewards[position.Reputer]).Equal(position.PendingReward))
+		s.Require().Nil(position.PendingRemoval)
+	}
+	s.Require().NotEqual(response.Delegations[0].Reputer, response.Delegations[1].Reputer)
+	s.Require().True(alloraMath.MustNewDecFromString("600").Equal(response.TotalAmount))
+	s.Require().True(alloraMath.MustNewDecFromString("250").Equal(response.TotalPendingReward))
+	s.Require().Equal(cosmosMath.NewInt(30), response.TotalPendingRemoval)
+	s.Require().True(response.AutoCompound)
+
+	response, err = s.queryServer.GetDelegatorPortfolio(ctx, &types.GetDelegatorPortfolioRequest{
+		Delegator:  delegator,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Delegations, 1)
+	s.Require().Nil(response.Pagination.NextKey)
+	s.Require().Equal(uint64(2), response.Delegations[0].TopicId)
+	s.Require().True(alloraMath.ZeroDec().Equal(response.Delegations[0].PendingReward))
+	s.Require().Equal(&removal, response.Delegations[0].PendingRemoval)
+	// Totals are only returned with the first page
+	s.Require().True(response.TotalAmount.IsZero())
+	s.Require().True(response.TotalPendingReward.IsZero())
+	s.Require().True(response.TotalPendingRemoval.IsZero())
+	s.Require().True(response.AutoCompound)
+}
+
+func (s *QueryServerTestSuite) TestGetReputerDelegateStakeRatio() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	topicId := uint64(1)
+	delegator := s.addrsStr[0]
+	reputer := s.addrsStr[1]
+
+	err := k.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(200))
+	s.Require().NoError(err)
+	err = k.AddDelegateStake(ctx, topicId, delegator, reputer, cosmosMath.NewInt(300))
+	s.Require().NoError(err)
+	moduleParams, err := k.GetParams(ctx)
+	s.Require().NoError(err)
+	moduleParams.MaxDelegateToSelfStakeRatio = alloraMath.MustNewDecFromString("1")
+	moduleParams.MaxDelegateStakePerReputer = cosmosMath.NewInt(1000)
+	err = k.SetParams(ctx, moduleParams)
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetReputerDelegateStakeRatio(ctx, &types.GetReputerDelegateStakeRatioRequest{
+		TopicId: topicId,
+		Reputer: reputer,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(200), response.SelfStake)
+	s.Require().Equal(cosmosMath.NewInt(300), response.DelegateStake)
+	s.Require().True(alloraMath.MustNewDecFromString("1.5").Equal(response.DelegateToSelfStakeRatio), response.DelegateToSelfStakeRatio.String())
+	s.Require().True(alloraMath.OneDec().Equal(response.MaxDelegateToSelfStakeRatio))
+	s.Require().Equal(cosmosMath.NewInt(1000), response.MaxDelegateStakePerReputer)
+	s.Require().Equal(cosmosMath.NewInt(400), response.EffectiveStake)
+
+	// A reputer without stake has a zero ratio
+	response, err = s.queryServer.GetReputerDelegateStakeRatio(ctx, &types.GetReputerDelegateStakeRatioRequest{
+		TopicId: topicId,
+		Reputer: s.addrsStr[2],
+	})
+	s.Require().NoError(err)
+	s.Require().True(response.DelegateToSelfStakeRatio.IsZero())
+	s.Require().True(response.EffectiveStake.IsZero())
+}
@@ -81,6 +81,9 @@ func (s *QueryServerTestSuite) SetupTest() {
 		mintTypes.EcosystemModuleName:  {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRequestsAccountName:                  {"minter"},
+		types.AlloraWorkerBondsAccountName:               {"minter"},
+		types.AlloraDelegateStakeReceiptsAccountName:     {"minter", "burner"},
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
 		multiPerm:                                        {"burner", "minter", "staking"},
@@ -183,7 +186,7 @@ func (s *QueryServerTestSuite) CreateOneTopic() uint64 {
 	newTopicMsg := &types.CreateNewTopicRequest{
 		Creator:                  creator.String(),
 		Metadata:                 metadata,
-		LossMethod:               "method",
+		LossMethod:               "mse",
 		EpochLength:              10800,
 		GroundTruthLag:           10800,
 		WorkerSubmissionWindow:   10,
@@ -195,6 +198,16 @@ func (s *QueryServerTestSuite) CreateOneTopic() uint64 {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	s.MintTokensToAddress(creator, types.DefaultParams().CreateTopicFee)
@@ -229,6 +242,16 @@ func (s *QueryServerTestSuite) TestCreateSeveralTopics() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	creatorInitialBalance := types.DefaultParams().CreateTopicFee.Mul(cosmosMath.NewInt(3))
@@ -280,6 +303,16 @@ func (s *QueryServerTestSuite) mockTopic() types.Topic {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 }
 
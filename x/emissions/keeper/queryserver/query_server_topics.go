@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/metrics"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,6 +26,21 @@ func (qs queryServer) GetNextTopicId(ctx context.Context, req *types.GetNextTopi
 // Topics defines the handler for the Get/Topics RPC method.
 func (qs queryServer) GetTopic(ctx context.Context, req *types.GetTopicRequest) (_ *types.GetTopicResponse, err error) {
 	defer metrics.RecordMetrics("GetTopic", time.Now(), &err)
+	// Archived topics are answered from their archive as their state may already be pruned.
+	// They are inactive and no longer earn weight nor revenue.
+	archive, isArchived, err := qs.k.GetTopicArchive(ctx, req.TopicId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting topic archive")
+	}
+	if isArchived {
+		return &types.GetTopicResponse{
+			Topic:            &archive.Topic,
+			Weight:           "0",
+			EffectiveRevenue: "0",
+			Archived:         true,
+		}, nil
+	}
+
 	topic, err := qs.k.GetTopic(ctx, req.TopicId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting topic")
@@ -54,6 +70,33 @@ func (qs queryServer) GetTopic(ctx context.Context, req *types.GetTopicRequest)
 	}, nil
 }
 
+// Lists the topics that are not archived and match all the filters set in the request
+func (qs queryServer) GetTopics(ctx context.Context, req *types.GetTopicsRequest) (_ *types.GetTopicsResponse, err error) {
+	defer metrics.RecordMetrics("GetTopics", time.Now(), &err)
+	if len(req.IsActive) > 1 {
+		return nil, status.Error(codes.InvalidArgument, "is_active must have at most one value")
+	}
+	if req.Pagination != nil && len(req.Pagination.Key) != 0 && len(req.Pagination.Key) != 8 {
+		return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+	}
+
+	filter := keeper.TopicFilter{
+		Tag:        req.Tag,
+		Category:   req.Category,
+		Creator:    req.Creator,
+		LossMethod: req.LossMethod,
+		IsActive:   nil,
+	}
+	if len(req.IsActive) == 1 {
+		filter.IsActive = &req.IsActive[0]
+	}
+	topics, pagination, err := qs.k.GetTopics(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.GetTopicsResponse{Topics: topics, Pagination: pagination}, nil
+}
+
 // Return last payload timestamp & nonce by worker/reputer
 func (qs queryServer) GetTopicLastWorkerCommitInfo(ctx context.Context, req *types.GetTopicLastWorkerCommitInfoRequest) (_ *types.GetTopicLastWorkerCommitInfoResponse, err error) {
 	defer metrics.RecordMetrics("GetTopicLastWorkerCommitInfo", time.Now(), &err)
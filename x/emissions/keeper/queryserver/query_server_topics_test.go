@@ -61,6 +61,32 @@ func (s *QueryServerTestSuite) TestGetTopic() {
 	s.Require().Equal(metadata, response.Topic.Metadata, "The metadata of the retrieved topic should match")
 }
 
+func (s *QueryServerTestSuite) TestGetTopicArchived() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	topicId, err := keeper.IncrementTopicId(ctx)
+	s.Require().NoError(err)
+	newTopic := s.mockTopic()
+	newTopic.Id = topicId
+	err = keeper.SetTopic(ctx, topicId, newTopic)
+	s.Require().NoError(err)
+
+	err = keeper.ArchiveTopic(ctx, topicId)
+	s.Require().NoError(err)
+
+	// The topic is still answered once its state has been pruned
+	_, done, err := keeper.PruneArchivedTopicState(ctx, topicId, 1000)
+	s.Require().NoError(err)
+	s.Require().True(done)
+
+	response, err := queryServer.GetTopic(ctx, &types.GetTopicRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().True(response.Archived)
+	s.Require().Equal(newTopic, *response.Topic)
+}
+
 func (s *QueryServerTestSuite) TestGetLatestCommit() {
 	ctx := s.ctx
 	queryServer := s.queryServer
@@ -273,3 +299,147 @@ func (s *QueryServerTestSuite) TestGetTopicFeeRevenue() {
 	s.Require().NoError(err, "Should not error when retrieving existing revenue")
 	s.Require().Equal(feeRev.String(), initialRevenueInt.String(), "Revenue should match the initial setup")
 }
+
+func (s *QueryServerTestSuite) TestGetTopics() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	setTopic := func(creator string, lossMethod string, metadata *types.TopicMetadata, active bool) uint64 {
+		topicId, err := keeper.IncrementTopicId(ctx)
+		s.Require().NoError(err)
+		topic := s.mockTopic()
+		topic.Id = topicId
+		topic.Creator = creator
+		topic.LossMethod = lossMethod
+		topic.TypedMetadata = metadata
+		// Distinct epoch lengths so that the active topics do not compete for the same block
+		topic.EpochLength = int64(topicId) * 100
+		topic.GroundTruthLag = topic.EpochLength
+		err = keeper.SetTopic(ctx, topicId, topic)
+		s.Require().NoError(err)
+		if active {
+			err = keeper.ActivateTopic(ctx, topicId)
+			s.Require().NoError(err)
+		}
+		return topicId
+	}
+	ethPrice := &types.TopicMetadata{
+		Target:                   "ETH/USD",
+		Unit:                     "USD",
+		PredictionHorizonSeconds: 300,
+		Tags:                     []string{"crypto", "eth"},
+		Category:                 "price",
+	}
+	btcVolatility := &types.TopicMetadata{
+		Target:                   "BTC/USD",
+		Unit:                     "",
+		PredictionHorizonSeconds: 86400,
+		Tags:                     []string{"crypto", "btc"},
+		Category:                 "volatility",
+	}
+	topic1 := setTopic(s.addrsStr[0], "mse", ethPrice, true)
+	topic2 := setTopic(s.addrsStr[1], "mse", btcVolatility, false)
+	topic3 := setTopic(s.addrsStr[0], "huber", nil, true)
+
+	isActive := true
+	isInactive := false
+	testCases := []struct {
+		name     string
+		req      *types.GetTopicsRequest
+		expected []uint64
+	}{
+		{"no filter", &types.GetTopicsRequest{}, []uint64{topic1, topic2, topic3}},
+		{"tag", &types.GetTopicsRequest{Tag: "crypto"}, []uint64{topic1, topic2}},
+		{"tag and category", &types.GetTopicsRequest{Tag: "crypto", Category: "price"}, []uint64{topic1}},
+		{"category", &types.GetTopicsRequest{Category: "volatility"}, []uint64{topic2}},
+		{"creator", &types.GetTopicsRequest{Creator: s.addrsStr[0]}, []uint64{topic1, topic3}},
+		{"loss method", &types.GetTopicsRequest{LossMethod: "mse"}, []uint64{topic1, topic2}},
+		{"active", &types.GetTopicsRequest{IsActive: []bool{isActive}}, []uint64{topic1, topic3}},
+		{"inactive", &types.GetTopicsRequest{IsActive: []bool{isInactive}}, []uint64{topic2}},
+		{"creator and active", &types.GetTopicsRequest{Creator: s.addrsStr[0], LossMethod: "huber", IsActive: []bool{isActive}}, []uint64{topic3}},
+		{"unknown tag", &types.GetTopicsRequest{Tag: "sol"}, []uint64{}},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			response, err := queryServer.GetTopics(ctx, tc.req)
+			s.Require().NoError(err)
+			ids := make([]uint64, 0)
+			for _, topic := range response.Topics {
+				ids = append(ids, topic.Id)
+			}
+			s.Require().Equal(tc.expected, ids)
+		})
+	}
+
+	// Page through the topics one at a time
+	ids := make([]uint64, 0)
+	req := &types.GetTopicsRequest{LossMethod: "mse", Pagination: &types.SimpleCursorPaginationRequest{Limit: 1}}
+	for {
+		response, err := queryServer.GetTopics(ctx, req)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(response.Topics), 1)
+		for _, topic := range response.Topics {
+			ids = append(ids, topic.Id)
+		}
+		if len(response.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination.Key = response.Pagination.NextKey
+	}
+	s.Require().Equal([]uint64{topic1, topic2}, ids)
+
+	// Archived topics are not listed
+	err := keeper.ArchiveTopic(ctx, topic1)
+	s.Require().NoError(err)
+	response, err := queryServer.GetTopics(ctx, &types.GetTopicsRequest{Tag: "crypto"})
+	s.Require().NoError(err)
+	s.Require().Len(response.Topics, 1)
+	s.Require().Equal(topic2, response.Topics[0].Id)
+
+	_, err = queryServer.GetTopics(ctx, &types.GetTopicsRequest{IsActive: []bool{true, false}})
+	s.Require().Error(err)
+	_, err = queryServer.GetTopics(ctx, &types.GetTopicsRequest{Pagination: &types.SimpleCursorPaginationRequest{Key: []byte{1}}})
+	s.Require().Error(err)
+}
+
+func (s *QueryServerTestSuite) TestGetTopicsFollowsTypedMetadataUpdates() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	topicId, err := keeper.IncrementTopicId(ctx)
+	s.Require().NoError(err)
+	topic := s.mockTopic()
+	topic.Id = topicId
+	topic.TypedMetadata = &types.TopicMetadata{
+		Target:                   "ETH/USD",
+		Unit:                     "USD",
+		PredictionHorizonSeconds: 300,
+		Tags:                     []string{"eth"},
+		Category:                 "price",
+	}
+	err = keeper.SetTopic(ctx, topicId, topic)
+	s.Require().NoError(err)
+
+	topic.TypedMetadata = &types.TopicMetadata{
+		Target:                   "ETH/USD",
+		Unit:                     "USD",
+		PredictionHorizonSeconds: 300,
+		Tags:                     []string{"ethereum"},
+		Category:                 "",
+	}
+	err = keeper.SetTopic(ctx, topicId, topic)
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetTopics(ctx, &types.GetTopicsRequest{Tag: "eth"})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Topics)
+	response, err = queryServer.GetTopics(ctx, &types.GetTopicsRequest{Category: "price"})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Topics)
+	response, err = queryServer.GetTopics(ctx, &types.GetTopicsRequest{Tag: "ethereum"})
+	s.Require().NoError(err)
+	s.Require().Len(response.Topics, 1)
+	s.Require().Equal(topicId, response.Topics[0].Id)
+}
@@ -26,3 +26,75 @@ func (qs queryServer) IsWhitelistAdmin(ctx context.Context, req *types.IsWhiteli
 
 	return &types.IsWhitelistAdminResponse{IsAdmin: isAdmin}, nil
 }
+
+func (qs queryServer) IsTopicWorkerWhitelistEnabled(ctx context.Context, req *types.IsTopicWorkerWhitelistEnabledRequest) (_ *types.IsTopicWorkerWhitelistEnabledResponse, err error) {
+	defer metrics.RecordMetrics("IsTopicWorkerWhitelistEnabled", time.Now(), &err)
+
+	enabled, err := qs.k.IsTopicWorkerWhitelistEnabled(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsTopicWorkerWhitelistEnabledResponse{IsTopicWorkerWhitelistEnabled: enabled}, nil
+}
+
+func (qs queryServer) IsTopicReputerWhitelistEnabled(ctx context.Context, req *types.IsTopicReputerWhitelistEnabledRequest) (_ *types.IsTopicReputerWhitelistEnabledResponse, err error) {
+	defer metrics.RecordMetrics("IsTopicReputerWhitelistEnabled", time.Now(), &err)
+
+	enabled, err := qs.k.IsTopicReputerWhitelistEnabled(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsTopicReputerWhitelistEnabledResponse{IsTopicReputerWhitelistEnabled: enabled}, nil
+}
+
+func (qs queryServer) IsWhitelistedTopicWorker(ctx context.Context, req *types.IsWhitelistedTopicWorkerRequest) (_ *types.IsWhitelistedTopicWorkerResponse, err error) {
+	defer metrics.RecordMetrics("IsWhitelistedTopicWorker", time.Now(), &err)
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	isWhitelisted, err := qs.k.IsWhitelistedTopicWorker(ctx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsWhitelistedTopicWorkerResponse{IsWhitelistedTopicWorker: isWhitelisted}, nil
+}
+
+func (qs queryServer) IsWhitelistedTopicReputer(ctx context.Context, req *types.IsWhitelistedTopicReputerRequest) (_ *types.IsWhitelistedTopicReputerResponse, err error) {
+	defer metrics.RecordMetrics("IsWhitelistedTopicReputer", time.Now(), &err)
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	isWhitelisted, err := qs.k.IsWhitelistedTopicReputer(ctx, req.TopicId, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IsWhitelistedTopicReputerResponse{IsWhitelistedTopicReputer: isWhitelisted}, nil
+}
+
+func (qs queryServer) GetTopicWorkerWhitelist(ctx context.Context, req *types.GetTopicWorkerWhitelistRequest) (_ *types.GetTopicWorkerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicWorkerWhitelist", time.Now(), &err)
+
+	addresses, pagination, err := qs.k.GetTopicWorkerWhitelist(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetTopicWorkerWhitelistResponse{Addresses: addresses, Pagination: pagination}, nil
+}
+
+func (qs queryServer) GetTopicReputerWhitelist(ctx context.Context, req *types.GetTopicReputerWhitelistRequest) (_ *types.GetTopicReputerWhitelistResponse, err error) {
+	defer metrics.RecordMetrics("GetTopicReputerWhitelist", time.Now(), &err)
+
+	addresses, pagination, err := qs.k.GetTopicReputerWhitelist(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GetTopicReputerWhitelistResponse{Addresses: addresses, Pagination: pagination}, nil
+}
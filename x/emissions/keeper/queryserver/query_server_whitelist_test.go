@@ -34,3 +34,86 @@ func (s *QueryServerTestSuite) TestIsWhitelistAdmin() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().False(response.IsAdmin, "The IsAdmin field should be false for the anti test address")
 }
+
+func (s *QueryServerTestSuite) TestTopicWorkerWhitelistQueries() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+
+	enabledResp, err := queryServer.IsTopicWorkerWhitelistEnabled(ctx, &types.IsTopicWorkerWhitelistEnabledRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().False(enabledResp.IsTopicWorkerWhitelistEnabled)
+
+	err = keeper.EnableTopicWorkerWhitelist(ctx, topicId)
+	s.Require().NoError(err)
+	for _, addr := range s.addrsStr[:3] {
+		err = keeper.AddToTopicWorkerWhitelist(ctx, topicId, addr)
+		s.Require().NoError(err)
+	}
+
+	enabledResp, err = queryServer.IsTopicWorkerWhitelistEnabled(ctx, &types.IsTopicWorkerWhitelistEnabledRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().True(enabledResp.IsTopicWorkerWhitelistEnabled)
+
+	isWhitelistedResp, err := queryServer.IsWhitelistedTopicWorker(ctx, &types.IsWhitelistedTopicWorkerRequest{TopicId: topicId, Address: s.addrsStr[0]})
+	s.Require().NoError(err)
+	s.Require().True(isWhitelistedResp.IsWhitelistedTopicWorker)
+	isWhitelistedResp, err = queryServer.IsWhitelistedTopicWorker(ctx, &types.IsWhitelistedTopicWorkerRequest{TopicId: topicId, Address: s.addrsStr[3]})
+	s.Require().NoError(err)
+	s.Require().False(isWhitelistedResp.IsWhitelistedTopicWorker)
+	_, err = queryServer.IsWhitelistedTopicWorker(ctx, &types.IsWhitelistedTopicWorkerRequest{TopicId: topicId, Address: "invalid"})
+	s.Require().Error(err)
+
+	// Page through the whitelist one address at a time
+	var listed []string
+	req := &types.GetTopicWorkerWhitelistRequest{
+		TopicId:    topicId,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 1},
+	}
+	for {
+		resp, err := queryServer.GetTopicWorkerWhitelist(ctx, req)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(resp.Addresses), 1)
+		listed = append(listed, resp.Addresses...)
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination.Key = resp.Pagination.NextKey
+	}
+	s.Require().ElementsMatch(s.addrsStr[:3], listed)
+
+	// Whitelists of other topics are not listed
+	resp, err := queryServer.GetTopicWorkerWhitelist(ctx, &types.GetTopicWorkerWhitelistRequest{TopicId: topicId + 1})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Addresses)
+}
+
+func (s *QueryServerTestSuite) TestTopicReputerWhitelistQueries() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	topicId := s.CreateOneTopic()
+
+	err := keeper.EnableTopicReputerWhitelist(ctx, topicId)
+	s.Require().NoError(err)
+	err = keeper.AddToTopicReputerWhitelist(ctx, topicId, s.addrsStr[0])
+	s.Require().NoError(err)
+
+	enabledResp, err := queryServer.IsTopicReputerWhitelistEnabled(ctx, &types.IsTopicReputerWhitelistEnabledRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().True(enabledResp.IsTopicReputerWhitelistEnabled)
+
+	isWhitelistedResp, err := queryServer.IsWhitelistedTopicReputer(ctx, &types.IsWhitelistedTopicReputerRequest{TopicId: topicId, Address: s.addrsStr[0]})
+	s.Require().NoError(err)
+	s.Require().True(isWhitelistedResp.IsWhitelistedTopicReputer)
+
+	// The worker whitelist of the topic is left untouched
+	workerResp, err := queryServer.IsWhitelistedTopicWorker(ctx, &types.IsWhitelistedTopicWorkerRequest{TopicId: topicId, Address: s.addrsStr[0]})
+	s.Require().NoError(err)
+	s.Require().False(workerResp.IsWhitelistedTopicWorker)
+
+	resp, err := queryServer.GetTopicReputerWhitelist(ctx, &types.GetTopicReputerWhitelistRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.addrsStr[0]}, resp.Addresses)
+}
@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the redelegation of stake of a delegator from a reputer to another in a topic, if any
+func (k *Keeper) GetRedelegation(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	srcReputer ActorId,
+	dstReputer ActorId,
+) (types.Redelegation, bool, error) {
+	redelegation, err := k.redelegations.Get(ctx, Join4(topicId, dstReputer, delegator, srcReputer))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.Redelegation{}, false, nil
+		}
+		return types.Redelegation{}, false, errors.Wrap(err, "error getting redelegation")
+	}
+	return redelegation, true, nil
+}
+
+// Returns the redelegations of stake of a delegator to a reputer in a topic that have not matured yet,
+// one per reputer the stake was redelegated from
+func (k *Keeper) GetUnmaturedRedelegationsToReputer(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) ([]types.Redelegation, error) {
+	rng := NewTriplePrefixedQuadrupleRange[TopicId, ActorId, ActorId, ActorId](topicId, reputer, delegator)
+	iter, err := k.redelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over redelegations to reputer")
+	}
+	redelegations, err := iter.Values()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting redelegations to reputer")
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	ret := make([]types.Redelegation, 0, len(redelegations))
+	for _, redelegation := range redelegations {
+		if blockHeight < redelegation.BlockMatured {
+			ret = append(ret, redelegation)
+		}
+	}
+	return ret, nil
+}
+
+// Records a redelegation, replacing any previous redelegation of the delegator from the same reputer
+// to the same reputer in the topic
+func (k *Keeper) SetRedelegation(ctx context.Context, redelegation types.Redelegation) error {
+	if err := redelegation.Validate(); err != nil {
+		return errors.Wrap(err, "redelegation validation failed")
+	}
+	topicId, delegator := redelegation.TopicId, redelegation.Delegator
+	srcReputer, dstReputer := redelegation.SrcReputer, redelegation.DstReputer
+	if err := k.DeleteRedelegation(ctx, topicId, delegator, srcReputer, dstReputer); err != nil {
+		return err
+	}
+	key := Join4(topicId, dstReputer, delegator, srcReputer)
+	if err := k.redelegations.Set(ctx, key, redelegation); err != nil {
+		return errors.Wrap(err, "error setting redelegation")
+	}
+	if err := k.redelegationsByMaturity.Set(ctx, collections.Join(redelegation.BlockMatured, key)); err != nil {
+		return errors.Wrap(err, "error setting redelegation by maturity")
+	}
+	bySrcReputerKey := Join4(topicId, srcReputer, delegator, dstReputer)
+	return k.redelegationsBySrcReputer.Set(ctx, bySrcReputerKey)
+}
+
+// Removes the redelegation of a delegator from a reputer to another in a topic, if any
+func (k *Keeper) DeleteRedelegation(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	srcReputer ActorId,
+	dstReputer ActorId,
+) error {
+	redelegation, found, err := k.GetRedelegation(ctx, topicId, delegator, srcReputer, dstReputer)
+	if err != nil || !found {
+		return err
+	}
+	key := Join4(topicId, dstReputer, delegator, srcReputer)
+	if err := k.redelegationsByMaturity.Remove(ctx, collections.Join(redelegation.BlockMatured, key)); err != nil {
+		return errors.Wrap(err, "error removing redelegation by maturity")
+	}
+	bySrcReputerKey := Join4(topicId, srcReputer, delegator, dstReputer)
+	if err := k.redelegationsBySrcReputer.Remove(ctx, bySrcReputerKey); err != nil {
+		return errors.Wrap(err, "error removing redelegation by source reputer")
+	}
+	return k.redelegations.Remove(ctx, key)
+}
+
+// Moves delegated stake from a reputer to another in a topic at once. The rewards pending upon both reputers
+// are paid out to the delegator as their reward debts are settled. As in x/staking, stake redelegated to the
+// source reputer cannot be redelegated again before it matures, after `maturityWindow` blocks. Until then,
+// the stake redelegated is slashed along with the source reputer, see SlashReputer. Redelegations to a
+// reputer are kept apart per source reputer, so that each is only slashed along with its own source.
+func (k *Keeper) RedelegateStake(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	srcReputer ActorId,
+	dstReputer ActorId,
+	amount cosmosMath.Int,
+	maturityWindow BlockHeight,
+) error {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	incoming, err := k.GetUnmaturedRedelegationsToReputer(ctx, topicId, delegator, srcReputer)
+	if err != nil {
+		return err
+	}
+	if len(incoming) > 0 {
+		blockMatured := incoming[0].BlockMatured
+		for _, redelegation := range incoming[1:] {
+			if redelegation.BlockMatured > blockMatured {
+				blockMatured = redelegation.BlockMatured
+			}
+		}
+		return errors.Wrapf(types.ErrRedelegationNotMatured, "stake can be redelegated again at block %d", blockMatured)
+	}
+
+	if err := k.removeDelegateStake(ctx, topicId, delegator, srcReputer, amount); err != nil {
+		return errors.Wrap(err, "error removing stake from source reputer")
+	}
+	if err := k.AddDelegateStake(ctx, topicId, delegator, dstReputer, amount); err != nil {
+		return errors.Wrap(err, "error adding stake to destination reputer")
+	}
+
+	// Stake redelegated between the same reputers before and not matured yet matures along with the new stake
+	redelegated := amount
+	previous, found, err := k.GetRedelegation(ctx, topicId, delegator, srcReputer, dstReputer)
+	if err != nil {
+		return err
+	}
+	if found && blockHeight < previous.BlockMatured {
+		redelegated = redelegated.Add(previous.Amount)
+	}
+	return k.SetRedelegation(ctx, types.Redelegation{
+		TopicId:          topicId,
+		Delegator:        delegator,
+		SrcReputer:       srcReputer,
+		DstReputer:       dstReputer,
+		Amount:           redelegated,
+		BlockRedelegated: blockHeight,
+		BlockMatured:     blockHeight + maturityWindow,
+	})
+}
+
+// Removes at most `limit` redelegations maturing before or at the block. Keys of the maturity index
+// left without their redelegation are removed as well, so that they do not hold back the others.
+func (k *Keeper) DeleteMaturedRedelegations(ctx context.Context, blockHeight BlockHeight, limit uint64) error {
+	keys, err := k.getMaturedRedelegationKeys(ctx, blockHeight, limit)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.redelegationsByMaturity.Remove(ctx, key); err != nil {
+			return errors.Wrap(err, "error removing redelegation by maturity")
+		}
+		topicId, dstReputer, delegator, srcReputer := key.K2().K1(), key.K2().K2(), key.K2().K3(), key.K2().K4()
+		redelegation, found, err := k.GetRedelegation(ctx, topicId, delegator, srcReputer, dstReputer)
+		if err != nil {
+			return err
+		}
+		if !found || redelegation.BlockMatured != key.K1() {
+			continue
+		}
+		if err := k.DeleteRedelegation(ctx, topicId, delegator, srcReputer, dstReputer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Slashes a share of the stake redelegated away from a reputer in a topic and not matured yet, from the
+// delegations upon the destination reputers, capped to the stake the delegators have left there.
+// Stake redelegated to the same reputers from other sources is left untouched.
+// Returns the stake slashed in total.
+func (k *Keeper) slashUnmaturedRedelegations(
+	ctx sdk.Context,
+	topicId TopicId,
+	srcReputer ActorId,
+	fraction alloraMath.Dec,
+) (cosmosMath.Int, error) {
+	rng := NewDoublePrefixedQuadrupleRange[TopicId, ActorId, ActorId, ActorId](topicId, srcReputer)
+	iter, err := k.redelegationsBySrcReputer.Iterate(ctx, rng)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error iterating over redelegations by source reputer")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error getting redelegations by source reputer")
+	}
+	totalSlashed := cosmosMath.ZeroInt()
+	for _, key := range keys {
+		delegator, dstReputer := key.K3(), key.K4()
+		redelegation, err := k.redelegations.Get(ctx, Join4(topicId, dstReputer, delegator, srcReputer))
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error getting redelegation")
+		}
+		if ctx.BlockHeight() >= redelegation.BlockMatured {
+			continue
+		}
+		placement, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, dstReputer)
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error getting delegate stake placement")
+		}
+		delegatedStake, err := placement.Amount.SdkIntTrim()
+		if err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error trimming delegated stake")
+		}
+		slashed, err := slashedShareOf(redelegation.Amount, fraction)
+		if err != nil {
+			return cosmosMath.Int{}, err
+		}
+		slashed = cosmosMath.MinInt(slashed, delegatedStake)
+		if !slashed.IsPositive() {
+			continue
+		}
+		if err := k.removeDelegateStake(ctx, topicId, delegator, dstReputer, slashed); err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error slashing redelegated stake")
+		}
+		if err := k.capDelegateStakeRemoval(ctx, topicId, delegator, dstReputer, delegatedStake.Sub(slashed)); err != nil {
+			return cosmosMath.Int{}, err
+		}
+		redelegation.Amount = redelegation.Amount.Sub(slashed)
+		if err := k.redelegations.Set(ctx, Join4(topicId, dstReputer, delegator, srcReputer), redelegation); err != nil {
+			return cosmosMath.Int{}, errors.Wrap(err, "error setting redelegation")
+		}
+		totalSlashed = totalSlashed.Add(slashed)
+	}
+	return totalSlashed, nil
+}
+
+// Returns the keys of at most `limit` redelegations maturing before or at the block
+func (k *Keeper) getMaturedRedelegationKeys(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	limit uint64,
+) ([]collections.Pair[BlockHeight, Quadruple[TopicId, ActorId, ActorId, ActorId]], error) {
+	keys := make([]collections.Pair[BlockHeight, Quadruple[TopicId, ActorId, ActorId, ActorId]], 0)
+	rng := &collections.Range[collections.Pair[BlockHeight, Quadruple[TopicId, ActorId, ActorId, ActorId]]]{}
+	rng = rng.EndExclusive(collections.PairPrefix[BlockHeight, Quadruple[TopicId, ActorId, ActorId, ActorId]](blockHeight + 1))
+	iter, err := k.redelegationsByMaturity.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over redelegations by maturity")
+	}
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(keys)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting redelegation by maturity")
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the commission of a reputer in a topic, and whether the reputer created one at all
+func (k *Keeper) GetReputerCommission(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) (types.ReputerCommission, bool, error) {
+	commission, err := k.reputerCommissions.Get(ctx, collections.Join(topicId, reputer))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.ReputerCommission{}, false, nil
+		}
+		return types.ReputerCommission{}, false, errors.Wrap(err, "error getting reputer commission")
+	}
+	return commission, true, nil
+}
+
+func (k *Keeper) SetReputerCommission(ctx context.Context, commission types.ReputerCommission) error {
+	if err := commission.Validate(); err != nil {
+		return errors.Wrap(err, "reputer commission validation failed")
+	}
+	return k.reputerCommissions.Set(ctx, collections.Join(commission.TopicId, commission.Reputer), commission)
+}
+
+// Returns the share of the rewards of its delegators a reputer keeps in a topic, zero if it created no commission
+func (k *Keeper) GetReputerCommissionRate(ctx context.Context, topicId TopicId, reputer ActorId) (alloraMath.Dec, error) {
+	commission, found, err := k.GetReputerCommission(ctx, topicId, reputer)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	if !found {
+		return alloraMath.ZeroDec(), nil
+	}
+	return commission.Rate, nil
+}
+
+// Creates the commission of a reputer in a topic. Its max rate and max change rate are final.
+func (k *Keeper) CreateReputerCommission(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	rate, maxRate, maxChangeRate alloraMath.Dec,
+) error {
+	_, found, err := k.GetReputerCommission(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	if found {
+		return types.ErrReputerCommissionExists
+	}
+	return k.SetReputerCommission(ctx, types.ReputerCommission{
+		TopicId:           topicId,
+		Reputer:           reputer,
+		Rate:              rate,
+		MaxRate:           maxRate,
+		MaxChangeRate:     maxChangeRate,
+		UpdateBlockHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	})
+}
+
+// Changes the commission rate of a reputer in a topic. As in x/staking, the rate can only be changed once
+// per cooldown, by at most the max change rate, and never above the max rate of the commission.
+func (k *Keeper) EditReputerCommissionRate(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	rate alloraMath.Dec,
+	cooldown BlockHeight,
+) error {
+	commission, found, err := k.GetReputerCommission(ctx, topicId, reputer)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrReputerCommissionNotFound
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if blockHeight < commission.UpdateBlockHeight+cooldown {
+		return errors.Wrapf(types.ErrReputerCommissionUpdateCooldown,
+			"rate can be changed again at block %d", commission.UpdateBlockHeight+cooldown)
+	}
+	if rate.Gt(commission.MaxRate) {
+		return errors.Wrap(types.ErrInvalidReputerCommission, "commission rate cannot be more than the max rate")
+	}
+	change, err := rate.Sub(commission.Rate)
+	if err != nil {
+		return err
+	}
+	change, err = change.Abs()
+	if err != nil {
+		return err
+	}
+	if change.Gt(commission.MaxChangeRate) {
+		return errors.Wrap(types.ErrInvalidReputerCommission, "commission rate cannot change by more than the max change rate")
+	}
+	commission.Rate = rate
+	commission.UpdateBlockHeight = blockHeight
+	return k.SetReputerCommission(ctx, commission)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Stores the commitment of a reputer to the loss bundle it reveals for an open reputer nonce of a topic
+func (k *Keeper) SetReputerCommitment(ctx context.Context, commitment types.ReputerCommitment) error {
+	if err := commitment.Validate(); err != nil {
+		return errors.Wrap(err, "reputer commitment validation failed")
+	}
+	key := collections.Join3(commitment.TopicId, commitment.NonceBlockHeight, commitment.Reputer)
+	return k.reputerCommitments.Set(ctx, key, commitment)
+}
+
+// Returns the commitment of a reputer for a reputer nonce of a topic, if any
+func (k *Keeper) GetReputerCommitment(
+	ctx context.Context,
+	topicId TopicId,
+	nonceBlockHeight BlockHeight,
+	reputer ActorId,
+) (types.ReputerCommitment, bool, error) {
+	commitment, err := k.reputerCommitments.Get(ctx, collections.Join3(topicId, nonceBlockHeight, reputer))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.ReputerCommitment{}, false, nil
+		}
+		return types.ReputerCommitment{}, false, errors.Wrap(err, "error getting reputer commitment")
+	}
+	return commitment, true, nil
+}
+
+// Removes the commitment of a reputer for a reputer nonce of a topic, once revealed
+func (k *Keeper) RemoveReputerCommitment(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight, reputer ActorId) error {
+	return k.reputerCommitments.Remove(ctx, collections.Join3(topicId, nonceBlockHeight, reputer))
+}
+
+// Returns the commitments of a reputer nonce that were not revealed yet
+func (k *Keeper) GetReputerCommitmentsOfNonce(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight) ([]types.ReputerCommitment, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonceBlockHeight)
+	iter, err := k.reputerCommitments.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over reputer commitments")
+	}
+	commitments, err := iter.Values()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting reputer commitments")
+	}
+	return commitments, nil
+}
+
+// Removes all commitments of a reputer nonce, when it closes. Reputers that did not reveal
+// their loss bundle did not take part in the nonce.
+func (k *Keeper) ResetReputerCommitmentsForNonce(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonceBlockHeight)
+	if err := k.reputerCommitments.Clear(ctx, rng); err != nil {
+		return errors.Wrap(err, "error clearing reputer commitments")
+	}
+	return nil
+}
+
+// True if the reputer committed to a loss bundle it did not reveal yet, for any open reputer nonce of the topic
+func (k *Keeper) HasPendingReputerCommitment(ctx context.Context, topicId TopicId, reputer ActorId) (bool, error) {
+	iter, err := k.reputerCommitments.Iterate(ctx, collections.NewPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId))
+	if err != nil {
+		return false, errors.Wrap(err, "error iterating over reputer commitments")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return false, errors.Wrap(err, "error getting reputer commitment key")
+		}
+		if key.K3() == reputer {
+			return true, nil
+		}
+	}
+	return false, nil
+}
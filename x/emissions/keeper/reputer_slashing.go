@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the number of consecutive epochs a reputer has been an outlier of a topic, zero if none
+func (k *Keeper) GetReputerOutlierStreak(ctx context.Context, topicId TopicId, reputer ActorId) (uint64, error) {
+	streak, err := k.reputerOutlierStreaks.Get(ctx, collections.Join(topicId, reputer))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "error getting reputer outlier streak")
+	}
+	return streak, nil
+}
+
+// Sets the number of consecutive epochs a reputer has been an outlier of a topic, removing it when zero
+func (k *Keeper) SetReputerOutlierStreak(ctx context.Context, topicId TopicId, reputer ActorId, streak uint64) error {
+	key := collections.Join(topicId, reputer)
+	if streak == 0 {
+		return k.reputerOutlierStreaks.Remove(ctx, key)
+	}
+	return k.reputerOutlierStreaks.Set(ctx, key, streak)
+}
+
+// Records the distance of a reputer to the consensus of a topic in an epoch. A reputer beyond the outlier
+// threshold of the topic extends its outlier streak and has the stake upon it slashed once the streak reaches
+// the reputer_outlier_slash_epochs param, which starts a new streak. A reputer within the threshold ends its streak.
+func (k *Keeper) UpdateReputerOutlierStreak(
+	ctx context.Context,
+	topic types.Topic,
+	reputer ActorId,
+	distance alloraMath.Dec,
+	moduleParams types.Params,
+) error {
+	if !distance.Gt(topic.ReputerOutlierThreshold) {
+		return k.SetReputerOutlierStreak(ctx, topic.Id, reputer, 0)
+	}
+	streak, err := k.GetReputerOutlierStreak(ctx, topic.Id, reputer)
+	if err != nil {
+		return err
+	}
+	streak++
+	if streak < moduleParams.ReputerOutlierSlashEpochs {
+		return k.SetReputerOutlierStreak(ctx, topic.Id, reputer, streak)
+	}
+	if _, err := k.SlashReputer(ctx, topic.Id, reputer, moduleParams.ReputerOutlierSlashFraction, distance); err != nil {
+		return errors.Wrap(err, "error slashing reputer")
+	}
+	return k.SetReputerOutlierStreak(ctx, topic.Id, reputer, 0)
+}
+
+// Slashes a share of the stake upon a reputer in a topic, of the stake of the reputer itself and of the stake
+// delegated upon it alike, and sends the slashed stake to the ecosystem bucket. Slashed delegators are paid out
+// their pending rewards, their reward debt being reset to their remaining stake as on any stake removal.
+// Stake redelegated away from the reputer and not matured yet is slashed alike at its destination reputers.
+// Stake removals queued for more than the stake left after the slash are capped to it.
+func (k *Keeper) SlashReputer(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	fraction alloraMath.Dec,
+	distance alloraMath.Dec,
+) (types.ReputerSlash, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerSlash{}, errors.Wrap(err, "error getting reputer authority")
+	}
+	delegateStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerSlash{}, errors.Wrap(err, "error getting delegate stake upon reputer")
+	}
+	reputerStake := reputerAuthority.Sub(delegateStakeUponReputer)
+	reputerStakeSlashed, err := slashedShareOf(reputerStake, fraction)
+	if err != nil {
+		return types.ReputerSlash{}, err
+	}
+	if reputerStakeSlashed.IsPositive() {
+		if err := k.removeReputerStake(ctx, topicId, reputer, reputerStakeSlashed); err != nil {
+			return types.ReputerSlash{}, errors.Wrap(err, "error slashing reputer stake")
+		}
+		if err := k.capStakeRemoval(sdkCtx, topicId, reputer, reputerStake.Sub(reputerStakeSlashed)); err != nil {
+			return types.ReputerSlash{}, err
+		}
+	}
+
+	delegations, err := k.getDelegationsUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return types.ReputerSlash{}, err
+	}
+	delegateStakeSlashed := cosmosMath.ZeroInt()
+	for _, delegation := range delegations {
+		delegator := delegation.Key.K2()
+		delegatedStake, err := delegation.Value.Amount.SdkIntTrim()
+		if err != nil {
+			return types.ReputerSlash{}, errors.Wrap(err, "error trimming delegated stake")
+		}
+		slashed, err := slashedShareOf(delegatedStake, fraction)
+		if err != nil {
+			return types.ReputerSlash{}, err
+		}
+		if !slashed.IsPositive() {
+			continue
+		}
+		if err := k.removeDelegateStake(ctx, topicId, delegator, reputer, slashed); err != nil {
+			return types.ReputerSlash{}, errors.Wrap(err, "error slashing delegate stake")
+		}
+		if err := k.capDelegateStakeRemoval(sdkCtx, topicId, delegator, reputer, delegatedStake.Sub(slashed)); err != nil {
+			return types.ReputerSlash{}, err
+		}
+		delegateStakeSlashed = delegateStakeSlashed.Add(slashed)
+	}
+	redelegatedStakeSlashed, err := k.slashUnmaturedRedelegations(sdkCtx, topicId, reputer, fraction)
+	if err != nil {
+		return types.ReputerSlash{}, errors.Wrap(err, "error slashing redelegated stake")
+	}
+	delegateStakeSlashed = delegateStakeSlashed.Add(redelegatedStakeSlashed)
+
+	totalSlashed := reputerStakeSlashed.Add(delegateStakeSlashed)
+	if totalSlashed.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, totalSlashed))
+		err = k.SendCoinsFromModuleToModule(ctx, types.AlloraStakingAccountName, minttypes.EcosystemModuleName, coins)
+		if err != nil {
+			return types.ReputerSlash{}, errors.Wrap(err, "error sending slashed stake to ecosystem")
+		}
+	}
+
+	slash := types.ReputerSlash{
+		TopicId:              topicId,
+		Reputer:              reputer,
+		BlockHeight:          sdkCtx.BlockHeight(),
+		Distance:             distance,
+		ReputerStakeSlashed:  reputerStakeSlashed,
+		DelegateStakeSlashed: delegateStakeSlashed,
+	}
+	if err := k.SetReputerSlash(ctx, slash); err != nil {
+		return types.ReputerSlash{}, err
+	}
+	types.EmitNewReputerSlashedEvent(sdkCtx, slash)
+	return slash, nil
+}
+
+// Records a slash of the stake upon a reputer
+func (k *Keeper) SetReputerSlash(ctx context.Context, slash types.ReputerSlash) error {
+	if err := slash.Validate(); err != nil {
+		return errors.Wrap(err, "reputer slash validation failed")
+	}
+	key := collections.Join3(slash.TopicId, slash.Reputer, slash.BlockHeight)
+	return k.reputerSlashes.Set(ctx, key, slash)
+}
+
+// Returns the slashes of the stake upon a reputer in a topic, oldest first
+func (k *Keeper) GetReputerSlashHistory(ctx context.Context, topicId TopicId, reputer ActorId) ([]types.ReputerSlash, error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, BlockHeight](topicId, reputer)
+	iter, err := k.reputerSlashes.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over reputer slashes")
+	}
+	slashes, err := iter.Values()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting reputer slashes")
+	}
+	return slashes, nil
+}
+
+// Returns the stake delegations upon a reputer in a topic
+func (k *Keeper) getDelegationsUponReputer(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+) ([]collections.KeyValue[collections.Triple[TopicId, ActorId, ActorId], types.DelegatorInfo], error) {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, reputer)
+	iter, err := k.reputerDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over delegations upon reputer")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting delegations upon reputer")
+	}
+	delegations := make([]collections.KeyValue[collections.Triple[TopicId, ActorId, ActorId], types.DelegatorInfo], 0, len(keys))
+	for _, key := range keys {
+		delegationKey := collections.Join3(topicId, key.K3(), reputer)
+		delegation, err := k.delegatedStakes.Get(ctx, delegationKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting delegated stake")
+		}
+		delegations = append(delegations, collections.KeyValue[collections.Triple[TopicId, ActorId, ActorId], types.DelegatorInfo]{
+			Key:   delegationKey,
+			Value: delegation,
+		})
+	}
+	return delegations, nil
+}
+
+// Caps the stake removal queued by a reputer in a topic to the stake it has left
+func (k *Keeper) capStakeRemoval(ctx sdk.Context, topicId TopicId, reputer ActorId, stakeLeft cosmosMath.Int) error {
+	removal, found, err := k.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting stake removal")
+	}
+	if !found || removal.Amount.LTE(stakeLeft) {
+		return nil
+	}
+	if err := k.DeleteStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer); err != nil {
+		return errors.Wrap(err, "error deleting stake removal")
+	}
+	if stakeLeft.IsZero() {
+		return nil
+	}
+	removal.Amount = stakeLeft
+	return k.SetStakeRemoval(ctx, removal)
+}
+
+// Caps the delegate stake removal queued by a delegator upon a reputer in a topic to the stake it has left
+func (k *Keeper) capDelegateStakeRemoval(
+	ctx sdk.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	stakeLeft cosmosMath.Int,
+) error {
+	removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting delegate stake removal")
+	}
+	if !found || removal.Amount.LTE(stakeLeft) {
+		return nil
+	}
+	if err := k.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer, delegator); err != nil {
+		return errors.Wrap(err, "error deleting delegate stake removal")
+	}
+	if stakeLeft.IsZero() {
+		return nil
+	}
+	removal.Amount = stakeLeft
+	return k.SetDelegateStakeRemoval(ctx, removal)
+}
+
+// Returns the share `fraction` of a stake, rounded down
+func slashedShareOf(stake cosmosMath.Int, fraction alloraMath.Dec) (cosmosMath.Int, error) {
+	stakeDec, err := alloraMath.NewDecFromSdkInt(stake)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error converting stake to dec")
+	}
+	slashedDec, err := stakeDec.Mul(fraction)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error calculating slashed stake")
+	}
+	return slashedDec.SdkIntTrim()
+}
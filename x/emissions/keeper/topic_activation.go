@@ -251,7 +251,7 @@ func (k *Keeper) addTopicToActiveSetRespectingLimitsWithoutMinWeightReset(
 	return true, nil
 }
 
-// Set a topic to active if the topic exists, else does nothing
+// Set a topic to active if the topic exists and is not archived, else does nothing
 func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 	topicExists, err := k.topics.Has(ctx, topicId)
 	if err != nil {
@@ -260,6 +260,13 @@ func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 	if !topicExists {
 		return nil
 	}
+	isArchived, err := k.IsTopicArchived(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if topic is archived")
+	}
+	if isArchived {
+		return nil
+	}
 
 	// Check topic activation with next possible churning block
 	_, topicIsActive, err := k.GetNextPossibleChurningBlockByTopicId(ctx, topicId)
@@ -312,6 +319,45 @@ func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
 	return nil
 }
 
+// Activates the topic if it is inactive and its current weight is at least the global minimum for active topics
+func (k *Keeper) ActivateTopicIfWeightAtLeastGlobalMin(ctx context.Context, topicId TopicId) error {
+	isActivated, err := k.IsTopicActive(ctx, topicId)
+	if err != nil {
+		return errors.Wrapf(err, "error getting topic activation status")
+	}
+	if !isActivated {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error getting params")
+		}
+		topic, err := k.GetTopic(ctx, topicId)
+		if err != nil {
+			return errors.Wrapf(err, "error getting topic")
+		}
+
+		newTopicWeight, _, err := k.GetCurrentTopicWeight(
+			ctx,
+			topicId,
+			topic.EpochLength,
+			params.TopicRewardAlpha,
+			params.TopicRewardStakeImportance,
+			params.TopicRewardFeeRevenueImportance,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "error getting current topic weight")
+		}
+
+		if newTopicWeight.Gte(params.MinTopicWeight) {
+			err = k.ActivateTopic(ctx, topicId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Inactivate the topic
 func (k *Keeper) InactivateTopic(ctx context.Context, topicId TopicId) error {
 	err := k.inactivateTopicWithoutMinWeightReset(ctx, topicId)
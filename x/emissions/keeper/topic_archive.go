@@ -0,0 +1,747 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prefixes of all the collections keyed by topic id first, i.e. all the state owned by a topic.
+// The topic itself comes last so that an archived topic keeps existing until everything else is gone.
+var archivedTopicStatePrefixes = []collections.Prefix{
+	types.TopicWorkersKey,
+	types.TopicReputersKey,
+	types.TopicRewardNonceKey,
+	types.ActiveInferersKey,
+	types.ActiveForecastersKey,
+	types.ActiveReputersKey,
+	types.LowestInfererScoreEmaKey,
+	types.LowestForecasterScoreEmaKey,
+	types.LowestReputerScoreEmaKey,
+	types.PendingTopicUpdatesKey,
+	types.InferenceScoresKey,
+	types.ForecastScoresKey,
+	types.ReputerScoresKey,
+	types.InfererScoreEmasKey,
+	types.ForecasterScoreEmasKey,
+	types.ReputerScoreEmasKey,
+	types.ReputerListeningCoefficientKey,
+	types.PreviousReputerRewardFractionKey,
+	types.PreviousInferenceRewardFractionKey,
+	types.PreviousForecastRewardFractionKey,
+	types.PreviousForecasterScoreRatioKey,
+	types.PreviousTopicQuantileInfererScoreEmaKey,
+	types.PreviousTopicQuantileForecasterScoreEmaKey,
+	types.PreviousTopicQuantileReputerScoreEmaKey,
+	types.TopicStakeKey,
+	types.StakeByReputerAndTopicIdKey,
+	types.DelegatorStakeKey,
+	types.DelegateStakePlacementKey,
+	types.TargetStakeKey,
+	types.DelegateRewardPerShare,
+	types.ReputerDelegationsKey,
+	types.RedelegationsKey,
+	types.RedelegationsBySrcReputerKey,
+	types.AutoCompoundDelegationsKey,
+	types.AutoCompoundCursorsKey,
+	types.DelegateStakeReceiptPoolIdsKey,
+	types.InferencesKey,
+	types.ForecastsKey,
+	types.LossBundlesKey,
+	types.TopicFeeRevenueKey,
+	types.TopicCreatorRewardsKey,
+	types.LastNodeRotationsKey,
+	types.WorkerCommitmentsKey,
+	types.ReputerCommitmentsKey,
+	types.WorkerBondsKey,
+	types.WorkerBondRemovalsByWorkerKey,
+	types.ReputerOutlierStreaksKey,
+	types.ReputerSlashesKey,
+	types.WorkerLivenessKey,
+	types.ReputerLivenessKey,
+	types.ReputerCommissionsKey,
+	types.PreviousTopicWeightKey,
+	types.AllInferencesKey,
+	types.AllForecastsKey,
+	types.AllLossBundlesKey,
+	types.NetworkLossBundlesKey,
+	types.ChainLossNetworkInferencesKey,
+	types.UnfulfilledWorkerNoncesKey,
+	types.UnfulfilledReputerNoncesKey,
+	types.LastDripBlockKey,
+	types.InfererNetworkRegretsKey,
+	types.ForecasterNetworkRegretsKey,
+	types.OneInForecasterNetworkRegretsKey,
+	types.LatestNaiveInfererNetworkRegretsKey,
+	types.LatestOneOutInfererInfererNetworkRegretsKey,
+	types.LatestOneOutInfererForecasterNetworkRegretsKey,
+	types.LatestOneOutForecasterInfererNetworkRegretsKey,
+	types.LatestOneOutForecasterForecasterNetworkRegretsKey,
+	types.CountInfererInclusionsInTopicKey,
+	types.CountForecasterInclusionsInTopicKey,
+	types.TopicLastWorkerCommitKey,
+	types.TopicLastReputerCommitKey,
+	types.TopicToNextPossibleChurningBlockKey,
+	types.TopicWorkerWhitelistKey,
+	types.TopicReputerWhitelistKey,
+	types.TopicWorkerWhitelistEnabledKey,
+	types.TopicReputerWhitelistEnabledKey,
+	types.DerivedTopicsKey,
+	types.FundingSubscriptionsByTopicKey,
+	types.ActiveTopicsKey,
+	types.RewardableTopicsKey,
+	types.ArchivedStakeReturnCursorsKey,
+	types.ArchivedStakeReturnFailuresKey,
+	types.TopicsKey,
+}
+
+// Boolean is true if the topic has been archived, whether or not its state has been pruned yet
+func (k *Keeper) IsTopicArchived(ctx context.Context, topicId TopicId) (bool, error) {
+	return k.topicArchives.Has(ctx, topicId)
+}
+
+// Returns the archival state of a topic.
+// Boolean is true if the topic has been archived, else false
+func (k *Keeper) GetTopicArchive(ctx context.Context, topicId TopicId) (types.TopicArchive, bool, error) {
+	archive, err := k.topicArchives.Get(ctx, topicId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.TopicArchive{}, false, nil
+		}
+		return types.TopicArchive{}, false, err
+	}
+	return archive, true, nil
+}
+
+// Sets the archival state of a topic and keeps track of whether the topic still has state to prune
+func (k *Keeper) SetTopicArchive(ctx context.Context, topicId TopicId, archive types.TopicArchive) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errors.Wrap(err, "topic id validation failed")
+	}
+	if err := k.topicArchives.Set(ctx, topicId, archive); err != nil {
+		return errors.Wrap(err, "error setting topic archive")
+	}
+	if archive.Pruned {
+		return k.archivingTopics.Remove(ctx, topicId)
+	}
+	return k.archivingTopics.Set(ctx, topicId)
+}
+
+// Returns the ids of the archived topics whose state has not been fully pruned yet, in ascending order.
+// Topics only stay in this set for the duration of their archival => the set is small.
+func (k *Keeper) GetArchivingTopicIds(ctx context.Context) ([]TopicId, error) {
+	iter, err := k.archivingTopics.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over archiving topics")
+	}
+	topicIds, err := iter.Keys()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting archiving topic ids")
+	}
+	return topicIds, nil
+}
+
+// Archives a topic: the topic is inactivated, its outstanding worker and reputer requests and any
+// pending update are dropped, and the time by which stake removals already queued for the topic
+// will have completed is recorded. The remaining stake is returned and the topic's state pruned
+// afterwards, over several end blocks.
+func (k *Keeper) ArchiveTopic(ctx context.Context, topicId TopicId) error {
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting topic")
+	}
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting params")
+	}
+
+	if err := k.InactivateTopic(ctx, topicId); err != nil {
+		return errors.Wrap(err, "error inactivating topic")
+	}
+	// Nothing will be submitted for these anymore, so worker windows closing later have nothing to do
+	if err := k.DeleteUnfulfilledWorkerNonces(ctx, topicId); err != nil {
+		return errors.Wrap(err, "error deleting unfulfilled worker nonces")
+	}
+	if err := k.DeleteUnfulfilledReputerNonces(ctx, topicId); err != nil {
+		return errors.Wrap(err, "error deleting unfulfilled reputer nonces")
+	}
+	if err := k.pendingTopicUpdates.Remove(ctx, topicId); err != nil {
+		return errors.Wrap(err, "error removing pending topic update")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockHeight := sdkCtx.BlockHeight()
+	archive := types.TopicArchive{
+		Topic:                       topic,
+		ArchivedBlockHeight:         blockHeight,
+		StakeRemovalsDueBlockHeight: blockHeight + moduleParams.RemoveStakeDelayWindow,
+		StakeReturned:               false,
+		Pruned:                      false,
+	}
+	if err := k.SetTopicArchive(ctx, topicId, archive); err != nil {
+		return err
+	}
+
+	types.EmitNewTopicArchivedEvent(sdkCtx, topicId, blockHeight)
+	return nil
+}
+
+// Stages of returning the stake of an archived topic, in the order in which they are processed
+const (
+	archivedStakeReturnDelegationsStage uint64 = iota
+	archivedStakeReturnReputerStakesStage
+	archivedStakeReturnWorkerBondsStage
+	archivedStakeReturnFundingSubscriptionsStage
+	archivedStakeReturnRedelegationsStage
+)
+
+// A stake position of an archived topic to return, identified by its key after the topic id
+type archivedStakePosition struct {
+	key1        ActorId
+	key2        ActorId
+	description string
+	returnStake func(ctx sdk.Context) error
+}
+
+// Returns the stake remaining on an archived topic to its delegators then to its reputers, then the
+// worker bonds to their workers and the escrow of its funding subscriptions to their funders,
+// processing at most `limit` stake positions. Pending rewards of
+// delegators are paid out alongside. Stake and bond removals still queued for the topic are
+// superseded by the return of the whole stake. The redelegations of the topic are deleted last, as
+// they are also indexed by the block they mature at, which is not pruned along with the topic.
+// Each call resumes after the last position visited by the previous one, so that positions failing
+// to be returned do not starve the others. A failed position keeps its stake and is retried in the
+// next pass over the topic; all the stake is only returned once a whole pass has no failure.
+// Returns the number of stake positions processed and whether all the stake has been returned.
+func (k *Keeper) ReturnArchivedTopicStake(
+	ctx context.Context,
+	topicId TopicId,
+	limit uint64,
+) (processed uint64, done bool, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	stage := archivedStakeReturnDelegationsStage
+	var after *collections.Triple[uint64, ActorId, ActorId]
+	cursor, err := k.archivedStakeReturnCursors.Get(ctx, topicId)
+	if err == nil {
+		stage = cursor.K1()
+		after = &cursor
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return 0, false, errors.Wrap(err, "error getting archived stake return cursor")
+	}
+	failed, err := k.archivedStakeReturnFailures.Has(ctx, topicId)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "error getting archived stake return failures")
+	}
+
+	// Delegators first, so that what remains on reputers afterwards is only their own stake
+	for ; stage <= archivedStakeReturnRedelegationsStage; stage++ {
+		var key1, key2 ActorId
+		if after != nil && after.K1() == stage {
+			key1, key2 = after.K2(), after.K3()
+		}
+		positions, more, err := k.getArchivedTopicStakePositions(ctx, topicId, stage, key1, key2, limit-processed)
+		if err != nil {
+			return processed, false, err
+		}
+		for _, position := range positions {
+			processed++
+			key1, key2 = position.key1, position.key2
+			// attempt writes in a cache context, only write finally if there are no errors
+			cacheSdkCtx, write := sdkCtx.CacheContext()
+			err := position.returnStake(cacheSdkCtx)
+			if err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf(
+					"Error returning %s of archived topic %d: %v", position.description, topicId, err,
+				))
+				failed = true
+				continue
+			}
+			write()
+		}
+		if more {
+			err := k.archivedStakeReturnCursors.Set(ctx, topicId, collections.Join3(stage, key1, key2))
+			if err != nil {
+				return processed, false, errors.Wrap(err, "error setting archived stake return cursor")
+			}
+			if failed {
+				if err := k.archivedStakeReturnFailures.Set(ctx, topicId); err != nil {
+					return processed, false, errors.Wrap(err, "error setting archived stake return failures")
+				}
+			}
+			return processed, false, nil
+		}
+	}
+
+	// End of the pass => the next one starts over from the first position left
+	if err := k.archivedStakeReturnCursors.Remove(ctx, topicId); err != nil {
+		return processed, false, errors.Wrap(err, "error removing archived stake return cursor")
+	}
+	if err := k.archivedStakeReturnFailures.Remove(ctx, topicId); err != nil {
+		return processed, false, errors.Wrap(err, "error removing archived stake return failures")
+	}
+	return processed, !failed, nil
+}
+
+// Returns at most `limit` stake positions of the given stage on an archived topic,
+// after the position with the given key, or from the first one if key1 is empty.
+// Boolean is true if there are more positions in the stage, else false
+func (k *Keeper) getArchivedTopicStakePositions(
+	ctx context.Context,
+	topicId TopicId,
+	stage uint64,
+	key1 ActorId,
+	key2 ActorId,
+	limit uint64,
+) ([]archivedStakePosition, bool, error) {
+	ret := make([]archivedStakePosition, 0)
+	switch stage {
+	case archivedStakeReturnDelegationsStage:
+		delegations, more, err := k.getDelegateStakePlacementsOfTopic(ctx, topicId, key1, key2, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, delegation := range delegations {
+			delegator := delegation.Key.K2()
+			reputer := delegation.Key.K3()
+			placement := delegation.Value
+			ret = append(ret, archivedStakePosition{
+				key1:        delegator,
+				key2:        reputer,
+				description: fmt.Sprintf("delegate stake to delegator %s on reputer %s", delegator, reputer),
+				returnStake: func(ctx sdk.Context) error {
+					return k.returnDelegateStakeOfArchivedTopic(ctx, topicId, delegator, reputer, placement)
+				},
+			})
+		}
+		return ret, more, nil
+	case archivedStakeReturnReputerStakesStage:
+		reputerStakes, more, err := k.getReputerStakesOfTopic(ctx, topicId, key1, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, reputerStake := range reputerStakes {
+			reputer := reputerStake.Key.K2()
+			ret = append(ret, archivedStakePosition{
+				key1:        reputer,
+				description: fmt.Sprintf("stake to reputer %s", reputer),
+				returnStake: func(ctx sdk.Context) error {
+					return k.returnReputerStakeOfArchivedTopic(ctx, topicId, reputer)
+				},
+			})
+		}
+		return ret, more, nil
+	case archivedStakeReturnWorkerBondsStage:
+		workerBonds, more, err := k.getWorkerBondsOfTopic(ctx, topicId, key1, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, workerBond := range workerBonds {
+			worker := workerBond.Key.K2()
+			bond := workerBond.Value
+			ret = append(ret, archivedStakePosition{
+				key1:        worker,
+				description: fmt.Sprintf("bond to worker %s", worker),
+				returnStake: func(ctx sdk.Context) error {
+					return k.returnWorkerBondOfArchivedTopic(ctx, topicId, worker, bond)
+				},
+			})
+		}
+		return ret, more, nil
+	case archivedStakeReturnFundingSubscriptionsStage:
+		subscriptions, more, err := k.getFundingSubscriptionsOfTopic(ctx, topicId, key1, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, subscription := range subscriptions {
+			ret = append(ret, archivedStakePosition{
+				key1:        strconv.FormatUint(subscription.Id, 10),
+				description: fmt.Sprintf("escrow of funding subscription %d to funder %s", subscription.Id, subscription.Funder),
+				returnStake: func(ctx sdk.Context) error {
+					_, err := k.RefundFundingSubscription(ctx, subscription)
+					return err
+				},
+			})
+		}
+		return ret, more, nil
+	case archivedStakeReturnRedelegationsStage:
+		redelegations, more, err := k.getRedelegationsOfTopic(ctx, topicId, key1, key2, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, group := range redelegations {
+			reputer, delegator := group[0].DstReputer, group[0].Delegator
+			ret = append(ret, archivedStakePosition{
+				key1:        reputer,
+				key2:        delegator,
+				description: fmt.Sprintf("redelegations of delegator %s to reputer %s", delegator, reputer),
+				returnStake: func(ctx sdk.Context) error {
+					for _, redelegation := range group {
+						err := k.DeleteRedelegation(ctx, topicId, delegator, redelegation.SrcReputer, reputer)
+						if err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			})
+		}
+		return ret, more, nil
+	default:
+		return nil, false, errors.Wrapf(types.ErrInvalidValue, "unknown archived stake return stage %d", stage)
+	}
+}
+
+// Deletes the state owned by an archived topic, at most `limit` entries at a time,
+// in the style of migutils.SafelyClearWholeMap.
+// Returns the number of entries deleted and whether all the state of the topic has been deleted.
+func (k *Keeper) PruneArchivedTopicState(
+	ctx context.Context,
+	topicId TopicId,
+	limit uint64,
+) (deleted uint64, done bool, err error) {
+	// Registered actors are also indexed by actor first => both entries are removed together
+	for _, isReputer := range []bool{false, true} {
+		count, more, err := k.removeTopicActorsPage(ctx, topicId, isReputer, limit-deleted)
+		if err != nil {
+			return deleted, false, err
+		}
+		deleted += count
+		if more {
+			return deleted, false, nil
+		}
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, collectionPrefix := range archivedTopicStatePrefixes {
+		topicPrefix, err := collections.EncodeKeyWithPrefix(collectionPrefix.Bytes(), collections.Uint64Key, topicId)
+		if err != nil {
+			return deleted, false, errors.Wrap(err, "error encoding topic prefix")
+		}
+		count, more, err := clearPrefixPage(store, topicPrefix, limit-deleted)
+		if err != nil {
+			return deleted, false, err
+		}
+		deleted += count
+		if more {
+			return deleted, false, nil
+		}
+	}
+
+	// The topic is gone => so are its entries in the topic indexes, which are not keyed by topic id first
+	archive, found, err := k.GetTopicArchive(ctx, topicId)
+	if err != nil {
+		return deleted, false, errors.Wrap(err, "error getting topic archive")
+	}
+	if found {
+		if err := k.removeTopicFromIndexes(ctx, topicId, archive.Topic); err != nil {
+			return deleted, false, errors.Wrap(err, "error removing topic from indexes")
+		}
+	}
+	return deleted, true, nil
+}
+
+// Deletes at most `maxPageSize` keys in the store with the given keyPrefix.
+// Boolean is true if keys with the prefix remain afterwards, else false
+func clearPrefixPage(store storetypes.KVStore, keyPrefix []byte, maxPageSize uint64) (uint64, bool, error) {
+	s := prefix.NewStore(store, keyPrefix)
+
+	// Gather keys to eventually delete
+	iterator := s.Iterator(nil, nil)
+	keysToDelete := make([][]byte, 0)
+	more := false
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(keysToDelete)) >= maxPageSize {
+			more = true
+			break
+		}
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	err := iterator.Close()
+	if err != nil {
+		return 0, false, errors.Wrap(err, "while closing iterator in `clearPrefixPage`")
+	}
+
+	// Delete the keys
+	for _, key := range keysToDelete {
+		s.Delete(key)
+	}
+	return uint64(len(keysToDelete)), more, nil
+}
+
+// Returns at most `limit` delegate stake placements on a topic,
+// after the placement of the given delegator upon the given reputer if the delegator is not empty.
+// Boolean is true if there are more placements on the topic, else false
+func (k *Keeper) getDelegateStakePlacementsOfTopic(
+	ctx context.Context,
+	topicId TopicId,
+	afterDelegator ActorId,
+	afterReputer ActorId,
+	limit uint64,
+) ([]collections.KeyValue[collections.Triple[TopicId, ActorId, ActorId], types.DelegatorInfo], bool, error) {
+	ret := make([]collections.KeyValue[collections.Triple[TopicId, ActorId, ActorId], types.DelegatorInfo], 0)
+	rng := (&collections.Range[collections.Triple[TopicId, ActorId, ActorId]]{}).
+		Prefix(collections.TriplePrefix[TopicId, ActorId, ActorId](topicId))
+	if afterDelegator != "" {
+		rng = rng.StartExclusive(collections.Join3(topicId, afterDelegator, afterReputer))
+	}
+	iter, err := k.delegatedStakes.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating over delegate stake placements")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(ret)) >= limit {
+			return ret, true, nil
+		}
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error getting delegate stake placement")
+		}
+		ret = append(ret, keyValue)
+	}
+	return ret, false, nil
+}
+
+// Returns at most `limit` reputer stakes on a topic, after the one of the given reputer if not empty.
+// Boolean is true if there are more reputer stakes on the topic, else false
+func (k *Keeper) getReputerStakesOfTopic(
+	ctx context.Context,
+	topicId TopicId,
+	afterReputer ActorId,
+	limit uint64,
+) ([]collections.KeyValue[collections.Pair[TopicId, ActorId], cosmosMath.Int], bool, error) {
+	ret := make([]collections.KeyValue[collections.Pair[TopicId, ActorId], cosmosMath.Int], 0)
+	rng := (&collections.Range[collections.Pair[TopicId, ActorId]]{}).
+		Prefix(collections.PairPrefix[TopicId, ActorId](topicId))
+	if afterReputer != "" {
+		rng = rng.StartExclusive(collections.Join(topicId, afterReputer))
+	}
+	iter, err := k.stakeReputerAuthority.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating over reputer stakes")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(ret)) >= limit {
+			return ret, true, nil
+		}
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error getting reputer stake")
+		}
+		ret = append(ret, keyValue)
+	}
+	return ret, false, nil
+}
+
+// Returns the whole stake of a delegator upon a reputer in an archived topic
+func (k *Keeper) returnDelegateStakeOfArchivedTopic(
+	ctx sdk.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	placement types.DelegatorInfo,
+) error {
+	amount, err := placement.Amount.SdkIntTrim()
+	if err != nil {
+		return errors.Wrap(err, "error trimming delegate stake placement amount")
+	}
+	if delegator == k.delegateStakeReceiptsAddress() {
+		return k.returnDelegateStakeReceiptPoolOfArchivedTopic(ctx, topicId, reputer, amount)
+	}
+	removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(ctx, delegator, reputer, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting delegate stake removal")
+	}
+	if found {
+		err = k.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer, delegator)
+		if err != nil {
+			return errors.Wrap(err, "error deleting delegate stake removal")
+		}
+	}
+	if err := k.removeDelegateStake(ctx, topicId, delegator, reputer, amount); err != nil {
+		return errors.Wrap(err, "error removing delegate stake")
+	}
+	// Any fraction of stake left is dropped along with the entry of the delegation in the delegator index
+	if err := k.removeDelegation(ctx, topicId, delegator, reputer); err != nil {
+		return errors.Wrap(err, "error removing delegation")
+	}
+	return k.sendCoinsToDelegator(ctx, types.AlloraStakingAccountName, topicId, delegator, reputer, amount)
+}
+
+// Returns the whole own stake of a reputer in an archived topic
+func (k *Keeper) returnReputerStakeOfArchivedTopic(ctx sdk.Context, topicId TopicId, reputer ActorId) error {
+	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return errors.Wrap(err, "error getting reputer authority")
+	}
+	delegateStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return errors.Wrap(err, "error getting delegate stake upon reputer")
+	}
+	amount := reputerAuthority.Sub(delegateStakeUponReputer)
+	if !amount.IsPositive() {
+		return nil
+	}
+	removal, found, err := k.GetStakeRemovalForReputerAndTopicId(ctx, reputer, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting stake removal")
+	}
+	if found {
+		err = k.DeleteStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer)
+		if err != nil {
+			return errors.Wrap(err, "error deleting stake removal")
+		}
+	}
+	if err := k.removeReputerStake(ctx, topicId, reputer, amount); err != nil {
+		return errors.Wrap(err, "error removing reputer stake")
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	return k.SendCoinsFromModuleToAccount(ctx, types.AlloraStakingAccountName, reputer, coins)
+}
+
+// Returns at most `limit` worker bonds on a topic, after the one of the given worker if not empty.
+// Boolean is true if there are more worker bonds on the topic, else false
+func (k *Keeper) getWorkerBondsOfTopic(
+	ctx context.Context,
+	topicId TopicId,
+	afterWorker ActorId,
+	limit uint64,
+) ([]collections.KeyValue[collections.Pair[TopicId, ActorId], cosmosMath.Int], bool, error) {
+	ret := make([]collections.KeyValue[collections.Pair[TopicId, ActorId], cosmosMath.Int], 0)
+	rng := (&collections.Range[collections.Pair[TopicId, ActorId]]{}).
+		Prefix(collections.PairPrefix[TopicId, ActorId](topicId))
+	if afterWorker != "" {
+		rng = rng.StartExclusive(collections.Join(topicId, afterWorker))
+	}
+	iter, err := k.workerBonds.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating over worker bonds")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(ret)) >= limit {
+			return ret, true, nil
+		}
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error getting worker bond")
+		}
+		ret = append(ret, keyValue)
+	}
+	return ret, false, nil
+}
+
+// Returns at most `limit` funding subscriptions of a topic, after the one with the given id if not empty.
+// Boolean is true if there are more funding subscriptions of the topic, else false
+func (k *Keeper) getFundingSubscriptionsOfTopic(
+	ctx context.Context,
+	topicId TopicId,
+	afterSubscriptionId string,
+	limit uint64,
+) ([]types.FundingSubscription, bool, error) {
+	ret := make([]types.FundingSubscription, 0)
+	rng := (&collections.Range[collections.Pair[TopicId, uint64]]{}).
+		Prefix(collections.PairPrefix[TopicId, uint64](topicId))
+	if afterSubscriptionId != "" {
+		subscriptionId, err := strconv.ParseUint(afterSubscriptionId, 10, 64)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error parsing funding subscription id")
+		}
+		rng = rng.StartExclusive(collections.Join(topicId, subscriptionId))
+	}
+	iter, err := k.fundingSubscriptionsByTopic.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating over funding subscriptions")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(ret)) >= limit {
+			return ret, true, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error getting funding subscription key")
+		}
+		subscription, err := k.GetFundingSubscription(ctx, key.K2())
+		if err != nil {
+			return nil, false, err
+		}
+		ret = append(ret, subscription)
+	}
+	return ret, false, nil
+}
+
+// Returns the whole bond of a worker in an archived topic
+func (k *Keeper) returnWorkerBondOfArchivedTopic(ctx sdk.Context, topicId TopicId, worker ActorId, bond cosmosMath.Int) error {
+	_, found, err := k.GetWorkerBondRemoval(ctx, topicId, worker)
+	if err != nil {
+		return errors.Wrap(err, "error getting worker bond removal")
+	}
+	if found {
+		if err := k.DeleteWorkerBondRemoval(ctx, topicId, worker); err != nil {
+			return errors.Wrap(err, "error deleting worker bond removal")
+		}
+	}
+	if err := k.RemoveWorkerBond(ctx, topicId, worker, bond); err != nil {
+		return errors.Wrap(err, "error removing worker bond")
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, bond))
+	return k.SendCoinsFromModuleToAccount(ctx, types.AlloraWorkerBondsAccountName, worker, coins)
+}
+
+// Returns the redelegations of at most `limit` delegators to reputers of a topic, grouped by reputer
+// and delegator, after the ones of the given reputer and delegator if not empty. Boolean is true if
+// there are more redelegations of the topic, else false
+func (k *Keeper) getRedelegationsOfTopic(
+	ctx context.Context,
+	topicId TopicId,
+	afterReputer ActorId,
+	afterDelegator ActorId,
+	limit uint64,
+) ([][]types.Redelegation, bool, error) {
+	ret := make([][]types.Redelegation, 0)
+	rng := &collections.Range[Quadruple[TopicId, ActorId, ActorId, ActorId]]{}
+	rng = rng.Prefix(QuadrupleSinglePrefix[TopicId, ActorId, ActorId, ActorId](topicId))
+	if afterReputer != "" {
+		rng = rng.StartInclusive(QuadrupleTriplePrefix[TopicId, ActorId, ActorId, ActorId](topicId, afterReputer, afterDelegator))
+	}
+	iter, err := k.redelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating over redelegations")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error getting redelegation")
+		}
+		reputer, delegator := kv.Key.K2(), kv.Key.K3()
+		if reputer == afterReputer && delegator == afterDelegator {
+			continue
+		}
+		if len(ret) > 0 {
+			last := ret[len(ret)-1][0]
+			if last.DstReputer == reputer && last.Delegator == delegator {
+				ret[len(ret)-1] = append(ret[len(ret)-1], kv.Value)
+				continue
+			}
+		}
+		if uint64(len(ret)) >= limit {
+			return ret, true, nil
+		}
+		ret = append(ret, []types.Redelegation{kv.Value})
+	}
+	return ret, false, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Returns the total rewards paid to the creator of a topic as commission on the rewards of the topic
+func (k *Keeper) GetTopicCreatorRewards(ctx context.Context, topicId TopicId) (cosmosMath.Int, error) {
+	rewards, err := k.topicCreatorRewards.Get(ctx, topicId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return cosmosMath.ZeroInt(), nil
+		}
+		return cosmosMath.Int{}, errors.Wrap(err, "error getting topic creator rewards")
+	}
+	return rewards, nil
+}
+
+// Adds to the total rewards paid to the creator of a topic
+func (k *Keeper) AddTopicCreatorRewards(ctx context.Context, topicId TopicId, amount cosmosMath.Int) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errors.Wrap(err, "topic id validation failed")
+	}
+	if err := types.ValidateSdkIntRepresentingMonetaryValue(amount); err != nil {
+		return errors.Wrap(err, "amount validation failed")
+	}
+	rewards, err := k.GetTopicCreatorRewards(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	return k.topicCreatorRewards.Set(ctx, topicId, rewards.Add(amount))
+}
@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Filters topics listed by GetTopics. Zero values match every topic
+type TopicFilter struct {
+	Tag        string
+	Category   string
+	Creator    ActorId
+	LossMethod string
+	// nil for both active and inactive topics
+	IsActive *bool
+}
+
+// True if the topic matches every filter set, regardless of its activity
+func (f TopicFilter) matches(topic types.Topic) bool {
+	if f.Tag != "" && !slices.Contains(topic.TypedMetadata.GetTags(), f.Tag) {
+		return false
+	}
+	if f.Category != "" && topic.TypedMetadata.GetCategory() != f.Category {
+		return false
+	}
+	if f.Creator != "" && topic.Creator != f.Creator {
+		return false
+	}
+	if f.LossMethod != "" && topic.LossMethod != f.LossMethod {
+		return false
+	}
+	return true
+}
+
+// True if the indexed fields of both topics are the same
+func sameIndexedTopicFields(a, b types.Topic) bool {
+	return a.Creator == b.Creator &&
+		a.LossMethod == b.LossMethod &&
+		a.GroundTruthSource.GetParentTopicId() == b.GroundTruthSource.GetParentTopicId() &&
+		a.TypedMetadata.GetCategory() == b.TypedMetadata.GetCategory() &&
+		slices.Equal(a.TypedMetadata.GetTags(), b.TypedMetadata.GetTags())
+}
+
+// Replaces the entries of the stored version of the topic, if any, in the topic indexes
+// by those of the given version of the topic. Must be called before the topic is stored.
+func (k *Keeper) updateTopicIndexes(ctx context.Context, topicId TopicId, topic types.Topic) error {
+	previous, err := k.topics.Get(ctx, topicId)
+	if err == nil {
+		if sameIndexedTopicFields(previous, topic) {
+			return nil
+		}
+		if err := k.removeTopicFromIndexes(ctx, topicId, previous); err != nil {
+			return err
+		}
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.addTopicToIndexes(ctx, topicId, topic)
+}
+
+// Adds a stored topic to the topic indexes, e.g. a topic created before the indexes existed
+func (k *Keeper) IndexTopic(ctx context.Context, topicId TopicId) error {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	return k.addTopicToIndexes(ctx, topicId, topic)
+}
+
+func (k *Keeper) addTopicToIndexes(ctx context.Context, topicId TopicId, topic types.Topic) error {
+	if err := k.topicsByCreator.Set(ctx, collections.Join(topic.Creator, topicId)); err != nil {
+		return err
+	}
+	if err := k.topicsByLossMethod.Set(ctx, collections.Join(topic.LossMethod, topicId)); err != nil {
+		return err
+	}
+	if topic.TypedMetadata.GetCategory() != "" {
+		if err := k.topicsByCategory.Set(ctx, collections.Join(topic.TypedMetadata.GetCategory(), topicId)); err != nil {
+			return err
+		}
+	}
+	for _, tag := range topic.TypedMetadata.GetTags() {
+		if err := k.topicsByTag.Set(ctx, collections.Join(tag, topicId)); err != nil {
+			return err
+		}
+	}
+	if topic.IsDerived() {
+		if err := k.derivedTopics.Set(ctx, collections.Join(topic.GroundTruthSource.ParentTopicId, topicId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Removes the entries of the given version of the topic from the topic indexes
+func (k *Keeper) removeTopicFromIndexes(ctx context.Context, topicId TopicId, topic types.Topic) error {
+	if err := k.topicsByCreator.Remove(ctx, collections.Join(topic.Creator, topicId)); err != nil {
+		return err
+	}
+	if err := k.topicsByLossMethod.Remove(ctx, collections.Join(topic.LossMethod, topicId)); err != nil {
+		return err
+	}
+	if err := k.topicsByCategory.Remove(ctx, collections.Join(topic.TypedMetadata.GetCategory(), topicId)); err != nil {
+		return err
+	}
+	for _, tag := range topic.TypedMetadata.GetTags() {
+		if err := k.topicsByTag.Remove(ctx, collections.Join(tag, topicId)); err != nil {
+			return err
+		}
+	}
+	if topic.IsDerived() {
+		if err := k.derivedTopics.Remove(ctx, collections.Join(topic.GroundTruthSource.ParentTopicId, topicId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns a page of the topics matching the filter, in ascending order of id. Archived topics are skipped.
+// The candidates are walked through the most selective index of the filter, the cursor of the
+// pagination being the id of the topic to start the page from.
+func (k *Keeper) GetTopics(
+	ctx context.Context,
+	filter TopicFilter,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.Topic, *types.SimpleCursorPaginationResponse, error) {
+	limit, cursor, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	topics := make([]*types.Topic, 0)
+	var nextKey []byte
+	err = k.walkTopicIdCandidates(ctx, filter, cursor, func(topicId TopicId) (bool, error) {
+		topic, err := k.topics.Get(ctx, topicId)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return false, nil
+			}
+			return true, err
+		}
+		if !filter.matches(topic) {
+			return false, nil
+		}
+		isArchived, err := k.IsTopicArchived(ctx, topicId)
+		if err != nil {
+			return true, err
+		} else if isArchived {
+			return false, nil
+		}
+		if filter.IsActive != nil {
+			isActive, err := k.activeTopics.Has(ctx, topicId)
+			if err != nil {
+				return true, err
+			} else if isActive != *filter.IsActive {
+				return false, nil
+			}
+		}
+
+		if uint64(len(topics)) >= limit {
+			nextKey = make([]byte, 8)
+			binary.BigEndian.PutUint64(nextKey, topicId)
+			return true, nil
+		}
+		topics = append(topics, &topic)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error walking through topics")
+	}
+	return topics, &types.SimpleCursorPaginationResponse{NextKey: nextKey}, nil
+}
+
+// Walks in ascending order through the ids, starting from `cursor`, of the topics that may match the
+// filter using the most selective index available. Every topic is a candidate when no index applies.
+func (k *Keeper) walkTopicIdCandidates(
+	ctx context.Context,
+	filter TopicFilter,
+	cursor TopicId,
+	walkFunc func(topicId TopicId) (stop bool, err error),
+) error {
+	walkIndex := func(index collections.KeySet[collections.Pair[string, TopicId]], value string) error {
+		rng := collections.NewPrefixedPairRange[string, TopicId](value).StartInclusive(cursor)
+		return index.Walk(ctx, rng, func(key collections.Pair[string, TopicId]) (bool, error) {
+			return walkFunc(key.K2())
+		})
+	}
+	rng := new(collections.Range[TopicId]).StartInclusive(cursor)
+
+	switch {
+	case filter.Tag != "":
+		return walkIndex(k.topicsByTag, filter.Tag)
+	case filter.Creator != "":
+		return walkIndex(k.topicsByCreator, filter.Creator)
+	case filter.Category != "":
+		return walkIndex(k.topicsByCategory, filter.Category)
+	case filter.LossMethod != "":
+		return walkIndex(k.topicsByLossMethod, filter.LossMethod)
+	case filter.IsActive != nil && *filter.IsActive:
+		return k.activeTopics.Walk(ctx, rng, walkFunc)
+	default:
+		return k.topics.Walk(ctx, rng, func(topicId TopicId, _ types.Topic) (bool, error) {
+			return walkFunc(topicId)
+		})
+	}
+}
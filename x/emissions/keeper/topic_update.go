@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the topic as it will be once pending updates are applied.
+// Boolean is true if there is a pending update for the topic, else false
+func (k *Keeper) GetPendingTopicUpdate(ctx context.Context, topicId TopicId) (types.Topic, bool, error) {
+	topic, err := k.pendingTopicUpdates.Get(ctx, topicId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.Topic{}, false, nil
+		}
+		return types.Topic{}, false, err
+	}
+	return topic, true, nil
+}
+
+// Stores the updated topic to be applied at the next epoch boundary of the topic,
+// replacing any update already pending for that topic
+func (k *Keeper) SetPendingTopicUpdate(ctx context.Context, topicId TopicId, topic types.Topic) error {
+	if err := types.ValidateTopicId(topicId); err != nil {
+		return errors.Wrap(err, "topic id validation failed")
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting params")
+	}
+	if err := topic.Validate(params); err != nil {
+		return errors.Wrap(err, "pending topic update validation failure")
+	}
+	return k.pendingTopicUpdates.Set(ctx, topicId, topic)
+}
+
+// Applies the pending update of a topic, if any, to the stored topic and emits an event
+// with the topic before and after the update. Only the fields that can be changed through
+// MsgUpdateTopic are taken from the pending update, so that state written to the topic by
+// the chain since the update was requested (e.g. EpochLastEnded) is preserved.
+// The update stays pending if it cannot be applied, to be retried at the next epoch boundary
+// unless the creator replaces it in the meantime.
+// Must only be called at an epoch boundary of the topic.
+func (k *Keeper) ApplyPendingTopicUpdate(ctx context.Context, topicId TopicId) error {
+	pending, found, err := k.GetPendingTopicUpdate(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting pending topic update")
+	}
+	if !found {
+		return nil
+	}
+	oldTopic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return errors.Wrap(err, "error getting topic")
+	}
+	newTopic := oldTopic
+	newTopic.Metadata = pending.Metadata
+	newTopic.TypedMetadata = pending.TypedMetadata
+	newTopic.EpochLength = pending.EpochLength
+	newTopic.GroundTruthLag = pending.GroundTruthLag
+	newTopic.WorkerSubmissionWindow = pending.WorkerSubmissionWindow
+	newTopic.PNorm = pending.PNorm
+	newTopic.AlphaRegret = pending.AlphaRegret
+	newTopic.Epsilon = pending.Epsilon
+	newTopic.MeritSortitionAlpha = pending.MeritSortitionAlpha
+	newTopic.ActiveInfererQuantile = pending.ActiveInfererQuantile
+	newTopic.ActiveForecasterQuantile = pending.ActiveForecasterQuantile
+	newTopic.ActiveReputerQuantile = pending.ActiveReputerQuantile
+	newTopic.GroundTruthSource = pending.GroundTruthSource
+	newTopic.CreatorCommission = pending.CreatorCommission
+	newTopic.WorkerRevealWindow = pending.WorkerRevealWindow
+	newTopic.ReputerRevealWindow = pending.ReputerRevealWindow
+	newTopic.MinWorkerBond = pending.MinWorkerBond
+	newTopic.ReputerOutlierThreshold = pending.ReputerOutlierThreshold
+
+	// Other topics may have changed their ground truth source since the update was requested
+	if newTopic.IsDerived() && !sameGroundTruthSource(oldTopic.GroundTruthSource, newTopic.GroundTruthSource) {
+		if err := k.ValidateGroundTruthSource(ctx, topicId, newTopic.GroundTruthSource); err != nil {
+			return errors.Wrap(err, "error validating ground truth source of topic update")
+		}
+	}
+
+	// SetTopic revalidates the topic against the current params
+	if err := k.SetTopic(ctx, topicId, newTopic); err != nil {
+		return errors.Wrap(err, "error setting updated topic")
+	}
+	if err := k.pendingTopicUpdates.Remove(ctx, topicId); err != nil {
+		return errors.Wrap(err, "error removing pending topic update")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	types.EmitNewTopicUpdatedEvent(sdkCtx, topicId, sdkCtx.BlockHeight(), oldTopic, newTopic)
+	return nil
+}
+
+func sameGroundTruthSource(a, b *types.GroundTruthSource) bool {
+	return a.GetParentTopicId() == b.GetParentTopicId() && a.GetAggregation() == b.GetAggregation()
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Returns the bond held by a worker in a topic, zero if none
+func (k *Keeper) GetWorkerBond(ctx context.Context, topicId TopicId, worker ActorId) (cosmosMath.Int, error) {
+	bond, err := k.workerBonds.Get(ctx, collections.Join(topicId, worker))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return cosmosMath.ZeroInt(), nil
+		}
+		return cosmosMath.Int{}, errors.Wrap(err, "error getting worker bond")
+	}
+	return bond, nil
+}
+
+// Sets the bond held by a worker in a topic, removing it when zero
+func (k *Keeper) SetWorkerBond(ctx context.Context, topicId TopicId, worker ActorId, bond cosmosMath.Int) error {
+	if err := types.ValidateSdkIntRepresentingMonetaryValue(bond); err != nil {
+		return errors.Wrap(err, "worker bond validation failed")
+	}
+	key := collections.Join(topicId, worker)
+	if bond.IsZero() {
+		return k.workerBonds.Remove(ctx, key)
+	}
+	return k.workerBonds.Set(ctx, key, bond)
+}
+
+// Adds to the bond of a worker in a topic. The funds are expected to be held by the worker bonds module account already.
+func (k *Keeper) AddWorkerBond(ctx context.Context, topicId TopicId, worker ActorId, amount cosmosMath.Int) error {
+	bond, err := k.GetWorkerBond(ctx, topicId, worker)
+	if err != nil {
+		return err
+	}
+	return k.SetWorkerBond(ctx, topicId, worker, bond.Add(amount))
+}
+
+// Subtracts from the bond of a worker in a topic. A pending removal of the bond is capped to what remains.
+// The funds are left to the caller to move out of the worker bonds module account.
+func (k *Keeper) RemoveWorkerBond(ctx context.Context, topicId TopicId, worker ActorId, amount cosmosMath.Int) error {
+	bond, err := k.GetWorkerBond(ctx, topicId, worker)
+	if err != nil {
+		return err
+	}
+	if amount.GT(bond) {
+		return errors.Wrapf(types.ErrInsufficientWorkerBondToRemove, "bond %s, amount %s", bond, amount)
+	}
+	remaining := bond.Sub(amount)
+	if err := k.SetWorkerBond(ctx, topicId, worker, remaining); err != nil {
+		return err
+	}
+
+	removal, found, err := k.GetWorkerBondRemoval(ctx, topicId, worker)
+	if err != nil {
+		return err
+	}
+	if found && removal.Amount.GT(remaining) {
+		if remaining.IsZero() {
+			return k.DeleteWorkerBondRemoval(ctx, topicId, worker)
+		}
+		removal.Amount = remaining
+		return k.SetWorkerBondRemoval(ctx, removal)
+	}
+	return nil
+}
+
+// Slashes the bond of a worker in a topic, sending the slashed amount to the ecosystem bucket.
+// At most the whole bond is slashed, the slashed amount is returned.
+func (k *Keeper) SlashWorkerBond(ctx context.Context, topicId TopicId, worker ActorId, amount cosmosMath.Int) (cosmosMath.Int, error) {
+	bond, err := k.GetWorkerBond(ctx, topicId, worker)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	slashed := cosmosMath.MinInt(amount, bond)
+	if slashed.IsZero() {
+		return slashed, nil
+	}
+	if err := k.RemoveWorkerBond(ctx, topicId, worker, slashed); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, slashed))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraWorkerBondsAccountName, minttypes.EcosystemModuleName, coins)
+	if err != nil {
+		return cosmosMath.Int{}, errors.Wrap(err, "error sending slashed worker bond to ecosystem")
+	}
+	return slashed, nil
+}
+
+// True if the worker holds at least the minimum worker bond of the topic, always true for topics requiring no bond
+func (k *Keeper) HasSufficientWorkerBond(ctx context.Context, topic types.Topic, worker ActorId) (bool, error) {
+	if !topic.RequiresWorkerBond() {
+		return true, nil
+	}
+	bond, err := k.GetWorkerBond(ctx, topic.Id, worker)
+	if err != nil {
+		return false, err
+	}
+	return bond.GTE(topic.MinWorkerBond), nil
+}
+
+// Queues the removal of a worker bond, replacing any removal already queued for the worker in the topic
+func (k *Keeper) SetWorkerBondRemoval(ctx context.Context, removal types.WorkerBondRemovalInfo) error {
+	if err := removal.Validate(); err != nil {
+		return errors.Wrap(err, "worker bond removal is not valid")
+	}
+	previous, found, err := k.GetWorkerBondRemoval(ctx, removal.TopicId, removal.Worker)
+	if err != nil {
+		return err
+	}
+	if found {
+		byBlockKey := collections.Join3(previous.BlockRemovalCompleted, previous.TopicId, previous.Worker)
+		if err := k.workerBondRemovalsByBlock.Remove(ctx, byBlockKey); err != nil {
+			return errors.Wrap(err, "error removing previous worker bond removal")
+		}
+	}
+	byBlockKey := collections.Join3(removal.BlockRemovalCompleted, removal.TopicId, removal.Worker)
+	if err := k.workerBondRemovalsByBlock.Set(ctx, byBlockKey, removal); err != nil {
+		return errors.Wrap(err, "error setting worker bond removal by block")
+	}
+	byWorkerKey := collections.Join(removal.TopicId, removal.Worker)
+	return k.workerBondRemovalsByWorker.Set(ctx, byWorkerKey, removal.BlockRemovalCompleted)
+}
+
+// Removes the queued removal of a worker bond
+func (k *Keeper) DeleteWorkerBondRemoval(ctx context.Context, topicId TopicId, worker ActorId) error {
+	byWorkerKey := collections.Join(topicId, worker)
+	blockHeight, err := k.workerBondRemovalsByWorker.Get(ctx, byWorkerKey)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.ErrWorkerBondRemovalNotFound
+		}
+		return errors.Wrap(err, "error getting worker bond removal by worker")
+	}
+	if err := k.workerBondRemovalsByBlock.Remove(ctx, collections.Join3(blockHeight, topicId, worker)); err != nil {
+		return errors.Wrap(err, "error removing worker bond removal by block")
+	}
+	return k.workerBondRemovalsByWorker.Remove(ctx, byWorkerKey)
+}
+
+// Returns the queued removal of the bond of a worker in a topic, if any
+func (k *Keeper) GetWorkerBondRemoval(
+	ctx context.Context,
+	topicId TopicId,
+	worker ActorId,
+) (types.WorkerBondRemovalInfo, bool, error) {
+	blockHeight, err := k.workerBondRemovalsByWorker.Get(ctx, collections.Join(topicId, worker))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.WorkerBondRemovalInfo{}, false, nil
+		}
+		return types.WorkerBondRemovalInfo{}, false, errors.Wrap(err, "error getting worker bond removal by worker")
+	}
+	removal, err := k.workerBondRemovalsByBlock.Get(ctx, collections.Join3(blockHeight, topicId, worker))
+	if err != nil {
+		return types.WorkerBondRemovalInfo{}, false, errors.Wrap(err, "error getting worker bond removal by block")
+	}
+	return removal, true, nil
+}
+
+// Returns at most `limit` worker bond removals completing before or at the block.
+// Boolean is true if there are more removals to process, else false
+func (k *Keeper) GetWorkerBondRemovalsUpUntilBlock(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	limit uint64,
+) ([]types.WorkerBondRemovalInfo, bool, error) {
+	ret := make([]types.WorkerBondRemovalInfo, 0)
+	rng := new(collections.Range[collections.Triple[BlockHeight, TopicId, ActorId]]).
+		EndExclusive(collections.TriplePrefix[BlockHeight, TopicId, ActorId](blockHeight + 1))
+	iter, err := k.workerBondRemovalsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error iterating over worker bond removals by block")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(ret)) >= limit {
+			return ret, true, nil
+		}
+		removal, err := iter.Value()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "error getting worker bond removal by block")
+		}
+		ret = append(ret, removal)
+	}
+	return ret, false, nil
+}
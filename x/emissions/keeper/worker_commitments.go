@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Stores the commitment of a worker to the payload it reveals for the open worker nonce of a topic
+func (k *Keeper) SetWorkerCommitment(ctx context.Context, commitment types.WorkerCommitment) error {
+	if err := commitment.Validate(); err != nil {
+		return errors.Wrap(err, "worker commitment validation failed")
+	}
+	return k.workerCommitments.Set(ctx, collections.Join(commitment.TopicId, commitment.Worker), commitment)
+}
+
+// Returns the commitment of a worker in a topic, if any
+func (k *Keeper) GetWorkerCommitment(ctx context.Context, topicId TopicId, worker ActorId) (types.WorkerCommitment, bool, error) {
+	commitment, err := k.workerCommitments.Get(ctx, collections.Join(topicId, worker))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.WorkerCommitment{}, false, nil
+		}
+		return types.WorkerCommitment{}, false, errors.Wrap(err, "error getting worker commitment")
+	}
+	return commitment, true, nil
+}
+
+// Removes the commitment of a worker in a topic, once revealed
+func (k *Keeper) RemoveWorkerCommitment(ctx context.Context, topicId TopicId, worker ActorId) error {
+	return k.workerCommitments.Remove(ctx, collections.Join(topicId, worker))
+}
+
+// Returns the commitments of a topic that were not revealed yet
+func (k *Keeper) GetWorkerCommitmentsOfTopic(ctx context.Context, topicId TopicId) ([]types.WorkerCommitment, error) {
+	iter, err := k.workerCommitments.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, ActorId](topicId))
+	if err != nil {
+		return nil, errors.Wrap(err, "error iterating over worker commitments")
+	}
+	commitments, err := iter.Values()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting worker commitments")
+	}
+	return commitments, nil
+}
+
+// Removes all commitments of a topic, when its worker nonce closes
+func (k *Keeper) ResetWorkerCommitmentsForTopic(ctx context.Context, topicId TopicId) error {
+	if err := k.workerCommitments.Clear(ctx, collections.NewPrefixedPairRange[TopicId, ActorId](topicId)); err != nil {
+		return errors.Wrap(err, "error clearing worker commitments")
+	}
+	return nil
+}
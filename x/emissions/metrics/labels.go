@@ -1,16 +1,24 @@
 package metrics
 
 const (
-	INFERER_SCORE_EVENT       = "inferer_score_event"
-	FORECASTER_SCORE_EVENT    = "forecaster_score_event"
-	REPUTER_SOCRE_EVENT       = "reputer_score_event"
-	NETWORK_LOSS_EVENT        = "network_loss_event"
-	INFERER_REWARD_EVENT      = "inferer_reward_event"
-	FORECASTER_REWARD_EVENT   = "forecaster_reward_event"
-	REPUTER_REWARD_EVENT      = "reputer_reward_event"
-	WORKER_LAST_COMMIT_EVENT  = "worker_last_commit_event"
-	REPUTER_LAST_COMMIT_EVENT = "reputer_last_commit_event"
-	FORECAST_TASK_SCORE_EVENT = "forecast_task_score_event"
-	TOPIC_REWARD_EVENT        = "topic_reward_event"
-	WORKER_EMA_SCORE_EVENT    = "worker_ema_score_event"
+	INFERER_SCORE_EVENT        = "inferer_score_event"
+	FORECASTER_SCORE_EVENT     = "forecaster_score_event"
+	REPUTER_SOCRE_EVENT        = "reputer_score_event"
+	NETWORK_LOSS_EVENT         = "network_loss_event"
+	INFERER_REWARD_EVENT       = "inferer_reward_event"
+	FORECASTER_REWARD_EVENT    = "forecaster_reward_event"
+	REPUTER_REWARD_EVENT       = "reputer_reward_event"
+	TOPIC_CREATOR_REWARD_EVENT = "topic_creator_reward_event"
+	WORKER_LAST_COMMIT_EVENT   = "worker_last_commit_event"
+	REPUTER_LAST_COMMIT_EVENT  = "reputer_last_commit_event"
+	FORECAST_TASK_SCORE_EVENT  = "forecast_task_score_event"
+	TOPIC_REWARD_EVENT         = "topic_reward_event"
+	WORKER_EMA_SCORE_EVENT     = "worker_ema_score_event"
+	TOPIC_UPDATED_EVENT        = "topic_updated_event"
+	TOPIC_UPDATE_FAILED_EVENT  = "topic_update_failed_event"
+	TOPIC_ARCHIVED_EVENT       = "topic_archived_event"
+	TOPIC_PRUNED_EVENT         = "topic_pruned_event"
+	REPUTER_SLASHED_EVENT      = "reputer_slashed_event"
+	ACTOR_JAILED_EVENT         = "actor_jailed_event"
+	ACTOR_DEREGISTERED_EVENT   = "actor_deregistered_event"
 )
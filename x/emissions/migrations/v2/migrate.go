@@ -184,6 +184,8 @@ func MigrateNetworkLossBundles(store storetypes.KVStore, cdc codec.BinaryCodec)
 			ForecasterValues:              newForecastValues,
 			NaiveValue:                    oldMsg.NaiveValue,
 			OneOutInfererForecasterValues: []*types.OneOutInfererForecasterValues{},
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 			OneOutInfererValues:           newOneOutInfererValues,
 			OneOutForecasterValues:        newOneOutForecasterValues,
 			OneInForecasterValues:         newOneInForecastValues,
@@ -267,6 +269,8 @@ func MigrateAllLossBundles(store storetypes.KVStore, cdc codec.BinaryCodec) erro
 						ForecasterValues:              valueBundle.ValueBundle.ForecasterValues,
 						NaiveValue:                    valueBundle.ValueBundle.NaiveValue,
 						OneOutInfererForecasterValues: []*types.OneOutInfererForecasterValues{},
+						GroundTruth:                   nil,
+						GroundTruthValues:             nil,
 						OneOutInfererValues:           valueBundle.ValueBundle.OneOutInfererValues,
 						OneOutForecasterValues:        valueBundle.ValueBundle.OneOutForecasterValues,
 						OneInForecasterValues:         valueBundle.ValueBundle.OneInForecasterValues,
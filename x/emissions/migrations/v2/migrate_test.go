@@ -61,6 +61,9 @@ func (s *EmissionsV2MigrationsTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRequestsAccountName:                  {"minter"},
+		types.AlloraWorkerBondsAccountName:               {"minter"},
+		types.AlloraDelegateStakeReceiptsAccountName:     {"minter", "burner"},
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -524,6 +527,18 @@ func (s *EmissionsV2MigrationsTestSuite) TestMigrateParams() {
 		MaxElementsPerForecast:              123,
 		MaxActiveTopicsPerBlock:             123,
 		MaxStringLength:                     123,
+		MaxPruneArchivedTopicKeysEndBlock:   123,
+		MaxFundingSubscriptionDripsEndBlock: 123,
+		MaxTopicCreatorCommission:           alloraMath.MustNewDecFromString("0.1337"),
+		NodeRotationCooldown:                123,
+		ReputerOutlierSlashEpochs:           123,
+		ReputerOutlierSlashFraction:         alloraMath.MustNewDecFromString("0.1337"),
+		MaxMissedEpochsJail:                 123,
+		MaxMissedEpochsDeregister:           1234,
+		ReputerCommissionUpdateCooldown:     1234,
+		MaxDelegateToSelfStakeRatio:         alloraMath.MustNewDecFromString("0.1337"),
+		MaxDelegateStakePerReputer:          cosmosMath.NewInt(1337),
+		MaxAutoCompoundDelegationsPerPayout: 1234,
 	}
 	err := s.emissionsKeeper.SetParams(s.ctx, prevParams)
 	s.Require().NoError(err)
@@ -294,6 +294,16 @@ func getNewTopic(oldMsg oldtypes.Topic) types.Topic {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.25"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.25"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.25"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -3,6 +3,7 @@ package v4
 import (
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -245,5 +246,15 @@ func copyTopic(original emissionstypes.Topic) emissionstypes.Topic {
 		ActiveInfererQuantile:    original.ActiveInfererQuantile,
 		ActiveForecasterQuantile: original.ActiveForecasterQuantile,
 		ActiveReputerQuantile:    original.ActiveReputerQuantile,
+		TypedMetadata:            original.TypedMetadata,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 }
@@ -6,6 +6,7 @@ import (
 	alloraMath "github.com/allora-network/allora-chain/math"
 
 	collections "cosmossdk.io/collections"
+	cosmosMath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
 
@@ -202,6 +203,16 @@ func (s *EmissionsV4MigrationTestSuite) TestMigratedTopicWithNoProblems() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	bz, err := proto.Marshal(&migratedOldTopic)
@@ -276,6 +287,16 @@ func (s *EmissionsV4MigrationTestSuite) TestMigratedTopicWithNaNInitialRegret()
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	bz, err := proto.Marshal(&migratedOldTopicWithNaNInitialRegret)
@@ -622,6 +643,8 @@ func getBundle() emissionstypes.ValueBundle {
 				},
 			},
 		},
+		GroundTruth:       nil,
+		GroundTruthValues: nil,
 	}
 }
 
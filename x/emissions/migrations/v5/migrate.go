@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -250,5 +251,15 @@ func copyTopic(original emissionstypes.Topic) emissionstypes.Topic {
 		ActiveInfererQuantile:    original.ActiveInfererQuantile,
 		ActiveForecasterQuantile: original.ActiveForecasterQuantile,
 		ActiveReputerQuantile:    original.ActiveReputerQuantile,
+		TypedMetadata:            original.TypedMetadata,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 }
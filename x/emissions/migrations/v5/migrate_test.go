@@ -7,6 +7,7 @@ import (
 	oldV4Types "github.com/allora-network/allora-chain/x/emissions/migrations/v5/oldtypes"
 
 	collections "cosmossdk.io/collections"
+	cosmosMath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
 
@@ -131,6 +132,19 @@ func (s *EmissionsV5MigrationTestSuite) TestMigrateParams() {
 	// PNormSafeDiv - defaultParams.PNormSafeDiv
 
 	paramsExpected := defaultParams
+	// Added after version 5, set by later migrations
+	paramsExpected.MaxPruneArchivedTopicKeysEndBlock = 0
+	paramsExpected.MaxFundingSubscriptionDripsEndBlock = 0
+	paramsExpected.MaxTopicCreatorCommission = alloraMath.Dec{}
+	paramsExpected.NodeRotationCooldown = 0
+	paramsExpected.ReputerOutlierSlashEpochs = 0
+	paramsExpected.ReputerOutlierSlashFraction = alloraMath.Dec{}
+	paramsExpected.MaxMissedEpochsJail = 0
+	paramsExpected.MaxMissedEpochsDeregister = 0
+	paramsExpected.ReputerCommissionUpdateCooldown = 0
+	paramsExpected.MaxDelegateToSelfStakeRatio = alloraMath.Dec{}
+	paramsExpected.MaxDelegateStakePerReputer = cosmosMath.ZeroInt()
+	paramsExpected.MaxAutoCompoundDelegationsPerPayout = 0
 
 	params, err := s.emissionsKeeper.GetParams(s.ctx)
 	s.Require().NoError(err)
@@ -206,6 +220,16 @@ func (s *EmissionsV5MigrationTestSuite) TestMigratedTopicWithNaNInitialRegret()
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 
 	_, err = s.emissionsKeeper.IncrementTopicId(s.ctx)
@@ -281,6 +305,16 @@ func (s *EmissionsV5MigrationTestSuite) TestMigratedSumTotalPreviousTopicWeights
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.1337"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.1337"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.1337"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	migratedOldTopic2 := migratedOldTopic1
 	migratedOldTopic2.Id = 2
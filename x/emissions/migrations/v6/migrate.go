@@ -0,0 +1,149 @@
+package v6
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// MigrateStore migrates the store from version 5 to version 6
+// it does the following:
+// - migrates params to set the params added since version 5
+// - adds the existing topics to the topic indexes
+// - adds the registered workers and reputers to the actor -> topic indexes
+func MigrateStore(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
+	ctx.Logger().Info("STARTING EMISSIONS MODULE MIGRATION FROM VERSION 5 TO VERSION 6")
+	storageService := emissionsKeeper.GetStorageService()
+	store := runtime.KVStoreAdapter(storageService.OpenKVStore(ctx))
+	cdc := emissionsKeeper.GetBinaryCodec()
+
+	ctx.Logger().Info("MIGRATING PARAMS FROM VERSION 5 TO VERSION 6")
+	if err := MigrateParams(store, cdc); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER MigrateParams() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("INDEXING TOPICS FROM VERSION 5 TO VERSION 6")
+	if err := MigrateTopicIndexes(ctx, emissionsKeeper); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER MigrateTopicIndexes() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("INDEXING TOPIC ACTORS FROM VERSION 5 TO VERSION 6")
+	if err := MigrateTopicActorIndexes(ctx, emissionsKeeper); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER MigrateTopicActorIndexes() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("INDEXING DELEGATOR DELEGATIONS FROM VERSION 5 TO VERSION 6")
+	if err := MigrateDelegatorDelegationIndex(ctx, emissionsKeeper); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER MigrateDelegatorDelegationIndex() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("INDEXING DELEGATE STAKE REMOVALS FROM VERSION 5 TO VERSION 6")
+	if err := MigrateDelegateStakeRemovalIndex(ctx, emissionsKeeper); err != nil {
+		ctx.Logger().Error("ERROR INVOKING MIGRATION HANDLER MigrateDelegateStakeRemovalIndex() FROM VERSION 5 TO VERSION 6")
+		return err
+	}
+
+	ctx.Logger().Info("MIGRATING EMISSIONS MODULE FROM VERSION 5 TO VERSION 6 COMPLETE")
+	return nil
+}
+
+// migrate params for this new version
+// params are only added since version 5, so the old params decode as the new params
+// with the added fields left to zero, which are then set to their default values
+func MigrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	params := emissionstypes.Params{} //nolint: exhaustruct // empty struct used by cosmos-sdk Unmarshal below
+	oldParamsBytes := store.Get(emissionstypes.ParamsKey)
+	if oldParamsBytes == nil {
+		return errorsmod.Wrapf(emissionstypes.ErrNotFound, "old parameters not found")
+	}
+	err := proto.Unmarshal(oldParamsBytes, &params)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to unmarshal old parameters")
+	}
+
+	defaultParams := emissionstypes.DefaultParams()
+
+	// DIFFERENCE BETWEEN OLD PARAMS AND NEW PARAMS:
+	// ADDED:
+	//      MaxPruneArchivedTopicKeysEndBlock
+	params.MaxPruneArchivedTopicKeysEndBlock = defaultParams.MaxPruneArchivedTopicKeysEndBlock
+	//      MaxFundingSubscriptionDripsEndBlock
+	params.MaxFundingSubscriptionDripsEndBlock = defaultParams.MaxFundingSubscriptionDripsEndBlock
+	//      MaxTopicCreatorCommission
+	params.MaxTopicCreatorCommission = defaultParams.MaxTopicCreatorCommission
+	//      NodeRotationCooldown
+	params.NodeRotationCooldown = defaultParams.NodeRotationCooldown
+	//      ReputerOutlierSlashEpochs
+	params.ReputerOutlierSlashEpochs = defaultParams.ReputerOutlierSlashEpochs
+	//      ReputerOutlierSlashFraction
+	params.ReputerOutlierSlashFraction = defaultParams.ReputerOutlierSlashFraction
+	//      MaxMissedEpochsJail
+	params.MaxMissedEpochsJail = defaultParams.MaxMissedEpochsJail
+	//      MaxMissedEpochsDeregister
+	params.MaxMissedEpochsDeregister = defaultParams.MaxMissedEpochsDeregister
+	//      ReputerCommissionUpdateCooldown
+	params.ReputerCommissionUpdateCooldown = defaultParams.ReputerCommissionUpdateCooldown
+	//      MaxDelegateToSelfStakeRatio
+	params.MaxDelegateToSelfStakeRatio = defaultParams.MaxDelegateToSelfStakeRatio
+	//      MaxDelegateStakePerReputer
+	params.MaxDelegateStakePerReputer = defaultParams.MaxDelegateStakePerReputer
+	//      MaxAutoCompoundDelegationsPerPayout
+	params.MaxAutoCompoundDelegationsPerPayout = defaultParams.MaxAutoCompoundDelegationsPerPayout
+
+	store.Delete(emissionstypes.ParamsKey)
+	store.Set(emissionstypes.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
+
+// add the topics created before version 6 to the topic indexes used to filter topics
+func MigrateTopicIndexes(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
+	nextTopicId, err := emissionsKeeper.GetNextTopicId(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to get next topic id")
+	}
+	for topicId := uint64(1); topicId < nextTopicId; topicId++ {
+		exists, err := emissionsKeeper.TopicExists(ctx, topicId)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to check if topic %d exists", topicId)
+		} else if !exists {
+			continue
+		}
+		if err := emissionsKeeper.IndexTopic(ctx, topicId); err != nil {
+			return errorsmod.Wrapf(err, "failed to index topic %d", topicId)
+		}
+	}
+	return nil
+}
+
+// add the workers and reputers registered before version 6 to the actor -> topic indexes
+func MigrateTopicActorIndexes(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
+	if err := emissionsKeeper.IndexTopicActors(ctx); err != nil {
+		return errorsmod.Wrapf(err, "failed to index topic actors")
+	}
+	return nil
+}
+
+// add the delegations of stake placed before version 6 to the delegator -> delegation and reputer -> delegation indexes
+func MigrateDelegatorDelegationIndex(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
+	if err := emissionsKeeper.IndexDelegatorDelegations(ctx); err != nil {
+		return errorsmod.Wrapf(err, "failed to index delegator delegations")
+	}
+	return nil
+}
+
+// add the delegate stake removals queued before version 6 to the reputer -> removal index
+func MigrateDelegateStakeRemovalIndex(ctx sdk.Context, emissionsKeeper keeper.Keeper) error {
+	if err := emissionsKeeper.IndexDelegateStakeRemovals(ctx); err != nil {
+		return errorsmod.Wrapf(err, "failed to index delegate stake removals")
+	}
+	return nil
+}
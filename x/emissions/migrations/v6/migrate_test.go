@@ -0,0 +1,282 @@
+package v6_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	cosmosMath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	v6 "github.com/allora-network/allora-chain/x/emissions/migrations/v6"
+	emissions "github.com/allora-network/allora-chain/x/emissions/module"
+	emissionstestutil "github.com/allora-network/allora-chain/x/emissions/testutil"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	codecAddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmissionsV6MigrationTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+
+	ctx             sdk.Context
+	storeService    store.KVStoreService
+	emissionsKeeper *keeper.Keeper
+}
+
+func TestEmissionsV6MigrationTestSuite(t *testing.T) {
+	suite.Run(t, new(EmissionsV6MigrationTestSuite))
+}
+
+func (s *EmissionsV6MigrationTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(emissions.AppModule{})
+	key := storetypes.NewKVStoreKey(emissionstypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	s.storeService = storeService
+	testCtx := cosmostestutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// gomock initializations
+	s.ctrl = gomock.NewController(s.T())
+	accountKeeper := emissionstestutil.NewMockAccountKeeper(s.ctrl)
+	bankKeeper := emissionstestutil.NewMockBankKeeper(s.ctrl)
+	emissionsKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		codecAddress.NewBech32Codec(params.Bech32PrefixAccAddr),
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		authtypes.FeeCollectorName)
+
+	s.emissionsKeeper = &emissionsKeeper
+}
+
+// in this test we check that the emissions module params have been migrated,
+// the existing fields are kept and the expected new fields are set.
+func (s *EmissionsV6MigrationTestSuite) TestMigrateParams() {
+	storageService := s.emissionsKeeper.GetStorageService()
+	store := runtime.KVStoreAdapter(storageService.OpenKVStore(s.ctx))
+	cdc := s.emissionsKeeper.GetBinaryCodec()
+
+	defaultParams := emissionstypes.DefaultParams()
+	// Params as stored by version 5, i.e. without the params added since
+	paramsOld := defaultParams
+	paramsOld.MaxPageLimit = 123
+	paramsOld.MaxPruneArchivedTopicKeysEndBlock = 0
+	paramsOld.MaxFundingSubscriptionDripsEndBlock = 0
+	paramsOld.MaxTopicCreatorCommission = alloraMath.ZeroDec()
+	paramsOld.NodeRotationCooldown = 0
+	paramsOld.ReputerOutlierSlashEpochs = 0
+	paramsOld.ReputerOutlierSlashFraction = alloraMath.ZeroDec()
+	paramsOld.MaxMissedEpochsJail = 0
+	paramsOld.MaxMissedEpochsDeregister = 0
+	paramsOld.ReputerCommissionUpdateCooldown = 0
+	paramsOld.MaxDelegateToSelfStakeRatio = alloraMath.ZeroDec()
+	paramsOld.MaxDelegateStakePerReputer = cosmosMath.ZeroInt()
+	paramsOld.MaxAutoCompoundDelegationsPerPayout = 0
+	store.Set(emissionstypes.ParamsKey, cdc.MustMarshal(&paramsOld))
+
+	// Run migration
+	err := v6.MigrateParams(store, cdc)
+	s.Require().NoError(err)
+
+	// TO BE ADDED VIA DEFAULT PARAMS
+	// MaxPruneArchivedTopicKeysEndBlock - defaultParams.MaxPruneArchivedTopicKeysEndBlock
+	// MaxFundingSubscriptionDripsEndBlock - defaultParams.MaxFundingSubscriptionDripsEndBlock
+	// MaxTopicCreatorCommission - defaultParams.MaxTopicCreatorCommission
+	// NodeRotationCooldown - defaultParams.NodeRotationCooldown
+	// ReputerOutlierSlashEpochs - defaultParams.ReputerOutlierSlashEpochs
+	// ReputerOutlierSlashFraction - defaultParams.ReputerOutlierSlashFraction
+	// MaxMissedEpochsJail - defaultParams.MaxMissedEpochsJail
+	// MaxMissedEpochsDeregister - defaultParams.MaxMissedEpochsDeregister
+	// ReputerCommissionUpdateCooldown - defaultParams.ReputerCommissionUpdateCooldown
+	// MaxDelegateToSelfStakeRatio - defaultParams.MaxDelegateToSelfStakeRatio
+	// MaxDelegateStakePerReputer - defaultParams.MaxDelegateStakePerReputer
+	// MaxAutoCompoundDelegationsPerPayout - defaultParams.MaxAutoCompoundDelegationsPerPayout
+
+	paramsExpected := defaultParams
+	paramsExpected.MaxPageLimit = 123
+
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(123), params.MaxPageLimit)
+	s.Require().Equal(paramsExpected.MaxPruneArchivedTopicKeysEndBlock, params.MaxPruneArchivedTopicKeysEndBlock)
+	s.Require().Equal(paramsExpected.MaxFundingSubscriptionDripsEndBlock, params.MaxFundingSubscriptionDripsEndBlock)
+	s.Require().Equal(paramsExpected.MaxTopicCreatorCommission, params.MaxTopicCreatorCommission)
+	s.Require().Equal(paramsExpected.NodeRotationCooldown, params.NodeRotationCooldown)
+	s.Require().Equal(paramsExpected.ReputerOutlierSlashEpochs, params.ReputerOutlierSlashEpochs)
+	s.Require().Equal(paramsExpected.ReputerOutlierSlashFraction, params.ReputerOutlierSlashFraction)
+	s.Require().Equal(paramsExpected.MaxMissedEpochsJail, params.MaxMissedEpochsJail)
+	s.Require().Equal(paramsExpected.MaxMissedEpochsDeregister, params.MaxMissedEpochsDeregister)
+	s.Require().Equal(paramsExpected.ReputerCommissionUpdateCooldown, params.ReputerCommissionUpdateCooldown)
+	s.Require().Equal(paramsExpected.MaxDelegateToSelfStakeRatio, params.MaxDelegateToSelfStakeRatio)
+	s.Require().Equal(paramsExpected.MaxDelegateStakePerReputer, params.MaxDelegateStakePerReputer)
+	s.Require().Equal(paramsExpected.MaxAutoCompoundDelegationsPerPayout, params.MaxAutoCompoundDelegationsPerPayout)
+	s.Require().Equal(paramsExpected, params)
+	s.Require().NoError(params.Validate())
+}
+
+// in this test we check that topics stored before version 6, hence missing from the topic indexes,
+// can be listed by filter once the migration has indexed them
+func (s *EmissionsV6MigrationTestSuite) TestMigrateTopicIndexes() {
+	storageService := s.emissionsKeeper.GetStorageService()
+	store := runtime.KVStoreAdapter(storageService.OpenKVStore(s.ctx))
+	cdc := s.emissionsKeeper.GetBinaryCodec()
+
+	err := s.emissionsKeeper.SetParams(s.ctx, emissionstypes.DefaultParams())
+	s.Require().NoError(err)
+	// reserve topic id 0 and take topic id 1
+	_, err = s.emissionsKeeper.IncrementTopicId(s.ctx)
+	s.Require().NoError(err)
+	topicId, err := s.emissionsKeeper.IncrementTopicId(s.ctx)
+	s.Require().NoError(err)
+
+	// Topic written as by version 5, without going through the keeper
+	creator := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+	topic := emissionstypes.Topic{ //nolint: exhaustruct // only the indexed fields matter here
+		Id:         topicId,
+		Creator:    creator,
+		LossMethod: "mse",
+	}
+	topicKey, err := collections.EncodeKeyWithPrefix(emissionstypes.TopicsKey.Bytes(), collections.Uint64Key, topicId)
+	s.Require().NoError(err)
+	store.Set(topicKey, cdc.MustMarshal(&topic))
+
+	filter := keeper.TopicFilter{Creator: creator} //nolint: exhaustruct // other filters unset
+	topics, _, err := s.emissionsKeeper.GetTopics(s.ctx, filter, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(topics)
+
+	// Run migration
+	err = v6.MigrateTopicIndexes(s.ctx, *s.emissionsKeeper)
+	s.Require().NoError(err)
+
+	topics, _, err = s.emissionsKeeper.GetTopics(s.ctx, filter, nil)
+	s.Require().NoError(err)
+	s.Require().Len(topics, 1)
+	s.Require().Equal(topicId, topics[0].Id)
+
+	filter = keeper.TopicFilter{LossMethod: "mse"} //nolint: exhaustruct // other filters unset
+	topics, _, err = s.emissionsKeeper.GetTopics(s.ctx, filter, nil)
+	s.Require().NoError(err)
+	s.Require().Len(topics, 1)
+}
+
+// in this test we check that workers and reputers registered before version 6, hence missing
+// from the actor -> topic indexes, are found in their topics once the migration has indexed them
+func (s *EmissionsV6MigrationTestSuite) TestMigrateTopicActorIndexes() {
+	storageService := s.emissionsKeeper.GetStorageService()
+	store := runtime.KVStoreAdapter(storageService.OpenKVStore(s.ctx))
+
+	// Registrations written as by version 5, without going through the keeper
+	actor := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+	keyCodec := collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)
+	for _, registration := range []struct {
+		prefix  collections.Prefix
+		topicId uint64
+	}{
+		{prefix: emissionstypes.TopicWorkersKey, topicId: 1},
+		{prefix: emissionstypes.TopicWorkersKey, topicId: 3},
+		{prefix: emissionstypes.TopicReputersKey, topicId: 2},
+	} {
+		key, err := collections.EncodeKeyWithPrefix(registration.prefix.Bytes(), keyCodec, collections.Join(registration.topicId, actor))
+		s.Require().NoError(err)
+		store.Set(key, []byte{})
+	}
+
+	topicIds, err := s.emissionsKeeper.GetActorTopicIds(s.ctx, actor, false)
+	s.Require().NoError(err)
+	s.Require().Empty(topicIds)
+
+	// Run migration
+	err = v6.MigrateTopicActorIndexes(s.ctx, *s.emissionsKeeper)
+	s.Require().NoError(err)
+
+	topicIds, err = s.emissionsKeeper.GetActorTopicIds(s.ctx, actor, false)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 3}, topicIds)
+	topicIds, err = s.emissionsKeeper.GetActorTopicIds(s.ctx, actor, true)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2}, topicIds)
+}
+
+func (s *EmissionsV6MigrationTestSuite) TestMigrateDelegatorDelegationIndex() {
+	storageService := s.emissionsKeeper.GetStorageService()
+	store := runtime.KVStoreAdapter(storageService.OpenKVStore(s.ctx))
+
+	// Delegations written as by version 5, without going through the keeper
+	delegator := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+	reputer := "allo1wmvlvr82nlnu2y6hewgjwex30spyqgzvjhc80h"
+	keyCodec := collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)
+	for _, topicId := range []uint64{2, 1} {
+		key, err := collections.EncodeKeyWithPrefix(emissionstypes.DelegateStakePlacementKey.Bytes(), keyCodec, collections.Join3(topicId, delegator, reputer))
+		s.Require().NoError(err)
+		value, err := s.emissionsKeeper.GetBinaryCodec().Marshal(&emissionstypes.DelegatorInfo{
+			Amount:     alloraMath.NewDecFromInt64(100),
+			RewardDebt: alloraMath.ZeroDec(),
+		})
+		s.Require().NoError(err)
+		store.Set(key, value)
+	}
+
+	delegations, err := s.emissionsKeeper.GetDelegatorDelegations(s.ctx, delegator)
+	s.Require().NoError(err)
+	s.Require().Empty(delegations)
+
+	// Run migration
+	err = v6.MigrateDelegatorDelegationIndex(s.ctx, *s.emissionsKeeper)
+	s.Require().NoError(err)
+
+	delegations, err = s.emissionsKeeper.GetDelegatorDelegations(s.ctx, delegator)
+	s.Require().NoError(err)
+	s.Require().Equal([]collections.Pair[uint64, string]{
+		collections.Join(uint64(1), reputer),
+		collections.Join(uint64(2), reputer),
+	}, delegations)
+}
+
+func (s *EmissionsV6MigrationTestSuite) TestMigrateDelegateStakeRemovalIndex() {
+	storageService := s.emissionsKeeper.GetStorageService()
+	store := runtime.KVStoreAdapter(storageService.OpenKVStore(s.ctx))
+
+	// Removal written as by version 5, without going through the keeper
+	delegator := "allo1snm6pxg7p9jetmkhz0jz9ku3vdzmszegy9q5lh"
+	reputer := "allo1wmvlvr82nlnu2y6hewgjwex30spyqgzvjhc80h"
+	keyCodec := keeper.QuadrupleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey, collections.StringKey)
+	key, err := collections.EncodeKeyWithPrefix(emissionstypes.DelegateStakeRemovalsByBlockKey.Bytes(), keyCodec, keeper.Join4(int64(20), uint64(1), delegator, reputer))
+	s.Require().NoError(err)
+	value, err := s.emissionsKeeper.GetBinaryCodec().Marshal(&emissionstypes.DelegateStakeRemovalInfo{
+		BlockRemovalStarted:   10,
+		TopicId:               1,
+		Delegator:             delegator,
+		Reputer:               reputer,
+		Amount:                cosmosMath.NewInt(100),
+		BlockRemovalCompleted: 20,
+	})
+	s.Require().NoError(err)
+	store.Set(key, value)
+
+	hasRemoval, err := s.emissionsKeeper.HasDelegateStakeRemovalUponReputer(s.ctx, 1, reputer)
+	s.Require().NoError(err)
+	s.Require().False(hasRemoval)
+
+	// Run migration
+	err = v6.MigrateDelegateStakeRemovalIndex(s.ctx, *s.emissionsKeeper)
+	s.Require().NoError(err)
+
+	hasRemoval, err = s.emissionsKeeper.HasDelegateStakeRemovalUponReputer(s.ctx, 1, reputer)
+	s.Require().NoError(err)
+	s.Require().True(hasRemoval)
+	hasRemoval, err = s.emissionsKeeper.HasDelegateStakeRemovalUponReputer(s.ctx, 2, reputer)
+	s.Require().NoError(err)
+	s.Require().False(hasRemoval)
+}
@@ -35,6 +35,31 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 	if err != nil {
 		sdkCtx.Logger().Error("Error removing delegate stakes: ", err)
 	}
+	err = RemoveWorkerBonds(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error removing worker bonds: ", err)
+	}
+	// Redelegated stake that has matured can be redelegated again
+	err = am.keeper.DeleteMaturedRedelegations(sdkCtx, blockHeight, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error deleting matured redelegations: ", err)
+	}
+	// Return the stake of archived topics and prune their state, once their queued stake removals are done
+	err = ProcessArchivedTopics(
+		sdkCtx,
+		blockHeight,
+		am.keeper,
+		moduleParams.HalfMaxProcessStakeRemovalsEndBlock,
+		moduleParams.MaxPruneArchivedTopicKeysEndBlock,
+	)
+	if err != nil {
+		sdkCtx.Logger().Error("Error processing archived topics: ", err)
+	}
+	// Add the due drips of funding subscriptions to the fee revenue of their topics
+	err = ProcessFundingSubscriptions(sdkCtx, blockHeight, am.keeper, moduleParams.MaxFundingSubscriptionDripsEndBlock)
+	if err != nil {
+		sdkCtx.Logger().Error("Error processing funding subscriptions: ", err)
+	}
 
 	// Get unnormalized weights of active topics and the sum weight and revenue they have generated
 	weights, sumWeight, totalRevenue, err := rewards.GetAndUpdateActiveTopicWeights(sdkCtx, am.keeper, blockHeight)
@@ -89,8 +114,9 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 					continue
 				}
 				for _, nonce := range nonces.Nonces {
-					// Skip rest of logic if the worker submission window is still open (i.e. don't close the window yet)
-					if am.keeper.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) {
+					// Skip rest of logic if the worker submission or reveal window is still open (i.e. don't close the window yet)
+					if am.keeper.BlockWithinWorkerSubmissionWindowOfNonce(topic, *nonce, blockHeight) ||
+						am.keeper.BlockWithinWorkerRevealWindowOfNonce(topic, *nonce, blockHeight) {
 						sdkCtx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker %d: Worker window still open for topic: %d, nonce: %v", blockHeight, topicId, nonce))
 						continue
 					}
@@ -107,6 +133,11 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 						}
 					}
 				}
+				// Jail or deregister the actors of the topic who stopped submitting, once per closed worker window
+				err = SweepInactiveActors(sdkCtx, am.keeper, topicId, moduleParams)
+				if err != nil {
+					sdkCtx.Logger().Warn(fmt.Sprintf("Error sweeping inactive actors: %s", err.Error()))
+				}
 			}
 		}
 		err = am.keeper.DeleteWorkerWindowBlockHeight(sdkCtx, blockHeight)
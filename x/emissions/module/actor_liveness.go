@@ -0,0 +1,29 @@
+package module
+
+import (
+	"cosmossdk.io/errors"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Jail or deregister the workers and reputers of a topic who missed too many epochs in a row.
+// The sweep is rolled back as a whole if it fails, and retried at the next closed worker window of the topic.
+func SweepInactiveActors(
+	sdkCtx sdk.Context,
+	k emissionskeeper.Keeper,
+	topicId emissionstypes.TopicId,
+	moduleParams emissionstypes.Params,
+) error {
+	topic, err := k.GetTopic(sdkCtx, topicId)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get topic %d", topicId)
+	}
+	// attempt writes in a cache context, only write finally if there are no errors
+	cacheSdkCtx, write := sdkCtx.CacheContext()
+	if err := k.SweepInactiveActorsOfTopic(cacheSdkCtx, topic, moduleParams); err != nil {
+		return errors.Wrapf(err, "Unable to sweep inactive actors of topic %d", topicId)
+	}
+	write()
+	return nil
+}
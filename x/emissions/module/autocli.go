@@ -29,6 +29,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetTopics",
+					Use:       "topics",
+					Short:     "List topics that are not archived, optionally filtered by tag, category, creator, loss method and active status",
+				},
 				{
 					RpcMethod: "TopicExists",
 					Use:       "topic-exists [topic_id]",
@@ -549,6 +554,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "GetActorLiveness",
+					Use:       "actor-liveness [topic_id] [actor] [is_reputer]",
+					Short:     "Get the liveness of a reputer or worker in a topic, whether it is jailed and how many epochs in a row it missed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "actor"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetActorProfile",
+					Use:       "actor-profile [actor]",
+					Short:     "Get the scores, inclusions, stake, rewards and activity of an actor in every topic it is registered in as a worker or reputer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "actor"},
+					},
+				},
 				{
 					RpcMethod: "GetTotalStake",
 					Use:       "total-stake",
@@ -597,6 +620,56 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "IsTopicWorkerWhitelistEnabled",
+					Use:       "is-topic-worker-whitelist-enabled [topic_id]",
+					Short:     "Check if the worker whitelist of a topic is enforced. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "IsWhitelistedTopicWorker",
+					Use:       "is-whitelisted-topic-worker [topic_id] [address]",
+					Short:     "Check if an address is in the worker whitelist of a topic. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicWorkerWhitelist",
+					Use:       "topic-worker-whitelist [topic_id]",
+					Short:     "Get the addresses in the worker whitelist of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "IsTopicReputerWhitelistEnabled",
+					Use:       "is-topic-reputer-whitelist-enabled [topic_id]",
+					Short:     "Check if the reputer whitelist of a topic is enforced. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "IsWhitelistedTopicReputer",
+					Use:       "is-whitelisted-topic-reputer [topic_id] [address]",
+					Short:     "Check if an address is in the reputer whitelist of a topic. True if so, else false",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicReputerWhitelist",
+					Use:       "topic-reputer-whitelist [topic_id]",
+					Short:     "Get the addresses in the reputer whitelist of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "GetStakeRemovalsUpUntilBlock",
 					Use:       "stake-removals-up-until-block [block_height]",
@@ -622,6 +695,75 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetWorkerBond",
+					Use:       "worker-bond [topic_id] [worker]",
+					Short:     "Get the bond held by a worker in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker"},
+					},
+				},
+				{
+					RpcMethod: "GetWorkerBondRemovalInfo",
+					Use:       "worker-bond-removal-info [topic_id] [worker]",
+					Short:     "Get a pending worker bond removal for a worker in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerSlashHistory",
+					Use:       "reputer-slash-history [topic_id] [reputer]",
+					Short:     "Get the slashes of the stake upon a reputer in a topic for being a persistent outlier, and its current outlier streak",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerCommission",
+					Use:       "reputer-commission [topic_id] [reputer]",
+					Short:     "Get the commission a reputer charges on the rewards of its delegators in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetDelegatorPortfolio",
+					Use:       "delegator-portfolio [delegator]",
+					Short:     "Get the delegations of a delegator with their pending rewards and removals, and their totals",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
+				{
+					RpcMethod: "GetDelegateStakeReceiptPool",
+					Use:       "delegate-stake-receipt-pool [pool_id]",
+					Short:     "Get a delegate stake receipt pool along with the stake and pending reward backing its receipts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerDelegateStakeRatio",
+					Use:       "reputer-delegate-stake-ratio [topic_id] [reputer]",
+					Short:     "Get the own and delegated stake of a reputer in a topic, their ratio, the delegation limits and the resulting effective stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+					},
+				},
+				{
+					RpcMethod: "SimulateRewards",
+					Use:       "simulate-rewards [topic_id]",
+					Short:     "Project the rewards, scores and entropies of the participants of a topic at its current reward nonce, without paying them out",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "GetDelegateStakeRemovalInfo",
 					Use:       "delegate-stake-removal-info [delegator] [reputer] [topic_id]",
@@ -688,6 +830,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetFundingSubscription",
+					Use:       "funding-subscription [subscription_id]",
+					Short:     "Get an active topic funding subscription by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subscription_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicFundingSubscriptions",
+					Use:       "topic-funding-subscriptions [topic_id]",
+					Short:     "Get the active funding subscriptions of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetFunderFundingSubscriptions",
+					Use:       "funder-funding-subscriptions [funder]",
+					Short:     "Get the active topic funding subscriptions created by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "funder"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicCreatorRewards",
+					Use:       "topic-creator-rewards [topic_id]",
+					Short:     "Get the commission of the creator of a topic and the rewards paid to them so far",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
 				// add inclusion methods
 				{
 					RpcMethod: "GetCountInfererInclusionsInTopic",
@@ -745,6 +919,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "active_reputer_quantile"},
 					},
 				},
+				{
+					RpcMethod: "UpdateTopic",
+					Use:       "update-topic [sender] [topic_id]",
+					Short:     "Update parameters of a topic, applied at the next epoch boundary of the topic. Only the topic creator or a whitelist admin can update a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "ArchiveTopic",
+					Use:       "archive-topic [sender] [topic_id]",
+					Short:     "Archive a topic: stop new submissions, return its stake and prune its state. Only the topic creator or a whitelist admin can archive a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "Register",
 					Use:       "register [sender] [topic_ids] [owner] [is_reputer]",
@@ -766,6 +958,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "is_reputer"},
 					},
 				},
+				{
+					RpcMethod: "UpdateNodeInfo",
+					Use:       "update-node-info [sender] [topic_id] [is_reputer] [node_address]",
+					Short:     "Move a reputer or worker of a topic to a new node address (--new-node-address) and/or hand it over to a new owner (--new-owner), keeping its scores and stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "node_address"},
+					},
+				},
+				{
+					RpcMethod: "Unjail",
+					Use:       "unjail [sender] [topic_id] [is_reputer]",
+					Short:     "Register a reputer or worker jailed from a topic for missing too many epochs again",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+					},
+				},
 				{
 					RpcMethod: "AddStake",
 					Use:       "add-stake [sender] [topic_id] [amount]",
@@ -795,6 +1008,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "AddWorkerBond",
+					Use:       "add-worker-bond [sender] [topic_id] [amount]",
+					Short:     "Add [amount] to the bond of sender [worker] in a topic [topic_id]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "RemoveWorkerBond",
+					Use:       "remove-worker-bond [sender] [topic_id] [amount]",
+					Short:     "Remove [amount] from the bond of sender [worker] in a topic [topic_id] after the removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "CancelRemoveWorkerBond",
+					Use:       "cancel-remove-worker-bond [sender] [topic_id]",
+					Short:     "Cancel the removal of the bond of a worker in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "SlashWorkerBond",
+					Use:       "slash-worker-bond [sender] [topic_id] [worker] [amount] [reason]",
+					Short:     "Slash [amount] of the bond of a worker in a topic for proven misbehavior. Only whitelist admins can slash",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker"},
+						{ProtoField: "amount"},
+						{ProtoField: "reason"},
+					},
+				},
 				{
 					RpcMethod: "DelegateStake",
 					Use:       "delegate-stake [sender] [topic_id] [reputer] [amount]",
@@ -837,6 +1091,78 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "CreateReputerCommission",
+					Use:       "create-reputer-commission [sender] [topic_id] [rate] [max_rate] [max_change_rate]",
+					Short:     "Set the commission a reputer charges on the rewards of its delegators in a topic. The max rate and max change rate cannot be changed afterwards",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "rate"},
+						{ProtoField: "max_rate"},
+						{ProtoField: "max_change_rate"},
+					},
+				},
+				{
+					RpcMethod: "EditReputerCommission",
+					Use:       "edit-reputer-commission [sender] [topic_id] [rate]",
+					Short:     "Change the commission rate of a reputer in a topic, by at most its max change rate and at most once per reputer_commission_update_cooldown blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "rate"},
+					},
+				},
+				{
+					RpcMethod: "RedelegateStake",
+					Use:       "redelegate-stake [sender] [topic_id] [src_reputer] [dst_reputer] [amount]",
+					Short:     "Move stake delegated upon a reputer to another reputer of the topic at once. The stake cannot be redelegated again until it matures",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "src_reputer"},
+						{ProtoField: "dst_reputer"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "SetDelegateRewardAutoCompound",
+					Use:       "set-delegate-reward-auto-compound [sender] [enabled]",
+					Short:     "Set whether the pending rewards of a delegator are restaked into its delegations at each payout",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "ClaimAllDelegateRewards",
+					Use:       "claim-all-delegate-rewards [sender]",
+					Short:     "Claim the pending rewards of every delegation of a delegator across all topics",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeDelegateStake",
+					Use:       "tokenize-delegate-stake [sender] [topic_id] [reputer] [amount]",
+					Short:     "Tokenize stake delegated upon a reputer in a topic into transferable receipts of the delegate stake receipt pool of the reputer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "RedeemDelegateStakeReceipt",
+					Use:       "redeem-delegate-stake-receipt [sender] [pool_id] [shares]",
+					Short:     "Redeem receipts of a delegate stake receipt pool for their share of the stake and reserve of the pool",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "pool_id"},
+						{ProtoField: "shares"},
+					},
+				},
 				{
 					RpcMethod: "FundTopic",
 					Use:       "fund-topic [sender] [topic_id] [amount] [extra_data]",
@@ -847,6 +1173,37 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount"},
 					},
 				},
+				{
+					RpcMethod: "CreateFundingSubscription",
+					Use:       "create-funding-subscription [sender] [topic_id] [amount_per_drip] [interval_blocks] [escrow_amount]",
+					Short:     "Escrow funds to add amount_per_drip to the fee revenue of a topic every interval_blocks blocks, or every epoch of the topic if 0, until the escrow runs out",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount_per_drip"},
+						{ProtoField: "interval_blocks"},
+						{ProtoField: "escrow_amount"},
+					},
+				},
+				{
+					RpcMethod: "TopUpFundingSubscription",
+					Use:       "top-up-funding-subscription [sender] [subscription_id] [amount]",
+					Short:     "Add funds to the escrow of a topic funding subscription. Only the funder of the subscription can top it up",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "subscription_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "CancelFundingSubscription",
+					Use:       "cancel-funding-subscription [sender] [subscription_id]",
+					Short:     "Cancel a topic funding subscription and refund its unspent escrow. Only the funder of the subscription can cancel it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "subscription_id"},
+					},
+				},
 				{
 					RpcMethod: "AddToWhitelistAdmin",
 					Use:       "add-to-whitelist-admin [sender] [address]",
@@ -865,6 +1222,82 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "EnableTopicWorkerWhitelist",
+					Use:       "enable-topic-worker-whitelist [sender] [topic_id]",
+					Short:     "Enforce the worker whitelist of a topic, only whitelisted workers can then register and submit payloads. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "DisableTopicWorkerWhitelist",
+					Use:       "disable-topic-worker-whitelist [sender] [topic_id]",
+					Short:     "Stop enforcing the worker whitelist of a topic. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "AddToTopicWorkerWhitelist",
+					Use:       "add-to-topic-worker-whitelist [sender] [topic_id] [address]",
+					Short:     "Add an address to the worker whitelist of a topic. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "RemoveFromTopicWorkerWhitelist",
+					Use:       "remove-from-topic-worker-whitelist [sender] [topic_id] [address]",
+					Short:     "Remove an address from the worker whitelist of a topic. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "EnableTopicReputerWhitelist",
+					Use:       "enable-topic-reputer-whitelist [sender] [topic_id]",
+					Short:     "Enforce the reputer whitelist of a topic, only whitelisted reputers can then register and submit payloads. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "DisableTopicReputerWhitelist",
+					Use:       "disable-topic-reputer-whitelist [sender] [topic_id]",
+					Short:     "Stop enforcing the reputer whitelist of a topic. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "AddToTopicReputerWhitelist",
+					Use:       "add-to-topic-reputer-whitelist [sender] [topic_id] [address]",
+					Short:     "Add an address to the reputer whitelist of a topic. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "RemoveFromTopicReputerWhitelist",
+					Use:       "remove-from-topic-reputer-whitelist [sender] [topic_id] [address]",
+					Short:     "Remove an address from the reputer whitelist of a topic. Topic creator only",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+					},
+				},
 				{
 					RpcMethod: "InsertWorkerPayload",
 					Use:       "insert-worker-payload [sender] [worker_data]",
@@ -874,6 +1307,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker_data_bundle"},
 					},
 				},
+				{
+					RpcMethod: "InsertWorkerPayloads",
+					Use:       "insert-worker-payloads [sender] [worker_data...]",
+					Short:     "Insert worker payloads of several topics at once, each accepted or refused on its own",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "worker_data_bundles", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "CommitWorkerPayload",
+					Use:       "commit-worker-payload [sender] [topic_id] [nonce_block_height] [commitment]",
+					Short:     "Commit to a worker payload of a commit-reveal topic, the commitment being the sha256 of the serialized inference forecast bundle followed by a salt",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "nonce_block_height"},
+						{ProtoField: "commitment"},
+					},
+				},
+				{
+					RpcMethod: "RevealWorkerPayload",
+					Use:       "reveal-worker-payload [sender] [worker_data] [salt]",
+					Short:     "Reveal a committed worker payload of a commit-reveal topic in the reveal window of the nonce",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "worker_data_bundle"},
+						{ProtoField: "salt"},
+					},
+				},
 				{
 					RpcMethod: "InsertReputerPayload",
 					Use:       "insert-reputer-payload [sender] [reputer_data]",
@@ -883,6 +1346,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer_value_bundle"},
 					},
 				},
+				{
+					RpcMethod: "CommitReputerPayload",
+					Use:       "commit-reputer-payload [sender] [topic_id] [nonce_block_height] [commitment]",
+					Short:     "Commit to a reputer payload of a commit-reveal topic, the commitment being the sha256 of the serialized value bundle followed by a salt",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "nonce_block_height"},
+						{ProtoField: "commitment"},
+					},
+				},
+				{
+					RpcMethod: "RevealReputerPayload",
+					Use:       "reveal-reputer-payload [sender] [reputer_data] [salt]",
+					Short:     "Reveal a committed reputer payload of a commit-reveal topic in the reveal window of the nonce",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "reputer_value_bundle"},
+						{ProtoField: "salt"},
+					},
+				},
 			},
 			SubCommands:          nil,
 			EnhanceCustomCommand: false,
@@ -0,0 +1,34 @@
+package module
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Drip the topic funding subscriptions that are due. At most maxDrips subscriptions are processed per block,
+// the others stay due and are processed in the next blocks. A drip that fails is rolled back and retried
+// in the next block.
+func ProcessFundingSubscriptions(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	maxDrips uint64,
+) error {
+	subscriptionIds, err := k.GetDueFundingSubscriptionIds(sdkCtx, currentBlock, maxDrips)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get due funding subscriptions at block %d", currentBlock)
+	}
+	for _, subscriptionId := range subscriptionIds {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+		if err := k.DripFundingSubscription(cacheSdkCtx, subscriptionId); err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error dripping funding subscription %d: %v", subscriptionId, err))
+			continue
+		}
+		write()
+	}
+	return nil
+}
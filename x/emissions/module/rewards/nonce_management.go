@@ -20,10 +20,24 @@ func UpdateReputerNonce(ctx sdk.Context, k keeper.Keeper, topic types.Topic, blo
 		// Check if current blockheight has reached the blockheight of the nonce + groundTruthLag + epochLength
 		// This means one epochLength is allowed for reputation responses to be sent since ground truth is revealed.
 		closingReputerNonceMinBlockHeight := nonce.ReputerNonce.BlockHeight + topic.GroundTruthLag + topic.EpochLength
+		// Commit-reveal topics wait for the reveal window following the reputer submission window to be over
+		if topic.IsReputerCommitReveal() {
+			revealEnd := nonce.ReputerNonce.BlockHeight + topic.GroundTruthLag*2 + 1 + topic.ReputerRevealWindow
+			closingReputerNonceMinBlockHeight = max(closingReputerNonceMinBlockHeight, revealEnd)
+		}
 		if block >= closingReputerNonceMinBlockHeight {
 			ctx.Logger().Debug(fmt.Sprintf("ABCI EndBlocker: Closing reputer nonce for topic: %v nonce: %v, min: %d. \n",
 				topic.Id, nonce, closingReputerNonceMinBlockHeight))
-			err = allorautils.CloseReputerNonce(&k, ctx, topic, *nonce.ReputerNonce)
+			// Reputers who committed to a loss bundle without revealing it take no part in the nonce,
+			// their commitments are simply dropped
+			if err := k.ResetReputerCommitmentsForNonce(ctx, topic.Id, nonce.ReputerNonce.BlockHeight); err != nil {
+				ctx.Logger().Warn(fmt.Sprintf("Error resetting reputer commitments: %s", err.Error()))
+			}
+			if topic.IsDerived() {
+				err = allorautils.CloseDerivedReputerNonce(&k, ctx, topic, *nonce.ReputerNonce)
+			} else {
+				err = allorautils.CloseReputerNonce(&k, ctx, topic, *nonce.ReputerNonce)
+			}
 			if err != nil {
 				ctx.Logger().Warn(fmt.Sprintf("Error closing reputer nonce: %s", err.Error()))
 				// Proactively close the nonce to avoid
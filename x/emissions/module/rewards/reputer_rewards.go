@@ -149,7 +149,7 @@ func GetRewardForReputerTaskInTopic(
 	return ret, nil
 }
 
-// Send total reward for delegator to PENDING_ACCOUNT
+// Send total reward for delegator, less the commission of the reputer, to PENDING_ACCOUNT
 // and return remain reward for reputer
 func GetRewardForReputerFromTotalReward(
 	ctx sdk.Context,
@@ -191,6 +191,20 @@ func GetRewardForReputerFromTotalReward(
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to sdk int trim delegator reward")
 		}
+		// the reputer keeps its commission out of the reward of its delegators
+		commissionRate, err := keeper.GetReputerCommissionRate(ctx, topicId, reputer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get reputer commission rate")
+		}
+		commissionDec, err := delegatorRewardDec.Mul(commissionRate)
+		if err != nil {
+			return nil, err
+		}
+		commissionInt, err := commissionDec.SdkIntTrim()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to sdk int trim reputer commission")
+		}
+		delegatorRewardInt = delegatorRewardInt.Sub(commissionInt)
 		delegatorRewardDec, err = alloraMath.NewDecFromSdkInt(delegatorRewardInt)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to reconvert delegator reward from int to dec")
@@ -224,7 +238,7 @@ func GetRewardForReputerFromTotalReward(
 				return nil, errors.Wrapf(err, "failed to send coins to allora pend reward account")
 			}
 		}
-		// Send remain rewards to reputer
+		// Send remain rewards, including the commission, to reputer
 		// delegatorRewardDec has already been trimmed.
 		// Any decimals are left in reputerRw to be trimmed elsewhere
 		reputerRw, err := reward.Sub(delegatorRewardDec)
@@ -631,6 +631,8 @@ func mockReputersData(s *RewardsTestSuite, topicId uint64, block int64, reputerI
 			OneOutForecasterValues:        nil,
 			OneInForecasterValues:         nil,
 			OneOutInfererForecasterValues: nil,
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 		}
 		signature := s.signValueBundle(valueBundle, s.privKeys[reputerIndex])
 		reputerValueBundle := &types.ReputerValueBundle{
@@ -666,6 +668,16 @@ func CreateTopic(ctx context.Context, msgServer types.MsgServiceServer, creator
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
 	if err != nil {
@@ -0,0 +1,163 @@
+package rewards
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Projects the rewards of the participants of a topic at its current reward nonce, without paying them out.
+// Runs the reward pipeline of EmitRewards on a branch of the state that is discarded afterwards,
+// with the reward the topic would get if rewards were emitted at the current block.
+func SimulateTopicRewards(ctx sdk.Context, k keeper.Keeper, topicId TopicId) (*types.SimulateRewardsResponse, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get module params")
+	}
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get topic %d", topicId)
+	}
+	topicRewardNonce, err := k.GetTopicRewardNonce(ctx, topicId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get reward nonce of topic %d", topicId)
+	}
+	if topicRewardNonce == 0 {
+		return nil, errors.Wrapf(types.ErrInvalidReward, "topic %d has no reward nonce", topicId)
+	}
+
+	// Scores, score ratios and delegator reward pools are written while rewards are generated
+	cacheCtx, _ := ctx.CacheContext()
+
+	topicReward, err := simulateTopicReward(cacheCtx, k, topic)
+	if err != nil {
+		return nil, err
+	}
+	creatorReward, rewardToTopicActors, err := splitTopicCreatorReward(topic, moduleParams, topicReward)
+	if err != nil {
+		return nil, err
+	}
+	distribution, err := generateTopicRewardsDistribution(GenerateRewardsDistributionByTopicParticipantArgs{
+		Ctx:          cacheCtx,
+		K:            k,
+		TopicId:      topicId,
+		TopicReward:  &rewardToTopicActors,
+		BlockHeight:  topicRewardNonce,
+		ModuleParams: moduleParams,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate rewards distribution for topic %d", topicId)
+	}
+
+	scores := map[types.TaskRewardType]map[string]alloraMath.Dec{
+		types.ReputerAndDelegatorRewardType: scoresByAddress(distribution.reputerScores),
+		types.WorkerInferenceRewardType:     scoresByAddress(distribution.infererScores),
+		types.WorkerForecastRewardType:      scoresByAddress(distribution.forecasterScores),
+	}
+	rewardFractions := map[types.TaskRewardType]map[string]alloraMath.Dec{
+		types.ReputerAndDelegatorRewardType: fractionsByAddress(distribution.reputers, distribution.reputersRewardFractions),
+		types.WorkerInferenceRewardType:     fractionsByAddress(distribution.inferers, distribution.inferersRewardFractions),
+		types.WorkerForecastRewardType:      fractionsByAddress(distribution.forecasters, distribution.forecastersRewardFractions),
+	}
+	simulatedRewards := make([]*types.SimulatedTaskReward, 0, len(distribution.rewards)+1)
+	for _, reward := range distribution.rewards {
+		score, ok := scores[reward.Type][reward.Address]
+		if !ok {
+			score = alloraMath.ZeroDec()
+		}
+		rewardFraction, ok := rewardFractions[reward.Type][reward.Address]
+		if !ok {
+			rewardFraction = alloraMath.ZeroDec()
+		}
+		simulatedRewards = append(simulatedRewards, &types.SimulatedTaskReward{
+			Address:        reward.Address,
+			RewardType:     string(reward.Type),
+			Reward:         reward.Reward,
+			Score:          score,
+			RewardFraction: rewardFraction,
+		})
+	}
+	simulatedRewards = append(simulatedRewards, &types.SimulatedTaskReward{
+		Address:        topic.Creator,
+		RewardType:     string(types.TopicCreatorRewardType),
+		Reward:         creatorReward,
+		Score:          alloraMath.ZeroDec(),
+		RewardFraction: alloraMath.ZeroDec(),
+	})
+
+	return &types.SimulateRewardsResponse{
+		RewardNonce:           topicRewardNonce,
+		TopicReward:           topicReward,
+		InferenceEntropy:      distribution.inferenceEntropy,
+		ForecastingEntropy:    distribution.forecastingEntropy,
+		ReputerEntropy:        distribution.reputerEntropy,
+		InferenceTaskReward:   distribution.inferenceTaskReward,
+		ForecastingTaskReward: distribution.forecastingTaskReward,
+		ReputerTaskReward:     distribution.reputerTaskReward,
+		Rewards:               simulatedRewards,
+	}, nil
+}
+
+// Reward of the topic for its epoch as computed by EmitRewards at the current block,
+// from its current weight against the total sum of previous topic weights and capped by the rewards treasury
+func simulateTopicReward(ctx sdk.Context, k keeper.Keeper, topic types.Topic) (alloraMath.Dec, error) {
+	totalRewardTreasury, err := k.GetTotalRewardToDistribute(ctx)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to get max rewards to distribute")
+	}
+	totalSumPreviousTopicWeights, err := k.GetTotalSumPreviousTopicWeights(ctx)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to get total sum of previous topic weights")
+	}
+	if totalRewardTreasury.IsZero() || totalSumPreviousTopicWeights.IsZero() {
+		return alloraMath.ZeroDec(), nil
+	}
+	weight, err := k.GetTopicWeightFromTopicId(ctx, topic.Id)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to get weight of topic %d", topic.Id)
+	}
+	currentBlockEmission, err := k.GetRewardCurrentBlockEmission(ctx)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to get current block emission")
+	}
+	currentBlockEmissionDec, err := alloraMath.NewDecFromSdkInt(currentBlockEmission)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to convert current block emission to decimal")
+	}
+
+	topicRewards, err := CalcTopicRewards(CalcTopicRewardsArgs{
+		Ctx:                             ctx,
+		Weights:                         map[uint64]*alloraMath.Dec{topic.Id: &weight},
+		SortedTopics:                    []uint64{topic.Id},
+		SumTopicWeights:                 totalSumPreviousTopicWeights,
+		TotalAvailableInRewardsTreasury: totalRewardTreasury,
+		EpochLengths:                    map[uint64]int64{topic.Id: topic.EpochLength},
+		CurrentRewardsEmissionPerBlock:  currentBlockEmissionDec,
+	})
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to calculate reward of topic %d", topic.Id)
+	}
+	topicReward, ok := topicRewards[topic.Id]
+	if !ok || topicReward == nil {
+		return alloraMath.ZeroDec(), nil
+	}
+	return *topicReward, nil
+}
+
+func scoresByAddress(scores []types.Score) map[string]alloraMath.Dec {
+	byAddress := make(map[string]alloraMath.Dec, len(scores))
+	for _, score := range scores {
+		byAddress[score.Address] = score.Score
+	}
+	return byAddress
+}
+
+func fractionsByAddress(addresses []string, fractions []alloraMath.Dec) map[string]alloraMath.Dec {
+	byAddress := make(map[string]alloraMath.Dec, len(addresses))
+	for i, address := range addresses {
+		byAddress[address] = fractions[i]
+	}
+	return byAddress
+}
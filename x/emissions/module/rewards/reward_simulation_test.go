@@ -0,0 +1,184 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
+	actorutils "github.com/allora-network/allora-chain/x/emissions/keeper/actor_utils"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *RewardsTestSuite) TestSimulateTopicRewardsProjectsRewardsWithoutPayingOut() {
+	block := int64(600)
+	s.ctx = s.ctx.WithBlockHeight(block)
+	epochLength := int64(10800)
+	s.MintTokensToModule(types.AlloraStakingAccountName, cosmosMath.NewInt(10000000000))
+
+	reputerIndexes := s.returnIndexes(0, 5)
+	workerIndexes := s.returnIndexes(5, 5)
+	creator := 10
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  s.addrsStr[creator],
+		Metadata:                 "test",
+		LossMethod:               "mse",
+		EpochLength:              epochLength,
+		AllowNegative:            false,
+		GroundTruthLag:           epochLength,
+		WorkerSubmissionWindow:   10,
+		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.MustNewDecFromString("0.1"),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+	}
+	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
+	s.Require().NoError(err)
+	topicId := res.TopicId
+
+	for _, index := range workerIndexes {
+		_, err := s.msgServer.Register(s.ctx, &types.RegisterRequest{
+			Sender:    s.addrsStr[index],
+			TopicId:   topicId,
+			IsReputer: false,
+			Owner:     s.addrsStr[index],
+		})
+		s.Require().NoError(err)
+	}
+	cosmosOneE18 := inferencesynthesis.CosmosIntOneE18()
+	for _, index := range reputerIndexes {
+		_, err := s.msgServer.Register(s.ctx, &types.RegisterRequest{
+			Sender:    s.addrsStr[index],
+			TopicId:   topicId,
+			IsReputer: true,
+			Owner:     s.addrsStr[index],
+		})
+		s.Require().NoError(err)
+		stake := cosmosMath.NewInt(1000000).Mul(cosmosOneE18)
+		s.MintTokensToAddress(s.addrs[index], stake)
+		_, err = s.msgServer.AddStake(s.ctx, &types.AddStakeRequest{
+			Sender:  s.addrsStr[index],
+			Amount:  stake,
+			TopicId: topicId,
+		})
+		s.Require().NoError(err)
+	}
+
+	s.MintTokensToAddress(s.addrs[reputerIndexes[0]], cosmosMath.NewInt(1000))
+	_, err = s.msgServer.FundTopic(s.ctx, &types.FundTopicRequest{
+		Sender:  s.addrsStr[reputerIndexes[0]],
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	s.Require().NoError(err)
+
+	err = s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block})
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.AddReputerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block})
+	s.Require().NoError(err)
+
+	inferenceBundles := generateWorkerDataBundles(s, block, topicId)
+	for _, payload := range inferenceBundles {
+		_, err = s.msgServer.InsertWorkerPayload(s.ctx, &types.InsertWorkerPayloadRequest{
+			Sender:           payload.Worker,
+			WorkerDataBundle: payload,
+		})
+		s.Require().NoError(err)
+	}
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	err = actorutils.CloseWorkerNonce(&s.emissionsKeeper, s.ctx, topic, *inferenceBundles[0].Nonce)
+	s.Require().NoError(err)
+
+	newBlockheight := block + topic.GroundTruthLag
+	s.ctx = s.ctx.WithBlockHeight(newBlockheight)
+	err = s.emissionsKeeper.SetRewardCurrentBlockEmission(s.ctx, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = s.emissionsAppModule.EndBlock(s.ctx)
+	s.Require().NoError(err)
+
+	lossBundles := generateLossBundles(s, block, topicId, reputerIndexes)
+	for _, payload := range lossBundles.ReputerValueBundles {
+		_, err = s.msgServer.InsertReputerPayload(s.ctx, &types.InsertReputerPayloadRequest{
+			Sender:             payload.ValueBundle.Reputer,
+			ReputerValueBundle: payload,
+		})
+		s.Require().NoError(err)
+	}
+	err = actorutils.CloseReputerNonce(&s.emissionsKeeper, s.ctx, topic, *lossBundles.ReputerValueBundles[0].ValueBundle.ReputerRequestNonce.ReputerNonce)
+	s.Require().NoError(err)
+
+	newBlockheight += epochLength
+	s.ctx = s.ctx.WithBlockHeight(newBlockheight).WithEventManager(sdk.NewEventManager())
+	err = s.emissionsKeeper.SetRewardCurrentBlockEmission(s.ctx, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+
+	worker := s.addrsStr[workerIndexes[0]]
+	scoreBefore, err := s.emissionsKeeper.GetInfererScoreEma(s.ctx, topicId, worker)
+	s.Require().NoError(err)
+	workerBalanceBefore := s.bankKeeper.GetBalance(s.ctx, s.addrs[workerIndexes[0]], params.DefaultBondDenom)
+
+	simulation, err := rewards.SimulateTopicRewards(s.ctx, s.emissionsKeeper, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(block, simulation.RewardNonce)
+	s.Require().True(simulation.TopicReward.IsPositive(), "topic reward was not projected")
+	s.Require().True(simulation.InferenceTaskReward.IsPositive())
+	s.Require().True(simulation.ReputerTaskReward.IsPositive())
+
+	expectedCreatorReward, err := simulation.TopicReward.Mul(alloraMath.MustNewDecFromString("0.1"))
+	s.Require().NoError(err)
+	rewardedByType := make(map[string]int)
+	for _, reward := range simulation.Rewards {
+		rewardedByType[reward.RewardType]++
+		if reward.RewardType == string(types.TopicCreatorRewardType) {
+			s.Require().Equal(s.addrsStr[creator], reward.Address)
+			alloratestutil.InEpsilon5Dec(s.T(), reward.Reward, expectedCreatorReward)
+			continue
+		}
+		s.Require().False(reward.Reward.IsNegative())
+		s.Require().False(reward.RewardFraction.IsNegative())
+	}
+	s.Require().Equal(len(reputerIndexes), rewardedByType[string(types.ReputerAndDelegatorRewardType)])
+	s.Require().Equal(len(workerIndexes), rewardedByType[string(types.WorkerInferenceRewardType)])
+	s.Require().Equal(1, rewardedByType[string(types.TopicCreatorRewardType)])
+
+	// The simulation runs on a branch of the state that is discarded
+	scoreAfter, err := s.emissionsKeeper.GetInfererScoreEma(s.ctx, topicId, worker)
+	s.Require().NoError(err)
+	s.Require().Equal(scoreBefore, scoreAfter)
+	workerBalanceAfter := s.bankKeeper.GetBalance(s.ctx, s.addrs[workerIndexes[0]], params.DefaultBondDenom)
+	s.Require().Equal(workerBalanceBefore, workerBalanceAfter)
+	creatorRewards, err := s.emissionsKeeper.GetTopicCreatorRewards(s.ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().True(creatorRewards.IsZero())
+	s.Require().Empty(s.ctx.EventManager().Events())
+}
+
+func (s *RewardsTestSuite) TestSimulateTopicRewardsFailsWithoutRewardNonce() {
+	topicId := s.setUpTopic(
+		600,
+		s.returnIndexes(5, 3),
+		s.returnIndexes(0, 3),
+		cosmosMath.NewInt(1000000).Mul(inferencesynthesis.CosmosIntOneE18()),
+		alloraMath.MustNewDecFromString("0.1"),
+	)
+
+	_, err := rewards.SimulateTopicRewards(s.ctx, s.emissionsKeeper, topicId)
+	s.Require().ErrorIs(err, types.ErrInvalidReward)
+}
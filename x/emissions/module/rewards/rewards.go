@@ -192,22 +192,46 @@ func EmitRewards(args EmitRewardsArgs) error {
 // It returns the total reward distributed to reputers
 func getDistributionAndPayoutRewardsToTopicActors(args GetDistributionAndPayoutRewardsToTopicActorsArgs) (alloraMath.Dec, error) {
 	Logger(args.Ctx).Debug(fmt.Sprintf("Generating rewards distribution for topic: %d, topicRewardNonce: %d, topicReward: %s", args.TopicId, args.TopicRewardNonce, args.TopicReward))
+	if args.TopicReward == nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(types.ErrInvalidReward, "no reward for topic %d", args.TopicId)
+	}
+
+	// The topic creator commission comes off the top of the topic reward
+	topic, err := args.K.GetTopic(args.Ctx, args.TopicId)
+	if err != nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(err, "failed to get topic %d", args.TopicId)
+	}
+	creatorReward, rewardToTopicActors, err := splitTopicCreatorReward(topic, args.ModuleParams, *args.TopicReward)
+	if err != nil {
+		return alloraMath.ZeroDec(), err
+	}
 
 	// Get the distribution of rewards across actor types and participants in this topic
 	totalRewardsDistribution, rewardInTopicToActors, err := GenerateRewardsDistributionByTopicParticipant(GenerateRewardsDistributionByTopicParticipantArgs{
 		Ctx:          args.Ctx,
 		K:            args.K,
 		TopicId:      args.TopicId,
-		TopicReward:  args.TopicReward,
+		TopicReward:  &rewardToTopicActors,
 		BlockHeight:  args.TopicRewardNonce,
 		ModuleParams: args.ModuleParams,
 	})
 	if err != nil {
 		return alloraMath.ZeroDec(), errors.Wrapf(err, "Failed to Generate Rewards for Topic %d", args.TopicId)
 	}
+	totalRewardsDistribution = append(totalRewardsDistribution, types.TaskReward{
+		Address: topic.Creator,
+		Reward:  creatorReward,
+		TopicId: args.TopicId,
+		Type:    types.TopicCreatorRewardType,
+	})
 
 	// Pay out rewards to topic participants
 	payoutErrors := payoutRewards(args.Ctx, args.K, totalRewardsDistribution)
+	// Restake the rewards of the delegators that opted in to auto-compounding
+	compoundErrors := compoundDelegateRewards(args.Ctx, args.K, args.TopicId, args.ModuleParams.MaxAutoCompoundDelegationsPerPayout)
+	for _, compoundErr := range compoundErrors {
+		Logger(args.Ctx).Warn(fmt.Sprintf("Failed to compound delegator rewards in Topic %d: %s", args.TopicId, compoundErr.Error()))
+	}
 	if len(payoutErrors) > 0 {
 		for _, payoutErr := range payoutErrors {
 			Logger(args.Ctx).Warn(fmt.Sprintf("Failed to pay out rewards to participant in Topic %d: %s", args.TopicId, payoutErr.Error()))
@@ -219,6 +243,27 @@ func getDistributionAndPayoutRewardsToTopicActors(args GetDistributionAndPayoutR
 	return rewardInTopicToActors, nil
 }
 
+// Splits the reward of a topic into the commission of its creator and the reward left to its participants
+func splitTopicCreatorReward(
+	topic types.Topic,
+	moduleParams types.Params,
+	topicReward alloraMath.Dec,
+) (creatorReward alloraMath.Dec, rewardToTopicActors alloraMath.Dec, err error) {
+	creatorCommission, err := topic.EffectiveCreatorCommission(moduleParams.MaxTopicCreatorCommission)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get creator commission of topic %d", topic.Id)
+	}
+	creatorReward, err = GetTopicCreatorReward(creatorCommission, topicReward)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to calculate creator reward of topic %d", topic.Id)
+	}
+	rewardToTopicActors, err = topicReward.Sub(creatorReward)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to calculate reward to actors of topic %d", topic.Id)
+	}
+	return creatorReward, rewardToTopicActors, nil
+}
+
 // Calculates the rewards for each topic.
 //
 // Calculates rewards per-block based on their weights vs all active topics.
@@ -341,62 +386,72 @@ func GenerateRewardsDistributionByTopicParticipant(
 	taskReputerReward alloraMath.Dec,
 	err error,
 ) {
+	distribution, err := generateTopicRewardsDistribution(args)
+	if err != nil {
+		return nil, alloraMath.Dec{}, err
+	}
+	return distribution.rewards, distribution.reputerTaskReward, nil
+}
+
+// Scores, reward fractions, entropies and task rewards behind the distribution of the reward of a topic
+type topicRewardsDistribution struct {
+	rewards                    []types.TaskReward
+	reputerScores              []types.Score
+	infererScores              []types.Score
+	forecasterScores           []types.Score
+	reputers                   []string
+	reputersRewardFractions    []alloraMath.Dec
+	inferers                   []string
+	inferersRewardFractions    []alloraMath.Dec
+	forecasters                []string
+	forecastersRewardFractions []alloraMath.Dec
+	inferenceEntropy           alloraMath.Dec
+	forecastingEntropy         alloraMath.Dec
+	reputerEntropy             alloraMath.Dec
+	inferenceTaskReward        alloraMath.Dec
+	forecastingTaskReward      alloraMath.Dec
+	reputerTaskReward          alloraMath.Dec
+}
+
+func generateTopicRewardsDistribution(
+	args GenerateRewardsDistributionByTopicParticipantArgs,
+) (topicRewardsDistribution, error) {
 	if args.TopicReward == nil {
-		return nil, alloraMath.Dec{}, types.ErrInvalidReward
+		return topicRewardsDistribution{}, types.ErrInvalidReward
 	}
 	args.Ctx.Logger().Debug(fmt.Sprintf("Generating rewards distribution for topic: %d, block: %d, topicReward: %s", args.TopicId, args.BlockHeight, args.TopicReward.String()))
-	bundles, err := args.K.GetReputerLossBundlesAtBlock(args.Ctx, args.TopicId, args.BlockHeight)
+	topic, err := args.K.GetTopic(args.Ctx, args.TopicId)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer loss bundle at block %d", args.BlockHeight)
-	}
-	if bundles != nil && len(bundles.ReputerValueBundles) == 0 {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer loss bundles")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get topic %d", args.TopicId)
 	}
 
 	lossBundles, err := args.K.GetNetworkLossBundleAtBlock(args.Ctx, args.TopicId, args.BlockHeight)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", args.BlockHeight)
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", args.BlockHeight)
 	}
 
-	// Calculate and Set the reputer scores
-	reputerScores, err := GenerateReputerScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *bundles)
-	if err != nil {
-		return nil, alloraMath.Dec{}, err
-	}
-	if len(reputerScores) == 0 {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer scores")
+	// Losses of derived topics are computed by the chain, leaving no reputation task to reward
+	reputerScores := []types.Score{}
+	reputers := []string{}
+	reputersRewardFractions := []alloraMath.Dec{}
+	reputerEntropy := alloraMath.ZeroDec()
+	if !topic.IsDerived() {
+		reputerScores, reputers, reputersRewardFractions, reputerEntropy, err = getReputationTaskRewardFractionsAndEntropy(args)
+		if err != nil {
+			return topicRewardsDistribution{}, err
+		}
 	}
 
 	// Calculate and Set the worker scores for their inference work
 	infererScores, err := GenerateInferenceScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
+		return topicRewardsDistribution{}, err
 	}
 
 	// Calculate and Set the worker scores for their forecast work
 	forecasterScores, err := GenerateForecastScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *lossBundles)
 	if err != nil {
-		return nil, alloraMath.Dec{}, err
-	}
-
-	// Get reputer participants' addresses and reward fractions to be used in the reward round for topic
-	reputers, reputersRewardFractions, err := GetReputersRewardFractions(args.Ctx, args.K, args.TopicId, args.ModuleParams.PRewardReputer, reputerScores)
-	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer reward round data")
-	}
-
-	// Get reputer task entropy
-	reputerEntropy, err := GetReputerTaskEntropy(
-		args.Ctx,
-		args.K,
-		args.TopicId,
-		args.ModuleParams.TaskRewardAlpha,
-		args.ModuleParams.BetaEntropy,
-		reputers,
-		reputersRewardFractions,
-	)
-	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer task entropy")
+		return topicRewardsDistribution{}, err
 	}
 
 	// Get inferer reward fractions
@@ -410,7 +465,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		infererScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inferer reward fractions")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get inferer reward fractions")
 	}
 
 	// Get inference entropy
@@ -424,7 +479,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference task entropy")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get inference task entropy")
 	}
 
 	// Get forecaster reward fractions
@@ -438,7 +493,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecasterScores,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get forecaster reward fractions")
 	}
 
 	var forecastingEntropy alloraMath.Dec
@@ -454,7 +509,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 			forecastersRewardFractions,
 		)
 		if err != nil {
-			return []types.TaskReward{}, alloraMath.Dec{}, err
+			return topicRewardsDistribution{}, err
 		}
 	} else {
 		// If there are no forecasters, set forecasting entropy to zero
@@ -462,20 +517,20 @@ func GenerateRewardsDistributionByTopicParticipant(
 	}
 
 	// Get Total Rewards for Reputation task
-	taskReputerReward, err = GetRewardForReputerTaskInTopic(
+	taskReputerReward, err := GetRewardForReputerTaskInTopic(
 		inferenceEntropy,
 		forecastingEntropy,
 		reputerEntropy,
 		args.TopicReward,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get reward for reputer task in topic")
 	}
 
 	// Get previous forecaster score ratio for topic
 	previousForecasterScoreRatio, err := args.K.GetPreviousForecasterScoreRatio(args.Ctx, args.TopicId)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get previous forecast score ratio")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get previous forecast score ratio")
 	}
 
 	// Get chi (Forecasting Utility) and gamma (Normalization Factor)
@@ -489,14 +544,14 @@ func GenerateRewardsDistributionByTopicParticipant(
 		args.ModuleParams.TaskRewardAlpha,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get chi and gamma")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get chi and gamma")
 	}
 	types.EmitNewForecastTaskUtilityScoreSetEvent(args.Ctx, args.TopicId, forecastingTaskUtilityScore)
 
 	// Set updated forecaster score ratio
 	err = args.K.SetPreviousForecasterScoreRatio(args.Ctx, args.TopicId, updatedForecasterScoreRatio)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to set previous forecast score ratio")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to set previous forecast score ratio")
 	}
 
 	// Get Total Rewards for Inference task
@@ -509,7 +564,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		gamma,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get reward for inference task in topic")
 	}
 
 	// Get Total Rewards for Forecasting task
@@ -522,10 +577,10 @@ func GenerateRewardsDistributionByTopicParticipant(
 		gamma,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get reward for forecasting task in topic")
 	}
 
-	totalRewardsDistribution = make([]types.TaskReward, 0)
+	totalRewardsDistribution := make([]types.TaskReward, 0)
 
 	// Get Distribution of Rewards per Reputer
 	reputerRewards, err := GetRewardPerReputer(
@@ -537,7 +592,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		reputersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer rewards")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get reputer rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, reputerRewards...)
 
@@ -550,7 +605,7 @@ func GenerateRewardsDistributionByTopicParticipant(
 		inferersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get inference rewards")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get inference rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, inferenceRewards...)
 
@@ -563,11 +618,72 @@ func GenerateRewardsDistributionByTopicParticipant(
 		forecastersRewardFractions,
 	)
 	if err != nil {
-		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get forecast rewards")
+		return topicRewardsDistribution{}, errors.Wrapf(err, "failed to get forecast rewards")
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, forecastRewards...)
 
-	return totalRewardsDistribution, taskReputerReward, nil
+	return topicRewardsDistribution{
+		rewards:                    totalRewardsDistribution,
+		reputerScores:              reputerScores,
+		infererScores:              infererScores,
+		forecasterScores:           forecasterScores,
+		reputers:                   reputers,
+		reputersRewardFractions:    reputersRewardFractions,
+		inferers:                   inferers,
+		inferersRewardFractions:    inferersRewardFractions,
+		forecasters:                forecasters,
+		forecastersRewardFractions: forecastersRewardFractions,
+		inferenceEntropy:           inferenceEntropy,
+		forecastingEntropy:         forecastingEntropy,
+		reputerEntropy:             reputerEntropy,
+		inferenceTaskReward:        taskInferenceReward,
+		forecastingTaskReward:      taskForecastingReward,
+		reputerTaskReward:          taskReputerReward,
+	}, nil
+}
+
+// Scores the reputers of the loss bundles of the topic at the block and returns their scores,
+// their addresses, their reward fractions and the entropy of the reputation task
+func getReputationTaskRewardFractionsAndEntropy(
+	args GenerateRewardsDistributionByTopicParticipantArgs,
+) (reputerScores []types.Score, reputers []string, reputersRewardFractions []alloraMath.Dec, reputerEntropy alloraMath.Dec, err error) {
+	bundles, err := args.K.GetReputerLossBundlesAtBlock(args.Ctx, args.TopicId, args.BlockHeight)
+	if err != nil {
+		return nil, nil, nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer loss bundle at block %d", args.BlockHeight)
+	}
+	if bundles != nil && len(bundles.ReputerValueBundles) == 0 {
+		return nil, nil, nil, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer loss bundles")
+	}
+
+	// Calculate and Set the reputer scores
+	reputerScores, err = GenerateReputerScores(args.Ctx, args.K, args.TopicId, args.BlockHeight, *bundles)
+	if err != nil {
+		return nil, nil, nil, alloraMath.Dec{}, err
+	}
+	if len(reputerScores) == 0 {
+		return nil, nil, nil, alloraMath.Dec{}, errors.Wrapf(types.ErrInvalidReward, "empty reputer scores")
+	}
+
+	// Get reputer participants' addresses and reward fractions to be used in the reward round for topic
+	reputers, reputersRewardFractions, err = GetReputersRewardFractions(args.Ctx, args.K, args.TopicId, args.ModuleParams.PRewardReputer, reputerScores)
+	if err != nil {
+		return nil, nil, nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer reward round data")
+	}
+
+	// Get reputer task entropy
+	reputerEntropy, err = GetReputerTaskEntropy(
+		args.Ctx,
+		args.K,
+		args.TopicId,
+		args.ModuleParams.TaskRewardAlpha,
+		args.ModuleParams.BetaEntropy,
+		reputers,
+		reputersRewardFractions,
+	)
+	if err != nil {
+		return nil, nil, nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to get reputer task entropy")
+	}
+	return reputerScores, reputers, reputersRewardFractions, reputerEntropy, nil
 }
 
 // pay out the rewards to the participants
@@ -582,6 +698,7 @@ func payoutRewards(
 	reputerAndDelegatorRewards := make([]types.TaskReward, 0)
 	infererRewards := make([]types.TaskReward, 0)
 	forecasterRewards := make([]types.TaskReward, 0)
+	topicCreatorRewards := make([]types.TaskReward, 0)
 	blockHeight := ctx.BlockHeight()
 	for _, reward := range rewards {
 		if reward.Reward.IsZero() {
@@ -664,6 +781,14 @@ func payoutRewards(
 					ret = append(ret, errors.Wrapf(err, "failed to increment count forecaster inclusions in topic"))
 					continue
 				}
+			} else if reward.Type == types.TopicCreatorRewardType {
+				topicCreatorRewards = append(topicCreatorRewards, reward)
+
+				err := k.AddTopicCreatorRewards(ctx, reward.TopicId, rewardInt)
+				if err != nil {
+					ret = append(ret, errors.Wrapf(err, "failed to add topic creator rewards"))
+					continue
+				}
 			}
 		}
 	}
@@ -671,6 +796,35 @@ func payoutRewards(
 	types.EmitNewInfererRewardsSettledEvent(ctx, blockHeight, infererRewards)
 	types.EmitNewForecasterRewardsSettledEvent(ctx, blockHeight, forecasterRewards)
 	types.EmitNewReputerAndDelegatorRewardsSettledEvent(ctx, blockHeight, reputerAndDelegatorRewards)
+	types.EmitNewTopicCreatorRewardsSettledEvent(ctx, blockHeight, topicCreatorRewards)
+	return ret
+}
+
+// Restakes the pending rewards of the delegations in a topic of the delegators that opted in to auto-compounding.
+// At most `limit` delegations are restaked per payout, the next payouts resuming where the previous one stopped,
+// and the delegations left for later keep accruing their rewards until then
+func compoundDelegateRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	limit uint64,
+) []error {
+	ret := make([]error, 0) // errors to return from compounding individual delegations
+	delegations, err := k.GetNextAutoCompoundDelegationsInTopic(ctx, topicId, limit)
+	if err != nil {
+		return append(ret, errors.Wrapf(err, "failed to get auto-compound delegations in topic %d", topicId))
+	}
+	for _, delegation := range delegations {
+		reputer, delegator := delegation.K1(), delegation.K2()
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheCtx, write := ctx.CacheContext()
+		_, err := k.CompoundDelegateReward(cacheCtx, topicId, delegator, reputer)
+		if err != nil {
+			ret = append(ret, errors.Wrapf(err, "failed to compound reward of delegator %s upon reputer %s", delegator, reputer))
+			continue
+		}
+		write()
+	}
 	return ret
 }
 
@@ -311,6 +311,29 @@ func GetConsensusScore(
 	mostDistantValues []alloraMath.Dec,
 	epsilonReputer alloraMath.Dec,
 	epsilon alloraMath.Dec,
+) (alloraMath.Dec, error) {
+	distanceOverConsensusNorm, err := GetConsensusDistance(reputerLosses, consensusLosses, mostDistantValues, epsilon)
+	if err != nil {
+		return alloraMath.ZeroDec(), err
+	}
+	distanceOverConsensusNormPlusEpsilonReputer, err := distanceOverConsensusNorm.Add(epsilonReputer)
+	if err != nil {
+		return alloraMath.ZeroDec(), err
+	}
+	score, err := alloraMath.OneDec().Quo(distanceOverConsensusNormPlusEpsilonReputer)
+	if err != nil {
+		return alloraMath.ZeroDec(), err
+	}
+	return score, nil
+}
+
+// GetConsensusDistance calculates the distance of the losses of a reputer to the consensus losses,
+// relative to the norm of the consensus losses.
+func GetConsensusDistance(
+	reputerLosses,
+	consensusLosses,
+	mostDistantValues []alloraMath.Dec,
+	epsilon alloraMath.Dec,
 ) (alloraMath.Dec, error) {
 	if len(reputerLosses) != len(consensusLosses) {
 		return alloraMath.ZeroDec(), types.ErrInvalidSliceLength
@@ -361,19 +384,7 @@ func GetConsensusScore(
 	if err != nil {
 		return alloraMath.ZeroDec(), err
 	}
-	distanceOverConsensusNorm, err := distance.Quo(consensusNorm)
-	if err != nil {
-		return alloraMath.ZeroDec(), err
-	}
-	distanceOverConsensusNormPlusEpsilonReputer, err := distanceOverConsensusNorm.Add(epsilonReputer)
-	if err != nil {
-		return alloraMath.ZeroDec(), err
-	}
-	score, err := alloraMath.OneDec().Quo(distanceOverConsensusNormPlusEpsilonReputer)
-	if err != nil {
-		return alloraMath.ZeroDec(), err
-	}
-	return score, nil
+	return distance.Quo(consensusNorm)
 }
 
 // GetAllConsensusScores calculates the proximity to consensus score for all reputers.
@@ -389,6 +400,56 @@ func GetAllConsensusScores(
 	epsilonReputer alloraMath.Dec,
 	epsilon alloraMath.Dec,
 ) ([]alloraMath.Dec, error) {
+	consensus, mostDistantValues, err := getConsensusLosses(allLosses, stakes, allListeningCoefficients, numReputers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get reputers scores
+	scores := make([]alloraMath.Dec, numReputers)
+	for i := int64(0); i < numReputers; i++ {
+		losses := allLosses[i]
+		scores[i], err = GetConsensusScore(losses, consensus, mostDistantValues, epsilonReputer, epsilon)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error in GetConsensusScore")
+		}
+	}
+
+	return scores, nil
+}
+
+// GetAllConsensusDistances calculates the distance of the losses of every reputer to the consensus losses,
+// relative to the norm of the consensus losses.
+func GetAllConsensusDistances(
+	allLosses [][]alloraMath.Dec,
+	stakes []alloraMath.Dec,
+	allListeningCoefficients []alloraMath.Dec,
+	numReputers int64,
+	epsilon alloraMath.Dec,
+) ([]alloraMath.Dec, error) {
+	consensus, mostDistantValues, err := getConsensusLosses(allLosses, stakes, allListeningCoefficients, numReputers)
+	if err != nil {
+		return nil, err
+	}
+
+	distances := make([]alloraMath.Dec, numReputers)
+	for i := int64(0); i < numReputers; i++ {
+		distances[i], err = GetConsensusDistance(allLosses[i], consensus, mostDistantValues, epsilon)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error in GetConsensusDistance")
+		}
+	}
+	return distances, nil
+}
+
+// getConsensusLosses calculates the consensus loss vector, weighted by the stakes of the reputers
+// adjusted by their listening coefficients, and the most distant values from it.
+func getConsensusLosses(
+	allLosses [][]alloraMath.Dec,
+	stakes []alloraMath.Dec,
+	allListeningCoefficients []alloraMath.Dec,
+	numReputers int64,
+) ([]alloraMath.Dec, []alloraMath.Dec, error) {
 	// Get adjusted stakes
 	var adjustedStakes []alloraMath.Dec
 	for i, reputerStake := range stakes {
@@ -400,7 +461,7 @@ func GetAllConsensusScores(
 			alloraMath.NewDecFromInt64(numReputers),
 		)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error in GetAdjustedStake")
+			return nil, nil, errors.Wrapf(err, "error in GetAdjustedStake")
 		}
 		adjustedStakes = append(adjustedStakes, adjustedStake)
 	}
@@ -408,20 +469,9 @@ func GetAllConsensusScores(
 	// Get consensus loss vector and retrieve most distant values from
 	consensus, mostDistantValues, err := GetStakeWeightedLossMatrix(adjustedStakes, allLosses)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error in GetStakeWeightedLossMatrix")
-	}
-
-	// Get reputers scores
-	scores := make([]alloraMath.Dec, numReputers)
-	for i := int64(0); i < numReputers; i++ {
-		losses := allLosses[i]
-		scores[i], err = GetConsensusScore(losses, consensus, mostDistantValues, epsilonReputer, epsilon)
-		if err != nil {
-			return nil, errors.Wrapf(err, "error in GetConsensusScore")
-		}
+		return nil, nil, errors.Wrapf(err, "error in GetStakeWeightedLossMatrix")
 	}
-
-	return scores, nil
+	return consensus, mostDistantValues, nil
 }
 
 // GetAllReputersOutput calculates the final scores and adjusted listening coefficients for all reputers.
@@ -689,6 +689,63 @@ func TestGetAllConsensusScores(t *testing.T) {
 	}
 }
 
+func TestGetConsensusDistance(t *testing.T) {
+	epsilon := alloraMath.MustNewDecFromString("1e-4")
+	consensusLosses := []alloraMath.Dec{alloraMath.MustNewDecFromString("3"), alloraMath.MustNewDecFromString("4")}
+	mostDistantValues := []alloraMath.Dec{alloraMath.MustNewDecFromString("10"), alloraMath.MustNewDecFromString("10")}
+
+	got, err := rewards.GetConsensusDistance(consensusLosses, consensusLosses, mostDistantValues, epsilon)
+	require.NoError(t, err)
+	require.True(t, got.IsZero())
+
+	// |(6, 8) - (3, 4)| / |(3, 4)| = 5 / 5
+	reputerLosses := []alloraMath.Dec{alloraMath.MustNewDecFromString("6"), alloraMath.MustNewDecFromString("8")}
+	got, err = rewards.GetConsensusDistance(reputerLosses, consensusLosses, mostDistantValues, epsilon)
+	require.NoError(t, err)
+	inDelta, err := alloraMath.InDelta(alloraMath.OneDec(), got, alloraMath.MustNewDecFromString("0.00001"))
+	require.NoError(t, err)
+	require.True(t, inDelta, "got %v", got)
+
+	_, err = rewards.GetConsensusDistance(reputerLosses[:1], consensusLosses, mostDistantValues, epsilon)
+	require.ErrorIs(t, err, emissionstypes.ErrInvalidSliceLength)
+}
+
+func TestGetAllConsensusDistancesMatchesConsensusScores(t *testing.T) {
+	allLosses := [][]alloraMath.Dec{
+		{alloraMath.MustNewDecFromString("0.0112"), alloraMath.MustNewDecFromString("0.00231"), alloraMath.MustNewDecFromString("0.02274")},
+		{alloraMath.MustNewDecFromString("0.01635"), alloraMath.MustNewDecFromString("0.00179"), alloraMath.MustNewDecFromString("0.03396")},
+		{alloraMath.MustNewDecFromString("0.01345"), alloraMath.MustNewDecFromString("0.00209"), alloraMath.MustNewDecFromString("0.03249")},
+		{alloraMath.MustNewDecFromString("0.09675"), alloraMath.MustNewDecFromString("0.00318"), alloraMath.MustNewDecFromString("0.00623")},
+	}
+	stakes := []alloraMath.Dec{alloraMath.MustNewDecFromString("1176644.37627"), alloraMath.MustNewDecFromString("384623.3607"), alloraMath.MustNewDecFromString("394676.13226"), alloraMath.MustNewDecFromString("207999.66194")}
+	coefficients := []alloraMath.Dec{alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec(), alloraMath.OneDec()}
+	var numReputers int64 = 4
+	reputerEpsilon := alloraMath.MustNewDecFromString("1e-2")
+	epsilon := alloraMath.MustNewDecFromString("1e-4")
+
+	distances, err := rewards.GetAllConsensusDistances(allLosses, stakes, coefficients, numReputers, epsilon)
+	require.NoError(t, err)
+	scores, err := rewards.GetAllConsensusScores(allLosses, stakes, coefficients, numReputers, reputerEpsilon, epsilon)
+	require.NoError(t, err)
+	require.Len(t, distances, len(scores))
+
+	// The consensus score of a reputer is the inverse of its distance to the consensus
+	for i, distance := range distances {
+		distancePlusEpsilon, err := distance.Add(reputerEpsilon)
+		require.NoError(t, err)
+		want, err := alloraMath.OneDec().Quo(distancePlusEpsilon)
+		require.NoError(t, err)
+		inDelta, err := alloraMath.InDelta(want, scores[i], alloraMath.MustNewDecFromString("0.00001"))
+		require.NoError(t, err)
+		require.True(t, inDelta, "reputer %d: got %v, want %v", i, scores[i], want)
+	}
+
+	// The reputer reporting far from the others is the most distant from the consensus
+	for i := 0; i < 3; i++ {
+		require.True(t, distances[3].Gt(distances[i]))
+	}
+}
+
 func (s *RewardsTestSuite) TestGetAllReputersOutput() {
 	require := s.Require()
 
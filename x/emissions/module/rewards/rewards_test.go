@@ -78,6 +78,9 @@ func (s *RewardsTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraRequestsAccountName:                  {"minter"},
+		types.AlloraWorkerBondsAccountName:               {"minter"},
+		types.AlloraDelegateStakeReceiptsAccountName:     {"minter", "burner"},
 		"ecosystem":              {"minter"},
 		"bonded_tokens_pool":     {"burner", "staking"},
 		"not_bonded_tokens_pool": {"burner", "staking"},
@@ -258,6 +261,16 @@ func (s *RewardsTestSuite) TestStandardRewardEmission() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -384,6 +397,16 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionShouldRewardTopicsWithFulfi
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -519,6 +542,16 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionShouldRewardTopicsWithFulfi
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err = s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -658,6 +691,16 @@ func (s *RewardsTestSuite) setUpTopicWithEpochLength(
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	require.NoError(err)
@@ -1265,6 +1308,16 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -1430,6 +1483,16 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err = s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -1593,6 +1656,16 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -1743,6 +1816,324 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	}
 }
 
+func (s *RewardsTestSuite) TestRewardsCompoundDelegateRewardsOfOptedInDelegators() {
+	block := int64(600)
+	s.ctx = s.ctx.WithBlockHeight(block)
+	epochLength := int64(10800)
+	s.MintTokensToModule(types.AlloraStakingAccountName, cosmosMath.NewInt(10000000000))
+
+	reputerIndexes := s.returnIndexes(0, 5)
+	workerIndexes := s.returnIndexes(5, 5)
+	compoundingDelegator := s.addrsStr[10]
+	claimingDelegator := s.addrsStr[11]
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  s.addrsStr[reputerIndexes[0]],
+		Metadata:                 "test",
+		LossMethod:               "mse",
+		EpochLength:              epochLength,
+		AllowNegative:            false,
+		GroundTruthLag:           epochLength,
+		WorkerSubmissionWindow:   10,
+		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+	}
+	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
+	s.Require().NoError(err)
+	topicId := res.TopicId
+
+	for _, index := range workerIndexes {
+		_, err := s.msgServer.Register(s.ctx, &types.RegisterRequest{
+			Sender:    s.addrsStr[index],
+			TopicId:   topicId,
+			IsReputer: false,
+			Owner:     s.addrsStr[index],
+		})
+		s.Require().NoError(err)
+	}
+	for _, index := range reputerIndexes {
+		_, err := s.msgServer.Register(s.ctx, &types.RegisterRequest{
+			Sender:    s.addrsStr[index],
+			TopicId:   topicId,
+			IsReputer: true,
+			Owner:     s.addrsStr[index],
+		})
+		s.Require().NoError(err)
+	}
+
+	cosmosOneE18 := inferencesynthesis.CosmosIntOneE18()
+	var stakes = []cosmosMath.Int{
+		cosmosMath.NewInt(1176644).Mul(cosmosOneE18),
+		cosmosMath.NewInt(984623).Mul(cosmosOneE18),
+		cosmosMath.NewInt(994676).Mul(cosmosOneE18),
+		cosmosMath.NewInt(907999).Mul(cosmosOneE18),
+		cosmosMath.NewInt(868582).Mul(cosmosOneE18),
+	}
+	for _, index := range reputerIndexes {
+		s.MintTokensToAddress(s.addrs[index], stakes[index])
+		_, err := s.msgServer.AddStake(s.ctx, &types.AddStakeRequest{
+			Sender:  s.addrsStr[index],
+			Amount:  stakes[index],
+			TopicId: topicId,
+		})
+		s.Require().NoError(err)
+	}
+
+	// Both delegators delegate the same stake upon the first reputer, only one of them auto-compounds
+	delegateStake := cosmosMath.NewInt(500000).Mul(cosmosOneE18)
+	reputer := s.addrsStr[reputerIndexes[0]]
+	for _, index := range []int{10, 11} {
+		s.MintTokensToAddress(s.addrs[index], delegateStake)
+		_, err := s.msgServer.DelegateStake(s.ctx, &types.DelegateStakeRequest{
+			Sender:  s.addrsStr[index],
+			TopicId: topicId,
+			Reputer: reputer,
+			Amount:  delegateStake,
+		})
+		s.Require().NoError(err)
+	}
+	_, err = s.msgServer.SetDelegateRewardAutoCompound(s.ctx, &types.SetDelegateRewardAutoCompoundRequest{
+		Sender:  compoundingDelegator,
+		Enabled: true,
+	})
+	s.Require().NoError(err)
+
+	initialStake := cosmosMath.NewInt(1000)
+	s.MintTokensToAddress(s.addrs[reputerIndexes[0]], initialStake)
+	_, err = s.msgServer.FundTopic(s.ctx, &types.FundTopicRequest{
+		Sender:  s.addrsStr[reputerIndexes[0]],
+		TopicId: topicId,
+		Amount:  initialStake,
+	})
+	s.Require().NoError(err)
+
+	err = s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, &types.Nonce{
+		BlockHeight: block,
+	})
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.AddReputerNonce(s.ctx, topicId, &types.Nonce{
+		BlockHeight: block,
+	})
+	s.Require().NoError(err)
+
+	inferenceBundles := generateWorkerDataBundles(s, block, topicId)
+	for _, payload := range inferenceBundles {
+		_, err = s.msgServer.InsertWorkerPayload(s.ctx, &types.InsertWorkerPayloadRequest{
+			Sender:           payload.Worker,
+			WorkerDataBundle: payload,
+		})
+		s.Require().NoError(err)
+	}
+
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	err = actorutils.CloseWorkerNonce(&s.emissionsKeeper, s.ctx, topic, *inferenceBundles[0].Nonce)
+	s.Require().NoError(err)
+
+	newBlockheight := block + topic.GroundTruthLag
+	s.ctx = sdk.UnwrapSDKContext(s.ctx).WithBlockHeight(newBlockheight)
+	err = s.emissionsKeeper.SetRewardCurrentBlockEmission(s.ctx, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = s.emissionsAppModule.EndBlock(s.ctx)
+	s.Require().NoError(err)
+
+	lossBundles := generateLossBundles(s, block, topicId, reputerIndexes)
+	for _, payload := range lossBundles.ReputerValueBundles {
+		_, err = s.msgServer.InsertReputerPayload(s.ctx, &types.InsertReputerPayloadRequest{
+			Sender:             payload.ValueBundle.Reputer,
+			ReputerValueBundle: payload,
+		})
+		s.Require().NoError(err)
+	}
+	err = actorutils.CloseReputerNonce(&s.emissionsKeeper, s.ctx, topic, *lossBundles.ReputerValueBundles[0].ValueBundle.ReputerRequestNonce.ReputerNonce)
+	s.Require().NoError(err)
+
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(1000))
+	newBlockheight += epochLength
+	s.ctx = s.ctx.WithBlockHeight(newBlockheight)
+	err = s.emissionsKeeper.SetRewardCurrentBlockEmission(s.ctx, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = s.emissionsAppModule.EndBlock(s.ctx)
+	s.Require().NoError(err)
+
+	delegateStakeDec, err := alloraMath.NewDecFromSdkInt(delegateStake)
+	s.Require().NoError(err)
+	compounded, err := s.emissionsKeeper.GetDelegateStakePlacement(s.ctx, topicId, compoundingDelegator, reputer)
+	s.Require().NoError(err)
+	s.Require().True(compounded.Amount.Gt(delegateStakeDec), "rewards were not restaked: %s", compounded.Amount.String())
+	claiming, err := s.emissionsKeeper.GetDelegateStakePlacement(s.ctx, topicId, claimingDelegator, reputer)
+	s.Require().NoError(err)
+	s.Require().True(claiming.Amount.Equal(delegateStakeDec), "rewards were restaked: %s", claiming.Amount.String())
+
+	// The rewards of the other delegator wait to be claimed
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, s.addrs[11], params.DefaultBondDenom)
+	claimed, err := s.msgServer.ClaimAllDelegateRewards(s.ctx, &types.ClaimAllDelegateRewardsRequest{
+		Sender: claimingDelegator,
+	})
+	s.Require().NoError(err)
+	s.Require().True(claimed.Amount.IsPositive())
+	balanceAfter := s.bankKeeper.GetBalance(s.ctx, s.addrs[11], params.DefaultBondDenom)
+	s.Require().Equal(balanceBefore.Amount.Add(claimed.Amount), balanceAfter.Amount)
+}
+
+func (s *RewardsTestSuite) TestRewardsPayTopicCreatorCommission() {
+	block := int64(600)
+	s.ctx = s.ctx.WithBlockHeight(block)
+	epochLength := int64(10800)
+	s.MintTokensToModule(types.AlloraStakingAccountName, cosmosMath.NewInt(10000000000))
+
+	reputerIndexes := s.returnIndexes(0, 5)
+	workerIndexes := s.returnIndexes(5, 5)
+	// The creator takes no part in the topic, so that its balance only changes by its commission
+	creator := 10
+
+	newTopicMsg := &types.CreateNewTopicRequest{
+		Creator:                  s.addrsStr[creator],
+		Metadata:                 "test",
+		LossMethod:               "mse",
+		EpochLength:              epochLength,
+		AllowNegative:            false,
+		GroundTruthLag:           epochLength,
+		WorkerSubmissionWindow:   10,
+		AlphaRegret:              alloraMath.MustNewDecFromString("0.1"),
+		PNorm:                    alloraMath.NewDecFromInt64(3),
+		Epsilon:                  alloraMath.MustNewDecFromString("0.01"),
+		MeritSortitionAlpha:      alloraMath.MustNewDecFromString("0.1"),
+		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
+		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.MustNewDecFromString("0.1"),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
+	}
+	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
+	s.Require().NoError(err)
+	topicId := res.TopicId
+
+	for _, index := range workerIndexes {
+		_, err := s.msgServer.Register(s.ctx, &types.RegisterRequest{
+			Sender:    s.addrsStr[index],
+			TopicId:   topicId,
+			IsReputer: false,
+			Owner:     s.addrsStr[index],
+		})
+		s.Require().NoError(err)
+	}
+	cosmosOneE18 := inferencesynthesis.CosmosIntOneE18()
+	for _, index := range reputerIndexes {
+		_, err := s.msgServer.Register(s.ctx, &types.RegisterRequest{
+			Sender:    s.addrsStr[index],
+			TopicId:   topicId,
+			IsReputer: true,
+			Owner:     s.addrsStr[index],
+		})
+		s.Require().NoError(err)
+		stake := cosmosMath.NewInt(1000000).Mul(cosmosOneE18)
+		s.MintTokensToAddress(s.addrs[index], stake)
+		_, err = s.msgServer.AddStake(s.ctx, &types.AddStakeRequest{
+			Sender:  s.addrsStr[index],
+			Amount:  stake,
+			TopicId: topicId,
+		})
+		s.Require().NoError(err)
+	}
+
+	s.MintTokensToAddress(s.addrs[reputerIndexes[0]], cosmosMath.NewInt(1000))
+	_, err = s.msgServer.FundTopic(s.ctx, &types.FundTopicRequest{
+		Sender:  s.addrsStr[reputerIndexes[0]],
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	s.Require().NoError(err)
+
+	err = s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block})
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.AddReputerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: block})
+	s.Require().NoError(err)
+
+	inferenceBundles := generateWorkerDataBundles(s, block, topicId)
+	for _, payload := range inferenceBundles {
+		_, err = s.msgServer.InsertWorkerPayload(s.ctx, &types.InsertWorkerPayloadRequest{
+			Sender:           payload.Worker,
+			WorkerDataBundle: payload,
+		})
+		s.Require().NoError(err)
+	}
+	topic, err := s.emissionsKeeper.GetTopic(s.ctx, topicId)
+	s.Require().NoError(err)
+	err = actorutils.CloseWorkerNonce(&s.emissionsKeeper, s.ctx, topic, *inferenceBundles[0].Nonce)
+	s.Require().NoError(err)
+
+	newBlockheight := block + topic.GroundTruthLag
+	s.ctx = s.ctx.WithBlockHeight(newBlockheight)
+	err = s.emissionsKeeper.SetRewardCurrentBlockEmission(s.ctx, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = s.emissionsAppModule.EndBlock(s.ctx)
+	s.Require().NoError(err)
+
+	lossBundles := generateLossBundles(s, block, topicId, reputerIndexes)
+	for _, payload := range lossBundles.ReputerValueBundles {
+		_, err = s.msgServer.InsertReputerPayload(s.ctx, &types.InsertReputerPayloadRequest{
+			Sender:             payload.ValueBundle.Reputer,
+			ReputerValueBundle: payload,
+		})
+		s.Require().NoError(err)
+	}
+	err = actorutils.CloseReputerNonce(&s.emissionsKeeper, s.ctx, topic, *lossBundles.ReputerValueBundles[0].ValueBundle.ReputerRequestNonce.ReputerNonce)
+	s.Require().NoError(err)
+
+	creatorBalanceBefore := s.bankKeeper.GetBalance(s.ctx, s.addrs[creator], params.DefaultBondDenom)
+
+	// Trigger end block - rewards distribution
+	newBlockheight += epochLength
+	s.ctx = s.ctx.WithBlockHeight(newBlockheight).WithEventManager(sdk.NewEventManager())
+	err = s.emissionsKeeper.SetRewardCurrentBlockEmission(s.ctx, cosmosMath.NewInt(100))
+	s.Require().NoError(err)
+	err = s.emissionsAppModule.EndBlock(s.ctx)
+	s.Require().NoError(err)
+
+	creatorRewards, err := s.emissionsKeeper.GetTopicCreatorRewards(s.ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().True(creatorRewards.IsPositive(), "topic creator was not paid")
+	creatorBalanceAfter := s.bankKeeper.GetBalance(s.ctx, s.addrs[creator], params.DefaultBondDenom)
+	s.Require().Equal(creatorBalanceBefore.Amount.Add(creatorRewards), creatorBalanceAfter.Amount)
+
+	creatorEventFound := false
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != "emissions.v4.EventRewardsSettled" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "actor_type" && attr.Value == "\"ACTOR_TYPE_TOPIC_CREATOR\"" {
+				creatorEventFound = true
+			}
+		}
+	}
+	s.Require().True(creatorEventFound, "topic creator rewards settled event not emitted")
+}
+
 func (s *RewardsTestSuite) TestRewardsHandleStandardDeviationOfZero() {
 	block := int64(600)
 	s.ctx = s.ctx.WithBlockHeight(block)
@@ -1769,6 +2160,16 @@ func (s *RewardsTestSuite) TestRewardsHandleStandardDeviationOfZero() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -1995,6 +2396,16 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionWithOneInfererAndOneReputer
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -2064,6 +2475,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionWithOneInfererAndOneReputer
 			Value:       alloraMath.MustNewDecFromString("0.01127"),
 			ExtraData:   []byte("extra data"),
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: nil,
 	}
@@ -2101,6 +2513,8 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionWithOneInfererAndOneReputer
 		OneOutForecasterValues:        []*types.WithheldWorkerAttributedValue{},
 		OneInForecasterValues:         []*types.WorkerAttributedValue{},
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 	sig, err := signValueBundle(valueBundle, s.privKeys[reputer])
 	s.Require().NoError(err)
@@ -2237,6 +2651,16 @@ func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -2391,6 +2815,16 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -2573,6 +3007,16 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err = s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -2744,6 +3188,16 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err = s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -3839,6 +4293,54 @@ func (s *RewardsTestSuite) TestCalcTopicRewards() {
 	}
 }
 
+func (s *RewardsTestSuite) TestGetRewardForReputerFromTotalRewardKeepsReputerCommission() {
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, topicId, reputer, cosmosMath.NewInt(500))
+	require.NoError(err)
+	err = s.emissionsKeeper.AddDelegateStake(s.ctx, topicId, delegator, reputer, cosmosMath.NewInt(500))
+	require.NoError(err)
+	err = s.emissionsKeeper.CreateReputerCommission(
+		s.ctx,
+		topicId,
+		reputer,
+		alloraMath.MustNewDecFromString("0.1"),
+		alloraMath.MustNewDecFromString("0.2"),
+		alloraMath.MustNewDecFromString("0.01"),
+	)
+	require.NoError(err)
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(1000))
+
+	reputerRewards, err := rewards.GetRewardForReputerFromTotalReward(
+		s.ctx,
+		s.emissionsKeeper,
+		topicId,
+		[]types.TaskReward{{
+			Address: reputer,
+			Reward:  alloraMath.NewDecFromInt64(1000),
+			TopicId: topicId,
+			Type:    types.ReputerAndDelegatorRewardType,
+		}},
+	)
+	require.NoError(err)
+
+	// Delegators hold half of the stake, so earn half of the reward less the 10% commission of the reputer
+	require.Len(reputerRewards, 1)
+	require.True(reputerRewards[0].Reward.Equal(alloraMath.NewDecFromInt64(550)), reputerRewards[0].Reward.String())
+	pendingRewards := s.bankKeeper.GetBalance(
+		s.ctx,
+		s.accountKeeper.GetModuleAddress(types.AlloraPendingRewardForDelegatorAccountName),
+		params.DefaultBondDenom,
+	)
+	require.Equal(cosmosMath.NewInt(450), pendingRewards.Amount)
+	share, err := s.emissionsKeeper.GetDelegateRewardPerShare(s.ctx, topicId, reputer)
+	require.NoError(err)
+	require.True(share.Equal(alloraMath.MustNewDecFromString("0.9")), share.String())
+}
+
 // Helper function to compare rewards
 func (s *RewardsTestSuite) compareRewards(actual, expected map[uint64]*alloraMath.Dec) bool {
 	if len(actual) != len(expected) {
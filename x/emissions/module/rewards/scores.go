@@ -1,6 +1,8 @@
 package rewards
 
 import (
+	"fmt"
+
 	"cosmossdk.io/errors"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
@@ -55,10 +57,10 @@ func GenerateReputerScores(
 	var losses [][]alloraMath.Dec
 	for _, reportedLoss := range reportedLosses.ReputerValueBundles {
 		reputers = append(reputers, reportedLoss.ValueBundle.Reputer)
-		// Get reputer topic stake
-		reputerStake, err := keeper.GetStakeReputerAuthority(ctx, topicId, reportedLoss.ValueBundle.Reputer)
+		// Get reputer topic stake, delegated stake beyond the delegation limits carrying no weight
+		reputerStake, err := keeper.GetReputerEffectiveStake(ctx, topicId, reportedLoss.ValueBundle.Reputer)
 		if err != nil {
-			return []types.Score{}, errors.Wrapf(err, "Error getting GetStakeOnReputerInTopic")
+			return []types.Score{}, errors.Wrapf(err, "Error getting GetReputerEffectiveStake")
 		}
 		reputerStakeDec, err := alloraMath.NewDecFromSdkInt(reputerStake)
 		if err != nil {
@@ -143,6 +145,30 @@ func GenerateReputerScores(
 		emaScores = append(emaScores, emaScore)
 	}
 
+	// Slash the reputers that have reported losses far from the consensus for too many consecutive epochs
+	if topic.SlashesReputerOutliers() && params.ReputerOutlierSlashFraction.IsPositive() {
+		distances, err := GetAllConsensusDistances(
+			losses,
+			reputerStakes,
+			newCoefficients,
+			int64(len(reputerStakes)),
+			params.EpsilonSafeDiv,
+		)
+		if err != nil {
+			return []types.Score{}, errors.Wrapf(err, "Error getting GetAllConsensusDistances")
+		}
+		for i, reputer := range reputers {
+			// A failed slash is rolled back without holding back the scores of the epoch
+			cacheCtx, write := ctx.CacheContext()
+			err := keeper.UpdateReputerOutlierStreak(cacheCtx, topic, reputer, distances[i], params)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("Error updating outlier streak of reputer %s in topic %d: %v", reputer, topicId, err))
+				continue
+			}
+			write()
+		}
+	}
+
 	// Update topic quantile of instant score
 	topicInstantScoreQuantile, err := getQuantileOfScores(instantScores, topic.ActiveReputerQuantile)
 	if err != nil {
@@ -35,6 +35,16 @@ func (s *RewardsTestSuite) TestGetReputersScoresFromCsv() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -192,6 +202,16 @@ func (s *RewardsTestSuite) TestGetInferenceScores() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -250,6 +270,16 @@ func (s *RewardsTestSuite) TestGetInferenceScoresFromCsv() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -320,6 +350,16 @@ func (s *RewardsTestSuite) TestHigherOneOutLossesHigherInferenceScore() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -373,6 +413,16 @@ func (s *RewardsTestSuite) TestGetForecastScores() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -428,6 +478,16 @@ func (s *RewardsTestSuite) TestGetForecasterScoresFromCsv() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -497,6 +557,16 @@ func (s *RewardsTestSuite) TestHigherOneOutLossesHigherForecastScore() {
 		ActiveInfererQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -664,6 +734,8 @@ func (s *RewardsTestSuite) TestEnsureWorkerPresenceConsistency() {
 							},
 						},
 					},
+					GroundTruth:       nil,
+					GroundTruthValues: nil,
 				},
 			},
 			{
@@ -710,6 +782,8 @@ func (s *RewardsTestSuite) TestEnsureWorkerPresenceConsistency() {
 							},
 						},
 					},
+					GroundTruth:       nil,
+					GroundTruthValues: nil,
 				},
 			},
 		},
@@ -997,6 +1071,8 @@ func generateLossBundles(s *RewardsTestSuite, blockHeight int64, topicId uint64,
 			OneOutForecasterValues:        make([]*types.WithheldWorkerAttributedValue, len(workers)),
 			OneInForecasterValues:         make([]*types.WorkerAttributedValue, len(workers)),
 			OneOutInfererForecasterValues: nil,
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 		}
 
 		for j, worker := range workers {
@@ -1057,6 +1133,8 @@ func generateHugeLossBundles(
 			OneOutForecasterValues:        make([]*types.WithheldWorkerAttributedValue, len(workerIndexes)),
 			OneInForecasterValues:         make([]*types.WorkerAttributedValue, len(workerIndexes)),
 			OneOutInfererForecasterValues: nil,
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 		}
 
 		for j, workerIndex := range workerIndexes {
@@ -1097,6 +1175,7 @@ func generateHugeWorkerDataBundles(
 				Value:       alloraMath.MustNewDecFromString(strconv.FormatInt(int64(rand.Intn(1000)+1), 10)),
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &types.Forecast{
 				TopicId:     topicId,
@@ -1180,6 +1259,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01127"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1218,6 +1298,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01791"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1256,6 +1337,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01404"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1294,6 +1376,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.02318"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1332,6 +1415,7 @@ func generateWorkerDataBundles(s *RewardsTestSuite, blockHeight int64, topicId u
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1378,6 +1462,7 @@ func generateMoreInferencesDataBundles(s *RewardsTestSuite, blockHeight int64, t
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1416,6 +1501,7 @@ func generateMoreInferencesDataBundles(s *RewardsTestSuite, blockHeight int64, t
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1462,6 +1548,7 @@ func generateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1500,6 +1587,7 @@ func generateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 			Value:       alloraMath.MustNewDecFromString("0.01251"),
 			ExtraData:   nil,
 			Proof:       "",
+			Values:      nil,
 		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
@@ -1576,6 +1664,7 @@ func generateSimpleWorkerDataBundles(
 				Value:       alloraMath.MustNewDecFromString(workerValue.Value),
 				ExtraData:   nil,
 				Proof:       "",
+				Values:      nil,
 			},
 			Forecast: &types.Forecast{
 				TopicId:     topicId,
@@ -1646,6 +1735,8 @@ func generateSimpleLossBundles(
 			OneOutForecasterValues:        make([]*types.WithheldWorkerAttributedValue, countValues),
 			OneInForecasterValues:         make([]*types.WorkerAttributedValue, countValues),
 			OneOutInfererForecasterValues: nil,
+			GroundTruth:                   nil,
+			GroundTruthValues:             nil,
 		}
 
 		for j, worker := range workerValues {
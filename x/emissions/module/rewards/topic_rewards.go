@@ -26,6 +26,18 @@ func GetTopicReward(
 	return topicRewardFraction.Mul(totalReward)
 }
 
+// The commission paid to the creator of a topic out of the topic reward
+// C_{t,i} = c_t*E_{t,i}
+// c_t is the effective creator commission of the topic
+// E_{t,i} is the reward of the topic for that epoch
+// The rest of the topic reward is distributed to the topic participants
+func GetTopicCreatorReward(
+	effectiveCreatorCommission alloraMath.Dec,
+	topicReward alloraMath.Dec,
+) (alloraMath.Dec, error) {
+	return effectiveCreatorCommission.Mul(topicReward)
+}
+
 // The reward fraction for a topic
 // normalize the topic reward weight
 // f{t,i} = (1 - f_v) * (w_{t,i}) / (∑_t w_{t,i})
@@ -98,7 +110,7 @@ func UpdateNoncesOfActiveTopics(
 		}
 		ctx.Logger().Debug(fmt.Sprintf("Added worker nonce for topic %d: %v \n", topic.Id, nextNonce.BlockHeight))
 
-		err = k.AddWorkerWindowTopicId(ctx, block+topic.WorkerSubmissionWindow, topic.Id)
+		err = k.AddWorkerWindowTopicId(ctx, block+topic.WorkerSubmissionWindow+topic.WorkerRevealWindow, topic.Id)
 		if err != nil {
 			ctx.Logger().Warn(fmt.Sprintf("Error adding worker window topic id: %s", err.Error()))
 			continue
@@ -179,6 +191,19 @@ func GetAndUpdateActiveTopicWeights(
 			return nil, alloraMath.Dec{}, cosmosMath.Int{}, errors.Wrapf(err, "failed to reset topic fee revenue")
 		}
 
+		// The epoch of the topic ends here, so updates requested by its creator can now take effect
+		// without disturbing nonces and worker windows opened under the previous topic parameters.
+		// Applied whether or not the topic stays active, and before reactivation so that the next
+		// epoch is scheduled with the updated epoch length.
+		cacheCtx, write := ctx.CacheContext()
+		err = k.ApplyPendingTopicUpdate(cacheCtx, topicId)
+		if err != nil {
+			ctx.Logger().Warn(fmt.Sprintf("Error applying pending topic update for topic %d: %s", topicId, err.Error()))
+			types.EmitNewTopicUpdateFailedEvent(ctx, topicId, block, err.Error())
+		} else {
+			write()
+		}
+
 		// If the topic is inactive, inactivate it
 		if weight.Lt(moduleParams.MinTopicWeight) {
 			err := k.InactivateTopic(ctx, topic.Id)
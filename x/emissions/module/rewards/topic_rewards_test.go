@@ -28,6 +28,16 @@ func mockTopic(s *RewardsTestSuite) types.Topic {
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		WorkerSubmissionWindow:   10,
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 }
 
@@ -121,6 +131,159 @@ func (s *RewardsTestSuite) TestGetAndUpdateActiveTopicWeights() {
 	s.Require().Equal(1, len(activeTopics.TopicIds), "Should retrieve exactly one active topics")
 }
 
+func (s *RewardsTestSuite) TestPendingTopicUpdateAppliedAtEpochBoundary() {
+	params := types.DefaultParams()
+	params.TopicRewardAlpha = alloraMath.MustNewDecFromString("0.5")
+	params.TopicRewardStakeImportance = alloraMath.OneDec()
+	params.TopicRewardFeeRevenueImportance = alloraMath.OneDec()
+	err := s.emissionsKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(err)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	topic := mockTopic(s)
+	topic.EpochLength = 15
+	topic.GroundTruthLag = topic.EpochLength
+	topic.WorkerSubmissionWindow = topic.EpochLength
+	err = s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.AddTopicFeeRevenue(ctx, topic.Id, cosmosMath.NewInt(1000))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.SetTopicStake(ctx, topic.Id, cosmosMath.NewInt(1000))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+
+	updated := topic
+	updated.EpochLength = 20
+	updated.GroundTruthLag = 20
+	updated.WorkerSubmissionWindow = 5
+	err = s.emissionsKeeper.SetPendingTopicUpdate(ctx, topic.Id, updated)
+	s.Require().NoError(err)
+
+	// Before the boundary the topic is unchanged
+	ctx = s.ctx.WithBlockHeight(10)
+	_, _, _, err = rewards.GetAndUpdateActiveTopicWeights(ctx, s.emissionsKeeper, 10)
+	s.Require().NoError(err)
+	stored, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(15), stored.EpochLength)
+
+	// At the boundary the update is applied and the next epoch uses the new epoch length
+	block := int64(16)
+	ctx = s.ctx.WithBlockHeight(block)
+	_, _, _, err = rewards.GetAndUpdateActiveTopicWeights(ctx, s.emissionsKeeper, block)
+	s.Require().NoError(err)
+	stored, err = s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(20), stored.EpochLength)
+	s.Require().Equal(int64(5), stored.WorkerSubmissionWindow)
+
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	nextChurningBlock, isActive, err := s.emissionsKeeper.GetNextPossibleChurningBlockByTopicId(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().True(isActive)
+	s.Require().Equal(block+20, nextChurningBlock)
+}
+
+func (s *RewardsTestSuite) TestPendingTopicUpdateAppliedWhenTopicInactivated() {
+	params := types.DefaultParams()
+	params.TopicRewardAlpha = alloraMath.MustNewDecFromString("0.5")
+	params.TopicRewardStakeImportance = alloraMath.OneDec()
+	params.TopicRewardFeeRevenueImportance = alloraMath.OneDec()
+	err := s.emissionsKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(err)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	topic := mockTopic(s)
+	topic.EpochLength = 15
+	topic.GroundTruthLag = topic.EpochLength
+	topic.WorkerSubmissionWindow = topic.EpochLength
+	err = s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+
+	updated := topic
+	updated.EpochLength = 20
+	updated.GroundTruthLag = 20
+	err = s.emissionsKeeper.SetPendingTopicUpdate(ctx, topic.Id, updated)
+	s.Require().NoError(err)
+
+	// Without stake nor fee revenue the topic falls below the min topic weight at its epoch boundary
+	block := int64(16)
+	ctx = s.ctx.WithBlockHeight(block)
+	_, _, _, err = rewards.GetAndUpdateActiveTopicWeights(ctx, s.emissionsKeeper, block)
+	s.Require().NoError(err)
+	isActive, err := s.emissionsKeeper.IsTopicActive(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().False(isActive)
+
+	stored, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(20), stored.EpochLength)
+	_, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *RewardsTestSuite) TestPendingTopicUpdateKeptWhenItFailsToApply() {
+	params := types.DefaultParams()
+	params.TopicRewardAlpha = alloraMath.MustNewDecFromString("0.5")
+	params.TopicRewardStakeImportance = alloraMath.OneDec()
+	params.TopicRewardFeeRevenueImportance = alloraMath.OneDec()
+	err := s.emissionsKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(err)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	topic := mockTopic(s)
+	topic.EpochLength = 15
+	topic.GroundTruthLag = topic.EpochLength
+	topic.WorkerSubmissionWindow = topic.EpochLength
+	err = s.emissionsKeeper.SetTopic(ctx, topic.Id, topic)
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.AddTopicFeeRevenue(ctx, topic.Id, cosmosMath.NewInt(1000))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.SetTopicStake(ctx, topic.Id, cosmosMath.NewInt(1000))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.ActivateTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+
+	updated := topic
+	updated.EpochLength = 20
+	updated.GroundTruthLag = 20
+	err = s.emissionsKeeper.SetPendingTopicUpdate(ctx, topic.Id, updated)
+	s.Require().NoError(err)
+
+	// The update no longer passes validation against the params by the epoch boundary
+	params.MinEpochLength = 25
+	err = s.emissionsKeeper.SetParams(ctx, params)
+	s.Require().NoError(err)
+
+	block := int64(16)
+	ctx = s.ctx.WithBlockHeight(block).WithEventManager(sdk.NewEventManager())
+	_, _, _, err = rewards.GetAndUpdateActiveTopicWeights(ctx, s.emissionsKeeper, block)
+	s.Require().NoError(err)
+
+	stored, err := s.emissionsKeeper.GetTopic(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(15), stored.EpochLength)
+	pending, found, err := s.emissionsKeeper.GetPendingTopicUpdate(ctx, topic.Id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(updated, pending)
+
+	failed := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "emissions.v4.EventTopicUpdateFailed" {
+			failed = true
+		}
+	}
+	s.Require().True(failed, "no topic update failed event emitted")
+}
+
 func (s *RewardsTestSuite) TestGetRewardAndRemovedRewardableTopics() {
 	block := int64(1)
 	s.ctx = s.ctx.WithBlockHeight(block)
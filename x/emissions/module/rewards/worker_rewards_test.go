@@ -1,6 +1,7 @@
 package rewards_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -25,6 +26,16 @@ func createNewTopic(s *RewardsTestSuite) uint64 {
 		ActiveForecasterQuantile: alloraMath.MustNewDecFromString("0.2"),
 		ActiveReputerQuantile:    alloraMath.MustNewDecFromString("0.2"),
 		AllowNegative:            false,
+		TypedMetadata:            nil,
+		ChainComputedLosses:      false,
+		OutputDimension:          0,
+		QuantileLevels:           nil,
+		GroundTruthSource:        nil,
+		CreatorCommission:        alloraMath.ZeroDec(),
+		WorkerRevealWindow:       0,
+		ReputerRevealWindow:      0,
+		MinWorkerBond:            cosmosMath.ZeroInt(),
+		ReputerOutlierThreshold:  alloraMath.ZeroDec(),
 	}
 	res, err := s.msgServer.CreateNewTopic(s.ctx, newTopicMsg)
 	s.Require().NoError(err)
@@ -642,6 +653,8 @@ func mockNetworkLosses(s *RewardsTestSuite, topicId uint64, block int64) (types.
 		OneOutForecasterValues:        oneOutForecasterLosses,
 		OneInForecasterValues:         oneInNaiveLosses,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	// Persist network losses
@@ -702,6 +715,8 @@ func mockSimpleNetworkLosses(
 		OneOutForecasterValues:        genericLossesWithheld,
 		OneInForecasterValues:         genericLosses,
 		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   nil,
+		GroundTruthValues:             nil,
 	}
 
 	err := s.emissionsKeeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, block, networkLosses)
@@ -148,3 +148,61 @@ func RemoveDelegateStakes(
 
 	return nil
 }
+
+// Remove all worker bonds that have been marked for removal up until this block.
+// Removals left over by the limit are processed in the following blocks.
+func RemoveWorkerBonds(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	limitToProcess uint64,
+) error {
+	removals, _, err := k.GetWorkerBondRemovalsUpUntilBlock(sdkCtx, currentBlock, limitToProcess)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get worker bond removals for block %d", currentBlock)
+	}
+	for _, bondRemoval := range removals {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+
+		// The removal is dropped before the bond is reduced so that it is not capped again
+		err = k.DeleteWorkerBondRemoval(cacheSdkCtx, bondRemoval.TopicId, bondRemoval.Worker)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error deleting worker bond removal: %v | %v",
+				bondRemoval,
+				err,
+			))
+			continue
+		}
+		err = k.RemoveWorkerBond(cacheSdkCtx, bondRemoval.TopicId, bondRemoval.Worker, bondRemoval.Amount)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error removing worker bond state: %v | %v",
+				bondRemoval,
+				err,
+			))
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(chainParams.DefaultBondDenom, bondRemoval.Amount))
+		err = k.SendCoinsFromModuleToAccount(
+			cacheSdkCtx,
+			emissionstypes.AlloraWorkerBondsAccountName,
+			bondRemoval.Worker,
+			coins,
+		)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error removing worker bond send funds: %v | %v",
+				bondRemoval,
+				err,
+			))
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
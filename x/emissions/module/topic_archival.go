@@ -0,0 +1,72 @@
+package module
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Carry archived topics through the end of their archival. Once the stake removals queued before a
+// topic was archived are due, the remaining stake of the topic is returned to its delegators and
+// reputers, the remaining escrow of its funding subscriptions to their funders, then all state of
+// the topic is pruned. The work done per block is bounded by
+// maxStakeReturns stake positions and maxPrunedKeys deleted entries, shared by all archived topics.
+func ProcessArchivedTopics(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	maxStakeReturns uint64,
+	maxPrunedKeys uint64,
+) error {
+	topicIds, err := k.GetArchivingTopicIds(sdkCtx)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get archiving topics at block %d", currentBlock)
+	}
+	for _, topicId := range topicIds {
+		archive, found, err := k.GetTopicArchive(sdkCtx, topicId)
+		if err != nil || !found {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error getting archive of topic %d: %v", topicId, err))
+			continue
+		}
+		if currentBlock < archive.StakeRemovalsDueBlockHeight {
+			continue
+		}
+
+		if !archive.StakeReturned {
+			processed, done, err := k.ReturnArchivedTopicStake(sdkCtx, topicId, maxStakeReturns)
+			if err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Error returning stake of archived topic %d: %v", topicId, err))
+				continue
+			}
+			maxStakeReturns -= processed
+			if !done {
+				continue
+			}
+			archive.StakeReturned = true
+			if err := k.SetTopicArchive(sdkCtx, topicId, archive); err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Error setting archive of topic %d: %v", topicId, err))
+				continue
+			}
+		}
+
+		deleted, done, err := k.PruneArchivedTopicState(sdkCtx, topicId, maxPrunedKeys)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error pruning state of archived topic %d: %v", topicId, err))
+			continue
+		}
+		maxPrunedKeys -= deleted
+		if !done {
+			continue
+		}
+		archive.Pruned = true
+		if err := k.SetTopicArchive(sdkCtx, topicId, archive); err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error setting archive of topic %d: %v", topicId, err))
+			continue
+		}
+		emissionstypes.EmitNewArchivedTopicPrunedEvent(sdkCtx, topicId, currentBlock)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package types
+
+// Number of epochs in a row an actor has missed as of the nonce at the reference block height,
+// counting from its last submission or from when it started being expected to submit, whichever is later
+func (liveness ActorLiveness) MissedEpochs(referenceBlock BlockHeight, epochLength BlockHeight) uint64 {
+	lastActive := liveness.LastSubmissionNonce
+	if liveness.ActiveSince > lastActive {
+		lastActive = liveness.ActiveSince
+	}
+	if epochLength <= 0 || referenceBlock <= lastActive {
+		return 0
+	}
+	return uint64((referenceBlock - lastActive) / epochLength)
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActorLivenessMissedEpochs(t *testing.T) {
+	tests := []struct {
+		name           string
+		liveness       types.ActorLiveness
+		referenceBlock int64
+		epochLength    int64
+		want           uint64
+	}{
+		{
+			name:           "submitted for the reference nonce",
+			liveness:       types.ActorLiveness{LastSubmissionNonce: 300, ActiveSince: 0, Jailed: false, JailedAtBlock: 0},
+			referenceBlock: 300,
+			epochLength:    100,
+			want:           0,
+		},
+		{
+			name:           "missed epochs since last submission",
+			liveness:       types.ActorLiveness{LastSubmissionNonce: 100, ActiveSince: 0, Jailed: false, JailedAtBlock: 0},
+			referenceBlock: 400,
+			epochLength:    100,
+			want:           3,
+		},
+		{
+			name:           "counts from the latest of last submission and active since",
+			liveness:       types.ActorLiveness{LastSubmissionNonce: 100, ActiveSince: 350, Jailed: false, JailedAtBlock: 0},
+			referenceBlock: 500,
+			epochLength:    100,
+			want:           1,
+		},
+		{
+			name:           "reference before last activity",
+			liveness:       types.ActorLiveness{LastSubmissionNonce: 0, ActiveSince: 500, Jailed: false, JailedAtBlock: 0},
+			referenceBlock: 400,
+			epochLength:    100,
+			want:           0,
+		},
+		{
+			name:           "no epoch length",
+			liveness:       types.ActorLiveness{LastSubmissionNonce: 0, ActiveSince: 0, Jailed: false, JailedAtBlock: 0},
+			referenceBlock: 400,
+			epochLength:    0,
+			want:           0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.liveness.MissedEpochs(tt.referenceBlock, tt.epochLength))
+		})
+	}
+}
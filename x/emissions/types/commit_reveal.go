@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Maximum length of the salt of a commitment, which only needs to be long enough not to be guessed
+const MaxCommitmentSaltLength = 64
+
+// True if workers of the topic commit to their payloads in the submission window and reveal them afterwards
+func (topic Topic) IsWorkerCommitReveal() bool {
+	return topic.WorkerRevealWindow > 0
+}
+
+// Hash a worker commits to for an inference forecast bundle: the sha256 of the
+// serialized bundle followed by the salt
+func WorkerPayloadCommitment(bundle *InferenceForecastBundle, salt []byte) ([]byte, error) {
+	if bundle == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "inference forecast bundle cannot be nil")
+	}
+	bz, err := bundle.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling inference forecast bundle")
+	}
+	hash := sha256.Sum256(append(bz, salt...))
+	return hash[:], nil
+}
+
+// True if the bundle revealed with the salt is the one the worker committed to
+func (commitment WorkerCommitment) Matches(bundle *InferenceForecastBundle, salt []byte) (bool, error) {
+	hash, err := WorkerPayloadCommitment(bundle, salt)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(hash, commitment.Commitment), nil
+}
+
+// validate that a worker commitment is for a valid topic, nonce and worker, and holds a sha256 hash
+func (commitment WorkerCommitment) Validate() error {
+	if err := ValidateTopicId(commitment.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBlockHeight(commitment.NonceBlockHeight); err != nil {
+		return errors.Wrap(err, "invalid nonce block height")
+	}
+	if _, err := sdk.AccAddressFromBech32(commitment.Worker); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid worker address (%s)", err)
+	}
+	if len(commitment.Commitment) != sha256.Size {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "commitment must be a %d bytes sha256 hash", sha256.Size)
+	}
+	return nil
+}
+
+// True if reputers of the topic commit to their loss bundles in the submission window and reveal them afterwards
+func (topic Topic) IsReputerCommitReveal() bool {
+	return topic.ReputerRevealWindow > 0
+}
+
+// Hash a reputer commits to for a value bundle: the sha256 of the serialized bundle followed by the salt
+func ReputerPayloadCommitment(bundle *ValueBundle, salt []byte) ([]byte, error) {
+	if bundle == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "value bundle cannot be nil")
+	}
+	bz, err := bundle.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling value bundle")
+	}
+	hash := sha256.Sum256(append(bz, salt...))
+	return hash[:], nil
+}
+
+// True if the bundle revealed with the salt is the one the reputer committed to
+func (commitment ReputerCommitment) Matches(bundle *ValueBundle, salt []byte) (bool, error) {
+	hash, err := ReputerPayloadCommitment(bundle, salt)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(hash, commitment.Commitment), nil
+}
+
+// validate that a reputer commitment is for a valid topic, nonce and reputer, and holds a sha256 hash
+func (commitment ReputerCommitment) Validate() error {
+	if err := ValidateTopicId(commitment.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBlockHeight(commitment.NonceBlockHeight); err != nil {
+		return errors.Wrap(err, "invalid nonce block height")
+	}
+	if _, err := sdk.AccAddressFromBech32(commitment.Reputer); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reputer address (%s)", err)
+	}
+	if len(commitment.Commitment) != sha256.Size {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "commitment must be a %d bytes sha256 hash", sha256.Size)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package types
+
+import "fmt"
+
+// Prefix of the bank denoms of the receipts of delegate stake receipt pools
+const DelegateStakeReceiptDenomPrefix = "delegatestake"
+
+// Bank denom of the receipts of a delegate stake receipt pool
+func DelegateStakeReceiptDenom(poolId uint64) string {
+	return fmt.Sprintf("%s/%d", DelegateStakeReceiptDenomPrefix, poolId)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Aggregations of the network inferences of a parent topic giving the ground truth of a derived topic
+const (
+	GroundTruthAggregationValueAtBlock = "value_at_block"
+	GroundTruthAggregationDifference   = "difference"
+	GroundTruthAggregationRatio        = "ratio"
+)
+
+// Maximum number of ancestors of a derived topic, bounding the walk that checks the topic dependency graph for cycles
+const MaxDerivedTopicDepth = 16
+
+// True if the ground truth of the topic is computed from the network inferences of another topic
+func (topic Topic) IsDerived() bool {
+	return topic.GroundTruthSource != nil
+}
+
+// validate that a ground truth source names a parent topic and a known aggregation
+func (source *GroundTruthSource) Validate() error {
+	if source == nil {
+		return errors.Wrap(ErrInvalidGroundTruthSource, "ground truth source cannot be nil")
+	}
+	if err := ValidateTopicId(source.ParentTopicId); err != nil {
+		return errors.Wrap(ErrInvalidGroundTruthSource, "invalid parent topic id")
+	}
+	switch source.Aggregation {
+	case GroundTruthAggregationValueAtBlock, GroundTruthAggregationDifference, GroundTruthAggregationRatio:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidGroundTruthSource, "unknown aggregation %q", source.Aggregation)
+	}
+}
+
+// Computes the ground truth of a derived topic from the combined network inferences of its parent
+// at the nonce of the derived inferences and once their ground truth lag has passed
+func (source *GroundTruthSource) Aggregate(valueAtNonce, valueAfterLag alloraMath.Dec) (alloraMath.Dec, error) {
+	switch source.Aggregation {
+	case GroundTruthAggregationValueAtBlock:
+		return valueAfterLag, nil
+	case GroundTruthAggregationDifference:
+		return valueAfterLag.Sub(valueAtNonce)
+	case GroundTruthAggregationRatio:
+		if valueAtNonce.IsZero() {
+			return alloraMath.Dec{}, errors.Wrap(ErrInvalidGroundTruthSource, "ratio to a zero network inference")
+		}
+		return valueAfterLag.Quo(valueAtNonce)
+	default:
+		return alloraMath.Dec{}, errors.Wrapf(ErrInvalidGroundTruthSource, "unknown aggregation %q", source.Aggregation)
+	}
+}
+
+// validate the ground truth source of a topic, if any, against the other settings of the topic.
+// The chain computes the losses of derived topics, so they must use a registered loss method, or a
+// quantile loss method if probabilistic, and their ground truth is a single value.
+func validateTopicGroundTruthSource(source *GroundTruthSource, lossMethod string, outputDimension uint64, quantileLevels []alloraMath.Dec) error {
+	if source == nil {
+		return nil
+	}
+	if err := source.Validate(); err != nil {
+		return err
+	}
+	if len(quantileLevels) == 0 && !IsRegisteredLossMethod(lossMethod) {
+		return errors.Wrap(ErrUnregisteredLossMethod, "derived topics require a registered loss method")
+	}
+	if outputDimension > 1 && len(quantileLevels) == 0 {
+		return errors.Wrap(ErrInvalidGroundTruthSource, "derived topics cannot have several outputs other than quantiles")
+	}
+	return nil
+}
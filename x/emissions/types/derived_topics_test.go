@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroundTruthSourceValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *types.GroundTruthSource
+		valid  bool
+	}{
+		{"nil source", nil, false},
+		{"missing parent", &types.GroundTruthSource{ParentTopicId: 0, Aggregation: types.GroundTruthAggregationRatio}, false},
+		{"unknown aggregation", &types.GroundTruthSource{ParentTopicId: 1, Aggregation: "median"}, false},
+		{"value at block", &types.GroundTruthSource{ParentTopicId: 1, Aggregation: types.GroundTruthAggregationValueAtBlock}, true},
+		{"difference", &types.GroundTruthSource{ParentTopicId: 1, Aggregation: types.GroundTruthAggregationDifference}, true},
+		{"ratio", &types.GroundTruthSource{ParentTopicId: 1, Aggregation: types.GroundTruthAggregationRatio}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.source.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidGroundTruthSource)
+			}
+		})
+	}
+}
+
+func TestGroundTruthSourceAggregate(t *testing.T) {
+	atNonce := alloraMath.NewDecFromInt64(4)
+	afterLag := alloraMath.NewDecFromInt64(10)
+
+	tests := []struct {
+		aggregation string
+		expected    alloraMath.Dec
+	}{
+		{types.GroundTruthAggregationValueAtBlock, alloraMath.NewDecFromInt64(10)},
+		{types.GroundTruthAggregationDifference, alloraMath.NewDecFromInt64(6)},
+		{types.GroundTruthAggregationRatio, alloraMath.MustNewDecFromString("2.5")},
+	}
+	for _, tc := range tests {
+		source := &types.GroundTruthSource{ParentTopicId: 1, Aggregation: tc.aggregation}
+		result, err := source.Aggregate(atNonce, afterLag)
+		require.NoError(t, err)
+		require.True(t, tc.expected.Equal(result), "%s: expected %s, got %s", tc.aggregation, tc.expected, result)
+	}
+
+	source := &types.GroundTruthSource{ParentTopicId: 1, Aggregation: types.GroundTruthAggregationRatio}
+	_, err := source.Aggregate(alloraMath.ZeroDec(), afterLag)
+	require.ErrorIs(t, err, types.ErrInvalidGroundTruthSource)
+}
@@ -83,4 +83,47 @@ var (
 	ErrCantUpdateEmaMoreThanOncePerWindow    = errors.Register(ModuleName, 78, "cannot update EMA more than once per window")
 	ErrNoInferencesToInsert                  = errors.Register(ModuleName, 79, "no inferences to insert")
 	ErrNoQualifiedInferers                   = errors.Register(ModuleName, 80, "no qualified inferers found for this topic")
+	ErrNotTopicCreatorOrAdmin                = errors.Register(ModuleName, 81, "not topic creator or whitelist admin")
+	ErrTopicArchived                         = errors.Register(ModuleName, 82, "topic is archived")
+	ErrNotInWorkerWhitelist                  = errors.Register(ModuleName, 83, "not in worker whitelist")
+	ErrNotTopicCreator                       = errors.Register(ModuleName, 84, "not topic creator")
+	ErrUnregisteredLossMethod                = errors.Register(ModuleName, 85, "loss method not registered")
+	ErrInvalidChainComputedLossBundle        = errors.Register(ModuleName, 86, "invalid value bundle for a topic with chain computed losses")
+	ErrGroundTruthNotAccepted                = errors.Register(ModuleName, 87, "ground truth only accepted for topics with chain computed losses")
+	ErrInvalidOutputDimension                = errors.Register(ModuleName, 88, "invalid output dimension")
+	ErrInvalidQuantileLevels                 = errors.Register(ModuleName, 89, "invalid quantile levels")
+	ErrCrossingQuantiles                     = errors.Register(ModuleName, 90, "quantile values must not decrease with their level")
+	ErrInvalidGroundTruthSource              = errors.Register(ModuleName, 91, "invalid ground truth source")
+	ErrTopicDependencyCycle                  = errors.Register(ModuleName, 92, "topic dependencies must not form a cycle")
+	ErrDerivedTopic                          = errors.Register(ModuleName, 93, "losses of derived topics are computed by the chain")
+	ErrTopicHasDerivedTopics                 = errors.Register(ModuleName, 94, "topic is the ground truth source of other topics")
+	ErrFundingSubscriptionNotFound           = errors.Register(ModuleName, 95, "funding subscription not found")
+	ErrNotFundingSubscriptionFunder          = errors.Register(ModuleName, 96, "not the funder of the funding subscription")
+	ErrInvalidFundingSubscription            = errors.Register(ModuleName, 97, "invalid funding subscription")
+	ErrInvalidCreatorCommission              = errors.Register(ModuleName, 98, "invalid topic creator commission")
+	ErrNotNodeOwner                          = errors.Register(ModuleName, 99, "sender is not the owner of the node")
+	ErrNodeRotationCooldown                  = errors.Register(ModuleName, 100, "node was moved to its address too recently")
+	ErrNodeRotationBlocked                   = errors.Register(ModuleName, 101, "node cannot be moved to the new address")
+	ErrCommitRevealRequired                  = errors.Register(ModuleName, 102, "topic requires payloads to be committed before being revealed")
+	ErrCommitRevealNotEnabled                = errors.Register(ModuleName, 103, "topic does not use commit-reveal")
+	ErrCommitmentNotFound                    = errors.Register(ModuleName, 104, "no commitment found for the payload")
+	ErrCommitmentMismatch                    = errors.Register(ModuleName, 105, "revealed payload does not match its commitment")
+	ErrCommitmentExists                      = errors.Register(ModuleName, 106, "payload already committed for this nonce")
+	ErrRevealWindowNotAvailable              = errors.Register(ModuleName, 107, "reveal window not available")
+	ErrInsufficientWorkerBond                = errors.Register(ModuleName, 108, "worker bond is lower than the minimum worker bond of the topic")
+	ErrInsufficientWorkerBondToRemove        = errors.Register(ModuleName, 109, "insufficient worker bond to remove")
+	ErrWorkerBondRemovalNotFound             = errors.Register(ModuleName, 110, "worker bond removal not found")
+	ErrActorJailed                           = errors.Register(ModuleName, 111, "actor is jailed from this topic")
+	ErrActorNotJailed                        = errors.Register(ModuleName, 112, "actor is not jailed from this topic")
+	ErrValidationDeregisterBeforeJail        = errors.Register(ModuleName, 113, "actors must be jailed before being deregistered")
+	ErrInvalidReputerCommission              = errors.Register(ModuleName, 114, "invalid reputer commission")
+	ErrReputerCommissionExists               = errors.Register(ModuleName, 115, "reputer commission already created for this topic")
+	ErrReputerCommissionNotFound             = errors.Register(ModuleName, 116, "reputer commission not found")
+	ErrReputerCommissionUpdateCooldown       = errors.Register(ModuleName, 117, "reputer commission rate was changed too recently")
+	ErrRedelegationNotMatured                = errors.Register(ModuleName, 118, "stake redelegated to this reputer has not matured yet")
+	ErrDelegateStakeReceiptPoolNotFound      = errors.Register(ModuleName, 119, "delegate stake receipt pool not found")
+	ErrDelegateStakeReceiptPoolDepleted      = errors.Register(ModuleName, 120, "delegate stake receipt pool has receipts but nothing left to back them")
+	ErrDelegateStakeExceedsReputerCap        = errors.Register(ModuleName, 121, "stake delegated upon reputer would exceed the max delegate stake per reputer")
+	ErrDelegateStakeExceedsSelfStakeRatio    = errors.Register(ModuleName, 122, "stake delegated upon reputer would exceed the max ratio to the reputer's own stake")
+	ErrNoDelegationToAutoCompound            = errors.Register(ModuleName, 123, "delegator has no delegation to auto-compound the rewards of")
 )
@@ -83,6 +83,17 @@ func EmitNewReputerAndDelegatorRewardsSettledEvent(ctx sdk.Context, blockHeight
 	}
 }
 
+func EmitNewTopicCreatorRewardsSettledEvent(ctx sdk.Context, blockHeight BlockHeight, rewards []TaskReward) {
+	if len(rewards) < 1 {
+		return
+	}
+	metrics.IncrProducerEventCount(metrics.TOPIC_CREATOR_REWARD_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewRewardsSetEventBase(ActorType_ACTOR_TYPE_TOPIC_CREATOR, blockHeight, rewards))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicCreatorRewardsSettledEvent: ", err.Error())
+	}
+}
+
 func EmitNewWorkerLastCommitSetEvent(ctx sdk.Context, topicId TopicId, height BlockHeight, nonce *Nonce) {
 	metrics.IncrProducerEventCount(metrics.WORKER_LAST_COMMIT_EVENT)
 	err := ctx.EventManager().EmitTypedEvent(NewWorkerLastCommitSetEventBase(topicId, height, nonce))
@@ -126,6 +137,62 @@ func EmitNewActorEMAScoresSetEvent(ctx sdk.Context, actorType ActorType, scores
 	}
 }
 
+func EmitNewTopicUpdatedEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight, oldTopic Topic, newTopic Topic) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_UPDATED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicUpdatedEventBase(topicId, blockHeight, oldTopic, newTopic))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicUpdatedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicUpdateFailedEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight, reason string) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_UPDATE_FAILED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicUpdateFailedEventBase(topicId, blockHeight, reason))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicUpdateFailedEvent: ", err.Error())
+	}
+}
+
+func EmitNewTopicArchivedEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_ARCHIVED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewTopicArchivedEventBase(topicId, blockHeight))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewTopicArchivedEvent: ", err.Error())
+	}
+}
+
+func EmitNewArchivedTopicPrunedEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight) {
+	metrics.IncrProducerEventCount(metrics.TOPIC_PRUNED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewArchivedTopicPrunedEventBase(topicId, blockHeight))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewArchivedTopicPrunedEvent: ", err.Error())
+	}
+}
+
+func EmitNewReputerSlashedEvent(ctx sdk.Context, slash ReputerSlash) {
+	metrics.IncrProducerEventCount(metrics.REPUTER_SLASHED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewReputerSlashedEventBase(slash))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewReputerSlashedEvent: ", err.Error())
+	}
+}
+
+func EmitNewActorJailedEvent(ctx sdk.Context, topicId TopicId, actor string, isReputer bool, missedEpochs uint64) {
+	metrics.IncrProducerEventCount(metrics.ACTOR_JAILED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewActorJailedEventBase(topicId, actor, isReputer, missedEpochs))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewActorJailedEvent: ", err.Error())
+	}
+}
+
+func EmitNewActorDeregisteredEvent(ctx sdk.Context, topicId TopicId, actor string, isReputer bool, missedEpochs uint64) {
+	metrics.IncrProducerEventCount(metrics.ACTOR_DEREGISTERED_EVENT)
+	err := ctx.EventManager().EmitTypedEvent(NewActorDeregisteredEventBase(topicId, actor, isReputer, missedEpochs))
+	if err != nil {
+		ctx.Logger().Warn("Error emitting NewActorDeregisteredEvent: ", err.Error())
+	}
+}
+
 /// Utils
 
 // Assumes length of `scores` is at least 1
@@ -229,3 +296,58 @@ func NewEMAScoresSetEventBase(actorType ActorType, scores []Score, activations m
 		IsActive:  activeArr,
 	}
 }
+
+func NewTopicUpdatedEventBase(topicId TopicId, blockHeight BlockHeight, oldTopic Topic, newTopic Topic) proto.Message {
+	return &EventTopicUpdated{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		OldTopic:    &oldTopic,
+		NewTopic:    &newTopic,
+	}
+}
+
+func NewTopicUpdateFailedEventBase(topicId TopicId, blockHeight BlockHeight, reason string) proto.Message {
+	return &EventTopicUpdateFailed{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Reason:      reason,
+	}
+}
+
+func NewTopicArchivedEventBase(topicId TopicId, blockHeight BlockHeight) proto.Message {
+	return &EventTopicArchived{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+	}
+}
+
+func NewArchivedTopicPrunedEventBase(topicId TopicId, blockHeight BlockHeight) proto.Message {
+	return &EventArchivedTopicPruned{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+	}
+}
+
+func NewReputerSlashedEventBase(slash ReputerSlash) proto.Message {
+	return &EventReputerSlashed{
+		Slash: &slash,
+	}
+}
+
+func NewActorJailedEventBase(topicId TopicId, actor string, isReputer bool, missedEpochs uint64) proto.Message {
+	return &EventActorJailed{
+		TopicId:      topicId,
+		Actor:        actor,
+		IsReputer:    isReputer,
+		MissedEpochs: missedEpochs,
+	}
+}
+
+func NewActorDeregisteredEventBase(topicId TopicId, actor string, isReputer bool, missedEpochs uint64) proto.Message {
+	return &EventActorDeregistered{
+		TopicId:      topicId,
+		Actor:        actor,
+		IsReputer:    isReputer,
+		MissedEpochs: missedEpochs,
+	}
+}
@@ -30,18 +30,21 @@ const (
 	ActorType_ACTOR_TYPE_INFERER_UNSPECIFIED ActorType = 0
 	ActorType_ACTOR_TYPE_FORECASTER          ActorType = 1
 	ActorType_ACTOR_TYPE_REPUTER             ActorType = 2
+	ActorType_ACTOR_TYPE_TOPIC_CREATOR       ActorType = 3
 )
 
 var ActorType_name = map[int32]string{
 	0: "ACTOR_TYPE_INFERER_UNSPECIFIED",
 	1: "ACTOR_TYPE_FORECASTER",
 	2: "ACTOR_TYPE_REPUTER",
+	3: "ACTOR_TYPE_TOPIC_CREATOR",
 }
 
 var ActorType_value = map[string]int32{
 	"ACTOR_TYPE_INFERER_UNSPECIFIED": 0,
 	"ACTOR_TYPE_FORECASTER":          1,
 	"ACTOR_TYPE_REPUTER":             2,
+	"ACTOR_TYPE_TOPIC_CREATOR":       3,
 }
 
 func (x ActorType) String() string {
@@ -537,271 +540,499 @@ func (m *EventEMAScoresSet) GetIsActive() []bool {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("emissions.v4.ActorType", ActorType_name, ActorType_value)
-	proto.RegisterType((*EventScoresSet)(nil), "emissions.v4.EventScoresSet")
-	proto.RegisterType((*EventRewardsSettled)(nil), "emissions.v4.EventRewardsSettled")
-	proto.RegisterType((*EventNetworkLossSet)(nil), "emissions.v4.EventNetworkLossSet")
-	proto.RegisterType((*EventForecastTaskScoreSet)(nil), "emissions.v4.EventForecastTaskScoreSet")
-	proto.RegisterType((*EventWorkerLastCommitSet)(nil), "emissions.v4.EventWorkerLastCommitSet")
-	proto.RegisterType((*EventReputerLastCommitSet)(nil), "emissions.v4.EventReputerLastCommitSet")
-	proto.RegisterType((*EventTopicRewardsSet)(nil), "emissions.v4.EventTopicRewardsSet")
-	proto.RegisterType((*EventEMAScoresSet)(nil), "emissions.v4.EventEMAScoresSet")
+type EventTopicUpdated struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	OldTopic    *Topic `protobuf:"bytes,3,opt,name=old_topic,json=oldTopic,proto3" json:"old_topic,omitempty"`
+	NewTopic    *Topic `protobuf:"bytes,4,opt,name=new_topic,json=newTopic,proto3" json:"new_topic,omitempty"`
 }
 
-func init() { proto.RegisterFile("emissions/v4/events.proto", fileDescriptor_c5fe366c0cfb6cf8) }
-
-var fileDescriptor_c5fe366c0cfb6cf8 = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6f, 0xd3, 0x3e,
-	0x18, 0xae, 0xdb, 0x7d, 0xb4, 0xde, 0x34, 0xed, 0x97, 0xed, 0x07, 0xe9, 0x40, 0x59, 0xc9, 0xa9,
-	0x20, 0x91, 0x48, 0xdb, 0x04, 0x1c, 0xb8, 0x74, 0x5d, 0x2a, 0x2a, 0x6d, 0xdd, 0x70, 0x33, 0x10,
-	0x5c, 0x22, 0x37, 0xb1, 0xda, 0xa8, 0x69, 0x5c, 0xd9, 0x6e, 0xc6, 0xce, 0x7c, 0x88, 0x23, 0xfc,
-	0x57, 0x3b, 0xee, 0x88, 0x38, 0x4c, 0x68, 0xfb, 0x1b, 0xb8, 0x71, 0x40, 0x71, 0x9a, 0x35, 0x13,
-	0x12, 0x20, 0x95, 0xaf, 0x9b, 0xdf, 0xf7, 0x79, 0xf4, 0xe6, 0x79, 0x1e, 0xdb, 0x31, 0x2c, 0x93,
-	0x81, 0xcf, 0xb9, 0x4f, 0x43, 0x6e, 0x46, 0x5b, 0x26, 0x89, 0x48, 0x28, 0xb8, 0x31, 0x64, 0x54,
-	0x50, 0x65, 0xf1, 0x12, 0x32, 0xa2, 0xad, 0x35, 0x35, 0x43, 0xdc, 0x34, 0x43, 0x1a, 0xba, 0x24,
-	0xe1, 0xad, 0xad, 0x5d, 0x41, 0x18, 0x19, 0x8e, 0x04, 0x61, 0x63, 0x6c, 0xb5, 0x4b, 0xbb, 0x54,
-	0x2e, 0xcd, 0x78, 0x95, 0x74, 0xf5, 0xcf, 0x00, 0x2e, 0x59, 0xf1, 0xa7, 0xda, 0x2e, 0x65, 0x84,
-	0xb7, 0x89, 0x50, 0xee, 0x41, 0x88, 0x5d, 0x41, 0x99, 0x23, 0x8e, 0x87, 0x44, 0x05, 0x15, 0x50,
-	0x5d, 0xda, 0xb8, 0x6e, 0x64, 0x15, 0x18, 0xb5, 0x18, 0xb7, 0x8f, 0x87, 0x04, 0x95, 0x70, 0xba,
-	0x54, 0xca, 0xb0, 0x28, 0xe8, 0xd0, 0x77, 0x1d, 0xdf, 0x53, 0xf3, 0x15, 0x50, 0x9d, 0x41, 0xf3,
-	0xb2, 0x6e, 0x7a, 0xca, 0x2d, 0xb8, 0xd8, 0x09, 0xa8, 0xdb, 0x77, 0x7a, 0xc4, 0xef, 0xf6, 0x84,
-	0x5a, 0xa8, 0x80, 0x6a, 0x01, 0x2d, 0xc8, 0xde, 0x23, 0xd9, 0x52, 0x6e, 0xc2, 0x12, 0xf6, 0x3c,
-	0x46, 0x38, 0x27, 0x5c, 0x9d, 0xa9, 0x14, 0xaa, 0x25, 0x34, 0x69, 0x28, 0xfb, 0x70, 0x8e, 0x4b,
-	0x81, 0xea, 0x6c, 0x0c, 0x6d, 0xdf, 0x3f, 0x39, 0x5b, 0xcf, 0x7d, 0x3c, 0x5b, 0x37, 0xbb, 0xbe,
-	0xe8, 0x8d, 0x3a, 0x86, 0x4b, 0x07, 0x26, 0x0e, 0x02, 0xca, 0xf0, 0xdd, 0x90, 0x88, 0x23, 0xca,
-	0xfa, 0x69, 0xe9, 0xf6, 0xb0, 0x1f, 0x9a, 0x03, 0x2c, 0x7a, 0xc6, 0x0e, 0x71, 0xd1, 0x78, 0x8c,
-	0xfe, 0x05, 0xc0, 0x15, 0xe9, 0x1b, 0x91, 0x23, 0xcc, 0xbc, 0xd8, 0xb8, 0x08, 0x88, 0xf7, 0x4f,
-	0x9a, 0x7f, 0x0c, 0xe7, 0x59, 0xa2, 0x72, 0x5a, 0xf7, 0xe9, 0x1c, 0xfd, 0x7d, 0x6a, 0xbf, 0x95,
-	0xf0, 0x77, 0x29, 0x97, 0x7b, 0x9f, 0xb5, 0x01, 0xbe, 0x6f, 0x23, 0xff, 0xad, 0x8d, 0x87, 0x70,
-	0x31, 0xc2, 0xc1, 0x88, 0x38, 0x9d, 0x51, 0xe8, 0x05, 0x44, 0x3a, 0x5d, 0xd8, 0x28, 0x67, 0xe3,
-	0xdb, 0x34, 0x9e, 0xc4, 0x8c, 0x6d, 0x49, 0x40, 0x0b, 0xd1, 0xa4, 0xd0, 0x5f, 0x03, 0x58, 0x96,
-	0x9a, 0x1a, 0x94, 0x11, 0x17, 0x73, 0x61, 0x63, 0xde, 0x97, 0xc7, 0xf2, 0x07, 0xca, 0xf6, 0xe0,
-	0xac, 0xdc, 0x55, 0x29, 0x69, 0x8a, 0x74, 0x92, 0x29, 0xfa, 0x4b, 0x00, 0x55, 0xa9, 0xe3, 0x29,
-	0x65, 0x7d, 0xc2, 0x76, 0x31, 0x17, 0x75, 0x3a, 0x18, 0xf8, 0x62, 0xfa, 0x80, 0x6e, 0xc3, 0x59,
-	0x79, 0x5d, 0xc7, 0xc9, 0xac, 0x5c, 0x4d, 0xa6, 0x15, 0x43, 0x28, 0x61, 0xe8, 0xaf, 0xd2, 0x34,
-	0x50, 0x72, 0x8b, 0xff, 0x92, 0x8c, 0x37, 0x00, 0xae, 0x4a, 0x19, 0x76, 0x3c, 0x7e, 0x72, 0x59,
-	0x94, 0x1b, 0xb0, 0x94, 0x2a, 0xe0, 0x2a, 0xa8, 0x14, 0xaa, 0x33, 0xa8, 0x38, 0x96, 0x70, 0xe5,
-	0xc4, 0xe6, 0x7f, 0xd1, 0x89, 0x7d, 0x9b, 0x87, 0xff, 0x49, 0x21, 0xd6, 0x5e, 0xed, 0xb7, 0xfe,
-	0xab, 0x56, 0xb3, 0xe1, 0x14, 0xc6, 0x39, 0xfc, 0xe1, 0xdf, 0x53, 0x9c, 0xae, 0xcf, 0x1d, 0xec,
-	0x0a, 0x3f, 0x22, 0xea, 0x5c, 0xa5, 0x50, 0x2d, 0xa2, 0xa2, 0xcf, 0x6b, 0xb2, 0xbe, 0xd3, 0x81,
-	0xa5, 0x4b, 0x53, 0x8a, 0x0e, 0xb5, 0x5a, 0xdd, 0xde, 0x47, 0x8e, 0xfd, 0xec, 0xc0, 0x72, 0x9a,
-	0xad, 0x86, 0x85, 0x2c, 0xe4, 0x1c, 0xb6, 0xda, 0x07, 0x56, 0xbd, 0xd9, 0x68, 0x5a, 0x3b, 0xcb,
-	0x39, 0xa5, 0x0c, 0xff, 0xcf, 0x70, 0x1a, 0xfb, 0xc8, 0xaa, 0xd7, 0xda, 0xb6, 0x85, 0x96, 0x81,
-	0x72, 0x0d, 0x2a, 0x19, 0x08, 0x59, 0x07, 0x87, 0x71, 0x3f, 0xbf, 0x8d, 0x4e, 0xce, 0x35, 0x70,
-	0x7a, 0xae, 0x81, 0x4f, 0xe7, 0x1a, 0x78, 0x77, 0xa1, 0xe5, 0x4e, 0x2f, 0xb4, 0xdc, 0x87, 0x0b,
-	0x2d, 0xf7, 0xfc, 0xc1, 0x4f, 0x7a, 0x7a, 0x61, 0x4e, 0x1e, 0xa3, 0x78, 0x77, 0x78, 0x67, 0x4e,
-	0x3e, 0x39, 0x9b, 0x5f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x69, 0x9e, 0x85, 0x59, 0xe9, 0x06, 0x00,
-	0x00,
+func (m *EventTopicUpdated) Reset()         { *m = EventTopicUpdated{} }
+func (m *EventTopicUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventTopicUpdated) ProtoMessage()    {}
+func (*EventTopicUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fe366c0cfb6cf8, []int{8}
 }
-
-func (m *EventScoresSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventScoresSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTopicUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicUpdated.Merge(m, src)
+}
+func (m *EventTopicUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicUpdated.DiscardUnknown(m)
 }
 
-func (m *EventScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Scores) > 0 {
-		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Scores[iNdEx].Size()
-				i -= size
-				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventTopicUpdated proto.InternalMessageInfo
+
+func (m *EventTopicUpdated) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventTopicUpdated) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventTopicUpdated) GetOldTopic() *Topic {
+	if m != nil {
+		return m.OldTopic
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventRewardsSettled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicUpdated) GetNewTopic() *Topic {
+	if m != nil {
+		return m.NewTopic
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventRewardsSettled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTopicArchived struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *EventRewardsSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Rewards[iNdEx].Size()
-				i -= size
-				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *EventTopicArchived) Reset()         { *m = EventTopicArchived{} }
+func (m *EventTopicArchived) String() string { return proto.CompactTextString(m) }
+func (*EventTopicArchived) ProtoMessage()    {}
+func (*EventTopicArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fe366c0cfb6cf8, []int{9}
+}
+func (m *EventTopicArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventTopicArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicArchived.Merge(m, src)
+}
+func (m *EventTopicArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicArchived proto.InternalMessageInfo
+
+func (m *EventTopicArchived) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventNetworkLossSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicArchived) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventNetworkLossSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventArchivedTopicPruned struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValueBundle != nil {
-		{
-			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventArchivedTopicPruned) Reset()         { *m = EventArchivedTopicPruned{} }
+func (m *EventArchivedTopicPruned) String() string { return proto.CompactTextString(m) }
+func (*EventArchivedTopicPruned) ProtoMessage()    {}
+func (*EventArchivedTopicPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fe366c0cfb6cf8, []int{10}
+}
+func (m *EventArchivedTopicPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventArchivedTopicPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventArchivedTopicPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventArchivedTopicPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventArchivedTopicPruned.Merge(m, src)
+}
+func (m *EventArchivedTopicPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventArchivedTopicPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventArchivedTopicPruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventArchivedTopicPruned proto.InternalMessageInfo
+
+func (m *EventArchivedTopicPruned) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventForecastTaskScoreSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventArchivedTopicPruned) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventForecastTaskScoreSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventReputerSlashed struct {
+	Slash *ReputerSlash `protobuf:"bytes,1,opt,name=slash,proto3" json:"slash,omitempty"`
 }
 
-func (m *EventForecastTaskScoreSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Score.Size()
-		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventReputerSlashed) Reset()         { *m = EventReputerSlashed{} }
+func (m *EventReputerSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventReputerSlashed) ProtoMessage()    {}
+func (*EventReputerSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fe366c0cfb6cf8, []int{11}
+}
+func (m *EventReputerSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReputerSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReputerSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventReputerSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReputerSlashed.Merge(m, src)
+}
+func (m *EventReputerSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReputerSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReputerSlashed.DiscardUnknown(m)
 }
 
-func (m *EventWorkerLastCommitSet) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_EventReputerSlashed proto.InternalMessageInfo
+
+func (m *EventReputerSlashed) GetSlash() *ReputerSlash {
+	if m != nil {
+		return m.Slash
+	}
+	return nil
+}
+
+type EventActorJailed struct {
+	TopicId      uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Actor        string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	IsReputer    bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	MissedEpochs uint64 `protobuf:"varint,4,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
+}
+
+func (m *EventActorJailed) Reset()         { *m = EventActorJailed{} }
+func (m *EventActorJailed) String() string { return proto.CompactTextString(m) }
+func (*EventActorJailed) ProtoMessage()    {}
+func (*EventActorJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fe366c0cfb6cf8, []int{12}
+}
+func (m *EventActorJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventActorJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActorJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventActorJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActorJailed.Merge(m, src)
+}
+func (m *EventActorJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventActorJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActorJailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActorJailed proto.InternalMessageInfo
+
+func (m *EventActorJailed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventActorJailed) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *EventActorJailed) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+func (m *EventActorJailed) GetMissedEpochs() uint64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
+}
+
+type EventActorDeregistered struct {
+	TopicId      uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Actor        string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	IsReputer    bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	MissedEpochs uint64 `protobuf:"varint,4,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
+}
+
+func (m *EventActorDeregistered) Reset()         { *m = EventActorDeregistered{} }
+func (m *EventActorDeregistered) String() string { return proto.CompactTextString(m) }
+func (*EventActorDeregistered) ProtoMessage()    {}
+func (*EventActorDeregistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fe366c0cfb6cf8, []int{13}
+}
+func (m *EventActorDeregistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventActorDeregistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActorDeregistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventActorDeregistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActorDeregistered.Merge(m, src)
+}
+func (m *EventActorDeregistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventActorDeregistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActorDeregistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActorDeregistered proto.InternalMessageInfo
+
+func (m *EventActorDeregistered) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventActorDeregistered) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *EventActorDeregistered) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+func (m *EventActorDeregistered) GetMissedEpochs() uint64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
+}
+
+type EventTopicUpdateFailed struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventTopicUpdateFailed) Reset()         { *m = EventTopicUpdateFailed{} }
+func (m *EventTopicUpdateFailed) String() string { return proto.CompactTextString(m) }
+func (*EventTopicUpdateFailed) ProtoMessage()    {}
+func (*EventTopicUpdateFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5fe366c0cfb6cf8, []int{14}
+}
+func (m *EventTopicUpdateFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicUpdateFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicUpdateFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTopicUpdateFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicUpdateFailed.Merge(m, src)
+}
+func (m *EventTopicUpdateFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicUpdateFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicUpdateFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicUpdateFailed proto.InternalMessageInfo
+
+func (m *EventTopicUpdateFailed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventTopicUpdateFailed) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventTopicUpdateFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("emissions.v4.ActorType", ActorType_name, ActorType_value)
+	proto.RegisterType((*EventScoresSet)(nil), "emissions.v4.EventScoresSet")
+	proto.RegisterType((*EventRewardsSettled)(nil), "emissions.v4.EventRewardsSettled")
+	proto.RegisterType((*EventNetworkLossSet)(nil), "emissions.v4.EventNetworkLossSet")
+	proto.RegisterType((*EventForecastTaskScoreSet)(nil), "emissions.v4.EventForecastTaskScoreSet")
+	proto.RegisterType((*EventWorkerLastCommitSet)(nil), "emissions.v4.EventWorkerLastCommitSet")
+	proto.RegisterType((*EventReputerLastCommitSet)(nil), "emissions.v4.EventReputerLastCommitSet")
+	proto.RegisterType((*EventTopicRewardsSet)(nil), "emissions.v4.EventTopicRewardsSet")
+	proto.RegisterType((*EventEMAScoresSet)(nil), "emissions.v4.EventEMAScoresSet")
+	proto.RegisterType((*EventTopicUpdated)(nil), "emissions.v4.EventTopicUpdated")
+	proto.RegisterType((*EventTopicArchived)(nil), "emissions.v4.EventTopicArchived")
+	proto.RegisterType((*EventArchivedTopicPruned)(nil), "emissions.v4.EventArchivedTopicPruned")
+	proto.RegisterType((*EventReputerSlashed)(nil), "emissions.v4.EventReputerSlashed")
+	proto.RegisterType((*EventActorJailed)(nil), "emissions.v4.EventActorJailed")
+	proto.RegisterType((*EventActorDeregistered)(nil), "emissions.v4.EventActorDeregistered")
+	proto.RegisterType((*EventTopicUpdateFailed)(nil), "emissions.v4.EventTopicUpdateFailed")
+}
+
+func init() { proto.RegisterFile("emissions/v4/events.proto", fileDescriptor_c5fe366c0cfb6cf8) }
+
+var fileDescriptor_c5fe366c0cfb6cf8 = []byte{
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xc0, 0x3d, 0xfe, 0x48, 0xed, 0x97, 0x50, 0xa5, 0x9b, 0x10, 0xd6, 0xa1, 0xb8, 0x66, 0xb9,
+	0x18, 0x24, 0xec, 0x2a, 0xa9, 0x80, 0x03, 0x17, 0xc7, 0x59, 0x83, 0x51, 0x1b, 0x9b, 0xb1, 0xc3,
+	0xd7, 0x65, 0x35, 0xd9, 0x7d, 0xb2, 0x57, 0x5e, 0xef, 0x58, 0x33, 0x63, 0x87, 0xde, 0x90, 0xf8,
+	0xbc, 0x20, 0xc1, 0x1f, 0xc3, 0xff, 0xd0, 0x63, 0x8f, 0x88, 0x43, 0x85, 0x92, 0xbf, 0x81, 0x1b,
+	0x07, 0xb4, 0xb3, 0xeb, 0x7a, 0x2d, 0xaa, 0xb4, 0x92, 0x81, 0x70, 0x7b, 0x5f, 0xf3, 0xf6, 0xf7,
+	0xde, 0xbc, 0x79, 0x5a, 0x28, 0xe3, 0xc4, 0x97, 0xd2, 0xe7, 0xa1, 0x6c, 0xcc, 0xef, 0x35, 0x70,
+	0x8e, 0xa1, 0x92, 0xf5, 0xa9, 0xe0, 0x8a, 0x1b, 0x5b, 0x4f, 0x5d, 0xf5, 0xf9, 0xbd, 0x7d, 0x33,
+	0x15, 0x78, 0xd8, 0x08, 0x79, 0xe8, 0x62, 0x1c, 0xb7, 0xbf, 0xbf, 0xe2, 0x11, 0x38, 0x9d, 0x29,
+	0x14, 0x89, 0x6f, 0xf5, 0x94, 0x54, 0x6c, 0x8c, 0xcf, 0xf4, 0x28, 0x3e, 0xf5, 0xdd, 0xc4, 0xb3,
+	0x3b, 0xe4, 0x43, 0xae, 0xc5, 0x46, 0x24, 0xc5, 0x56, 0xeb, 0x0f, 0x02, 0x37, 0xed, 0x08, 0xaf,
+	0xef, 0x72, 0x81, 0xb2, 0x8f, 0xca, 0x78, 0x07, 0x80, 0xb9, 0x8a, 0x0b, 0x47, 0x3d, 0x9c, 0xa2,
+	0x49, 0xaa, 0xa4, 0x76, 0xf3, 0xe0, 0x95, 0x7a, 0x9a, 0xba, 0xde, 0x8c, 0xfc, 0x83, 0x87, 0x53,
+	0xa4, 0x25, 0xb6, 0x10, 0x8d, 0x32, 0x14, 0xf5, 0xf7, 0x1c, 0xdf, 0x33, 0xb3, 0x55, 0x52, 0xcb,
+	0xd3, 0x1b, 0x5a, 0xef, 0x78, 0xc6, 0xeb, 0xb0, 0x75, 0x16, 0x70, 0x77, 0xec, 0x8c, 0xd0, 0x1f,
+	0x8e, 0x94, 0x99, 0xab, 0x92, 0x5a, 0x8e, 0x6e, 0x6a, 0xdb, 0x87, 0xda, 0x64, 0xdc, 0x86, 0x12,
+	0xf3, 0x3c, 0x81, 0x52, 0xa2, 0x34, 0xf3, 0xd5, 0x5c, 0xad, 0x44, 0x97, 0x06, 0xa3, 0x0b, 0x1b,
+	0x52, 0x03, 0x9a, 0x85, 0xc8, 0x75, 0xf4, 0xee, 0xa3, 0x27, 0x77, 0x32, 0xbf, 0x3d, 0xb9, 0xd3,
+	0x18, 0xfa, 0x6a, 0x34, 0x3b, 0xab, 0xbb, 0x7c, 0xd2, 0x60, 0x41, 0xc0, 0x05, 0x7b, 0x3b, 0x44,
+	0x75, 0xce, 0xc5, 0x78, 0xa1, 0xba, 0x23, 0xe6, 0x87, 0x8d, 0x09, 0x53, 0xa3, 0xfa, 0x31, 0xba,
+	0x34, 0x49, 0x63, 0xfd, 0x49, 0x60, 0x47, 0xd7, 0x4d, 0xf1, 0x9c, 0x09, 0x2f, 0x2a, 0x5c, 0x05,
+	0xe8, 0xfd, 0x2f, 0x8b, 0xff, 0x18, 0x6e, 0x88, 0x98, 0x72, 0xdd, 0xea, 0x17, 0x79, 0xac, 0x9f,
+	0x17, 0xe5, 0x9f, 0xc4, 0xf1, 0xf7, 0xb9, 0xd4, 0x77, 0x9f, 0x2e, 0x83, 0x5c, 0x5d, 0x46, 0xf6,
+	0xef, 0x65, 0xbc, 0x0f, 0x5b, 0x73, 0x16, 0xcc, 0xd0, 0x39, 0x9b, 0x85, 0x5e, 0x80, 0xba, 0xd2,
+	0xcd, 0x83, 0x72, 0xba, 0x7d, 0x87, 0xf5, 0x4f, 0xa2, 0x88, 0x23, 0x1d, 0x40, 0x37, 0xe7, 0x4b,
+	0xc5, 0xfa, 0x96, 0x40, 0x59, 0x33, 0xb5, 0xb9, 0x40, 0x97, 0x49, 0x35, 0x60, 0x72, 0xac, 0xc7,
+	0xf2, 0x39, 0x64, 0x0f, 0xa0, 0xa0, 0x6f, 0x55, 0x23, 0xad, 0xd1, 0x9d, 0x38, 0x8b, 0xf5, 0x35,
+	0x01, 0x53, 0x73, 0x7c, 0xca, 0xc5, 0x18, 0xc5, 0x7d, 0x26, 0x55, 0x8b, 0x4f, 0x26, 0xbe, 0x5a,
+	0xbf, 0x41, 0x6f, 0x42, 0x41, 0x3f, 0xf1, 0xa4, 0x33, 0x3b, 0xab, 0x9d, 0x39, 0x89, 0x5c, 0x34,
+	0x8e, 0xb0, 0xbe, 0x59, 0x74, 0x83, 0xc6, 0x2f, 0xff, 0x9a, 0x30, 0xbe, 0x23, 0xb0, 0xab, 0x31,
+	0x06, 0x51, 0xfa, 0xe5, 0x63, 0x31, 0x5e, 0x85, 0xd2, 0x82, 0x40, 0x9a, 0xa4, 0x9a, 0xab, 0xe5,
+	0x69, 0x31, 0x41, 0x58, 0x99, 0xd8, 0xec, 0x3f, 0x34, 0xb1, 0x3f, 0x64, 0xe1, 0x96, 0x06, 0xb1,
+	0x1f, 0x34, 0xff, 0xd5, 0x5d, 0xb5, 0x9b, 0x6e, 0x4e, 0x2e, 0xe9, 0xc3, 0x7f, 0xbc, 0x9e, 0xa2,
+	0xee, 0xfa, 0xd2, 0x61, 0xae, 0xf2, 0xe7, 0x68, 0x6e, 0x54, 0x73, 0xb5, 0x22, 0x2d, 0xfa, 0xb2,
+	0xa9, 0x75, 0xeb, 0x17, 0x02, 0xb7, 0x96, 0x77, 0x72, 0x3a, 0xf5, 0x98, 0x42, 0x6f, 0xcd, 0x91,
+	0xb8, 0x0b, 0x25, 0x1e, 0x78, 0x8e, 0x3e, 0xf1, 0xec, 0xb1, 0x88, 0x07, 0xa0, 0xc8, 0x03, 0x4f,
+	0x4b, 0xd1, 0x89, 0x10, 0xcf, 0x93, 0x13, 0xf9, 0x2b, 0x4e, 0x84, 0x78, 0xae, 0x25, 0x8b, 0x82,
+	0xb1, 0xc4, 0x6e, 0x0a, 0x77, 0xe4, 0xcf, 0xd7, 0xe5, 0xb6, 0x3e, 0x4b, 0xde, 0xea, 0x22, 0x9d,
+	0xce, 0xdd, 0x13, 0xb3, 0x70, 0xed, 0xcc, 0x1f, 0xc0, 0x4e, 0xfa, 0xfd, 0xf5, 0x03, 0x26, 0x47,
+	0xe8, 0x19, 0x77, 0xa1, 0x20, 0x23, 0x51, 0x67, 0xdc, 0x3c, 0xd8, 0x5f, 0x2d, 0x39, 0x1d, 0x4c,
+	0xe3, 0x40, 0xeb, 0x7b, 0x02, 0xdb, 0x31, 0x63, 0x34, 0x7e, 0x1f, 0x31, 0x3f, 0xb8, 0x9a, 0x6d,
+	0x17, 0x0a, 0x7a, 0x50, 0xe3, 0x75, 0x46, 0x63, 0xc5, 0x78, 0x0d, 0xc0, 0x97, 0x4e, 0xf2, 0x1b,
+	0xa0, 0x6f, 0xa8, 0x48, 0x4b, 0xbe, 0x4c, 0x3e, 0x68, 0xbc, 0x01, 0x2f, 0x45, 0x1c, 0xe8, 0x39,
+	0x38, 0xe5, 0xee, 0x48, 0xea, 0x1b, 0xc9, 0xd3, 0xad, 0xd8, 0x68, 0x6b, 0x9b, 0xf5, 0x23, 0x81,
+	0xbd, 0x25, 0xc9, 0x31, 0x0a, 0x1c, 0xfa, 0x52, 0xa1, 0xb8, 0x2e, 0x9e, 0x30, 0xc1, 0x49, 0xcd,
+	0x71, 0xfb, 0xb9, 0xed, 0x79, 0x81, 0x61, 0xde, 0x83, 0x0d, 0x81, 0x4c, 0xf2, 0x50, 0x73, 0x95,
+	0x68, 0xa2, 0xbd, 0xf5, 0x15, 0x81, 0xd2, 0xd3, 0x75, 0x60, 0x58, 0x50, 0x69, 0xb6, 0x06, 0x5d,
+	0xea, 0x0c, 0x3e, 0xef, 0xd9, 0x4e, 0xe7, 0xa4, 0x6d, 0x53, 0x9b, 0x3a, 0xa7, 0x27, 0xfd, 0x9e,
+	0xdd, 0xea, 0xb4, 0x3b, 0xf6, 0xf1, 0x76, 0xc6, 0x28, 0xc3, 0xcb, 0xa9, 0x98, 0x76, 0x97, 0xda,
+	0xad, 0x66, 0x7f, 0x60, 0xd3, 0x6d, 0x62, 0xec, 0x81, 0x91, 0x72, 0x51, 0xbb, 0x77, 0x1a, 0xd9,
+	0xb3, 0xc6, 0x6d, 0x30, 0x53, 0xf6, 0x41, 0xb7, 0xd7, 0x69, 0x39, 0x2d, 0x6a, 0x37, 0x07, 0x5d,
+	0xba, 0x9d, 0x3b, 0xa2, 0x8f, 0x2e, 0x2a, 0xe4, 0xf1, 0x45, 0x85, 0xfc, 0x7e, 0x51, 0x21, 0x3f,
+	0x5d, 0x56, 0x32, 0x8f, 0x2f, 0x2b, 0x99, 0x5f, 0x2f, 0x2b, 0x99, 0x2f, 0xde, 0x7b, 0xc1, 0x5d,
+	0xf1, 0x65, 0x63, 0xf9, 0x8b, 0x17, 0x6d, 0x3d, 0x79, 0xb6, 0xa1, 0x7f, 0xe5, 0x0e, 0xff, 0x1a,
+	0x00, 0xc5, 0x2d, 0xa6, 0x23, 0x75, 0x0a, 0x00, 0x00,
+}
+
+func (m *EventScoresSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -811,42 +1042,58 @@ func (m *EventWorkerLastCommitSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWorkerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScoresSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWorkerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Scores[iNdEx].Size()
+				i -= size
+				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventReputerLastCommitSet) Marshal() (dAtA []byte, err error) {
+func (m *EventRewardsSettled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -856,42 +1103,58 @@ func (m *EventReputerLastCommitSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventReputerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRewardsSettled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventReputerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRewardsSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTopicRewardsSet) Marshal() (dAtA []byte, err error) {
+func (m *EventNetworkLossSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -901,19 +1164,192 @@ func (m *EventTopicRewardsSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTopicRewardsSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventNetworkLossSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTopicRewardsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForecastTaskScoreSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForecastTaskScoreSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForecastTaskScoreSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWorkerLastCommitSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWorkerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWorkerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReputerLastCommitSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReputerLastCommitSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReputerLastCommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicRewardsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicRewardsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicRewardsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size := m.Rewards[iNdEx].Size()
 				i -= size
 				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
@@ -1020,55 +1456,353 @@ func (m *EventEMAScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventTopicUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventScoresSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventTopicUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
+	if m.NewTopic != nil {
+		{
+			size, err := m.NewTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	if m.OldTopic != nil {
+		{
+			size, err := m.OldTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Scores) > 0 {
-		for _, e := range m.Scores {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRewardsSettled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTopicArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventArchivedTopicPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventArchivedTopicPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventArchivedTopicPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReputerSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReputerSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReputerSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slash != nil {
+		{
+			size, err := m.Slash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventActorJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActorJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActorJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedEpochs != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventActorDeregistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActorDeregistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActorDeregistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedEpochs != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicUpdateFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicUpdateFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicUpdateFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventScoresSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewardsSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
 	}
 	if m.TopicId != 0 {
 		n += 1 + sovEvents(uint64(m.TopicId))
@@ -1181,49 +1915,755 @@ func (m *EventTopicRewardsSet) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventEMAScoresSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.IsActive) > 0 {
+		n += 1 + sovEvents(uint64(len(m.IsActive))) + len(m.IsActive)*1
+	}
+	return n
+}
+
+func (m *EventTopicUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.OldTopic != nil {
+		l = m.OldTopic.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewTopic != nil {
+		l = m.NewTopic.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTopicArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventArchivedTopicPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventReputerSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slash != nil {
+		l = m.Slash.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventActorJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	if m.MissedEpochs != 0 {
+		n += 1 + sovEvents(uint64(m.MissedEpochs))
+	}
+	return n
+}
+
+func (m *EventActorDeregistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	if m.MissedEpochs != 0 {
+		n += 1 + sovEvents(uint64(m.MissedEpochs))
+	}
+	return n
+}
+
+func (m *EventTopicUpdateFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Scores = append(m.Scores, v)
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueBundle == nil {
+				m.ValueBundle = &ValueBundle{}
+			}
+			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventEMAScoresSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovEvents(uint64(m.Nonce))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Scores) > 0 {
-		for _, e := range m.Scores {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForecastTaskScoreSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForecastTaskScoreSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.IsActive) > 0 {
-		n += 1 + sovEvents(uint64(len(m.IsActive))) + len(m.IsActive)*1
-	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1246,17 +2686,17 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWorkerLastCommitSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWorkerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.ActorType = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1266,12 +2706,117 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReputerLastCommitSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReputerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -1290,7 +2835,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -1309,11 +2854,11 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1323,27 +2868,157 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicRewardsSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicRewardsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicIds = append(m.TopicIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicIds) == 0 {
+					m.TopicIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicIds = append(m.TopicIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1372,8 +3047,8 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_allora_network_allora_chain_math.Dec
-			m.Scores = append(m.Scores, v)
-			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1398,7 +3073,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,10 +3096,10 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEMAScoresSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEMAScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1467,9 +3142,9 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.BlockHeight = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1479,7 +3154,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.Nonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1518,7 +3193,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1535,23 +3210,93 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Rewards = append(m.Rewards, v)
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Scores = append(m.Scores, v)
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IsActive = append(m.IsActive, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.IsActive) == 0 {
+					m.IsActive = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IsActive = append(m.IsActive, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1573,7 +3318,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+func (m *EventTopicUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1596,10 +3341,10 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTopicUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTopicUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1642,7 +3387,7 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTopic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,10 +3414,46 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueBundle == nil {
-				m.ValueBundle = &ValueBundle{}
+			if m.OldTopic == nil {
+				m.OldTopic = &Topic{}
 			}
-			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldTopic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTopic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewTopic == nil {
+				m.NewTopic = &Topic{}
+			}
+			if err := m.NewTopic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1697,7 +3478,7 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
+func (m *EventTopicArchived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1720,10 +3501,10 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventForecastTaskScoreSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTopicArchived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventForecastTaskScoreSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTopicArchived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1746,10 +3527,10 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1759,26 +3540,11 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1800,7 +3566,7 @@ func (m *EventForecastTaskScoreSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
+func (m *EventArchivedTopicPruned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1823,10 +3589,10 @@ func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWorkerLastCommitSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventArchivedTopicPruned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWorkerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventArchivedTopicPruned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1867,42 +3633,6 @@ func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
-			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1924,7 +3654,7 @@ func (m *EventWorkerLastCommitSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
+func (m *EventReputerSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1947,53 +3677,15 @@ func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventReputerLastCommitSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReputerSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventReputerLastCommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReputerSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2020,10 +3712,10 @@ func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
+			if m.Slash == nil {
+				m.Slash = &ReputerSlash{}
 			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Slash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2048,7 +3740,7 @@ func (m *EventReputerLastCommitSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
+func (m *EventActorJailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2071,91 +3763,34 @@ func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTopicRewardsSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActorJailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTopicRewardsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActorJailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TopicIds = append(m.TopicIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TopicIds) == 0 {
-					m.TopicIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TopicIds = append(m.TopicIds, v)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2183,12 +3818,47 @@ func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Rewards = append(m.Rewards, v)
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
+			}
+			m.MissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2210,7 +3880,7 @@ func (m *EventTopicRewardsSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
+func (m *EventActorDeregistered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2233,17 +3903,17 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventEMAScoresSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActorDeregistered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventEMAScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActorDeregistered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.ActorType = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2253,16 +3923,16 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2272,16 +3942,29 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			m.Nonce = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2291,16 +3974,17 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsReputer = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
 			}
-			var stringLen uint64
+			m.MissedEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2310,27 +3994,102 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicUpdateFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicUpdateFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicUpdateFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2358,82 +4117,8 @@ func (m *EventEMAScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Scores = append(m.Scores, v)
-			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.IsActive = append(m.IsActive, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.IsActive) == 0 {
-					m.IsActive = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IsActive = append(m.IsActive, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
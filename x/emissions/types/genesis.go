@@ -81,6 +81,29 @@ func NewGenesisState() *GenesisState {
 		CountForecasterInclusionsInTopicActiveSet:      []*TopicIdActorIdUint64{},
 		TotalSumPreviousTopicWeights:                   alloraMath.ZeroDec(),
 		RewardCurrentBlockEmission:                     cosmosMath.ZeroInt(),
+		PendingTopicUpdates:                            []*TopicIdAndTopic{},
+		ArchivedTopics:                                 []*TopicIdAndTopicArchive{},
+		TopicWorkerWhitelistEnabled:                    []uint64{},
+		TopicReputerWhitelistEnabled:                   []uint64{},
+		TopicWorkerWhitelist:                           []*TopicAndActorId{},
+		TopicReputerWhitelist:                          []*TopicAndActorId{},
+		FundingSubscriptions:                           []*FundingSubscription{},
+		NextFundingSubscriptionId:                      0,
+		TopicCreatorRewards:                            []*TopicIdAndInt{},
+		LastNodeRotations:                              []*TopicIdActorIdBlockHeight{},
+		WorkerCommitments:                              []*WorkerCommitment{},
+		ReputerCommitments:                             []*ReputerCommitment{},
+		WorkerBonds:                                    []*TopicIdActorIdInt{},
+		WorkerBondRemovals:                             []*WorkerBondRemovalInfo{},
+		ReputerOutlierStreaks:                          []*TopicIdActorIdUint64{},
+		ReputerSlashes:                                 []*ReputerSlash{},
+		WorkerLiveness:                                 []*TopicIdActorIdActorLiveness{},
+		ReputerLiveness:                                []*TopicIdActorIdActorLiveness{},
+		ReputerCommissions:                             []*ReputerCommission{},
+		Redelegations:                                  []*Redelegation{},
+		AutoCompoundDelegators:                         []string{},
+		DelegateStakeReceiptPools:                      []*DelegateStakeReceiptPool{},
+		NextDelegateStakeReceiptPoolId:                 0,
 	}
 }
 
@@ -192,6 +192,50 @@ type GenesisState struct {
 	TotalSumPreviousTopicWeights github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,75,opt,name=total_sum_previous_topic_weights,json=totalSumPreviousTopicWeights,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"total_sum_previous_topic_weights"`
 	// reward emission on current block
 	RewardCurrentBlockEmission cosmossdk_io_math.Int `protobuf:"bytes,76,opt,name=reward_current_block_emission,json=rewardCurrentBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"reward_current_block_emission"`
+	// topics with updates waiting to be applied at their next epoch boundary
+	PendingTopicUpdates []*TopicIdAndTopic `protobuf:"bytes,77,rep,name=pending_topic_updates,json=pendingTopicUpdates,proto3" json:"pending_topic_updates,omitempty"`
+	// archival state of topics retired through MsgArchiveTopic
+	ArchivedTopics []*TopicIdAndTopicArchive `protobuf:"bytes,78,rep,name=archived_topics,json=archivedTopics,proto3" json:"archived_topics,omitempty"`
+	// topics whose worker whitelist is enabled
+	TopicWorkerWhitelistEnabled []uint64 `protobuf:"varint,79,rep,packed,name=topic_worker_whitelist_enabled,json=topicWorkerWhitelistEnabled,proto3" json:"topic_worker_whitelist_enabled,omitempty"`
+	// topics whose reputer whitelist is enabled
+	TopicReputerWhitelistEnabled []uint64 `protobuf:"varint,80,rep,packed,name=topic_reputer_whitelist_enabled,json=topicReputerWhitelistEnabled,proto3" json:"topic_reputer_whitelist_enabled,omitempty"`
+	// topic id, worker whitelisted in the topic
+	TopicWorkerWhitelist []*TopicAndActorId `protobuf:"bytes,81,rep,name=topic_worker_whitelist,json=topicWorkerWhitelist,proto3" json:"topic_worker_whitelist,omitempty"`
+	// topic id, reputer whitelisted in the topic
+	TopicReputerWhitelist []*TopicAndActorId `protobuf:"bytes,82,rep,name=topic_reputer_whitelist,json=topicReputerWhitelist,proto3" json:"topic_reputer_whitelist,omitempty"`
+	// active topic funding subscriptions
+	FundingSubscriptions      []*FundingSubscription `protobuf:"bytes,83,rep,name=funding_subscriptions,json=fundingSubscriptions,proto3" json:"funding_subscriptions,omitempty"`
+	NextFundingSubscriptionId uint64                 `protobuf:"varint,84,opt,name=next_funding_subscription_id,json=nextFundingSubscriptionId,proto3" json:"next_funding_subscription_id,omitempty"`
+	// total commission paid to topic creators, per topic
+	TopicCreatorRewards []*TopicIdAndInt `protobuf:"bytes,85,rep,name=topic_creator_rewards,json=topicCreatorRewards,proto3" json:"topic_creator_rewards,omitempty"`
+	// block at which nodes were last moved to their address, per topic
+	LastNodeRotations []*TopicIdActorIdBlockHeight `protobuf:"bytes,86,rep,name=last_node_rotations,json=lastNodeRotations,proto3" json:"last_node_rotations,omitempty"`
+	// worker commitments of the open worker nonces of commit-reveal topics
+	WorkerCommitments []*WorkerCommitment `protobuf:"bytes,87,rep,name=worker_commitments,json=workerCommitments,proto3" json:"worker_commitments,omitempty"`
+	// reputer commitments of the open reputer nonces of commit-reveal topics
+	ReputerCommitments []*ReputerCommitment `protobuf:"bytes,88,rep,name=reputer_commitments,json=reputerCommitments,proto3" json:"reputer_commitments,omitempty"`
+	// worker bonds held in each topic
+	WorkerBonds []*TopicIdActorIdInt `protobuf:"bytes,89,rep,name=worker_bonds,json=workerBonds,proto3" json:"worker_bonds,omitempty"`
+	// worker bond removals waiting for their removal delay to pass
+	WorkerBondRemovals []*WorkerBondRemovalInfo `protobuf:"bytes,90,rep,name=worker_bond_removals,json=workerBondRemovals,proto3" json:"worker_bond_removals,omitempty"`
+	// number of consecutive epochs reputers have been outliers of topics
+	ReputerOutlierStreaks []*TopicIdActorIdUint64 `protobuf:"bytes,91,rep,name=reputer_outlier_streaks,json=reputerOutlierStreaks,proto3" json:"reputer_outlier_streaks,omitempty"`
+	// slashes of reputers that were persistent outliers of topics
+	ReputerSlashes []*ReputerSlash `protobuf:"bytes,92,rep,name=reputer_slashes,json=reputerSlashes,proto3" json:"reputer_slashes,omitempty"`
+	// liveness of the workers of topics
+	WorkerLiveness []*TopicIdActorIdActorLiveness `protobuf:"bytes,93,rep,name=worker_liveness,json=workerLiveness,proto3" json:"worker_liveness,omitempty"`
+	// liveness of the reputers of topics
+	ReputerLiveness []*TopicIdActorIdActorLiveness `protobuf:"bytes,94,rep,name=reputer_liveness,json=reputerLiveness,proto3" json:"reputer_liveness,omitempty"`
+	// commissions of the reputers of topics on the rewards of their delegators
+	ReputerCommissions []*ReputerCommission `protobuf:"bytes,95,rep,name=reputer_commissions,json=reputerCommissions,proto3" json:"reputer_commissions,omitempty"`
+	// redelegations of stake between reputers of topics that have not matured yet
+	Redelegations []*Redelegation `protobuf:"bytes,96,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+	// delegators whose pending rewards are restaked into their delegations at each payout
+	AutoCompoundDelegators []string `protobuf:"bytes,97,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
+	// pools of delegated stake tokenized into receipts
+	DelegateStakeReceiptPools      []*DelegateStakeReceiptPool `protobuf:"bytes,98,rep,name=delegate_stake_receipt_pools,json=delegateStakeReceiptPools,proto3" json:"delegate_stake_receipt_pools,omitempty"`
+	NextDelegateStakeReceiptPoolId uint64                      `protobuf:"varint,99,opt,name=next_delegate_stake_receipt_pool_id,json=nextDelegateStakeReceiptPoolId,proto3" json:"next_delegate_stake_receipt_pool_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -710,6 +754,167 @@ func (m *GenesisState) GetLossBundles() []*TopicIdReputerReputerValueBundle {
 	return nil
 }
 
+func (m *GenesisState) GetPendingTopicUpdates() []*TopicIdAndTopic {
+	if m != nil {
+		return m.PendingTopicUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedTopics() []*TopicIdAndTopicArchive {
+	if m != nil {
+		return m.ArchivedTopics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicWorkerWhitelistEnabled() []uint64 {
+	if m != nil {
+		return m.TopicWorkerWhitelistEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicReputerWhitelistEnabled() []uint64 {
+	if m != nil {
+		return m.TopicReputerWhitelistEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicWorkerWhitelist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicWorkerWhitelist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicReputerWhitelist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicReputerWhitelist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingSubscriptions() []*FundingSubscription {
+	if m != nil {
+		return m.FundingSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextFundingSubscriptionId() uint64 {
+	if m != nil {
+		return m.NextFundingSubscriptionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTopicCreatorRewards() []*TopicIdAndInt {
+	if m != nil {
+		return m.TopicCreatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastNodeRotations() []*TopicIdActorIdBlockHeight {
+	if m != nil {
+		return m.LastNodeRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerCommitments() []*WorkerCommitment {
+	if m != nil {
+		return m.WorkerCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerCommitments() []*ReputerCommitment {
+	if m != nil {
+		return m.ReputerCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerBonds() []*TopicIdActorIdInt {
+	if m != nil {
+		return m.WorkerBonds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerBondRemovals() []*WorkerBondRemovalInfo {
+	if m != nil {
+		return m.WorkerBondRemovals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerOutlierStreaks() []*TopicIdActorIdUint64 {
+	if m != nil {
+		return m.ReputerOutlierStreaks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerSlashes() []*ReputerSlash {
+	if m != nil {
+		return m.ReputerSlashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerLiveness() []*TopicIdActorIdActorLiveness {
+	if m != nil {
+		return m.WorkerLiveness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerLiveness() []*TopicIdActorIdActorLiveness {
+	if m != nil {
+		return m.ReputerLiveness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerCommissions() []*ReputerCommission {
+	if m != nil {
+		return m.ReputerCommissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegations() []*Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundDelegators() []string {
+	if m != nil {
+		return m.AutoCompoundDelegators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegateStakeReceiptPools() []*DelegateStakeReceiptPool {
+	if m != nil {
+		return m.DelegateStakeReceiptPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextDelegateStakeReceiptPoolId() uint64 {
+	if m != nil {
+		return m.NextDelegateStakeReceiptPoolId
+	}
+	return 0
+}
+
 type TopicIdAndTopic struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	Topic   *Topic `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -762,6 +967,58 @@ func (m *TopicIdAndTopic) GetTopic() *Topic {
 	return nil
 }
 
+type TopicIdAndTopicArchive struct {
+	TopicId      uint64        `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	TopicArchive *TopicArchive `protobuf:"bytes,2,opt,name=topic_archive,json=topicArchive,proto3" json:"topic_archive,omitempty"`
+}
+
+func (m *TopicIdAndTopicArchive) Reset()         { *m = TopicIdAndTopicArchive{} }
+func (m *TopicIdAndTopicArchive) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndTopicArchive) ProtoMessage()    {}
+func (*TopicIdAndTopicArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{2}
+}
+func (m *TopicIdAndTopicArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdAndTopicArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdAndTopicArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdAndTopicArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndTopicArchive.Merge(m, src)
+}
+func (m *TopicIdAndTopicArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdAndTopicArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndTopicArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdAndTopicArchive proto.InternalMessageInfo
+
+func (m *TopicIdAndTopicArchive) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdAndTopicArchive) GetTopicArchive() *TopicArchive {
+	if m != nil {
+		return m.TopicArchive
+	}
+	return nil
+}
+
 type TopicAndActorId struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
@@ -771,7 +1028,7 @@ func (m *TopicAndActorId) Reset()         { *m = TopicAndActorId{} }
 func (m *TopicAndActorId) String() string { return proto.CompactTextString(m) }
 func (*TopicAndActorId) ProtoMessage()    {}
 func (*TopicAndActorId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{2}
+	return fileDescriptor_a6c24e1b56b831d9, []int{3}
 }
 func (m *TopicAndActorId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1080,7 @@ func (m *TopicIdAndBlockHeight) Reset()         { *m = TopicIdAndBlockHeight{} }
 func (m *TopicIdAndBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndBlockHeight) ProtoMessage()    {}
 func (*TopicIdAndBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{3}
+	return fileDescriptor_a6c24e1b56b831d9, []int{4}
 }
 func (m *TopicIdAndBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1132,7 @@ func (m *BlockHeightAndTopicIds) Reset()         { *m = BlockHeightAndTopicIds{}
 func (m *BlockHeightAndTopicIds) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightAndTopicIds) ProtoMessage()    {}
 func (*BlockHeightAndTopicIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{4}
+	return fileDescriptor_a6c24e1b56b831d9, []int{5}
 }
 func (m *BlockHeightAndTopicIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,7 +1185,7 @@ func (m *TopicIdBlockHeightScores) Reset()         { *m = TopicIdBlockHeightScor
 func (m *TopicIdBlockHeightScores) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightScores) ProtoMessage()    {}
 func (*TopicIdBlockHeightScores) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{5}
+	return fileDescriptor_a6c24e1b56b831d9, []int{6}
 }
 func (m *TopicIdBlockHeightScores) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,7 +1245,7 @@ func (m *TopicIdActorIdScore) Reset()         { *m = TopicIdActorIdScore{} }
 func (m *TopicIdActorIdScore) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdScore) ProtoMessage()    {}
 func (*TopicIdActorIdScore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{6}
+	return fileDescriptor_a6c24e1b56b831d9, []int{7}
 }
 func (m *TopicIdActorIdScore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,6 +1295,66 @@ func (m *TopicIdActorIdScore) GetScore() *Score {
 	return nil
 }
 
+type TopicIdActorIdBlockHeight struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId     string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *TopicIdActorIdBlockHeight) Reset()         { *m = TopicIdActorIdBlockHeight{} }
+func (m *TopicIdActorIdBlockHeight) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdBlockHeight) ProtoMessage()    {}
+func (*TopicIdActorIdBlockHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{8}
+}
+func (m *TopicIdActorIdBlockHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdActorIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdActorIdBlockHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdActorIdBlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdBlockHeight.Merge(m, src)
+}
+func (m *TopicIdActorIdBlockHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdActorIdBlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdBlockHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdActorIdBlockHeight proto.InternalMessageInfo
+
+func (m *TopicIdActorIdBlockHeight) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdActorIdBlockHeight) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdActorIdBlockHeight) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 type TopicIdActorIdUint64 struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
@@ -1048,7 +1365,7 @@ func (m *TopicIdActorIdUint64) Reset()         { *m = TopicIdActorIdUint64{} }
 func (m *TopicIdActorIdUint64) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdUint64) ProtoMessage()    {}
 func (*TopicIdActorIdUint64) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{7}
+	return fileDescriptor_a6c24e1b56b831d9, []int{9}
 }
 func (m *TopicIdActorIdUint64) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1425,7 @@ func (m *TopicIdActorIdListeningCoefficient) Reset()         { *m = TopicIdActor
 func (m *TopicIdActorIdListeningCoefficient) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdListeningCoefficient) ProtoMessage()    {}
 func (*TopicIdActorIdListeningCoefficient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{8}
+	return fileDescriptor_a6c24e1b56b831d9, []int{10}
 }
 func (m *TopicIdActorIdListeningCoefficient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,7 +1485,7 @@ func (m *TopicIdActorIdDec) Reset()         { *m = TopicIdActorIdDec{} }
 func (m *TopicIdActorIdDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdDec) ProtoMessage()    {}
 func (*TopicIdActorIdDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{9}
+	return fileDescriptor_a6c24e1b56b831d9, []int{11}
 }
 func (m *TopicIdActorIdDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1537,7 @@ func (m *TopicIdAndInt) Reset()         { *m = TopicIdAndInt{} }
 func (m *TopicIdAndInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndInt) ProtoMessage()    {}
 func (*TopicIdAndInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{10}
+	return fileDescriptor_a6c24e1b56b831d9, []int{12}
 }
 func (m *TopicIdAndInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,7 +1583,7 @@ func (m *TopicIdActorIdInt) Reset()         { *m = TopicIdActorIdInt{} }
 func (m *TopicIdActorIdInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInt) ProtoMessage()    {}
 func (*TopicIdActorIdInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{11}
+	return fileDescriptor_a6c24e1b56b831d9, []int{13}
 }
 func (m *TopicIdActorIdInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1320,7 +1637,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Reset()         { *m = TopicIdDel
 func (m *TopicIdDelegatorReputerDelegatorInfo) String() string { return proto.CompactTextString(m) }
 func (*TopicIdDelegatorReputerDelegatorInfo) ProtoMessage()    {}
 func (*TopicIdDelegatorReputerDelegatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{12}
+	return fileDescriptor_a6c24e1b56b831d9, []int{14}
 }
 func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1392,7 +1709,7 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) String() string {
 }
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{13}
+	return fileDescriptor_a6c24e1b56b831d9, []int{15}
 }
 func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1459,7 +1776,7 @@ func (m *ActorIdTopicIdBlockHeight) Reset()         { *m = ActorIdTopicIdBlockHe
 func (m *ActorIdTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*ActorIdTopicIdBlockHeight) ProtoMessage()    {}
 func (*ActorIdTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{14}
+	return fileDescriptor_a6c24e1b56b831d9, []int{16}
 }
 func (m *ActorIdTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +1842,7 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) String() st
 }
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{15}
+	return fileDescriptor_a6c24e1b56b831d9, []int{17}
 }
 func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1600,7 +1917,7 @@ func (m *DelegatorReputerTopicIdBlockHeight) Reset()         { *m = DelegatorRep
 func (m *DelegatorReputerTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage()    {}
 func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{16}
+	return fileDescriptor_a6c24e1b56b831d9, []int{18}
 }
 func (m *DelegatorReputerTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1667,7 +1984,7 @@ func (m *TopicIdActorIdInference) Reset()         { *m = TopicIdActorIdInference
 func (m *TopicIdActorIdInference) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInference) ProtoMessage()    {}
 func (*TopicIdActorIdInference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{17}
+	return fileDescriptor_a6c24e1b56b831d9, []int{19}
 }
 func (m *TopicIdActorIdInference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1727,7 +2044,7 @@ func (m *TopicIdActorIdForecast) Reset()         { *m = TopicIdActorIdForecast{}
 func (m *TopicIdActorIdForecast) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdForecast) ProtoMessage()    {}
 func (*TopicIdActorIdForecast) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{18}
+	return fileDescriptor_a6c24e1b56b831d9, []int{20}
 }
 func (m *TopicIdActorIdForecast) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,7 +2103,7 @@ func (m *LibP2PKeyAndOffchainNode) Reset()         { *m = LibP2PKeyAndOffchainNo
 func (m *LibP2PKeyAndOffchainNode) String() string { return proto.CompactTextString(m) }
 func (*LibP2PKeyAndOffchainNode) ProtoMessage()    {}
 func (*LibP2PKeyAndOffchainNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{19}
+	return fileDescriptor_a6c24e1b56b831d9, []int{21}
 }
 func (m *LibP2PKeyAndOffchainNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1838,7 +2155,7 @@ func (m *TopicIdAndDec) Reset()         { *m = TopicIdAndDec{} }
 func (m *TopicIdAndDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndDec) ProtoMessage()    {}
 func (*TopicIdAndDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{20}
+	return fileDescriptor_a6c24e1b56b831d9, []int{22}
 }
 func (m *TopicIdAndDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1884,7 +2201,7 @@ func (m *TopicIdBlockHeightInferences) Reset()         { *m = TopicIdBlockHeight
 func (m *TopicIdBlockHeightInferences) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightInferences) ProtoMessage()    {}
 func (*TopicIdBlockHeightInferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{21}
+	return fileDescriptor_a6c24e1b56b831d9, []int{23}
 }
 func (m *TopicIdBlockHeightInferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,7 +2261,7 @@ func (m *TopicIdBlockHeightForecasts) Reset()         { *m = TopicIdBlockHeightF
 func (m *TopicIdBlockHeightForecasts) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightForecasts) ProtoMessage()    {}
 func (*TopicIdBlockHeightForecasts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{22}
+	return fileDescriptor_a6c24e1b56b831d9, []int{24}
 }
 func (m *TopicIdBlockHeightForecasts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2321,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) Reset()         { *m = TopicIdBl
 func (m *TopicIdBlockHeightReputerValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightReputerValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{23}
+	return fileDescriptor_a6c24e1b56b831d9, []int{25}
 }
 func (m *TopicIdBlockHeightReputerValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2064,7 +2381,7 @@ func (m *TopicIdBlockHeightValueBundles) Reset()         { *m = TopicIdBlockHeig
 func (m *TopicIdBlockHeightValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{24}
+	return fileDescriptor_a6c24e1b56b831d9, []int{26}
 }
 func (m *TopicIdBlockHeightValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2123,7 +2440,7 @@ func (m *TopicIdAndNonces) Reset()         { *m = TopicIdAndNonces{} }
 func (m *TopicIdAndNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndNonces) ProtoMessage()    {}
 func (*TopicIdAndNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{25}
+	return fileDescriptor_a6c24e1b56b831d9, []int{27}
 }
 func (m *TopicIdAndNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2492,7 @@ func (m *TopicIdAndReputerRequestNonces) Reset()         { *m = TopicIdAndRepute
 func (m *TopicIdAndReputerRequestNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndReputerRequestNonces) ProtoMessage()    {}
 func (*TopicIdAndReputerRequestNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{26}
+	return fileDescriptor_a6c24e1b56b831d9, []int{28}
 }
 func (m *TopicIdAndReputerRequestNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2545,7 @@ func (m *TopicIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdTi
 func (m *TopicIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{27}
+	return fileDescriptor_a6c24e1b56b831d9, []int{29}
 }
 func (m *TopicIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2289,7 +2606,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Reset()         { *m = TopicIdAc
 func (m *TopicIdActorIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{28}
+	return fileDescriptor_a6c24e1b56b831d9, []int{30}
 }
 func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2355,7 +2672,7 @@ func (m *TopicIdTimestampedActorNonce) Reset()         { *m = TopicIdTimestamped
 func (m *TopicIdTimestampedActorNonce) String() string { return proto.CompactTextString(m) }
 func (*TopicIdTimestampedActorNonce) ProtoMessage()    {}
 func (*TopicIdTimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{29}
+	return fileDescriptor_a6c24e1b56b831d9, []int{31}
 }
 func (m *TopicIdTimestampedActorNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2407,7 +2724,7 @@ func (m *BlockHeightTopicIds) Reset()         { *m = BlockHeightTopicIds{} }
 func (m *BlockHeightTopicIds) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightTopicIds) ProtoMessage()    {}
 func (*BlockHeightTopicIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{30}
+	return fileDescriptor_a6c24e1b56b831d9, []int{32}
 }
 func (m *BlockHeightTopicIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2459,7 +2776,7 @@ func (m *BlockHeightTopicIdWeightPair) Reset()         { *m = BlockHeightTopicId
 func (m *BlockHeightTopicIdWeightPair) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightTopicIdWeightPair) ProtoMessage()    {}
 func (*BlockHeightTopicIdWeightPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{31}
+	return fileDescriptor_a6c24e1b56b831d9, []int{33}
 }
 func (m *BlockHeightTopicIdWeightPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2512,7 +2829,7 @@ func (m *TopicIdReputerReputerValueBundle) Reset()         { *m = TopicIdReputer
 func (m *TopicIdReputerReputerValueBundle) String() string { return proto.CompactTextString(m) }
 func (*TopicIdReputerReputerValueBundle) ProtoMessage()    {}
 func (*TopicIdReputerReputerValueBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6c24e1b56b831d9, []int{32}
+	return fileDescriptor_a6c24e1b56b831d9, []int{34}
 }
 func (m *TopicIdReputerReputerValueBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2562,14 +2879,76 @@ func (m *TopicIdReputerReputerValueBundle) GetReputerValueBundle() *ReputerValue
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "emissions.v5.GenesisState")
-	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v5.TopicIdAndTopic")
-	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v5.TopicAndActorId")
-	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v5.TopicIdAndBlockHeight")
-	proto.RegisterType((*BlockHeightAndTopicIds)(nil), "emissions.v5.BlockHeightAndTopicIds")
+type TopicIdActorIdActorLiveness struct {
+	TopicId  uint64         `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	ActorId  string         `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Liveness *ActorLiveness `protobuf:"bytes,3,opt,name=liveness,proto3" json:"liveness,omitempty"`
+}
+
+func (m *TopicIdActorIdActorLiveness) Reset()         { *m = TopicIdActorIdActorLiveness{} }
+func (m *TopicIdActorIdActorLiveness) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdActorLiveness) ProtoMessage()    {}
+func (*TopicIdActorIdActorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6c24e1b56b831d9, []int{35}
+}
+func (m *TopicIdActorIdActorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdActorIdActorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdActorIdActorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdActorIdActorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdActorLiveness.Merge(m, src)
+}
+func (m *TopicIdActorIdActorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdActorIdActorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdActorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdActorIdActorLiveness proto.InternalMessageInfo
+
+func (m *TopicIdActorIdActorLiveness) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdActorIdActorLiveness) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdActorIdActorLiveness) GetLiveness() *ActorLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "emissions.v5.GenesisState")
+	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v5.TopicIdAndTopic")
+	proto.RegisterType((*TopicIdAndTopicArchive)(nil), "emissions.v5.TopicIdAndTopicArchive")
+	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v5.TopicAndActorId")
+	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v5.TopicIdAndBlockHeight")
+	proto.RegisterType((*BlockHeightAndTopicIds)(nil), "emissions.v5.BlockHeightAndTopicIds")
 	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v5.TopicIdBlockHeightScores")
 	proto.RegisterType((*TopicIdActorIdScore)(nil), "emissions.v5.TopicIdActorIdScore")
+	proto.RegisterType((*TopicIdActorIdBlockHeight)(nil), "emissions.v5.TopicIdActorIdBlockHeight")
 	proto.RegisterType((*TopicIdActorIdUint64)(nil), "emissions.v5.TopicIdActorIdUint64")
 	proto.RegisterType((*TopicIdActorIdListeningCoefficient)(nil), "emissions.v5.TopicIdActorIdListeningCoefficient")
 	proto.RegisterType((*TopicIdActorIdDec)(nil), "emissions.v5.TopicIdActorIdDec")
@@ -2596,206 +2975,243 @@ func init() {
 	proto.RegisterType((*BlockHeightTopicIds)(nil), "emissions.v5.BlockHeightTopicIds")
 	proto.RegisterType((*BlockHeightTopicIdWeightPair)(nil), "emissions.v5.BlockHeightTopicIdWeightPair")
 	proto.RegisterType((*TopicIdReputerReputerValueBundle)(nil), "emissions.v5.TopicIdReputerReputerValueBundle")
+	proto.RegisterType((*TopicIdActorIdActorLiveness)(nil), "emissions.v5.TopicIdActorIdActorLiveness")
 }
 
 func init() { proto.RegisterFile("emissions/v5/genesis.proto", fileDescriptor_a6c24e1b56b831d9) }
 
 var fileDescriptor_a6c24e1b56b831d9 = []byte{
-	// 3089 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcb, 0x6f, 0xdc, 0xc6,
-	0x19, 0x37, 0x25, 0x45, 0x96, 0x3e, 0x49, 0x96, 0x34, 0x7a, 0x51, 0x0f, 0xaf, 0xd6, 0xf4, 0x23,
-	0x72, 0x62, 0xaf, 0x62, 0x29, 0x4e, 0xdc, 0x34, 0x6d, 0xa2, 0xb5, 0x2d, 0x67, 0x6d, 0xc1, 0x96,
-	0x29, 0x39, 0x0e, 0x12, 0x24, 0x2c, 0xb5, 0x9c, 0x95, 0x18, 0x73, 0x39, 0x1b, 0x0e, 0x57, 0xb2,
-	0xdb, 0x02, 0x2d, 0x0a, 0x34, 0x87, 0x02, 0x05, 0x82, 0xa2, 0x29, 0xfa, 0xba, 0x14, 0xbd, 0x14,
-	0xe8, 0xa5, 0x40, 0x8b, 0x9e, 0xda, 0x7b, 0x7a, 0x4b, 0x7b, 0x2a, 0x7a, 0x08, 0x8a, 0xe4, 0xd0,
-	0x7f, 0xa3, 0xe0, 0xcc, 0xf0, 0xb5, 0x3b, 0xe4, 0xae, 0x56, 0xb9, 0x08, 0x22, 0x67, 0xbe, 0xdf,
-	0xef, 0x37, 0xdf, 0x7c, 0xfc, 0xe6, 0xf1, 0x2d, 0x2c, 0xe0, 0xba, 0x4d, 0xa9, 0x4d, 0x5c, 0xba,
-	0x7a, 0x78, 0x7d, 0x75, 0x1f, 0xbb, 0x98, 0xda, 0xb4, 0xd4, 0xf0, 0x88, 0x4f, 0xd0, 0x68, 0xd4,
-	0x56, 0x3a, 0xbc, 0xbe, 0x30, 0x69, 0xd6, 0x6d, 0x97, 0xac, 0xb2, 0xbf, 0xbc, 0xc3, 0xc2, 0x7c,
-	0x95, 0xd0, 0x3a, 0xa1, 0x06, 0x7b, 0x5a, 0xe5, 0x0f, 0xa2, 0x69, 0x2e, 0x81, 0xbb, 0xbe, 0xea,
-	0x12, 0x0b, 0x8b, 0x06, 0xb5, 0xa5, 0xc1, 0xad, 0x86, 0x2d, 0x0b, 0xa9, 0x16, 0x0f, 0x37, 0x9a,
-	0x3e, 0xf6, 0xa4, 0x56, 0xb4, 0x4a, 0x3c, 0x39, 0x1e, 0xf5, 0xcd, 0x27, 0xf2, 0x16, 0x9f, 0x34,
-	0xec, 0xaa, 0xbc, 0xe5, 0x59, 0x03, 0x87, 0xb2, 0xe7, 0x53, 0x2d, 0x47, 0xc4, 0x7b, 0x12, 0x49,
-	0x98, 0x4f, 0x79, 0xaa, 0x61, 0x7a, 0x66, 0x3d, 0xb4, 0x9a, 0xde, 0x27, 0xfb, 0x84, 0x3b, 0x21,
-	0xf8, 0x8f, 0xbf, 0xd5, 0xfe, 0x7a, 0x03, 0x46, 0xef, 0x70, 0x87, 0xee, 0xf8, 0xa6, 0x8f, 0xd1,
-	0x1a, 0x0c, 0x72, 0x33, 0x55, 0x29, 0x2a, 0x2b, 0x23, 0x6b, 0xd3, 0xa5, 0xa4, 0x83, 0x4b, 0xdb,
-	0xac, 0xad, 0x3c, 0xf0, 0xd9, 0x17, 0xcb, 0xa7, 0x74, 0xd1, 0x13, 0x69, 0x30, 0xe6, 0xe2, 0xa7,
-	0xbe, 0xc1, 0xe4, 0x1b, 0xb6, 0xa5, 0xf6, 0x17, 0x95, 0x95, 0x01, 0x7d, 0x24, 0x78, 0xb9, 0x1b,
-	0xbc, 0xab, 0x58, 0xe8, 0x3a, 0x0c, 0xb2, 0x66, 0xaa, 0x0e, 0x14, 0xfb, 0x57, 0x46, 0xd6, 0xce,
-	0xa6, 0x71, 0x45, 0xb7, 0x0d, 0xd7, 0x62, 0xff, 0xe9, 0xa2, 0x33, 0x3a, 0x0f, 0x63, 0x66, 0xd5,
-	0xb7, 0x0f, 0xb1, 0x21, 0xac, 0x9f, 0x2b, 0xf6, 0xaf, 0x0c, 0xe8, 0xa3, 0xfc, 0xe5, 0x2e, 0xef,
-	0xf4, 0x22, 0x4c, 0x7a, 0xf8, 0xc8, 0xf4, 0x2c, 0x73, 0xcf, 0x89, 0x3a, 0x0e, 0xb2, 0x8e, 0x13,
-	0x71, 0x83, 0xe8, 0x5c, 0x86, 0x31, 0xae, 0x93, 0x3b, 0x8e, 0xaa, 0xa7, 0x33, 0xf5, 0x6c, 0xb8,
-	0xd6, 0x46, 0xd5, 0x27, 0x5e, 0xc5, 0xd2, 0x47, 0x99, 0xcd, 0x63, 0x6e, 0x82, 0x6e, 0xc1, 0x19,
-	0x8e, 0x21, 0x02, 0x80, 0xaa, 0x43, 0xdd, 0x80, 0x70, 0x62, 0x5d, 0xd8, 0xa0, 0x87, 0x80, 0x42,
-	0x94, 0x40, 0xa3, 0xc1, 0xe2, 0x4c, 0x1d, 0x66, 0x48, 0xe7, 0xb3, 0xdc, 0x53, 0x76, 0x48, 0xf5,
-	0xc9, 0x5b, 0xd8, 0xde, 0x3f, 0xf0, 0xf5, 0x09, 0x81, 0x17, 0x58, 0xdf, 0x0f, 0x8c, 0xd1, 0xfb,
-	0x30, 0x67, 0xbb, 0x35, 0xec, 0x61, 0xcf, 0x60, 0xf1, 0x47, 0x8d, 0xbd, 0x67, 0xc6, 0x5e, 0x60,
-	0xa2, 0x02, 0xc3, 0xbd, 0x24, 0xc5, 0x4d, 0x80, 0xee, 0x30, 0x33, 0x7d, 0x5a, 0xc0, 0xf0, 0xc7,
-	0xf2, 0x33, 0xd6, 0x03, 0x55, 0x61, 0xa1, 0x46, 0x3c, 0x5c, 0x35, 0xa9, 0x2f, 0x61, 0x18, 0x39,
-	0x16, 0xc3, 0x5c, 0x8c, 0x94, 0x26, 0x79, 0x1f, 0xe6, 0x84, 0x5b, 0xdb, 0x18, 0x46, 0x8f, 0x37,
-	0x06, 0x01, 0x93, 0x86, 0x7f, 0x00, 0x28, 0xe5, 0x22, 0x03, 0xd7, 0x4d, 0xaa, 0xbe, 0xce, 0x90,
-	0xcf, 0xc9, 0xbd, 0xce, 0xa7, 0x8f, 0xc1, 0xe8, 0x13, 0x49, 0xc7, 0xdc, 0xae, 0x9b, 0x14, 0x3d,
-	0x82, 0x99, 0x56, 0xa7, 0x70, 0xcc, 0x6f, 0x75, 0x8b, 0x39, 0xd5, 0xe2, 0x0a, 0x06, 0xfb, 0x00,
-	0x50, 0xca, 0x0d, 0x1c, 0xf3, 0xdb, 0x5d, 0xeb, 0x4c, 0x0e, 0x9e, 0x01, 0xfa, 0x70, 0x36, 0x04,
-	0x74, 0x6c, 0xea, 0x63, 0xd7, 0x76, 0xf7, 0x8d, 0x2a, 0xc1, 0xb5, 0x9a, 0x5d, 0xb5, 0xb1, 0xeb,
-	0xab, 0x13, 0x0c, 0xfb, 0xa5, 0x3c, 0xec, 0xad, 0xd0, 0xf0, 0x66, 0x6c, 0xa7, 0x2f, 0x0a, 0x58,
-	0x59, 0x23, 0x3a, 0x80, 0x62, 0xc3, 0xc3, 0x87, 0x36, 0x69, 0xd2, 0xf0, 0x6b, 0x09, 0xe3, 0xbd,
-	0xe6, 0x05, 0x5f, 0x31, 0x71, 0xd5, 0x49, 0x46, 0xbc, 0x9c, 0x47, 0x7c, 0x0b, 0x57, 0xf5, 0xb3,
-	0x21, 0x90, 0xf8, 0x82, 0x78, 0xe0, 0x6f, 0x0a, 0x14, 0xe4, 0x80, 0x16, 0x31, 0xf1, 0x49, 0x72,
-	0xab, 0xb8, 0x8d, 0x0b, 0x75, 0xc7, 0xb5, 0x1c, 0x42, 0x55, 0x42, 0xa4, 0x16, 0xb6, 0x0f, 0xe1,
-	0x5c, 0xc4, 0x16, 0x4e, 0x5f, 0x1b, 0xd9, 0x54, 0x77, 0x64, 0x85, 0x10, 0x69, 0x53, 0x00, 0xb5,
-	0x70, 0xed, 0xc1, 0x72, 0x1b, 0x57, 0x14, 0x16, 0x9e, 0xe9, 0xdb, 0x44, 0x9d, 0x66, 0x4c, 0x8b,
-	0x59, 0x59, 0x23, 0x60, 0x59, 0x6a, 0x65, 0x11, 0xc1, 0xa1, 0x07, 0x00, 0xe8, 0x21, 0x8c, 0xf8,
-	0xc4, 0x37, 0x1d, 0x83, 0xad, 0x4e, 0xea, 0x4c, 0x51, 0x59, 0x19, 0x2e, 0xbf, 0x14, 0xa4, 0xf9,
-	0xff, 0x7c, 0xb1, 0x3c, 0xc3, 0x97, 0x4d, 0x6a, 0x3d, 0x29, 0xd9, 0x64, 0xb5, 0x6e, 0xfa, 0x07,
-	0xa5, 0x8a, 0xeb, 0xff, 0xeb, 0x2f, 0x57, 0x41, 0xac, 0xa7, 0x15, 0xd7, 0xff, 0xc3, 0xff, 0xfe,
-	0xf4, 0x82, 0xa2, 0x03, 0x03, 0xd9, 0x09, 0x30, 0xd0, 0xeb, 0x01, 0x64, 0x90, 0xdf, 0x38, 0xe4,
-	0x6c, 0xbe, 0xc4, 0x8a, 0xeb, 0x07, 0xd6, 0x0d, 0xbb, 0xca, 0xad, 0x1f, 0xc3, 0x1c, 0xb3, 0x8b,
-	0xa2, 0xc6, 0x6c, 0xfa, 0x07, 0xc4, 0xb3, 0xfd, 0x67, 0xea, 0x5c, 0x67, 0xb7, 0x06, 0x68, 0x33,
-	0xcc, 0x5e, 0x04, 0xcb, 0x46, 0x68, 0x8d, 0xde, 0x01, 0x95, 0x03, 0xd3, 0x66, 0xdd, 0xa8, 0x79,
-	0xa4, 0x6e, 0x58, 0xd8, 0xc1, 0xfb, 0xa6, 0x4f, 0x3c, 0x55, 0x3d, 0x0e, 0xf2, 0x4e, 0xb3, 0xbe,
-	0xe9, 0x91, 0xfa, 0xad, 0xd0, 0x1a, 0xbd, 0x0f, 0x13, 0x02, 0x0a, 0x5b, 0x7c, 0xd0, 0x54, 0x9d,
-	0x67, 0x88, 0x6b, 0x52, 0xc4, 0xc8, 0x52, 0x68, 0x8c, 0x9e, 0x2b, 0x6e, 0x8d, 0xe8, 0xe3, 0x11,
-	0x16, 0x73, 0x08, 0x45, 0x4f, 0x40, 0xe3, 0xc2, 0xd3, 0xa2, 0xa9, 0xd1, 0x6c, 0x10, 0x37, 0x74,
-	0x93, 0xba, 0xd0, 0xdd, 0x10, 0x0a, 0x0c, 0x2a, 0xa5, 0x9f, 0x3e, 0x6a, 0x10, 0x57, 0x48, 0x41,
-	0xef, 0xc2, 0x7c, 0xc8, 0x1f, 0x86, 0x75, 0x23, 0x88, 0xb9, 0x03, 0xd3, 0xc3, 0xea, 0x62, 0x77,
-	0x71, 0x3d, 0x1b, 0x22, 0xf0, 0x78, 0xde, 0xc6, 0xde, 0x4e, 0x60, 0x8e, 0xdc, 0x78, 0x6a, 0xeb,
-	0xe4, 0xd0, 0x74, 0x12, 0x19, 0x7e, 0x89, 0x21, 0xbf, 0x9a, 0x46, 0x4e, 0xa4, 0x76, 0x41, 0x22,
-	0x54, 0xee, 0xf0, 0x09, 0x66, 0x20, 0xcc, 0x67, 0xd3, 0x34, 0xf1, 0x26, 0x4a, 0xf9, 0x1f, 0xc8,
-	0xf8, 0xcc, 0x40, 0xa7, 0x7a, 0x96, 0xf1, 0x3d, 0x9f, 0xe6, 0x13, 0x43, 0x68, 0x5f, 0x58, 0xda,
-	0xf0, 0x59, 0x4f, 0xf4, 0x89, 0x02, 0xc5, 0xc8, 0x59, 0x59, 0x23, 0x2b, 0x30, 0xa6, 0xb7, 0x3a,
-	0x8d, 0x2c, 0x23, 0x26, 0x70, 0xdb, 0x50, 0x97, 0x2c, 0x49, 0x4b, 0x34, 0xe4, 0xa7, 0xb9, 0x8a,
-	0xf8, 0xd8, 0x97, 0x65, 0xf9, 0xbe, 0x95, 0x5f, 0xe2, 0x84, 0x2c, 0x66, 0xee, 0x8c, 0xdb, 0x00,
-	0x51, 0xf6, 0xa5, 0x6a, 0x91, 0x71, 0x5c, 0xcc, 0x8f, 0xc6, 0x30, 0xc3, 0x26, 0x0c, 0x51, 0x19,
-	0x86, 0xc3, 0x54, 0x47, 0xd5, 0x73, 0x0c, 0xe5, 0x42, 0x1e, 0x4a, 0x94, 0x3a, 0x63, 0x33, 0xf4,
-	0x26, 0x9c, 0x0e, 0x37, 0x79, 0x9a, 0x6c, 0xe7, 0xb0, 0x65, 0xef, 0x6d, 0xaf, 0x35, 0xee, 0xe1,
-	0x67, 0x1b, 0xae, 0xf5, 0xa0, 0x56, 0xab, 0x1e, 0x98, 0xb6, 0x7b, 0x9f, 0x58, 0x58, 0x0f, 0xcd,
-	0x50, 0x19, 0x86, 0xa2, 0x2d, 0xde, 0xf9, 0x63, 0x41, 0x44, 0x76, 0xe8, 0x0e, 0x4c, 0xf2, 0x34,
-	0x58, 0xc3, 0xc1, 0x2c, 0x1c, 0x62, 0xb7, 0x89, 0xd5, 0x0b, 0x9d, 0x93, 0xe1, 0x38, 0xb3, 0xda,
-	0xc4, 0x58, 0xe7, 0x36, 0xe8, 0x01, 0xcc, 0x44, 0xcb, 0x80, 0xd8, 0xc2, 0xb2, 0x09, 0x51, 0x2f,
-	0x76, 0x4e, 0xfe, 0x53, 0xa1, 0x25, 0x7b, 0xfd, 0x98, 0xd9, 0xa1, 0x87, 0x70, 0xc6, 0x74, 0x1c,
-	0x23, 0x31, 0x5d, 0x97, 0x18, 0xd2, 0x0b, 0x9d, 0x36, 0x58, 0xd1, 0x94, 0x51, 0x7d, 0xcc, 0x74,
-	0x9c, 0xf8, 0x11, 0xdd, 0x87, 0xe0, 0x85, 0x11, 0x4f, 0xdd, 0xf3, 0x0c, 0xf1, 0x72, 0x27, 0xc4,
-	0x70, 0xfa, 0xa8, 0x3e, 0x6a, 0x3a, 0x4e, 0xf4, 0x14, 0xa4, 0xd4, 0x00, 0xcf, 0x21, 0x94, 0x1a,
-	0x7b, 0x4d, 0xd7, 0x72, 0x30, 0x55, 0x57, 0x18, 0xe4, 0x7a, 0x27, 0x48, 0x11, 0xc0, 0x6f, 0x9b,
-	0x4e, 0x13, 0x97, 0xb9, 0xa9, 0x1e, 0x8c, 0x77, 0x8b, 0x50, 0x2a, 0x9e, 0xd1, 0x07, 0x30, 0xed,
-	0x62, 0x3f, 0x98, 0xed, 0x34, 0xc5, 0x65, 0x46, 0x71, 0xa5, 0x13, 0x45, 0x0a, 0x1b, 0x09, 0xa4,
-	0x24, 0xfe, 0x2f, 0x15, 0xb8, 0x1a, 0xcd, 0x59, 0x03, 0x7b, 0x55, 0xec, 0xfa, 0xe6, 0x7e, 0x94,
-	0x51, 0x7d, 0xc2, 0x3f, 0x4e, 0x2b, 0x3e, 0x48, 0xbc, 0xc0, 0x16, 0xde, 0x57, 0xc5, 0xc2, 0xbb,
-	0xba, 0x6f, 0xfb, 0x07, 0xcd, 0xbd, 0x52, 0x95, 0xd4, 0x57, 0x4d, 0xc7, 0x21, 0x9e, 0x79, 0x55,
-	0xb0, 0x84, 0x8f, 0x2c, 0xe2, 0xf8, 0x92, 0x1c, 0xcc, 0xf3, 0x4a, 0xc8, 0xb6, 0x1d, 0x91, 0xf1,
-	0xe4, 0xbb, 0x4b, 0xd8, 0xd7, 0x6a, 0x45, 0xa7, 0x8f, 0x5d, 0x98, 0x22, 0x0d, 0xec, 0x8a, 0x63,
-	0x90, 0x71, 0x64, 0xbb, 0x16, 0x39, 0xa2, 0xea, 0xab, 0xb2, 0x4f, 0x2d, 0x31, 0xe4, 0xf0, 0x84,
-	0x56, 0xb1, 0xa8, 0x3e, 0x19, 0x00, 0xf0, 0x33, 0xd1, 0x63, 0x6e, 0x1e, 0x2c, 0x1b, 0x4d, 0xb7,
-	0xd6, 0x74, 0x6a, 0xb6, 0xe3, 0x60, 0x2b, 0x04, 0x67, 0x27, 0x1b, 0xaa, 0xbe, 0xc8, 0xb0, 0x0b,
-	0x59, 0x71, 0xca, 0x8e, 0x30, 0x54, 0x9f, 0x4b, 0x00, 0x70, 0x70, 0xde, 0x80, 0x3e, 0x84, 0x85,
-	0x24, 0x76, 0xb8, 0x2f, 0x10, 0xe0, 0x57, 0x72, 0xa6, 0x6c, 0xc3, 0xb5, 0xa2, 0x3d, 0xe3, 0x47,
-	0x4d, 0x4c, 0x7d, 0x41, 0xa5, 0x26, 0xf0, 0x44, 0x07, 0xc1, 0x75, 0x0f, 0xc6, 0x9d, 0x60, 0x47,
-	0x67, 0x79, 0x76, 0x43, 0x24, 0xf0, 0x1b, 0xdd, 0x1f, 0xcc, 0xc6, 0x02, 0xdb, 0x5b, 0x9e, 0xdd,
-	0xe0, 0xc9, 0xf8, 0x23, 0x28, 0x38, 0xa6, 0x8f, 0xa9, 0x6f, 0x84, 0x27, 0x8f, 0x30, 0xe8, 0x3c,
-	0xbc, 0xef, 0x61, 0x9f, 0xaa, 0x57, 0xf3, 0xc4, 0x8b, 0xd5, 0xc8, 0xae, 0x07, 0x09, 0xb7, 0xde,
-	0xc0, 0x16, 0x0b, 0x3b, 0x7d, 0x91, 0x63, 0xf2, 0x8f, 0xcf, 0xbb, 0xcf, 0x11, 0x75, 0x0e, 0x88,
-	0x8e, 0xe0, 0x9c, 0xa0, 0x4c, 0x6c, 0x18, 0x5b, 0x59, 0x4b, 0x3d, 0xb0, 0x8a, 0x91, 0xc4, 0x7b,
-	0xc8, 0x16, 0xe2, 0x9f, 0x28, 0xb0, 0x22, 0x98, 0x89, 0x8b, 0x0d, 0xdb, 0xcd, 0x13, 0xb0, 0x9a,
-	0xf3, 0x25, 0x0b, 0x01, 0x59, 0x3a, 0xce, 0x73, 0x92, 0x07, 0x2e, 0xae, 0xb8, 0x99, 0x62, 0xbe,
-	0x0f, 0x17, 0x84, 0x16, 0xd7, 0xb4, 0x0f, 0x71, 0xa6, 0xfb, 0x5f, 0xea, 0xc1, 0x11, 0x45, 0x8e,
-	0x7c, 0x3f, 0x00, 0x96, 0xcf, 0xc1, 0xa7, 0x0a, 0x94, 0x12, 0xae, 0x20, 0xcd, 0x78, 0xfe, 0xb3,
-	0x84, 0x5c, 0xeb, 0xdd, 0x21, 0x2b, 0x91, 0x43, 0x1e, 0x34, 0xc3, 0xa0, 0x90, 0xeb, 0xfa, 0x8d,
-	0x02, 0xd7, 0x32, 0x74, 0xe5, 0xcc, 0xd5, 0x5a, 0xef, 0xd2, 0x5e, 0x94, 0x48, 0xcb, 0x9c, 0x33,
-	0x89, 0xba, 0x84, 0xaa, 0x2c, 0xc7, 0xad, 0x7f, 0x4d, 0xea, 0x62, 0x59, 0x72, 0xdf, 0xfd, 0x4e,
-	0x81, 0x97, 0xb3, 0xd5, 0xe5, 0xb8, 0xef, 0xe5, 0xde, 0x05, 0x96, 0xe4, 0x02, 0x33, 0x3d, 0x58,
-	0x82, 0x29, 0x76, 0x32, 0xf4, 0xb1, 0x59, 0x37, 0x4c, 0xcb, 0xf2, 0x30, 0xa5, 0x98, 0xaa, 0x7d,
-	0xc5, 0xfe, 0x95, 0x61, 0x7d, 0x32, 0x68, 0xda, 0xc5, 0x66, 0x7d, 0x23, 0x6c, 0x40, 0x55, 0x50,
-	0xf9, 0x76, 0x82, 0x65, 0x3c, 0x91, 0xb2, 0xab, 0xa4, 0x5e, 0xb7, 0x7d, 0xf5, 0x7a, 0xce, 0x86,
-	0x20, 0x10, 0x4a, 0xb9, 0x50, 0x26, 0x9d, 0xa5, 0x4e, 0x7d, 0x86, 0x61, 0x6d, 0x99, 0xd4, 0xe7,
-	0xc9, 0xfb, 0x26, 0x03, 0x42, 0x18, 0xe6, 0x13, 0x24, 0x61, 0xee, 0x16, 0x2c, 0xaf, 0x1c, 0x9b,
-	0x65, 0x36, 0x62, 0x11, 0x79, 0x5b, 0xd0, 0xf8, 0x70, 0x91, 0xd3, 0xf8, 0xc4, 0x60, 0x77, 0x92,
-	0x0d, 0x42, 0xa9, 0xbd, 0xe7, 0x60, 0xa3, 0x7a, 0xd0, 0xf4, 0xd8, 0x95, 0x07, 0xcf, 0xe6, 0xdf,
-	0xe8, 0x3e, 0x9b, 0x17, 0x19, 0xe2, 0x2e, 0xb9, 0x8f, 0x9f, 0xfa, 0xdb, 0x02, 0xee, 0xa6, 0x40,
-	0xe3, 0x09, 0xfe, 0x6d, 0x98, 0x65, 0xa8, 0x01, 0x6b, 0xfa, 0xba, 0xf2, 0x35, 0xd9, 0x7d, 0x4d,
-	0xfb, 0xae, 0x9f, 0xea, 0x53, 0x0c, 0x60, 0x97, 0x6c, 0x24, 0x2f, 0x36, 0x8f, 0xe0, 0x7c, 0x84,
-	0xeb, 0x90, 0xa3, 0x20, 0xea, 0x92, 0xf0, 0xe1, 0xfe, 0xef, 0x9b, 0x32, 0xf7, 0xb5, 0x93, 0xf0,
-	0x5d, 0xdf, 0xb6, 0x69, 0x7b, 0x7a, 0x41, 0xb0, 0x6d, 0x31, 0xd0, 0x04, 0xa7, 0xd8, 0x19, 0xfa,
-	0x70, 0xb9, 0x65, 0xab, 0xf9, 0x51, 0xd3, 0x74, 0x7d, 0xdb, 0x89, 0x73, 0x68, 0x74, 0x29, 0xa5,
-	0xbe, 0xd1, 0x79, 0xfb, 0x79, 0x21, 0xb5, 0xfd, 0x7c, 0x28, 0xb0, 0x2a, 0xe9, 0xab, 0x34, 0xf4,
-	0x5d, 0xb8, 0x92, 0xc5, 0x2a, 0xbb, 0x61, 0x53, 0xdf, 0xec, 0x4c, 0xfc, 0xbc, 0x94, 0x78, 0xb3,
-	0xed, 0xbe, 0x2d, 0x6f, 0xc4, 0x6d, 0xd7, 0x70, 0xea, 0x46, 0xaf, 0x23, 0xd6, 0xd3, 0x97, 0x72,
-	0xe8, 0x87, 0x0a, 0x5c, 0xa9, 0x92, 0xa6, 0x9b, 0x5c, 0x19, 0xaa, 0x4e, 0x93, 0x61, 0x06, 0x6b,
-	0x27, 0x57, 0x22, 0xe6, 0x9c, 0x62, 0x5f, 0x2d, 0x33, 0x66, 0x2d, 0x2f, 0x8d, 0x3c, 0xb2, 0x5d,
-	0xff, 0x95, 0x97, 0xf5, 0x4b, 0x0c, 0x37, 0x5a, 0x08, 0x42, 0xd4, 0x8a, 0xcb, 0x6f, 0xa5, 0x19,
-	0xe4, 0x0e, 0xf6, 0xd1, 0xc7, 0x0a, 0xac, 0x72, 0x09, 0xa9, 0x34, 0x9b, 0xab, 0xe2, 0x66, 0xd7,
-	0x2a, 0x2e, 0x33, 0xe8, 0x64, 0x56, 0xcd, 0x14, 0xb2, 0x09, 0xe3, 0x82, 0x42, 0xf8, 0x82, 0xaa,
-	0xb7, 0xba, 0xb9, 0x55, 0x3f, 0xc3, 0xad, 0xc4, 0x48, 0x29, 0xda, 0x02, 0x24, 0x70, 0xe2, 0x01,
-	0x51, 0xf5, 0x76, 0x37, 0x50, 0x93, 0xdc, 0x30, 0x96, 0x1b, 0x6c, 0x68, 0x55, 0xf1, 0xe5, 0xb5,
-	0x07, 0xfe, 0x66, 0xb7, 0x97, 0xb1, 0x33, 0x1c, 0xa2, 0x35, 0xde, 0xbf, 0x03, 0x8b, 0x02, 0x5b,
-	0x1a, 0xde, 0x77, 0xba, 0x85, 0x17, 0x0a, 0x25, 0x51, 0x1d, 0xfb, 0x34, 0x3a, 0x60, 0xbc, 0x75,
-	0x0c, 0x9f, 0x46, 0x87, 0x85, 0xd8, 0x0b, 0xed, 0x1f, 0x43, 0xe5, 0x98, 0x5e, 0x68, 0xfd, 0x06,
-	0x1e, 0xc2, 0x68, 0xea, 0xec, 0x75, 0x97, 0xe1, 0x95, 0xa4, 0x78, 0xd1, 0x2e, 0xbe, 0xf5, 0x68,
-	0xa7, 0x8f, 0x38, 0x89, 0x63, 0xd7, 0x0f, 0xa0, 0x28, 0x2e, 0x33, 0x9b, 0x75, 0x43, 0x7a, 0x66,
-	0xa6, 0xea, 0xbd, 0x93, 0x1d, 0xb4, 0x96, 0xf8, 0x45, 0x67, 0xb3, 0xbe, 0xdd, 0x7e, 0xb0, 0xa6,
-	0x88, 0xc2, 0x59, 0x71, 0xc4, 0xab, 0x36, 0x3d, 0x0f, 0xbb, 0x3e, 0x5f, 0x75, 0x8c, 0x70, 0x4c,
-	0xea, 0x56, 0x8f, 0xf7, 0xab, 0x0b, 0x1c, 0xf6, 0x26, 0x47, 0x65, 0x39, 0xfe, 0xb6, 0xc0, 0xbc,
-	0x3b, 0x30, 0x34, 0x36, 0x71, 0xe6, 0xee, 0xc0, 0xd0, 0x99, 0x89, 0xf1, 0xbb, 0x03, 0x43, 0xe3,
-	0x13, 0x13, 0x2d, 0x47, 0x84, 0xb0, 0x0e, 0xc2, 0xd7, 0xe5, 0xf6, 0x9d, 0x7c, 0x4b, 0xfb, 0x12,
-	0x6f, 0xd7, 0xd3, 0x45, 0x14, 0xf1, 0xa8, 0x3d, 0x86, 0xf1, 0x96, 0x9a, 0x1d, 0x9a, 0x87, 0xa1,
-	0xa8, 0x02, 0xa8, 0xb0, 0x0a, 0xe0, 0x69, 0x5f, 0x54, 0xff, 0x2e, 0xc3, 0x73, 0xec, 0x5f, 0xb5,
-	0x8f, 0x15, 0x15, 0xa7, 0x92, 0x93, 0xbb, 0xce, 0x27, 0x57, 0xe7, 0x3d, 0xb4, 0x3b, 0x02, 0x38,
-	0x8e, 0xc6, 0x3c, 0xe0, 0x79, 0x18, 0x62, 0x97, 0x59, 0x41, 0x53, 0x80, 0x3d, 0xac, 0x9f, 0x36,
-	0xb9, 0x95, 0xf6, 0x08, 0x66, 0xa4, 0xeb, 0x79, 0x1e, 0xdc, 0x39, 0x18, 0xe5, 0x13, 0x75, 0xc0,
-	0x57, 0xd6, 0x00, 0xb2, 0x5f, 0x1f, 0xd9, 0x8b, 0xad, 0xb5, 0x77, 0x60, 0x56, 0x7e, 0x1c, 0x6e,
-	0x33, 0x56, 0xda, 0x8c, 0xd1, 0x22, 0x0c, 0x87, 0xd4, 0x7c, 0x43, 0x36, 0xa0, 0x0f, 0x09, 0x6e,
-	0xaa, 0x7d, 0xac, 0x80, 0x9a, 0x55, 0xcc, 0x3a, 0x99, 0x68, 0x74, 0x05, 0x06, 0x79, 0x2d, 0x8d,
-	0x95, 0x66, 0x5b, 0xaa, 0xba, 0xeb, 0x25, 0x51, 0x30, 0x13, 0x7d, 0xb4, 0x43, 0x98, 0x92, 0x7c,
-	0xbf, 0xbd, 0x4d, 0x43, 0x30, 0xf5, 0x0c, 0x56, 0x30, 0x4f, 0x49, 0x98, 0x75, 0xde, 0x43, 0xb3,
-	0x60, 0x5a, 0xb6, 0x88, 0xf4, 0x48, 0x3c, 0x0b, 0x83, 0x4d, 0x66, 0x2f, 0xca, 0xd1, 0xe2, 0x49,
-	0xfb, 0xb3, 0x02, 0x5a, 0xe7, 0xaa, 0x56, 0x8f, 0xa4, 0x8f, 0x61, 0x46, 0x5e, 0x5c, 0xe3, 0xa3,
-	0xd7, 0xd2, 0xa3, 0x97, 0x96, 0xd3, 0xa6, 0x1d, 0xc9, 0x5b, 0xed, 0xe7, 0x0a, 0x4c, 0xb6, 0xdd,
-	0xb0, 0xf7, 0x28, 0xb2, 0x02, 0xfd, 0x16, 0xae, 0x32, 0x49, 0x27, 0xc8, 0x80, 0x01, 0x86, 0xe6,
-	0xc2, 0x58, 0xea, 0xd6, 0x32, 0x4f, 0x51, 0x19, 0xfa, 0x6d, 0x97, 0x87, 0x67, 0x2f, 0xa9, 0x2f,
-	0x30, 0xd6, 0x7e, 0xda, 0xe6, 0x86, 0x4a, 0xcf, 0x73, 0x25, 0xf4, 0xf4, 0x9f, 0x44, 0xcf, 0xdf,
-	0x15, 0xb8, 0xd0, 0x4d, 0x35, 0x27, 0x4f, 0xe2, 0x12, 0x0c, 0xc7, 0x15, 0x28, 0xae, 0x31, 0x7e,
-	0x81, 0x54, 0x38, 0x1d, 0x96, 0x76, 0xfa, 0xb9, 0x7e, 0xf1, 0x88, 0xca, 0x70, 0x26, 0xea, 0x16,
-	0xec, 0x4e, 0x88, 0x3a, 0xc0, 0x82, 0x6c, 0x31, 0x1d, 0x64, 0xe9, 0xaa, 0xd2, 0x98, 0x95, 0x7c,
-	0xd4, 0xfe, 0xa9, 0xc0, 0xe5, 0xae, 0xcb, 0x2b, 0xdd, 0x64, 0xb8, 0xe4, 0x38, 0xfb, 0xd2, 0xe3,
-	0xcc, 0x1e, 0xc9, 0x16, 0xa0, 0x54, 0x91, 0x22, 0x39, 0x9a, 0x42, 0x4b, 0xc2, 0x68, 0xad, 0x83,
-	0x4c, 0xd0, 0x96, 0x37, 0x9a, 0x0f, 0xf3, 0x99, 0x15, 0x9c, 0x54, 0x3c, 0x28, 0xe9, 0x78, 0xc8,
-	0x91, 0xde, 0x3a, 0xf0, 0xfe, 0xf6, 0x75, 0xe1, 0x57, 0x7d, 0xf0, 0x5a, 0xef, 0xe5, 0x9c, 0x13,
-	0xba, 0x36, 0x15, 0x42, 0xfd, 0x39, 0x21, 0x34, 0x90, 0x76, 0x3c, 0x86, 0x45, 0x79, 0x99, 0x88,
-	0xcf, 0xc0, 0x73, 0x6c, 0x06, 0x2e, 0x49, 0xe3, 0xa9, 0xbd, 0x22, 0xa5, 0x5a, 0x19, 0x2d, 0xda,
-	0xaf, 0x15, 0xd0, 0x3a, 0x17, 0x96, 0xd2, 0xa3, 0x50, 0x72, 0x46, 0xd1, 0x97, 0x1e, 0x45, 0xd2,
-	0x31, 0xfd, 0xf9, 0x13, 0x37, 0xd0, 0x3e, 0x71, 0x1f, 0x2b, 0x30, 0x97, 0x51, 0x91, 0xea, 0x31,
-	0xb1, 0x5c, 0x87, 0xe1, 0xa8, 0xa6, 0x22, 0x12, 0xff, 0x5c, 0xda, 0x87, 0x71, 0xcd, 0x2b, 0xee,
-	0xa9, 0xfd, 0x48, 0x81, 0x59, 0x79, 0x51, 0xab, 0x47, 0x1d, 0x6b, 0x30, 0x14, 0x1e, 0x2c, 0x84,
-	0x8c, 0xd9, 0xb4, 0x8c, 0xa8, 0x68, 0x16, 0xf5, 0xd3, 0xbe, 0x07, 0x6a, 0x56, 0x4d, 0x0b, 0x15,
-	0x60, 0xc4, 0xb1, 0xf7, 0x8c, 0xc6, 0x5a, 0xc3, 0x78, 0x82, 0x9f, 0x85, 0x33, 0xe4, 0x84, 0xdd,
-	0xd1, 0x1b, 0x30, 0x46, 0x44, 0x7f, 0xc3, 0x25, 0x16, 0x16, 0xbb, 0xbd, 0x85, 0x34, 0x69, 0xaa,
-	0x4c, 0x36, 0x4a, 0x12, 0x4f, 0x5a, 0x33, 0xb9, 0x9a, 0x74, 0x58, 0xdf, 0xc4, 0x22, 0xd6, 0xf7,
-	0x35, 0x2c, 0x62, 0xbf, 0x50, 0x60, 0x29, 0xaf, 0xc8, 0x75, 0xc2, 0xcd, 0xd7, 0x8d, 0x54, 0x45,
-	0x94, 0x4f, 0x84, 0x9a, 0x11, 0x0f, 0x34, 0x59, 0x04, 0xd5, 0x7e, 0xa6, 0xc0, 0x62, 0x4e, 0xad,
-	0xec, 0x84, 0xba, 0xae, 0x27, 0x4b, 0xac, 0xd2, 0x30, 0x8d, 0xab, 0x72, 0x71, 0x4f, 0xed, 0x6f,
-	0x0a, 0x5c, 0xec, 0xaa, 0xda, 0x76, 0x42, 0x79, 0x8f, 0x60, 0x26, 0x3c, 0x6c, 0x1e, 0x06, 0xa8,
-	0xd1, 0x01, 0x91, 0x4b, 0x3d, 0x97, 0x96, 0x2a, 0xab, 0xf6, 0x4d, 0x79, 0xed, 0x2f, 0xb5, 0xdf,
-	0x2a, 0x50, 0xc8, 0xaf, 0xe4, 0x9d, 0x50, 0xf7, 0xeb, 0x30, 0x9a, 0xd4, 0x2b, 0xe4, 0xce, 0xa7,
-	0xe5, 0xa6, 0x8e, 0xae, 0x87, 0xf1, 0x83, 0xf6, 0x1e, 0x4c, 0xb4, 0x56, 0xc4, 0xf2, 0xf4, 0x5c,
-	0x81, 0x41, 0x51, 0xff, 0xea, 0x93, 0x6d, 0xec, 0x45, 0x9d, 0x4b, 0xf4, 0xd1, 0x3e, 0x8d, 0xc7,
-	0x9e, 0x51, 0x12, 0xcb, 0xe3, 0x7a, 0x07, 0x66, 0xe3, 0x5f, 0x70, 0x31, 0x1b, 0x23, 0xc5, 0xad,
-	0x49, 0x67, 0x24, 0x5d, 0x71, 0x0b, 0x7f, 0x93, 0x97, 0x7a, 0xab, 0xfd, 0x3e, 0xa1, 0x4b, 0x7e,
-	0x09, 0xde, 0x63, 0x06, 0xbc, 0x07, 0x93, 0x7e, 0x7c, 0x7f, 0xcc, 0xe3, 0x48, 0x4c, 0x48, 0xcb,
-	0xbe, 0x22, 0x71, 0xcd, 0xcc, 0x6f, 0xdd, 0x27, 0xfc, 0x96, 0x37, 0xda, 0x3f, 0xe2, 0xc0, 0xcf,
-	0xbf, 0xb1, 0xcf, 0x13, 0xbb, 0x08, 0xc3, 0xa1, 0xd8, 0x6b, 0x42, 0xed, 0x90, 0x50, 0x7b, 0x2d,
-	0xd9, 0xb8, 0x26, 0x96, 0xf1, 0xb0, 0x71, 0x4d, 0x3e, 0x96, 0x81, 0x1e, 0xc7, 0x92, 0x48, 0x79,
-	0xd2, 0x0b, 0xf6, 0xbc, 0x21, 0xbc, 0x07, 0x73, 0x49, 0x21, 0x5c, 0x31, 0xff, 0xf1, 0x2a, 0x0f,
-	0x84, 0xf3, 0x99, 0x72, 0x52, 0x75, 0x02, 0xd9, 0x6b, 0xad, 0x0e, 0x53, 0x92, 0xeb, 0xf1, 0x6e,
-	0xb6, 0x47, 0xeb, 0xe9, 0xb3, 0xb5, 0x64, 0xb9, 0x8b, 0x2e, 0xdb, 0xe3, 0x33, 0xf7, 0x8f, 0x15,
-	0x58, 0xca, 0xbb, 0x29, 0xef, 0x86, 0xb8, 0x0c, 0xa3, 0xa9, 0xeb, 0x78, 0xce, 0xbd, 0x2c, 0xe5,
-	0x4e, 0xdc, 0xc1, 0xf3, 0x1f, 0xc7, 0xf1, 0x17, 0xda, 0x1f, 0x15, 0x28, 0x76, 0xba, 0xe3, 0xca,
-	0x9b, 0x93, 0xec, 0xcd, 0x91, 0x0e, 0xd3, 0xb2, 0x34, 0x2a, 0xbe, 0x82, 0x62, 0xa7, 0x2c, 0xaa,
-	0xa3, 0xf6, 0x24, 0x5a, 0xd6, 0x3f, 0xfb, 0xb2, 0xa0, 0x7c, 0xfe, 0x65, 0x41, 0xf9, 0xef, 0x97,
-	0x05, 0xe5, 0x93, 0xaf, 0x0a, 0xa7, 0x3e, 0xff, 0xaa, 0x70, 0xea, 0xdf, 0x5f, 0x15, 0x4e, 0xbd,
-	0x7b, 0xa3, 0xcb, 0x05, 0xf8, 0xe9, 0x6a, 0xfc, 0x4b, 0x75, 0xf6, 0xdb, 0xf6, 0xbd, 0x41, 0xf6,
-	0x83, 0xf4, 0xf5, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x19, 0x81, 0xa1, 0xa8, 0xed, 0x2f, 0x00,
-	0x00,
+	// 3680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0xcb, 0x6f, 0x1c, 0x47,
+	0x7a, 0x77, 0x6b, 0x68, 0x89, 0xfc, 0xf8, 0x2e, 0xbe, 0x8a, 0x0f, 0x0f, 0x47, 0x2d, 0xd9, 0xa6,
+	0x6c, 0x79, 0x68, 0x93, 0xd6, 0x4a, 0xd9, 0x38, 0xb1, 0x49, 0x4a, 0x94, 0x29, 0xd1, 0x22, 0xd5,
+	0x24, 0x45, 0xc5, 0x8e, 0xdc, 0xdb, 0x9c, 0x2e, 0x92, 0xbd, 0xea, 0xe9, 0x1e, 0x77, 0xf5, 0x90,
+	0x52, 0x12, 0x20, 0x41, 0x80, 0xf8, 0x10, 0x24, 0xc0, 0x22, 0xc8, 0x06, 0x79, 0x5d, 0x82, 0x5c,
+	0x02, 0xe4, 0x12, 0x20, 0x39, 0x26, 0xf7, 0xcd, 0x6d, 0x93, 0x53, 0x90, 0x83, 0x11, 0xd8, 0x87,
+	0xfc, 0x1b, 0x8b, 0x7a, 0xf4, 0x6b, 0xa6, 0xba, 0x67, 0x38, 0xdc, 0x0b, 0xc1, 0x7a, 0x7c, 0xbf,
+	0xdf, 0x57, 0x5f, 0x55, 0x7f, 0xf5, 0xd5, 0x57, 0x35, 0x30, 0x47, 0xea, 0x0e, 0xa5, 0x8e, 0xef,
+	0xd1, 0xe5, 0xb3, 0x3b, 0xcb, 0x27, 0xc4, 0x23, 0xd4, 0xa1, 0xd5, 0x46, 0xe0, 0x87, 0x3e, 0x1a,
+	0x8a, 0xdb, 0xaa, 0x67, 0x77, 0xe6, 0xc6, 0xad, 0xba, 0xe3, 0xf9, 0xcb, 0xfc, 0xaf, 0xe8, 0x30,
+	0x37, 0x5b, 0xf3, 0x69, 0xdd, 0xa7, 0x26, 0x2f, 0x2d, 0x8b, 0x82, 0x6c, 0x9a, 0x49, 0xe1, 0xae,
+	0x2e, 0x7b, 0xbe, 0x4d, 0x64, 0x03, 0x6e, 0x69, 0xf0, 0x6a, 0x51, 0xcb, 0x5c, 0xa6, 0x25, 0x20,
+	0x8d, 0x66, 0x48, 0x02, 0xa5, 0x14, 0xad, 0xf9, 0x81, 0x1a, 0x8f, 0x86, 0xd6, 0x4b, 0x75, 0x4b,
+	0xe8, 0x37, 0x9c, 0x9a, 0xba, 0xe5, 0x75, 0x83, 0x44, 0x6a, 0xcf, 0x66, 0x5a, 0xce, 0xfd, 0xe0,
+	0x25, 0x09, 0x14, 0x4d, 0x77, 0x96, 0x1b, 0x56, 0x60, 0xd5, 0x23, 0xa9, 0xc9, 0x13, 0xff, 0xc4,
+	0x17, 0x46, 0x60, 0xff, 0x89, 0x5a, 0xfd, 0xbb, 0x4d, 0x18, 0x7a, 0x28, 0x0c, 0xba, 0x17, 0x5a,
+	0x21, 0x41, 0x2b, 0x70, 0x55, 0x88, 0x61, 0xad, 0xa2, 0x2d, 0x0d, 0xae, 0x4c, 0x56, 0xd3, 0x06,
+	0xae, 0xee, 0xf2, 0xb6, 0xf5, 0xbe, 0x5f, 0x7c, 0xb7, 0xf8, 0x86, 0x21, 0x7b, 0x22, 0x1d, 0x86,
+	0x3d, 0xf2, 0x2a, 0x34, 0xb9, 0xfa, 0xa6, 0x63, 0xe3, 0x52, 0x45, 0x5b, 0xea, 0x33, 0x06, 0x59,
+	0xe5, 0x3e, 0xab, 0xdb, 0xb2, 0xd1, 0x1d, 0xb8, 0xca, 0x9b, 0x29, 0xee, 0xab, 0x94, 0x96, 0x06,
+	0x57, 0xde, 0xca, 0xe2, 0xca, 0x6e, 0x6b, 0x9e, 0xcd, 0xff, 0x33, 0x64, 0x67, 0x74, 0x03, 0x86,
+	0xad, 0x5a, 0xe8, 0x9c, 0x11, 0x53, 0x4a, 0xbf, 0x59, 0x29, 0x2d, 0xf5, 0x19, 0x43, 0xa2, 0x72,
+	0x5f, 0x74, 0x7a, 0x1f, 0xc6, 0x03, 0x72, 0x6e, 0x05, 0xb6, 0x75, 0xe4, 0xc6, 0x1d, 0xaf, 0xf2,
+	0x8e, 0x63, 0x49, 0x83, 0xec, 0xbc, 0x0e, 0xc3, 0x42, 0x4f, 0x61, 0x38, 0x8a, 0xaf, 0xe5, 0xea,
+	0xb3, 0xe6, 0xd9, 0x6b, 0xb5, 0xd0, 0x0f, 0xb6, 0x6c, 0x63, 0x88, 0xcb, 0x1c, 0x0a, 0x11, 0x74,
+	0x1f, 0x46, 0x04, 0x86, 0x5c, 0x00, 0x14, 0xf7, 0x77, 0x03, 0x22, 0x88, 0x0d, 0x29, 0x83, 0x9e,
+	0x02, 0x8a, 0x50, 0x98, 0x8e, 0x26, 0x5f, 0x67, 0x78, 0x80, 0x23, 0xdd, 0xc8, 0x33, 0xcf, 0xba,
+	0xeb, 0xd7, 0x5e, 0x7e, 0x4e, 0x9c, 0x93, 0xd3, 0xd0, 0x18, 0x93, 0x78, 0x4c, 0xfa, 0x09, 0x13,
+	0x46, 0x2f, 0x60, 0xc6, 0xf1, 0x8e, 0x49, 0x40, 0x02, 0x93, 0xaf, 0x3f, 0x6a, 0x1e, 0xbd, 0x36,
+	0x8f, 0x98, 0x08, 0x06, 0x8e, 0xfb, 0x8e, 0x12, 0x37, 0x05, 0xba, 0xc7, 0xc5, 0x8c, 0x49, 0x09,
+	0x23, 0x8a, 0xeb, 0xaf, 0x79, 0x0f, 0x54, 0x83, 0xb9, 0x63, 0x3f, 0x20, 0x35, 0x8b, 0x86, 0x0a,
+	0x86, 0xc1, 0x0b, 0x31, 0xcc, 0x24, 0x48, 0x59, 0x92, 0x17, 0x30, 0x23, 0xcd, 0xda, 0xc6, 0x30,
+	0x74, 0xb1, 0x31, 0x48, 0x98, 0x2c, 0xfc, 0x0e, 0xa0, 0x8c, 0x89, 0x4c, 0x52, 0xb7, 0x28, 0xfe,
+	0x84, 0x23, 0x5f, 0x57, 0x5b, 0x5d, 0x4c, 0x1f, 0x87, 0x31, 0xc6, 0xd2, 0x86, 0x79, 0x50, 0xb7,
+	0x28, 0x3a, 0x80, 0xa9, 0x56, 0xa3, 0x08, 0xcc, 0xdf, 0xea, 0x16, 0x73, 0xa2, 0xc5, 0x14, 0x1c,
+	0x76, 0x07, 0x50, 0xc6, 0x0c, 0x02, 0xf3, 0xb7, 0xbb, 0xd6, 0x33, 0x3d, 0x78, 0x0e, 0x18, 0xc2,
+	0x5b, 0x11, 0xa0, 0xeb, 0xd0, 0x90, 0x78, 0x8e, 0x77, 0x62, 0xd6, 0x7c, 0x72, 0x7c, 0xec, 0xd4,
+	0x1c, 0xe2, 0x85, 0x78, 0x8c, 0x63, 0x7f, 0x58, 0x84, 0xbd, 0x1d, 0x09, 0x6e, 0x24, 0x72, 0xc6,
+	0xbc, 0x84, 0x55, 0x35, 0xa2, 0x53, 0xa8, 0x34, 0x02, 0x72, 0xe6, 0xf8, 0x4d, 0x1a, 0x7d, 0x2d,
+	0xd1, 0x7a, 0x3f, 0x0e, 0xd8, 0x57, 0xec, 0x7b, 0x78, 0x9c, 0x13, 0x2f, 0x16, 0x11, 0xdf, 0x27,
+	0x35, 0xe3, 0xad, 0x08, 0x48, 0x7e, 0x41, 0x62, 0xe1, 0x6f, 0x4a, 0x14, 0xe4, 0x82, 0x1e, 0x33,
+	0x89, 0x49, 0xf2, 0x6a, 0xa4, 0x8d, 0x0b, 0x75, 0xc7, 0xb5, 0x18, 0x41, 0x6d, 0x45, 0x48, 0x2d,
+	0x6c, 0x3f, 0x85, 0xeb, 0x31, 0x5b, 0x34, 0x7d, 0x6d, 0x64, 0x13, 0xdd, 0x91, 0x95, 0x23, 0xa4,
+	0x4d, 0x09, 0xd4, 0xc2, 0x75, 0x04, 0x8b, 0x6d, 0x5c, 0xf1, 0xb2, 0x08, 0xac, 0xd0, 0xf1, 0xf1,
+	0x24, 0x67, 0x9a, 0xcf, 0xf3, 0x1a, 0x8c, 0x65, 0xa1, 0x95, 0x45, 0x2e, 0x0e, 0x83, 0x01, 0xa0,
+	0xa7, 0x30, 0x18, 0xfa, 0xa1, 0xe5, 0x9a, 0x7c, 0x77, 0xc2, 0x53, 0x15, 0x6d, 0x69, 0x60, 0xfd,
+	0x43, 0xe6, 0xe6, 0xff, 0xf7, 0xbb, 0xc5, 0x29, 0xb1, 0x6d, 0x52, 0xfb, 0x65, 0xd5, 0xf1, 0x97,
+	0xeb, 0x56, 0x78, 0x5a, 0xdd, 0xf2, 0xc2, 0xff, 0xfe, 0xb7, 0x0f, 0x40, 0x34, 0xb0, 0xd2, 0x3f,
+	0xfd, 0xff, 0xbf, 0xbc, 0xa7, 0x19, 0xc0, 0x41, 0xf6, 0x18, 0x06, 0xfa, 0x04, 0x06, 0x85, 0x7f,
+	0x13, 0x90, 0xd3, 0xc5, 0x2a, 0x6e, 0x79, 0x21, 0x93, 0x6e, 0x38, 0x35, 0x21, 0x7d, 0x08, 0x33,
+	0x5c, 0x2e, 0x5e, 0x35, 0x56, 0x33, 0x3c, 0xf5, 0x03, 0x27, 0x7c, 0x8d, 0x67, 0x3a, 0x9b, 0x95,
+	0xa1, 0x4d, 0x71, 0x79, 0xb9, 0x58, 0xd6, 0x22, 0x69, 0xf4, 0x1c, 0xb0, 0x00, 0xa6, 0xcd, 0xba,
+	0x79, 0x1c, 0xf8, 0x75, 0xd3, 0x26, 0x2e, 0x39, 0xb1, 0x42, 0x3f, 0xc0, 0xf8, 0x22, 0xc8, 0x7b,
+	0xcd, 0xfa, 0x66, 0xe0, 0xd7, 0xef, 0x47, 0xd2, 0xe8, 0x05, 0x8c, 0x49, 0x28, 0x62, 0x8b, 0x41,
+	0x53, 0x3c, 0xcb, 0x11, 0x57, 0x94, 0x88, 0xb1, 0xa4, 0xd4, 0x31, 0x2e, 0x6f, 0x79, 0xc7, 0xbe,
+	0x31, 0x1a, 0x63, 0x71, 0x83, 0x50, 0xf4, 0x12, 0x74, 0xa1, 0x78, 0x56, 0x69, 0x6a, 0x36, 0x1b,
+	0xbe, 0x17, 0x99, 0x09, 0xcf, 0x75, 0x37, 0x84, 0x32, 0x87, 0xca, 0xe8, 0x4f, 0x0f, 0x1a, 0xbe,
+	0x27, 0x55, 0x41, 0x5f, 0xc2, 0x6c, 0xc4, 0x1f, 0x2d, 0xeb, 0x06, 0x5b, 0x73, 0xa7, 0x56, 0x40,
+	0xf0, 0x7c, 0x77, 0xeb, 0x7a, 0x3a, 0x42, 0x10, 0xeb, 0x79, 0x97, 0x04, 0x7b, 0x4c, 0x1c, 0x79,
+	0xc9, 0xd4, 0xd6, 0xfd, 0x33, 0xcb, 0x4d, 0x79, 0xf8, 0x05, 0x8e, 0x7c, 0x37, 0x8b, 0x9c, 0x72,
+	0xed, 0x92, 0x44, 0x6a, 0xb9, 0x27, 0x26, 0x98, 0x83, 0x70, 0x9b, 0x4d, 0xd2, 0x54, 0x4d, 0xec,
+	0xf2, 0xbf, 0x56, 0xf1, 0x59, 0x4c, 0x4f, 0xfc, 0x16, 0xe7, 0x7b, 0x37, 0xcb, 0x27, 0x87, 0xd0,
+	0xbe, 0xb1, 0xb4, 0xe1, 0xf3, 0x9e, 0xe8, 0x67, 0x1a, 0x54, 0x62, 0x63, 0xe5, 0x8d, 0xac, 0xcc,
+	0x99, 0x3e, 0xef, 0x34, 0xb2, 0x9c, 0x35, 0x41, 0xda, 0x86, 0xba, 0x60, 0x2b, 0x5a, 0xe2, 0x21,
+	0xbf, 0x2a, 0xd4, 0x48, 0x8c, 0x7d, 0x51, 0xe5, 0xef, 0x5b, 0xf9, 0x15, 0x46, 0xc8, 0x63, 0x16,
+	0xc6, 0x78, 0x00, 0x10, 0x7b, 0x5f, 0x8a, 0x2b, 0x9c, 0xe3, 0xed, 0xe2, 0xd5, 0x18, 0x79, 0xd8,
+	0x94, 0x20, 0x5a, 0x87, 0x81, 0xc8, 0xd5, 0x51, 0x7c, 0x9d, 0xa3, 0xdc, 0x2c, 0x42, 0x89, 0x5d,
+	0x67, 0x22, 0x86, 0x3e, 0x83, 0x6b, 0x51, 0x90, 0xa7, 0xab, 0x22, 0x87, 0x6d, 0xe7, 0x68, 0x77,
+	0xa5, 0xf1, 0x98, 0xbc, 0x5e, 0xf3, 0xec, 0x9d, 0xe3, 0xe3, 0xda, 0xa9, 0xe5, 0x78, 0x4f, 0x7c,
+	0x9b, 0x18, 0x91, 0x18, 0x5a, 0x87, 0xfe, 0x38, 0xc4, 0xbb, 0x71, 0x21, 0x88, 0x58, 0x0e, 0x3d,
+	0x84, 0x71, 0xe1, 0x06, 0x8f, 0x09, 0x9b, 0x85, 0x33, 0xe2, 0x35, 0x09, 0xbe, 0xd9, 0xd9, 0x19,
+	0x8e, 0x72, 0xa9, 0x4d, 0x42, 0x0c, 0x21, 0x83, 0x76, 0x60, 0x2a, 0xde, 0x06, 0x64, 0x08, 0xcb,
+	0x27, 0x04, 0xbf, 0xdd, 0xd9, 0xf9, 0x4f, 0x44, 0x92, 0xbc, 0xfa, 0x90, 0xcb, 0xa1, 0xa7, 0x30,
+	0x62, 0xb9, 0xae, 0x99, 0x9a, 0xae, 0x77, 0x38, 0xd2, 0x7b, 0x9d, 0x02, 0xac, 0x78, 0xca, 0xa8,
+	0x31, 0x6c, 0xb9, 0x6e, 0x52, 0x44, 0x4f, 0x80, 0x55, 0x98, 0xc9, 0xd4, 0xbd, 0xcb, 0x11, 0x6f,
+	0x75, 0x42, 0x8c, 0xa6, 0x8f, 0x1a, 0x43, 0x96, 0xeb, 0xc6, 0x25, 0xe6, 0x52, 0x19, 0x9e, 0xeb,
+	0x53, 0x6a, 0x1e, 0x35, 0x3d, 0xdb, 0x25, 0x14, 0x2f, 0x71, 0xc8, 0xd5, 0x4e, 0x90, 0x72, 0x01,
+	0x3f, 0xb3, 0xdc, 0x26, 0x59, 0x17, 0xa2, 0x06, 0x1b, 0xef, 0xb6, 0x4f, 0xa9, 0x2c, 0xa3, 0xaf,
+	0x61, 0xd2, 0x23, 0x21, 0x9b, 0xed, 0x2c, 0xc5, 0x2d, 0x4e, 0x71, 0xbb, 0x13, 0x45, 0x06, 0x1b,
+	0x49, 0xa4, 0x34, 0xfe, 0x5f, 0x6b, 0xf0, 0x41, 0x3c, 0x67, 0x0d, 0x12, 0xd4, 0x88, 0x17, 0x5a,
+	0x27, 0xb1, 0x47, 0x0d, 0x7d, 0xf1, 0x71, 0xda, 0xc9, 0x41, 0xe2, 0x3d, 0xbe, 0xf1, 0xde, 0x95,
+	0x1b, 0xef, 0xf2, 0x89, 0x13, 0x9e, 0x36, 0x8f, 0xaa, 0x35, 0xbf, 0xbe, 0x6c, 0xb9, 0xae, 0x1f,
+	0x58, 0x1f, 0x48, 0x96, 0xa8, 0xc8, 0x57, 0x9c, 0xd8, 0x92, 0xd9, 0x3c, 0x2f, 0x45, 0x6c, 0xbb,
+	0x31, 0x99, 0x70, 0xbe, 0xfb, 0x3e, 0xff, 0x5a, 0xed, 0xf8, 0xf4, 0xb1, 0x0f, 0x13, 0x7e, 0x83,
+	0x78, 0xf2, 0x18, 0x64, 0x9e, 0x3b, 0x9e, 0xed, 0x9f, 0x53, 0x7c, 0x57, 0xf5, 0xa9, 0xa5, 0x86,
+	0x1c, 0x9d, 0xd0, 0xb6, 0x6c, 0x6a, 0x8c, 0x33, 0x00, 0x71, 0x26, 0x3a, 0x14, 0xe2, 0x6c, 0xdb,
+	0x68, 0x7a, 0xc7, 0x4d, 0xf7, 0xd8, 0x71, 0x5d, 0x62, 0x47, 0xe0, 0xfc, 0x64, 0x43, 0xf1, 0xfb,
+	0x1c, 0xbb, 0x9c, 0xb7, 0x4e, 0xf9, 0x11, 0x86, 0x1a, 0x33, 0x29, 0x00, 0x01, 0x2e, 0x1a, 0xd0,
+	0x4f, 0x61, 0x2e, 0x8d, 0x1d, 0xc5, 0x05, 0x12, 0xfc, 0x76, 0xc1, 0x94, 0xad, 0x79, 0x76, 0x1c,
+	0x33, 0x7e, 0xd3, 0x24, 0x34, 0x94, 0x54, 0x38, 0x85, 0x27, 0x3b, 0x48, 0xae, 0xc7, 0x30, 0xea,
+	0xb2, 0x88, 0xce, 0x0e, 0x9c, 0x86, 0x74, 0xe0, 0xf7, 0xba, 0x3f, 0x98, 0x0d, 0x33, 0xd9, 0xfb,
+	0x81, 0xd3, 0xe0, 0x95, 0xe8, 0x1b, 0x28, 0xbb, 0x56, 0x48, 0x68, 0x68, 0x46, 0x27, 0x8f, 0x68,
+	0xd1, 0x05, 0xe4, 0x24, 0x20, 0x21, 0xc5, 0x1f, 0x14, 0x29, 0x2f, 0x77, 0x23, 0xa7, 0xce, 0x1c,
+	0x6e, 0xbd, 0x41, 0x6c, 0xbe, 0xec, 0x8c, 0x79, 0x81, 0x29, 0x3e, 0xbe, 0xe0, 0x89, 0x40, 0x34,
+	0x04, 0x20, 0x3a, 0x87, 0xeb, 0x92, 0x32, 0x15, 0x30, 0xb6, 0xb2, 0x56, 0x7b, 0x60, 0x95, 0x23,
+	0x49, 0x62, 0xc8, 0x16, 0xe2, 0x3f, 0xd5, 0x60, 0x49, 0x32, 0xfb, 0x1e, 0x31, 0x1d, 0xaf, 0x48,
+	0x81, 0xe5, 0x82, 0x2f, 0x59, 0x2a, 0x90, 0xa7, 0xc7, 0x0d, 0x41, 0xb2, 0xe3, 0x91, 0x2d, 0x2f,
+	0x57, 0x99, 0x3f, 0x80, 0x9b, 0x52, 0x17, 0xcf, 0x62, 0x39, 0x84, 0x3c, 0xf3, 0x7f, 0xd8, 0x83,
+	0x21, 0x2a, 0x02, 0xf9, 0x09, 0x03, 0x56, 0xcf, 0xc1, 0xcf, 0x35, 0xa8, 0xa6, 0x4c, 0xe1, 0x37,
+	0x93, 0xf9, 0xcf, 0x53, 0xe4, 0xa3, 0xde, 0x0d, 0xb2, 0x14, 0x1b, 0x64, 0xa7, 0x19, 0x2d, 0x0a,
+	0xb5, 0x5e, 0x7f, 0xa7, 0xc1, 0x47, 0x39, 0x7a, 0x15, 0xcc, 0xd5, 0x4a, 0xef, 0xaa, 0xbd, 0xaf,
+	0x50, 0x2d, 0x77, 0xce, 0x14, 0xda, 0xa5, 0xb4, 0xca, 0x33, 0xdc, 0xea, 0xaf, 0x49, 0xbb, 0x44,
+	0x2d, 0xb5, 0xed, 0xfe, 0x41, 0x83, 0x8f, 0xf3, 0xb5, 0x2b, 0x30, 0xdf, 0xc7, 0xbd, 0x2b, 0x58,
+	0x55, 0x2b, 0x98, 0x6b, 0xc1, 0x2a, 0x4c, 0xf0, 0x93, 0x61, 0x48, 0xac, 0xba, 0x69, 0xd9, 0x76,
+	0x40, 0x28, 0x25, 0x14, 0x5f, 0xa9, 0x94, 0x96, 0x06, 0x8c, 0x71, 0xd6, 0xb4, 0x4f, 0xac, 0xfa,
+	0x5a, 0xd4, 0x80, 0x6a, 0x80, 0x45, 0x38, 0xc1, 0x3d, 0x9e, 0x74, 0xd9, 0x35, 0xbf, 0x5e, 0x77,
+	0x42, 0x7c, 0xa7, 0x20, 0x20, 0x60, 0x8a, 0x52, 0xa1, 0x28, 0x57, 0x9d, 0xbb, 0x4e, 0x63, 0x8a,
+	0x63, 0x6d, 0x5b, 0x34, 0x14, 0xce, 0x7b, 0x83, 0x03, 0x21, 0x02, 0xb3, 0x29, 0x92, 0xc8, 0x77,
+	0x4b, 0x96, 0x1f, 0x5d, 0x98, 0x65, 0x3a, 0x66, 0x91, 0x7e, 0x5b, 0xd2, 0x84, 0xf0, 0xb6, 0xa0,
+	0x09, 0x7d, 0x93, 0xe7, 0x24, 0x1b, 0x3e, 0xa5, 0x0e, 0x4b, 0x0b, 0xd6, 0x4e, 0x9b, 0x01, 0x4f,
+	0x79, 0x08, 0x6f, 0xfe, 0x1b, 0xdd, 0x7b, 0xf3, 0x0a, 0x47, 0xdc, 0xf7, 0x9f, 0x90, 0x57, 0xe1,
+	0xae, 0x84, 0xdb, 0x90, 0x68, 0xc2, 0xc1, 0x3f, 0x83, 0x69, 0x8e, 0xca, 0x58, 0xb3, 0xe9, 0xca,
+	0x1f, 0xab, 0xf2, 0x35, 0xed, 0x51, 0x3f, 0x35, 0x26, 0x38, 0xc0, 0xbe, 0xbf, 0x96, 0x4e, 0x6c,
+	0x9e, 0xc3, 0x8d, 0x18, 0xd7, 0xf5, 0xcf, 0xd9, 0xaa, 0x4b, 0xc3, 0x47, 0xf1, 0xdf, 0x6f, 0xaa,
+	0xcc, 0xd7, 0x4e, 0x22, 0xa2, 0xbe, 0x5d, 0xcb, 0x09, 0x8c, 0xb2, 0x64, 0xdb, 0xe6, 0xa0, 0x29,
+	0x4e, 0xd1, 0x07, 0x85, 0x70, 0xab, 0x25, 0xd4, 0xfc, 0xa6, 0x69, 0x79, 0xa1, 0xe3, 0x26, 0x3e,
+	0x34, 0x4e, 0x4a, 0xe1, 0x4f, 0x3b, 0x87, 0x9f, 0x37, 0x33, 0xe1, 0xe7, 0x53, 0x89, 0xb5, 0x95,
+	0x4d, 0xa5, 0xa1, 0xdf, 0x83, 0xdb, 0x79, 0xac, 0xaa, 0x0c, 0x1b, 0xfe, 0xac, 0x33, 0xf1, 0xbb,
+	0x4a, 0xe2, 0xcd, 0xb6, 0x7c, 0x5b, 0xd1, 0x88, 0xdb, 0xd2, 0x70, 0x78, 0xad, 0xd7, 0x11, 0x1b,
+	0xd9, 0xa4, 0x1c, 0xfa, 0x23, 0x0d, 0x6e, 0xd7, 0xfc, 0xa6, 0x97, 0xde, 0x19, 0x6a, 0x6e, 0x93,
+	0x63, 0xb2, 0xbd, 0x53, 0x68, 0x22, 0xe7, 0x9c, 0x92, 0x10, 0xaf, 0x73, 0x66, 0xbd, 0xc8, 0x8d,
+	0x1c, 0x38, 0x5e, 0xf8, 0xa3, 0x8f, 0x8d, 0x77, 0x38, 0x6e, 0xbc, 0x11, 0x44, 0xa8, 0x5b, 0x1e,
+	0xef, 0x2d, 0xa6, 0x7c, 0x8f, 0x84, 0xe8, 0x5b, 0x0d, 0x96, 0x85, 0x0a, 0x19, 0x37, 0x5b, 0xa8,
+	0xc5, 0x46, 0xd7, 0x5a, 0xdc, 0xe2, 0xd0, 0x69, 0xaf, 0x9a, 0xab, 0xc8, 0x26, 0x8c, 0x4a, 0x0a,
+	0x69, 0x0b, 0x8a, 0xef, 0x77, 0x93, 0x55, 0x1f, 0x11, 0x52, 0x72, 0xa4, 0x14, 0x6d, 0x03, 0x92,
+	0x38, 0xc9, 0x80, 0x28, 0x7e, 0xd0, 0x0d, 0xd4, 0xb8, 0x10, 0x4c, 0xd4, 0x65, 0x01, 0x2d, 0x96,
+	0x5f, 0x5e, 0xfb, 0xc2, 0xdf, 0xec, 0x36, 0x19, 0x3b, 0x25, 0x20, 0x5a, 0xd7, 0xfb, 0x4f, 0x60,
+	0x5e, 0x62, 0x2b, 0x97, 0xf7, 0xc3, 0x6e, 0xe1, 0xa5, 0x86, 0x8a, 0x55, 0x9d, 0xd8, 0x34, 0x3e,
+	0x60, 0x7c, 0x7e, 0x01, 0x9b, 0xc6, 0x87, 0x85, 0xc4, 0x0a, 0xed, 0x1f, 0xc3, 0xd6, 0x05, 0xad,
+	0xd0, 0xfa, 0x0d, 0x3c, 0x85, 0xa1, 0xcc, 0xd9, 0xeb, 0x11, 0xc7, 0xab, 0x2a, 0xf1, 0xe2, 0x28,
+	0xbe, 0xf5, 0x68, 0x67, 0x0c, 0xba, 0xa9, 0x63, 0xd7, 0x1f, 0x42, 0x45, 0x26, 0x33, 0x9b, 0x75,
+	0x53, 0x79, 0x66, 0xa6, 0xf8, 0xf1, 0xe5, 0x0e, 0x5a, 0x0b, 0x22, 0xd1, 0xd9, 0xac, 0xef, 0xb6,
+	0x1f, 0xac, 0x29, 0xa2, 0x2c, 0xd7, 0xce, 0x8f, 0x78, 0xb5, 0x66, 0x10, 0x10, 0x2f, 0x14, 0xbb,
+	0x8e, 0x19, 0x8d, 0x09, 0x6f, 0xf7, 0x98, 0x5f, 0x9d, 0x13, 0xb0, 0x1b, 0x02, 0x95, 0xfb, 0xf8,
+	0x07, 0x12, 0x13, 0x3d, 0x85, 0xa9, 0x06, 0xf1, 0x6c, 0xb6, 0xc7, 0x89, 0xa1, 0x36, 0x1b, 0xb6,
+	0x15, 0x12, 0x8a, 0xbf, 0xe8, 0xe6, 0xc6, 0x6d, 0x42, 0xca, 0xf2, 0xd2, 0x81, 0x90, 0x44, 0x5f,
+	0xc0, 0xa8, 0x15, 0xd4, 0x4e, 0x9d, 0x33, 0x62, 0x47, 0x3b, 0xda, 0x93, 0xa2, 0x5c, 0x8c, 0x04,
+	0x5b, 0x13, 0x32, 0xc6, 0x48, 0x24, 0x2c, 0xf7, 0xb3, 0x0d, 0x28, 0xa7, 0xef, 0xde, 0xcc, 0xf3,
+	0x53, 0x27, 0x24, 0xae, 0x43, 0x43, 0x93, 0x78, 0xec, 0x8a, 0xce, 0xc6, 0x3b, 0xfc, 0xd6, 0x6e,
+	0x3e, 0x75, 0xdb, 0x76, 0x18, 0xf5, 0x79, 0x20, 0xba, 0xa0, 0x07, 0xb0, 0x98, 0xb9, 0x7c, 0x53,
+	0xa0, 0xec, 0x72, 0x94, 0x85, 0xf4, 0x75, 0x5b, 0x1b, 0xcc, 0x1e, 0x4c, 0xab, 0x75, 0xc1, 0x4f,
+	0xbb, 0xf9, 0x42, 0x26, 0x55, 0x2a, 0xa2, 0x03, 0x98, 0xc9, 0xd1, 0x0d, 0x1b, 0xdd, 0xa0, 0x4e,
+	0x29, 0x55, 0x46, 0xcf, 0x60, 0xea, 0xb8, 0x29, 0x66, 0x96, 0x36, 0x8f, 0x68, 0x2d, 0x70, 0x1a,
+	0x21, 0x83, 0xc0, 0x7b, 0xed, 0xdf, 0xde, 0x6a, 0x75, 0x53, 0x74, 0xdd, 0x4b, 0xf5, 0x34, 0x26,
+	0x8f, 0xdb, 0x2b, 0x29, 0xfa, 0x14, 0x16, 0x78, 0x90, 0xa4, 0x02, 0x67, 0xf7, 0xb8, 0xfb, 0xfc,
+	0x1e, 0x77, 0x96, 0xf5, 0x51, 0x80, 0x6e, 0xd9, 0x2c, 0x25, 0x25, 0xc6, 0x5b, 0x0b, 0x88, 0x15,
+	0xfa, 0xd1, 0xd5, 0x0e, 0xc5, 0x07, 0x9d, 0xf3, 0x5b, 0x13, 0x5c, 0x72, 0x43, 0x08, 0x8a, 0x24,
+	0x05, 0x45, 0x87, 0x30, 0xc1, 0x03, 0x44, 0xcf, 0xb7, 0x89, 0x19, 0xf8, 0xa1, 0x25, 0xc6, 0xf9,
+	0x4c, 0x95, 0xa6, 0xcd, 0xfa, 0x98, 0x74, 0xc4, 0x36, 0xce, 0x30, 0x78, 0x1a, 0x2e, 0x42, 0x40,
+	0x5f, 0x00, 0xca, 0x44, 0xb6, 0x75, 0xe2, 0x85, 0x14, 0x1f, 0xb6, 0x67, 0x24, 0x56, 0xab, 0xe9,
+	0xb8, 0x95, 0x75, 0x33, 0xc6, 0xcf, 0x5b, 0x6a, 0x28, 0xda, 0x85, 0x89, 0x6c, 0x0c, 0x2b, 0xf0,
+	0x9e, 0xb7, 0x27, 0xc6, 0x57, 0xab, 0x99, 0x08, 0x95, 0x03, 0xa2, 0xa0, 0xb5, 0x8a, 0xa5, 0x1a,
+	0x87, 0xa4, 0x82, 0x47, 0xbe, 0x67, 0x53, 0xfc, 0x3b, 0xdd, 0xe5, 0xf1, 0x07, 0x85, 0xd0, 0x3a,
+	0x93, 0x41, 0x07, 0x30, 0x99, 0xc2, 0x88, 0x53, 0xbe, 0xf8, 0xcb, 0xf6, 0x60, 0x37, 0x1a, 0x26,
+	0x13, 0x4c, 0xa7, 0x95, 0xd1, 0x79, 0x6b, 0x35, 0xf3, 0xfe, 0xf1, 0x8d, 0xac, 0xdf, 0x0c, 0x5d,
+	0x87, 0xb9, 0xff, 0x30, 0x20, 0xd6, 0x4b, 0x8a, 0xbf, 0xea, 0x3a, 0x12, 0x98, 0x92, 0x10, 0x3b,
+	0x02, 0x61, 0x4f, 0x00, 0xa0, 0x0d, 0x18, 0x8d, 0xb0, 0xa9, 0x6b, 0xd1, 0x53, 0x42, 0xf1, 0xef,
+	0x72, 0xcc, 0x39, 0xa5, 0x11, 0xf7, 0x58, 0x1f, 0x63, 0x24, 0x48, 0x95, 0x08, 0x45, 0x06, 0x8c,
+	0xca, 0x71, 0xbb, 0xce, 0x19, 0xf1, 0x08, 0xa5, 0xf8, 0x45, 0x41, 0xde, 0x31, 0x7d, 0xde, 0xda,
+	0x96, 0x02, 0xc6, 0x88, 0x40, 0x88, 0xca, 0x68, 0x1f, 0xc6, 0x92, 0xeb, 0x52, 0x09, 0xfa, 0xf5,
+	0x45, 0x41, 0x47, 0xe3, 0xab, 0x51, 0x89, 0xda, 0xba, 0x6e, 0x04, 0x0c, 0x36, 0x3b, 0xad, 0x1b,
+	0x5e, 0x9b, 0x5d, 0x37, 0xa2, 0x23, 0xfa, 0x0c, 0x86, 0x03, 0x22, 0x33, 0xf2, 0x1c, 0xeb, 0x27,
+	0x6a, 0xf3, 0x25, 0x5d, 0x8c, 0xac, 0x00, 0xba, 0x07, 0xd8, 0x6a, 0x86, 0x3e, 0x53, 0xa8, 0xe1,
+	0x37, 0x3d, 0x3b, 0x75, 0xb5, 0x84, 0x2d, 0x7e, 0x68, 0x9c, 0x66, 0xed, 0x1b, 0xb2, 0x39, 0xb9,
+	0x2f, 0x42, 0x27, 0xb0, 0xd0, 0x76, 0xcb, 0x50, 0x23, 0x4e, 0x83, 0x1d, 0xbb, 0x7c, 0x97, 0xe2,
+	0xa3, 0xf6, 0x94, 0xf9, 0x6a, 0xb5, 0xe5, 0x46, 0x83, 0xf7, 0xdf, 0xf5, 0x7d, 0xd7, 0x98, 0xb5,
+	0x73, 0x5a, 0x58, 0x3a, 0xee, 0x06, 0x77, 0x54, 0x05, 0x6c, 0xcc, 0x5f, 0xd5, 0xb8, 0xbf, 0x2a,
+	0xb3, 0xae, 0x79, 0x2c, 0x5b, 0xf6, 0xa3, 0xbe, 0xfe, 0xe1, 0xb1, 0x91, 0x47, 0x7d, 0xfd, 0x23,
+	0x63, 0xa3, 0x8f, 0xfa, 0xfa, 0x47, 0xc7, 0xc6, 0x5a, 0x52, 0x69, 0xd1, 0x7b, 0x01, 0xf1, 0x81,
+	0xb4, 0x67, 0xbc, 0x5a, 0xda, 0x17, 0x44, 0xbb, 0x91, 0x7d, 0x6c, 0x20, 0x8b, 0xfa, 0x21, 0x8c,
+	0xb6, 0x6c, 0x8e, 0x68, 0x16, 0xfa, 0xe3, 0x97, 0x32, 0x1a, 0xd7, 0xf8, 0x5a, 0x28, 0xba, 0xa0,
+	0x5b, 0xf0, 0x26, 0xff, 0x17, 0x5f, 0xe1, 0x8f, 0x6f, 0x26, 0xb2, 0x96, 0x13, 0x1b, 0xb5, 0xe8,
+	0xa1, 0x87, 0x30, 0xad, 0xde, 0x75, 0x8b, 0xf0, 0x3f, 0x8d, 0x1e, 0xbf, 0xc8, 0x8d, 0x59, 0xf2,
+	0xcc, 0x29, 0x78, 0xa2, 0x3d, 0x7c, 0x28, 0x4c, 0x95, 0xf4, 0x87, 0x30, 0xda, 0xb2, 0x67, 0x15,
+	0xd1, 0xcd, 0x42, 0x3f, 0xbf, 0x6a, 0x62, 0x4d, 0x8c, 0x69, 0xc0, 0xb8, 0x66, 0x09, 0x29, 0xfd,
+	0x00, 0xa6, 0x94, 0xa7, 0xed, 0x22, 0xb8, 0xeb, 0x30, 0x24, 0xc2, 0xa8, 0x53, 0xde, 0x95, 0x43,
+	0x96, 0x8c, 0xc1, 0xa3, 0x44, 0x5a, 0x7f, 0x0e, 0xd3, 0xea, 0x64, 0x75, 0x9b, 0xb0, 0xd6, 0x26,
+	0x8c, 0xe6, 0x61, 0x20, 0xa2, 0x16, 0xe9, 0x92, 0x3e, 0xa3, 0x5f, 0x72, 0x53, 0xfd, 0x5b, 0x0d,
+	0x70, 0xde, 0x53, 0x93, 0xcb, 0x29, 0x8d, 0x6e, 0xc3, 0x55, 0xf1, 0xd2, 0x05, 0x97, 0xda, 0xdf,
+	0x5c, 0xad, 0x56, 0x05, 0x87, 0x21, 0xfb, 0xe8, 0x67, 0x30, 0xa1, 0x88, 0xae, 0x7b, 0x9b, 0x06,
+	0xb6, 0xe0, 0x38, 0x2c, 0x2e, 0xa9, 0x16, 0x9c, 0x88, 0xdb, 0x45, 0x0f, 0x3d, 0x84, 0xd9, 0xdc,
+	0x1d, 0xb7, 0x47, 0xf6, 0x56, 0xdb, 0x94, 0xda, 0x27, 0xd4, 0x86, 0x49, 0xd5, 0x76, 0xd2, 0x23,
+	0xe1, 0x34, 0x5c, 0x6d, 0x72, 0x79, 0xf9, 0x44, 0x4d, 0x96, 0xf4, 0x7f, 0xd5, 0x40, 0xef, 0xfc,
+	0xd2, 0xa5, 0x47, 0xd2, 0x43, 0x98, 0x52, 0x3f, 0xb8, 0x11, 0x36, 0xd7, 0xb3, 0x36, 0x57, 0x11,
+	0x1b, 0x93, 0xae, 0xa2, 0x56, 0xff, 0x4b, 0x0d, 0xc6, 0xdb, 0x6e, 0xdd, 0x7b, 0x54, 0x72, 0x0b,
+	0x4a, 0x36, 0xa9, 0xe1, 0xd2, 0xe5, 0x4e, 0x45, 0x0c, 0x43, 0xf7, 0x60, 0x38, 0x13, 0xe9, 0x15,
+	0x69, 0xb4, 0x0e, 0x25, 0xc7, 0x13, 0x1f, 0x45, 0x2f, 0xc7, 0x21, 0x26, 0xac, 0xff, 0x79, 0x9b,
+	0x19, 0xb6, 0x7a, 0x9e, 0x2b, 0xa9, 0x4f, 0xe9, 0x32, 0xfa, 0xfc, 0x87, 0x06, 0x37, 0xbb, 0x79,
+	0xe1, 0x51, 0xa4, 0xe2, 0x02, 0x0c, 0x24, 0xaf, 0x52, 0x84, 0x8e, 0x49, 0x05, 0xc2, 0x70, 0x2d,
+	0x7a, 0xee, 0x51, 0x12, 0xfa, 0xcb, 0x22, 0x5a, 0x87, 0x91, 0xb8, 0x1b, 0xcb, 0x58, 0xf8, 0xb8,
+	0xaf, 0xa2, 0xb5, 0x46, 0xe2, 0xab, 0xd5, 0x8c, 0x1e, 0xc6, 0xb0, 0x9d, 0x2e, 0xea, 0xff, 0xa5,
+	0xc1, 0xad, 0xae, 0x9f, 0x5c, 0x74, 0xe3, 0x57, 0xd3, 0xe3, 0xbc, 0x92, 0x1d, 0x67, 0xfe, 0x48,
+	0xb6, 0x01, 0x65, 0x1e, 0x2e, 0xa4, 0x47, 0xd3, 0x12, 0xb0, 0xb7, 0xea, 0x64, 0x8c, 0xd1, 0x96,
+	0x1a, 0xe6, 0xbc, 0x72, 0x5f, 0x75, 0x64, 0xd6, 0x83, 0x96, 0x5d, 0x0f, 0x05, 0xaa, 0x77, 0xe1,
+	0xbc, 0xfe, 0xe6, 0x0a, 0xfc, 0xb8, 0xf7, 0x27, 0x1e, 0x97, 0x34, 0x6d, 0x66, 0x09, 0x95, 0x0a,
+	0x96, 0x50, 0x5f, 0xd6, 0xf0, 0x04, 0xe6, 0xd5, 0x4f, 0x47, 0xc4, 0x0c, 0xbc, 0x59, 0xd1, 0x3a,
+	0xc6, 0x74, 0xc9, 0x4c, 0x60, 0x3b, 0xa7, 0x45, 0xff, 0x5b, 0x0d, 0xf4, 0xce, 0x8f, 0x4d, 0xb2,
+	0xa3, 0xd0, 0x0a, 0x46, 0x71, 0x25, 0x3b, 0x8a, 0xb4, 0x61, 0x4a, 0xc5, 0x13, 0xd7, 0xd7, 0x3e,
+	0x71, 0xdf, 0x6a, 0x30, 0x93, 0xf3, 0x4a, 0xa5, 0x47, 0xc7, 0x72, 0x07, 0x06, 0xe2, 0x77, 0x16,
+	0xd2, 0xf1, 0xcf, 0x64, 0x6d, 0x18, 0x33, 0x18, 0x49, 0x4f, 0xfd, 0x8f, 0xb5, 0x24, 0xcc, 0xcb,
+	0x3e, 0x74, 0xe9, 0x51, 0x8f, 0x15, 0xe8, 0x8f, 0x92, 0x8d, 0x52, 0x8d, 0xe9, 0x96, 0xec, 0x81,
+	0x6c, 0x35, 0xe2, 0x7e, 0xfa, 0xef, 0x03, 0xce, 0x7b, 0xe7, 0x82, 0xca, 0x30, 0xe8, 0x3a, 0x47,
+	0x66, 0x63, 0xa5, 0x61, 0xbe, 0x24, 0xaf, 0xa3, 0x19, 0x72, 0xa3, 0xee, 0x2c, 0xe2, 0xf4, 0x65,
+	0x7f, 0x7e, 0xa8, 0x57, 0x47, 0x9c, 0x69, 0x48, 0x63, 0xc8, 0x4f, 0x95, 0xf4, 0x66, 0x7a, 0x37,
+	0xe9, 0xb0, 0xbf, 0xc9, 0x4d, 0xec, 0xca, 0xaf, 0x61, 0x13, 0xfb, 0x2b, 0x0d, 0x16, 0x8a, 0x1e,
+	0xbe, 0x5c, 0x32, 0xe4, 0xbb, 0x97, 0x79, 0x25, 0x25, 0x26, 0x02, 0xe7, 0xac, 0x07, 0x9a, 0x7e,
+	0x18, 0xa5, 0xff, 0x85, 0x06, 0xf3, 0x05, 0xef, 0x67, 0x2e, 0xa9, 0xd7, 0x9d, 0xf4, 0xb3, 0x2b,
+	0xe5, 0x32, 0x8d, 0x99, 0x52, 0x2f, 0xad, 0xf4, 0x7f, 0xd7, 0xe0, 0xed, 0xae, 0x5e, 0xe0, 0x5c,
+	0x52, 0xbd, 0x03, 0x88, 0xd2, 0x08, 0xe6, 0x19, 0x43, 0x8d, 0x93, 0xc6, 0x42, 0xd5, 0xeb, 0xca,
+	0x03, 0x74, 0x9a, 0xdf, 0x98, 0x08, 0xda, 0x2b, 0xf5, 0xbf, 0xd7, 0xa0, 0x5c, 0xfc, 0xba, 0xe7,
+	0x92, 0x7a, 0x7f, 0x02, 0x43, 0x69, 0x7d, 0xa5, 0xba, 0xb3, 0x59, 0x75, 0x33, 0xe9, 0xec, 0xb3,
+	0xa4, 0xa0, 0x7f, 0x05, 0x63, 0xad, 0xaf, 0x64, 0x8a, 0xf4, 0xb9, 0x0d, 0x57, 0xe5, 0x9b, 0x98,
+	0x2b, 0xaa, 0xe3, 0x84, 0x00, 0x30, 0x64, 0x1f, 0xfd, 0xe7, 0xc9, 0xd8, 0x73, 0x9e, 0xc9, 0x14,
+	0x71, 0x3d, 0x87, 0xe9, 0xe4, 0x55, 0x37, 0x97, 0x31, 0x33, 0xdc, 0xba, 0x72, 0x46, 0x32, 0xf0,
+	0xf1, 0x3b, 0xfd, 0x4c, 0xad, 0xfe, 0x8f, 0x29, 0xbd, 0xd4, 0x17, 0xe3, 0x3d, 0x7a, 0xc0, 0xc7,
+	0x30, 0x1e, 0x26, 0x77, 0xca, 0x62, 0x1d, 0xe1, 0x92, 0x2a, 0xae, 0x48, 0x5d, 0x3d, 0x73, 0x42,
+	0x63, 0x2c, 0x6c, 0xa9, 0xd1, 0xff, 0x33, 0x59, 0xf8, 0xc5, 0xb7, 0xf8, 0x45, 0xca, 0xce, 0xc3,
+	0x40, 0xa4, 0xec, 0x47, 0x52, 0xdb, 0x7e, 0xa9, 0xed, 0x47, 0xe9, 0xc6, 0x15, 0x5c, 0xca, 0x34,
+	0xae, 0xa8, 0xc7, 0xd2, 0xd7, 0xe3, 0x58, 0x52, 0x2e, 0x4f, 0x79, 0xe9, 0x5e, 0x34, 0x84, 0xaf,
+	0x60, 0x26, 0xad, 0x88, 0xd0, 0x58, 0xfc, 0xa0, 0x45, 0x2c, 0x84, 0x1b, 0xb9, 0xea, 0x64, 0xde,
+	0x0e, 0xa8, 0xaa, 0xf5, 0x3a, 0x4c, 0x28, 0xae, 0xcc, 0xbb, 0x09, 0x8f, 0x56, 0xb3, 0x27, 0x7a,
+	0xc5, 0x76, 0x17, 0xa1, 0xa5, 0x4e, 0xfa, 0x7f, 0xa2, 0xc1, 0x42, 0xd1, 0xed, 0x79, 0x37, 0xc4,
+	0xeb, 0x30, 0x94, 0xb9, 0xa2, 0x17, 0xdc, 0x8b, 0x4a, 0xee, 0x04, 0xd9, 0x10, 0x0f, 0xe6, 0x45,
+	0x85, 0xfe, 0xcf, 0x1a, 0x54, 0x3a, 0xdd, 0x7b, 0x15, 0xcd, 0x49, 0x7e, 0x70, 0x64, 0xc0, 0xa4,
+	0xca, 0x8d, 0xca, 0xaf, 0xa0, 0xd2, 0xc9, 0x8b, 0xc6, 0x79, 0xc8, 0x54, 0x9d, 0xfe, 0x67, 0xc9,
+	0xbe, 0xa4, 0x4a, 0x85, 0xf6, 0xf8, 0xb1, 0xde, 0x85, 0xfe, 0x38, 0xfb, 0x5a, 0x52, 0x9d, 0x64,
+	0x32, 0x24, 0x46, 0xdc, 0x79, 0xdd, 0xf8, 0xc5, 0xf7, 0x65, 0xed, 0x97, 0xdf, 0x97, 0xb5, 0xff,
+	0xfb, 0xbe, 0xac, 0xfd, 0xec, 0x87, 0xf2, 0x1b, 0xbf, 0xfc, 0xa1, 0xfc, 0xc6, 0xff, 0xfc, 0x50,
+	0x7e, 0xe3, 0xcb, 0x7b, 0x5d, 0xc6, 0x03, 0xaf, 0x96, 0x93, 0x1f, 0xd3, 0xf1, 0x9f, 0xdf, 0x1d,
+	0x5d, 0xe5, 0xbf, 0x99, 0x5b, 0xfd, 0xd5, 0x00, 0x25, 0x22, 0xf3, 0xc9, 0x90, 0x38, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -2818,34 +3234,17 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.RewardCurrentBlockEmission.Size()
-		i -= size
-		if _, err := m.RewardCurrentBlockEmission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4
-	i--
-	dAtA[i] = 0xe2
-	{
-		size := m.TotalSumPreviousTopicWeights.Size()
-		i -= size
-		if _, err := m.TotalSumPreviousTopicWeights.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.NextDelegateStakeReceiptPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextDelegateStakeReceiptPoolId))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x98
 	}
-	i--
-	dAtA[i] = 0x4
-	i--
-	dAtA[i] = 0xda
-	if len(m.LossBundles) > 0 {
-		for iNdEx := len(m.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DelegateStakeReceiptPools) > 0 {
+		for iNdEx := len(m.DelegateStakeReceiptPools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DelegateStakeReceiptPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2853,31 +3252,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xd2
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.LowestReputerScoreEma) > 0 {
-		for iNdEx := len(m.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LowestReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xca
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.ActiveReputers) > 0 {
-		for iNdEx := len(m.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2885,15 +3279,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x6
 			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.LowestForecasterScoreEma) > 0 {
-		for iNdEx := len(m.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerCommissions) > 0 {
+		for iNdEx := len(m.ReputerCommissions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LowestForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2901,15 +3295,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xba
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.LowestInfererScoreEma) > 0 {
-		for iNdEx := len(m.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerLiveness) > 0 {
+		for iNdEx := len(m.ReputerLiveness) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LowestInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2917,15 +3311,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xb2
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.ActiveForecasters) > 0 {
-		for iNdEx := len(m.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerLiveness) > 0 {
+		for iNdEx := len(m.WorkerLiveness) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveForecasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2933,15 +3327,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.ActiveInferers) > 0 {
-		for iNdEx := len(m.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerSlashes) > 0 {
+		for iNdEx := len(m.ReputerSlashes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveInferers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2949,15 +3343,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
-		for iNdEx := len(m.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerOutlierStreaks) > 0 {
+		for iNdEx := len(m.ReputerOutlierStreaks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CountForecasterInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerOutlierStreaks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2965,15 +3359,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
-		for iNdEx := len(m.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerBondRemovals) > 0 {
+		for iNdEx := len(m.WorkerBondRemovals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CountInfererInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerBondRemovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2981,15 +3375,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerBonds) > 0 {
+		for iNdEx := len(m.WorkerBonds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicQuantileReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2997,15 +3391,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerCommitments) > 0 {
+		for iNdEx := len(m.ReputerCommitments) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicQuantileForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3013,15 +3407,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0x82
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
-		for iNdEx := len(m.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerCommitments) > 0 {
+		for iNdEx := len(m.WorkerCommitments) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicQuantileInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3029,15 +3423,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xfa
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.ReputerScoreEmas) > 0 {
-		for iNdEx := len(m.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LastNodeRotations) > 0 {
+		for iNdEx := len(m.LastNodeRotations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LastNodeRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3045,15 +3439,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xf2
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.ForecasterScoreEmas) > 0 {
-		for iNdEx := len(m.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicCreatorRewards) > 0 {
+		for iNdEx := len(m.TopicCreatorRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ForecasterScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicCreatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3061,15 +3455,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xea
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.InfererScoreEmas) > 0 {
-		for iNdEx := len(m.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextFundingSubscriptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFundingSubscriptionId))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.FundingSubscriptions) > 0 {
+		for iNdEx := len(m.FundingSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FundingSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3077,15 +3478,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xe2
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.BlockToLowestActiveTopicWeight) > 0 {
-		for iNdEx := len(m.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicReputerWhitelist) > 0 {
+		for iNdEx := len(m.TopicReputerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlockToLowestActiveTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicReputerWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3093,15 +3494,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xda
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.BlockToActiveTopics) > 0 {
-		for iNdEx := len(m.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicWorkerWhitelist) > 0 {
+		for iNdEx := len(m.TopicWorkerWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlockToActiveTopics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicWorkerWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3109,15 +3510,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x5
 			i--
-			dAtA[i] = 0xd2
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.TopicToNextPossibleChurningBlock) > 0 {
-		for iNdEx := len(m.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicReputerWhitelistEnabled) > 0 {
+		dAtA2 := make([]byte, len(m.TopicReputerWhitelistEnabled)*10)
+		var j1 int
+		for _, num := range m.TopicReputerWhitelistEnabled {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.TopicWorkerWhitelistEnabled) > 0 {
+		dAtA4 := make([]byte, len(m.TopicWorkerWhitelistEnabled)*10)
+		var j3 int
+		for _, num := range m.TopicWorkerWhitelistEnabled {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.ArchivedTopics) > 0 {
+		for iNdEx := len(m.ArchivedTopics) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicToNextPossibleChurningBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ArchivedTopics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3125,15 +3566,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xca
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.LastDripBlock) > 0 {
-		for iNdEx := len(m.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingTopicUpdates) > 0 {
+		for iNdEx := len(m.PendingTopicUpdates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LastDripBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingTopicUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3141,15 +3582,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.OpenWorkerWindows) > 0 {
-		for iNdEx := len(m.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.RewardCurrentBlockEmission.Size()
+		i -= size
+		if _, err := m.RewardCurrentBlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.TotalSumPreviousTopicWeights.Size()
+		i -= size
+		if _, err := m.TotalSumPreviousTopicWeights.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0xda
+	if len(m.LossBundles) > 0 {
+		for iNdEx := len(m.LossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OpenWorkerWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3157,15 +3622,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.LowestReputerScoreEma) > 0 {
+		for iNdEx := len(m.LowestReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowestReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.ActiveReputers) > 0 {
+		for iNdEx := len(m.ActiveReputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.LowestForecasterScoreEma) > 0 {
+		for iNdEx := len(m.LowestForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowestForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LowestInfererScoreEma) > 0 {
+		for iNdEx := len(m.LowestInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LowestInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3173,15 +3686,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveForecasters) > 0 {
+		for iNdEx := len(m.ActiveForecasters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveForecasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3189,15 +3702,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveInferers) > 0 {
+		for iNdEx := len(m.ActiveInferers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveInferers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3205,15 +3718,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CountForecasterInclusionsInTopicActiveSet) > 0 {
+		for iNdEx := len(m.CountForecasterInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutForecasterInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CountForecasterInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3221,15 +3734,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CountInfererInclusionsInTopicActiveSet) > 0 {
+		for iNdEx := len(m.CountInfererInclusionsInTopicActiveSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutInfererForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CountInfererInclusionsInTopicActiveSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3237,15 +3750,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileReputerScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileReputerScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneOutInfererInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileReputerScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3253,15 +3766,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileForecasterScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileForecasterScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestNaiveInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileForecasterScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3269,15 +3782,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreviousTopicQuantileInfererScoreEma) > 0 {
+		for iNdEx := len(m.PreviousTopicQuantileInfererScoreEma) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreviousTopicQuantileInfererScoreEma[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3285,15 +3798,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerScoreEmas) > 0 {
+		for iNdEx := len(m.ReputerScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3301,15 +3814,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ForecasterScoreEmas) > 0 {
+		for iNdEx := len(m.ForecasterScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ForecasterScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3317,15 +3830,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xea
 		}
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InfererScoreEmas) > 0 {
+		for iNdEx := len(m.InfererScoreEmas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InfererScoreEmas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3333,15 +3846,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlockToLowestActiveTopicWeight) > 0 {
+		for iNdEx := len(m.BlockToLowestActiveTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlockToLowestActiveTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3349,27 +3862,31 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xda
 		}
 	}
-	{
-		size := m.PreviousPercentageRewardToStakedReputers.Size()
-		i -= size
-		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.BlockToActiveTopics) > 0 {
+		for iNdEx := len(m.BlockToActiveTopics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockToActiveTopics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2
-	i--
-	dAtA[i] = 0xd2
-	if len(m.NetworkLossBundles) > 0 {
-		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicToNextPossibleChurningBlock) > 0 {
+		for iNdEx := len(m.TopicToNextPossibleChurningBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicToNextPossibleChurningBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3377,15 +3894,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.AllLossBundles) > 0 {
-		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LastDripBlock) > 0 {
+		for iNdEx := len(m.LastDripBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LastDripBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3393,15 +3910,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.AllForecasts) > 0 {
-		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OpenWorkerWindows) > 0 {
+		for iNdEx := len(m.OpenWorkerWindows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OpenWorkerWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3409,15 +3926,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.AllInferences) > 0 {
-		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastReputerCommit) > 0 {
+		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3425,15 +3942,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3441,15 +3958,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutForecasterForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutForecasterForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutForecasterForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3457,15 +3974,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.Reputers) > 0 {
-		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutForecasterInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutForecasterInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutForecasterInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3473,15 +3990,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutInfererForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutInfererForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutInfererForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3489,15 +4006,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.Forecasts) > 0 {
-		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneOutInfererInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneOutInfererInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneOutInfererInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3505,15 +4022,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.Inferences) > 0 {
-		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestNaiveInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestNaiveInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestNaiveInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3521,15 +4038,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3537,7 +4054,259 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	{
+		size := m.PreviousPercentageRewardToStakedReputers.Size()
+		i -= size
+		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if len(m.NetworkLossBundles) > 0 {
+		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.AllLossBundles) > 0 {
+		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.AllForecasts) > 0 {
+		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.AllInferences) > 0 {
+		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.PreviousTopicWeight) > 0 {
+		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.TopicFeeRevenue) > 0 {
+		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Reputers) > 0 {
+		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Forecasts) > 0 {
+		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Inferences) > 0 {
+		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
 			i--
 			dAtA[i] = 0xfa
 		}
@@ -3863,38 +4632,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RewardableTopics) > 0 {
-		dAtA2 := make([]byte, len(m.RewardableTopics)*10)
-		var j1 int
+		dAtA6 := make([]byte, len(m.RewardableTopics)*10)
+		var j5 int
 		for _, num := range m.RewardableTopics {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j5++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x32
 	}
 	if len(m.ActiveTopics) > 0 {
-		dAtA4 := make([]byte, len(m.ActiveTopics)*10)
-		var j3 int
+		dAtA8 := make([]byte, len(m.ActiveTopics)*10)
+		var j7 int
 		for _, num := range m.ActiveTopics {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j7++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGenesis(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -3979,6 +4748,46 @@ func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TopicIdAndTopicArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndTopicArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndTopicArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicArchive != nil {
+		{
+			size, err := m.TopicArchive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4068,20 +4877,20 @@ func (m *BlockHeightAndTopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.TopicIds) > 0 {
-		dAtA8 := make([]byte, len(m.TopicIds)*10)
-		var j7 int
+		dAtA13 := make([]byte, len(m.TopicIds)*10)
+		var j12 int
 		for _, num := range m.TopicIds {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j12++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintGenesis(dAtA, i, uint64(j7))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintGenesis(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4185,6 +4994,46 @@ func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TopicIdActorIdBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TopicIdActorIdUint64) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5354,6 +6203,53 @@ func (m *TopicIdReputerReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *TopicIdActorIdActorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdActorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdActorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Liveness != nil {
+		{
+			size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -5788,6 +6684,140 @@ func (m *GenesisState) Size() (n int) {
 	n += 2 + l + sovGenesis(uint64(l))
 	l = m.RewardCurrentBlockEmission.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.PendingTopicUpdates) > 0 {
+		for _, e := range m.PendingTopicUpdates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedTopics) > 0 {
+		for _, e := range m.ArchivedTopics {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicWorkerWhitelistEnabled) > 0 {
+		l = 0
+		for _, e := range m.TopicWorkerWhitelistEnabled {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TopicReputerWhitelistEnabled) > 0 {
+		l = 0
+		for _, e := range m.TopicReputerWhitelistEnabled {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TopicWorkerWhitelist) > 0 {
+		for _, e := range m.TopicWorkerWhitelist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicReputerWhitelist) > 0 {
+		for _, e := range m.TopicReputerWhitelist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingSubscriptions) > 0 {
+		for _, e := range m.FundingSubscriptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFundingSubscriptionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextFundingSubscriptionId))
+	}
+	if len(m.TopicCreatorRewards) > 0 {
+		for _, e := range m.TopicCreatorRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastNodeRotations) > 0 {
+		for _, e := range m.LastNodeRotations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerCommitments) > 0 {
+		for _, e := range m.WorkerCommitments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerCommitments) > 0 {
+		for _, e := range m.ReputerCommitments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerBonds) > 0 {
+		for _, e := range m.WorkerBonds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerBondRemovals) > 0 {
+		for _, e := range m.WorkerBondRemovals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerOutlierStreaks) > 0 {
+		for _, e := range m.ReputerOutlierStreaks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerSlashes) > 0 {
+		for _, e := range m.ReputerSlashes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerLiveness) > 0 {
+		for _, e := range m.WorkerLiveness {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerLiveness) > 0 {
+		for _, e := range m.ReputerLiveness {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerCommissions) > 0 {
+		for _, e := range m.ReputerCommissions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateStakeReceiptPools) > 0 {
+		for _, e := range m.DelegateStakeReceiptPools {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextDelegateStakeReceiptPoolId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextDelegateStakeReceiptPoolId))
+	}
 	return n
 }
 
@@ -5807,6 +6837,22 @@ func (m *TopicIdAndTopic) Size() (n int) {
 	return n
 }
 
+func (m *TopicIdAndTopicArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TopicArchive != nil {
+		l = m.TopicArchive.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *TopicAndActorId) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5896,7 +6942,7 @@ func (m *TopicIdActorIdScore) Size() (n int) {
 	return n
 }
 
-func (m *TopicIdActorIdUint64) Size() (n int) {
+func (m *TopicIdActorIdBlockHeight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5909,13 +6955,13 @@ func (m *TopicIdActorIdUint64) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Uint64 != 0 {
-		n += 1 + sovGenesis(uint64(m.Uint64))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
+func (m *TopicIdActorIdUint64) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5928,14 +6974,33 @@ func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.ListeningCoefficient != nil {
-		l = m.ListeningCoefficient.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Uint64 != 0 {
+		n += 1 + sovGenesis(uint64(m.Uint64))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdDec) Size() (n int) {
+func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ListeningCoefficient != nil {
+		l = m.ListeningCoefficient.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdDec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6392,6 +7457,26 @@ func (m *TopicIdReputerReputerValueBundle) Size() (n int) {
 	return n
 }
 
+func (m *TopicIdActorIdActorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Liveness != nil {
+		l = m.Liveness.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6697,9 +7782,825 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
 			}
-		case 7:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
+			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
+			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
+			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
+			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecasterScoreRatio = append(m.PreviousForecasterScoreRatio, &TopicIdAndDec{})
+			if err := m.PreviousForecasterScoreRatio[len(m.PreviousForecasterScoreRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
+			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
+			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
+			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
+			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
+			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
+			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
+			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
+			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
+			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
+			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
+			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
+			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6726,14 +8627,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
-			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6760,14 +8661,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
-			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6794,14 +8695,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
-			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
+			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6828,14 +8729,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
+			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6862,14 +8763,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
+			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6896,14 +8797,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
+			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6930,14 +8831,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
-			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
+			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6964,16 +8865,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
+			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6983,29 +8884,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7032,14 +8933,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
+			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecasterScoreRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7066,16 +8967,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecasterScoreRatio = append(m.PreviousForecasterScoreRatio, &TopicIdAndDec{})
-			if err := m.PreviousForecasterScoreRatio[len(m.PreviousForecasterScoreRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
+			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7085,29 +8986,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7134,14 +9035,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
-			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7168,14 +9069,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
-			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7202,14 +9103,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
-			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestNaiveInfererNetworkRegrets = append(m.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestNaiveInfererNetworkRegrets[len(m.LatestNaiveInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7236,14 +9137,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
-			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutInfererInfererNetworkRegrets = append(m.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutInfererInfererNetworkRegrets[len(m.LatestOneOutInfererInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7270,14 +9171,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
-			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutInfererForecasterNetworkRegrets = append(m.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutInfererForecasterNetworkRegrets[len(m.LatestOneOutInfererForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7304,14 +9205,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
-			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutForecasterInfererNetworkRegrets = append(m.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutForecasterInfererNetworkRegrets[len(m.LatestOneOutForecasterInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7338,14 +9239,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
-			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneOutForecasterForecasterNetworkRegrets = append(m.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneOutForecasterForecasterNetworkRegrets[len(m.LatestOneOutForecasterForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7372,14 +9273,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
-			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7406,14 +9307,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
-			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7440,14 +9341,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
-			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OpenWorkerWindows = append(m.OpenWorkerWindows, &BlockHeightAndTopicIds{})
+			if err := m.OpenWorkerWindows[len(m.OpenWorkerWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7474,14 +9375,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
-			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastDripBlock = append(m.LastDripBlock, &TopicIdAndBlockHeight{})
+			if err := m.LastDripBlock[len(m.LastDripBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7508,14 +9409,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
-			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicToNextPossibleChurningBlock = append(m.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
+			if err := m.TopicToNextPossibleChurningBlock[len(m.TopicToNextPossibleChurningBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7542,14 +9443,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockToActiveTopics = append(m.BlockToActiveTopics, &BlockHeightTopicIds{})
+			if err := m.BlockToActiveTopics[len(m.BlockToActiveTopics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7576,14 +9477,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockToLowestActiveTopicWeight = append(m.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
+			if err := m.BlockToLowestActiveTopicWeight[len(m.BlockToLowestActiveTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7610,14 +9511,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
-			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InfererScoreEmas = append(m.InfererScoreEmas, &TopicIdActorIdScore{})
+			if err := m.InfererScoreEmas[len(m.InfererScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7644,14 +9545,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
-			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForecasterScoreEmas = append(m.ForecasterScoreEmas, &TopicIdActorIdScore{})
+			if err := m.ForecasterScoreEmas[len(m.ForecasterScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7678,14 +9579,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
-			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerScoreEmas = append(m.ReputerScoreEmas, &TopicIdActorIdScore{})
+			if err := m.ReputerScoreEmas[len(m.ReputerScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7712,14 +9613,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
-			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileInfererScoreEma = append(m.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileInfererScoreEma[len(m.PreviousTopicQuantileInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7746,14 +9647,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
-			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileForecasterScoreEma = append(m.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileForecasterScoreEma[len(m.PreviousTopicQuantileForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 41:
+		case 65:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7780,16 +9681,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
-			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousTopicQuantileReputerScoreEma = append(m.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
+			if err := m.PreviousTopicQuantileReputerScoreEma[len(m.PreviousTopicQuantileReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 42:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7799,29 +9700,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CountInfererInclusionsInTopicActiveSet = append(m.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+			if err := m.CountInfererInclusionsInTopicActiveSet[len(m.CountInfererInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 43:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7848,14 +9749,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
-			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CountForecasterInclusionsInTopicActiveSet = append(m.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
+			if err := m.CountForecasterInclusionsInTopicActiveSet[len(m.CountForecasterInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7882,14 +9783,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
-			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveInferers = append(m.ActiveInferers, &TopicAndActorId{})
+			if err := m.ActiveInferers[len(m.ActiveInferers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 45:
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7916,14 +9817,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveForecasters = append(m.ActiveForecasters, &TopicAndActorId{})
+			if err := m.ActiveForecasters[len(m.ActiveForecasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 46:
+		case 70:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7950,14 +9851,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestInfererScoreEma = append(m.LowestInfererScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestInfererScoreEma[len(m.LowestInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 47:
+		case 71:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7984,14 +9885,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestForecasterScoreEma = append(m.LowestForecasterScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestForecasterScoreEma[len(m.LowestForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 48:
+		case 72:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestNaiveInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8018,14 +9919,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestNaiveInfererNetworkRegrets = append(m.LatestNaiveInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestNaiveInfererNetworkRegrets[len(m.LatestNaiveInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveReputers = append(m.ActiveReputers, &TopicAndActorId{})
+			if err := m.ActiveReputers[len(m.ActiveReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 49:
+		case 73:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8052,14 +9953,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutInfererInfererNetworkRegrets = append(m.LatestOneOutInfererInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutInfererInfererNetworkRegrets[len(m.LatestOneOutInfererInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LowestReputerScoreEma = append(m.LowestReputerScoreEma, &TopicIdActorIdScore{})
+			if err := m.LowestReputerScoreEma[len(m.LowestReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 50:
+		case 74:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutInfererForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8086,16 +9987,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutInfererForecasterNetworkRegrets = append(m.LatestOneOutInfererForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutInfererForecasterNetworkRegrets[len(m.LatestOneOutInfererForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LossBundles = append(m.LossBundles, &TopicIdReputerReputerValueBundle{})
+			if err := m.LossBundles[len(m.LossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 51:
+		case 75:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8105,31 +10006,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutForecasterInfererNetworkRegrets = append(m.LatestOneOutForecasterInfererNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutForecasterInfererNetworkRegrets[len(m.LatestOneOutForecasterInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalSumPreviousTopicWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 52:
+		case 76:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneOutForecasterForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8139,29 +10040,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneOutForecasterForecasterNetworkRegrets = append(m.LatestOneOutForecasterForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneOutForecasterForecasterNetworkRegrets[len(m.LatestOneOutForecasterForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardCurrentBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 53:
+		case 77:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTopicUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8188,14 +10089,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTopicUpdates = append(m.PendingTopicUpdates, &TopicIdAndTopic{})
+			if err := m.PendingTopicUpdates[len(m.PendingTopicUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 54:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8222,48 +10123,166 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ArchivedTopics = append(m.ArchivedTopics, &TopicIdAndTopicArchive{})
+			if err := m.ArchivedTopics[len(m.ArchivedTopics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 55:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenWorkerWindows", wireType)
+		case 79:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicWorkerWhitelistEnabled = append(m.TopicWorkerWhitelistEnabled, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicWorkerWhitelistEnabled) == 0 {
+					m.TopicWorkerWhitelistEnabled = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicWorkerWhitelistEnabled = append(m.TopicWorkerWhitelistEnabled, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelistEnabled", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
+		case 80:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicReputerWhitelistEnabled = append(m.TopicReputerWhitelistEnabled, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicReputerWhitelistEnabled) == 0 {
+					m.TopicReputerWhitelistEnabled = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicReputerWhitelistEnabled = append(m.TopicReputerWhitelistEnabled, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelistEnabled", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OpenWorkerWindows = append(m.OpenWorkerWindows, &BlockHeightAndTopicIds{})
-			if err := m.OpenWorkerWindows[len(m.OpenWorkerWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 56:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDripBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerWhitelist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8290,14 +10309,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastDripBlock = append(m.LastDripBlock, &TopicIdAndBlockHeight{})
-			if err := m.LastDripBlock[len(m.LastDripBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicWorkerWhitelist = append(m.TopicWorkerWhitelist, &TopicAndActorId{})
+			if err := m.TopicWorkerWhitelist[len(m.TopicWorkerWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 57:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicToNextPossibleChurningBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerWhitelist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8324,14 +10343,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicToNextPossibleChurningBlock = append(m.TopicToNextPossibleChurningBlock, &TopicIdAndBlockHeight{})
-			if err := m.TopicToNextPossibleChurningBlock[len(m.TopicToNextPossibleChurningBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicReputerWhitelist = append(m.TopicReputerWhitelist, &TopicAndActorId{})
+			if err := m.TopicReputerWhitelist[len(m.TopicReputerWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 58:
+		case 83:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockToActiveTopics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingSubscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8358,16 +10377,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockToActiveTopics = append(m.BlockToActiveTopics, &BlockHeightTopicIds{})
-			if err := m.BlockToActiveTopics[len(m.BlockToActiveTopics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FundingSubscriptions = append(m.FundingSubscriptions, &FundingSubscription{})
+			if err := m.FundingSubscriptions[len(m.FundingSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 59:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockToLowestActiveTopicWeight", wireType)
+		case 84:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingSubscriptionId", wireType)
 			}
-			var msglen int
+			m.NextFundingSubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8377,29 +10396,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextFundingSubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockToLowestActiveTopicWeight = append(m.BlockToLowestActiveTopicWeight, &BlockHeightTopicIdWeightPair{})
-			if err := m.BlockToLowestActiveTopicWeight[len(m.BlockToLowestActiveTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 60:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicCreatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8426,14 +10430,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoreEmas = append(m.InfererScoreEmas, &TopicIdActorIdScore{})
-			if err := m.InfererScoreEmas[len(m.InfererScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicCreatorRewards = append(m.TopicCreatorRewards, &TopicIdAndInt{})
+			if err := m.TopicCreatorRewards[len(m.TopicCreatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 61:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNodeRotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8460,14 +10464,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoreEmas = append(m.ForecasterScoreEmas, &TopicIdActorIdScore{})
-			if err := m.ForecasterScoreEmas[len(m.ForecasterScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastNodeRotations = append(m.LastNodeRotations, &TopicIdActorIdBlockHeight{})
+			if err := m.LastNodeRotations[len(m.LastNodeRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 62:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreEmas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerCommitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8494,14 +10498,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerScoreEmas = append(m.ReputerScoreEmas, &TopicIdActorIdScore{})
-			if err := m.ReputerScoreEmas[len(m.ReputerScoreEmas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerCommitments = append(m.WorkerCommitments, &WorkerCommitment{})
+			if err := m.WorkerCommitments[len(m.WorkerCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 63:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileInfererScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerCommitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8528,14 +10532,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileInfererScoreEma = append(m.PreviousTopicQuantileInfererScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileInfererScoreEma[len(m.PreviousTopicQuantileInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerCommitments = append(m.ReputerCommitments, &ReputerCommitment{})
+			if err := m.ReputerCommitments[len(m.ReputerCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 64:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileForecasterScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBonds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8562,14 +10566,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileForecasterScoreEma = append(m.PreviousTopicQuantileForecasterScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileForecasterScoreEma[len(m.PreviousTopicQuantileForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerBonds = append(m.WorkerBonds, &TopicIdActorIdInt{})
+			if err := m.WorkerBonds[len(m.WorkerBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 65:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicQuantileReputerScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBondRemovals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8596,14 +10600,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTopicQuantileReputerScoreEma = append(m.PreviousTopicQuantileReputerScoreEma, &TopicIdAndDec{})
-			if err := m.PreviousTopicQuantileReputerScoreEma[len(m.PreviousTopicQuantileReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerBondRemovals = append(m.WorkerBondRemovals, &WorkerBondRemovalInfo{})
+			if err := m.WorkerBondRemovals[len(m.WorkerBondRemovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 66:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountInfererInclusionsInTopicActiveSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierStreaks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8630,14 +10634,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CountInfererInclusionsInTopicActiveSet = append(m.CountInfererInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-			if err := m.CountInfererInclusionsInTopicActiveSet[len(m.CountInfererInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerOutlierStreaks = append(m.ReputerOutlierStreaks, &TopicIdActorIdUint64{})
+			if err := m.ReputerOutlierStreaks[len(m.ReputerOutlierStreaks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 67:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountForecasterInclusionsInTopicActiveSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8664,14 +10668,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CountForecasterInclusionsInTopicActiveSet = append(m.CountForecasterInclusionsInTopicActiveSet, &TopicIdActorIdUint64{})
-			if err := m.CountForecasterInclusionsInTopicActiveSet[len(m.CountForecasterInclusionsInTopicActiveSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerSlashes = append(m.ReputerSlashes, &ReputerSlash{})
+			if err := m.ReputerSlashes[len(m.ReputerSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 68:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInferers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerLiveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8698,14 +10702,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveInferers = append(m.ActiveInferers, &TopicAndActorId{})
-			if err := m.ActiveInferers[len(m.ActiveInferers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerLiveness = append(m.WorkerLiveness, &TopicIdActorIdActorLiveness{})
+			if err := m.WorkerLiveness[len(m.WorkerLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 69:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerLiveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8732,14 +10736,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveForecasters = append(m.ActiveForecasters, &TopicAndActorId{})
-			if err := m.ActiveForecasters[len(m.ActiveForecasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerLiveness = append(m.ReputerLiveness, &TopicIdActorIdActorLiveness{})
+			if err := m.ReputerLiveness[len(m.ReputerLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 70:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestInfererScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerCommissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8766,14 +10770,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestInfererScoreEma = append(m.LowestInfererScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestInfererScoreEma[len(m.LowestInfererScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerCommissions = append(m.ReputerCommissions, &ReputerCommission{})
+			if err := m.ReputerCommissions[len(m.ReputerCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 71:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestForecasterScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8800,16 +10804,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestForecasterScoreEma = append(m.LowestForecasterScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestForecasterScoreEma[len(m.LowestForecasterScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, &Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 72:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8819,29 +10823,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveReputers = append(m.ActiveReputers, &TopicAndActorId{})
-			if err := m.ActiveReputers[len(m.ActiveReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 73:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestReputerScoreEma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeReceiptPools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8868,16 +10870,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestReputerScoreEma = append(m.LowestReputerScoreEma, &TopicIdActorIdScore{})
-			if err := m.LowestReputerScoreEma[len(m.LowestReputerScoreEma)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateStakeReceiptPools = append(m.DelegateStakeReceiptPools, &DelegateStakeReceiptPool{})
+			if err := m.DelegateStakeReceiptPools[len(m.DelegateStakeReceiptPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 74:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossBundles", wireType)
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDelegateStakeReceiptPoolId", wireType)
 			}
-			var msglen int
+			m.NextDelegateStakeReceiptPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8887,31 +10889,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextDelegateStakeReceiptPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossBundles = append(m.LossBundles, &TopicIdReputerReputerValueBundle{})
-			if err := m.LossBundles[len(m.LossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 75:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSumPreviousTopicWeights", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8921,31 +10958,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalSumPreviousTopicWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 76:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurrentBlockEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8955,23 +10977,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardCurrentBlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8996,7 +11020,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndTopicArchive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9019,10 +11043,10 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndTopicArchive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndTopicArchive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9046,7 +11070,7 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicArchive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9073,10 +11097,10 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &Topic{}
+			if m.TopicArchive == nil {
+				m.TopicArchive = &TopicArchive{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopicArchive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9696,6 +11720,126 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TopicIdActorIdBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13070,6 +15214,143 @@ func (m *TopicIdReputerReputerValueBundle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TopicIdActorIdActorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdActorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdActorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Liveness == nil {
+				m.Liveness = &ActorLiveness{}
+			}
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
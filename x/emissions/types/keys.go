@@ -8,6 +8,9 @@ const (
 	AlloraStakingAccountName                   = "allorastaking"
 	AlloraRewardsAccountName                   = "allorarewards"
 	AlloraPendingRewardForDelegatorAccountName = "allorapendingrewards"
+	AlloraRequestsAccountName                  = "allorarequests"
+	AlloraWorkerBondsAccountName               = "alloraworkerbonds"
+	AlloraDelegateStakeReceiptsAccountName     = "alloradelegatestakereceipts"
 )
 
 var (
@@ -97,4 +100,51 @@ var (
 	LossBundlesKey                                    = collections.NewPrefix(82)
 	TotalSumPreviousTopicWeightsKey                   = collections.NewPrefix(83)
 	RewardCurrentBlockEmissionKey                     = collections.NewPrefix(84)
+	PendingTopicUpdatesKey                            = collections.NewPrefix(85)
+	TopicArchivesKey                                  = collections.NewPrefix(86)
+	ArchivingTopicsKey                                = collections.NewPrefix(87)
+	TopicWorkerWhitelistKey                           = collections.NewPrefix(88)
+	TopicReputerWhitelistKey                          = collections.NewPrefix(89)
+	TopicWorkerWhitelistEnabledKey                    = collections.NewPrefix(90)
+	TopicReputerWhitelistEnabledKey                   = collections.NewPrefix(91)
+	TopicsByCreatorKey                                = collections.NewPrefix(92)
+	TopicsByTagKey                                    = collections.NewPrefix(93)
+	TopicsByCategoryKey                               = collections.NewPrefix(94)
+	TopicsByLossMethodKey                             = collections.NewPrefix(95)
+	DerivedTopicsKey                                  = collections.NewPrefix(96)
+	// 97 is the first byte of the "accountNumber" prefix of x/auth, which shares the store in keeper tests
+	FundingSubscriptionsKey           = collections.NewPrefix(98)
+	NextFundingSubscriptionIdKey      = collections.NewPrefix(99)
+	FundingSubscriptionsByTopicKey    = collections.NewPrefix(100)
+	FundingSubscriptionsByFunderKey   = collections.NewPrefix(101)
+	DueFundingSubscriptionsKey        = collections.NewPrefix(102)
+	TopicCreatorRewardsKey            = collections.NewPrefix(103)
+	LastNodeRotationsKey              = collections.NewPrefix(104)
+	WorkerCommitmentsKey              = collections.NewPrefix(105)
+	ReputerCommitmentsKey             = collections.NewPrefix(106)
+	WorkerBondsKey                    = collections.NewPrefix(107)
+	WorkerBondRemovalsByBlockKey      = collections.NewPrefix(108)
+	WorkerBondRemovalsByWorkerKey     = collections.NewPrefix(109)
+	ReputerOutlierStreaksKey          = collections.NewPrefix(110)
+	ReputerSlashesKey                 = collections.NewPrefix(111)
+	WorkerLivenessKey                 = collections.NewPrefix(112)
+	ReputerLivenessKey                = collections.NewPrefix(113)
+	WorkerTopicsKey                   = collections.NewPrefix(114)
+	ReputerTopicsKey                  = collections.NewPrefix(115)
+	ReputerCommissionsKey             = collections.NewPrefix(116)
+	RedelegationsKey                  = collections.NewPrefix(117)
+	RedelegationsByMaturityKey        = collections.NewPrefix(118)
+	DelegatorDelegationsKey           = collections.NewPrefix(119)
+	AutoCompoundDelegatorsKey         = collections.NewPrefix(120)
+	DelegateStakeReceiptPoolsKey      = collections.NewPrefix(121)
+	DelegateStakeReceiptPoolIdsKey    = collections.NewPrefix(122)
+	NextDelegateStakeReceiptPoolIdKey = collections.NewPrefix(123)
+	ArchivedStakeReturnCursorsKey     = collections.NewPrefix(124)
+	ArchivedStakeReturnFailuresKey    = collections.NewPrefix(125)
+	AutoCompoundDelegationsKey        = collections.NewPrefix(126)
+	ReputerDelegationsKey             = collections.NewPrefix(127)
+	RedelegationsBySrcReputerKey      = collections.NewPrefix(128)
+	ChainLossNetworkInferencesKey     = collections.NewPrefix(129)
+	DelegateStakeRemovalsByReputerKey = collections.NewPrefix(130)
+	AutoCompoundCursorsKey            = collections.NewPrefix(131)
 )
@@ -0,0 +1,149 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Loss methods supported by the chain. Topics must use one of them: point prediction topics one of the
+// registered loss methods, probabilistic topics one of the quantile loss methods, crps or pinball.
+const (
+	LossMethodMSE     = "mse"
+	LossMethodLogMSE  = "log_mse"
+	LossMethodMAE     = "mae"
+	LossMethodHuber   = "huber"
+	LossMethodCRPS    = "crps"
+	LossMethodPinball = "pinball"
+)
+
+// Losses computed by the chain are floored to this value before taking their logarithm,
+// as perfect predictions would otherwise have a loss of log10(0)
+var MinChainComputedLoss = alloraMath.MustNewDecFromString("1e-18")
+
+// Loss of a prediction given the ground truth
+type LossFunction func(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error)
+
+var lossFunctions = map[string]LossFunction{
+	LossMethodMSE:    squaredErrorLoss,
+	LossMethodLogMSE: squaredLogErrorLoss,
+	LossMethodMAE:    absoluteErrorLoss,
+	LossMethodHuber:  huberLoss,
+}
+
+// True if the loss method is supported by the chain
+func IsRegisteredLossMethod(lossMethod string) bool {
+	_, ok := lossFunctions[lossMethod]
+	return ok
+}
+
+// Returns the names of the registered loss methods in alphabetical order
+func RegisteredLossMethods() []string {
+	lossMethods := make([]string, 0, len(lossFunctions))
+	for lossMethod := range lossFunctions {
+		lossMethods = append(lossMethods, lossMethod)
+	}
+	sort.Strings(lossMethods)
+	return lossMethods
+}
+
+// Returns the loss function of a registered loss method
+func GetLossFunction(lossMethod string) (LossFunction, error) {
+	lossFunction, ok := lossFunctions[lossMethod]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnregisteredLossMethod, "%q, expected one of %v", lossMethod, RegisteredLossMethods())
+	}
+	return lossFunction, nil
+}
+
+// Returns log10 of the loss of the prediction, the form in which losses are stored in value bundles
+func CalcLogLoss(lossFunction LossFunction, prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	return CalcVectorLogLoss(lossFunction, []alloraMath.Dec{prediction}, []alloraMath.Dec{groundTruth})
+}
+
+// Returns log10 of the loss of a prediction with several outputs, defined as the mean of the losses of its outputs
+func CalcVectorLogLoss(lossFunction LossFunction, prediction, groundTruth []alloraMath.Dec) (alloraMath.Dec, error) {
+	if len(prediction) == 0 || len(prediction) != len(groundTruth) {
+		return alloraMath.Dec{}, errors.Wrapf(ErrInvalidOutputDimension,
+			"prediction has %d values, ground truth has %d", len(prediction), len(groundTruth))
+	}
+	sumLoss := alloraMath.ZeroDec()
+	for i := range prediction {
+		componentLoss, err := lossFunction(prediction[i], groundTruth[i])
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		sumLoss, err = sumLoss.Add(componentLoss)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+	}
+	loss, err := sumLoss.Quo(alloraMath.NewDecFromInt64(int64(len(prediction))))
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	loss, err = alloraMath.Max(loss, MinChainComputedLoss)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return alloraMath.Log10(loss)
+}
+
+// (p - y)^2
+func squaredErrorLoss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	diff, err := prediction.Sub(groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return diff.Mul(diff)
+}
+
+// (ln(1 + p) - ln(1 + y))^2, defined for values greater than -1
+func squaredLogErrorLoss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	logOnePlus := func(x alloraMath.Dec) (alloraMath.Dec, error) {
+		onePlusX, err := x.Add(alloraMath.OneDec())
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		if !onePlusX.IsPositive() {
+			return alloraMath.Dec{}, errors.Wrapf(ErrInvalidValue, "log_mse loss undefined for %s", x.String())
+		}
+		return alloraMath.Ln(onePlusX)
+	}
+	logPrediction, err := logOnePlus(prediction)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	logGroundTruth, err := logOnePlus(groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return squaredErrorLoss(logPrediction, logGroundTruth)
+}
+
+// |p - y|
+func absoluteErrorLoss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	diff, err := prediction.Sub(groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return diff.Abs()
+}
+
+// Huber loss with delta = 1: (p - y)^2 / 2 when |p - y| <= 1, |p - y| - 1/2 otherwise
+func huberLoss(prediction, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	half := alloraMath.MustNewDecFromString("0.5")
+	absDiff, err := absoluteErrorLoss(prediction, groundTruth)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	if absDiff.Lte(alloraMath.OneDec()) {
+		squared, err := absDiff.Mul(absDiff)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		return squared.Mul(half)
+	}
+	return absDiff.Sub(half)
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLossFunctions(t *testing.T) {
+	tests := []struct {
+		lossMethod   string
+		prediction   string
+		groundTruth  string
+		expectedLoss string
+	}{
+		{types.LossMethodMSE, "3", "1", "4"},
+		{types.LossMethodMSE, "-1.5", "1", "6.25"},
+		{types.LossMethodMAE, "3", "1", "2"},
+		{types.LossMethodMAE, "-1.5", "1", "2.5"},
+		{types.LossMethodHuber, "1.5", "1", "0.125"},
+		{types.LossMethodHuber, "4", "1", "2.5"},
+		{types.LossMethodLogMSE, "1", "1", "0"},
+	}
+	for _, tc := range tests {
+		lossFunction, err := types.GetLossFunction(tc.lossMethod)
+		require.NoError(t, err)
+		loss, err := lossFunction(alloraMath.MustNewDecFromString(tc.prediction), alloraMath.MustNewDecFromString(tc.groundTruth))
+		require.NoError(t, err)
+		require.True(t, alloraMath.MustNewDecFromString(tc.expectedLoss).Equal(loss),
+			"%s loss of %s against %s: expected %s, got %s", tc.lossMethod, tc.prediction, tc.groundTruth, tc.expectedLoss, loss)
+	}
+}
+
+func TestLogMSELossUndefinedBelowMinusOne(t *testing.T) {
+	lossFunction, err := types.GetLossFunction(types.LossMethodLogMSE)
+	require.NoError(t, err)
+	_, err = lossFunction(alloraMath.MustNewDecFromString("-1"), alloraMath.OneDec())
+	require.ErrorIs(t, err, types.ErrInvalidValue)
+
+	loss, err := lossFunction(alloraMath.MustNewDecFromString("-0.5"), alloraMath.MustNewDecFromString("-0.5"))
+	require.NoError(t, err)
+	require.True(t, loss.IsZero())
+}
+
+func TestGetLossFunctionUnregistered(t *testing.T) {
+	_, err := types.GetLossFunction("rmse")
+	require.ErrorIs(t, err, types.ErrUnregisteredLossMethod)
+	require.False(t, types.IsRegisteredLossMethod("MSE"))
+	require.Equal(t, []string{"huber", "log_mse", "mae", "mse"}, types.RegisteredLossMethods())
+	// Quantile loss methods only score the quantiles of probabilistic topics
+	_, err = types.GetLossFunction(types.LossMethodCRPS)
+	require.ErrorIs(t, err, types.ErrUnregisteredLossMethod)
+}
+
+func TestCalcLogLossFloorsPerfectPredictions(t *testing.T) {
+	lossFunction, err := types.GetLossFunction(types.LossMethodMSE)
+	require.NoError(t, err)
+
+	logLoss, err := types.CalcLogLoss(lossFunction, alloraMath.NewDecFromInt64(10), alloraMath.NewDecFromInt64(0))
+	require.NoError(t, err)
+	require.True(t, alloraMath.NewDecFromInt64(2).Equal(logLoss))
+
+	logLoss, err = types.CalcLogLoss(lossFunction, alloraMath.NewDecFromInt64(7), alloraMath.NewDecFromInt64(7))
+	require.NoError(t, err)
+	require.True(t, alloraMath.NewDecFromInt64(-18).Equal(logLoss))
+}
+
+func TestCalcVectorLogLossAveragesOutputs(t *testing.T) {
+	lossFunction, err := types.GetLossFunction(types.LossMethodMSE)
+	require.NoError(t, err)
+
+	prediction := []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(2)}
+	groundTruth := []alloraMath.Dec{alloraMath.NewDecFromInt64(11), alloraMath.NewDecFromInt64(12)}
+	logLoss, err := types.CalcVectorLogLoss(lossFunction, prediction, groundTruth)
+	require.NoError(t, err)
+	require.True(t, alloraMath.NewDecFromInt64(2).Equal(logLoss))
+
+	_, err = types.CalcVectorLogLoss(lossFunction, prediction, groundTruth[:1])
+	require.ErrorIs(t, err, types.ErrInvalidOutputDimension)
+	_, err = types.CalcVectorLogLoss(lossFunction, nil, nil)
+	require.ErrorIs(t, err, types.ErrInvalidOutputDimension)
+}
+
+func TestReputerValueBundleWithGroundTruthSignatureExcludesLosses(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	reputer := sdk.AccAddress(privKey.PubKey().Address()).String()
+	groundTruth := alloraMath.NewDecFromInt64(3)
+	valueBundle := &types.ValueBundle{
+		TopicId:                       1,
+		ReputerRequestNonce:           &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: 1}},
+		Reputer:                       reputer,
+		ExtraData:                     nil,
+		CombinedValue:                 alloraMath.Dec{},
+		InfererValues:                 nil,
+		ForecasterValues:              nil,
+		NaiveValue:                    alloraMath.Dec{},
+		OneOutInfererValues:           nil,
+		OneOutForecasterValues:        nil,
+		OneInForecasterValues:         nil,
+		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   &groundTruth,
+		GroundTruthValues:             nil,
+	}
+	src, err := valueBundle.XXX_Marshal(make([]byte, 0), true)
+	require.NoError(t, err)
+	signature, err := privKey.Sign(src)
+	require.NoError(t, err)
+	bundle := types.ReputerValueBundle{
+		ValueBundle: valueBundle,
+		Signature:   signature,
+		Pubkey:      hex.EncodeToString(privKey.PubKey().Bytes()),
+	}
+	require.NoError(t, bundle.Validate())
+
+	// Losses filled in by the chain are not part of the signed bytes
+	valueBundle.CombinedValue = alloraMath.MustNewDecFromString("0.6")
+	valueBundle.NaiveValue = alloraMath.MustNewDecFromString("0.7")
+	valueBundle.InfererValues = []*types.WorkerAttributedValue{{Worker: reputer, Value: alloraMath.MustNewDecFromString("0.6")}}
+	require.NoError(t, bundle.Validate())
+
+	// The ground truth is part of the signed bytes
+	otherGroundTruth := alloraMath.NewDecFromInt64(4)
+	valueBundle.GroundTruth = &otherGroundTruth
+	require.Error(t, bundle.Validate())
+}
@@ -4,7 +4,12 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -74,29 +79,335 @@ func (m *OffchainNode) GetNodeAddress() string {
 	return ""
 }
 
+// Liveness of a worker or reputer registered in a topic
+type ActorLiveness struct {
+	// block height of the last nonce the actor submitted a payload for
+	LastSubmissionNonce int64 `protobuf:"varint,1,opt,name=last_submission_nonce,json=lastSubmissionNonce,proto3" json:"last_submission_nonce,omitempty"`
+	// block height since which the actor has been expected to submit,
+	// i.e. at which it was first tracked or last unjailed
+	ActiveSince   int64 `protobuf:"varint,2,opt,name=active_since,json=activeSince,proto3" json:"active_since,omitempty"`
+	Jailed        bool  `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailedAtBlock int64 `protobuf:"varint,4,opt,name=jailed_at_block,json=jailedAtBlock,proto3" json:"jailed_at_block,omitempty"`
+}
+
+func (m *ActorLiveness) Reset()         { *m = ActorLiveness{} }
+func (m *ActorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ActorLiveness) ProtoMessage()    {}
+func (*ActorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d7c0385e42299b, []int{1}
+}
+func (m *ActorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorLiveness.Merge(m, src)
+}
+func (m *ActorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorLiveness proto.InternalMessageInfo
+
+func (m *ActorLiveness) GetLastSubmissionNonce() int64 {
+	if m != nil {
+		return m.LastSubmissionNonce
+	}
+	return 0
+}
+
+func (m *ActorLiveness) GetActiveSince() int64 {
+	if m != nil {
+		return m.ActiveSince
+	}
+	return 0
+}
+
+func (m *ActorLiveness) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ActorLiveness) GetJailedAtBlock() int64 {
+	if m != nil {
+		return m.JailedAtBlock
+	}
+	return 0
+}
+
+// Participation of an actor registered as a worker in a topic
+type WorkerTopicProfile struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// scores of the worker at the last block its score emas were updated at,
+	// left empty if the worker was not scored at that block
+	LatestInfererScore    *Score                `protobuf:"bytes,2,opt,name=latest_inferer_score,json=latestInfererScore,proto3" json:"latest_inferer_score,omitempty"`
+	InfererScoreEma       *Score                `protobuf:"bytes,3,opt,name=inferer_score_ema,json=infererScoreEma,proto3" json:"inferer_score_ema,omitempty"`
+	LatestForecasterScore *Score                `protobuf:"bytes,4,opt,name=latest_forecaster_score,json=latestForecasterScore,proto3" json:"latest_forecaster_score,omitempty"`
+	ForecasterScoreEma    *Score                `protobuf:"bytes,5,opt,name=forecaster_score_ema,json=forecasterScoreEma,proto3" json:"forecaster_score_ema,omitempty"`
+	InfererInclusions     uint64                `protobuf:"varint,6,opt,name=inferer_inclusions,json=infererInclusions,proto3" json:"inferer_inclusions,omitempty"`
+	ForecasterInclusions  uint64                `protobuf:"varint,7,opt,name=forecaster_inclusions,json=forecasterInclusions,proto3" json:"forecaster_inclusions,omitempty"`
+	Bond                  cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
+	// fractions of the worker rewards of the topic earned at the last rewarded epoch
+	PreviousInferenceRewardFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,9,opt,name=previous_inference_reward_fraction,json=previousInferenceRewardFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"previous_inference_reward_fraction"`
+	PreviousForecastRewardFraction  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,opt,name=previous_forecast_reward_fraction,json=previousForecastRewardFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"previous_forecast_reward_fraction"`
+	IsActiveInferer                 bool                                            `protobuf:"varint,11,opt,name=is_active_inferer,json=isActiveInferer,proto3" json:"is_active_inferer,omitempty"`
+	IsActiveForecaster              bool                                            `protobuf:"varint,12,opt,name=is_active_forecaster,json=isActiveForecaster,proto3" json:"is_active_forecaster,omitempty"`
+}
+
+func (m *WorkerTopicProfile) Reset()         { *m = WorkerTopicProfile{} }
+func (m *WorkerTopicProfile) String() string { return proto.CompactTextString(m) }
+func (*WorkerTopicProfile) ProtoMessage()    {}
+func (*WorkerTopicProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d7c0385e42299b, []int{2}
+}
+func (m *WorkerTopicProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerTopicProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerTopicProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerTopicProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerTopicProfile.Merge(m, src)
+}
+func (m *WorkerTopicProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerTopicProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerTopicProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerTopicProfile proto.InternalMessageInfo
+
+func (m *WorkerTopicProfile) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *WorkerTopicProfile) GetLatestInfererScore() *Score {
+	if m != nil {
+		return m.LatestInfererScore
+	}
+	return nil
+}
+
+func (m *WorkerTopicProfile) GetInfererScoreEma() *Score {
+	if m != nil {
+		return m.InfererScoreEma
+	}
+	return nil
+}
+
+func (m *WorkerTopicProfile) GetLatestForecasterScore() *Score {
+	if m != nil {
+		return m.LatestForecasterScore
+	}
+	return nil
+}
+
+func (m *WorkerTopicProfile) GetForecasterScoreEma() *Score {
+	if m != nil {
+		return m.ForecasterScoreEma
+	}
+	return nil
+}
+
+func (m *WorkerTopicProfile) GetInfererInclusions() uint64 {
+	if m != nil {
+		return m.InfererInclusions
+	}
+	return 0
+}
+
+func (m *WorkerTopicProfile) GetForecasterInclusions() uint64 {
+	if m != nil {
+		return m.ForecasterInclusions
+	}
+	return 0
+}
+
+func (m *WorkerTopicProfile) GetIsActiveInferer() bool {
+	if m != nil {
+		return m.IsActiveInferer
+	}
+	return false
+}
+
+func (m *WorkerTopicProfile) GetIsActiveForecaster() bool {
+	if m != nil {
+		return m.IsActiveForecaster
+	}
+	return false
+}
+
+// Participation of an actor registered as a reputer in a topic
+type ReputerTopicProfile struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// score of the reputer at the last block its score ema was updated at,
+	// left empty if the reputer was not scored at that block
+	LatestScore *Score `protobuf:"bytes,2,opt,name=latest_score,json=latestScore,proto3" json:"latest_score,omitempty"`
+	ScoreEma    *Score `protobuf:"bytes,3,opt,name=score_ema,json=scoreEma,proto3" json:"score_ema,omitempty"`
+	// stake of the reputer including the stake delegated to it
+	Stake          cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+	DelegatedStake cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=delegated_stake,json=delegatedStake,proto3,customtype=cosmossdk.io/math.Int" json:"delegated_stake"`
+	// fraction of the reputer rewards of the topic earned at the last rewarded epoch
+	PreviousRewardFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,opt,name=previous_reward_fraction,json=previousRewardFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"previous_reward_fraction"`
+	IsActive               bool                                            `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// share of the rewards of the delegators the reputer keeps as commission
+	CommissionRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"commission_rate"`
+}
+
+func (m *ReputerTopicProfile) Reset()         { *m = ReputerTopicProfile{} }
+func (m *ReputerTopicProfile) String() string { return proto.CompactTextString(m) }
+func (*ReputerTopicProfile) ProtoMessage()    {}
+func (*ReputerTopicProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82d7c0385e42299b, []int{3}
+}
+func (m *ReputerTopicProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerTopicProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerTopicProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerTopicProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerTopicProfile.Merge(m, src)
+}
+func (m *ReputerTopicProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerTopicProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerTopicProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerTopicProfile proto.InternalMessageInfo
+
+func (m *ReputerTopicProfile) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *ReputerTopicProfile) GetLatestScore() *Score {
+	if m != nil {
+		return m.LatestScore
+	}
+	return nil
+}
+
+func (m *ReputerTopicProfile) GetScoreEma() *Score {
+	if m != nil {
+		return m.ScoreEma
+	}
+	return nil
+}
+
+func (m *ReputerTopicProfile) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*OffchainNode)(nil), "emissions.v3.OffchainNode")
+	proto.RegisterType((*ActorLiveness)(nil), "emissions.v3.ActorLiveness")
+	proto.RegisterType((*WorkerTopicProfile)(nil), "emissions.v3.WorkerTopicProfile")
+	proto.RegisterType((*ReputerTopicProfile)(nil), "emissions.v3.ReputerTopicProfile")
 }
 
 func init() { proto.RegisterFile("emissions/v3/node.proto", fileDescriptor_82d7c0385e42299b) }
 
 var fileDescriptor_82d7c0385e42299b = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xcd, 0xcd, 0x2c,
-	0x2e, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x33, 0xd6, 0xcf, 0xcb, 0x4f, 0x49, 0xd5, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x4b, 0xe8, 0x95, 0x19, 0x2b, 0xd5, 0x71, 0xf1, 0xf8, 0xa7,
-	0xa5, 0x25, 0x67, 0x24, 0x66, 0xe6, 0xf9, 0xe5, 0xa7, 0xa4, 0x0a, 0x89, 0x70, 0xb1, 0xe6, 0x97,
-	0xe7, 0xa5, 0x16, 0x49, 0x30, 0x2b, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x38, 0x42, 0x8a, 0x5c, 0x3c,
-	0x20, 0x13, 0xe2, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x25, 0x58, 0xc0, 0x92, 0xdc, 0x20,
-	0x31, 0x47, 0x88, 0x90, 0x17, 0x0b, 0x07, 0xa3, 0x00, 0x93, 0x17, 0x0b, 0x07, 0x93, 0x00, 0xb3,
-	0x17, 0x0b, 0x07, 0xab, 0x00, 0x5b, 0x10, 0x77, 0x4e, 0x66, 0x52, 0x7c, 0x81, 0x51, 0x41, 0x7c,
-	0x76, 0x6a, 0x65, 0x10, 0x6f, 0x6e, 0x69, 0x4e, 0x49, 0x26, 0xcc, 0x80, 0x20, 0x76, 0xb0, 0x71,
-	0x99, 0x29, 0x4e, 0x41, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x91,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x98, 0x93, 0x93, 0x5f, 0x94,
-	0xa8, 0x9b, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0x0d, 0xe3, 0x82, 0x5d, 0xad, 0x5f, 0xa1, 0x8f,
-	0xf0, 0x69, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xa3, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3d, 0xe3, 0x3c, 0x9c, 0x03, 0x01, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x6b, 0x67, 0x33, 0x76, 0xea, 0x64, 0x62, 0xb7, 0xdb, 0x20, 0x39, 0xad, 0x0f,
+	0xa8, 0xaa, 0x14, 0x6f, 0x14, 0x4b, 0xc0, 0x0d, 0x39, 0x6a, 0x22, 0x39, 0xa0, 0x82, 0xc6, 0x48,
+	0x08, 0x2e, 0xc3, 0x78, 0x77, 0xec, 0x0c, 0xde, 0x9d, 0x31, 0x33, 0x63, 0x87, 0x5e, 0x38, 0x20,
+	0x3e, 0x00, 0x9f, 0x02, 0x71, 0x83, 0x03, 0x1f, 0xa2, 0xc7, 0x8a, 0x13, 0xe2, 0x50, 0x50, 0x72,
+	0xe0, 0x6b, 0xa0, 0x9d, 0xd9, 0x5d, 0x3b, 0x46, 0xa5, 0x51, 0x72, 0xb1, 0xf6, 0xfd, 0xf9, 0xfd,
+	0xde, 0xef, 0xf9, 0xbd, 0xb7, 0x0b, 0x1e, 0xd0, 0x84, 0x29, 0xc5, 0x04, 0x57, 0xc1, 0xbc, 0x1b,
+	0x70, 0x11, 0xd1, 0xce, 0x54, 0x0a, 0x2d, 0x60, 0xad, 0x08, 0x74, 0xe6, 0xdd, 0xbd, 0x1d, 0x92,
+	0x30, 0x2e, 0x02, 0xf3, 0x6b, 0x13, 0xf6, 0x1e, 0x86, 0x42, 0x25, 0x42, 0x61, 0x63, 0x05, 0xd6,
+	0xc8, 0x42, 0xfe, 0x35, 0x52, 0x15, 0x0a, 0x99, 0xb1, 0xee, 0x35, 0xc6, 0x62, 0x2c, 0x2c, 0x22,
+	0x7d, 0xb2, 0xde, 0xf6, 0x77, 0xa0, 0xf6, 0xc9, 0x68, 0x14, 0x9e, 0x13, 0xc6, 0x9f, 0x8b, 0x88,
+	0xc2, 0x06, 0x28, 0x8b, 0x0b, 0x4e, 0xa5, 0x5f, 0x7a, 0xe4, 0x3c, 0xd9, 0x44, 0xd6, 0x80, 0x8f,
+	0x41, 0x2d, 0xd5, 0x87, 0x49, 0x14, 0x49, 0xaa, 0x94, 0xef, 0x9a, 0x60, 0x35, 0xf5, 0xf5, 0xac,
+	0xeb, 0xcc, 0xf5, 0x9c, 0xed, 0xf5, 0x33, 0xd7, 0x5b, 0xdf, 0x2e, 0x9d, 0xb9, 0x5e, 0x79, 0xbb,
+	0x82, 0xaa, 0x31, 0x1b, 0xe2, 0xe9, 0xd1, 0x14, 0x4f, 0xe8, 0x0b, 0xb4, 0x95, 0xcc, 0x62, 0xcd,
+	0x72, 0x02, 0xb4, 0x61, 0xe8, 0x58, 0xd4, 0xfe, 0xc9, 0x01, 0x5b, 0xbd, 0x50, 0x0b, 0xf9, 0x31,
+	0x9b, 0x53, 0x4e, 0x95, 0x82, 0x47, 0xa0, 0x19, 0x13, 0xa5, 0xb1, 0x9a, 0x0d, 0xb3, 0x56, 0x30,
+	0x17, 0x3c, 0xa4, 0xbe, 0xf3, 0xc8, 0x79, 0x52, 0x42, 0xbb, 0x69, 0x70, 0x50, 0xc4, 0x9e, 0xa7,
+	0xa1, 0x54, 0x1f, 0x09, 0x35, 0x9b, 0x53, 0xac, 0x58, 0x9a, 0xba, 0x6e, 0x52, 0xab, 0xd6, 0x37,
+	0x48, 0x5d, 0xf0, 0x3e, 0xa8, 0x7c, 0x4d, 0x58, 0x4c, 0x23, 0xd3, 0x99, 0x87, 0x32, 0x0b, 0xbe,
+	0x0b, 0xea, 0xf6, 0x09, 0x13, 0x8d, 0x87, 0xb1, 0x08, 0x27, 0xa6, 0xbb, 0x12, 0xda, 0xb2, 0xee,
+	0x9e, 0x3e, 0x4e, 0x9d, 0xed, 0xbf, 0x2a, 0x00, 0x7e, 0x2e, 0xe4, 0x84, 0xca, 0xcf, 0xc4, 0x94,
+	0x85, 0x9f, 0x4a, 0x31, 0x62, 0x31, 0x85, 0x0f, 0x81, 0xa7, 0x53, 0x1b, 0xb3, 0xc8, 0x08, 0x74,
+	0xd1, 0x86, 0xb1, 0xfb, 0x11, 0x3c, 0x01, 0x8d, 0x98, 0x68, 0xaa, 0x34, 0x66, 0x7c, 0x44, 0x25,
+	0x95, 0xd8, 0x8c, 0xc3, 0x88, 0xab, 0x1e, 0xed, 0x76, 0x96, 0xa7, 0xdc, 0x19, 0xa4, 0x21, 0x04,
+	0x2d, 0xa0, 0x6f, 0xf3, 0x8d, 0x0f, 0x7e, 0x08, 0x76, 0xae, 0xe1, 0x31, 0x4d, 0x88, 0x5f, 0x7a,
+	0x33, 0x47, 0x9d, 0x2d, 0xa1, 0x4f, 0x12, 0x02, 0x3f, 0x02, 0x0f, 0x32, 0x1d, 0x23, 0x21, 0x69,
+	0x48, 0x94, 0x2e, 0xa4, 0xb8, 0x6f, 0xa6, 0x69, 0x5a, 0xcc, 0x69, 0x01, 0xb1, 0x6a, 0x4e, 0x40,
+	0x63, 0x95, 0xc5, 0x08, 0x2a, 0xff, 0x4f, 0x53, 0xa3, 0xeb, 0x1c, 0xa9, 0xa6, 0x03, 0x00, 0xf3,
+	0xa6, 0x18, 0x0f, 0xe3, 0x99, 0x81, 0xf8, 0x15, 0xf3, 0x07, 0xe6, 0xed, 0xf6, 0x8b, 0x00, 0xec,
+	0x82, 0xe6, 0x52, 0xd5, 0x25, 0xc4, 0x86, 0x41, 0x2c, 0x49, 0x5a, 0x02, 0x3d, 0x03, 0xee, 0x50,
+	0xf0, 0xc8, 0xf7, 0xd2, 0x65, 0x3d, 0x3e, 0x7c, 0xf9, 0x7a, 0x7f, 0xed, 0xcf, 0xd7, 0xfb, 0x4d,
+	0x7b, 0x2e, 0x2a, 0x9a, 0x74, 0x98, 0x08, 0x12, 0xa2, 0xcf, 0x3b, 0x7d, 0xae, 0x7f, 0xff, 0xed,
+	0x00, 0x64, 0x77, 0xd4, 0xe7, 0xfa, 0xe7, 0x7f, 0x7e, 0x7d, 0xea, 0x20, 0x83, 0x86, 0x3f, 0x38,
+	0xa0, 0x3d, 0x95, 0x74, 0xce, 0xc4, 0x4c, 0x65, 0x83, 0xe4, 0x21, 0xc5, 0x92, 0x5e, 0x10, 0x19,
+	0xe1, 0x91, 0x4c, 0x77, 0x4c, 0x70, 0x7f, 0xd3, 0x14, 0x79, 0x3f, 0x2b, 0x12, 0x8c, 0x99, 0x3e,
+	0x9f, 0x0d, 0x3b, 0xa1, 0x48, 0x02, 0x12, 0xc7, 0x42, 0x92, 0x03, 0x4e, 0xf5, 0x85, 0x90, 0x93,
+	0xdc, 0x34, 0x17, 0x67, 0xcb, 0x3f, 0xa3, 0x21, 0xda, 0xcf, 0x4b, 0xf4, 0xf3, 0x0a, 0xc8, 0x14,
+	0x38, 0xcd, 0xf8, 0xe1, 0xf7, 0x0e, 0x78, 0x5c, 0xc8, 0xc8, 0xdb, 0xfd, 0x8f, 0x0a, 0x70, 0x37,
+	0x15, 0xad, 0xbc, 0x42, 0x3e, 0xf5, 0x15, 0x11, 0x4f, 0xc1, 0x0e, 0x53, 0x38, 0xbb, 0xb4, 0x6c,
+	0x4a, 0x7e, 0xd5, 0x9c, 0x53, 0x9d, 0xa9, 0x9e, 0xf1, 0x67, 0xbb, 0x0b, 0x0f, 0x41, 0x63, 0x91,
+	0xbb, 0x98, 0x8f, 0x5f, 0x33, 0xe9, 0x30, 0x4f, 0x5f, 0xec, 0x57, 0xfb, 0x17, 0x17, 0xec, 0x22,
+	0x3a, 0x9d, 0xe9, 0x9b, 0x9f, 0xd8, 0x7b, 0xa0, 0x96, 0xad, 0xf6, 0x5b, 0x4f, 0xab, 0x6a, 0x13,
+	0x8d, 0x01, 0x0f, 0xc1, 0xe6, 0x8d, 0x6e, 0xc9, 0x53, 0xf9, 0xc2, 0x9e, 0x82, 0xb2, 0xd2, 0x64,
+	0x62, 0x4f, 0xe6, 0x36, 0xdb, 0x64, 0xe1, 0xf0, 0x0b, 0x50, 0x8f, 0x68, 0x4c, 0xc7, 0x44, 0xd3,
+	0x08, 0x5b, 0xc6, 0xf2, 0x2d, 0x19, 0xef, 0x15, 0x44, 0x03, 0x43, 0xfd, 0x0d, 0xf0, 0x8b, 0x0d,
+	0x59, 0x5d, 0x8c, 0xca, 0xdd, 0x16, 0xe3, 0x7e, 0x4e, 0xbc, 0xb2, 0x10, 0xef, 0x80, 0xcd, 0x62,
+	0xc8, 0xe6, 0x16, 0x3d, 0xe4, 0xe5, 0x93, 0x85, 0x5f, 0x81, 0x7a, 0x28, 0x92, 0xfc, 0x1d, 0x2e,
+	0x89, 0xa6, 0xbe, 0x77, 0x37, 0x19, 0xf7, 0x16, 0x7c, 0x88, 0x68, 0x7a, 0x8c, 0x5e, 0x5e, 0xb6,
+	0x9c, 0x57, 0x97, 0x2d, 0xe7, 0xef, 0xcb, 0x96, 0xf3, 0xe3, 0x55, 0x6b, 0xed, 0xd5, 0x55, 0x6b,
+	0xed, 0x8f, 0xab, 0xd6, 0xda, 0x97, 0x1f, 0xdc, 0x90, 0xfa, 0xdb, 0x60, 0xf1, 0xbd, 0xd4, 0x2f,
+	0xa6, 0x54, 0x0d, 0x2b, 0xe6, 0xbb, 0xd8, 0xfd, 0x77, 0x00, 0xc5, 0x64, 0x04, 0x26, 0x9e, 0x07,
+	0x00, 0x00,
 }
 
 func (m *OffchainNode) Marshal() (dAtA []byte, err error) {
@@ -133,44 +444,1062 @@ func (m *OffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedAtBlock != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.JailedAtBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActiveSince != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.ActiveSince))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastSubmissionNonce != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.LastSubmissionNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerTopicProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerTopicProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerTopicProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsActiveForecaster {
+		i--
+		if m.IsActiveForecaster {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IsActiveInferer {
+		i--
+		if m.IsActiveInferer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.PreviousForecastRewardFraction.Size()
+		i -= size
+		if _, err := m.PreviousForecastRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNode(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.PreviousInferenceRewardFraction.Size()
+		i -= size
+		if _, err := m.PreviousInferenceRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNode(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNode(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ForecasterInclusions != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.ForecasterInclusions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InfererInclusions != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.InfererInclusions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ForecasterScoreEma != nil {
+		{
+			size, err := m.ForecasterScoreEma.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LatestForecasterScore != nil {
+		{
+			size, err := m.LatestForecasterScore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InfererScoreEma != nil {
+		{
+			size, err := m.InfererScoreEma.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LatestInfererScore != nil {
+		{
+			size, err := m.LatestInfererScore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReputerTopicProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerTopicProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerTopicProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNode(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.PreviousRewardFraction.Size()
+		i -= size
+		if _, err := m.PreviousRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNode(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DelegatedStake.Size()
+		i -= size
+		if _, err := m.DelegatedStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNode(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNode(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ScoreEma != nil {
+		{
+			size, err := m.ScoreEma.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LatestScore != nil {
+		{
+			size, err := m.LatestScore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNode(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OffchainNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *ActorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastSubmissionNonce != 0 {
+		n += 1 + sovNode(uint64(m.LastSubmissionNonce))
+	}
+	if m.ActiveSince != 0 {
+		n += 1 + sovNode(uint64(m.ActiveSince))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedAtBlock != 0 {
+		n += 1 + sovNode(uint64(m.JailedAtBlock))
+	}
+	return n
+}
+
+func (m *WorkerTopicProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovNode(uint64(m.TopicId))
+	}
+	if m.LatestInfererScore != nil {
+		l = m.LatestInfererScore.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.InfererScoreEma != nil {
+		l = m.InfererScoreEma.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.LatestForecasterScore != nil {
+		l = m.LatestForecasterScore.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.ForecasterScoreEma != nil {
+		l = m.ForecasterScoreEma.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.InfererInclusions != 0 {
+		n += 1 + sovNode(uint64(m.InfererInclusions))
+	}
+	if m.ForecasterInclusions != 0 {
+		n += 1 + sovNode(uint64(m.ForecasterInclusions))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovNode(uint64(l))
+	l = m.PreviousInferenceRewardFraction.Size()
+	n += 1 + l + sovNode(uint64(l))
+	l = m.PreviousForecastRewardFraction.Size()
+	n += 1 + l + sovNode(uint64(l))
+	if m.IsActiveInferer {
+		n += 2
+	}
+	if m.IsActiveForecaster {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReputerTopicProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovNode(uint64(m.TopicId))
+	}
+	if m.LatestScore != nil {
+		l = m.LatestScore.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.ScoreEma != nil {
+		l = m.ScoreEma.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovNode(uint64(l))
+	l = m.DelegatedStake.Size()
+	n += 1 + l + sovNode(uint64(l))
+	l = m.PreviousRewardFraction.Size()
+	n += 1 + l + sovNode(uint64(l))
+	if m.IsActive {
+		n += 2
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovNode(uint64(l))
+	return n
+}
+
+func sovNode(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNode(x uint64) (n int) {
+	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OffchainNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffchainNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffchainNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmissionNonce", wireType)
+			}
+			m.LastSubmissionNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSubmissionNonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSince", wireType)
+			}
+			m.ActiveSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedAtBlock", wireType)
+			}
+			m.JailedAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedAtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerTopicProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerTopicProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerTopicProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererScore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestInfererScore == nil {
+				m.LatestInfererScore = &Score{}
+			}
+			if err := m.LatestInfererScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreEma", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InfererScoreEma == nil {
+				m.InfererScoreEma = &Score{}
+			}
+			if err := m.InfererScoreEma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterScore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestForecasterScore == nil {
+				m.LatestForecasterScore = &Score{}
+			}
+			if err := m.LatestForecasterScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreEma", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForecasterScoreEma == nil {
+				m.ForecasterScoreEma = &Score{}
+			}
+			if err := m.ForecasterScoreEma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererInclusions", wireType)
+			}
+			m.InfererInclusions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfererInclusions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterInclusions", wireType)
+			}
+			m.ForecasterInclusions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForecasterInclusions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousInferenceRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousForecastRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActiveInferer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActiveInferer = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActiveForecaster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActiveForecaster = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNode(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *OffchainNode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovNode(uint64(l))
-	}
-	l = len(m.NodeAddress)
-	if l > 0 {
-		n += 1 + l + sovNode(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovNode(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNode(x uint64) (n int) {
-	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *OffchainNode) Unmarshal(dAtA []byte) error {
+func (m *ReputerTopicProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -193,15 +1522,106 @@ func (m *OffchainNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OffchainNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerTopicProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OffchainNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerTopicProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestScore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestScore == nil {
+				m.LatestScore = &Score{}
+			}
+			if err := m.LatestScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreEma", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScoreEma == nil {
+				m.ScoreEma = &Score{}
+			}
+			if err := m.ScoreEma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -229,11 +1649,13 @@ func (m *OffchainNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -261,7 +1683,97 @@ func (m *OffchainNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.DelegatedStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55,6 +55,18 @@ func DefaultParams() Params {
 		MaxStringLength:                     uint64(255),                                  // maximum length of strings uploaded to the chain
 		InitialRegretQuantile:               alloraMath.MustNewDecFromString("0.25"),      // quantile value for getting initial regret during network regret calculation
 		PNormSafeDiv:                        alloraMath.MustNewDecFromString("8.25"),      // pnorm divide value to calculate offset with cnorm
+		MaxPruneArchivedTopicKeysEndBlock:   uint64(1000),                                 // max number of state entries of archived topics to delete at the end of the block
+		MaxFundingSubscriptionDripsEndBlock: uint64(100),                                  // max number of topic funding subscriptions to drip at the end of the block
+		MaxTopicCreatorCommission:           alloraMath.MustNewDecFromString("0.1"),       // max share of the rewards of a topic paid to its creator
+		NodeRotationCooldown:                int64((60 * 60 * 24) / 3),                    // ~approx 1 day assuming 3 second block time, number of blocks to wait before a node moved to a new address can be moved again
+		ReputerOutlierSlashEpochs:           uint64(3),                                    // number of consecutive epochs a reputer must be an outlier of a topic to be slashed
+		ReputerOutlierSlashFraction:         alloraMath.MustNewDecFromString("0.01"),      // share of the stake upon a reputer slashed when it has been a persistent outlier
+		MaxMissedEpochsJail:                 uint64(50),                                   // number of epochs an actor may miss in a row before being jailed from a topic
+		MaxMissedEpochsDeregister:           uint64(500),                                  // number of epochs an actor may miss in a row before being deregistered from a topic
+		ReputerCommissionUpdateCooldown:     int64((60 * 60 * 24) / 3),                    // ~approx 1 day assuming 3 second block time, number of blocks to wait before a reputer can change its commission rate again
+		MaxDelegateToSelfStakeRatio:         alloraMath.ZeroDec(),                         // max ratio of the stake delegated upon a reputer in a topic to its own stake, 0 for no max
+		MaxDelegateStakePerReputer:          cosmosMath.ZeroInt(),                         // max stake delegated upon a reputer in a topic, 0 for no max
+		MaxAutoCompoundDelegationsPerPayout: uint64(100),                                  // max number of auto-compound delegations of a topic restaked at each payout of its rewards
 	}
 }
 
@@ -189,6 +201,39 @@ func (p Params) Validate() error {
 	if err := validatePNormSafeDiv(p.PNormSafeDiv); err != nil {
 		return errorsmod.Wrap(err, "params validation failure: pnorm safe div")
 	}
+	if err := validateMaxPruneArchivedTopicKeysEndBlock(p.MaxPruneArchivedTopicKeysEndBlock); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max prune archived topic keys end block")
+	}
+	if err := validateMaxFundingSubscriptionDripsEndBlock(p.MaxFundingSubscriptionDripsEndBlock); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max funding subscription drips end block")
+	}
+	if err := validateMaxTopicCreatorCommission(p.MaxTopicCreatorCommission); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max topic creator commission")
+	}
+	if err := validateNodeRotationCooldown(p.NodeRotationCooldown); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: node rotation cooldown")
+	}
+	if err := validateReputerOutlierSlashEpochs(p.ReputerOutlierSlashEpochs); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: reputer outlier slash epochs")
+	}
+	if err := validateReputerOutlierSlashFraction(p.ReputerOutlierSlashFraction); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: reputer outlier slash fraction")
+	}
+	if err := validateMaxMissedEpochsDeregister(p.MaxMissedEpochsDeregister, p.MaxMissedEpochsJail); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max missed epochs deregister")
+	}
+	if err := validateReputerCommissionUpdateCooldown(p.ReputerCommissionUpdateCooldown); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: reputer commission update cooldown")
+	}
+	if err := validateMaxDelegateToSelfStakeRatio(p.MaxDelegateToSelfStakeRatio); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max delegate to self stake ratio")
+	}
+	if err := validateMaxDelegateStakePerReputer(p.MaxDelegateStakePerReputer); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max delegate stake per reputer")
+	}
+	if err := validateMaxAutoCompoundDelegationsPerPayout(p.MaxAutoCompoundDelegationsPerPayout); err != nil {
+		return errorsmod.Wrap(err, "params validation failure: max auto-compound delegations per payout")
+	}
 	return nil
 }
 
@@ -576,6 +621,113 @@ func validatePNormSafeDiv(i alloraMath.Dec) error {
 	return nil
 }
 
+// this value should be greater than or equal to 1
+func validateMaxPruneArchivedTopicKeysEndBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// this value should be greater than or equal to 1
+func validateMaxFundingSubscriptionDripsEndBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max share of the rewards of a topic paid to its creator.
+// Should be x in [0, 1), zero disabling creator commissions.
+func validateMaxTopicCreatorCommission(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	}
+	if !isAlloraDecZeroOrLessThanOne(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Number of blocks to wait before a node moved to a new address can be moved again.
+// Should be x >= 0, zero disabling the cooldown.
+func validateNodeRotationCooldown(i int64) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Number of consecutive epochs a reputer must be an outlier of a topic to be slashed.
+// Should be x >= 1.
+func validateReputerOutlierSlashEpochs(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Share of the stake upon a reputer slashed when it has been a persistent outlier of a topic.
+// Should be x in [0, 1), zero disabling the slashing of outliers.
+func validateReputerOutlierSlashFraction(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	}
+	if !isAlloraDecZeroOrLessThanOne(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Number of epochs an actor may miss in a row before being deregistered from a topic.
+// Should be zero, disabling deregistration, or greater than the number of missed epochs jailing
+// an actor when jailing is enabled, so that actors are jailed before being deregistered.
+func validateMaxMissedEpochsDeregister(deregister uint64, jail uint64) error {
+	if deregister != 0 && jail != 0 && deregister <= jail {
+		return ErrValidationDeregisterBeforeJail
+	}
+	return nil
+}
+
+// Number of blocks to wait before a reputer can change its commission rate again.
+// Should be x >= 0, zero disabling the cooldown.
+func validateReputerCommissionUpdateCooldown(i int64) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max ratio of the stake delegated upon a reputer in a topic to the own stake of the reputer.
+// Should be x >= 0, zero disabling the max.
+func validateMaxDelegateToSelfStakeRatio(i alloraMath.Dec) error {
+	if err := ValidateDec(i); err != nil {
+		return err
+	}
+	if i.IsNegative() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max stake delegated upon a reputer in a topic.
+// Should be x >= 0, zero disabling the max.
+func validateMaxDelegateStakePerReputer(i cosmosMath.Int) error {
+	if err := ValidateSdkIntRepresentingMonetaryValue(i); err != nil {
+		return errorsmod.Wrap(err, ErrValidationMustBeGreaterthanZero.Error())
+	}
+	return nil
+}
+
+// Max number of auto-compound delegations of a topic restaked at each payout of its rewards.
+// Should be x > 0
+func validateMaxAutoCompoundDelegationsPerPayout(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
 // Whether an alloraDec is between the value of [0, 1] inclusive
 func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 	return a.Gte(alloraMath.ZeroDec()) && a.Lte(alloraMath.OneDec())
@@ -84,11 +84,23 @@ type Params struct {
 	/// a small tolerance quantity used to cap division by zero
 	DataSendingFee cosmossdk_io_math.Int `protobuf:"bytes,44,opt,name=data_sending_fee,json=dataSendingFee,proto3,customtype=cosmossdk.io/math.Int" json:"data_sending_fee"`
 	// payload sending fee for reputer or worker
-	MaxElementsPerForecast  uint64                                          `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`
-	MaxActiveTopicsPerBlock uint64                                          `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"`
-	MaxStringLength         uint64                                          `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`
-	InitialRegretQuantile   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret_quantile"`
-	PNormSafeDiv            github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm_safe_div"`
+	MaxElementsPerForecast              uint64                                          `protobuf:"varint,45,opt,name=max_elements_per_forecast,json=maxElementsPerForecast,proto3" json:"max_elements_per_forecast,omitempty"`
+	MaxActiveTopicsPerBlock             uint64                                          `protobuf:"varint,46,opt,name=max_active_topics_per_block,json=maxActiveTopicsPerBlock,proto3" json:"max_active_topics_per_block,omitempty"`
+	MaxStringLength                     uint64                                          `protobuf:"varint,47,opt,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`
+	InitialRegretQuantile               github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,48,opt,name=initial_regret_quantile,json=initialRegretQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret_quantile"`
+	PNormSafeDiv                        github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,opt,name=p_norm_safe_div,json=pNormSafeDiv,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm_safe_div"`
+	MaxPruneArchivedTopicKeysEndBlock   uint64                                          `protobuf:"varint,50,opt,name=max_prune_archived_topic_keys_end_block,json=maxPruneArchivedTopicKeysEndBlock,proto3" json:"max_prune_archived_topic_keys_end_block,omitempty"`
+	MaxFundingSubscriptionDripsEndBlock uint64                                          `protobuf:"varint,51,opt,name=max_funding_subscription_drips_end_block,json=maxFundingSubscriptionDripsEndBlock,proto3" json:"max_funding_subscription_drips_end_block,omitempty"`
+	MaxTopicCreatorCommission           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,52,opt,name=max_topic_creator_commission,json=maxTopicCreatorCommission,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_topic_creator_commission"`
+	NodeRotationCooldown                int64                                           `protobuf:"varint,53,opt,name=node_rotation_cooldown,json=nodeRotationCooldown,proto3" json:"node_rotation_cooldown,omitempty"`
+	ReputerOutlierSlashEpochs           uint64                                          `protobuf:"varint,54,opt,name=reputer_outlier_slash_epochs,json=reputerOutlierSlashEpochs,proto3" json:"reputer_outlier_slash_epochs,omitempty"`
+	ReputerOutlierSlashFraction         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,55,opt,name=reputer_outlier_slash_fraction,json=reputerOutlierSlashFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_outlier_slash_fraction"`
+	MaxMissedEpochsJail                 uint64                                          `protobuf:"varint,56,opt,name=max_missed_epochs_jail,json=maxMissedEpochsJail,proto3" json:"max_missed_epochs_jail,omitempty"`
+	MaxMissedEpochsDeregister           uint64                                          `protobuf:"varint,57,opt,name=max_missed_epochs_deregister,json=maxMissedEpochsDeregister,proto3" json:"max_missed_epochs_deregister,omitempty"`
+	ReputerCommissionUpdateCooldown     int64                                           `protobuf:"varint,58,opt,name=reputer_commission_update_cooldown,json=reputerCommissionUpdateCooldown,proto3" json:"reputer_commission_update_cooldown,omitempty"`
+	MaxDelegateToSelfStakeRatio         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,59,opt,name=max_delegate_to_self_stake_ratio,json=maxDelegateToSelfStakeRatio,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_delegate_to_self_stake_ratio"`
+	MaxDelegateStakePerReputer          cosmossdk_io_math.Int                           `protobuf:"bytes,60,opt,name=max_delegate_stake_per_reputer,json=maxDelegateStakePerReputer,proto3,customtype=cosmossdk.io/math.Int" json:"max_delegate_stake_per_reputer"`
+	MaxAutoCompoundDelegationsPerPayout uint64                                          `protobuf:"varint,61,opt,name=max_auto_compound_delegations_per_payout,json=maxAutoCompoundDelegationsPerPayout,proto3" json:"max_auto_compound_delegations_per_payout,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -257,6 +269,62 @@ func (m *Params) GetMaxStringLength() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxPruneArchivedTopicKeysEndBlock() uint64 {
+	if m != nil {
+		return m.MaxPruneArchivedTopicKeysEndBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFundingSubscriptionDripsEndBlock() uint64 {
+	if m != nil {
+		return m.MaxFundingSubscriptionDripsEndBlock
+	}
+	return 0
+}
+
+func (m *Params) GetNodeRotationCooldown() int64 {
+	if m != nil {
+		return m.NodeRotationCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetReputerOutlierSlashEpochs() uint64 {
+	if m != nil {
+		return m.ReputerOutlierSlashEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedEpochsJail() uint64 {
+	if m != nil {
+		return m.MaxMissedEpochsJail
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedEpochsDeregister() uint64 {
+	if m != nil {
+		return m.MaxMissedEpochsDeregister
+	}
+	return 0
+}
+
+func (m *Params) GetReputerCommissionUpdateCooldown() int64 {
+	if m != nil {
+		return m.ReputerCommissionUpdateCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundDelegationsPerPayout() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundDelegationsPerPayout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "emissions.v5.Params")
 }
@@ -264,94 +332,117 @@ func init() {
 func init() { proto.RegisterFile("emissions/v5/params.proto", fileDescriptor_93845aebd2ad0d44) }
 
 var fileDescriptor_93845aebd2ad0d44 = []byte{
-	// 1380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x14, 0x47,
-	0x13, 0xf6, 0xbe, 0x18, 0x63, 0x1a, 0x63, 0xaf, 0xe7, 0x35, 0x30, 0xfe, 0xf6, 0x8b, 0x79, 0x13,
-	0xe3, 0x80, 0x97, 0x28, 0x42, 0xf9, 0x3c, 0x04, 0x64, 0x1b, 0xd9, 0xc2, 0xc8, 0x19, 0x3b, 0x20,
-	0x91, 0x28, 0x9d, 0xf6, 0x4c, 0xcd, 0x6e, 0xcb, 0x33, 0xdd, 0x43, 0x77, 0xef, 0x7a, 0xcd, 0x3d,
-	0xf7, 0xfc, 0x8b, 0xe4, 0x98, 0x43, 0x7e, 0x04, 0x47, 0x94, 0x53, 0x94, 0x03, 0x8a, 0xe0, 0x90,
-	0xbf, 0x11, 0x55, 0x77, 0xcf, 0x78, 0x0d, 0x51, 0x14, 0x31, 0xb9, 0xac, 0x76, 0xba, 0xab, 0x9e,
-	0xa7, 0xe6, 0xe9, 0xea, 0xaa, 0x1a, 0x32, 0x0d, 0x39, 0xd7, 0x9a, 0x4b, 0xa1, 0x5b, 0xbd, 0xdb,
-	0xad, 0x82, 0x29, 0x96, 0xeb, 0xb5, 0x42, 0x49, 0x23, 0x83, 0xb1, 0x6a, 0x6b, 0xad, 0x77, 0x7b,
-	0x66, 0x92, 0xe5, 0x5c, 0xc8, 0x96, 0xfd, 0x75, 0x06, 0x33, 0xd3, 0xb1, 0xd4, 0xb9, 0xd4, 0xd4,
-	0x3e, 0xb5, 0xdc, 0x83, 0xdf, 0x9a, 0x6a, 0xcb, 0xb6, 0x74, 0xeb, 0xf8, 0xcf, 0xad, 0x5e, 0xfd,
-	0x61, 0x9e, 0x8c, 0xec, 0x5a, 0x8a, 0x20, 0x24, 0xe7, 0x7a, 0xa0, 0x10, 0x3d, 0x6c, 0x2c, 0x35,
-	0x56, 0xce, 0x47, 0xe5, 0x63, 0xf0, 0x31, 0x99, 0xce, 0x59, 0x9f, 0x6a, 0x50, 0x9c, 0x65, 0xfc,
-	0x29, 0x24, 0x34, 0xd7, 0x6d, 0x9a, 0x81, 0x68, 0x9b, 0x4e, 0xf8, 0x9f, 0xa5, 0xc6, 0xca, 0x99,
-	0xe8, 0x72, 0xce, 0xfa, 0x7b, 0xd5, 0xfe, 0x8e, 0x6e, 0xdf, 0xb7, 0xbb, 0x01, 0x23, 0xcd, 0x9c,
-	0x0b, 0x6a, 0x64, 0xc1, 0x63, 0x7a, 0x04, 0xbc, 0xdd, 0x31, 0xe1, 0x19, 0x44, 0xbf, 0xfb, 0xe1,
-	0xb3, 0x17, 0x8b, 0x43, 0xbf, 0xbd, 0x58, 0x6c, 0xb5, 0xb9, 0xe9, 0x74, 0x0f, 0xd6, 0x62, 0x99,
-	0xb7, 0x58, 0x96, 0x49, 0xc5, 0x6e, 0x0a, 0x30, 0x47, 0x52, 0x1d, 0x96, 0x8f, 0x71, 0x87, 0x71,
-	0xd1, 0xca, 0x99, 0xe9, 0xac, 0xad, 0x43, 0x1c, 0x8d, 0xe7, 0x5c, 0xec, 0x23, 0xde, 0x23, 0x0b,
-	0x17, 0xa4, 0xe4, 0xb2, 0x82, 0x27, 0x5d, 0xae, 0x30, 0x2e, 0x2e, 0x78, 0xde, 0xcd, 0xa9, 0x36,
-	0xec, 0x10, 0xc2, 0xb3, 0x96, 0xe8, 0x96, 0x27, 0xba, 0xe4, 0xe4, 0xd0, 0xc9, 0xe1, 0x1a, 0x97,
-	0x0e, 0x6e, 0x4b, 0x98, 0x5f, 0x7e, 0xbe, 0x49, 0xbc, 0x4e, 0x5b, 0xc2, 0xfc, 0xf8, 0xc7, 0x4f,
-	0xab, 0x8d, 0x68, 0xaa, 0xc4, 0xdb, 0x71, 0x70, 0x7b, 0x88, 0x86, 0x2a, 0x28, 0xc8, 0x65, 0x0f,
-	0x1c, 0x3a, 0x4d, 0x20, 0x63, 0xc7, 0xf4, 0x88, 0x8b, 0x44, 0x1e, 0x85, 0x23, 0x4e, 0x05, 0x67,
-	0x60, 0xed, 0xd7, 0x71, 0xfb, 0x91, 0xdd, 0x0d, 0x56, 0x9c, 0x0a, 0x50, 0xc8, 0xb8, 0x53, 0xea,
-	0x76, 0xce, 0x7a, 0xe0, 0xcb, 0x6c, 0xe0, 0xb2, 0xd7, 0xeb, 0x31, 0x19, 0x3b, 0x00, 0xc3, 0x28,
-	0x08, 0xa3, 0x64, 0x71, 0x1c, 0x8e, 0xd6, 0xd3, 0xea, 0x02, 0x82, 0x6d, 0x38, 0xac, 0xe0, 0x6b,
-	0x72, 0x31, 0x03, 0xa6, 0x04, 0x17, 0x6d, 0xaa, 0x98, 0x81, 0xf0, 0x7c, 0x3d, 0xf0, 0xb1, 0x12,
-	0x2d, 0x62, 0x06, 0x82, 0x9c, 0x60, 0x0e, 0xd0, 0xb6, 0x62, 0x09, 0x07, 0x61, 0xa8, 0xe9, 0x28,
-	0xd0, 0x1d, 0x99, 0x25, 0x21, 0xa9, 0x47, 0x33, 0x95, 0xb3, 0xfe, 0x3d, 0x8f, 0xba, 0x5f, 0x82,
-	0x06, 0x40, 0x02, 0x94, 0xd4, 0x1d, 0x45, 0xaa, 0x58, 0x6c, 0x30, 0x71, 0x2f, 0xd4, 0xa3, 0xc2,
-	0x53, 0xb2, 0x87, 0xb7, 0xe9, 0x01, 0x83, 0x0d, 0xb2, 0x88, 0x6f, 0xd5, 0x15, 0x69, 0x37, 0x4b,
-	0x79, 0x96, 0x41, 0x42, 0xd1, 0x1f, 0x14, 0xc5, 0x1c, 0x01, 0x6d, 0x74, 0x78, 0x71, 0xa9, 0xb1,
-	0x32, 0x1c, 0xcd, 0xe5, 0xac, 0xff, 0xe5, 0x89, 0xd5, 0x23, 0x6b, 0x14, 0x79, 0x9b, 0xe0, 0x1e,
-	0x59, 0x7a, 0x1d, 0x46, 0x41, 0xd1, 0x35, 0x83, 0x38, 0xe3, 0x16, 0x67, 0xfe, 0x34, 0x4e, 0xe4,
-	0xac, 0x2a, 0xa0, 0xa7, 0x64, 0xde, 0xdd, 0x25, 0x05, 0x47, 0x4c, 0x25, 0xfe, 0xfd, 0x79, 0x5e,
-	0x48, 0x65, 0x98, 0x88, 0x21, 0x9c, 0xa8, 0xa7, 0xc0, 0x8c, 0x45, 0x8f, 0x2c, 0xb8, 0x55, 0x62,
-	0xab, 0x82, 0x0e, 0xbe, 0x6b, 0x90, 0xe5, 0x53, 0xe4, 0x29, 0x00, 0x55, 0xd0, 0x03, 0xd1, 0x3d,
-	0x15, 0x42, 0xb3, 0x5e, 0x08, 0x8b, 0x03, 0x21, 0x6c, 0x02, 0x44, 0x8e, 0x60, 0x20, 0x0e, 0x20,
-	0xc1, 0xa9, 0x30, 0x58, 0x56, 0x74, 0x58, 0x38, 0x59, 0xf3, 0xe8, 0x07, 0x58, 0xef, 0x20, 0x60,
-	0x10, 0x93, 0x49, 0xc3, 0xf4, 0xe1, 0x69, 0x96, 0xa0, 0x1e, 0xcb, 0x04, 0x22, 0x0e, 0x92, 0xa0,
-	0xa6, 0x3d, 0x96, 0xf1, 0x84, 0x19, 0xa9, 0x34, 0xed, 0x69, 0xea, 0x1c, 0x69, 0x01, 0x2a, 0xc6,
-	0x6b, 0xe4, 0xd8, 0xc3, 0xff, 0xd6, 0xd4, 0xf4, 0x84, 0xe3, 0xa1, 0xbe, 0x63, 0x4d, 0x76, 0x1d,
-	0x81, 0x0b, 0x26, 0xf8, 0x8c, 0xcc, 0xda, 0x12, 0xcf, 0xf2, 0x22, 0x03, 0x4d, 0x8d, 0xa4, 0x3a,
-	0x66, 0x19, 0x50, 0x1d, 0x4b, 0x05, 0x3a, 0x9c, 0xb2, 0xb9, 0x79, 0x05, 0x8b, 0xbc, 0xb3, 0xd8,
-	0x97, 0x7b, 0xb8, 0xbf, 0x67, 0xb7, 0x83, 0x4f, 0xc8, 0x0c, 0x7a, 0x1b, 0x59, 0x50, 0x2e, 0x52,
-	0x50, 0xa0, 0x2c, 0x84, 0x8f, 0xfd, 0x92, 0x75, 0xc6, 0xea, 0xb0, 0x2f, 0x8b, 0x2d, 0xbf, 0xbf,
-	0x2f, 0x3d, 0xf3, 0xe7, 0x64, 0xbe, 0xf4, 0x4d, 0xa5, 0x82, 0x98, 0x69, 0x73, 0xda, 0xfd, 0xb2,
-	0x75, 0x9f, 0x76, 0xee, 0x9b, 0x27, 0x26, 0x15, 0xc2, 0x00, 0xbb, 0xbf, 0x54, 0x83, 0xee, 0x57,
-	0x06, 0xd9, 0xfd, 0x75, 0x3a, 0xf1, 0x7d, 0x4c, 0x9a, 0xb1, 0x02, 0x66, 0xc0, 0xb7, 0xa8, 0x14,
-	0x20, 0x0c, 0xdf, 0xb2, 0x6d, 0x8c, 0x3b, 0x24, 0xdb, 0x9b, 0x36, 0x01, 0x82, 0x4f, 0xc9, 0x4c,
-	0x55, 0x0d, 0x13, 0xd0, 0xf6, 0x38, 0x31, 0x50, 0x8e, 0x11, 0x84, 0xd3, 0x4e, 0xd2, 0xd2, 0x62,
-	0xdd, 0x19, 0xec, 0xb0, 0xfe, 0x16, 0x6e, 0x07, 0x5f, 0x91, 0xa6, 0x82, 0x36, 0xd7, 0x46, 0x31,
-	0x2c, 0x44, 0x36, 0xb0, 0xb9, 0xb7, 0x0c, 0x6c, 0x62, 0x10, 0x09, 0x23, 0xbb, 0x41, 0x82, 0x04,
-	0x52, 0xd6, 0xcd, 0x0c, 0x2d, 0x58, 0x1b, 0x68, 0xc6, 0x73, 0x6e, 0xc2, 0x79, 0x1b, 0x51, 0xd3,
-	0xef, 0xec, 0xb2, 0x36, 0xdc, 0xc7, 0xf5, 0xe0, 0x1a, 0x19, 0xc7, 0xb0, 0x07, 0x2c, 0x17, 0xac,
-	0xe5, 0x58, 0xce, 0xfa, 0x27, 0x56, 0x78, 0x8e, 0xaf, 0xf5, 0x38, 0xaa, 0x20, 0x96, 0x2a, 0xf1,
-	0x4e, 0x8b, 0xb6, 0xe1, 0x4d, 0x9f, 0x6e, 0x78, 0x91, 0xb5, 0x70, 0x08, 0x2b, 0xa4, 0x79, 0x90,
-	0xc9, 0xf8, 0x50, 0x63, 0xf2, 0xd3, 0x5c, 0x0a, 0xd3, 0x09, 0x97, 0x2c, 0xd3, 0xb8, 0x5b, 0xdf,
-	0x05, 0xb5, 0x83, 0xab, 0x58, 0x01, 0x8a, 0xf2, 0x5e, 0xba, 0x84, 0xc3, 0xba, 0xf3, 0xbf, 0x9a,
-	0x15, 0xa0, 0x70, 0x39, 0xb1, 0x55, 0x02, 0x62, 0x05, 0xa8, 0x68, 0xca, 0xdc, 0x0c, 0xaf, 0xd6,
-	0xac, 0x00, 0x9e, 0xa5, 0x4c, 0x64, 0x9c, 0x90, 0x2a, 0x12, 0x9f, 0xbe, 0xe1, 0x72, 0xcd, 0x09,
-	0xc9, 0x73, 0xf8, 0x6c, 0x47, 0xb9, 0xe2, 0x37, 0xe5, 0xba, 0x56, 0x53, 0xae, 0xf8, 0x2f, 0xe4,
-	0x8a, 0xdf, 0x90, 0xeb, 0xff, 0x35, 0xe5, 0x8a, 0x5f, 0x93, 0xeb, 0x01, 0x19, 0x89, 0xa9, 0x90,
-	0x2a, 0x0f, 0xdf, 0xa9, 0x87, 0x7c, 0x36, 0x7e, 0x20, 0x55, 0x1e, 0x7c, 0x4b, 0x26, 0xa0, 0xd0,
-	0x3c, 0x93, 0xa2, 0x52, 0x7f, 0xa5, 0xa6, 0xfa, 0x1e, 0xaf, 0x54, 0xff, 0x21, 0xb9, 0xde, 0x61,
-	0x59, 0x6a, 0xaf, 0x7e, 0xa1, 0x64, 0x0c, 0x5a, 0xfb, 0xb6, 0x6d, 0xa7, 0x45, 0x96, 0x69, 0x0a,
-	0x22, 0xa1, 0x36, 0xc5, 0xc3, 0x55, 0x9b, 0xef, 0xcb, 0xe8, 0xb0, 0xc3, 0xfa, 0xbb, 0xce, 0xdc,
-	0x36, 0xe2, 0xc8, 0x1b, 0x6f, 0x88, 0xe4, 0x2e, 0x9a, 0x62, 0xe2, 0x94, 0x91, 0x6b, 0x96, 0x02,
-	0x4d, 0x78, 0x2f, 0x7c, 0xef, 0xdf, 0x09, 0x7d, 0x8f, 0xa5, 0xb0, 0xce, 0x7b, 0x58, 0x1d, 0x13,
-	0x66, 0x18, 0xd5, 0x20, 0x12, 0x9c, 0x1a, 0xb1, 0x08, 0xdd, 0x78, 0xdb, 0xea, 0x88, 0x48, 0x7b,
-	0x0e, 0x08, 0x6b, 0x90, 0xff, 0xa8, 0x80, 0x0c, 0x72, 0x10, 0xc6, 0xdd, 0xf9, 0x2a, 0x6b, 0x6e,
-	0x56, 0x45, 0x7b, 0xc3, 0xef, 0xef, 0x82, 0xaa, 0x72, 0xc0, 0x37, 0x2b, 0x1c, 0xd1, 0x7a, 0xbe,
-	0x70, 0x3b, 0x7f, 0xa7, 0xe1, 0x5a, 0xd5, 0xac, 0xee, 0x58, 0x0b, 0x5b, 0x90, 0x11, 0xc0, 0xe9,
-	0xb6, 0x4a, 0x26, 0x6d, 0xab, 0x33, 0x0a, 0x5f, 0xc9, 0x4f, 0xe3, 0x2d, 0xeb, 0x33, 0x81, 0x0d,
-	0xce, 0xae, 0xfb, 0x71, 0x5c, 0x92, 0x2b, 0x5c, 0x70, 0xc3, 0x59, 0x46, 0x15, 0xb4, 0x15, 0x18,
-	0xfa, 0xa4, 0xcb, 0x84, 0xe1, 0x19, 0x84, 0xb7, 0xea, 0x49, 0x7d, 0xc9, 0xe3, 0x46, 0x16, 0xf6,
-	0x0b, 0x8f, 0x1a, 0x7c, 0x43, 0x26, 0x0a, 0x9b, 0xde, 0x27, 0x67, 0xfa, 0x7e, 0xcd, 0x29, 0xbd,
-	0xc0, 0x3c, 0xf7, 0x27, 0xba, 0x3d, 0x3c, 0x3a, 0xdc, 0x3c, 0xbb, 0x3d, 0x3c, 0x3a, 0xd3, 0x9c,
-	0xdd, 0x1e, 0x1e, 0x9d, 0x6d, 0xce, 0x6d, 0x0f, 0x8f, 0xbe, 0xdb, 0x5c, 0xd9, 0x1e, 0x1e, 0xbd,
-	0xde, 0x5c, 0xb5, 0xa3, 0xf6, 0x1b, 0x7a, 0x46, 0xb3, 0xb6, 0xae, 0xa7, 0x29, 0x0c, 0xe8, 0x5d,
-	0xce, 0x7d, 0xd1, 0x32, 0xba, 0x28, 0x30, 0x8a, 0xbb, 0xb1, 0xc1, 0x4d, 0xae, 0x54, 0x48, 0x11,
-	0x83, 0xf6, 0xb3, 0x72, 0x34, 0x57, 0x35, 0xd7, 0x6a, 0x5e, 0x4c, 0x20, 0x66, 0xc7, 0xf6, 0x33,
-	0x24, 0xba, 0xf6, 0xb7, 0x10, 0xfe, 0x56, 0xde, 0x8d, 0x9e, 0xbd, 0x5c, 0x68, 0x3c, 0x7f, 0xb9,
-	0xd0, 0xf8, 0xfd, 0xe5, 0x42, 0xe3, 0xfb, 0x57, 0x0b, 0x43, 0xcf, 0x5f, 0x2d, 0x0c, 0xfd, 0xfa,
-	0x6a, 0x61, 0xe8, 0xf1, 0x47, 0xff, 0x50, 0x92, 0x7e, 0xeb, 0xe4, 0xcb, 0xda, 0x1c, 0x17, 0xa0,
-	0x0f, 0x46, 0xec, 0x47, 0xf0, 0x07, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x43, 0x8d, 0x72,
-	0x73, 0x0f, 0x00, 0x00,
+	// 1745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0xdc, 0xc6,
+	0x11, 0x16, 0x63, 0x4a, 0xa6, 0xc7, 0x32, 0xb9, 0x82, 0x29, 0x09, 0xfc, 0xd1, 0x92, 0x96, 0xe4,
+	0x98, 0x56, 0x2c, 0xae, 0x13, 0x59, 0xf1, 0x6f, 0x2a, 0xa1, 0x44, 0xd2, 0x45, 0xda, 0x74, 0x36,
+	0x58, 0xca, 0xaa, 0x52, 0x52, 0x99, 0x0c, 0x81, 0xc6, 0xee, 0x84, 0xc0, 0x0c, 0x3c, 0x33, 0x58,
+	0x2e, 0x5d, 0xa9, 0xdc, 0x72, 0xcf, 0x63, 0xe4, 0x98, 0x43, 0x1e, 0xc2, 0x47, 0x27, 0xa7, 0x54,
+	0x0e, 0xae, 0x94, 0x74, 0xc8, 0x6b, 0xa4, 0x7a, 0x66, 0x80, 0x05, 0x25, 0x55, 0x2a, 0x25, 0xf8,
+	0xc2, 0x22, 0x30, 0x5f, 0x7f, 0xdd, 0xf8, 0xa6, 0xa7, 0xbb, 0x67, 0xc9, 0x12, 0xe4, 0x5c, 0x6b,
+	0x2e, 0x85, 0xee, 0x8d, 0xef, 0xf6, 0x0a, 0xa6, 0x58, 0xae, 0x37, 0x0b, 0x25, 0x8d, 0x0c, 0x2e,
+	0xd6, 0x4b, 0x9b, 0xe3, 0xbb, 0xcb, 0x97, 0x58, 0xce, 0x85, 0xec, 0xd9, 0xbf, 0x0e, 0xb0, 0xbc,
+	0x14, 0x4b, 0x9d, 0x4b, 0x4d, 0xed, 0x53, 0xcf, 0x3d, 0xf8, 0xa5, 0xc5, 0xa1, 0x1c, 0x4a, 0xf7,
+	0x1e, 0xff, 0x73, 0x6f, 0xaf, 0xff, 0xfd, 0x4d, 0x72, 0xa1, 0x6f, 0x5d, 0x04, 0x21, 0x79, 0x79,
+	0x0c, 0x0a, 0xd9, 0xc3, 0x99, 0xf5, 0x99, 0x8d, 0x57, 0xa2, 0xea, 0x31, 0xf8, 0x90, 0x2c, 0xe5,
+	0x6c, 0x42, 0x35, 0x28, 0xce, 0x32, 0xfe, 0x35, 0x24, 0x34, 0xd7, 0x43, 0x9a, 0x81, 0x18, 0x9a,
+	0x51, 0xf8, 0x83, 0xf5, 0x99, 0x8d, 0x97, 0xa2, 0x2b, 0x39, 0x9b, 0x0c, 0xea, 0xf5, 0x03, 0x3d,
+	0xfc, 0xdc, 0xae, 0x06, 0x8c, 0x74, 0x72, 0x2e, 0xa8, 0x91, 0x05, 0x8f, 0xe9, 0x09, 0xf0, 0xe1,
+	0xc8, 0x84, 0x2f, 0x21, 0xfb, 0xbd, 0xf7, 0xbf, 0xf9, 0x6e, 0xed, 0xdc, 0xbf, 0xbe, 0x5b, 0xeb,
+	0x0d, 0xb9, 0x19, 0x95, 0x47, 0x9b, 0xb1, 0xcc, 0x7b, 0x2c, 0xcb, 0xa4, 0x62, 0xb7, 0x05, 0x98,
+	0x13, 0xa9, 0x8e, 0xab, 0xc7, 0x78, 0xc4, 0xb8, 0xe8, 0xe5, 0xcc, 0x8c, 0x36, 0xb7, 0x21, 0x8e,
+	0xe6, 0x73, 0x2e, 0x0e, 0x91, 0xef, 0xa1, 0xa5, 0x0b, 0x52, 0x72, 0x45, 0xc1, 0x57, 0x25, 0x57,
+	0x18, 0x17, 0x17, 0x3c, 0x2f, 0x73, 0xaa, 0x0d, 0x3b, 0x86, 0xf0, 0xbc, 0x75, 0xf4, 0xae, 0x77,
+	0x74, 0xd9, 0xc9, 0xa1, 0x93, 0xe3, 0x4d, 0x2e, 0x1d, 0xdd, 0x9e, 0x30, 0xff, 0xf8, 0xdb, 0x6d,
+	0xe2, 0x75, 0xda, 0x13, 0xe6, 0x2f, 0xff, 0xf9, 0xeb, 0xad, 0x99, 0x68, 0xb1, 0xe2, 0x3b, 0x70,
+	0x74, 0x03, 0x64, 0x43, 0x15, 0x14, 0xe4, 0x72, 0x0c, 0x8e, 0x9d, 0x26, 0x90, 0xb1, 0x53, 0x7a,
+	0xc2, 0x45, 0x22, 0x4f, 0xc2, 0x0b, 0x4e, 0x05, 0x07, 0xb0, 0xf8, 0x6d, 0x5c, 0x7e, 0x68, 0x57,
+	0x83, 0x0d, 0xa7, 0x02, 0x14, 0x32, 0x1e, 0x55, 0xba, 0xbd, 0x6c, 0x2d, 0xf0, 0x63, 0x76, 0xf0,
+	0xb5, 0xd7, 0xeb, 0x11, 0xb9, 0x78, 0x04, 0x86, 0x51, 0x10, 0x46, 0xc9, 0xe2, 0x34, 0x9c, 0x6b,
+	0xa7, 0xd5, 0xab, 0x48, 0xb6, 0xe3, 0xb8, 0x82, 0xdf, 0x90, 0xd7, 0x32, 0x60, 0x4a, 0x70, 0x31,
+	0xa4, 0x8a, 0x19, 0x08, 0x5f, 0x69, 0x47, 0x7e, 0xb1, 0x62, 0x8b, 0x98, 0x81, 0x20, 0x27, 0x98,
+	0x03, 0x74, 0xa8, 0x58, 0xc2, 0x41, 0x18, 0x6a, 0x46, 0x0a, 0xf4, 0x48, 0x66, 0x49, 0x48, 0xda,
+	0xb9, 0x59, 0xcc, 0xd9, 0xe4, 0x53, 0xcf, 0x7a, 0x58, 0x91, 0x06, 0x40, 0x02, 0x94, 0xd4, 0x6d,
+	0x45, 0xaa, 0x58, 0x6c, 0x30, 0x71, 0x5f, 0x6d, 0xe7, 0x0a, 0x77, 0xc9, 0x6e, 0xde, 0xae, 0x27,
+	0x0c, 0x76, 0xc8, 0x1a, 0x7e, 0x55, 0x29, 0xd2, 0x32, 0x4b, 0x79, 0x96, 0x41, 0x42, 0xd1, 0x1e,
+	0x14, 0xc5, 0x1c, 0x01, 0x6d, 0x74, 0xf8, 0xda, 0xfa, 0xcc, 0xc6, 0x6c, 0xb4, 0x9a, 0xb3, 0xc9,
+	0x83, 0x29, 0xea, 0xa1, 0x05, 0x45, 0x1e, 0x13, 0x7c, 0x4a, 0xd6, 0x9f, 0xa6, 0x51, 0x50, 0x94,
+	0xa6, 0xc9, 0x33, 0x6f, 0x79, 0xae, 0x9d, 0xe5, 0x89, 0x1c, 0xaa, 0x26, 0xfa, 0x9a, 0x5c, 0x73,
+	0x67, 0x49, 0xc1, 0x09, 0x53, 0x89, 0xff, 0x7e, 0x9e, 0x17, 0x52, 0x19, 0x26, 0x62, 0x08, 0x17,
+	0xda, 0x29, 0xb0, 0x6c, 0xd9, 0x23, 0x4b, 0x6e, 0x95, 0xd8, 0xab, 0xa9, 0x83, 0x3f, 0xcd, 0x90,
+	0x1b, 0x67, 0x9c, 0xa7, 0x00, 0x54, 0xc1, 0x18, 0x44, 0x79, 0x26, 0x84, 0x4e, 0xbb, 0x10, 0xd6,
+	0x1a, 0x21, 0xec, 0x02, 0x44, 0xce, 0x41, 0x23, 0x0e, 0x20, 0xc1, 0x99, 0x30, 0x58, 0x56, 0x8c,
+	0x58, 0x78, 0xa9, 0xe5, 0xd6, 0x37, 0xbc, 0x6e, 0x21, 0x61, 0x10, 0x93, 0x4b, 0x86, 0xe9, 0xe3,
+	0xb3, 0x5e, 0x82, 0x76, 0x5e, 0x16, 0x90, 0xb1, 0xe9, 0x04, 0x35, 0x1d, 0xb3, 0x8c, 0x27, 0xcc,
+	0x48, 0xa5, 0xe9, 0x58, 0x53, 0x67, 0x48, 0x0b, 0x50, 0x31, 0x1e, 0x23, 0xe7, 0x3d, 0x7c, 0xbd,
+	0xa5, 0xa6, 0x53, 0x1f, 0x5f, 0xea, 0x2d, 0x0b, 0xe9, 0x3b, 0x07, 0x2e, 0x98, 0xe0, 0x13, 0xb2,
+	0x62, 0x4b, 0x3c, 0xcb, 0x8b, 0x0c, 0x34, 0x35, 0x92, 0xea, 0x98, 0x65, 0x40, 0x75, 0x2c, 0x15,
+	0xe8, 0x70, 0xd1, 0xe6, 0xe6, 0x55, 0x2c, 0xf2, 0x0e, 0x71, 0x28, 0x07, 0xb8, 0x3e, 0xb0, 0xcb,
+	0xc1, 0x47, 0x64, 0x19, 0xad, 0x8d, 0x2c, 0x28, 0x17, 0x29, 0x28, 0x50, 0x96, 0xc2, 0xc7, 0x7e,
+	0xd9, 0x1a, 0x63, 0x75, 0x38, 0x94, 0xc5, 0x9e, 0x5f, 0x3f, 0x94, 0xde, 0xf3, 0x2f, 0xc8, 0xb5,
+	0xca, 0x36, 0x95, 0x0a, 0x62, 0xa6, 0xcd, 0x59, 0xf3, 0x2b, 0xd6, 0x7c, 0xc9, 0x99, 0xef, 0x4e,
+	0x21, 0x35, 0x43, 0xc3, 0xbb, 0x3f, 0x54, 0x4d, 0xf3, 0xab, 0x4d, 0xef, 0xfe, 0x38, 0x4d, 0x6d,
+	0x1f, 0x91, 0x4e, 0xac, 0x80, 0x19, 0xf0, 0x2d, 0x2a, 0x05, 0x08, 0xc3, 0x17, 0x6c, 0x1b, 0xf3,
+	0x8e, 0xc9, 0xf6, 0xa6, 0x5d, 0x80, 0xe0, 0x63, 0xb2, 0x5c, 0x57, 0xc3, 0x04, 0xb4, 0xdd, 0x4e,
+	0x0c, 0x94, 0x63, 0x04, 0xe1, 0x92, 0x93, 0xb4, 0x42, 0x6c, 0x3b, 0xc0, 0x01, 0x9b, 0xec, 0xe1,
+	0x72, 0xf0, 0x6b, 0xd2, 0x51, 0x30, 0xe4, 0xda, 0x28, 0x86, 0x85, 0xc8, 0x06, 0xb6, 0xfa, 0x82,
+	0x81, 0x2d, 0x34, 0x99, 0x30, 0xb2, 0x77, 0x48, 0x90, 0x40, 0xca, 0xca, 0xcc, 0xd0, 0x82, 0x0d,
+	0x81, 0x66, 0x3c, 0xe7, 0x26, 0xbc, 0x66, 0x23, 0xea, 0xf8, 0x95, 0x3e, 0x1b, 0xc2, 0xe7, 0xf8,
+	0x3e, 0xb8, 0x49, 0xe6, 0x31, 0xec, 0x06, 0xb2, 0x6b, 0x91, 0x17, 0x73, 0x36, 0x99, 0xa2, 0x70,
+	0x1f, 0x9f, 0xea, 0x71, 0x54, 0x41, 0x2c, 0x55, 0xe2, 0x8d, 0xd6, 0x6c, 0xc3, 0x5b, 0x3a, 0xdb,
+	0xf0, 0x22, 0x8b, 0x70, 0x0c, 0x1b, 0xa4, 0x73, 0x94, 0xc9, 0xf8, 0x58, 0x63, 0xf2, 0xd3, 0x5c,
+	0x0a, 0x33, 0x0a, 0xd7, 0xad, 0xa7, 0x79, 0xf7, 0xbe, 0x0f, 0xea, 0x00, 0xdf, 0x62, 0x05, 0x28,
+	0xaa, 0x73, 0xe9, 0x12, 0x0e, 0xeb, 0xce, 0x1b, 0x2d, 0x2b, 0x40, 0xe1, 0x72, 0x62, 0xaf, 0x22,
+	0xc4, 0x0a, 0x50, 0xbb, 0xa9, 0x72, 0x33, 0xbc, 0xde, 0xb2, 0x02, 0x78, 0x2f, 0x55, 0x22, 0xe3,
+	0x84, 0x54, 0x3b, 0xf1, 0xe9, 0x1b, 0xde, 0x68, 0x39, 0x21, 0x79, 0x1f, 0x3e, 0xdb, 0x51, 0xae,
+	0xf8, 0x59, 0xb9, 0x6e, 0xb6, 0x94, 0x2b, 0x7e, 0x8e, 0x5c, 0xf1, 0x33, 0x72, 0xbd, 0xd9, 0x52,
+	0xae, 0xf8, 0x29, 0xb9, 0xbe, 0x20, 0x17, 0x62, 0x2a, 0xa4, 0xca, 0xc3, 0x1f, 0xb6, 0x63, 0x3e,
+	0x1f, 0x7f, 0x21, 0x55, 0x1e, 0xfc, 0x8e, 0x2c, 0x40, 0xa1, 0x79, 0x26, 0x45, 0xad, 0xfe, 0x46,
+	0x4b, 0xf5, 0x3d, 0x5f, 0xa5, 0xfe, 0x97, 0xe4, 0xed, 0x11, 0xcb, 0x52, 0x7b, 0xf4, 0x0b, 0x25,
+	0x63, 0xd0, 0xda, 0xb7, 0x6d, 0x3b, 0x2d, 0xb2, 0x4c, 0x53, 0x10, 0x09, 0xb5, 0x29, 0x1e, 0xde,
+	0xb2, 0xf9, 0x7e, 0x03, 0x0d, 0x0e, 0xd8, 0xa4, 0xef, 0xe0, 0xb6, 0x11, 0x47, 0x1e, 0xbc, 0x23,
+	0x92, 0x7b, 0x08, 0xc5, 0xc4, 0xa9, 0x22, 0xd7, 0x2c, 0x05, 0x9a, 0xf0, 0x71, 0xf8, 0xa3, 0xef,
+	0x27, 0xf4, 0x01, 0x4b, 0x61, 0x9b, 0x8f, 0xb1, 0x3a, 0x26, 0xcc, 0x30, 0xaa, 0x41, 0x24, 0x38,
+	0x35, 0x62, 0x11, 0x7a, 0xe7, 0x45, 0xab, 0x23, 0x32, 0x0d, 0x1c, 0x11, 0xd6, 0x20, 0x7f, 0xa9,
+	0x80, 0x0c, 0x72, 0x10, 0xc6, 0x9d, 0xf9, 0x3a, 0x6b, 0x6e, 0xd7, 0x45, 0x7b, 0xc7, 0xaf, 0xf7,
+	0x41, 0xd5, 0x39, 0xe0, 0x9b, 0x15, 0x8e, 0x68, 0x63, 0x5f, 0xb8, 0x9d, 0xbd, 0xd3, 0x70, 0xb3,
+	0x6e, 0x56, 0x5b, 0x16, 0x61, 0x0b, 0x32, 0x12, 0x38, 0xdd, 0x6e, 0x91, 0x4b, 0x68, 0xad, 0x8d,
+	0xc2, 0x4f, 0xf2, 0xd3, 0x78, 0xcf, 0xda, 0x2c, 0x60, 0x83, 0xb3, 0xef, 0xfd, 0x38, 0x2e, 0xc9,
+	0x55, 0x2e, 0xb8, 0xe1, 0x2c, 0xa3, 0x0a, 0x86, 0x0a, 0x0c, 0xfd, 0xaa, 0x64, 0xc2, 0xf0, 0x0c,
+	0xc2, 0x77, 0xdb, 0x49, 0x7d, 0xd9, 0xf3, 0x46, 0x96, 0xf6, 0x57, 0x9e, 0x35, 0xf8, 0x2d, 0x59,
+	0x28, 0x6c, 0x7a, 0x4f, 0xf7, 0xf4, 0xc7, 0x2d, 0xa7, 0xf4, 0x02, 0xf3, 0xbc, 0xda, 0xd1, 0x88,
+	0xbc, 0xe5, 0xf2, 0xb0, 0x14, 0x40, 0x99, 0x8a, 0x47, 0x7c, 0x0c, 0x89, 0xef, 0x7d, 0xc7, 0x70,
+	0xda, 0x4c, 0xc5, 0x9f, 0x58, 0x49, 0xde, 0xc0, 0x22, 0x8f, 0xe8, 0x2d, 0x0f, 0xb6, 0x6a, 0x7e,
+	0x06, 0xa7, 0xd3, 0x44, 0x7c, 0x40, 0x36, 0x90, 0x33, 0x2d, 0x5d, 0x92, 0xe8, 0xf2, 0x48, 0xc7,
+	0x8a, 0x17, 0xb6, 0x6d, 0x25, 0x8a, 0x17, 0x4d, 0xd2, 0x3b, 0x2e, 0xbf, 0x73, 0x36, 0xd9, 0x75,
+	0xf0, 0x41, 0x03, 0xbd, 0x8d, 0xe0, 0x9a, 0x76, 0x42, 0x56, 0x7d, 0x5b, 0xe7, 0x31, 0xb5, 0xad,
+	0x55, 0x2a, 0x1a, 0xcb, 0xdc, 0x5f, 0x81, 0xc3, 0xf7, 0xda, 0xe9, 0xe2, 0x07, 0x0a, 0x1e, 0xdf,
+	0x77, 0xd4, 0xf7, 0x6b, 0xe6, 0xe0, 0x3d, 0x72, 0x45, 0xc8, 0x04, 0xa8, 0x92, 0xc6, 0x35, 0xdf,
+	0x58, 0xca, 0x2c, 0x91, 0x27, 0x22, 0xbc, 0x6b, 0x7b, 0xd8, 0x22, 0xae, 0x46, 0x7e, 0xf1, 0xbe,
+	0x5f, 0x0b, 0x7e, 0x4e, 0x56, 0xab, 0x99, 0x5e, 0x96, 0x26, 0xe3, 0xa0, 0xa8, 0xce, 0x98, 0x1e,
+	0xb9, 0x96, 0xa8, 0xc3, 0x9f, 0xba, 0x39, 0xc6, 0x63, 0x7e, 0xe9, 0x20, 0x03, 0x44, 0xd8, 0x7e,
+	0xa8, 0x83, 0x3f, 0x90, 0xee, 0xf3, 0x09, 0xea, 0xeb, 0xcd, 0xfb, 0xed, 0x3e, 0x79, 0xe5, 0x39,
+	0xbe, 0xeb, 0x9b, 0xce, 0x1d, 0x77, 0x7f, 0x43, 0x0d, 0x20, 0xf1, 0x31, 0xd3, 0xdf, 0x33, 0x9e,
+	0x85, 0x1f, 0xd8, 0xc0, 0x5f, 0xcf, 0xd9, 0xe4, 0xc0, 0x2e, 0xba, 0x70, 0xf7, 0x19, 0xcf, 0xf0,
+	0x9b, 0x9f, 0x35, 0x4a, 0xc0, 0xcd, 0x1b, 0xa0, 0xc2, 0x0f, 0xeb, 0xd9, 0xad, 0x69, 0xba, 0x5d,
+	0x03, 0x82, 0xcf, 0xc8, 0xf5, 0xea, 0x9b, 0xa7, 0x5b, 0x4b, 0xcb, 0x22, 0x61, 0x06, 0xa6, 0xb2,
+	0x7f, 0x64, 0x65, 0x5f, 0xf3, 0xc8, 0xe9, 0x4e, 0x3d, 0xb0, 0xb8, 0x7a, 0x07, 0xfe, 0xe8, 0x6e,
+	0x59, 0x09, 0x64, 0x30, 0x74, 0x23, 0x1d, 0xd5, 0x90, 0xa5, 0x55, 0xb5, 0xc5, 0xed, 0x0a, 0x3f,
+	0x6e, 0x29, 0x61, 0xce, 0x26, 0xdb, 0x9e, 0xff, 0x50, 0x0e, 0x20, 0x4b, 0x5d, 0x71, 0x46, 0xee,
+	0xc0, 0x90, 0xee, 0x19, 0xff, 0xce, 0x6f, 0x61, 0x2f, 0x79, 0xae, 0xb5, 0x7c, 0xf2, 0x82, 0xc5,
+	0x73, 0xb9, 0xe1, 0xd6, 0x3a, 0xec, 0xe3, 0x9d, 0xd0, 0xf5, 0x17, 0x7f, 0xfc, 0x58, 0x69, 0x24,
+	0x6a, 0x58, 0xc8, 0x52, 0x24, 0x55, 0x0c, 0xf8, 0x53, 0x91, 0x0d, 0xa0, 0x60, 0xa7, 0xb2, 0x34,
+	0xe1, 0xcf, 0xea, 0xe3, 0xb7, 0x55, 0x1a, 0x79, 0xdf, 0xa3, 0xb7, 0xa7, 0xe0, 0x3e, 0xa8, 0xbe,
+	0x85, 0xee, 0xcf, 0xce, 0xcd, 0x76, 0xce, 0xef, 0xcf, 0xce, 0x2d, 0x77, 0x56, 0xf6, 0x67, 0xe7,
+	0x56, 0x3a, 0xab, 0xfb, 0xb3, 0x73, 0x6f, 0x75, 0x36, 0xf6, 0x67, 0xe7, 0xde, 0xee, 0xdc, 0x8a,
+	0x16, 0xeb, 0xa3, 0xd9, 0xa8, 0xbc, 0xd1, 0x8a, 0x9d, 0x00, 0xd3, 0x14, 0x1a, 0x95, 0xb9, 0xba,
+	0x21, 0x5a, 0xaf, 0x54, 0x81, 0x51, 0xdc, 0x5d, 0x30, 0xdc, 0x1d, 0x97, 0x0a, 0x29, 0x62, 0xd0,
+	0xfe, 0x56, 0x1d, 0xad, 0xd6, 0x63, 0x78, 0x65, 0x47, 0x13, 0x88, 0xd9, 0x29, 0xee, 0x1e, 0x44,
+	0x37, 0xff, 0x27, 0x85, 0x17, 0xf9, 0x5e, 0xf4, 0xcd, 0xe3, 0xee, 0xcc, 0xb7, 0x8f, 0xbb, 0x33,
+	0xff, 0x7e, 0xdc, 0x9d, 0xf9, 0xf3, 0x93, 0xee, 0xb9, 0x6f, 0x9f, 0x74, 0xcf, 0xfd, 0xf3, 0x49,
+	0xf7, 0xdc, 0xa3, 0x0f, 0xfe, 0xcf, 0xed, 0x9e, 0xf4, 0xa6, 0xbf, 0xc1, 0x99, 0xd3, 0x02, 0xf4,
+	0xd1, 0x05, 0xfb, 0x73, 0xd9, 0x9d, 0xff, 0x0e, 0x00, 0x10, 0xa3, 0x06, 0x2e, 0x9d, 0x13, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -374,6 +465,110 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundDelegationsPerPayout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoCompoundDelegationsPerPayout))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
+	}
+	{
+		size := m.MaxDelegateStakePerReputer.Size()
+		i -= size
+		if _, err := m.MaxDelegateStakePerReputer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.MaxDelegateToSelfStakeRatio.Size()
+		i -= size
+		if _, err := m.MaxDelegateToSelfStakeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xda
+	if m.ReputerCommissionUpdateCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReputerCommissionUpdateCooldown))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxMissedEpochsDeregister != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedEpochsDeregister))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MaxMissedEpochsJail != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedEpochsJail))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.ReputerOutlierSlashFraction.Size()
+		i -= size
+		if _, err := m.ReputerOutlierSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xba
+	if m.ReputerOutlierSlashEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReputerOutlierSlashEpochs))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.NodeRotationCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NodeRotationCooldown))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.MaxTopicCreatorCommission.Size()
+		i -= size
+		if _, err := m.MaxTopicCreatorCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xa2
+	if m.MaxFundingSubscriptionDripsEndBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFundingSubscriptionDripsEndBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxPruneArchivedTopicKeysEndBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPruneArchivedTopicKeysEndBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
 	{
 		size := m.PNormSafeDiv.Size()
 		i -= size
@@ -897,6 +1092,38 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovParams(uint64(l))
 	l = m.PNormSafeDiv.Size()
 	n += 2 + l + sovParams(uint64(l))
+	if m.MaxPruneArchivedTopicKeysEndBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxPruneArchivedTopicKeysEndBlock))
+	}
+	if m.MaxFundingSubscriptionDripsEndBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxFundingSubscriptionDripsEndBlock))
+	}
+	l = m.MaxTopicCreatorCommission.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.NodeRotationCooldown != 0 {
+		n += 2 + sovParams(uint64(m.NodeRotationCooldown))
+	}
+	if m.ReputerOutlierSlashEpochs != 0 {
+		n += 2 + sovParams(uint64(m.ReputerOutlierSlashEpochs))
+	}
+	l = m.ReputerOutlierSlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxMissedEpochsJail != 0 {
+		n += 2 + sovParams(uint64(m.MaxMissedEpochsJail))
+	}
+	if m.MaxMissedEpochsDeregister != 0 {
+		n += 2 + sovParams(uint64(m.MaxMissedEpochsDeregister))
+	}
+	if m.ReputerCommissionUpdateCooldown != 0 {
+		n += 2 + sovParams(uint64(m.ReputerCommissionUpdateCooldown))
+	}
+	l = m.MaxDelegateToSelfStakeRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxDelegateStakePerReputer.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxAutoCompoundDelegationsPerPayout != 0 {
+		n += 2 + sovParams(uint64(m.MaxAutoCompoundDelegationsPerPayout))
+	}
 	return n
 }
 
@@ -2125,6 +2352,294 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPruneArchivedTopicKeysEndBlock", wireType)
+			}
+			m.MaxPruneArchivedTopicKeysEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPruneArchivedTopicKeysEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingSubscriptionDripsEndBlock", wireType)
+			}
+			m.MaxFundingSubscriptionDripsEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFundingSubscriptionDripsEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicCreatorCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTopicCreatorCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeRotationCooldown", wireType)
+			}
+			m.NodeRotationCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeRotationCooldown |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierSlashEpochs", wireType)
+			}
+			m.ReputerOutlierSlashEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerOutlierSlashEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerOutlierSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochsJail", wireType)
+			}
+			m.MaxMissedEpochsJail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedEpochsJail |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochsDeregister", wireType)
+			}
+			m.MaxMissedEpochsDeregister = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedEpochsDeregister |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerCommissionUpdateCooldown", wireType)
+			}
+			m.ReputerCommissionUpdateCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerCommissionUpdateCooldown |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegateToSelfStakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDelegateToSelfStakeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegateStakePerReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDelegateStakePerReputer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundDelegationsPerPayout", wireType)
+			}
+			m.MaxAutoCompoundDelegationsPerPayout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundDelegationsPerPayout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
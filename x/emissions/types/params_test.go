@@ -53,6 +53,18 @@ func TestDefaultParams(t *testing.T) {
 		MaxStringLength:                     uint64(255),
 		InitialRegretQuantile:               alloraMath.MustNewDecFromString("0.25"),
 		PNormSafeDiv:                        alloraMath.MustNewDecFromString("8.25"),
+		MaxPruneArchivedTopicKeysEndBlock:   uint64(1000),
+		MaxFundingSubscriptionDripsEndBlock: uint64(100),
+		MaxTopicCreatorCommission:           alloraMath.MustNewDecFromString("0.1"),
+		NodeRotationCooldown:                int64((60 * 60 * 24) / 3),
+		ReputerOutlierSlashEpochs:           uint64(3),
+		ReputerOutlierSlashFraction:         alloraMath.MustNewDecFromString("0.01"),
+		MaxMissedEpochsJail:                 uint64(50),
+		MaxMissedEpochsDeregister:           uint64(500),
+		ReputerCommissionUpdateCooldown:     int64((60 * 60 * 24) / 3),
+		MaxDelegateToSelfStakeRatio:         alloraMath.ZeroDec(),
+		MaxDelegateStakePerReputer:          cosmosMath.ZeroInt(),
+		MaxAutoCompoundDelegationsPerPayout: uint64(100),
 	}
 
 	params := DefaultParams()
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// True if the inferences of the topic are sets of quantiles rather than point predictions
+func (topic Topic) IsProbabilistic() bool {
+	return len(topic.QuantileLevels) > 0
+}
+
+// Loss methods able to score the quantiles of a probabilistic topic
+func QuantileLossMethods() []string {
+	return []string{LossMethodCRPS, LossMethodPinball}
+}
+
+// True if the loss method can score the quantiles of a probabilistic topic
+func IsQuantileLossMethod(lossMethod string) bool {
+	return lossMethod == LossMethodCRPS || lossMethod == LossMethodPinball
+}
+
+// Returns log10 of the loss of quantiles predicted at the given levels. The pinball loss is the mean of the
+// pinball losses of the quantiles, and CRPS is approximated by twice that mean, CRPS being the integral of
+// the pinball loss over all levels. Losses are floored as in CalcVectorLogLoss.
+func CalcQuantileLogLoss(lossMethod string, quantiles, levels []alloraMath.Dec, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	if len(quantiles) == 0 || len(quantiles) != len(levels) {
+		return alloraMath.Dec{}, errors.Wrapf(ErrInvalidOutputDimension,
+			"prediction has %d quantiles, topic has %d levels", len(quantiles), len(levels))
+	}
+	var scale alloraMath.Dec
+	switch lossMethod {
+	case LossMethodPinball:
+		scale = alloraMath.OneDec()
+	case LossMethodCRPS:
+		scale = alloraMath.NewDecFromInt64(2)
+	default:
+		return alloraMath.Dec{}, errors.Wrapf(ErrInvalidQuantileLevels,
+			"loss method %q cannot score quantiles, expected one of %v", lossMethod, QuantileLossMethods())
+	}
+	sumLoss := alloraMath.ZeroDec()
+	for i := range quantiles {
+		loss, err := pinballLoss(quantiles[i], levels[i], groundTruth)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		sumLoss, err = sumLoss.Add(loss)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+	}
+	loss, err := sumLoss.Quo(alloraMath.NewDecFromInt64(int64(len(quantiles))))
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	loss, err = loss.Mul(scale)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	loss, err = alloraMath.Max(loss, MinChainComputedLoss)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return alloraMath.Log10(loss)
+}
+
+// Pinball loss of the quantile q at level τ: τ (y - q) when y >= q, (1 - τ) (q - y) otherwise
+func pinballLoss(quantile, level, groundTruth alloraMath.Dec) (alloraMath.Dec, error) {
+	diff, err := groundTruth.Sub(quantile)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	if !diff.IsNegative() {
+		return diff.Mul(level)
+	}
+	oneMinusLevel, err := alloraMath.OneDec().Sub(level)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	absDiff, err := diff.Abs()
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return absDiff.Mul(oneMinusLevel)
+}
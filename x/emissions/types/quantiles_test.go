@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func decs(values ...string) []alloraMath.Dec {
+	result := make([]alloraMath.Dec, 0, len(values))
+	for _, value := range values {
+		result = append(result, alloraMath.MustNewDecFromString(value))
+	}
+	return result
+}
+
+func TestCalcQuantileLogLoss(t *testing.T) {
+	levels := decs("0.1", "0.5", "0.9")
+	quantiles := decs("1", "2", "3")
+	groundTruth := alloraMath.MustNewDecFromString("2.5")
+
+	// Pinball losses of 0.1 * 1.5, 0.5 * 0.5 and 0.1 * 0.5
+	logLoss, err := types.CalcQuantileLogLoss(types.LossMethodPinball, quantiles, levels, groundTruth)
+	require.NoError(t, err)
+	expected, err := alloraMath.Log10(alloraMath.MustNewDecFromString("0.15"))
+	require.NoError(t, err)
+	require.True(t, expected.Equal(logLoss), "expected %s, got %s", expected, logLoss)
+
+	logLoss, err = types.CalcQuantileLogLoss(types.LossMethodCRPS, quantiles, levels, groundTruth)
+	require.NoError(t, err)
+	expected, err = alloraMath.Log10(alloraMath.MustNewDecFromString("0.3"))
+	require.NoError(t, err)
+	require.True(t, expected.Equal(logLoss), "expected %s, got %s", expected, logLoss)
+
+	_, err = types.CalcQuantileLogLoss(types.LossMethodMSE, quantiles, levels, groundTruth)
+	require.ErrorIs(t, err, types.ErrInvalidQuantileLevels)
+	_, err = types.CalcQuantileLogLoss(types.LossMethodPinball, quantiles[:2], levels, groundTruth)
+	require.ErrorIs(t, err, types.ErrInvalidOutputDimension)
+}
+
+func TestValidateQuantileLevels(t *testing.T) {
+	require.NoError(t, types.ValidateQuantileLevels(nil, 0, types.LossMethodMSE))
+	require.NoError(t, types.ValidateQuantileLevels(decs("0.1", "0.5", "0.9"), 3, types.LossMethodCRPS))
+
+	tests := []struct {
+		name            string
+		levels          []alloraMath.Dec
+		outputDimension uint64
+		lossMethod      string
+	}{
+		{"dimension mismatch", decs("0.1", "0.9"), 3, types.LossMethodPinball},
+		{"point loss method", decs("0.1", "0.9"), 2, types.LossMethodMSE},
+		{"level of zero", decs("0", "0.9"), 2, types.LossMethodPinball},
+		{"level of one", decs("0.1", "1"), 2, types.LossMethodPinball},
+		{"decreasing levels", decs("0.9", "0.1"), 2, types.LossMethodPinball},
+		{"repeated levels", decs("0.5", "0.5"), 2, types.LossMethodPinball},
+	}
+	for _, tc := range tests {
+		err := types.ValidateQuantileLevels(tc.levels, tc.outputDimension, tc.lossMethod)
+		require.ErrorIs(t, err, types.ErrInvalidQuantileLevels, tc.name)
+	}
+}
+
+func TestInferenceValidateQuantiles(t *testing.T) {
+	inference := types.Inference{
+		TopicId:     1,
+		BlockHeight: 1,
+		Inferer:     "",
+		Value:       alloraMath.Dec{},
+		ExtraData:   nil,
+		Proof:       "",
+		Values:      decs("1", "1", "2"),
+	}
+	require.NoError(t, inference.ValidateQuantiles())
+
+	inference.Values = decs("1", "3", "2")
+	require.ErrorIs(t, inference.ValidateQuantiles(), types.ErrCrossingQuantiles)
+}
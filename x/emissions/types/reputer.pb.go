@@ -195,6 +195,13 @@ type ValueBundle struct {
 	// R^-_ilm || log10 L^-_ilm || I^-_il where l = any j
 	// log10 L^-_j'ikm || I^-_j'ik
 	OneOutInfererForecasterValues []*OneOutInfererForecasterValues `protobuf:"bytes,12,rep,name=one_out_inferer_forecaster_values,json=oneOutInfererForecasterValues,proto3" json:"one_out_inferer_forecaster_values,omitempty"`
+	// Ground truth reported for topics whose losses are computed by the chain.
+	// The losses above are then left empty by the reputer and filled in by the
+	// chain, which excludes them from the signed bytes of the bundle.
+	GroundTruth *github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,opt,name=ground_truth,json=groundTruth,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"ground_truth,omitempty"`
+	// Ground truth of a topic with chain computed losses and an output dimension
+	// greater than 1, one value per output, in place of ground_truth
+	GroundTruthValues []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,14,rep,name=ground_truth_values,json=groundTruthValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"ground_truth_values"`
 }
 
 func (m *ValueBundle) Reset()         { *m = ValueBundle{} }
@@ -406,6 +413,77 @@ func (m *ReputerValueBundles) GetReputerValueBundles() []*ReputerValueBundle {
 	return nil
 }
 
+// Hash a reputer committed to in the submission window of a reputer nonce,
+// for the value bundle it reveals in the reveal window of the nonce
+type ReputerCommitment struct {
+	TopicId          uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64  `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	Reputer          string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// sha256 of the serialized value bundle followed by a salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *ReputerCommitment) Reset()         { *m = ReputerCommitment{} }
+func (m *ReputerCommitment) String() string { return proto.CompactTextString(m) }
+func (*ReputerCommitment) ProtoMessage()    {}
+func (*ReputerCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314531a75ebe144f, []int{6}
+}
+func (m *ReputerCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerCommitment.Merge(m, src)
+}
+func (m *ReputerCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerCommitment proto.InternalMessageInfo
+
+func (m *ReputerCommitment) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *ReputerCommitment) GetNonceBlockHeight() int64 {
+	if m != nil {
+		return m.NonceBlockHeight
+	}
+	return 0
+}
+
+func (m *ReputerCommitment) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *ReputerCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WorkerAttributedValue)(nil), "emissions.v3.WorkerAttributedValue")
 	proto.RegisterType((*WithheldWorkerAttributedValue)(nil), "emissions.v3.WithheldWorkerAttributedValue")
@@ -413,54 +491,61 @@ func init() {
 	proto.RegisterType((*ValueBundle)(nil), "emissions.v3.ValueBundle")
 	proto.RegisterType((*ReputerValueBundle)(nil), "emissions.v3.ReputerValueBundle")
 	proto.RegisterType((*ReputerValueBundles)(nil), "emissions.v3.ReputerValueBundles")
+	proto.RegisterType((*ReputerCommitment)(nil), "emissions.v3.ReputerCommitment")
 }
 
 func init() { proto.RegisterFile("emissions/v3/reputer.proto", fileDescriptor_314531a75ebe144f) }
 
 var fileDescriptor_314531a75ebe144f = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x4a, 0xa1, 0xf4, 0x6d, 0x21, 0x3a, 0x08, 0x59, 0x88, 0x5d, 0x0a, 0x5e, 0x48, 0x8c,
-	0xdd, 0x04, 0x0e, 0x1a, 0xe3, 0x45, 0x42, 0x4c, 0x30, 0x51, 0xcc, 0x46, 0xc5, 0x18, 0xe3, 0x3a,
-	0xdd, 0x7d, 0x6d, 0x27, 0xb4, 0x33, 0x75, 0x76, 0xb6, 0xc2, 0xdd, 0xa3, 0x31, 0xfe, 0x04, 0x6f,
-	0xfe, 0x02, 0xff, 0x03, 0x47, 0x8e, 0xc6, 0x03, 0x31, 0x70, 0xf1, 0x67, 0x98, 0x9d, 0xdd, 0xd2,
-	0xa5, 0xad, 0xa4, 0x06, 0x0f, 0xde, 0xf6, 0xbd, 0x79, 0xf3, 0xbe, 0xef, 0x7d, 0xf3, 0xde, 0x5b,
-	0x58, 0xc2, 0x36, 0x0b, 0x43, 0x26, 0x78, 0xe8, 0x74, 0x37, 0x1c, 0x89, 0x9d, 0x48, 0xa1, 0xac,
-	0x76, 0xa4, 0x50, 0x82, 0x94, 0xce, 0xce, 0xaa, 0xdd, 0x8d, 0x25, 0xeb, 0x5c, 0x24, 0x17, 0xdc,
-	0xc7, 0x24, 0x6e, 0xe9, 0x7a, 0x43, 0x34, 0x84, 0xfe, 0x74, 0xe2, 0xaf, 0xc4, 0xbb, 0xfa, 0xc1,
-	0x80, 0xf9, 0x5d, 0x21, 0xf7, 0x50, 0x3e, 0x50, 0x4a, 0xb2, 0x5a, 0xa4, 0x30, 0x78, 0x41, 0x5b,
-	0x11, 0x92, 0x05, 0x98, 0x7a, 0xaf, 0x0f, 0x2c, 0xa3, 0x62, 0xac, 0x15, 0xdd, 0xd4, 0x22, 0x8f,
-	0x61, 0xb2, 0x1b, 0x07, 0x58, 0x57, 0x62, 0xf7, 0xe6, 0x9d, 0xc3, 0xe3, 0xe5, 0xdc, 0x8f, 0xe3,
-	0x65, 0xa7, 0xc1, 0x54, 0x33, 0xaa, 0x55, 0x7d, 0xd1, 0x76, 0x68, 0xab, 0x25, 0x24, 0xbd, 0xcd,
-	0x51, 0xc5, 0x77, 0x7a, 0xa6, 0xdf, 0xa4, 0x8c, 0x3b, 0x6d, 0xaa, 0x9a, 0xd5, 0x2d, 0xf4, 0xdd,
-	0x24, 0xcb, 0xbd, 0xfc, 0xaf, 0x2f, 0xcb, 0xc6, 0xea, 0x47, 0x03, 0xca, 0xbb, 0x4c, 0x35, 0x9b,
-	0xd8, 0x0a, 0xfe, 0x03, 0x3a, 0x5f, 0x0d, 0x28, 0xef, 0x70, 0xdc, 0x89, 0xd4, 0x36, 0xaf, 0xa3,
-	0x44, 0xf9, 0x50, 0x48, 0xf4, 0x69, 0xa8, 0x50, 0x6a, 0x36, 0x21, 0xb1, 0x01, 0xea, 0x67, 0x3e,
-	0x6b, 0x42, 0x53, 0xca, 0x78, 0xc8, 0x5b, 0x58, 0x10, 0x1c, 0x3d, 0x11, 0x29, 0x8f, 0x25, 0x29,
-	0x3c, 0x0d, 0x10, 0x5a, 0xf9, 0xca, 0xc4, 0x9a, 0xb9, 0x7e, 0xab, 0x9a, 0x7d, 0xb6, 0xea, 0x85,
-	0xb5, 0xbb, 0x73, 0x22, 0xcb, 0x25, 0x61, 0x90, 0x32, 0xfd, 0x56, 0x00, 0x53, 0x3b, 0x36, 0x23,
-	0x1e, 0xb4, 0x90, 0x2c, 0xc2, 0xb4, 0x12, 0x1d, 0xe6, 0x7b, 0x2c, 0xd0, 0x42, 0xe5, 0xdd, 0x82,
-	0xb6, 0xb7, 0x03, 0xf2, 0x1c, 0xe6, 0xd3, 0xce, 0xf1, 0x24, 0xbe, 0x8b, 0x30, 0x54, 0x9e, 0xee,
-	0x0f, 0xad, 0x9c, 0xb9, 0xbe, 0x72, 0x9e, 0x91, 0x9b, 0x84, 0xba, 0x49, 0xe4, 0x93, 0x38, 0xd0,
-	0x9d, 0x93, 0xc3, 0x4e, 0x62, 0x41, 0x21, 0x75, 0xa7, 0x32, 0xf4, 0x4c, 0x52, 0x06, 0xc0, 0x7d,
-	0x25, 0xa9, 0x17, 0x50, 0x45, 0xad, 0x7c, 0xc5, 0x58, 0x2b, 0xb9, 0x45, 0xed, 0xd9, 0xa2, 0x8a,
-	0x92, 0x37, 0x30, 0xeb, 0x8b, 0x76, 0x8d, 0x71, 0x0c, 0x12, 0x6d, 0xac, 0xc9, 0xcb, 0x3d, 0xe1,
-	0x4c, 0x2f, 0x5d, 0xd2, 0x31, 0x8f, 0x60, 0x76, 0x40, 0xfa, 0x29, 0x2d, 0xfd, 0xcd, 0x01, 0xe9,
-	0x47, 0x4a, 0x3e, 0xc3, 0xb2, 0x62, 0x93, 0xa7, 0x70, 0xad, 0xff, 0xb8, 0xbd, 0x74, 0x85, 0xf1,
-	0xd3, 0x5d, 0xad, 0x0f, 0x36, 0xd0, 0x4b, 0x30, 0x39, 0x65, 0x5d, 0x4c, 0x4b, 0x9f, 0xbe, 0x5c,
-	0xe9, 0xa0, 0x73, 0x25, 0x75, 0xff, 0xb9, 0xf5, 0x8a, 0xff, 0xa6, 0xf5, 0x48, 0x1d, 0x16, 0x7b,
-	0x08, 0xc3, 0xaa, 0xc0, 0xdf, 0x83, 0x2c, 0x24, 0x20, 0x43, 0x43, 0xf6, 0x1a, 0xac, 0x18, 0x87,
-	0xf1, 0x11, 0x30, 0xe6, 0xf8, 0xe2, 0xcf, 0x0b, 0x8e, 0xdb, 0x7c, 0x28, 0x7b, 0x04, 0x2b, 0x83,
-	0x3a, 0x0d, 0xc3, 0x94, 0x46, 0x55, 0x73, 0xe1, 0x6a, 0x70, 0xcb, 0xe2, 0xa2, 0xe3, 0x74, 0x6e,
-	0x3f, 0x19, 0x40, 0xd2, 0x11, 0xcb, 0x8e, 0xef, 0x7d, 0x28, 0x69, 0x60, 0xaf, 0xa6, 0x6d, 0x3d,
-	0xc2, 0xe6, 0xfa, 0xe2, 0x79, 0xf8, 0xcc, 0x05, 0xd7, 0xec, 0x66, 0x6e, 0xdf, 0x80, 0x62, 0xc8,
-	0x1a, 0x9c, 0xaa, 0x48, 0x26, 0x53, 0x5d, 0x72, 0xfb, 0x8e, 0x78, 0x83, 0x76, 0xa2, 0xda, 0x1e,
-	0x1e, 0xa4, 0x73, 0x9a, 0x5a, 0x29, 0xa1, 0x3d, 0x98, 0x1b, 0xe6, 0x13, 0x92, 0x67, 0xfd, 0xa5,
-	0x91, 0x25, 0x16, 0x5a, 0x86, 0x16, 0xa6, 0x32, 0x72, 0x69, 0x64, 0x09, 0xf6, 0x76, 0x46, 0x36,
-	0xeb, 0xa6, 0x7b, 0x78, 0x62, 0x1b, 0x47, 0x27, 0xb6, 0xf1, 0xf3, 0xc4, 0x36, 0x3e, 0x9f, 0xda,
-	0xb9, 0xa3, 0x53, 0x3b, 0xf7, 0xfd, 0xd4, 0xce, 0xbd, 0xba, 0x3b, 0x66, 0xe7, 0xef, 0x3b, 0xfd,
-	0x1f, 0x9d, 0x3a, 0xe8, 0x60, 0x58, 0x9b, 0xd2, 0x3f, 0xb4, 0x8d, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe0, 0x07, 0x4c, 0xeb, 0x2c, 0x07, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0xcd, 0xbc, 0xa4, 0x1f, 0xb9, 0x4e, 0xab, 0x76, 0xf2, 0x5a, 0xb9, 0xd5, 0x8b, 0x9b, 0xf6,
+	0x6d, 0x2a, 0xbd, 0x47, 0x22, 0xb5, 0x0b, 0x10, 0x62, 0x43, 0xa8, 0x10, 0x45, 0x82, 0x22, 0xab,
+	0x50, 0x54, 0x21, 0x8c, 0x63, 0x4f, 0xe2, 0x51, 0x92, 0x99, 0x30, 0x1e, 0x87, 0x76, 0xcf, 0x12,
+	0x21, 0x56, 0xac, 0xd9, 0xf1, 0x57, 0xba, 0xec, 0x12, 0xb1, 0xa8, 0x50, 0xbb, 0xe1, 0x37, 0xb0,
+	0x42, 0x1e, 0x3b, 0x89, 0xf3, 0x41, 0x54, 0x28, 0x0b, 0x76, 0x9e, 0x33, 0xd7, 0xf7, 0x9e, 0x7b,
+	0xee, 0xcd, 0x89, 0x61, 0x95, 0xb4, 0xa8, 0xef, 0x53, 0xce, 0xfc, 0x72, 0x67, 0xbb, 0x2c, 0x48,
+	0x3b, 0x90, 0x44, 0x94, 0xda, 0x82, 0x4b, 0x8e, 0x73, 0xbd, 0xbb, 0x52, 0x67, 0x7b, 0x55, 0x1f,
+	0x88, 0x64, 0x9c, 0x39, 0x24, 0x8a, 0x5b, 0xfd, 0xbb, 0xce, 0xeb, 0x5c, 0x3d, 0x96, 0xc3, 0xa7,
+	0x08, 0xdd, 0x78, 0x8d, 0x60, 0xe9, 0x80, 0x8b, 0x06, 0x11, 0xb7, 0xa5, 0x14, 0xb4, 0x1a, 0x48,
+	0xe2, 0x3e, 0xb1, 0x9b, 0x01, 0xc1, 0xcb, 0x30, 0xfd, 0x4a, 0x5d, 0xe8, 0xa8, 0x88, 0x36, 0xb3,
+	0x66, 0x7c, 0xc2, 0x0f, 0x60, 0xaa, 0x13, 0x06, 0xe8, 0x7f, 0x85, 0x70, 0xe5, 0xfa, 0xc9, 0xd9,
+	0x5a, 0xea, 0xf3, 0xd9, 0x5a, 0xb9, 0x4e, 0xa5, 0x17, 0x54, 0x4b, 0x0e, 0x6f, 0x95, 0xed, 0x66,
+	0x93, 0x0b, 0xfb, 0x1a, 0x23, 0x32, 0x7c, 0xa7, 0x7b, 0x74, 0x3c, 0x9b, 0xb2, 0x72, 0xcb, 0x96,
+	0x5e, 0x69, 0x87, 0x38, 0x66, 0x94, 0xe5, 0x66, 0xe6, 0xeb, 0x87, 0x35, 0xb4, 0xf1, 0x06, 0x41,
+	0xe1, 0x80, 0x4a, 0xcf, 0x23, 0x4d, 0xf7, 0x0f, 0xa0, 0xf3, 0x11, 0x41, 0x61, 0x8f, 0x91, 0xbd,
+	0x40, 0xee, 0xb2, 0x1a, 0x11, 0x44, 0xdc, 0xe5, 0x82, 0x38, 0xb6, 0x2f, 0x89, 0x50, 0x6c, 0x7c,
+	0x6c, 0x00, 0xd4, 0x7a, 0x98, 0x9e, 0x56, 0x94, 0x12, 0x08, 0x7e, 0x01, 0xcb, 0x9c, 0x11, 0x8b,
+	0x07, 0xd2, 0xa2, 0x51, 0x0a, 0x4b, 0x15, 0xf0, 0xf5, 0x4c, 0x31, 0xbd, 0xa9, 0x6d, 0xfd, 0x57,
+	0x4a, 0x8e, 0xad, 0x34, 0xb1, 0x77, 0x33, 0xcf, 0x93, 0x5c, 0x22, 0x06, 0x31, 0xd3, 0x6f, 0xb3,
+	0xa0, 0x29, 0xa0, 0x12, 0x30, 0xb7, 0x49, 0xf0, 0x0a, 0xcc, 0x4a, 0xde, 0xa6, 0x8e, 0x45, 0x5d,
+	0x25, 0x54, 0xc6, 0x9c, 0x51, 0xe7, 0x5d, 0x17, 0x3f, 0x86, 0xa5, 0x78, 0x73, 0x2c, 0x41, 0x5e,
+	0x06, 0xc4, 0x97, 0x96, 0xda, 0x0f, 0xa5, 0x9c, 0xb6, 0xb5, 0x3e, 0xc8, 0xc8, 0x8c, 0x42, 0xcd,
+	0x28, 0xf2, 0x61, 0x18, 0x68, 0xe6, 0xc5, 0x28, 0x88, 0x75, 0x98, 0x89, 0xe1, 0x58, 0x86, 0xee,
+	0x11, 0x17, 0x00, 0xc8, 0x91, 0x14, 0xb6, 0xe5, 0xda, 0xd2, 0xd6, 0x33, 0x45, 0xb4, 0x99, 0x33,
+	0xb3, 0x0a, 0xd9, 0xb1, 0xa5, 0x8d, 0x9f, 0xc3, 0xbc, 0xc3, 0x5b, 0x55, 0xca, 0x88, 0x1b, 0x69,
+	0xa3, 0x4f, 0x5d, 0x6d, 0x84, 0x73, 0xdd, 0x74, 0xd1, 0xc6, 0xdc, 0x87, 0xf9, 0x21, 0xe9, 0xa7,
+	0x95, 0xf4, 0xff, 0x0e, 0x49, 0x3f, 0x56, 0xf2, 0x39, 0x9a, 0x14, 0x1b, 0x3f, 0x82, 0xc5, 0xfe,
+	0x70, 0xbb, 0xe9, 0x66, 0x2e, 0x9f, 0x6e, 0xa1, 0x36, 0xbc, 0x40, 0x4f, 0x41, 0x63, 0x36, 0xed,
+	0x90, 0xb8, 0xf5, 0xd9, 0xab, 0xb5, 0x0e, 0x2a, 0x57, 0xd4, 0xf7, 0x8f, 0x57, 0x2f, 0xfb, 0x7b,
+	0x56, 0x0f, 0xd7, 0x60, 0xa5, 0x5b, 0x61, 0x54, 0x15, 0xf8, 0xf9, 0x22, 0xcb, 0x51, 0x91, 0x91,
+	0x1f, 0xd9, 0x33, 0xd0, 0xc3, 0x3a, 0x94, 0x8d, 0x29, 0xa3, 0x5d, 0x5e, 0xfc, 0x25, 0xce, 0xc8,
+	0x2e, 0x1b, 0xc9, 0x1e, 0xc0, 0xfa, 0xb0, 0x4e, 0xa3, 0x65, 0x72, 0xe3, 0xba, 0x99, 0x68, 0x0d,
+	0x66, 0x81, 0x4f, 0xba, 0xc6, 0x87, 0x90, 0xab, 0x0b, 0x1e, 0x30, 0xd7, 0x92, 0x22, 0x90, 0x9e,
+	0x3e, 0xd7, 0x9b, 0x3c, 0xfa, 0x95, 0xc9, 0x6b, 0x51, 0xb2, 0xfd, 0x30, 0x17, 0xae, 0x43, 0x3e,
+	0x99, 0xbb, 0xdb, 0xc4, 0x7c, 0x31, 0x7d, 0x95, 0xe5, 0x5a, 0x4c, 0x94, 0x18, 0x30, 0x9f, 0xb7,
+	0x08, 0x70, 0xec, 0x13, 0x49, 0x0f, 0xba, 0x05, 0x39, 0x55, 0xd8, 0xaa, 0xaa, 0xb3, 0xf2, 0x21,
+	0x6d, 0x6b, 0x65, 0x50, 0xc3, 0xc4, 0x0b, 0xa6, 0xd6, 0x49, 0xbc, 0xfd, 0x0f, 0x64, 0x7d, 0x5a,
+	0x67, 0xb6, 0x0c, 0x44, 0x64, 0x4d, 0x39, 0xb3, 0x0f, 0x84, 0x7f, 0x03, 0xed, 0xa0, 0xda, 0x20,
+	0xc7, 0xb1, 0xd9, 0xc4, 0xa7, 0x98, 0x50, 0x03, 0xf2, 0xa3, 0x7c, 0x7c, 0xbc, 0xdf, 0x77, 0xbe,
+	0x24, 0x31, 0x5f, 0x47, 0x6a, 0xba, 0xc5, 0xb1, 0xce, 0x97, 0x24, 0x98, 0x17, 0x23, 0x98, 0xbf,
+	0xf1, 0x1e, 0xc1, 0x62, 0x1c, 0x7b, 0x87, 0xb7, 0x5a, 0x54, 0xb6, 0x08, 0x93, 0x93, 0x0c, 0xf8,
+	0x7f, 0xc0, 0xca, 0x70, 0xad, 0x6a, 0x93, 0x3b, 0x0d, 0xcb, 0x23, 0xb4, 0xee, 0x49, 0xd5, 0x62,
+	0xda, 0x5c, 0x50, 0x37, 0x95, 0xf0, 0xe2, 0x9e, 0xc2, 0x27, 0xf8, 0xaa, 0x01, 0xe0, 0xf4, 0x0a,
+	0xc6, 0xbe, 0x9a, 0x40, 0x2a, 0xe6, 0xc9, 0xb9, 0x81, 0x4e, 0xcf, 0x0d, 0xf4, 0xe5, 0xdc, 0x40,
+	0xef, 0x2e, 0x8c, 0xd4, 0xe9, 0x85, 0x91, 0xfa, 0x74, 0x61, 0xa4, 0x0e, 0x6f, 0x5c, 0x72, 0xf4,
+	0x47, 0xe5, 0xfe, 0x67, 0x84, 0x3c, 0x6e, 0x13, 0xbf, 0x3a, 0xad, 0x3e, 0x17, 0xb6, 0xbf, 0x0f,
+	0x00, 0x5c, 0x7b, 0x5d, 0x8f, 0x8a, 0x08, 0x00, 0x00,
 }
 
 func (this *WorkerAttributedValue) Equal(that interface{}) bool {
@@ -634,6 +719,21 @@ func (this *ValueBundle) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.GroundTruth == nil {
+		if this.GroundTruth != nil {
+			return false
+		}
+	} else if !this.GroundTruth.Equal(*that1.GroundTruth) {
+		return false
+	}
+	if len(this.GroundTruthValues) != len(that1.GroundTruthValues) {
+		return false
+	}
+	for i := range this.GroundTruthValues {
+		if !this.GroundTruthValues[i].Equal(that1.GroundTruthValues[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReputerValueBundle) Equal(that interface{}) bool {
@@ -810,6 +910,32 @@ func (m *ValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroundTruthValues) > 0 {
+		for iNdEx := len(m.GroundTruthValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.GroundTruthValues[iNdEx].Size()
+				i -= size
+				if _, err := m.GroundTruthValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.GroundTruth != nil {
+		{
+			size := m.GroundTruth.Size()
+			i -= size
+			if _, err := m.GroundTruth.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintReputer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.OneOutInfererForecasterValues) > 0 {
 		for iNdEx := len(m.OneOutInfererForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1034,6 +1160,53 @@ func (m *ReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReputerCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintReputer(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintReputer(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NonceBlockHeight != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.NonceBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReputer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReputer(v)
 	base := offset
@@ -1155,6 +1328,16 @@ func (m *ValueBundle) Size() (n int) {
 			n += 1 + l + sovReputer(uint64(l))
 		}
 	}
+	if m.GroundTruth != nil {
+		l = m.GroundTruth.Size()
+		n += 1 + l + sovReputer(uint64(l))
+	}
+	if len(m.GroundTruthValues) > 0 {
+		for _, e := range m.GroundTruthValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1194,6 +1377,29 @@ func (m *ReputerValueBundles) Size() (n int) {
 	return n
 }
 
+func (m *ReputerCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovReputer(uint64(m.TopicId))
+	}
+	if m.NonceBlockHeight != 0 {
+		n += 1 + sovReputer(uint64(m.NonceBlockHeight))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovReputer(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovReputer(uint64(l))
+	}
+	return n
+}
+
 func sovReputer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1970,6 +2176,78 @@ func (m *ValueBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.GroundTruth = &v
+			if err := m.GroundTruth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.GroundTruthValues = append(m.GroundTruthValues, v)
+			if err := m.GroundTruthValues[len(m.GroundTruthValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -2227,6 +2505,160 @@ func (m *ReputerValueBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReputerCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReputerCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReputerCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+			}
+			m.NonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReputer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,6 @@
+package types
+
+// True if reputers reporting losses far from the consensus of the topic for too long are slashed
+func (topic Topic) SlashesReputerOutliers() bool {
+	return topic.ReputerOutlierThreshold.IsPositive()
+}
@@ -8,6 +8,7 @@ const (
 	ReputerAndDelegatorRewardType TaskRewardType = "ReputerAndDelegator"
 	WorkerInferenceRewardType     TaskRewardType = "WorkerInference"
 	WorkerForecastRewardType      TaskRewardType = "WorkerForecast"
+	TopicCreatorRewardType        TaskRewardType = "TopicCreator"
 )
 
 type TaskReward struct {
@@ -16,3 +17,12 @@ type TaskReward struct {
 	TopicId TopicId
 	Type    TaskRewardType
 }
+
+// Share of the rewards of the topic paid to its creator: the creator commission of the topic,
+// capped by the max topic creator commission param, which may have been lowered since it was set
+func (topic Topic) EffectiveCreatorCommission(maxCommission alloraMath.Dec) (alloraMath.Dec, error) {
+	if topic.CreatorCommission.IsZero() {
+		return alloraMath.ZeroDec(), nil
+	}
+	return alloraMath.Min(topic.CreatorCommission, maxCommission)
+}
@@ -403,238 +403,557 @@ func (m *DelegatorInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StakePlacement)(nil), "emissions.v3.StakePlacement")
-	proto.RegisterType((*DelegateStakePlacement)(nil), "emissions.v3.DelegateStakePlacement")
-	proto.RegisterType((*StakeInfo)(nil), "emissions.v3.StakeInfo")
-	proto.RegisterType((*StakeRemovalInfo)(nil), "emissions.v3.StakeRemovalInfo")
-	proto.RegisterType((*DelegateStakeRemovalInfo)(nil), "emissions.v3.DelegateStakeRemovalInfo")
-	proto.RegisterType((*DelegatorInfo)(nil), "emissions.v3.DelegatorInfo")
+// Unbonding of a worker bond, returned to the worker once the removal delay
+// has passed
+type WorkerBondRemovalInfo struct {
+	BlockRemovalStarted   int64                 `protobuf:"varint,1,opt,name=block_removal_started,json=blockRemovalStarted,proto3" json:"block_removal_started,omitempty"`
+	TopicId               uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Worker                string                `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	Amount                cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRemovalCompleted int64                 `protobuf:"varint,5,opt,name=block_removal_completed,json=blockRemovalCompleted,proto3" json:"block_removal_completed,omitempty"`
 }
 
-func init() { proto.RegisterFile("emissions/v3/stake.proto", fileDescriptor_cdee6632fec3e740) }
-
-var fileDescriptor_cdee6632fec3e740 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xc7, 0x73, 0x49, 0x9b, 0xfe, 0x72, 0x3f, 0x40, 0x60, 0x08, 0xb8, 0x15, 0x72, 0xa3, 0x4c,
-	0x11, 0x52, 0x6d, 0x44, 0x24, 0x60, 0x2e, 0x19, 0xc8, 0x04, 0x72, 0x17, 0xc4, 0x62, 0x9d, 0xed,
-	0x47, 0x62, 0xc5, 0x77, 0xcf, 0xba, 0xbb, 0xa4, 0xf0, 0x2f, 0x30, 0xf1, 0x3f, 0xb0, 0x30, 0x32,
-	0x30, 0xb1, 0xb2, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x92, 0x01, 0xf1, 0x47, 0x20, 0xa1, 0xdc,
-	0x39, 0x69, 0x33, 0xb4, 0x42, 0x54, 0xa2, 0x62, 0xb1, 0xfc, 0xde, 0xd7, 0x7e, 0xf7, 0xf9, 0x7e,
-	0xf5, 0x74, 0xd4, 0x05, 0x9e, 0x29, 0x95, 0xa1, 0x50, 0xc1, 0xa4, 0x1b, 0x28, 0xcd, 0x46, 0xe0,
-	0x17, 0x12, 0x35, 0x3a, 0x97, 0x96, 0x8a, 0x3f, 0xe9, 0x6e, 0x5d, 0x63, 0x3c, 0x13, 0x18, 0x98,
-	0xa7, 0xfd, 0x60, 0x6b, 0x33, 0x41, 0xc5, 0x51, 0x45, 0xa6, 0x0a, 0x6c, 0x51, 0x4a, 0x37, 0x06,
-	0x38, 0x40, 0xdb, 0x9f, 0xbf, 0xd9, 0x6e, 0xfb, 0x13, 0xa1, 0x57, 0xf6, 0xe6, 0x27, 0x3c, 0xcd,
-	0x59, 0x02, 0x1c, 0x84, 0x76, 0xee, 0xd1, 0x66, 0x9c, 0x63, 0x32, 0x8a, 0x24, 0x70, 0x9c, 0xb0,
-	0x3c, 0x52, 0x9a, 0x49, 0x0d, 0xa9, 0x4b, 0x5a, 0xa4, 0x53, 0x0b, 0xaf, 0x1b, 0x31, 0xb4, 0xda,
-	0x9e, 0x95, 0x9c, 0x4d, 0xfa, 0x9f, 0xc6, 0x22, 0x4b, 0xa2, 0x2c, 0x75, 0xab, 0x2d, 0xd2, 0x59,
-	0x0b, 0x37, 0x4c, 0xdd, 0x4f, 0x1d, 0x97, 0x6e, 0x48, 0x28, 0xc6, 0x1a, 0xa4, 0x5b, 0x6b, 0x91,
-	0x4e, 0x23, 0x5c, 0x94, 0xce, 0x63, 0x5a, 0x67, 0x1c, 0xc7, 0x42, 0xbb, 0x6b, 0x73, 0x61, 0xf7,
-	0xee, 0xc1, 0xd1, 0x76, 0xe5, 0xeb, 0xd1, 0x76, 0xd3, 0x72, 0xab, 0x74, 0xe4, 0x67, 0x18, 0x70,
-	0xa6, 0x87, 0x7e, 0x5f, 0xe8, 0xcf, 0x1f, 0x76, 0x68, 0x69, 0xa8, 0x2f, 0xf4, 0xbb, 0xef, 0xef,
-	0xef, 0x90, 0xb0, 0xfc, 0xbf, 0xfd, 0x83, 0xd0, 0x9b, 0x3d, 0xc8, 0x61, 0xc0, 0x34, 0x5c, 0x94,
-	0x9b, 0xdb, 0xb4, 0x91, 0x5a, 0x04, 0x94, 0xd6, 0x50, 0x78, 0xdc, 0x38, 0xe1, 0x75, 0xfd, 0x9c,
-	0x5e, 0x5f, 0x13, 0xda, 0x30, 0x1e, 0xfb, 0xe2, 0x05, 0xae, 0xa0, 0x92, 0x53, 0x51, 0xab, 0xa7,
-	0x05, 0x5f, 0x3b, 0x27, 0xcc, 0x4f, 0x42, 0xaf, 0x1a, 0x98, 0x32, 0x41, 0xc3, 0xf4, 0xef, 0x2d,
-	0x90, 0x73, 0x9f, 0xde, 0x5a, 0x45, 0x4e, 0x90, 0x17, 0x39, 0xcc, 0xa1, 0xd7, 0x0d, 0x74, 0xf3,
-	0x24, 0xf4, 0xa3, 0x85, 0xd8, 0x7e, 0x5b, 0xa5, 0xee, 0xca, 0xe2, 0x5d, 0x48, 0x0e, 0x7f, 0x69,
-	0xf5, 0xce, 0x4a, 0xa9, 0x7e, 0x56, 0x4a, 0x1f, 0x09, 0xbd, 0xdc, 0x5b, 0xf0, 0x98, 0x68, 0x9e,
-	0x2c, 0x99, 0x88, 0x61, 0x7a, 0x50, 0x32, 0x05, 0x83, 0x4c, 0x0f, 0xc7, 0xb1, 0x9f, 0x20, 0x0f,
-	0x58, 0x9e, 0xa3, 0x64, 0x3b, 0x02, 0xf4, 0x3e, 0xca, 0xd1, 0xa2, 0x4c, 0x86, 0x2c, 0x13, 0x96,
-	0xb6, 0x07, 0xc9, 0x12, 0xed, 0x19, 0xfd, 0x5f, 0xc2, 0x3e, 0x93, 0x69, 0x94, 0x42, 0xac, 0xed,
-	0xc2, 0xff, 0xf9, 0x54, 0x6a, 0x67, 0xf5, 0x20, 0xd6, 0xbb, 0xe1, 0xc1, 0xd4, 0x23, 0x87, 0x53,
-	0x8f, 0x7c, 0x9b, 0x7a, 0xe4, 0xcd, 0xcc, 0xab, 0x1c, 0xce, 0xbc, 0xca, 0x97, 0x99, 0x57, 0x79,
-	0xfe, 0xf0, 0x37, 0xc7, 0xbe, 0x0c, 0x8e, 0x2f, 0x74, 0xfd, 0xaa, 0x00, 0x15, 0xd7, 0xcd, 0xe5,
-	0xdb, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x21, 0xcd, 0x79, 0xea, 0x05, 0x00, 0x00,
+func (m *WorkerBondRemovalInfo) Reset()         { *m = WorkerBondRemovalInfo{} }
+func (m *WorkerBondRemovalInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkerBondRemovalInfo) ProtoMessage()    {}
+func (*WorkerBondRemovalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{6}
 }
-
-func (m *StakePlacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerBondRemovalInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerBondRemovalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerBondRemovalInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *StakePlacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkerBondRemovalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerBondRemovalInfo.Merge(m, src)
+}
+func (m *WorkerBondRemovalInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerBondRemovalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerBondRemovalInfo.DiscardUnknown(m)
 }
 
-func (m *StakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_WorkerBondRemovalInfo proto.InternalMessageInfo
+
+func (m *WorkerBondRemovalInfo) GetBlockRemovalStarted() int64 {
+	if m != nil {
+		return m.BlockRemovalStarted
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *WorkerBondRemovalInfo) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.BlockRemovalStarted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *WorkerBondRemovalInfo) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DelegateStakePlacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerBondRemovalInfo) GetBlockRemovalCompleted() int64 {
+	if m != nil {
+		return m.BlockRemovalCompleted
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegateStakePlacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Slash of the stake upon a reputer that was an outlier of a topic for
+// reputer_outlier_slash_epochs consecutive epochs
+type ReputerSlash struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer     string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// distance of the reputer to the consensus in the epoch of the slash
+	Distance github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=distance,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"distance"`
+	// stake of the reputer itself slashed
+	ReputerStakeSlashed cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=reputer_stake_slashed,json=reputerStakeSlashed,proto3,customtype=cosmossdk.io/math.Int" json:"reputer_stake_slashed"`
+	// stake delegated upon the reputer slashed
+	DelegateStakeSlashed cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=delegate_stake_slashed,json=delegateStakeSlashed,proto3,customtype=cosmossdk.io/math.Int" json:"delegate_stake_slashed"`
 }
 
-func (m *DelegateStakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ReputerSlash) Reset()         { *m = ReputerSlash{} }
+func (m *ReputerSlash) String() string { return proto.CompactTextString(m) }
+func (*ReputerSlash) ProtoMessage()    {}
+func (*ReputerSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{7}
+}
+func (m *ReputerSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ReputerSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerSlash.Merge(m, src)
+}
+func (m *ReputerSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerSlash proto.InternalMessageInfo
+
+func (m *ReputerSlash) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.BlockRemovalStarted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ReputerSlash) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *StakeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReputerSlash) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StakeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Commission a reputer charges on the rewards of the stake delegated to it in a topic.
+// As for validators in x/staking, its limits are fixed once and for all when it is created.
+type ReputerCommission struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// share of the rewards of the delegators paid to the reputer
+	Rate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"rate"`
+	// highest rate the reputer can ever charge
+	MaxRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_rate"`
+	// largest change of the rate allowed at once
+	MaxChangeRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_change_rate"`
+	// block height at which the rate was last set
+	UpdateBlockHeight int64 `protobuf:"varint,6,opt,name=update_block_height,json=updateBlockHeight,proto3" json:"update_block_height,omitempty"`
 }
 
-func (m *StakeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ReputerCommission) Reset()         { *m = ReputerCommission{} }
+func (m *ReputerCommission) String() string { return proto.CompactTextString(m) }
+func (*ReputerCommission) ProtoMessage()    {}
+func (*ReputerCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{8}
+}
+func (m *ReputerCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ReputerCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerCommission.Merge(m, src)
+}
+func (m *ReputerCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerCommission proto.InternalMessageInfo
+
+func (m *ReputerCommission) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ReputerCommission) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *StakeRemovalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReputerCommission) GetUpdateBlockHeight() int64 {
+	if m != nil {
+		return m.UpdateBlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Stake a delegator moved at once from a reputer to another in a topic. Until it matures, the
+// stake cannot be redelegated away from its new reputer again, so that it cannot hop between reputers.
+// Stake redelegated to a reputer from different reputers is recorded apart for each of them.
+type Redelegation struct {
+	TopicId   uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// reputer the stake was redelegated from
+	SrcReputer string `protobuf:"bytes,3,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstReputer string `protobuf:"bytes,4,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	// stake redelegated from the source reputer to the reputer since the redelegation last matured
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// block height of the last redelegation from the source reputer to the reputer
+	BlockRedelegated int64 `protobuf:"varint,6,opt,name=block_redelegated,json=blockRedelegated,proto3" json:"block_redelegated,omitempty"`
+	// block height from which the stake can be redelegated again
+	BlockMatured int64 `protobuf:"varint,7,opt,name=block_matured,json=blockMatured,proto3" json:"block_matured,omitempty"`
 }
 
-func (m *StakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockRemovalCompleted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *Redelegation) Reset()         { *m = Redelegation{} }
+func (m *Redelegation) String() string { return proto.CompactTextString(m) }
+func (*Redelegation) ProtoMessage()    {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{9}
+}
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
+}
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
+}
+func (m *Redelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
+
+func (m *Redelegation) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *Redelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *Redelegation) GetSrcReputer() string {
+	if m != nil {
+		return m.SrcReputer
+	}
+	return ""
+}
+
+func (m *Redelegation) GetDstReputer() string {
+	if m != nil {
+		return m.DstReputer
+	}
+	return ""
+}
+
+func (m *Redelegation) GetBlockRedelegated() int64 {
+	if m != nil {
+		return m.BlockRedelegated
+	}
+	return 0
+}
+
+func (m *Redelegation) GetBlockMatured() int64 {
+	if m != nil {
+		return m.BlockMatured
+	}
+	return 0
+}
+
+// Stake a delegator has delegated upon a reputer in a topic, along with the reward it has pending
+type DelegationPosition struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// stake delegated, including the stake queued for removal reported by pending_removal
+	Amount github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"amount"`
+	// reward earned by the delegation and not claimed yet, of which the integer part is paid out on claim
+	PendingReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=pending_reward,json=pendingReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"pending_reward"`
+	// removal of stake queued from the delegation, if any
+	PendingRemoval *DelegateStakeRemovalInfo `protobuf:"bytes,5,opt,name=pending_removal,json=pendingRemoval,proto3" json:"pending_removal,omitempty"`
+	// redelegations of stake to the reputer that have not matured yet, one per reputer the stake was redelegated from
+	Redelegations []*Redelegation `protobuf:"bytes,6,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+}
+
+func (m *DelegationPosition) Reset()         { *m = DelegationPosition{} }
+func (m *DelegationPosition) String() string { return proto.CompactTextString(m) }
+func (*DelegationPosition) ProtoMessage()    {}
+func (*DelegationPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{10}
+}
+func (m *DelegationPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPosition.Merge(m, src)
+}
+func (m *DelegationPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationPosition proto.InternalMessageInfo
+
+func (m *DelegationPosition) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *DelegationPosition) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *DelegationPosition) GetPendingRemoval() *DelegateStakeRemovalInfo {
+	if m != nil {
+		return m.PendingRemoval
+	}
+	return nil
+}
+
+func (m *DelegationPosition) GetRedelegations() []*Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+// Pool of delegated stake upon a reputer in a topic tokenized into transferable receipts.
+// Receipts are minted as the bank denom `delegatestake/{id}` and are shares of the stake the pool
+// delegates along with its reserve, so the rewards of the stake accrue to every receipt holder
+type DelegateStakeReceiptPool struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// receipts in circulation, equal to the bank supply of the receipt denom
+	Shares cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=shares,proto3,customtype=cosmossdk.io/math.Int" json:"shares"`
+	// coins owed to the pool outside of its delegation, e.g. rewards paid out when its delegation
+	// changes or stake returned when its topic is archived
+	Reserve cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=reserve,proto3,customtype=cosmossdk.io/math.Int" json:"reserve"`
+}
+
+func (m *DelegateStakeReceiptPool) Reset()         { *m = DelegateStakeReceiptPool{} }
+func (m *DelegateStakeReceiptPool) String() string { return proto.CompactTextString(m) }
+func (*DelegateStakeReceiptPool) ProtoMessage()    {}
+func (*DelegateStakeReceiptPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdee6632fec3e740, []int{11}
+}
+func (m *DelegateStakeReceiptPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateStakeReceiptPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateStakeReceiptPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateStakeReceiptPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateStakeReceiptPool.Merge(m, src)
+}
+func (m *DelegateStakeReceiptPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateStakeReceiptPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateStakeReceiptPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateStakeReceiptPool proto.InternalMessageInfo
+
+func (m *DelegateStakeReceiptPool) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DelegateStakeReceiptPool) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *DelegateStakeReceiptPool) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*StakePlacement)(nil), "emissions.v3.StakePlacement")
+	proto.RegisterType((*DelegateStakePlacement)(nil), "emissions.v3.DelegateStakePlacement")
+	proto.RegisterType((*StakeInfo)(nil), "emissions.v3.StakeInfo")
+	proto.RegisterType((*StakeRemovalInfo)(nil), "emissions.v3.StakeRemovalInfo")
+	proto.RegisterType((*DelegateStakeRemovalInfo)(nil), "emissions.v3.DelegateStakeRemovalInfo")
+	proto.RegisterType((*DelegatorInfo)(nil), "emissions.v3.DelegatorInfo")
+	proto.RegisterType((*WorkerBondRemovalInfo)(nil), "emissions.v3.WorkerBondRemovalInfo")
+	proto.RegisterType((*ReputerSlash)(nil), "emissions.v3.ReputerSlash")
+	proto.RegisterType((*ReputerCommission)(nil), "emissions.v3.ReputerCommission")
+	proto.RegisterType((*Redelegation)(nil), "emissions.v3.Redelegation")
+	proto.RegisterType((*DelegationPosition)(nil), "emissions.v3.DelegationPosition")
+	proto.RegisterType((*DelegateStakeReceiptPool)(nil), "emissions.v3.DelegateStakeReceiptPool")
+}
+
+func init() { proto.RegisterFile("emissions/v3/stake.proto", fileDescriptor_cdee6632fec3e740) }
+
+var fileDescriptor_cdee6632fec3e740 = []byte{
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6b, 0x24, 0x45,
+	0x14, 0x4e, 0xf5, 0x24, 0x93, 0xe4, 0x4d, 0x26, 0xbb, 0xa9, 0xec, 0xc4, 0xde, 0x20, 0x93, 0x38,
+	0x82, 0x04, 0x65, 0x67, 0x24, 0x01, 0xf5, 0x28, 0xc9, 0x1c, 0x36, 0x8a, 0x24, 0xf4, 0x1c, 0x14,
+	0x0f, 0x36, 0x35, 0x5d, 0xb5, 0x33, 0xcd, 0x74, 0x77, 0x0d, 0x55, 0x35, 0x49, 0xbc, 0x79, 0x55,
+	0x10, 0xfc, 0x1f, 0x44, 0xf0, 0xe8, 0xc1, 0x93, 0x57, 0x2f, 0x7b, 0x5c, 0x3c, 0x89, 0x87, 0x45,
+	0x92, 0x83, 0xf8, 0x07, 0x78, 0x11, 0x04, 0xe9, 0xaa, 0x9a, 0x9e, 0xe9, 0x2c, 0x59, 0xa5, 0x1b,
+	0x8d, 0x5e, 0x42, 0xea, 0xbd, 0xea, 0xef, 0x7d, 0xef, 0x47, 0x7d, 0x55, 0x03, 0x2e, 0x8b, 0x43,
+	0x29, 0x43, 0x9e, 0xc8, 0xce, 0xd9, 0x41, 0x47, 0x2a, 0x32, 0x62, 0xed, 0xb1, 0xe0, 0x8a, 0xe3,
+	0xb5, 0xcc, 0xd3, 0x3e, 0x3b, 0xd8, 0xde, 0x20, 0x71, 0x98, 0xf0, 0x8e, 0xfe, 0x6b, 0x36, 0x6c,
+	0xdf, 0x0f, 0xb8, 0x8c, 0xb9, 0xf4, 0xf5, 0xaa, 0x63, 0x16, 0xd6, 0x75, 0x6f, 0xc0, 0x07, 0xdc,
+	0xd8, 0xd3, 0xff, 0x8c, 0xb5, 0xf5, 0x3d, 0x82, 0xf5, 0x5e, 0x1a, 0xe1, 0x34, 0x22, 0x01, 0x8b,
+	0x59, 0xa2, 0xf0, 0x3e, 0x34, 0xfa, 0x11, 0x0f, 0x46, 0xbe, 0x60, 0x31, 0x3f, 0x23, 0x91, 0x2f,
+	0x15, 0x11, 0x8a, 0x51, 0x17, 0xed, 0xa2, 0xbd, 0x8a, 0xb7, 0xa9, 0x9d, 0x9e, 0xf1, 0xf5, 0x8c,
+	0x0b, 0xdf, 0x87, 0x15, 0xc5, 0xc7, 0x61, 0xe0, 0x87, 0xd4, 0x75, 0x76, 0xd1, 0xde, 0xa2, 0xb7,
+	0xac, 0xd7, 0xc7, 0x14, 0xbb, 0xb0, 0x2c, 0xd8, 0x78, 0xa2, 0x98, 0x70, 0x2b, 0xbb, 0x68, 0x6f,
+	0xd5, 0x9b, 0x2e, 0xf1, 0x43, 0xa8, 0x92, 0x98, 0x4f, 0x12, 0xe5, 0x2e, 0xa6, 0x8e, 0xc3, 0xd7,
+	0x1f, 0x3f, 0xdd, 0x59, 0xf8, 0xe9, 0xe9, 0x4e, 0xc3, 0xf0, 0x96, 0x74, 0xd4, 0x0e, 0x79, 0x27,
+	0x26, 0x6a, 0xd8, 0x3e, 0x4e, 0xd4, 0x0f, 0xdf, 0x3e, 0x00, 0x9b, 0xd0, 0x71, 0xa2, 0xbe, 0xfe,
+	0xe5, 0x9b, 0x57, 0x91, 0x67, 0xbf, 0x6f, 0xfd, 0x8a, 0x60, 0xab, 0xcb, 0x22, 0x36, 0x20, 0x8a,
+	0xdd, 0x56, 0x36, 0x2f, 0xc2, 0x2a, 0x35, 0x14, 0xb8, 0x30, 0x09, 0x79, 0x33, 0xc3, 0x5c, 0xae,
+	0x4b, 0x25, 0x73, 0xfd, 0x0c, 0xc1, 0xaa, 0xce, 0xf1, 0x38, 0x79, 0xc4, 0x73, 0x54, 0xd1, 0x8d,
+	0x54, 0x9d, 0x9b, 0x0a, 0x5f, 0x29, 0x49, 0xe6, 0x0f, 0x04, 0x77, 0x35, 0x19, 0x5b, 0x41, 0xcd,
+	0xe9, 0xff, 0x37, 0x40, 0xf8, 0x0d, 0x78, 0x21, 0x4f, 0x39, 0xe0, 0xf1, 0x38, 0x62, 0x29, 0xe9,
+	0x25, 0x4d, 0xba, 0x31, 0x4f, 0xfa, 0x68, 0xea, 0x6c, 0x7d, 0xe9, 0x80, 0x9b, 0x1b, 0xbc, 0x5b,
+	0xa9, 0xc3, 0xbf, 0x34, 0x7a, 0xcf, 0xab, 0x52, 0xf5, 0x79, 0x55, 0xfa, 0x0e, 0x41, 0xbd, 0x3b,
+	0xe5, 0xa3, 0x4b, 0x73, 0x92, 0x71, 0x42, 0x9a, 0xd3, 0x9b, 0x96, 0x53, 0x67, 0x10, 0xaa, 0xe1,
+	0xa4, 0xdf, 0x0e, 0x78, 0xdc, 0x21, 0x51, 0xc4, 0x05, 0x79, 0x90, 0x30, 0x75, 0xce, 0xc5, 0x68,
+	0xba, 0x0c, 0x86, 0x24, 0x4c, 0x0c, 0xdb, 0x2e, 0x0b, 0x32, 0x6a, 0x1f, 0x40, 0x4d, 0xb0, 0x73,
+	0x22, 0xa8, 0x4f, 0x59, 0x5f, 0xb9, 0x4e, 0x39, 0x54, 0x30, 0x58, 0x5d, 0xd6, 0x57, 0xad, 0x4f,
+	0x1c, 0x68, 0xbc, 0xcf, 0xc5, 0x88, 0x89, 0x43, 0x9e, 0xd0, 0x7f, 0xb0, 0xbf, 0x5b, 0x50, 0x3d,
+	0xd7, 0x71, 0x6c, 0x7b, 0xed, 0xea, 0x3f, 0x30, 0xe5, 0x9f, 0x56, 0x60, 0xcd, 0x33, 0xb3, 0xd6,
+	0x8b, 0x88, 0x1c, 0x16, 0x53, 0x9d, 0x97, 0x60, 0xcd, 0x44, 0x1f, 0xb2, 0x70, 0x30, 0x34, 0xda,
+	0x53, 0xf1, 0x6a, 0xda, 0xf6, 0x50, 0x9b, 0x70, 0x0f, 0x56, 0x68, 0x28, 0x15, 0x49, 0x02, 0xe6,
+	0x2e, 0x96, 0x6b, 0x61, 0x06, 0x84, 0x29, 0x34, 0x2c, 0x05, 0x5f, 0xdf, 0xa5, 0xbe, 0x4c, 0x73,
+	0xb0, 0x39, 0x17, 0x29, 0xe7, 0xa6, 0x85, 0xd3, 0x07, 0xbe, 0x67, 0xc0, 0xf0, 0x23, 0xd8, 0xb2,
+	0x47, 0x8e, 0x5d, 0x0b, 0x53, 0x2d, 0x18, 0xe6, 0x1e, 0x9d, 0x17, 0x16, 0x1b, 0xa7, 0xf5, 0xbb,
+	0x03, 0x1b, 0xb6, 0x17, 0x47, 0x3c, 0xb6, 0xcf, 0x81, 0x62, 0x0d, 0x79, 0x17, 0x16, 0x05, 0x51,
+	0xcc, 0xad, 0x94, 0xab, 0xb4, 0x06, 0xc1, 0x1e, 0xac, 0xc4, 0xe4, 0xc2, 0xd7, 0x80, 0x25, 0x5b,
+	0xb7, 0x1c, 0x93, 0x0b, 0x2f, 0xc5, 0xf4, 0xe1, 0x4e, 0x8a, 0x19, 0x0c, 0x49, 0x32, 0x60, 0x06,
+	0x7a, 0xa9, 0x1c, 0x74, 0x3d, 0x26, 0x17, 0x47, 0x1a, 0x4e, 0x07, 0x68, 0xc3, 0xe6, 0x64, 0x4c,
+	0xd3, 0x96, 0xe5, 0x26, 0xd3, 0x88, 0xd9, 0x86, 0x71, 0x1d, 0xce, 0xe6, 0xb3, 0xf5, 0x95, 0x93,
+	0x1e, 0x04, 0xdb, 0x97, 0xbf, 0xa8, 0x7b, 0x4e, 0x94, 0x9d, 0xeb, 0xa2, 0xbc, 0x03, 0x35, 0x29,
+	0x02, 0x3f, 0x2f, 0xe8, 0x20, 0x45, 0x60, 0x7b, 0x9b, 0x6e, 0xa0, 0x52, 0x65, 0x1b, 0x8c, 0xaa,
+	0x03, 0x95, 0xca, 0x7b, 0xe6, 0xf2, 0x2b, 0x2b, 0xeb, 0xaf, 0xc1, 0xc6, 0x54, 0x16, 0xa6, 0x23,
+	0x37, 0x15, 0xf4, 0xbb, 0x56, 0x10, 0x32, 0x3b, 0x7e, 0x19, 0xea, 0x66, 0x73, 0x4c, 0xd4, 0x44,
+	0x30, 0xea, 0x2e, 0xeb, 0x8d, 0xe6, 0x68, 0xbf, 0x67, 0x6c, 0xad, 0xcf, 0x2b, 0x80, 0xbb, 0x59,
+	0x95, 0x4e, 0xb9, 0x0c, 0x55, 0xe1, 0x29, 0x3d, 0xb9, 0xf6, 0x58, 0x29, 0x7d, 0x55, 0x7c, 0x04,
+	0xeb, 0x63, 0x96, 0xd0, 0x30, 0x19, 0xf8, 0x46, 0xe6, 0xcb, 0xce, 0x6b, 0xdd, 0xc2, 0x79, 0x1a,
+	0x0d, 0x9f, 0xc0, 0x9d, 0x19, 0xbe, 0x56, 0x52, 0xdd, 0xa1, 0xda, 0xfe, 0x2b, 0xed, 0xf9, 0xe7,
+	0x7b, 0xfb, 0xa6, 0x77, 0x83, 0xb7, 0x9e, 0xe1, 0x69, 0x1b, 0x7e, 0x1b, 0xea, 0x62, 0x6e, 0xe8,
+	0xa4, 0x5b, 0xdd, 0xad, 0xec, 0xd5, 0xf6, 0xb7, 0xf3, 0x70, 0xf3, 0x73, 0xe9, 0xe5, 0x3f, 0x68,
+	0xfd, 0x86, 0x9e, 0x79, 0xa6, 0x04, 0x2c, 0x1c, 0xab, 0x53, 0xce, 0x23, 0xbc, 0x0e, 0x4e, 0xd6,
+	0x0f, 0x27, 0x2c, 0xfe, 0x14, 0x93, 0x43, 0x22, 0x98, 0x2c, 0x7e, 0x49, 0x99, 0xef, 0xf1, 0x3b,
+	0x69, 0x0c, 0xc9, 0xc4, 0x19, 0x2b, 0x3c, 0xd8, 0x53, 0x80, 0x43, 0xef, 0xf1, 0x65, 0x13, 0x3d,
+	0xb9, 0x6c, 0xa2, 0x9f, 0x2f, 0x9b, 0xe8, 0x8b, 0xab, 0xe6, 0xc2, 0x93, 0xab, 0xe6, 0xc2, 0x8f,
+	0x57, 0xcd, 0x85, 0x0f, 0xdf, 0xfa, 0x9b, 0x4d, 0xbe, 0xe8, 0xcc, 0x7e, 0x8c, 0xa9, 0x8f, 0xc7,
+	0x4c, 0xf6, 0xab, 0xfa, 0x87, 0xd3, 0xc1, 0x9f, 0x03, 0x00, 0x46, 0xb6, 0xad, 0xdc, 0xa6, 0x0d,
+	0x00, 0x00,
+}
+
+func (m *StakePlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakePlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x22
 	if len(m.Reputer) > 0 {
 		i -= len(m.Reputer)
@@ -656,7 +975,7 @@ func (m *StakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *DelegateStakePlacement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -666,21 +985,16 @@ func (m *DelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegateStakePlacement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegateStakePlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalCompleted != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
-		i--
-		dAtA[i] = 0x30
-	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -718,7 +1032,7 @@ func (m *DelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
+func (m *StakeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -728,26 +1042,16 @@ func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *StakeInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StakeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.RewardDebt.Size()
-		i -= size
-		if _, err := m.RewardDebt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -757,157 +1061,1864 @@ func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStake(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+
+func (m *StakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateStakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	if m.BlockRemovalCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StakeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.BlockRemovalCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	{
+		size := m.RewardDebt.Size()
+		i -= size
+		if _, err := m.RewardDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerBondRemovalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *WorkerBondRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerBondRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.BlockRemovalCompleted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalCompleted))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRemovalStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRemovalStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegateStakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReputerSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	{
+		size := m.DelegateStakeSlashed.Size()
+		i -= size
+		if _, err := m.DelegateStakeSlashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ReputerStakeSlashed.Size()
+		i -= size
+		if _, err := m.ReputerStakeSlashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Distance.Size()
+		i -= size
+		if _, err := m.Distance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	if m.BlockRemovalCompleted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReputerCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputerCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputerCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateBlockHeight != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.UpdateBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMatured != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockMatured))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockRedelegated != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRedelegated))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DstReputer) > 0 {
+		i -= len(m.DstReputer)
+		copy(dAtA[i:], m.DstReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.DstReputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcReputer) > 0 {
+		i -= len(m.SrcReputer)
+		copy(dAtA[i:], m.SrcReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.SrcReputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PendingRemoval != nil {
+		{
+			size, err := m.PendingRemoval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStake(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.PendingReward.Size()
+		i -= size
+		if _, err := m.PendingReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateStakeReceiptPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakeReceiptPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakeReceiptPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *DelegateStakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *StakeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *StakeRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	}
+	return n
+}
+
+func (m *DelegateStakeRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	}
+	return n
+}
+
+func (m *DelegatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.RewardDebt.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *WorkerBondRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRemovalCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalCompleted))
+	}
+	return n
+}
+
+func (m *ReputerSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovStake(uint64(m.BlockHeight))
+	}
+	l = m.Distance.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.ReputerStakeSlashed.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.DelegateStakeSlashed.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *ReputerCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.UpdateBlockHeight != 0 {
+		n += 1 + sovStake(uint64(m.UpdateBlockHeight))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.SrcReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.DstReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRedelegated != 0 {
+		n += 1 + sovStake(uint64(m.BlockRedelegated))
+	}
+	if m.BlockMatured != 0 {
+		n += 1 + sovStake(uint64(m.BlockMatured))
+	}
+	return n
+}
+
+func (m *DelegationPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.PendingReward.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.PendingRemoval != nil {
+		l = m.PendingRemoval.Size()
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovStake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegateStakeReceiptPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStake(uint64(m.Id))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.Reserve.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func sovStake(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStake(x uint64) (n int) {
+	return sovStake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StakePlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakePlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakePlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeRemovalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakeRemovalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *DelegatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	l = m.RewardDebt.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
-}
-
-func sovStake(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStake(x uint64) (n int) {
-	return sovStake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StakePlacement) Unmarshal(dAtA []byte) error {
+func (m *WorkerBondRemovalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,10 +2941,10 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakePlacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerBondRemovalInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerBondRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -976,7 +2987,7 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1004,7 +3015,7 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -1040,6 +3051,25 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -1061,7 +3091,7 @@ func (m *StakePlacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
+func (m *ReputerSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1084,17 +3114,17 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakePlacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakePlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockRemovalStarted = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1104,16 +3134,16 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1123,14 +3153,46 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1158,11 +3220,13 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.Distance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerStakeSlashed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1190,11 +3254,13 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			if err := m.ReputerStakeSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeSlashed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,7 +3288,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegateStakeSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1247,7 +3313,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeInfo) Unmarshal(dAtA []byte) error {
+func (m *ReputerCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,10 +3336,10 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1295,9 +3361,75 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,11 +3457,13 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1357,10 +3491,29 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateBlockHeight", wireType)
+			}
+			m.UpdateBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -1382,7 +3535,7 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,17 +3558,17 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockRemovalStarted = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1425,16 +3578,16 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1444,14 +3597,27 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1479,9 +3645,41 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.SrcReputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1515,11 +3713,11 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegated", wireType)
 			}
-			m.BlockRemovalCompleted = 0
+			m.BlockRedelegated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1529,7 +3727,26 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				m.BlockRedelegated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMatured", wireType)
+			}
+			m.BlockMatured = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMatured |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1555,7 +3772,7 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegationPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,17 +3795,17 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockRemovalStarted = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1598,16 +3815,16 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1617,14 +3834,27 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1652,11 +3882,13 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1684,13 +3916,15 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			if err := m.PendingReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRemoval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1700,31 +3934,33 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingRemoval == nil {
+				m.PendingRemoval = &DelegateStakeRemovalInfo{}
+			}
+			if err := m.PendingRemoval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
-			m.BlockRemovalCompleted = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1734,11 +3970,26 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, &Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -1760,7 +4011,7 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegateStakeReceiptPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,15 +4034,53 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateStakeReceiptPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateStakeReceiptPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1819,13 +4108,45 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1853,7 +4174,7 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4,8 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -44,6 +47,39 @@ type Topic struct {
 	ActiveInfererQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,19,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
 	ActiveForecasterQuantile github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,20,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
 	ActiveReputerQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,21,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	// structured description of the topic, if any, indexed to filter topics
+	TypedMetadata *TopicMetadata `protobuf:"bytes,22,opt,name=typed_metadata,json=typedMetadata,proto3" json:"typed_metadata,omitempty"`
+	// reputers report the ground truth and the chain computes the losses with
+	// the loss method of the topic, which must then be a registered one
+	ChainComputedLosses bool `protobuf:"varint,23,opt,name=chain_computed_losses,json=chainComputedLosses,proto3" json:"chain_computed_losses,omitempty"`
+	// number of values of each inference, 0 and 1 both standing for a single
+	// value
+	OutputDimension uint64 `protobuf:"varint,24,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	// levels, strictly increasing in (0, 1), of the quantiles making up the
+	// inferences of a probabilistic topic, one per output
+	QuantileLevels []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,25,rep,name=quantile_levels,json=quantileLevels,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"quantile_levels"`
+	// ground truth of a derived topic, taken from the network inferences of
+	// another topic, in which case the chain computes the losses of the topic
+	// and reputers do not submit any
+	GroundTruthSource *GroundTruthSource `protobuf:"bytes,26,opt,name=ground_truth_source,json=groundTruthSource,proto3" json:"ground_truth_source,omitempty"`
+	// share of the rewards of the topic paid to its creator, in [0, 1), of which
+	// at most the max_topic_creator_commission param is paid out
+	CreatorCommission github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,27,opt,name=creator_commission,json=creatorCommission,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"creator_commission"`
+	// number of blocks following the worker submission window during which
+	// workers reveal the payloads they committed to in the submission window,
+	// 0 for workers to submit their payloads in plaintext
+	WorkerRevealWindow int64 `protobuf:"varint,28,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	// number of blocks following the reputer submission window during which
+	// reputers reveal the loss bundles they committed to in the submission
+	// window, 0 for reputers to submit their loss bundles in plaintext
+	ReputerRevealWindow int64 `protobuf:"varint,29,opt,name=reputer_reveal_window,json=reputerRevealWindow,proto3" json:"reputer_reveal_window,omitempty"`
+	// bond workers must hold in the topic for their payloads to be accepted,
+	// 0 for workers to need no bond
+	MinWorkerBond cosmossdk_io_math.Int `protobuf:"bytes,30,opt,name=min_worker_bond,json=minWorkerBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_worker_bond"`
+	// distance of the losses reported by a reputer to the consensus losses,
+	// relative to the norm of the consensus losses, beyond which the reputer is
+	// an outlier of the epoch, 0 for reputers never to be slashed as outliers
+	ReputerOutlierThreshold github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,31,opt,name=reputer_outlier_threshold,json=reputerOutlierThreshold,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_outlier_threshold"`
 }
 
 func (m *Topic) Reset()         { *m = Topic{} }
@@ -142,6 +178,352 @@ func (m *Topic) GetWorkerSubmissionWindow() int64 {
 	return 0
 }
 
+func (m *Topic) GetTypedMetadata() *TopicMetadata {
+	if m != nil {
+		return m.TypedMetadata
+	}
+	return nil
+}
+
+func (m *Topic) GetChainComputedLosses() bool {
+	if m != nil {
+		return m.ChainComputedLosses
+	}
+	return false
+}
+
+func (m *Topic) GetOutputDimension() uint64 {
+	if m != nil {
+		return m.OutputDimension
+	}
+	return 0
+}
+
+func (m *Topic) GetGroundTruthSource() *GroundTruthSource {
+	if m != nil {
+		return m.GroundTruthSource
+	}
+	return nil
+}
+
+func (m *Topic) GetWorkerRevealWindow() int64 {
+	if m != nil {
+		return m.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (m *Topic) GetReputerRevealWindow() int64 {
+	if m != nil {
+		return m.ReputerRevealWindow
+	}
+	return 0
+}
+
+// Network inferences of a parent topic from which the ground truth of a
+// derived topic is computed. With y(b) the combined network inference of the
+// parent at its latest inference nonce at or before block b, the ground truth
+// of the derived inferences at nonce n is, depending on the aggregation:
+// "value_at_block": y(n + ground truth lag of the derived topic)
+// "difference": y(n + ground truth lag) - y(n)
+// "ratio": y(n + ground truth lag) / y(n)
+type GroundTruthSource struct {
+	ParentTopicId uint64 `protobuf:"varint,1,opt,name=parent_topic_id,json=parentTopicId,proto3" json:"parent_topic_id,omitempty"`
+	Aggregation   string `protobuf:"bytes,2,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+}
+
+func (m *GroundTruthSource) Reset()         { *m = GroundTruthSource{} }
+func (m *GroundTruthSource) String() string { return proto.CompactTextString(m) }
+func (*GroundTruthSource) ProtoMessage()    {}
+func (*GroundTruthSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{1}
+}
+func (m *GroundTruthSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroundTruthSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroundTruthSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroundTruthSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroundTruthSource.Merge(m, src)
+}
+func (m *GroundTruthSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroundTruthSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroundTruthSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroundTruthSource proto.InternalMessageInfo
+
+func (m *GroundTruthSource) GetParentTopicId() uint64 {
+	if m != nil {
+		return m.ParentTopicId
+	}
+	return 0
+}
+
+func (m *GroundTruthSource) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+// Structured description of what a topic predicts. Every field is optional.
+// Tags and category are lowercase labels used to look topics up.
+type TopicMetadata struct {
+	// asset or series being predicted, e.g. "ETH/USD"
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// unit of the predicted values, e.g. "USD"
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// how far ahead the predictions look, in seconds
+	PredictionHorizonSeconds int64    `protobuf:"varint,3,opt,name=prediction_horizon_seconds,json=predictionHorizonSeconds,proto3" json:"prediction_horizon_seconds,omitempty"`
+	Tags                     []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category                 string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (m *TopicMetadata) Reset()         { *m = TopicMetadata{} }
+func (m *TopicMetadata) String() string { return proto.CompactTextString(m) }
+func (*TopicMetadata) ProtoMessage()    {}
+func (*TopicMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{2}
+}
+func (m *TopicMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicMetadata.Merge(m, src)
+}
+func (m *TopicMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicMetadata proto.InternalMessageInfo
+
+func (m *TopicMetadata) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *TopicMetadata) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *TopicMetadata) GetPredictionHorizonSeconds() int64 {
+	if m != nil {
+		return m.PredictionHorizonSeconds
+	}
+	return 0
+}
+
+func (m *TopicMetadata) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *TopicMetadata) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+// State of a topic retired through MsgArchiveTopic. The topic is kept as it
+// was when archived so that it can still be queried once its state is pruned.
+type TopicArchive struct {
+	Topic               Topic `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+	ArchivedBlockHeight int64 `protobuf:"varint,2,opt,name=archived_block_height,json=archivedBlockHeight,proto3" json:"archived_block_height,omitempty"`
+	// stake removals queued before archival have all completed by this block
+	StakeRemovalsDueBlockHeight int64 `protobuf:"varint,3,opt,name=stake_removals_due_block_height,json=stakeRemovalsDueBlockHeight,proto3" json:"stake_removals_due_block_height,omitempty"`
+	// remaining stake has been returned to reputers and delegators
+	StakeReturned bool `protobuf:"varint,4,opt,name=stake_returned,json=stakeReturned,proto3" json:"stake_returned,omitempty"`
+	// all per-topic state has been pruned
+	Pruned bool `protobuf:"varint,5,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (m *TopicArchive) Reset()         { *m = TopicArchive{} }
+func (m *TopicArchive) String() string { return proto.CompactTextString(m) }
+func (*TopicArchive) ProtoMessage()    {}
+func (*TopicArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{3}
+}
+func (m *TopicArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicArchive.Merge(m, src)
+}
+func (m *TopicArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicArchive proto.InternalMessageInfo
+
+func (m *TopicArchive) GetTopic() Topic {
+	if m != nil {
+		return m.Topic
+	}
+	return Topic{}
+}
+
+func (m *TopicArchive) GetArchivedBlockHeight() int64 {
+	if m != nil {
+		return m.ArchivedBlockHeight
+	}
+	return 0
+}
+
+func (m *TopicArchive) GetStakeRemovalsDueBlockHeight() int64 {
+	if m != nil {
+		return m.StakeRemovalsDueBlockHeight
+	}
+	return 0
+}
+
+func (m *TopicArchive) GetStakeReturned() bool {
+	if m != nil {
+		return m.StakeReturned
+	}
+	return false
+}
+
+func (m *TopicArchive) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+// Recurring funding of a topic. The escrow is held by the module and
+// `amount_per_drip` of it is added to the fee revenue of the topic at every
+// drip, until the escrow runs out or the funder cancels the subscription.
+type FundingSubscription struct {
+	Id            uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TopicId       uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Funder        string                `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+	AmountPerDrip cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_per_drip,json=amountPerDrip,proto3,customtype=cosmossdk.io/math.Int" json:"amount_per_drip"`
+	// blocks between drips, 0 to drip once per epoch of the topic
+	IntervalBlocks int64 `protobuf:"varint,5,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// unspent escrow, refunded to the funder on cancellation
+	Escrow              cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=escrow,proto3,customtype=cosmossdk.io/math.Int" json:"escrow"`
+	NextDripBlockHeight int64                 `protobuf:"varint,7,opt,name=next_drip_block_height,json=nextDripBlockHeight,proto3" json:"next_drip_block_height,omitempty"`
+}
+
+func (m *FundingSubscription) Reset()         { *m = FundingSubscription{} }
+func (m *FundingSubscription) String() string { return proto.CompactTextString(m) }
+func (*FundingSubscription) ProtoMessage()    {}
+func (*FundingSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6320c39e204beb2b, []int{4}
+}
+func (m *FundingSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingSubscription.Merge(m, src)
+}
+func (m *FundingSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingSubscription proto.InternalMessageInfo
+
+func (m *FundingSubscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FundingSubscription) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *FundingSubscription) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *FundingSubscription) GetIntervalBlocks() int64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *FundingSubscription) GetNextDripBlockHeight() int64 {
+	if m != nil {
+		return m.NextDripBlockHeight
+	}
+	return 0
+}
+
 type TopicList struct {
 	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
@@ -150,7 +532,7 @@ func (m *TopicList) Reset()         { *m = TopicList{} }
 func (m *TopicList) String() string { return proto.CompactTextString(m) }
 func (*TopicList) ProtoMessage()    {}
 func (*TopicList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6320c39e204beb2b, []int{1}
+	return fileDescriptor_6320c39e204beb2b, []int{5}
 }
 func (m *TopicList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +577,7 @@ func (m *TimestampedActorNonce) Reset()         { *m = TimestampedActorNonce{} }
 func (m *TimestampedActorNonce) String() string { return proto.CompactTextString(m) }
 func (*TimestampedActorNonce) ProtoMessage()    {}
 func (*TimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6320c39e204beb2b, []int{2}
+	return fileDescriptor_6320c39e204beb2b, []int{6}
 }
 func (m *TimestampedActorNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +628,7 @@ func (m *TopicIds) Reset()         { *m = TopicIds{} }
 func (m *TopicIds) String() string { return proto.CompactTextString(m) }
 func (*TopicIds) ProtoMessage()    {}
 func (*TopicIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6320c39e204beb2b, []int{3}
+	return fileDescriptor_6320c39e204beb2b, []int{7}
 }
 func (m *TopicIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +673,7 @@ func (m *TopicIdWeightPair) Reset()         { *m = TopicIdWeightPair{} }
 func (m *TopicIdWeightPair) String() string { return proto.CompactTextString(m) }
 func (*TopicIdWeightPair) ProtoMessage()    {}
 func (*TopicIdWeightPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6320c39e204beb2b, []int{4}
+	return fileDescriptor_6320c39e204beb2b, []int{8}
 }
 func (m *TopicIdWeightPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,6 +711,10 @@ func (m *TopicIdWeightPair) GetTopicId() uint64 {
 
 func init() {
 	proto.RegisterType((*Topic)(nil), "emissions.v3.Topic")
+	proto.RegisterType((*GroundTruthSource)(nil), "emissions.v3.GroundTruthSource")
+	proto.RegisterType((*TopicMetadata)(nil), "emissions.v3.TopicMetadata")
+	proto.RegisterType((*TopicArchive)(nil), "emissions.v3.TopicArchive")
+	proto.RegisterType((*FundingSubscription)(nil), "emissions.v3.FundingSubscription")
 	proto.RegisterType((*TopicList)(nil), "emissions.v3.TopicList")
 	proto.RegisterType((*TimestampedActorNonce)(nil), "emissions.v3.TimestampedActorNonce")
 	proto.RegisterType((*TopicIds)(nil), "emissions.v3.TopicIds")
@@ -338,56 +724,95 @@ func init() {
 func init() { proto.RegisterFile("emissions/v3/topic.proto", fileDescriptor_6320c39e204beb2b) }
 
 var fileDescriptor_6320c39e204beb2b = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x8e, 0x1b, 0x35,
-	0x18, 0xc7, 0x77, 0x92, 0x49, 0x32, 0x71, 0xb2, 0xe9, 0xd4, 0xdb, 0x80, 0x09, 0x52, 0x12, 0x22,
-	0x21, 0x05, 0xa1, 0x26, 0x12, 0x7b, 0x60, 0xc5, 0xad, 0x15, 0x20, 0x1a, 0x2d, 0x81, 0x4e, 0x57,
-	0xaa, 0xd4, 0x03, 0x96, 0x33, 0xe3, 0x9d, 0xb1, 0x76, 0xc6, 0x1e, 0x6c, 0xcf, 0xa6, 0x9c, 0x78,
-	0x05, 0x1e, 0x81, 0x37, 0xe1, 0xda, 0x63, 0x8f, 0x88, 0x43, 0x85, 0x76, 0x2f, 0x3c, 0x06, 0xb2,
-	0x3d, 0xc9, 0xb6, 0xe2, 0x82, 0x94, 0xde, 0xc6, 0xff, 0xef, 0xf3, 0xef, 0xff, 0xd9, 0x33, 0xfa,
-	0x0f, 0x40, 0xb4, 0x60, 0x4a, 0x31, 0xc1, 0xd5, 0xf2, 0xfa, 0x74, 0xa9, 0x45, 0xc9, 0xe2, 0x45,
-	0x29, 0x85, 0x16, 0xb0, 0xbf, 0xaf, 0x2c, 0xae, 0x4f, 0x47, 0xef, 0xf6, 0x71, 0xc1, 0x63, 0xea,
-	0xfa, 0x46, 0x0f, 0x52, 0x91, 0x0a, 0xfb, 0xb8, 0x34, 0x4f, 0x4e, 0x9d, 0xfd, 0xd1, 0x05, 0xad,
-	0x0b, 0x43, 0x83, 0x03, 0xd0, 0x60, 0x09, 0xf2, 0xa6, 0xde, 0xdc, 0x8f, 0x1a, 0x2c, 0x81, 0x08,
-	0x74, 0x62, 0x49, 0x89, 0x16, 0x12, 0x35, 0xa6, 0xde, 0xbc, 0x1b, 0xed, 0x96, 0x70, 0x04, 0x82,
-	0x82, 0x6a, 0x92, 0x10, 0x4d, 0x50, 0xd3, 0x96, 0xf6, 0x6b, 0x38, 0x01, 0xbd, 0x5c, 0x28, 0x85,
-	0x0b, 0xaa, 0x33, 0x91, 0xa0, 0x96, 0x2d, 0x03, 0x23, 0x7d, 0x6f, 0x15, 0x38, 0x07, 0x21, 0x2d,
-	0x45, 0x9c, 0xe1, 0x9c, 0x28, 0x8d, 0x29, 0x4f, 0x68, 0x82, 0x82, 0xa9, 0x37, 0x6f, 0x46, 0x03,
-	0xab, 0x9f, 0x13, 0xa5, 0xbf, 0x31, 0x2a, 0xfc, 0x04, 0xf4, 0xeb, 0x4e, 0xca, 0x53, 0x9d, 0xa1,
-	0xae, 0xed, 0xea, 0xb9, 0x2e, 0x2b, 0x19, 0x58, 0x2a, 0x45, 0xc5, 0x13, 0xac, 0x65, 0xa5, 0x0d,
-	0x33, 0x45, 0xc0, 0xc1, 0x9c, 0x7e, 0x61, 0xe4, 0x73, 0x92, 0xc2, 0x35, 0x68, 0x97, 0x98, 0x0b,
-	0x59, 0xa0, 0xbe, 0x19, 0xe9, 0xf1, 0x97, 0xaf, 0xde, 0x4c, 0x8e, 0xfe, 0x7a, 0x33, 0x59, 0xa6,
-	0x4c, 0x67, 0xd5, 0x66, 0x11, 0x8b, 0x62, 0x49, 0xf2, 0x5c, 0x48, 0xf2, 0x90, 0x53, 0xbd, 0x15,
-	0xf2, 0x6a, 0xb7, 0x8c, 0x33, 0xc2, 0xf8, 0xb2, 0x20, 0x3a, 0x5b, 0x7c, 0x4d, 0xe3, 0xa8, 0x55,
-	0xae, 0x85, 0x2c, 0xe0, 0x0b, 0xd0, 0x27, 0x79, 0x99, 0x11, 0x2c, 0x69, 0x2a, 0xa9, 0x46, 0xc7,
-	0x87, 0x51, 0x7b, 0x16, 0x16, 0x59, 0x16, 0xfc, 0x14, 0x0c, 0x4c, 0xd7, 0x16, 0x73, 0x9a, 0x12,
-	0xcd, 0xae, 0x29, 0x1a, 0x4c, 0xbd, 0x79, 0x10, 0x1d, 0x5b, 0x75, 0x5d, 0x8b, 0xf0, 0x29, 0xe8,
-	0xd0, 0x52, 0xb1, 0x5c, 0x70, 0x74, 0xef, 0x30, 0xf7, 0x1d, 0x07, 0xfe, 0x04, 0x06, 0x8c, 0x33,
-	0xcd, 0x48, 0xbe, 0x3b, 0x57, 0x78, 0x18, 0xf9, 0xb8, 0xc6, 0xd5, 0x27, 0x3b, 0x03, 0xc8, 0x34,
-	0x53, 0x89, 0x55, 0xb5, 0xa9, 0x3f, 0x54, 0xbc, 0x65, 0x3c, 0x11, 0x5b, 0x74, 0xdf, 0xbe, 0xb7,
-	0x0f, 0x5c, 0xfd, 0xd9, 0xbe, 0xfc, 0xdc, 0x56, 0xe1, 0x15, 0x18, 0x16, 0x54, 0x32, 0x8d, 0x95,
-	0x90, 0x9a, 0x69, 0xb3, 0xcf, 0x5e, 0x19, 0x82, 0x87, 0x0d, 0x78, 0x62, 0xa9, 0xcf, 0x76, 0xd0,
-	0x47, 0x86, 0x09, 0x05, 0xf8, 0x90, 0xc4, 0xe6, 0x8e, 0x31, 0xe3, 0x97, 0x54, 0x52, 0x89, 0x7f,
-	0xae, 0x08, 0xd7, 0x2c, 0xa7, 0xe8, 0xe4, 0x30, 0xbb, 0xa1, 0xe3, 0x3e, 0x71, 0xd8, 0xa7, 0x35,
-	0x15, 0x56, 0x60, 0x54, 0x1b, 0x5e, 0x0a, 0x49, 0x63, 0xa2, 0xf4, 0xdb, 0x9e, 0x0f, 0x0e, 0xf3,
-	0x44, 0x0e, 0xfd, 0xed, 0x9e, 0xbc, 0xb7, 0xbd, 0x3b, 0xa7, 0xa4, 0x65, 0xf5, 0x8e, 0xe7, 0xf0,
-	0xbd, 0x9c, 0x33, 0x72, 0xd8, 0x9d, 0xe1, 0xca, 0x0f, 0xfc, 0xb0, 0xb5, 0xf2, 0x83, 0x76, 0xd8,
-	0x59, 0xf9, 0x41, 0x27, 0x0c, 0x56, 0x7e, 0xd0, 0x0b, 0xfb, 0x2e, 0x20, 0x70, 0x2e, 0x52, 0x16,
-	0x47, 0xf7, 0xdc, 0x9d, 0xf3, 0x98, 0xd6, 0x42, 0x78, 0x27, 0xb8, 0x54, 0x89, 0x7a, 0x09, 0xbd,
-	0x24, 0x55, 0xae, 0x31, 0x91, 0xe9, 0xec, 0x0c, 0x74, 0x6d, 0x80, 0x9d, 0x33, 0xa5, 0xe1, 0xe7,
-	0xa0, 0x6d, 0xb3, 0x51, 0x21, 0x6f, 0xda, 0x9c, 0xf7, 0xbe, 0x38, 0x59, 0xbc, 0x9d, 0x8e, 0x0b,
-	0xdb, 0x18, 0xd5, 0x2d, 0xb3, 0x97, 0x60, 0x78, 0xc1, 0x0a, 0xaa, 0x34, 0x29, 0x4a, 0x9a, 0x3c,
-	0x8a, 0xb5, 0x90, 0x6b, 0x13, 0x98, 0x26, 0x79, 0x36, 0xb9, 0x88, 0xaf, 0x70, 0x46, 0x59, 0x9a,
-	0x69, 0x1b, 0x8a, 0xcd, 0xa8, 0x67, 0xb5, 0xef, 0xac, 0x04, 0x3f, 0x03, 0x2d, 0x1b, 0xae, 0x36,
-	0x00, 0xff, 0xe3, 0x63, 0x31, 0x91, 0xeb, 0xf8, 0xca, 0xff, 0xe7, 0xf7, 0x89, 0xb7, 0xf2, 0x83,
-	0x46, 0xd8, 0x8c, 0x5a, 0xc4, 0xb8, 0xcc, 0x1e, 0x82, 0xc0, 0x8e, 0xf2, 0x24, 0x51, 0xf0, 0x63,
-	0xd0, 0xb5, 0xf3, 0x60, 0x96, 0xb8, 0xa9, 0xfd, 0x28, 0xd0, 0x75, 0xd1, 0xed, 0x9d, 0xfd, 0x0a,
-	0xee, 0xd7, 0xed, 0xcf, 0xad, 0xfb, 0x8f, 0x84, 0x49, 0xf8, 0x11, 0x08, 0x76, 0xfb, 0xea, 0xd4,
-	0xee, 0xd4, 0xdb, 0xe0, 0x0f, 0xa0, 0xbd, 0x75, 0x93, 0x37, 0x0e, 0x7b, 0x8d, 0x35, 0xe6, 0x71,
-	0xf4, 0xea, 0x66, 0xec, 0xbd, 0xbe, 0x19, 0x7b, 0x7f, 0xdf, 0x8c, 0xbd, 0xdf, 0x6e, 0xc7, 0x47,
-	0xaf, 0x6f, 0xc7, 0x47, 0x7f, 0xde, 0x8e, 0x8f, 0x5e, 0x9c, 0xfd, 0x4f, 0xe4, 0xcb, 0xe5, 0xdd,
-	0x8f, 0x49, 0xff, 0x52, 0x52, 0xb5, 0x69, 0xdb, 0x1f, 0xd0, 0xe9, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x0b, 0xb9, 0xfe, 0xda, 0x06, 0x00, 0x00,
+	// 1408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0x1c, 0x37,
+	0x12, 0x56, 0x6b, 0x7e, 0x34, 0xe2, 0x48, 0x33, 0x23, 0x8e, 0x25, 0x53, 0xd2, 0xae, 0x34, 0x3b,
+	0x80, 0x77, 0xe5, 0x5d, 0x58, 0x63, 0x48, 0x87, 0x35, 0x16, 0x7b, 0xb1, 0xac, 0xf5, 0xda, 0x82,
+	0x2c, 0xdb, 0x2d, 0x01, 0x0e, 0x0c, 0x24, 0x0c, 0xd5, 0x4d, 0xf5, 0x10, 0xea, 0x26, 0x3b, 0x24,
+	0x5b, 0xb2, 0x73, 0xc9, 0x2b, 0xe4, 0x11, 0x72, 0x4c, 0x6e, 0x41, 0x90, 0x87, 0xf0, 0xd1, 0xc8,
+	0x29, 0xf0, 0xc1, 0x08, 0xec, 0x43, 0xf2, 0x08, 0x39, 0x06, 0xfc, 0xe9, 0x91, 0x26, 0x4e, 0x80,
+	0xc0, 0x93, 0xcb, 0xa0, 0x59, 0x5f, 0xd5, 0x57, 0x64, 0xb1, 0xaa, 0x58, 0x03, 0x10, 0xcd, 0x98,
+	0x52, 0x4c, 0x70, 0x35, 0x38, 0xdb, 0x1e, 0x68, 0x91, 0xb3, 0x68, 0x33, 0x97, 0x42, 0x0b, 0x38,
+	0x37, 0x42, 0x36, 0xcf, 0xb6, 0x57, 0x16, 0x48, 0xc6, 0xb8, 0x18, 0xd8, 0x5f, 0xa7, 0xb0, 0xb2,
+	0x1c, 0x09, 0x95, 0x09, 0x85, 0xed, 0x6a, 0xe0, 0x16, 0x1e, 0x1a, 0x67, 0xe5, 0x82, 0x47, 0xd4,
+	0x23, 0x57, 0x12, 0x91, 0x08, 0x67, 0x61, 0xbe, 0x9c, 0xb4, 0xff, 0x4d, 0x1b, 0xd4, 0x8e, 0x8c,
+	0x6f, 0xd8, 0x02, 0xd3, 0x2c, 0x46, 0x41, 0x2f, 0xd8, 0xa8, 0x86, 0xd3, 0x2c, 0x86, 0x08, 0xcc,
+	0x44, 0x92, 0x12, 0x2d, 0x24, 0x9a, 0xee, 0x05, 0x1b, 0xb3, 0x61, 0xb9, 0x84, 0x2b, 0xa0, 0x91,
+	0x51, 0x4d, 0x62, 0xa2, 0x09, 0xaa, 0x58, 0x68, 0xb4, 0x86, 0xeb, 0xa0, 0x99, 0x0a, 0xa5, 0x70,
+	0x46, 0xf5, 0x50, 0xc4, 0xa8, 0x66, 0x61, 0x60, 0x44, 0x0f, 0xac, 0x04, 0x6e, 0x80, 0x0e, 0xcd,
+	0x45, 0x34, 0xc4, 0x29, 0x51, 0x1a, 0x53, 0x1e, 0xd3, 0x18, 0x35, 0x7a, 0xc1, 0x46, 0x25, 0x6c,
+	0x59, 0xf9, 0x3e, 0x51, 0xfa, 0x7f, 0x46, 0x0a, 0xff, 0x06, 0xe6, 0xbc, 0x26, 0xe5, 0x89, 0x1e,
+	0xa2, 0x59, 0xab, 0xd5, 0x74, 0x5a, 0x56, 0x64, 0xc8, 0x12, 0x29, 0x0a, 0x1e, 0x63, 0x2d, 0x0b,
+	0x6d, 0x38, 0x13, 0x04, 0x1c, 0x99, 0x93, 0x1f, 0x19, 0xf1, 0x3e, 0x49, 0xe0, 0x01, 0xa8, 0xe7,
+	0x98, 0x0b, 0x99, 0xa1, 0x39, 0xb3, 0xa5, 0x9d, 0x7f, 0xbf, 0x78, 0xbd, 0x3e, 0xf5, 0xea, 0xf5,
+	0xfa, 0x20, 0x61, 0x7a, 0x58, 0x1c, 0x6f, 0x46, 0x22, 0x1b, 0x90, 0x34, 0x15, 0x92, 0xdc, 0xe0,
+	0x54, 0x9f, 0x0b, 0x79, 0x5a, 0x2e, 0xa3, 0x21, 0x61, 0x7c, 0x90, 0x11, 0x3d, 0xdc, 0xdc, 0xa5,
+	0x51, 0x58, 0xcb, 0x0f, 0x84, 0xcc, 0xe0, 0x53, 0x30, 0x47, 0xd2, 0x7c, 0x48, 0xb0, 0xa4, 0x89,
+	0xa4, 0x1a, 0xcd, 0x4f, 0xc6, 0xda, 0xb4, 0x64, 0xa1, 0xe5, 0x82, 0xd7, 0x40, 0xcb, 0x68, 0x9d,
+	0x63, 0x4e, 0x13, 0xa2, 0xd9, 0x19, 0x45, 0xad, 0x5e, 0xb0, 0xd1, 0x08, 0xe7, 0xad, 0xf4, 0xc0,
+	0x0b, 0xe1, 0x63, 0x30, 0x43, 0x73, 0xc5, 0x52, 0xc1, 0x51, 0x7b, 0x32, 0xef, 0x25, 0x0f, 0xfc,
+	0x08, 0xb4, 0x18, 0x67, 0x9a, 0x91, 0xb4, 0x3c, 0x57, 0x67, 0x32, 0xe6, 0x79, 0x4f, 0xe7, 0x4f,
+	0x76, 0x0b, 0x20, 0xa3, 0x4c, 0x25, 0x56, 0xc5, 0xb1, 0x4f, 0x54, 0x7c, 0xce, 0x78, 0x2c, 0xce,
+	0xd1, 0x82, 0xbd, 0xb7, 0x25, 0x87, 0x1f, 0x8e, 0xe0, 0x27, 0x16, 0x85, 0xa7, 0x60, 0x31, 0xa3,
+	0x92, 0x69, 0xac, 0x84, 0xd4, 0x4c, 0x1b, 0x3b, 0x1b, 0x32, 0x04, 0x27, 0xdb, 0x60, 0xd7, 0xb2,
+	0x1e, 0x96, 0xa4, 0xb7, 0x0d, 0x27, 0x14, 0xe0, 0x2a, 0x89, 0x4c, 0x8c, 0x31, 0xe3, 0x27, 0x54,
+	0x52, 0x89, 0x3f, 0x29, 0x08, 0xd7, 0x2c, 0xa5, 0xa8, 0x3b, 0x99, 0xbb, 0x45, 0xc7, 0x7b, 0xdf,
+	0xd1, 0x3e, 0xf6, 0xac, 0xb0, 0x00, 0x2b, 0xde, 0xe1, 0x89, 0x90, 0x34, 0x22, 0x4a, 0x5f, 0xf6,
+	0x79, 0x65, 0x32, 0x9f, 0xc8, 0x51, 0xdf, 0x1d, 0x31, 0x8f, 0xdc, 0x5e, 0x9c, 0x53, 0xd2, 0xbc,
+	0x18, 0xf3, 0xb9, 0xf8, 0xa7, 0x9c, 0x33, 0x74, 0xb4, 0x23, 0x87, 0x3b, 0xa0, 0xa5, 0x9f, 0xe7,
+	0x34, 0xc6, 0xa3, 0xfe, 0xb1, 0xd4, 0x0b, 0x36, 0x9a, 0x5b, 0xab, 0x9b, 0x97, 0x5b, 0xde, 0xa6,
+	0x6d, 0x48, 0x0f, 0xbc, 0x4a, 0x38, 0x6f, 0x4d, 0xca, 0x25, 0xdc, 0x02, 0x8b, 0xd6, 0x19, 0x8e,
+	0x44, 0x66, 0xd8, 0x63, 0x6c, 0xba, 0x0b, 0x55, 0xe8, 0xaa, 0x2d, 0x92, 0xae, 0x05, 0xef, 0x78,
+	0x6c, 0xdf, 0x42, 0xf0, 0x3a, 0xe8, 0x88, 0x42, 0xe7, 0x85, 0xc6, 0x31, 0xcb, 0x28, 0x37, 0x8e,
+	0x10, 0xb2, 0x9d, 0xae, 0xed, 0xe4, 0xbb, 0xa5, 0x18, 0x7e, 0x0c, 0xda, 0x65, 0x10, 0x70, 0x4a,
+	0xcf, 0x68, 0xaa, 0xd0, 0x72, 0xaf, 0x32, 0x49, 0x2c, 0x5a, 0x25, 0xdf, 0xbe, 0xa5, 0x83, 0x0f,
+	0x41, 0x77, 0xac, 0x69, 0x29, 0x51, 0xc8, 0x88, 0xa2, 0x15, 0x1b, 0x89, 0xf5, 0xf1, 0x48, 0xfc,
+	0xff, 0xa2, 0x8b, 0x1d, 0x5a, 0xb5, 0x70, 0x21, 0xf9, 0xb5, 0x08, 0x9e, 0x00, 0xe8, 0x5b, 0xb3,
+	0x89, 0x89, 0x37, 0x47, 0xab, 0x93, 0xdd, 0xe0, 0x82, 0xa7, 0xbc, 0x33, 0x62, 0x84, 0x37, 0xc1,
+	0x15, 0x5f, 0xbd, 0x92, 0x9e, 0x51, 0x92, 0x96, 0x95, 0xfb, 0x17, 0x5b, 0xb9, 0xd0, 0x61, 0xa1,
+	0x85, 0x7c, 0xd5, 0x6e, 0x81, 0xc5, 0x32, 0xb3, 0xc6, 0x4d, 0xfe, 0x6a, 0x4d, 0xba, 0x1e, 0x1c,
+	0xb3, 0xf9, 0x00, 0xb4, 0x33, 0xc6, 0xb1, 0xf7, 0x74, 0x2c, 0x78, 0x8c, 0xd6, 0xec, 0x51, 0x6e,
+	0xfa, 0xa3, 0x2c, 0xba, 0x07, 0x4f, 0xc5, 0xa7, 0x9b, 0x4c, 0xb8, 0x0d, 0xdf, 0xe7, 0xfa, 0xbb,
+	0x6f, 0x6f, 0x00, 0x07, 0x98, 0xd5, 0x97, 0x3f, 0x7e, 0xfd, 0xcf, 0x20, 0x9c, 0xcf, 0x18, 0x7f,
+	0x62, 0x79, 0x76, 0x04, 0x8f, 0xa1, 0x02, 0xcb, 0xe5, 0x6e, 0x44, 0xa1, 0x53, 0x46, 0x25, 0xd6,
+	0x43, 0x49, 0xd5, 0x50, 0xa4, 0x31, 0x5a, 0x9f, 0x2c, 0x5c, 0x57, 0x3d, 0xf3, 0x43, 0x47, 0x7c,
+	0x54, 0xf2, 0xee, 0x55, 0x1b, 0xd5, 0x4e, 0x6d, 0xaf, 0xda, 0xa8, 0x77, 0x66, 0xf6, 0xaa, 0x8d,
+	0x99, 0x4e, 0x63, 0xaf, 0xda, 0x68, 0x76, 0xe6, 0xdc, 0x9b, 0x88, 0x53, 0x91, 0xb0, 0x28, 0x6c,
+	0xbb, 0x36, 0xc3, 0x23, 0xea, 0x05, 0x9d, 0x0b, 0x81, 0x7b, 0x48, 0xc3, 0x66, 0x4c, 0x4f, 0x48,
+	0x91, 0x6a, 0x4c, 0x64, 0xd2, 0xff, 0x10, 0x2c, 0xbc, 0x93, 0x18, 0xf0, 0xef, 0xa0, 0x9d, 0x13,
+	0x49, 0xb9, 0xc6, 0x76, 0x96, 0xc0, 0xa3, 0xc7, 0x7c, 0xde, 0x89, 0x6d, 0x51, 0xdd, 0x8f, 0x61,
+	0x0f, 0x34, 0x49, 0x92, 0x48, 0xfb, 0x8a, 0x08, 0xee, 0xdf, 0xf6, 0xcb, 0xa2, 0xfe, 0x57, 0x01,
+	0x98, 0x1f, 0x2b, 0x41, 0xb8, 0x04, 0xea, 0x9a, 0xc8, 0x84, 0x6a, 0x4b, 0x39, 0x1b, 0xfa, 0x15,
+	0x84, 0xa0, 0x5a, 0x70, 0xa6, 0x3d, 0x89, 0xfd, 0x86, 0xff, 0x05, 0x2b, 0xb9, 0xa4, 0x31, 0x8b,
+	0x6c, 0x93, 0x1e, 0x0a, 0xc9, 0x3e, 0x15, 0x1c, 0x2b, 0x1a, 0x09, 0x1e, 0x2b, 0x3b, 0x2f, 0x54,
+	0x42, 0x74, 0xa1, 0x71, 0xcf, 0x29, 0x1c, 0x3a, 0xdc, 0x30, 0x6a, 0x92, 0x28, 0x54, 0x35, 0x35,
+	0x17, 0xda, 0x6f, 0x33, 0x6f, 0x44, 0x44, 0xd3, 0x44, 0xc8, 0xe7, 0x7e, 0xa0, 0x18, 0xad, 0xfb,
+	0x3f, 0x07, 0x60, 0xce, 0xee, 0xf5, 0xb6, 0x8c, 0x86, 0xe6, 0x55, 0x1c, 0x80, 0x9a, 0x3d, 0xbf,
+	0xdd, 0x69, 0x73, 0xab, 0xfb, 0x1b, 0x9d, 0x65, 0xa7, 0x6a, 0x6e, 0x39, 0x74, 0x7a, 0x26, 0x47,
+	0x89, 0xb3, 0x8d, 0xf1, 0x71, 0x2a, 0xa2, 0x53, 0x3c, 0xa4, 0x2c, 0x19, 0xba, 0x43, 0x55, 0xc2,
+	0x6e, 0x09, 0xee, 0x18, 0xec, 0x9e, 0x85, 0xe0, 0x2e, 0x58, 0x57, 0x9a, 0x9c, 0x9a, 0xbe, 0x99,
+	0x89, 0x33, 0x92, 0x2a, 0x1c, 0x17, 0x74, 0xdc, 0xda, 0x1d, 0x74, 0xd5, 0xaa, 0x85, 0x5e, 0x6b,
+	0xb7, 0xa0, 0x97, 0x59, 0xae, 0x81, 0x56, 0xc9, 0xa2, 0x0b, 0xc9, 0x69, 0x8c, 0xaa, 0xee, 0x9d,
+	0xf7, 0x46, 0x4e, 0x68, 0x82, 0x9f, 0xcb, 0xc2, 0xc0, 0x35, 0x0b, 0xfb, 0x55, 0xff, 0xd5, 0x34,
+	0xe8, 0xde, 0x2d, 0x78, 0xcc, 0x78, 0x72, 0x58, 0x1c, 0xab, 0x48, 0xb2, 0xdc, 0x04, 0xf4, 0x9d,
+	0x41, 0x6e, 0x19, 0x34, 0x46, 0x19, 0x31, 0x6d, 0xa5, 0x33, 0xda, 0xe7, 0xc2, 0x12, 0xa8, 0x9f,
+	0x14, 0x3c, 0xa6, 0xd2, 0xcf, 0x71, 0x7e, 0x65, 0x6a, 0x90, 0x64, 0xa2, 0xe0, 0x1a, 0xe7, 0x54,
+	0xe2, 0x58, 0xb2, 0x1c, 0x55, 0xdf, 0xb7, 0x06, 0x1d, 0xd1, 0x23, 0x2a, 0x77, 0x25, 0xcb, 0xe1,
+	0x3f, 0x40, 0x9b, 0x71, 0x4d, 0xe5, 0x19, 0x49, 0x5d, 0xbc, 0x94, 0x3d, 0x55, 0x25, 0x6c, 0x95,
+	0x62, 0x1b, 0x21, 0x05, 0xef, 0x81, 0x3a, 0x55, 0x91, 0x14, 0xe7, 0xa8, 0xfe, 0x9e, 0x9e, 0xbd,
+	0x3d, 0xdc, 0x06, 0x4b, 0x9c, 0x3e, 0xd3, 0xf6, 0x18, 0xe3, 0x77, 0x34, 0xe3, 0x6e, 0xd8, 0xa0,
+	0x66, 0x73, 0x97, 0xee, 0xa6, 0x7f, 0x0b, 0xcc, 0xda, 0x5c, 0xd9, 0x67, 0x4a, 0xc3, 0x7f, 0x81,
+	0xba, 0x8d, 0x98, 0x42, 0x41, 0xaf, 0xf2, 0x3b, 0x49, 0x15, 0x7a, 0x95, 0xfe, 0x33, 0xb0, 0x78,
+	0xc4, 0x32, 0xaa, 0x34, 0xc9, 0x72, 0x1a, 0xdf, 0x8e, 0xb4, 0x90, 0x07, 0x66, 0x0c, 0x37, 0xf3,
+	0xec, 0x98, 0xf7, 0xc0, 0xcd, 0xb3, 0xc7, 0x97, 0x32, 0xe2, 0x3a, 0xa8, 0xd9, 0x91, 0xdd, 0x5e,
+	0xc7, 0x3b, 0x7e, 0x2c, 0x4d, 0xe8, 0x34, 0xfe, 0x53, 0xfd, 0xe9, 0x8b, 0xf5, 0x60, 0xaf, 0xda,
+	0x98, 0xee, 0x54, 0xc2, 0x1a, 0x31, 0x5e, 0xfa, 0x37, 0x40, 0xc3, 0x17, 0xb9, 0x82, 0xab, 0x60,
+	0xb6, 0xbc, 0x74, 0xb7, 0xeb, 0x6a, 0xd8, 0xf0, 0xb7, 0xae, 0x9c, 0x6d, 0xff, 0x33, 0xb0, 0xe0,
+	0xd5, 0x9f, 0x58, 0xef, 0x8f, 0x08, 0x93, 0x63, 0xc9, 0x12, 0x8c, 0x27, 0xcb, 0x43, 0x50, 0x3f,
+	0xbf, 0xa8, 0x8c, 0x09, 0x7a, 0xa5, 0xa7, 0xd9, 0x09, 0x5f, 0xbc, 0x59, 0x0b, 0x5e, 0xbe, 0x59,
+	0x0b, 0x7e, 0x78, 0xb3, 0x16, 0x7c, 0xfe, 0x76, 0x6d, 0xea, 0xe5, 0xdb, 0xb5, 0xa9, 0xef, 0xdf,
+	0xae, 0x4d, 0x3d, 0xbd, 0xf5, 0x07, 0x29, 0x9f, 0x0d, 0x2e, 0xfe, 0xee, 0x98, 0x29, 0x41, 0x1d,
+	0xd7, 0xed, 0xdf, 0x9a, 0xed, 0x5f, 0x06, 0x00, 0x31, 0xbc, 0x18, 0x27, 0x5e, 0x0d, 0x00, 0x00,
 }
 
 func (this *TimestampedActorNonce) Equal(that interface{}) bool {
@@ -467,21 +892,9 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.ActiveReputerQuantile.Size()
-		i -= size
-		if _, err := m.ActiveReputerQuantile.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTopic(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	{
-		size := m.ActiveForecasterQuantile.Size()
+		size := m.ReputerOutlierThreshold.Size()
 		i -= size
-		if _, err := m.ActiveForecasterQuantile.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ReputerOutlierThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTopic(dAtA, i, uint64(size))
@@ -489,11 +902,11 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0xa2
+	dAtA[i] = 0xfa
 	{
-		size := m.ActiveInfererQuantile.Size()
+		size := m.MinWorkerBond.Size()
 		i -= size
-		if _, err := m.ActiveInfererQuantile.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinWorkerBond.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTopic(dAtA, i, uint64(size))
@@ -501,30 +914,25 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x9a
-	{
-		size := m.MeritSortitionAlpha.Size()
-		i -= size
-		if _, err := m.MeritSortitionAlpha.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTopic(dAtA, i, uint64(size))
+	dAtA[i] = 0xf2
+	if m.ReputerRevealWindow != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ReputerRevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
-	if m.WorkerSubmissionWindow != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerSubmissionWindow))
+	if m.WorkerRevealWindow != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerRevealWindow))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0xe0
 	}
 	{
-		size := m.InitialRegret.Size()
+		size := m.CreatorCommission.Size()
 		i -= size
-		if _, err := m.InitialRegret.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CreatorCommission.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTopic(dAtA, i, uint64(size))
@@ -532,14 +940,144 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x82
-	{
-		size := m.Epsilon.Size()
-		i -= size
-		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	dAtA[i] = 0xda
+	if m.GroundTruthSource != nil {
+		{
+			size, err := m.GroundTruthSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopic(dAtA, i, uint64(size))
 		}
-		i = encodeVarintTopic(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.QuantileLevels) > 0 {
+		for iNdEx := len(m.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.QuantileLevels[iNdEx].Size()
+				i -= size
+				if _, err := m.QuantileLevels[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.OutputDimension != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.OutputDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ChainComputedLosses {
+		i--
+		if m.ChainComputedLosses {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.TypedMetadata != nil {
+		{
+			size, err := m.TypedMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	{
+		size := m.ActiveReputerQuantile.Size()
+		i -= size
+		if _, err := m.ActiveReputerQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.ActiveForecasterQuantile.Size()
+		i -= size
+		if _, err := m.ActiveForecasterQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.ActiveInfererQuantile.Size()
+		i -= size
+		if _, err := m.ActiveInfererQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.MeritSortitionAlpha.Size()
+		i -= size
+		if _, err := m.MeritSortitionAlpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.WorkerSubmissionWindow != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerSubmissionWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.InitialRegret.Size()
+		i -= size
+		if _, err := m.InitialRegret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.Epsilon.Size()
+		i -= size
+		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x7a
@@ -617,7 +1155,7 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicList) Marshal() (dAtA []byte, err error) {
+func (m *GroundTruthSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -627,34 +1165,32 @@ func (m *TopicList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicList) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroundTruthSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroundTruthSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTopic(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentTopicId != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ParentTopicId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+func (m *TopicMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -664,37 +1200,55 @@ func (m *TimestampedActorNonce) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTopic(dAtA, i, uint64(size))
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintTopic(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if m.PredictionHorizonSeconds != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.PredictionHorizonSeconds))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Unit)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIds) Marshal() (dAtA []byte, err error) {
+func (m *TopicArchive) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -704,38 +1258,60 @@ func (m *TopicIds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIds) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicArchive) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TopicIds) > 0 {
-		dAtA3 := make([]byte, len(m.TopicIds)*10)
-		var j2 int
-		for _, num := range m.TopicIds {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
+	if m.Pruned {
+		i--
+		if m.Pruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTopic(dAtA, i, uint64(j2))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x28
+	}
+	if m.StakeReturned {
+		i--
+		if m.StakeReturned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakeRemovalsDueBlockHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.StakeRemovalsDueBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ArchivedBlockHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ArchivedBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopic(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdWeightPair) Marshal() (dAtA []byte, err error) {
+func (m *FundingSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -745,65 +1321,253 @@ func (m *TopicIdWeightPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdWeightPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *FundingSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FundingSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.NextDripBlockHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.NextDripBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	{
-		size := m.Weight.Size()
+		size := m.Escrow.Size()
 		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Escrow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTopic(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x32
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AmountPerDrip.Size()
+		i -= size
+		if _, err := m.AmountPerDrip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TopicId != 0 {
 		i = encodeVarintTopic(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Id))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTopic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TopicList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Topic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TopicList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTopic(uint64(m.Id))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
-	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopicIds) > 0 {
+		dAtA6 := make([]byte, len(m.TopicIds)*10)
+		var j5 int
+		for _, num := range m.TopicIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTopic(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdWeightPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdWeightPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdWeightPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TopicId != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Topic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTopic(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
 	}
 	if m.EpochLastEnded != 0 {
 		n += 1 + sovTopic(uint64(m.EpochLastEnded))
@@ -836,6 +1600,136 @@ func (m *Topic) Size() (n int) {
 	n += 2 + l + sovTopic(uint64(l))
 	l = m.ActiveReputerQuantile.Size()
 	n += 2 + l + sovTopic(uint64(l))
+	if m.TypedMetadata != nil {
+		l = m.TypedMetadata.Size()
+		n += 2 + l + sovTopic(uint64(l))
+	}
+	if m.ChainComputedLosses {
+		n += 3
+	}
+	if m.OutputDimension != 0 {
+		n += 2 + sovTopic(uint64(m.OutputDimension))
+	}
+	if len(m.QuantileLevels) > 0 {
+		for _, e := range m.QuantileLevels {
+			l = e.Size()
+			n += 2 + l + sovTopic(uint64(l))
+		}
+	}
+	if m.GroundTruthSource != nil {
+		l = m.GroundTruthSource.Size()
+		n += 2 + l + sovTopic(uint64(l))
+	}
+	l = m.CreatorCommission.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	if m.WorkerRevealWindow != 0 {
+		n += 2 + sovTopic(uint64(m.WorkerRevealWindow))
+	}
+	if m.ReputerRevealWindow != 0 {
+		n += 2 + sovTopic(uint64(m.ReputerRevealWindow))
+	}
+	l = m.MinWorkerBond.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	l = m.ReputerOutlierThreshold.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	return n
+}
+
+func (m *GroundTruthSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentTopicId != 0 {
+		n += 1 + sovTopic(uint64(m.ParentTopicId))
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.PredictionHorizonSeconds != 0 {
+		n += 1 + sovTopic(uint64(m.PredictionHorizonSeconds))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Topic.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.ArchivedBlockHeight != 0 {
+		n += 1 + sovTopic(uint64(m.ArchivedBlockHeight))
+	}
+	if m.StakeRemovalsDueBlockHeight != 0 {
+		n += 1 + sovTopic(uint64(m.StakeRemovalsDueBlockHeight))
+	}
+	if m.StakeReturned {
+		n += 2
+	}
+	if m.Pruned {
+		n += 2
+	}
+	return n
+}
+
+func (m *FundingSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTopic(uint64(m.Id))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTopic(uint64(m.TopicId))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = m.AmountPerDrip.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovTopic(uint64(m.IntervalBlocks))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.NextDripBlockHeight != 0 {
+		n += 1 + sovTopic(uint64(m.NextDripBlockHeight))
+	}
 	return n
 }
 
@@ -1013,16 +1907,1051 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTopic
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLastEnded", wireType)
+			}
+			m.EpochLastEnded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLastEnded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNegative = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+			}
+			m.WorkerSubmissionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerSubmissionWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MeritSortitionAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveInfererQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveForecasterQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveReputerQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TypedMetadata == nil {
+				m.TypedMetadata = &TopicMetadata{}
+			}
+			if err := m.TypedMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainComputedLosses", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainComputedLosses = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
+			}
+			m.OutputDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.QuantileLevels = append(m.QuantileLevels, v)
+			if err := m.QuantileLevels[len(m.QuantileLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroundTruthSource == nil {
+				m.GroundTruthSource = &GroundTruthSource{}
+			}
+			if err := m.GroundTruthSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatorCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+			}
+			m.WorkerRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRevealWindow", wireType)
+			}
+			m.ReputerRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkerBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWorkerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerOutlierThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroundTruthSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroundTruthSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroundTruthSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentTopicId", wireType)
+			}
+			m.ParentTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictionHorizonSeconds", wireType)
+			}
+			m.PredictionHorizonSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PredictionHorizonSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicArchive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicArchive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicArchive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1032,29 +2961,30 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTopic
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLastEnded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedBlockHeight", wireType)
 			}
-			m.EpochLastEnded = 0
+			m.ArchivedBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1064,16 +2994,16 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLastEnded |= int64(b&0x7F) << shift
+				m.ArchivedBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsDueBlockHeight", wireType)
 			}
-			m.EpochLength = 0
+			m.StakeRemovalsDueBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1083,16 +3013,16 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
+				m.StakeRemovalsDueBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeReturned", wireType)
 			}
-			m.GroundTruthLag = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1102,16 +3032,17 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroundTruthLag |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			m.StakeReturned = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1121,65 +3052,67 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
+			m.Pruned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTopic
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 14:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1189,17 +3122,16 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowNegative = bool(v != 0)
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1209,29 +3141,14 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1259,32 +3176,11 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
-			}
-			m.WorkerSubmissionWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkerSubmissionWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerDrip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1312,15 +3208,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MeritSortitionAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountPerDrip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
 			}
-			var stringLen uint64
+			m.IntervalBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1330,29 +3226,14 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IntervalBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ActiveInfererQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1380,15 +3261,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActiveForecasterQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDripBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.NextDripBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1398,26 +3279,11 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextDripBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ActiveReputerQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
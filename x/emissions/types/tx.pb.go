@@ -83,6 +83,18 @@ type OptionalParams struct {
 	MaxStringLength                     []uint64                                          `protobuf:"varint,47,rep,packed,name=max_string_length,json=maxStringLength,proto3" json:"max_string_length,omitempty"`
 	InitialRegretQuantile               []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,48,rep,name=initial_regret_quantile,json=initialRegretQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret_quantile"`
 	PNormSafeDiv                        []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,49,rep,name=p_norm_safe_div,json=pNormSafeDiv,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm_safe_div"`
+	MaxPruneArchivedTopicKeysEndBlock   []uint64                                          `protobuf:"varint,50,rep,packed,name=max_prune_archived_topic_keys_end_block,json=maxPruneArchivedTopicKeysEndBlock,proto3" json:"max_prune_archived_topic_keys_end_block,omitempty"`
+	MaxFundingSubscriptionDripsEndBlock []uint64                                          `protobuf:"varint,51,rep,packed,name=max_funding_subscription_drips_end_block,json=maxFundingSubscriptionDripsEndBlock,proto3" json:"max_funding_subscription_drips_end_block,omitempty"`
+	MaxTopicCreatorCommission           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,52,rep,name=max_topic_creator_commission,json=maxTopicCreatorCommission,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_topic_creator_commission"`
+	NodeRotationCooldown                []int64                                           `protobuf:"varint,53,rep,packed,name=node_rotation_cooldown,json=nodeRotationCooldown,proto3" json:"node_rotation_cooldown,omitempty"`
+	ReputerOutlierSlashEpochs           []uint64                                          `protobuf:"varint,54,rep,packed,name=reputer_outlier_slash_epochs,json=reputerOutlierSlashEpochs,proto3" json:"reputer_outlier_slash_epochs,omitempty"`
+	ReputerOutlierSlashFraction         []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,55,rep,name=reputer_outlier_slash_fraction,json=reputerOutlierSlashFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_outlier_slash_fraction"`
+	MaxMissedEpochsJail                 []uint64                                          `protobuf:"varint,56,rep,packed,name=max_missed_epochs_jail,json=maxMissedEpochsJail,proto3" json:"max_missed_epochs_jail,omitempty"`
+	MaxMissedEpochsDeregister           []uint64                                          `protobuf:"varint,57,rep,packed,name=max_missed_epochs_deregister,json=maxMissedEpochsDeregister,proto3" json:"max_missed_epochs_deregister,omitempty"`
+	ReputerCommissionUpdateCooldown     []int64                                           `protobuf:"varint,58,rep,packed,name=reputer_commission_update_cooldown,json=reputerCommissionUpdateCooldown,proto3" json:"reputer_commission_update_cooldown,omitempty"`
+	MaxDelegateToSelfStakeRatio         []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,59,rep,name=max_delegate_to_self_stake_ratio,json=maxDelegateToSelfStakeRatio,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_delegate_to_self_stake_ratio"`
+	MaxDelegateStakePerReputer          []cosmossdk_io_math.Int                           `protobuf:"bytes,60,rep,name=max_delegate_stake_per_reputer,json=maxDelegateStakePerReputer,proto3,customtype=cosmossdk.io/math.Int" json:"max_delegate_stake_per_reputer"`
+	MaxAutoCompoundDelegationsPerPayout []uint64                                          `protobuf:"varint,61,rep,packed,name=max_auto_compound_delegations_per_payout,json=maxAutoCompoundDelegationsPerPayout,proto3" json:"max_auto_compound_delegations_per_payout,omitempty"`
 }
 
 func (m *OptionalParams) Reset()         { *m = OptionalParams{} }
@@ -251,6 +263,62 @@ func (m *OptionalParams) GetMaxStringLength() []uint64 {
 	return nil
 }
 
+func (m *OptionalParams) GetMaxPruneArchivedTopicKeysEndBlock() []uint64 {
+	if m != nil {
+		return m.MaxPruneArchivedTopicKeysEndBlock
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxFundingSubscriptionDripsEndBlock() []uint64 {
+	if m != nil {
+		return m.MaxFundingSubscriptionDripsEndBlock
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetNodeRotationCooldown() []int64 {
+	if m != nil {
+		return m.NodeRotationCooldown
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetReputerOutlierSlashEpochs() []uint64 {
+	if m != nil {
+		return m.ReputerOutlierSlashEpochs
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxMissedEpochsJail() []uint64 {
+	if m != nil {
+		return m.MaxMissedEpochsJail
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxMissedEpochsDeregister() []uint64 {
+	if m != nil {
+		return m.MaxMissedEpochsDeregister
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetReputerCommissionUpdateCooldown() []int64 {
+	if m != nil {
+		return m.ReputerCommissionUpdateCooldown
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxAutoCompoundDelegationsPerPayout() []uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundDelegationsPerPayout
+	}
+	return nil
+}
+
 type UpdateParamsRequest struct {
 	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Params *OptionalParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
@@ -341,20 +409,30 @@ var xxx_messageInfo_UpdateParamsResponse proto.InternalMessageInfo
 
 type CreateNewTopicRequest struct {
 	// creator is the message sender.
-	Creator                  string                                          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Metadata                 string                                          `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LossMethod               string                                          `protobuf:"bytes,4,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
-	EpochLength              int64                                           `protobuf:"varint,7,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	GroundTruthLag           int64                                           `protobuf:"varint,8,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
-	PNorm                    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,opt,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
-	AlphaRegret              github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,opt,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
-	AllowNegative            bool                                            `protobuf:"varint,12,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
-	Epsilon                  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
-	WorkerSubmissionWindow   int64                                           `protobuf:"varint,14,opt,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
-	MeritSortitionAlpha      github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,15,opt,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"merit_sortition_alpha"`
-	ActiveInfererQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,16,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
-	ActiveForecasterQuantile github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,17,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
-	ActiveReputerQuantile    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,18,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	Creator                  string                                            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata                 string                                            `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LossMethod               string                                            `protobuf:"bytes,4,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
+	EpochLength              int64                                             `protobuf:"varint,7,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag           int64                                             `protobuf:"varint,8,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	PNorm                    github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,10,opt,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
+	AlphaRegret              github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,11,opt,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
+	AllowNegative            bool                                              `protobuf:"varint,12,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
+	Epsilon                  github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,13,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
+	WorkerSubmissionWindow   int64                                             `protobuf:"varint,14,opt,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	MeritSortitionAlpha      github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,15,opt,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"merit_sortition_alpha"`
+	ActiveInfererQuantile    github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,16,opt,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
+	ActiveForecasterQuantile github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,17,opt,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
+	ActiveReputerQuantile    github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,18,opt,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	TypedMetadata            *TopicMetadata                                    `protobuf:"bytes,19,opt,name=typed_metadata,json=typedMetadata,proto3" json:"typed_metadata,omitempty"`
+	ChainComputedLosses      bool                                              `protobuf:"varint,20,opt,name=chain_computed_losses,json=chainComputedLosses,proto3" json:"chain_computed_losses,omitempty"`
+	OutputDimension          uint64                                            `protobuf:"varint,21,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	QuantileLevels           []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,22,rep,name=quantile_levels,json=quantileLevels,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"quantile_levels"`
+	GroundTruthSource        *GroundTruthSource                                `protobuf:"bytes,23,opt,name=ground_truth_source,json=groundTruthSource,proto3" json:"ground_truth_source,omitempty"`
+	CreatorCommission        github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,24,opt,name=creator_commission,json=creatorCommission,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"creator_commission"`
+	WorkerRevealWindow       int64                                             `protobuf:"varint,25,opt,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	ReputerRevealWindow      int64                                             `protobuf:"varint,26,opt,name=reputer_reveal_window,json=reputerRevealWindow,proto3" json:"reputer_reveal_window,omitempty"`
+	MinWorkerBond            cosmossdk_io_math.Int                             `protobuf:"bytes,27,opt,name=min_worker_bond,json=minWorkerBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_worker_bond"`
+	ReputerOutlierThreshold  github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,28,opt,name=reputer_outlier_threshold,json=reputerOutlierThreshold,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_outlier_threshold"`
 }
 
 func (m *CreateNewTopicRequest) Reset()         { *m = CreateNewTopicRequest{} }
@@ -439,6 +517,48 @@ func (m *CreateNewTopicRequest) GetWorkerSubmissionWindow() int64 {
 	return 0
 }
 
+func (m *CreateNewTopicRequest) GetTypedMetadata() *TopicMetadata {
+	if m != nil {
+		return m.TypedMetadata
+	}
+	return nil
+}
+
+func (m *CreateNewTopicRequest) GetChainComputedLosses() bool {
+	if m != nil {
+		return m.ChainComputedLosses
+	}
+	return false
+}
+
+func (m *CreateNewTopicRequest) GetOutputDimension() uint64 {
+	if m != nil {
+		return m.OutputDimension
+	}
+	return 0
+}
+
+func (m *CreateNewTopicRequest) GetGroundTruthSource() *GroundTruthSource {
+	if m != nil {
+		return m.GroundTruthSource
+	}
+	return nil
+}
+
+func (m *CreateNewTopicRequest) GetWorkerRevealWindow() int64 {
+	if m != nil {
+		return m.WorkerRevealWindow
+	}
+	return 0
+}
+
+func (m *CreateNewTopicRequest) GetReputerRevealWindow() int64 {
+	if m != nil {
+		return m.ReputerRevealWindow
+	}
+	return 0
+}
+
 type CreateNewTopicResponse struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
@@ -483,23 +603,47 @@ func (m *CreateNewTopicResponse) GetTopicId() uint64 {
 	return 0
 }
 
-type InsertReputerPayloadRequest struct {
-	Sender             string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,2,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
-}
-
-func (m *InsertReputerPayloadRequest) Reset()         { *m = InsertReputerPayloadRequest{} }
-func (m *InsertReputerPayloadRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertReputerPayloadRequest) ProtoMessage()    {}
-func (*InsertReputerPayloadRequest) Descriptor() ([]byte, []int) {
+// Update the mutable parameters of an existing topic. Like OptionalParams,
+// every field after topic_id is a repeated field with at most one element,
+// and an empty field leaves the current value of the topic untouched.
+// The update is validated on submission but only applied to the topic at its
+// next epoch boundary, so that open nonces and worker windows are unaffected.
+type UpdateTopicRequest struct {
+	// sender must be the topic creator or a whitelist admin
+	Sender                   string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId                  uint64                                            `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Metadata                 []string                                          `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	EpochLength              []int64                                           `protobuf:"varint,4,rep,packed,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag           []int64                                           `protobuf:"varint,5,rep,packed,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	WorkerSubmissionWindow   []int64                                           `protobuf:"varint,6,rep,packed,name=worker_submission_window,json=workerSubmissionWindow,proto3" json:"worker_submission_window,omitempty"`
+	PNorm                    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
+	AlphaRegret              []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,8,rep,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
+	Epsilon                  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,9,rep,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
+	MeritSortitionAlpha      []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,rep,name=merit_sortition_alpha,json=meritSortitionAlpha,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"merit_sortition_alpha"`
+	ActiveInfererQuantile    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,rep,name=active_inferer_quantile,json=activeInfererQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_inferer_quantile"`
+	ActiveForecasterQuantile []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,rep,name=active_forecaster_quantile,json=activeForecasterQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_forecaster_quantile"`
+	ActiveReputerQuantile    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,rep,name=active_reputer_quantile,json=activeReputerQuantile,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"active_reputer_quantile"`
+	TypedMetadata            []*TopicMetadata                                  `protobuf:"bytes,14,rep,name=typed_metadata,json=typedMetadata,proto3" json:"typed_metadata,omitempty"`
+	GroundTruthSource        []*GroundTruthSource                              `protobuf:"bytes,15,rep,name=ground_truth_source,json=groundTruthSource,proto3" json:"ground_truth_source,omitempty"`
+	CreatorCommission        []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,16,rep,name=creator_commission,json=creatorCommission,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"creator_commission"`
+	WorkerRevealWindow       []int64                                           `protobuf:"varint,17,rep,packed,name=worker_reveal_window,json=workerRevealWindow,proto3" json:"worker_reveal_window,omitempty"`
+	ReputerRevealWindow      []int64                                           `protobuf:"varint,18,rep,packed,name=reputer_reveal_window,json=reputerRevealWindow,proto3" json:"reputer_reveal_window,omitempty"`
+	MinWorkerBond            []cosmossdk_io_math.Int                           `protobuf:"bytes,19,rep,name=min_worker_bond,json=minWorkerBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_worker_bond"`
+	ReputerOutlierThreshold  []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,20,rep,name=reputer_outlier_threshold,json=reputerOutlierThreshold,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_outlier_threshold"`
+}
+
+func (m *UpdateTopicRequest) Reset()         { *m = UpdateTopicRequest{} }
+func (m *UpdateTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTopicRequest) ProtoMessage()    {}
+func (*UpdateTopicRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{5}
 }
-func (m *InsertReputerPayloadRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertReputerPayloadRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,47 +653,103 @@ func (m *InsertReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *InsertReputerPayloadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertReputerPayloadRequest.Merge(m, src)
+func (m *UpdateTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTopicRequest.Merge(m, src)
 }
-func (m *InsertReputerPayloadRequest) XXX_Size() int {
+func (m *UpdateTopicRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertReputerPayloadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertReputerPayloadRequest.DiscardUnknown(m)
+func (m *UpdateTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertReputerPayloadRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTopicRequest proto.InternalMessageInfo
 
-func (m *InsertReputerPayloadRequest) GetSender() string {
+func (m *UpdateTopicRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *InsertReputerPayloadRequest) GetReputerValueBundle() *ReputerValueBundle {
+func (m *UpdateTopicRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.ReputerValueBundle
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *UpdateTopicRequest) GetMetadata() []string {
+	if m != nil {
+		return m.Metadata
 	}
 	return nil
 }
 
-type InsertReputerPayloadResponse struct {
+func (m *UpdateTopicRequest) GetEpochLength() []int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return nil
 }
 
-func (m *InsertReputerPayloadResponse) Reset()         { *m = InsertReputerPayloadResponse{} }
-func (m *InsertReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
-func (*InsertReputerPayloadResponse) ProtoMessage()    {}
-func (*InsertReputerPayloadResponse) Descriptor() ([]byte, []int) {
+func (m *UpdateTopicRequest) GetGroundTruthLag() []int64 {
+	if m != nil {
+		return m.GroundTruthLag
+	}
+	return nil
+}
+
+func (m *UpdateTopicRequest) GetWorkerSubmissionWindow() []int64 {
+	if m != nil {
+		return m.WorkerSubmissionWindow
+	}
+	return nil
+}
+
+func (m *UpdateTopicRequest) GetTypedMetadata() []*TopicMetadata {
+	if m != nil {
+		return m.TypedMetadata
+	}
+	return nil
+}
+
+func (m *UpdateTopicRequest) GetGroundTruthSource() []*GroundTruthSource {
+	if m != nil {
+		return m.GroundTruthSource
+	}
+	return nil
+}
+
+func (m *UpdateTopicRequest) GetWorkerRevealWindow() []int64 {
+	if m != nil {
+		return m.WorkerRevealWindow
+	}
+	return nil
+}
+
+func (m *UpdateTopicRequest) GetReputerRevealWindow() []int64 {
+	if m != nil {
+		return m.ReputerRevealWindow
+	}
+	return nil
+}
+
+type UpdateTopicResponse struct {
+}
+
+func (m *UpdateTopicResponse) Reset()         { *m = UpdateTopicResponse{} }
+func (m *UpdateTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTopicResponse) ProtoMessage()    {}
+func (*UpdateTopicResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{6}
 }
-func (m *InsertReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertReputerPayloadResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -559,35 +759,35 @@ func (m *InsertReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *InsertReputerPayloadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertReputerPayloadResponse.Merge(m, src)
+func (m *UpdateTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTopicResponse.Merge(m, src)
 }
-func (m *InsertReputerPayloadResponse) XXX_Size() int {
+func (m *UpdateTopicResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertReputerPayloadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertReputerPayloadResponse.DiscardUnknown(m)
+func (m *UpdateTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertReputerPayloadResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTopicResponse proto.InternalMessageInfo
 
-type InsertWorkerPayloadRequest struct {
-	Sender           string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	WorkerDataBundle *WorkerDataBundle `protobuf:"bytes,2,opt,name=worker_data_bundle,json=workerDataBundle,proto3" json:"worker_data_bundle,omitempty"`
+type ArchiveTopicRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *InsertWorkerPayloadRequest) Reset()         { *m = InsertWorkerPayloadRequest{} }
-func (m *InsertWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertWorkerPayloadRequest) ProtoMessage()    {}
-func (*InsertWorkerPayloadRequest) Descriptor() ([]byte, []int) {
+func (m *ArchiveTopicRequest) Reset()         { *m = ArchiveTopicRequest{} }
+func (m *ArchiveTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveTopicRequest) ProtoMessage()    {}
+func (*ArchiveTopicRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{7}
 }
-func (m *InsertWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
+func (m *ArchiveTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ArchiveTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertWorkerPayloadRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ArchiveTopicRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,47 +797,47 @@ func (m *InsertWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *InsertWorkerPayloadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertWorkerPayloadRequest.Merge(m, src)
+func (m *ArchiveTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveTopicRequest.Merge(m, src)
 }
-func (m *InsertWorkerPayloadRequest) XXX_Size() int {
+func (m *ArchiveTopicRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertWorkerPayloadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertWorkerPayloadRequest.DiscardUnknown(m)
+func (m *ArchiveTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveTopicRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertWorkerPayloadRequest proto.InternalMessageInfo
+var xxx_messageInfo_ArchiveTopicRequest proto.InternalMessageInfo
 
-func (m *InsertWorkerPayloadRequest) GetSender() string {
+func (m *ArchiveTopicRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *InsertWorkerPayloadRequest) GetWorkerDataBundle() *WorkerDataBundle {
+func (m *ArchiveTopicRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.WorkerDataBundle
+		return m.TopicId
 	}
-	return nil
+	return 0
 }
 
-type InsertWorkerPayloadResponse struct {
+type ArchiveTopicResponse struct {
 }
 
-func (m *InsertWorkerPayloadResponse) Reset()         { *m = InsertWorkerPayloadResponse{} }
-func (m *InsertWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
-func (*InsertWorkerPayloadResponse) ProtoMessage()    {}
-func (*InsertWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+func (m *ArchiveTopicResponse) Reset()         { *m = ArchiveTopicResponse{} }
+func (m *ArchiveTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*ArchiveTopicResponse) ProtoMessage()    {}
+func (*ArchiveTopicResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{8}
 }
-func (m *InsertWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
+func (m *ArchiveTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ArchiveTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertWorkerPayloadResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ArchiveTopicResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,37 +847,35 @@ func (m *InsertWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *InsertWorkerPayloadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertWorkerPayloadResponse.Merge(m, src)
+func (m *ArchiveTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveTopicResponse.Merge(m, src)
 }
-func (m *InsertWorkerPayloadResponse) XXX_Size() int {
+func (m *ArchiveTopicResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertWorkerPayloadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertWorkerPayloadResponse.DiscardUnknown(m)
+func (m *ArchiveTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveTopicResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertWorkerPayloadResponse proto.InternalMessageInfo
+var xxx_messageInfo_ArchiveTopicResponse proto.InternalMessageInfo
 
-type RegisterRequest struct {
-	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId   uint64 `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Owner     string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
-	IsReputer bool   `protobuf:"varint,6,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+type InsertReputerPayloadRequest struct {
+	Sender             string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,2,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
 }
 
-func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
-func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterRequest) ProtoMessage()    {}
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
+func (m *InsertReputerPayloadRequest) Reset()         { *m = InsertReputerPayloadRequest{} }
+func (m *InsertReputerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertReputerPayloadRequest) ProtoMessage()    {}
+func (*InsertReputerPayloadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{9}
 }
-func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
+func (m *InsertReputerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertReputerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,63 +885,47 @@ func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *RegisterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterRequest.Merge(m, src)
+func (m *InsertReputerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertReputerPayloadRequest.Merge(m, src)
 }
-func (m *RegisterRequest) XXX_Size() int {
+func (m *InsertReputerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterRequest.DiscardUnknown(m)
+func (m *InsertReputerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertReputerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterRequest proto.InternalMessageInfo
+var xxx_messageInfo_InsertReputerPayloadRequest proto.InternalMessageInfo
 
-func (m *RegisterRequest) GetSender() string {
+func (m *InsertReputerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RegisterRequest) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
-}
-
-func (m *RegisterRequest) GetOwner() string {
-	if m != nil {
-		return m.Owner
-	}
-	return ""
-}
-
-func (m *RegisterRequest) GetIsReputer() bool {
+func (m *InsertReputerPayloadRequest) GetReputerValueBundle() *ReputerValueBundle {
 	if m != nil {
-		return m.IsReputer
+		return m.ReputerValueBundle
 	}
-	return false
+	return nil
 }
 
-type RegisterResponse struct {
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type InsertReputerPayloadResponse struct {
 }
 
-func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
-func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterResponse) ProtoMessage()    {}
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
+func (m *InsertReputerPayloadResponse) Reset()         { *m = InsertReputerPayloadResponse{} }
+func (m *InsertReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertReputerPayloadResponse) ProtoMessage()    {}
+func (*InsertReputerPayloadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{10}
 }
-func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
+func (m *InsertReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertReputerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -753,50 +935,40 @@ func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *RegisterResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterResponse.Merge(m, src)
+func (m *InsertReputerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertReputerPayloadResponse.Merge(m, src)
 }
-func (m *RegisterResponse) XXX_Size() int {
+func (m *InsertReputerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
-
-func (m *RegisterResponse) GetSuccess() bool {
-	if m != nil {
-		return m.Success
-	}
-	return false
+func (m *InsertReputerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertReputerPayloadResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterResponse) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
-}
+var xxx_messageInfo_InsertReputerPayloadResponse proto.InternalMessageInfo
 
-type RemoveRegistrationRequest struct {
-	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+// Commits a reputer to the loss bundle it reveals after the submission window
+// of the reputer nonce, in topics using commit-reveal
+type CommitReputerPayloadRequest struct {
+	Sender           string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId          uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64  `protobuf:"varint,3,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	// sha256 of the serialized value bundle followed by the salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (m *RemoveRegistrationRequest) Reset()         { *m = RemoveRegistrationRequest{} }
-func (m *RemoveRegistrationRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveRegistrationRequest) ProtoMessage()    {}
-func (*RemoveRegistrationRequest) Descriptor() ([]byte, []int) {
+func (m *CommitReputerPayloadRequest) Reset()         { *m = CommitReputerPayloadRequest{} }
+func (m *CommitReputerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitReputerPayloadRequest) ProtoMessage()    {}
+func (*CommitReputerPayloadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{11}
 }
-func (m *RemoveRegistrationRequest) XXX_Unmarshal(b []byte) error {
+func (m *CommitReputerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRegistrationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitReputerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -806,56 +978,61 @@ func (m *RemoveRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *RemoveRegistrationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRegistrationRequest.Merge(m, src)
+func (m *CommitReputerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitReputerPayloadRequest.Merge(m, src)
 }
-func (m *RemoveRegistrationRequest) XXX_Size() int {
+func (m *CommitReputerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRegistrationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRegistrationRequest.DiscardUnknown(m)
+func (m *CommitReputerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitReputerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRegistrationRequest proto.InternalMessageInfo
+var xxx_messageInfo_CommitReputerPayloadRequest proto.InternalMessageInfo
 
-func (m *RemoveRegistrationRequest) GetSender() string {
+func (m *CommitReputerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveRegistrationRequest) GetTopicId() uint64 {
+func (m *CommitReputerPayloadRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-func (m *RemoveRegistrationRequest) GetIsReputer() bool {
+func (m *CommitReputerPayloadRequest) GetNonceBlockHeight() int64 {
 	if m != nil {
-		return m.IsReputer
+		return m.NonceBlockHeight
 	}
-	return false
+	return 0
 }
 
-type RemoveRegistrationResponse struct {
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (m *CommitReputerPayloadRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
 }
 
-func (m *RemoveRegistrationResponse) Reset()         { *m = RemoveRegistrationResponse{} }
-func (m *RemoveRegistrationResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveRegistrationResponse) ProtoMessage()    {}
-func (*RemoveRegistrationResponse) Descriptor() ([]byte, []int) {
+type CommitReputerPayloadResponse struct {
+}
+
+func (m *CommitReputerPayloadResponse) Reset()         { *m = CommitReputerPayloadResponse{} }
+func (m *CommitReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitReputerPayloadResponse) ProtoMessage()    {}
+func (*CommitReputerPayloadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{12}
 }
-func (m *RemoveRegistrationResponse) XXX_Unmarshal(b []byte) error {
+func (m *CommitReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRegistrationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitReputerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -865,50 +1042,38 @@ func (m *RemoveRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RemoveRegistrationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRegistrationResponse.Merge(m, src)
+func (m *CommitReputerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitReputerPayloadResponse.Merge(m, src)
 }
-func (m *RemoveRegistrationResponse) XXX_Size() int {
+func (m *CommitReputerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRegistrationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRegistrationResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RemoveRegistrationResponse proto.InternalMessageInfo
-
-func (m *RemoveRegistrationResponse) GetSuccess() bool {
-	if m != nil {
-		return m.Success
-	}
-	return false
+func (m *CommitReputerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitReputerPayloadResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveRegistrationResponse) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
-}
+var xxx_messageInfo_CommitReputerPayloadResponse proto.InternalMessageInfo
 
-type AddStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+// Reveals the loss bundle a reputer committed to, to be sent in the reveal
+// window of the nonce
+type RevealReputerPayloadRequest struct {
+	Sender             string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReputerValueBundle *ReputerValueBundle `protobuf:"bytes,2,opt,name=reputer_value_bundle,json=reputerValueBundle,proto3" json:"reputer_value_bundle,omitempty"`
+	Salt               []byte              `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func (m *AddStakeRequest) Reset()         { *m = AddStakeRequest{} }
-func (m *AddStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*AddStakeRequest) ProtoMessage()    {}
-func (*AddStakeRequest) Descriptor() ([]byte, []int) {
+func (m *RevealReputerPayloadRequest) Reset()         { *m = RevealReputerPayloadRequest{} }
+func (m *RevealReputerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*RevealReputerPayloadRequest) ProtoMessage()    {}
+func (*RevealReputerPayloadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{13}
 }
-func (m *AddStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *RevealReputerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevealReputerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevealReputerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -918,47 +1083,54 @@ func (m *AddStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *AddStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddStakeRequest.Merge(m, src)
+func (m *RevealReputerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevealReputerPayloadRequest.Merge(m, src)
 }
-func (m *AddStakeRequest) XXX_Size() int {
+func (m *RevealReputerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddStakeRequest.DiscardUnknown(m)
+func (m *RevealReputerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevealReputerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevealReputerPayloadRequest proto.InternalMessageInfo
 
-func (m *AddStakeRequest) GetSender() string {
+func (m *RevealReputerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *AddStakeRequest) GetTopicId() uint64 {
+func (m *RevealReputerPayloadRequest) GetReputerValueBundle() *ReputerValueBundle {
 	if m != nil {
-		return m.TopicId
+		return m.ReputerValueBundle
 	}
-	return 0
+	return nil
 }
 
-type AddStakeResponse struct {
+func (m *RevealReputerPayloadRequest) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
 }
 
-func (m *AddStakeResponse) Reset()         { *m = AddStakeResponse{} }
-func (m *AddStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*AddStakeResponse) ProtoMessage()    {}
-func (*AddStakeResponse) Descriptor() ([]byte, []int) {
+type RevealReputerPayloadResponse struct {
+}
+
+func (m *RevealReputerPayloadResponse) Reset()         { *m = RevealReputerPayloadResponse{} }
+func (m *RevealReputerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*RevealReputerPayloadResponse) ProtoMessage()    {}
+func (*RevealReputerPayloadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{14}
 }
-func (m *AddStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *RevealReputerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevealReputerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevealReputerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -968,36 +1140,35 @@ func (m *AddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AddStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddStakeResponse.Merge(m, src)
+func (m *RevealReputerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevealReputerPayloadResponse.Merge(m, src)
 }
-func (m *AddStakeResponse) XXX_Size() int {
+func (m *RevealReputerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddStakeResponse.DiscardUnknown(m)
+func (m *RevealReputerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevealReputerPayloadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_RevealReputerPayloadResponse proto.InternalMessageInfo
 
-type RemoveStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+type InsertWorkerPayloadRequest struct {
+	Sender           string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WorkerDataBundle *WorkerDataBundle `protobuf:"bytes,2,opt,name=worker_data_bundle,json=workerDataBundle,proto3" json:"worker_data_bundle,omitempty"`
 }
 
-func (m *RemoveStakeRequest) Reset()         { *m = RemoveStakeRequest{} }
-func (m *RemoveStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveStakeRequest) ProtoMessage()    {}
-func (*RemoveStakeRequest) Descriptor() ([]byte, []int) {
+func (m *InsertWorkerPayloadRequest) Reset()         { *m = InsertWorkerPayloadRequest{} }
+func (m *InsertWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadRequest) ProtoMessage()    {}
+func (*InsertWorkerPayloadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{15}
 }
-func (m *RemoveStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1007,47 +1178,47 @@ func (m *RemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RemoveStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveStakeRequest.Merge(m, src)
+func (m *InsertWorkerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadRequest.Merge(m, src)
 }
-func (m *RemoveStakeRequest) XXX_Size() int {
+func (m *InsertWorkerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveStakeRequest.DiscardUnknown(m)
+func (m *InsertWorkerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadRequest proto.InternalMessageInfo
 
-func (m *RemoveStakeRequest) GetSender() string {
+func (m *InsertWorkerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveStakeRequest) GetTopicId() uint64 {
+func (m *InsertWorkerPayloadRequest) GetWorkerDataBundle() *WorkerDataBundle {
 	if m != nil {
-		return m.TopicId
+		return m.WorkerDataBundle
 	}
-	return 0
+	return nil
 }
 
-type RemoveStakeResponse struct {
+type InsertWorkerPayloadResponse struct {
 }
 
-func (m *RemoveStakeResponse) Reset()         { *m = RemoveStakeResponse{} }
-func (m *RemoveStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveStakeResponse) ProtoMessage()    {}
-func (*RemoveStakeResponse) Descriptor() ([]byte, []int) {
+func (m *InsertWorkerPayloadResponse) Reset()         { *m = InsertWorkerPayloadResponse{} }
+func (m *InsertWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadResponse) ProtoMessage()    {}
+func (*InsertWorkerPayloadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{16}
 }
-func (m *RemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1057,35 +1228,37 @@ func (m *RemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RemoveStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveStakeResponse.Merge(m, src)
+func (m *InsertWorkerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadResponse.Merge(m, src)
 }
-func (m *RemoveStakeResponse) XXX_Size() int {
+func (m *InsertWorkerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveStakeResponse.DiscardUnknown(m)
+func (m *InsertWorkerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadResponse proto.InternalMessageInfo
 
-type CancelRemoveStakeRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+// Submits worker payloads of several topics in one tx. Each bundle is accepted
+// or refused on its own, the data sending fees of the accepted bundles are paid at once.
+type InsertWorkerPayloadsRequest struct {
+	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WorkerDataBundles []*WorkerDataBundle `protobuf:"bytes,2,rep,name=worker_data_bundles,json=workerDataBundles,proto3" json:"worker_data_bundles,omitempty"`
 }
 
-func (m *CancelRemoveStakeRequest) Reset()         { *m = CancelRemoveStakeRequest{} }
-func (m *CancelRemoveStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveStakeRequest) ProtoMessage()    {}
-func (*CancelRemoveStakeRequest) Descriptor() ([]byte, []int) {
+func (m *InsertWorkerPayloadsRequest) Reset()         { *m = InsertWorkerPayloadsRequest{} }
+func (m *InsertWorkerPayloadsRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadsRequest) ProtoMessage()    {}
+func (*InsertWorkerPayloadsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{17}
 }
-func (m *CancelRemoveStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1095,47 +1268,52 @@ func (m *CancelRemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveStakeRequest.Merge(m, src)
+func (m *InsertWorkerPayloadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadsRequest.Merge(m, src)
 }
-func (m *CancelRemoveStakeRequest) XXX_Size() int {
+func (m *InsertWorkerPayloadsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveStakeRequest.DiscardUnknown(m)
+func (m *InsertWorkerPayloadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadsRequest proto.InternalMessageInfo
 
-func (m *CancelRemoveStakeRequest) GetSender() string {
+func (m *InsertWorkerPayloadsRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *CancelRemoveStakeRequest) GetTopicId() uint64 {
+func (m *InsertWorkerPayloadsRequest) GetWorkerDataBundles() []*WorkerDataBundle {
 	if m != nil {
-		return m.TopicId
+		return m.WorkerDataBundles
 	}
-	return 0
+	return nil
 }
 
-type CancelRemoveStakeResponse struct {
+// Outcome of one bundle of an InsertWorkerPayloadsRequest, error is empty when the bundle was accepted
+type WorkerPayloadResult struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Worker  string `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
+	Success bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *CancelRemoveStakeResponse) Reset()         { *m = CancelRemoveStakeResponse{} }
-func (m *CancelRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveStakeResponse) ProtoMessage()    {}
-func (*CancelRemoveStakeResponse) Descriptor() ([]byte, []int) {
+func (m *WorkerPayloadResult) Reset()         { *m = WorkerPayloadResult{} }
+func (m *WorkerPayloadResult) String() string { return proto.CompactTextString(m) }
+func (*WorkerPayloadResult) ProtoMessage()    {}
+func (*WorkerPayloadResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6928f235d40e7988, []int{18}
 }
-func (m *CancelRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *WorkerPayloadResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkerPayloadResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkerPayloadResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1145,94 +1323,63 @@ func (m *CancelRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveStakeResponse.Merge(m, src)
+func (m *WorkerPayloadResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerPayloadResult.Merge(m, src)
 }
-func (m *CancelRemoveStakeResponse) XXX_Size() int {
+func (m *WorkerPayloadResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveStakeResponse.DiscardUnknown(m)
+func (m *WorkerPayloadResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerPayloadResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_WorkerPayloadResult proto.InternalMessageInfo
 
-type DelegateStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string                `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+func (m *WorkerPayloadResult) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *DelegateStakeRequest) Reset()         { *m = DelegateStakeRequest{} }
-func (m *DelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*DelegateStakeRequest) ProtoMessage()    {}
-func (*DelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{19}
-}
-func (m *DelegateStakeRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *DelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DelegateStakeRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *DelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateStakeRequest.Merge(m, src)
-}
-func (m *DelegateStakeRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *DelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateStakeRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DelegateStakeRequest proto.InternalMessageInfo
-
-func (m *DelegateStakeRequest) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
+func (m *WorkerPayloadResult) GetWorker() string {
+	if m != nil {
+		return m.Worker
+	}
+	return ""
 }
 
-func (m *DelegateStakeRequest) GetTopicId() uint64 {
+func (m *WorkerPayloadResult) GetSuccess() bool {
 	if m != nil {
-		return m.TopicId
+		return m.Success
 	}
-	return 0
+	return false
 }
 
-func (m *DelegateStakeRequest) GetReputer() string {
+func (m *WorkerPayloadResult) GetError() string {
 	if m != nil {
-		return m.Reputer
+		return m.Error
 	}
 	return ""
 }
 
-type DelegateStakeResponse struct {
+// Results are in the order of the bundles of the request
+type InsertWorkerPayloadsResponse struct {
+	Results []*WorkerPayloadResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (m *DelegateStakeResponse) Reset()         { *m = DelegateStakeResponse{} }
-func (m *DelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegateStakeResponse) ProtoMessage()    {}
-func (*DelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{20}
+func (m *InsertWorkerPayloadsResponse) Reset()         { *m = InsertWorkerPayloadsResponse{} }
+func (m *InsertWorkerPayloadsResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertWorkerPayloadsResponse) ProtoMessage()    {}
+func (*InsertWorkerPayloadsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{19}
 }
-func (m *DelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *InsertWorkerPayloadsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertWorkerPayloadsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertWorkerPayloadsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1242,37 +1389,47 @@ func (m *DelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegateStakeResponse.Merge(m, src)
+func (m *InsertWorkerPayloadsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertWorkerPayloadsResponse.Merge(m, src)
 }
-func (m *DelegateStakeResponse) XXX_Size() int {
+func (m *InsertWorkerPayloadsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegateStakeResponse.DiscardUnknown(m)
+func (m *InsertWorkerPayloadsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertWorkerPayloadsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_InsertWorkerPayloadsResponse proto.InternalMessageInfo
 
-type RemoveDelegateStakeRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Reputer string                `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
-	TopicId uint64                `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+func (m *InsertWorkerPayloadsResponse) GetResults() []*WorkerPayloadResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func (m *RemoveDelegateStakeRequest) Reset()         { *m = RemoveDelegateStakeRequest{} }
-func (m *RemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveDelegateStakeRequest) ProtoMessage()    {}
-func (*RemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{21}
+// Commits a worker of a commit-reveal topic to the payload it reveals after
+// the submission window of the nonce. Sent by the worker itself.
+type CommitWorkerPayloadRequest struct {
+	Sender           string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId          uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64  `protobuf:"varint,3,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	// sha256 of the serialized inference forecast bundle followed by the salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
-func (m *RemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *CommitWorkerPayloadRequest) Reset()         { *m = CommitWorkerPayloadRequest{} }
+func (m *CommitWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitWorkerPayloadRequest) ProtoMessage()    {}
+func (*CommitWorkerPayloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{20}
+}
+func (m *CommitWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitWorkerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1282,54 +1439,61 @@ func (m *RemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveDelegateStakeRequest.Merge(m, src)
+func (m *CommitWorkerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitWorkerPayloadRequest.Merge(m, src)
 }
-func (m *RemoveDelegateStakeRequest) XXX_Size() int {
+func (m *CommitWorkerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveDelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveDelegateStakeRequest.DiscardUnknown(m)
+func (m *CommitWorkerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitWorkerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveDelegateStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_CommitWorkerPayloadRequest proto.InternalMessageInfo
 
-func (m *RemoveDelegateStakeRequest) GetSender() string {
+func (m *CommitWorkerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveDelegateStakeRequest) GetReputer() string {
+func (m *CommitWorkerPayloadRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Reputer
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-func (m *RemoveDelegateStakeRequest) GetTopicId() uint64 {
+func (m *CommitWorkerPayloadRequest) GetNonceBlockHeight() int64 {
 	if m != nil {
-		return m.TopicId
+		return m.NonceBlockHeight
 	}
 	return 0
 }
 
-type RemoveDelegateStakeResponse struct {
+func (m *CommitWorkerPayloadRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
 }
 
-func (m *RemoveDelegateStakeResponse) Reset()         { *m = RemoveDelegateStakeResponse{} }
-func (m *RemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveDelegateStakeResponse) ProtoMessage()    {}
-func (*RemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{22}
+type CommitWorkerPayloadResponse struct {
 }
-func (m *RemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *CommitWorkerPayloadResponse) Reset()         { *m = CommitWorkerPayloadResponse{} }
+func (m *CommitWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitWorkerPayloadResponse) ProtoMessage()    {}
+func (*CommitWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{21}
+}
+func (m *CommitWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitWorkerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1339,37 +1503,38 @@ func (m *RemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveDelegateStakeResponse.Merge(m, src)
+func (m *CommitWorkerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitWorkerPayloadResponse.Merge(m, src)
 }
-func (m *RemoveDelegateStakeResponse) XXX_Size() int {
+func (m *CommitWorkerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveDelegateStakeResponse.DiscardUnknown(m)
+func (m *CommitWorkerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitWorkerPayloadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_CommitWorkerPayloadResponse proto.InternalMessageInfo
 
-type CancelRemoveDelegateStakeRequest struct {
-	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Reputer   string `protobuf:"bytes,4,opt,name=reputer,proto3" json:"reputer,omitempty"`
+// Reveals the payload a worker committed to, to be sent in the reveal window
+// of the nonce
+type RevealWorkerPayloadRequest struct {
+	Sender           string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WorkerDataBundle *WorkerDataBundle `protobuf:"bytes,2,opt,name=worker_data_bundle,json=workerDataBundle,proto3" json:"worker_data_bundle,omitempty"`
+	Salt             []byte            `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func (m *CancelRemoveDelegateStakeRequest) Reset()         { *m = CancelRemoveDelegateStakeRequest{} }
-func (m *CancelRemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveDelegateStakeRequest) ProtoMessage()    {}
-func (*CancelRemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{23}
+func (m *RevealWorkerPayloadRequest) Reset()         { *m = RevealWorkerPayloadRequest{} }
+func (m *RevealWorkerPayloadRequest) String() string { return proto.CompactTextString(m) }
+func (*RevealWorkerPayloadRequest) ProtoMessage()    {}
+func (*RevealWorkerPayloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{22}
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+func (m *RevealWorkerPayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevealWorkerPayloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevealWorkerPayloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1379,61 +1544,54 @@ func (m *CancelRemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveDelegateStakeRequest.Merge(m, src)
+func (m *RevealWorkerPayloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevealWorkerPayloadRequest.Merge(m, src)
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_Size() int {
+func (m *RevealWorkerPayloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveDelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveDelegateStakeRequest.DiscardUnknown(m)
+func (m *RevealWorkerPayloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevealWorkerPayloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveDelegateStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevealWorkerPayloadRequest proto.InternalMessageInfo
 
-func (m *CancelRemoveDelegateStakeRequest) GetSender() string {
+func (m *RevealWorkerPayloadRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *CancelRemoveDelegateStakeRequest) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
-}
-
-func (m *CancelRemoveDelegateStakeRequest) GetDelegator() string {
+func (m *RevealWorkerPayloadRequest) GetWorkerDataBundle() *WorkerDataBundle {
 	if m != nil {
-		return m.Delegator
+		return m.WorkerDataBundle
 	}
-	return ""
+	return nil
 }
 
-func (m *CancelRemoveDelegateStakeRequest) GetReputer() string {
+func (m *RevealWorkerPayloadRequest) GetSalt() []byte {
 	if m != nil {
-		return m.Reputer
+		return m.Salt
 	}
-	return ""
+	return nil
 }
 
-type CancelRemoveDelegateStakeResponse struct {
+type RevealWorkerPayloadResponse struct {
 }
 
-func (m *CancelRemoveDelegateStakeResponse) Reset()         { *m = CancelRemoveDelegateStakeResponse{} }
-func (m *CancelRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*CancelRemoveDelegateStakeResponse) ProtoMessage()    {}
-func (*CancelRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{24}
+func (m *RevealWorkerPayloadResponse) Reset()         { *m = RevealWorkerPayloadResponse{} }
+func (m *RevealWorkerPayloadResponse) String() string { return proto.CompactTextString(m) }
+func (*RevealWorkerPayloadResponse) ProtoMessage()    {}
+func (*RevealWorkerPayloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{23}
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *RevealWorkerPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevealWorkerPayloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelRemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevealWorkerPayloadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1443,38 +1601,37 @@ func (m *CancelRemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelRemoveDelegateStakeResponse.Merge(m, src)
+func (m *RevealWorkerPayloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevealWorkerPayloadResponse.Merge(m, src)
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_Size() int {
+func (m *RevealWorkerPayloadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelRemoveDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelRemoveDelegateStakeResponse.DiscardUnknown(m)
+func (m *RevealWorkerPayloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevealWorkerPayloadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelRemoveDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_RevealWorkerPayloadResponse proto.InternalMessageInfo
 
-// Inferences are requested by consumers who fund topics by sending ALLO to
-// ecosystem account via TopicFund messages
-type FundTopicRequest struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+type RegisterRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,4,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Owner     string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	IsReputer bool   `protobuf:"varint,6,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
 }
 
-func (m *FundTopicRequest) Reset()         { *m = FundTopicRequest{} }
-func (m *FundTopicRequest) String() string { return proto.CompactTextString(m) }
-func (*FundTopicRequest) ProtoMessage()    {}
-func (*FundTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{25}
+func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
+func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterRequest) ProtoMessage()    {}
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{24}
 }
-func (m *FundTopicRequest) XXX_Unmarshal(b []byte) error {
+func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FundTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FundTopicRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1484,47 +1641,63 @@ func (m *FundTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *FundTopicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTopicRequest.Merge(m, src)
+func (m *RegisterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterRequest.Merge(m, src)
 }
-func (m *FundTopicRequest) XXX_Size() int {
+func (m *RegisterRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FundTopicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTopicRequest.DiscardUnknown(m)
+func (m *RegisterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTopicRequest proto.InternalMessageInfo
+var xxx_messageInfo_RegisterRequest proto.InternalMessageInfo
 
-func (m *FundTopicRequest) GetSender() string {
+func (m *RegisterRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *FundTopicRequest) GetTopicId() uint64 {
+func (m *RegisterRequest) GetTopicId() uint64 {
 	if m != nil {
 		return m.TopicId
 	}
 	return 0
 }
 
-type FundTopicResponse struct {
+func (m *RegisterRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *FundTopicResponse) Reset()         { *m = FundTopicResponse{} }
-func (m *FundTopicResponse) String() string { return proto.CompactTextString(m) }
-func (*FundTopicResponse) ProtoMessage()    {}
-func (*FundTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{26}
+func (m *RegisterRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
 }
-func (m *FundTopicResponse) XXX_Unmarshal(b []byte) error {
+
+type RegisterResponse struct {
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
+func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse) ProtoMessage()    {}
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{25}
+}
+func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FundTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FundTopicResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1534,35 +1707,50 @@ func (m *FundTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *FundTopicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTopicResponse.Merge(m, src)
+func (m *RegisterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse.Merge(m, src)
 }
-func (m *FundTopicResponse) XXX_Size() int {
+func (m *RegisterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FundTopicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTopicResponse.DiscardUnknown(m)
+func (m *RegisterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTopicResponse proto.InternalMessageInfo
+var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
 
-type AddToWhitelistAdminRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+func (m *RegisterResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-func (m *AddToWhitelistAdminRequest) Reset()         { *m = AddToWhitelistAdminRequest{} }
-func (m *AddToWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*AddToWhitelistAdminRequest) ProtoMessage()    {}
-func (*AddToWhitelistAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{27}
+func (m *RegisterResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
-func (m *AddToWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+
+type RemoveRegistrationRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+}
+
+func (m *RemoveRegistrationRequest) Reset()         { *m = RemoveRegistrationRequest{} }
+func (m *RemoveRegistrationRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRegistrationRequest) ProtoMessage()    {}
+func (*RemoveRegistrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{26}
+}
+func (m *RemoveRegistrationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddToWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddToWhitelistAdminRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveRegistrationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1572,47 +1760,56 @@ func (m *AddToWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *AddToWhitelistAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToWhitelistAdminRequest.Merge(m, src)
+func (m *RemoveRegistrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRegistrationRequest.Merge(m, src)
 }
-func (m *AddToWhitelistAdminRequest) XXX_Size() int {
+func (m *RemoveRegistrationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddToWhitelistAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToWhitelistAdminRequest.DiscardUnknown(m)
+func (m *RemoveRegistrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRegistrationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToWhitelistAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveRegistrationRequest proto.InternalMessageInfo
 
-func (m *AddToWhitelistAdminRequest) GetSender() string {
+func (m *RemoveRegistrationRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *AddToWhitelistAdminRequest) GetAddress() string {
+func (m *RemoveRegistrationRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.TopicId
 	}
-	return ""
+	return 0
 }
 
-type AddToWhitelistAdminResponse struct {
+func (m *RemoveRegistrationRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
 }
 
-func (m *AddToWhitelistAdminResponse) Reset()         { *m = AddToWhitelistAdminResponse{} }
-func (m *AddToWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*AddToWhitelistAdminResponse) ProtoMessage()    {}
-func (*AddToWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{28}
+type RemoveRegistrationResponse struct {
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
-func (m *AddToWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *RemoveRegistrationResponse) Reset()         { *m = RemoveRegistrationResponse{} }
+func (m *RemoveRegistrationResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveRegistrationResponse) ProtoMessage()    {}
+func (*RemoveRegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{27}
+}
+func (m *RemoveRegistrationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddToWhitelistAdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveRegistrationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1622,35 +1819,62 @@ func (m *AddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *AddToWhitelistAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToWhitelistAdminResponse.Merge(m, src)
+func (m *RemoveRegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRegistrationResponse.Merge(m, src)
 }
-func (m *AddToWhitelistAdminResponse) XXX_Size() int {
+func (m *RemoveRegistrationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddToWhitelistAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToWhitelistAdminResponse.DiscardUnknown(m)
+func (m *RemoveRegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRegistrationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToWhitelistAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveRegistrationResponse proto.InternalMessageInfo
 
-type RemoveFromWhitelistAdminRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+func (m *RemoveRegistrationResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-func (m *RemoveFromWhitelistAdminRequest) Reset()         { *m = RemoveFromWhitelistAdminRequest{} }
-func (m *RemoveFromWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveFromWhitelistAdminRequest) ProtoMessage()    {}
-func (*RemoveFromWhitelistAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{29}
+func (m *RemoveRegistrationResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+
+// Rotate the key of a worker or reputer registered in a topic, and/or change
+// its owner. The registration of the node in the topic, its scores, regrets,
+// inclusion counts and, for reputers, its stake and the stake delegated upon
+// it are moved to the new node address, which must not be registered in the
+// topic in the same role nor hold stake in the topic.
+type UpdateNodeInfoRequest struct {
+	// sender must be the current owner of the node
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	// current address of the node
+	NodeAddress string `protobuf:"bytes,4,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	// address the node is moved to, the node keeps its address if empty
+	NewNodeAddress string `protobuf:"bytes,5,opt,name=new_node_address,json=newNodeAddress,proto3" json:"new_node_address,omitempty"`
+	// new owner of the node, the node keeps its owner if empty
+	NewOwner string `protobuf:"bytes,6,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *UpdateNodeInfoRequest) Reset()         { *m = UpdateNodeInfoRequest{} }
+func (m *UpdateNodeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeInfoRequest) ProtoMessage()    {}
+func (*UpdateNodeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{28}
+}
+func (m *UpdateNodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveFromWhitelistAdminRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateNodeInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1660,47 +1884,75 @@ func (m *RemoveFromWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveFromWhitelistAdminRequest.Merge(m, src)
+func (m *UpdateNodeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNodeInfoRequest.Merge(m, src)
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_Size() int {
+func (m *UpdateNodeInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveFromWhitelistAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveFromWhitelistAdminRequest.DiscardUnknown(m)
+func (m *UpdateNodeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNodeInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveFromWhitelistAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateNodeInfoRequest proto.InternalMessageInfo
 
-func (m *RemoveFromWhitelistAdminRequest) GetSender() string {
+func (m *UpdateNodeInfoRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *RemoveFromWhitelistAdminRequest) GetAddress() string {
+func (m *UpdateNodeInfoRequest) GetTopicId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *UpdateNodeInfoRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+func (m *UpdateNodeInfoRequest) GetNodeAddress() string {
+	if m != nil {
+		return m.NodeAddress
 	}
 	return ""
 }
 
-type RemoveFromWhitelistAdminResponse struct {
+func (m *UpdateNodeInfoRequest) GetNewNodeAddress() string {
+	if m != nil {
+		return m.NewNodeAddress
+	}
+	return ""
 }
 
-func (m *RemoveFromWhitelistAdminResponse) Reset()         { *m = RemoveFromWhitelistAdminResponse{} }
-func (m *RemoveFromWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveFromWhitelistAdminResponse) ProtoMessage()    {}
-func (*RemoveFromWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{30}
+func (m *UpdateNodeInfoRequest) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+
+type UpdateNodeInfoResponse struct {
+}
+
+func (m *UpdateNodeInfoResponse) Reset()         { *m = UpdateNodeInfoResponse{} }
+func (m *UpdateNodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeInfoResponse) ProtoMessage()    {}
+func (*UpdateNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{29}
+}
+func (m *UpdateNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveFromWhitelistAdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateNodeInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1710,33 +1962,36 @@ func (m *RemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveFromWhitelistAdminResponse.Merge(m, src)
+func (m *UpdateNodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNodeInfoResponse.Merge(m, src)
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_Size() int {
+func (m *UpdateNodeInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveFromWhitelistAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveFromWhitelistAdminResponse.DiscardUnknown(m)
+func (m *UpdateNodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNodeInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveFromWhitelistAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateNodeInfoResponse proto.InternalMessageInfo
 
-type RewardDelegateStakeResponse struct {
+type UnjailRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	IsReputer bool   `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
 }
 
-func (m *RewardDelegateStakeResponse) Reset()         { *m = RewardDelegateStakeResponse{} }
-func (m *RewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*RewardDelegateStakeResponse) ProtoMessage()    {}
-func (*RewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{31}
+func (m *UnjailRequest) Reset()         { *m = UnjailRequest{} }
+func (m *UnjailRequest) String() string { return proto.CompactTextString(m) }
+func (*UnjailRequest) ProtoMessage()    {}
+func (*UnjailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{30}
 }
-func (m *RewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnjailRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnjailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RewardDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnjailRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1746,36 +2001,54 @@ func (m *RewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RewardDelegateStakeResponse.Merge(m, src)
+func (m *UnjailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnjailRequest.Merge(m, src)
 }
-func (m *RewardDelegateStakeResponse) XXX_Size() int {
+func (m *UnjailRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RewardDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RewardDelegateStakeResponse.DiscardUnknown(m)
+func (m *UnjailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnjailRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RewardDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnjailRequest proto.InternalMessageInfo
 
-type RewardDelegateStakeRequest struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+func (m *UnjailRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *RewardDelegateStakeRequest) Reset()         { *m = RewardDelegateStakeRequest{} }
-func (m *RewardDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
-func (*RewardDelegateStakeRequest) ProtoMessage()    {}
-func (*RewardDelegateStakeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6928f235d40e7988, []int{32}
+func (m *UnjailRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
-func (m *RewardDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *UnjailRequest) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+type UnjailResponse struct {
+}
+
+func (m *UnjailResponse) Reset()         { *m = UnjailResponse{} }
+func (m *UnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*UnjailResponse) ProtoMessage()    {}
+func (*UnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{31}
+}
+func (m *UnjailResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RewardDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RewardDelegateStakeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnjailResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1785,3633 +2058,19735 @@ func (m *RewardDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RewardDelegateStakeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RewardDelegateStakeRequest.Merge(m, src)
+func (m *UnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnjailResponse.Merge(m, src)
 }
-func (m *RewardDelegateStakeRequest) XXX_Size() int {
+func (m *UnjailResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RewardDelegateStakeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RewardDelegateStakeRequest.DiscardUnknown(m)
+func (m *UnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnjailResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RewardDelegateStakeRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnjailResponse proto.InternalMessageInfo
 
-func (m *RewardDelegateStakeRequest) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
+type AddStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *RewardDelegateStakeRequest) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
-	}
-	return 0
+func (m *AddStakeRequest) Reset()         { *m = AddStakeRequest{} }
+func (m *AddStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*AddStakeRequest) ProtoMessage()    {}
+func (*AddStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{32}
 }
-
-func (m *RewardDelegateStakeRequest) GetReputer() string {
-	if m != nil {
-		return m.Reputer
-	}
-	return ""
+func (m *AddStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterType((*OptionalParams)(nil), "emissions.v5.OptionalParams")
-	proto.RegisterType((*UpdateParamsRequest)(nil), "emissions.v5.UpdateParamsRequest")
-	proto.RegisterType((*UpdateParamsResponse)(nil), "emissions.v5.UpdateParamsResponse")
-	proto.RegisterType((*CreateNewTopicRequest)(nil), "emissions.v5.CreateNewTopicRequest")
-	proto.RegisterType((*CreateNewTopicResponse)(nil), "emissions.v5.CreateNewTopicResponse")
-	proto.RegisterType((*InsertReputerPayloadRequest)(nil), "emissions.v5.InsertReputerPayloadRequest")
-	proto.RegisterType((*InsertReputerPayloadResponse)(nil), "emissions.v5.InsertReputerPayloadResponse")
-	proto.RegisterType((*InsertWorkerPayloadRequest)(nil), "emissions.v5.InsertWorkerPayloadRequest")
-	proto.RegisterType((*InsertWorkerPayloadResponse)(nil), "emissions.v5.InsertWorkerPayloadResponse")
-	proto.RegisterType((*RegisterRequest)(nil), "emissions.v5.RegisterRequest")
-	proto.RegisterType((*RegisterResponse)(nil), "emissions.v5.RegisterResponse")
-	proto.RegisterType((*RemoveRegistrationRequest)(nil), "emissions.v5.RemoveRegistrationRequest")
-	proto.RegisterType((*RemoveRegistrationResponse)(nil), "emissions.v5.RemoveRegistrationResponse")
-	proto.RegisterType((*AddStakeRequest)(nil), "emissions.v5.AddStakeRequest")
-	proto.RegisterType((*AddStakeResponse)(nil), "emissions.v5.AddStakeResponse")
-	proto.RegisterType((*RemoveStakeRequest)(nil), "emissions.v5.RemoveStakeRequest")
-	proto.RegisterType((*RemoveStakeResponse)(nil), "emissions.v5.RemoveStakeResponse")
-	proto.RegisterType((*CancelRemoveStakeRequest)(nil), "emissions.v5.CancelRemoveStakeRequest")
-	proto.RegisterType((*CancelRemoveStakeResponse)(nil), "emissions.v5.CancelRemoveStakeResponse")
-	proto.RegisterType((*DelegateStakeRequest)(nil), "emissions.v5.DelegateStakeRequest")
-	proto.RegisterType((*DelegateStakeResponse)(nil), "emissions.v5.DelegateStakeResponse")
-	proto.RegisterType((*RemoveDelegateStakeRequest)(nil), "emissions.v5.RemoveDelegateStakeRequest")
-	proto.RegisterType((*RemoveDelegateStakeResponse)(nil), "emissions.v5.RemoveDelegateStakeResponse")
-	proto.RegisterType((*CancelRemoveDelegateStakeRequest)(nil), "emissions.v5.CancelRemoveDelegateStakeRequest")
-	proto.RegisterType((*CancelRemoveDelegateStakeResponse)(nil), "emissions.v5.CancelRemoveDelegateStakeResponse")
-	proto.RegisterType((*FundTopicRequest)(nil), "emissions.v5.FundTopicRequest")
-	proto.RegisterType((*FundTopicResponse)(nil), "emissions.v5.FundTopicResponse")
-	proto.RegisterType((*AddToWhitelistAdminRequest)(nil), "emissions.v5.AddToWhitelistAdminRequest")
-	proto.RegisterType((*AddToWhitelistAdminResponse)(nil), "emissions.v5.AddToWhitelistAdminResponse")
-	proto.RegisterType((*RemoveFromWhitelistAdminRequest)(nil), "emissions.v5.RemoveFromWhitelistAdminRequest")
-	proto.RegisterType((*RemoveFromWhitelistAdminResponse)(nil), "emissions.v5.RemoveFromWhitelistAdminResponse")
-	proto.RegisterType((*RewardDelegateStakeResponse)(nil), "emissions.v5.RewardDelegateStakeResponse")
-	proto.RegisterType((*RewardDelegateStakeRequest)(nil), "emissions.v5.RewardDelegateStakeRequest")
+func (m *AddStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func init() { proto.RegisterFile("emissions/v5/tx.proto", fileDescriptor_6928f235d40e7988) }
-
-var fileDescriptor_6928f235d40e7988 = []byte{
-	// 2631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
-	0x15, 0xf7, 0x5a, 0x2b, 0x69, 0xf5, 0x24, 0x4b, 0xab, 0xb6, 0x24, 0x8f, 0x56, 0x9f, 0x96, 0x9c,
-	0x44, 0x31, 0xb1, 0x36, 0x89, 0x49, 0x11, 0x02, 0x07, 0xec, 0xd8, 0x0a, 0x52, 0x59, 0x46, 0x19,
-	0x29, 0x31, 0xe5, 0x50, 0x4c, 0x5a, 0x33, 0xbd, 0xbb, 0x83, 0x66, 0xa6, 0x27, 0xdd, 0xbd, 0x2b,
-	0x29, 0xc5, 0x81, 0xa2, 0x8a, 0x33, 0x9c, 0x38, 0x00, 0xc5, 0x99, 0x13, 0xe4, 0xc0, 0x89, 0x53,
-	0xaa, 0xb8, 0xe4, 0x98, 0xe2, 0x44, 0x71, 0x48, 0x51, 0xc9, 0x21, 0xff, 0x06, 0xd5, 0x1f, 0x33,
-	0x3b, 0xb3, 0xda, 0x95, 0x15, 0x8f, 0xa8, 0xca, 0x25, 0xf1, 0x74, 0xbf, 0xfe, 0xbd, 0xd7, 0xbf,
-	0x7e, 0x9f, 0x5b, 0x82, 0x59, 0x12, 0xfa, 0x9c, 0xfb, 0x34, 0xe2, 0xf5, 0xce, 0x1b, 0x75, 0x71,
-	0xb2, 0x19, 0x33, 0x2a, 0x28, 0x9a, 0x48, 0x97, 0x37, 0x3b, 0x6f, 0xd4, 0xa6, 0x71, 0xe8, 0x47,
-	0xb4, 0xae, 0xfe, 0xab, 0x05, 0x6a, 0x37, 0x5c, 0xca, 0x43, 0xca, 0xeb, 0x21, 0x6f, 0xd6, 0x3b,
-	0xaf, 0xc9, 0xff, 0x99, 0x8d, 0x79, 0xbd, 0xe1, 0xa8, 0xaf, 0xba, 0xfe, 0x30, 0x5b, 0xb5, 0x8c,
-	0xae, 0xbb, 0x75, 0x46, 0xe2, 0xb6, 0x20, 0x2c, 0x39, 0x96, 0xdb, 0x3b, 0xa6, 0xec, 0x28, 0xdd,
-	0x9a, 0x69, 0xd2, 0x26, 0xd5, 0x70, 0xf2, 0x5f, 0x7a, 0x75, 0xed, 0x6f, 0x4b, 0x30, 0xf9, 0x93,
-	0x58, 0xf8, 0x34, 0xc2, 0xc1, 0x1e, 0x66, 0x38, 0xe4, 0xc8, 0x82, 0xd1, 0x0e, 0x61, 0x12, 0xc4,
-	0x2a, 0xad, 0x0e, 0x6d, 0x8c, 0xd9, 0xc9, 0x27, 0xfa, 0x3e, 0xcc, 0x87, 0xf8, 0xc4, 0xe1, 0x84,
-	0xf9, 0x38, 0xf0, 0x3f, 0x26, 0x9e, 0x13, 0xf2, 0xa6, 0x13, 0x90, 0xa8, 0x29, 0x5a, 0xd6, 0xd5,
-	0xd5, 0xa1, 0x8d, 0x21, 0x7b, 0x2e, 0xc4, 0x27, 0xfb, 0xe9, 0xfe, 0x2e, 0x6f, 0x3e, 0x52, 0xbb,
-	0x08, 0x43, 0x35, 0xf4, 0x23, 0x47, 0xd0, 0xd8, 0x77, 0x9d, 0x63, 0xe2, 0x37, 0x5b, 0xc2, 0x1a,
-	0x92, 0xe8, 0xf7, 0xbf, 0xf7, 0xd9, 0x17, 0x2b, 0x57, 0xfe, 0xf3, 0xc5, 0x4a, 0xbd, 0xe9, 0x8b,
-	0x56, 0xfb, 0x70, 0xd3, 0xa5, 0x61, 0x1d, 0x07, 0x01, 0x65, 0xf8, 0x4e, 0x44, 0x84, 0xbc, 0x42,
-	0xf2, 0xe9, 0xb6, 0xb0, 0x1f, 0xd5, 0x43, 0x2c, 0x5a, 0x9b, 0x0f, 0x88, 0x6b, 0x4f, 0x86, 0x7e,
-	0x74, 0x20, 0xf1, 0x9e, 0x28, 0x38, 0xd4, 0x80, 0x39, 0x46, 0x3e, 0x6a, 0xfb, 0x4c, 0xda, 0xe5,
-	0x47, 0x7e, 0xd8, 0x0e, 0x1d, 0x2e, 0xf0, 0x11, 0xb1, 0x86, 0x95, 0xa2, 0x57, 0x8d, 0xa2, 0x59,
-	0xcd, 0x26, 0xf7, 0x8e, 0x36, 0x7d, 0xaa, 0xe1, 0xb6, 0x23, 0xf1, 0xaf, 0xbf, 0xdf, 0x01, 0x43,
-	0xf3, 0x76, 0x24, 0xfe, 0xf2, 0xf5, 0x27, 0xb7, 0x4b, 0xf6, 0x4c, 0x82, 0xb7, 0xab, 0xe1, 0xf6,
-	0x25, 0x9a, 0x64, 0x81, 0x91, 0x90, 0x76, 0x88, 0x46, 0x77, 0x3c, 0x12, 0xe0, 0x53, 0xe7, 0xd8,
-	0x8f, 0x3c, 0x7a, 0x6c, 0x8d, 0x68, 0x16, 0xb4, 0x80, 0x92, 0x7f, 0x20, 0xb7, 0x9f, 0xa8, 0x5d,
-	0xb4, 0xa1, 0x59, 0x20, 0x31, 0x75, 0x5b, 0x09, 0x6f, 0xa3, 0xea, 0x84, 0xbc, 0xcc, 0x43, 0xb9,
-	0x6c, 0xf8, 0x7a, 0x0a, 0x13, 0x87, 0x44, 0x60, 0x87, 0x44, 0x82, 0xd1, 0xf8, 0xd4, 0xaa, 0x14,
-	0xe3, 0x6a, 0x5c, 0x82, 0x3d, 0xd4, 0x58, 0xe8, 0x67, 0x70, 0x2d, 0x20, 0x98, 0x45, 0x7e, 0xd4,
-	0x74, 0x18, 0x16, 0xc4, 0x1a, 0x2b, 0x06, 0x3e, 0x91, 0xa0, 0xd9, 0x58, 0x10, 0x14, 0x82, 0xf4,
-	0x01, 0xa7, 0xc9, 0xb0, 0xe7, 0x93, 0x48, 0x38, 0xa2, 0xc5, 0x08, 0x6f, 0xd1, 0xc0, 0xb3, 0xa0,
-	0x98, 0x9a, 0x99, 0x10, 0x9f, 0xbc, 0x63, 0x50, 0x0f, 0x12, 0x50, 0x44, 0x00, 0x49, 0x4a, 0xf5,
-	0x53, 0x34, 0x18, 0x76, 0xa5, 0x2f, 0x5b, 0xe3, 0xc5, 0x54, 0xc9, 0x57, 0x52, 0x8f, 0xb7, 0x65,
-	0x00, 0xd1, 0x43, 0x58, 0x91, 0xb7, 0x6a, 0x47, 0x8d, 0x76, 0xd0, 0xf0, 0x83, 0x80, 0x78, 0x8e,
-	0x8e, 0x2e, 0x47, 0xfa, 0x08, 0xe1, 0x82, 0x5b, 0xd7, 0x56, 0x87, 0x36, 0xca, 0xf6, 0x62, 0x88,
-	0x4f, 0xde, 0xeb, 0x4a, 0x3d, 0x51, 0x42, 0xb6, 0x91, 0x41, 0xef, 0xc0, 0x6a, 0x2f, 0x8c, 0x09,
-	0xe0, 0x2e, 0xce, 0xa4, 0xc2, 0x59, 0xca, 0xe3, 0xd8, 0x5a, 0x2a, 0x05, 0xfa, 0x18, 0x96, 0x74,
-	0x2c, 0x31, 0x72, 0x8c, 0x99, 0x67, 0xee, 0xef, 0x87, 0x31, 0x65, 0x02, 0x47, 0x2e, 0xb1, 0xa6,
-	0x8a, 0x31, 0x50, 0x53, 0xe8, 0xb6, 0x02, 0x57, 0x4c, 0x6c, 0xa7, 0xd0, 0xe8, 0x37, 0x25, 0x58,
-	0xcf, 0x29, 0x6f, 0x10, 0xe2, 0x30, 0xd2, 0x21, 0x51, 0x3b, 0x67, 0x42, 0xb5, 0x98, 0x09, 0x2b,
-	0x19, 0x13, 0xb6, 0x08, 0xb1, 0xb5, 0x82, 0x8c, 0x1d, 0x04, 0x50, 0xce, 0x0c, 0x1c, 0xc4, 0x2d,
-	0x6c, 0x4d, 0x17, 0x7c, 0xfa, 0x8c, 0xd6, 0x7b, 0x12, 0x10, 0xb9, 0x30, 0x2d, 0x30, 0x3f, 0xca,
-	0x6b, 0x41, 0xc5, 0xb4, 0x4c, 0x49, 0xc4, 0xac, 0x12, 0xc9, 0x69, 0x07, 0x07, 0xbe, 0x87, 0x05,
-	0x65, 0xdc, 0xe9, 0x70, 0x47, 0x1f, 0x74, 0x62, 0xc2, 0x5c, 0x19, 0x46, 0x5a, 0xbb, 0x75, 0xbd,
-	0x20, 0xa7, 0x5d, 0x1d, 0xef, 0xf3, 0x7b, 0x4a, 0x64, 0x4f, 0x2b, 0xd0, 0xc6, 0xa0, 0x1f, 0xc2,
-	0x82, 0x4a, 0xf1, 0x38, 0x8c, 0x03, 0xc2, 0x1d, 0x41, 0x1d, 0xee, 0xe2, 0x80, 0x38, 0xdc, 0xa5,
-	0x8c, 0x70, 0x6b, 0x46, 0xf9, 0xe6, 0x0d, 0x99, 0xe4, 0xb5, 0xc4, 0x01, 0xdd, 0x97, 0xfb, 0xfb,
-	0x6a, 0x1b, 0xbd, 0x05, 0x35, 0x79, 0x5a, 0xd0, 0xd8, 0xf1, 0xa3, 0x06, 0x61, 0x84, 0x29, 0x08,
-	0x63, 0xfb, 0xac, 0x3a, 0x2c, 0xb3, 0xc3, 0x01, 0x8d, 0xb7, 0xcd, 0xfe, 0x01, 0x35, 0x9a, 0x7f,
-	0x04, 0x4b, 0xc9, 0xd9, 0x06, 0x65, 0xc4, 0xc5, 0x5c, 0xe4, 0x8f, 0xcf, 0xa9, 0xe3, 0xf3, 0xfa,
-	0xf8, 0x56, 0x57, 0x24, 0x45, 0xc8, 0x68, 0x37, 0x41, 0x95, 0x3d, 0x7e, 0x23, 0xab, 0xdd, 0x84,
-	0x53, 0xf7, 0xec, 0x53, 0xa8, 0xba, 0x8c, 0x60, 0x41, 0x4c, 0x89, 0x6a, 0x10, 0x62, 0x59, 0xcf,
-	0x59, 0x36, 0x26, 0x35, 0x92, 0xaa, 0x4d, 0x5b, 0x84, 0xa0, 0x1f, 0x40, 0x2d, 0xcd, 0x86, 0x1e,
-	0xe1, 0xea, 0x39, 0xa5, 0xa1, 0xbe, 0xb4, 0xc0, 0x9a, 0xd7, 0x94, 0x26, 0x12, 0x0f, 0xb4, 0xc0,
-	0x2e, 0x3e, 0xd9, 0x96, 0xdb, 0xe8, 0x03, 0xa8, 0x32, 0xd2, 0xf4, 0xb9, 0x60, 0x58, 0x26, 0x22,
-	0x65, 0xd8, 0xe2, 0x73, 0x1a, 0x36, 0x95, 0x45, 0x92, 0x96, 0xbd, 0x02, 0xc8, 0x23, 0x0d, 0xdc,
-	0x0e, 0x84, 0x13, 0xe3, 0x26, 0x71, 0x02, 0x3f, 0xf4, 0x85, 0xb5, 0xa4, 0x2c, 0xaa, 0x9a, 0x9d,
-	0x3d, 0xdc, 0x24, 0x8f, 0xe4, 0x3a, 0xba, 0x05, 0x93, 0xd2, 0xec, 0x8c, 0xe4, 0xb2, 0x92, 0x9c,
-	0x08, 0xf1, 0x49, 0x57, 0x4a, 0xbe, 0x63, 0x4f, 0x8d, 0x73, 0x18, 0x71, 0x29, 0xf3, 0xcc, 0xa1,
-	0x15, 0x55, 0xf0, 0xe6, 0xf3, 0x05, 0xcf, 0x56, 0x12, 0x1a, 0x61, 0x03, 0xaa, 0x87, 0x01, 0x75,
-	0x8f, 0xb8, 0x74, 0x7e, 0x27, 0xa4, 0x91, 0x68, 0x59, 0xab, 0x4a, 0xd3, 0xa4, 0x5e, 0xdf, 0x23,
-	0x6c, 0x57, 0xae, 0xca, 0x0c, 0x10, 0x27, 0x71, 0xa9, 0x1d, 0x4e, 0xe6, 0x9d, 0x9b, 0x05, 0x33,
-	0x40, 0xac, 0x7d, 0x62, 0x3b, 0x01, 0x94, 0x19, 0x20, 0x55, 0x93, 0xf8, 0xa6, 0xb5, 0x56, 0x30,
-	0x03, 0x18, 0x2d, 0x89, 0x23, 0xcb, 0x0e, 0x29, 0x55, 0x62, 0xdc, 0xd7, 0x5a, 0x2f, 0xd8, 0x21,
-	0x19, 0x1d, 0xc6, 0xdb, 0x25, 0x5d, 0xee, 0x59, 0xba, 0x6e, 0x15, 0xa4, 0xcb, 0xed, 0x43, 0x97,
-	0x7b, 0x86, 0xae, 0x17, 0x0a, 0xd2, 0xe5, 0xf6, 0xd0, 0xf5, 0x18, 0x46, 0x5c, 0x27, 0xa2, 0x2c,
-	0xb4, 0x5e, 0x2c, 0x86, 0x3c, 0xec, 0x3e, 0xa6, 0x2c, 0x44, 0x1f, 0xc2, 0x14, 0x89, 0xb9, 0x1f,
-	0xd0, 0x28, 0x65, 0x7f, 0xa3, 0x20, 0xfb, 0x06, 0x2f, 0x61, 0xff, 0x7d, 0x78, 0xb9, 0x85, 0x83,
-	0x86, 0x0a, 0xfd, 0x98, 0x51, 0x97, 0x70, 0x6e, 0xca, 0xb6, 0xea, 0x16, 0x71, 0xc0, 0x1d, 0x12,
-	0x79, 0x8e, 0x72, 0x71, 0xeb, 0xb6, 0xf2, 0xf7, 0x75, 0x79, 0x60, 0x17, 0x9f, 0xec, 0x69, 0x71,
-	0x55, 0x88, 0x6d, 0x23, 0xfc, 0x30, 0xf2, 0xee, 0x4b, 0x51, 0x99, 0xba, 0x3c, 0x2c, 0xb0, 0xc3,
-	0x49, 0xe4, 0xc9, 0x96, 0x4e, 0x66, 0x88, 0xef, 0x3c, 0x6f, 0xea, 0x92, 0x48, 0xfb, 0x1a, 0x48,
-	0x26, 0x08, 0x0c, 0xd5, 0x84, 0x15, 0x8e, 0x1b, 0xc4, 0xf1, 0xfc, 0x8e, 0xf5, 0xca, 0xe5, 0xd0,
-	0xb2, 0x8f, 0x1b, 0xe4, 0x81, 0xdf, 0x49, 0x86, 0x0a, 0x12, 0x90, 0x90, 0x44, 0x42, 0xc7, 0x7c,
-	0xea, 0x35, 0x77, 0xd2, 0xa4, 0xfd, 0xd0, 0xec, 0xef, 0x11, 0x96, 0xfa, 0x80, 0x29, 0x56, 0xb2,
-	0x45, 0xeb, 0x98, 0xc4, 0xad, 0xcf, 0x6b, 0x0e, 0x37, 0xd3, 0x62, 0x75, 0x4f, 0x49, 0xa8, 0x84,
-	0x2c, 0x01, 0x34, 0x6f, 0xb7, 0x61, 0x5a, 0x95, 0x3a, 0xc1, 0x24, 0x6b, 0xa6, 0x1b, 0xaf, 0xab,
-	0x33, 0x53, 0xb2, 0xc0, 0xa9, 0x75, 0xd3, 0x8e, 0x53, 0xb8, 0xe1, 0x47, 0xbe, 0xf0, 0x71, 0xe0,
-	0x30, 0xd2, 0x64, 0x44, 0x38, 0x1f, 0xb5, 0x71, 0x24, 0xfc, 0x80, 0x58, 0xaf, 0x16, 0xa3, 0x63,
-	0xd6, 0xe0, 0xda, 0x0a, 0xf6, 0x5d, 0x83, 0x8a, 0x7e, 0x0e, 0x53, 0xb1, 0x72, 0xef, 0x2e, 0xef,
-	0xaf, 0x15, 0xec, 0xd2, 0x63, 0xe9, 0xe7, 0x86, 0xf5, 0x9d, 0x72, 0xa5, 0x5c, 0x1d, 0xde, 0x29,
-	0x57, 0x6a, 0xd5, 0x85, 0x9d, 0x72, 0x65, 0xa1, 0xba, 0xb8, 0x53, 0xae, 0xbc, 0x54, 0xdd, 0xd8,
-	0x29, 0x57, 0x5e, 0xae, 0xde, 0x56, 0xad, 0xf6, 0x19, 0x3e, 0xed, 0x05, 0x95, 0xd7, 0x1b, 0x0d,
-	0x92, 0xe1, 0x3b, 0xe9, 0xfb, 0xec, 0x75, 0x79, 0x84, 0x11, 0xc1, 0x7c, 0xdd, 0x36, 0xe8, 0xce,
-	0xd5, 0x89, 0x68, 0xe4, 0x12, 0x6e, 0x7a, 0x65, 0x7b, 0x31, 0x2d, 0xae, 0x69, 0xbf, 0xe8, 0x11,
-	0x17, 0x9f, 0xaa, 0x31, 0xc4, 0xbe, 0x75, 0x2e, 0x84, 0x89, 0xca, 0xb5, 0x18, 0xae, 0xbf, 0x17,
-	0x7b, 0x58, 0x10, 0x3d, 0xae, 0x9a, 0x8e, 0x18, 0xcd, 0xc1, 0x88, 0x0c, 0x00, 0xc2, 0xac, 0xd2,
-	0x6a, 0x69, 0x63, 0xcc, 0x36, 0x5f, 0xe8, 0xbb, 0x30, 0x12, 0x2b, 0x41, 0xeb, 0xea, 0x6a, 0x69,
-	0x63, 0xfc, 0xf5, 0xc5, 0xcd, 0xec, 0x4c, 0xbe, 0x99, 0x9f, 0x7d, 0x6d, 0x23, 0xfb, 0xd6, 0xf8,
-	0xaf, 0xbf, 0xfe, 0xe4, 0xb6, 0x81, 0x58, 0x9b, 0x83, 0x99, 0xbc, 0x46, 0x1e, 0xd3, 0x88, 0x93,
-	0xb5, 0x7f, 0x56, 0x60, 0xf6, 0x6d, 0x55, 0xea, 0x1f, 0x93, 0xe3, 0x03, 0xdd, 0x36, 0x6a, 0x63,
-	0x2c, 0x18, 0x55, 0x3d, 0x00, 0x4d, 0xac, 0x49, 0x3e, 0x51, 0x0d, 0x2a, 0x21, 0x11, 0x58, 0x86,
-	0x99, 0x32, 0x68, 0xcc, 0x4e, 0xbf, 0xd1, 0x0a, 0x8c, 0x07, 0x94, 0x73, 0x27, 0x24, 0xa2, 0x45,
-	0x3d, 0xab, 0xac, 0xb6, 0x41, 0x2e, 0xed, 0xaa, 0x15, 0x74, 0x13, 0x26, 0x7a, 0x46, 0xc7, 0xd2,
-	0xc6, 0x90, 0x3d, 0x4e, 0x32, 0x73, 0xe3, 0x06, 0x54, 0x9b, 0x8c, 0xb6, 0x23, 0xcf, 0x11, 0xac,
-	0x2d, 0x5a, 0x4e, 0x80, 0x9b, 0x56, 0x45, 0x89, 0x4d, 0xea, 0xf5, 0x03, 0xb9, 0xfc, 0x08, 0x37,
-	0x65, 0x02, 0xd5, 0x1e, 0x66, 0x81, 0x54, 0x54, 0x20, 0x81, 0x2a, 0xc7, 0x92, 0x13, 0xab, 0x6a,
-	0x8d, 0x4d, 0x80, 0x58, 0xe3, 0xc5, 0x50, 0xc7, 0x15, 0x98, 0x8e, 0x0a, 0xf4, 0x02, 0x4c, 0x4a,
-	0xa9, 0x63, 0x27, 0x22, 0x4d, 0x2c, 0x9d, 0xcf, 0x9a, 0x58, 0x2d, 0x6d, 0x54, 0xec, 0x6b, 0x6a,
-	0xf5, 0xb1, 0x59, 0x44, 0xef, 0xc2, 0xa8, 0x49, 0x2e, 0xd6, 0xb5, 0x62, 0xda, 0x13, 0x1c, 0xf4,
-	0x26, 0x58, 0x66, 0xce, 0xe3, 0xed, 0x43, 0xe3, 0x38, 0xc9, 0xac, 0x3f, 0xa9, 0x78, 0x9d, 0xd3,
-	0xfb, 0xfb, 0xe9, 0xb6, 0x99, 0xf5, 0x8f, 0x60, 0x36, 0x24, 0xcc, 0x17, 0x0e, 0xa7, 0x4c, 0xf8,
-	0xaa, 0x77, 0xd3, 0xa3, 0xc3, 0x54, 0x31, 0xd3, 0xae, 0x2b, 0xd4, 0xfd, 0x04, 0x54, 0x8f, 0x0f,
-	0x14, 0x6e, 0x98, 0x2c, 0x68, 0xfa, 0xee, 0x6e, 0x7e, 0xaa, 0x16, 0x53, 0x37, 0xab, 0x71, 0x4d,
-	0xbb, 0x9e, 0xe6, 0xa7, 0x36, 0xd4, 0x8c, 0xc2, 0x6e, 0xb3, 0xde, 0xd5, 0x39, 0x5d, 0x4c, 0xa7,
-	0xa5, 0xa1, 0xbb, 0x3d, 0x7e, 0xaa, 0xb6, 0x7b, 0xcf, 0x64, 0x6c, 0x4e, 0x75, 0xa2, 0x4b, 0xb9,
-	0xa7, 0x29, 0xd6, 0x89, 0xc2, 0xb7, 0x26, 0x64, 0x22, 0x48, 0xa2, 0x77, 0xa7, 0x5c, 0x19, 0xaa,
-	0x96, 0x77, 0xca, 0x95, 0xe1, 0xea, 0xc8, 0x4e, 0xb9, 0x32, 0x52, 0x1d, 0xdd, 0x29, 0x57, 0xc6,
-	0xaa, 0xa0, 0x83, 0xd4, 0x09, 0x68, 0xd3, 0x77, 0xed, 0xa9, 0xb4, 0xb3, 0x32, 0x0b, 0xd5, 0xee,
-	0x82, 0x8e, 0x6c, 0x7b, 0x3c, 0x69, 0xba, 0x31, 0x6b, 0xae, 0xdd, 0x85, 0xb9, 0xde, 0x24, 0xa2,
-	0xf3, 0x0b, 0x9a, 0x87, 0x8a, 0xce, 0x97, 0xbe, 0xa7, 0xd2, 0x48, 0xd9, 0x1e, 0x55, 0xdf, 0xdb,
-	0xde, 0xda, 0xef, 0x4b, 0xb0, 0xb0, 0x1d, 0x71, 0xc2, 0x84, 0x31, 0x78, 0x0f, 0x9f, 0x06, 0x14,
-	0x7b, 0xcf, 0xca, 0x86, 0x36, 0xcc, 0x24, 0xc4, 0x75, 0x70, 0xd0, 0x26, 0xce, 0x61, 0x3b, 0xf2,
-	0x02, 0x62, 0x72, 0xe3, 0x6a, 0x36, 0x37, 0xde, 0xdd, 0x34, 0xd0, 0xef, 0x4b, 0xc1, 0xfb, 0x4a,
-	0xce, 0x46, 0xec, 0xcc, 0x5a, 0x3e, 0x57, 0x2e, 0xc3, 0x62, 0x7f, 0xbb, 0x4c, 0xce, 0xfc, 0x6d,
-	0x09, 0x6a, 0x5a, 0x40, 0xff, 0x32, 0x72, 0x41, 0xbb, 0x1f, 0x01, 0x32, 0x61, 0xa8, 0x5a, 0x9d,
-	0x9c, 0xd5, 0xcb, 0x79, 0xab, 0x35, 0xee, 0x03, 0x2c, 0xb0, 0xb1, 0xb9, 0x7a, 0xdc, 0xb3, 0x92,
-	0xb7, 0x78, 0x29, 0x61, 0xb2, 0xc7, 0x20, 0x63, 0xf0, 0x5f, 0x4b, 0x30, 0x65, 0xab, 0xb1, 0x29,
-	0xfd, 0xf5, 0x65, 0xa0, 0x95, 0xd9, 0x07, 0x2b, 0xe7, 0x1e, 0x0c, 0xcd, 0xc0, 0x30, 0x3d, 0x8e,
-	0x08, 0xb3, 0x86, 0xd5, 0x09, 0xfd, 0x81, 0x96, 0x00, 0xfc, 0xb4, 0xb2, 0x59, 0x23, 0x2a, 0xa7,
-	0x8d, 0xf9, 0xdc, 0x70, 0x97, 0xb3, 0x73, 0xa7, 0x5c, 0xb9, 0x5a, 0x1d, 0xd2, 0x1e, 0x68, 0x8f,
-	0x07, 0xfe, 0xa1, 0x13, 0xbf, 0x1e, 0x3b, 0x47, 0xe4, 0xd4, 0xbe, 0x16, 0xb6, 0x03, 0xe1, 0x3b,
-	0xd8, 0xf3, 0x18, 0xe1, 0x7c, 0x6d, 0x0b, 0xaa, 0x5d, 0x7b, 0x8d, 0x27, 0x59, 0x30, 0xca, 0xdb,
-	0xae, 0x6c, 0x21, 0x95, 0xc5, 0x15, 0x3b, 0xf9, 0x94, 0x3b, 0x21, 0xe1, 0x1c, 0x37, 0x89, 0x29,
-	0x47, 0xc9, 0xe7, 0xda, 0xc7, 0x30, 0xaf, 0x5a, 0x4d, 0x62, 0x67, 0x86, 0xc6, 0x6f, 0xc2, 0xc0,
-	0xd5, 0x3c, 0x03, 0xf9, 0xbb, 0x0e, 0x9d, 0x77, 0xd7, 0xb5, 0x3d, 0xa8, 0xf5, 0xd3, 0x5d, 0xe0,
-	0x36, 0x7f, 0x2c, 0xc1, 0xd4, 0x3d, 0xcf, 0x33, 0x1d, 0xf4, 0x73, 0x5f, 0xe2, 0xc7, 0x30, 0x82,
-	0x43, 0xda, 0x8e, 0x84, 0xba, 0xc0, 0xf3, 0x74, 0xd8, 0xe6, 0x7c, 0xfe, 0xbe, 0x08, 0xaa, 0x5d,
-	0xe3, 0x8c, 0xe3, 0xfd, 0xb9, 0x04, 0xc8, 0xee, 0xfe, 0x8c, 0xfc, 0xed, 0x33, 0x7a, 0x16, 0xae,
-	0xe7, 0xec, 0x33, 0x76, 0x3f, 0x05, 0xeb, 0x6d, 0x1c, 0xb9, 0x24, 0xb8, 0x14, 0xe3, 0xf3, 0x2a,
-	0x17, 0x60, 0xbe, 0x0f, 0xb6, 0x51, 0xfc, 0x8f, 0x12, 0xcc, 0x3c, 0x20, 0x81, 0x6c, 0x06, 0x0a,
-	0x53, 0x66, 0xc1, 0x68, 0xd6, 0x53, 0xc7, 0xec, 0xe4, 0x33, 0x43, 0x66, 0xf9, 0x32, 0xc9, 0xbc,
-	0x01, 0xb3, 0x3d, 0xb6, 0x9b, 0x5b, 0x7d, 0x5a, 0x4a, 0x62, 0xe1, 0x1b, 0xdd, 0x2d, 0x73, 0x81,
-	0xab, 0xf9, 0x0b, 0x64, 0x6f, 0x3d, 0x34, 0xc8, 0x51, 0x2e, 0xf5, 0x6e, 0x4b, 0xb0, 0xd0, 0xf7,
-	0x06, 0xe6, 0x86, 0x7f, 0x28, 0xc1, 0x6a, 0xf6, 0x55, 0x2f, 0xeb, 0x0d, 0x17, 0x61, 0xcc, 0xd3,
-	0x50, 0x34, 0x79, 0xc5, 0xee, 0x42, 0x96, 0xa0, 0x72, 0x8e, 0xa0, 0xbc, 0xed, 0xeb, 0x70, 0xf3,
-	0x1c, 0xdb, 0xcc, 0x0d, 0xfe, 0x54, 0x82, 0xea, 0x96, 0xec, 0xad, 0xb3, 0x33, 0xc0, 0xb7, 0x27,
-	0x50, 0xaf, 0xc3, 0x74, 0xc6, 0x3a, 0x63, 0xf3, 0x07, 0x50, 0xbb, 0xe7, 0x79, 0x07, 0xf4, 0x49,
-	0xcb, 0x17, 0x24, 0xf0, 0xb9, 0xb8, 0xe7, 0x85, 0x7e, 0x74, 0x01, 0xb7, 0x32, 0x75, 0x26, 0x71,
-	0x2b, 0xf3, 0x79, 0xe6, 0xc5, 0xfb, 0x82, 0x1b, 0xdd, 0x1f, 0xc2, 0x8a, 0xa6, 0x73, 0x8b, 0xd1,
-	0xf0, 0xff, 0x62, 0xc0, 0x1a, 0xac, 0x0e, 0xd6, 0x60, 0xac, 0x50, 0x6e, 0x79, 0x8c, 0x99, 0xd7,
-	0xff, 0x51, 0x3b, 0x32, 0xee, 0xfa, 0x6c, 0x5f, 0x7e, 0x4e, 0xc9, 0x99, 0xfe, 0xfa, 0xa7, 0x13,
-	0x00, 0xbb, 0xbc, 0xb9, 0x4f, 0x58, 0xc7, 0x77, 0x09, 0x7a, 0x0f, 0x26, 0xb2, 0xc3, 0x27, 0xba,
-	0x99, 0x9f, 0x5f, 0xfb, 0x8c, 0xc2, 0xb5, 0xb5, 0xf3, 0x44, 0x4c, 0x0d, 0xfd, 0x00, 0x26, 0xf3,
-	0x5d, 0x27, 0x5a, 0xcf, 0x9f, 0xea, 0x3b, 0xd8, 0xd6, 0x6e, 0x9d, 0x2f, 0x64, 0xc0, 0xb7, 0xa1,
-	0x92, 0xb4, 0x20, 0x68, 0x29, 0x7f, 0xa2, 0xa7, 0x95, 0xaa, 0x2d, 0x0f, 0xda, 0x36, 0x50, 0xcd,
-	0xa4, 0x08, 0x66, 0x3b, 0x01, 0xf4, 0x52, 0xef, 0xa9, 0x01, 0x7d, 0x4a, 0x6d, 0xe3, 0xd9, 0x82,
-	0x5d, 0x9b, 0x93, 0x12, 0xdc, 0x6b, 0x73, 0x4f, 0xdf, 0xd0, 0x6b, 0x73, 0x6f, 0xe5, 0x46, 0x36,
-	0x8c, 0x67, 0xea, 0x13, 0x5a, 0xed, 0x67, 0x43, 0x0e, 0xf0, 0xe6, 0x39, 0x12, 0x06, 0xd3, 0x83,
-	0xe9, 0x33, 0x95, 0x0f, 0xbd, 0xd8, 0xf3, 0x1a, 0x03, 0xca, 0x6e, 0xed, 0xa5, 0x67, 0xca, 0x19,
-	0x2d, 0x3f, 0x85, 0x6b, 0x39, 0x6f, 0x47, 0x3d, 0xae, 0xd4, 0x2f, 0x14, 0x6a, 0xeb, 0xe7, 0xca,
-	0x18, 0xe4, 0x5f, 0xc8, 0x66, 0xe1, 0x4c, 0x34, 0xa1, 0x33, 0xef, 0x33, 0x28, 0xe0, 0x6a, 0x2f,
-	0x5f, 0x40, 0x32, 0xab, 0xeb, 0x4c, 0xb6, 0x46, 0x7d, 0x7d, 0xe1, 0x62, 0xba, 0x06, 0xa6, 0x7e,
-	0xf4, 0xcb, 0x7c, 0x47, 0x92, 0xd7, 0xb8, 0x39, 0x98, 0xf7, 0xbe, 0x7a, 0xeb, 0x17, 0x96, 0x37,
-	0xda, 0x1f, 0xc1, 0x58, 0x9a, 0xd9, 0x51, 0x8f, 0x5b, 0xf6, 0x16, 0xa4, 0xda, 0xca, 0xc0, 0xfd,
-	0x2e, 0x6f, 0x7d, 0xb2, 0x76, 0x2f, 0x6f, 0x83, 0xab, 0x46, 0x2f, 0x6f, 0xe7, 0x94, 0x00, 0x74,
-	0x0a, 0xd6, 0xa0, 0x04, 0x8d, 0xee, 0xf4, 0xa3, 0x7f, 0x60, 0xa9, 0xa8, 0x6d, 0x5e, 0x54, 0xbc,
-	0x7b, 0xcd, 0x3e, 0x03, 0x5f, 0xef, 0x35, 0x07, 0x0f, 0xa9, 0xbd, 0xd7, 0x3c, 0x67, 0x7a, 0x44,
-	0x21, 0xcc, 0xf4, 0x1b, 0x87, 0x51, 0x5f, 0x88, 0xbe, 0xa3, 0x7c, 0xed, 0xf6, 0x45, 0x44, 0xb5,
-	0xba, 0xda, 0xf0, 0xaf, 0x64, 0x17, 0x70, 0xdf, 0xfe, 0xec, 0xcb, 0xe5, 0xd2, 0xe7, 0x5f, 0x2e,
-	0x97, 0xfe, 0xfb, 0xe5, 0x72, 0xe9, 0x77, 0x5f, 0x2d, 0x5f, 0xf9, 0xfc, 0xab, 0xe5, 0x2b, 0xff,
-	0xfe, 0x6a, 0xf9, 0xca, 0xd3, 0x37, 0x2f, 0xf8, 0xb3, 0xc8, 0x49, 0xbd, 0xfb, 0x87, 0x44, 0xe2,
-	0x34, 0x26, 0xfc, 0x70, 0x44, 0xfd, 0xbd, 0xd0, 0xdd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x97,
-	0x13, 0x33, 0x9c, 0xea, 0x24, 0x00, 0x00,
+func (m *AddStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStakeRequest.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgServiceClient is the client API for MsgService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgServiceClient interface {
-	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
-	CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error)
-	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
-	RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error)
-	AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error)
-	RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error)
-	CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error)
-	DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error)
-	RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error)
-	RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error)
-	FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error)
-	AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error)
-	InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error)
-	InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error)
+func (m *AddStakeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type msgServiceClient struct {
-	cc grpc1.ClientConn
+func (m *AddStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStakeRequest.DiscardUnknown(m)
 }
 
-func NewMsgServiceClient(cc grpc1.ClientConn) MsgServiceClient {
-	return &msgServiceClient{cc}
-}
+var xxx_messageInfo_AddStakeRequest proto.InternalMessageInfo
 
-func (c *msgServiceClient) UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error) {
-	out := new(UpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgServiceClient) CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error) {
-	out := new(CreateNewTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CreateNewTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
-	out := new(RegisterResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/Register", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddStakeResponse struct {
 }
 
-func (c *msgServiceClient) RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error) {
-	out := new(RemoveRegistrationResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveRegistration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddStakeResponse) Reset()         { *m = AddStakeResponse{} }
+func (m *AddStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*AddStakeResponse) ProtoMessage()    {}
+func (*AddStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{33}
 }
-
-func (c *msgServiceClient) AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error) {
-	out := new(AddStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgServiceClient) RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error) {
-	out := new(RemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgServiceClient) CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error) {
-	out := new(CancelRemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddStakeResponse.Merge(m, src)
 }
-
-func (c *msgServiceClient) DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error) {
-	out := new(DelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/DelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddStakeResponse.DiscardUnknown(m)
 }
 
-func (c *msgServiceClient) RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error) {
-	out := new(RewardDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RewardDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_AddStakeResponse proto.InternalMessageInfo
+
+type RemoveStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (c *msgServiceClient) RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error) {
-	out := new(RemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveStakeRequest) Reset()         { *m = RemoveStakeRequest{} }
+func (m *RemoveStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveStakeRequest) ProtoMessage()    {}
+func (*RemoveStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{34}
+}
+func (m *RemoveStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RemoveStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveStakeRequest.Merge(m, src)
+}
+func (m *RemoveStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveStakeRequest.DiscardUnknown(m)
 }
 
-func (c *msgServiceClient) CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error) {
-	out := new(CancelRemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveStakeRequest proto.InternalMessageInfo
+
+func (m *RemoveStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgServiceClient) FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error) {
-	out := new(FundTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/FundTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgServiceClient) AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error) {
-	out := new(AddToWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddToWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RemoveStakeResponse struct {
 }
 
-func (c *msgServiceClient) RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error) {
-	out := new(RemoveFromWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveFromWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveStakeResponse) Reset()         { *m = RemoveStakeResponse{} }
+func (m *RemoveStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveStakeResponse) ProtoMessage()    {}
+func (*RemoveStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{35}
 }
-
-func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error) {
-	out := new(InsertWorkerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertWorkerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error) {
-	out := new(InsertReputerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertReputerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServiceServer is the server API for MsgService service.
-type MsgServiceServer interface {
-	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
-	CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error)
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error)
-	AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error)
-	RemoveStake(context.Context, *RemoveStakeRequest) (*RemoveStakeResponse, error)
-	CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error)
-	DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error)
-	RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error)
-	RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error)
-	FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error)
-	AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error)
-	InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error)
-	InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error)
+func (m *RemoveStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveStakeResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServiceServer struct {
+func (m *RemoveStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveStakeResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *UpdateParamsRequest) (*UpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_RemoveStakeResponse proto.InternalMessageInfo
+
+type CancelRemoveStakeRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
-func (*UnimplementedMsgServiceServer) CreateNewTopic(ctx context.Context, req *CreateNewTopicRequest) (*CreateNewTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
+
+func (m *CancelRemoveStakeRequest) Reset()         { *m = CancelRemoveStakeRequest{} }
+func (m *CancelRemoveStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveStakeRequest) ProtoMessage()    {}
+func (*CancelRemoveStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{36}
 }
-func (*UnimplementedMsgServiceServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (m *CancelRemoveStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServiceServer) RemoveRegistration(ctx context.Context, req *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
+func (m *CancelRemoveStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServiceServer) AddStake(ctx context.Context, req *AddStakeRequest) (*AddStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
+func (m *CancelRemoveStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveStakeRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServiceServer) RemoveStake(ctx context.Context, req *RemoveStakeRequest) (*RemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
+func (m *CancelRemoveStakeRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServiceServer) CancelRemoveStake(ctx context.Context, req *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
+func (m *CancelRemoveStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveStakeRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServiceServer) DelegateStake(ctx context.Context, req *DelegateStakeRequest) (*DelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
+
+var xxx_messageInfo_CancelRemoveStakeRequest proto.InternalMessageInfo
+
+func (m *CancelRemoveStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
-func (*UnimplementedMsgServiceServer) RewardDelegateStake(ctx context.Context, req *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
+
+func (m *CancelRemoveStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
-func (*UnimplementedMsgServiceServer) RemoveDelegateStake(ctx context.Context, req *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+
+type CancelRemoveStakeResponse struct {
 }
-func (*UnimplementedMsgServiceServer) CancelRemoveDelegateStake(ctx context.Context, req *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+
+func (m *CancelRemoveStakeResponse) Reset()         { *m = CancelRemoveStakeResponse{} }
+func (m *CancelRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveStakeResponse) ProtoMessage()    {}
+func (*CancelRemoveStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{37}
 }
-func (*UnimplementedMsgServiceServer) FundTopic(ctx context.Context, req *FundTopicRequest) (*FundTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+func (m *CancelRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServiceServer) AddToWhitelistAdmin(ctx context.Context, req *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+func (m *CancelRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServiceServer) RemoveFromWhitelistAdmin(ctx context.Context, req *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+func (m *CancelRemoveStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveStakeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServiceServer) InsertWorkerPayload(ctx context.Context, req *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
+func (m *CancelRemoveStakeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServiceServer) InsertReputerPayload(ctx context.Context, req *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
+func (m *CancelRemoveStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveStakeResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
-	s.RegisterService(&_MsgService_serviceDesc, srv)
+var xxx_messageInfo_CancelRemoveStakeResponse proto.InternalMessageInfo
+
+// Bonds an amount for the sender to work in a topic
+type AddWorkerBondRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*UpdateParamsRequest))
+func (m *AddWorkerBondRequest) Reset()         { *m = AddWorkerBondRequest{} }
+func (m *AddWorkerBondRequest) String() string { return proto.CompactTextString(m) }
+func (*AddWorkerBondRequest) ProtoMessage()    {}
+func (*AddWorkerBondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{38}
+}
+func (m *AddWorkerBondRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddWorkerBondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddWorkerBondRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddWorkerBondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWorkerBondRequest.Merge(m, src)
+}
+func (m *AddWorkerBondRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddWorkerBondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWorkerBondRequest.DiscardUnknown(m)
 }
 
-func _MsgService_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNewTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).CreateNewTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/CreateNewTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).CreateNewTopic(ctx, req.(*CreateNewTopicRequest))
+var xxx_messageInfo_AddWorkerBondRequest proto.InternalMessageInfo
+
+func (m *AddWorkerBondRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).Register(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/Register",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).Register(ctx, req.(*RegisterRequest))
+func (m *AddWorkerBondRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRegistrationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveRegistration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveRegistration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveRegistration(ctx, req.(*RemoveRegistrationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AddWorkerBondResponse struct {
 }
 
-func _MsgService_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).AddStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/AddStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).AddStake(ctx, req.(*AddStakeRequest))
+func (m *AddWorkerBondResponse) Reset()         { *m = AddWorkerBondResponse{} }
+func (m *AddWorkerBondResponse) String() string { return proto.CompactTextString(m) }
+func (*AddWorkerBondResponse) ProtoMessage()    {}
+func (*AddWorkerBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{39}
+}
+func (m *AddWorkerBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddWorkerBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddWorkerBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddWorkerBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWorkerBondResponse.Merge(m, src)
+}
+func (m *AddWorkerBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddWorkerBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWorkerBondResponse.DiscardUnknown(m)
 }
 
-func _MsgService_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveStake(ctx, req.(*RemoveStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_AddWorkerBondResponse proto.InternalMessageInfo
+
+// Starts the unbonding of an amount of the bond of the sender in a topic,
+// which is returned after the stake removal delay
+type RemoveWorkerBondRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func _MsgService_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelRemoveStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).CancelRemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/CancelRemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).CancelRemoveStake(ctx, req.(*CancelRemoveStakeRequest))
+func (m *RemoveWorkerBondRequest) Reset()         { *m = RemoveWorkerBondRequest{} }
+func (m *RemoveWorkerBondRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveWorkerBondRequest) ProtoMessage()    {}
+func (*RemoveWorkerBondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{40}
+}
+func (m *RemoveWorkerBondRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveWorkerBondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveWorkerBondRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemoveWorkerBondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveWorkerBondRequest.Merge(m, src)
+}
+func (m *RemoveWorkerBondRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveWorkerBondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveWorkerBondRequest.DiscardUnknown(m)
 }
 
-func _MsgService_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).DelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/DelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).DelegateStake(ctx, req.(*DelegateStakeRequest))
+var xxx_messageInfo_RemoveWorkerBondRequest proto.InternalMessageInfo
+
+func (m *RemoveWorkerBondRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RewardDelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RewardDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RewardDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RewardDelegateStake(ctx, req.(*RewardDelegateStakeRequest))
+func (m *RemoveWorkerBondRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MsgService_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveDelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, req.(*RemoveDelegateStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type RemoveWorkerBondResponse struct {
 }
 
-func _MsgService_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelRemoveDelegateStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/CancelRemoveDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, req.(*CancelRemoveDelegateStakeRequest))
+func (m *RemoveWorkerBondResponse) Reset()         { *m = RemoveWorkerBondResponse{} }
+func (m *RemoveWorkerBondResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveWorkerBondResponse) ProtoMessage()    {}
+func (*RemoveWorkerBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{41}
+}
+func (m *RemoveWorkerBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveWorkerBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveWorkerBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemoveWorkerBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveWorkerBondResponse.Merge(m, src)
+}
+func (m *RemoveWorkerBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveWorkerBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveWorkerBondResponse.DiscardUnknown(m)
 }
 
-func _MsgService_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FundTopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).FundTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/FundTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).FundTopic(ctx, req.(*FundTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_RemoveWorkerBondResponse proto.InternalMessageInfo
+
+type CancelRemoveWorkerBondRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func _MsgService_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToWhitelistAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/AddToWhitelistAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, req.(*AddToWhitelistAdminRequest))
+func (m *CancelRemoveWorkerBondRequest) Reset()         { *m = CancelRemoveWorkerBondRequest{} }
+func (m *CancelRemoveWorkerBondRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveWorkerBondRequest) ProtoMessage()    {}
+func (*CancelRemoveWorkerBondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{42}
+}
+func (m *CancelRemoveWorkerBondRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRemoveWorkerBondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveWorkerBondRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CancelRemoveWorkerBondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveWorkerBondRequest.Merge(m, src)
+}
+func (m *CancelRemoveWorkerBondRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRemoveWorkerBondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveWorkerBondRequest.DiscardUnknown(m)
 }
 
-func _MsgService_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveFromWhitelistAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/RemoveFromWhitelistAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, req.(*RemoveFromWhitelistAdminRequest))
+var xxx_messageInfo_CancelRemoveWorkerBondRequest proto.InternalMessageInfo
+
+func (m *CancelRemoveWorkerBondRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertWorkerPayloadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/InsertWorkerPayload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, req.(*InsertWorkerPayloadRequest))
+func (m *CancelRemoveWorkerBondRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertReputerPayloadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServiceServer).InsertReputerPayload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v5.MsgService/InsertReputerPayload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServiceServer).InsertReputerPayload(ctx, req.(*InsertReputerPayloadRequest))
+type CancelRemoveWorkerBondResponse struct {
+}
+
+func (m *CancelRemoveWorkerBondResponse) Reset()         { *m = CancelRemoveWorkerBondResponse{} }
+func (m *CancelRemoveWorkerBondResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveWorkerBondResponse) ProtoMessage()    {}
+func (*CancelRemoveWorkerBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{43}
+}
+func (m *CancelRemoveWorkerBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRemoveWorkerBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveWorkerBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CancelRemoveWorkerBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveWorkerBondResponse.Merge(m, src)
+}
+func (m *CancelRemoveWorkerBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRemoveWorkerBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveWorkerBondResponse.DiscardUnknown(m)
 }
 
-var MsgService_serviceDesc = _MsgService_serviceDesc
-var _MsgService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "emissions.v5.MsgService",
-	HandlerType: (*MsgServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _MsgService_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateNewTopic",
-			Handler:    _MsgService_CreateNewTopic_Handler,
-		},
-		{
-			MethodName: "Register",
-			Handler:    _MsgService_Register_Handler,
-		},
-		{
-			MethodName: "RemoveRegistration",
-			Handler:    _MsgService_RemoveRegistration_Handler,
-		},
-		{
-			MethodName: "AddStake",
-			Handler:    _MsgService_AddStake_Handler,
-		},
-		{
-			MethodName: "RemoveStake",
-			Handler:    _MsgService_RemoveStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveStake",
-			Handler:    _MsgService_CancelRemoveStake_Handler,
-		},
-		{
-			MethodName: "DelegateStake",
-			Handler:    _MsgService_DelegateStake_Handler,
-		},
-		{
-			MethodName: "RewardDelegateStake",
-			Handler:    _MsgService_RewardDelegateStake_Handler,
-		},
-		{
-			MethodName: "RemoveDelegateStake",
-			Handler:    _MsgService_RemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveDelegateStake",
-			Handler:    _MsgService_CancelRemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "FundTopic",
-			Handler:    _MsgService_FundTopic_Handler,
-		},
-		{
-			MethodName: "AddToWhitelistAdmin",
-			Handler:    _MsgService_AddToWhitelistAdmin_Handler,
-		},
-		{
-			MethodName: "RemoveFromWhitelistAdmin",
-			Handler:    _MsgService_RemoveFromWhitelistAdmin_Handler,
-		},
-		{
-			MethodName: "InsertWorkerPayload",
-			Handler:    _MsgService_InsertWorkerPayload_Handler,
-		},
-		{
-			MethodName: "InsertReputerPayload",
-			Handler:    _MsgService_InsertReputerPayload_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "emissions/v5/tx.proto",
+var xxx_messageInfo_CancelRemoveWorkerBondResponse proto.InternalMessageInfo
+
+// Slashes the bond of a worker for proven misbehavior, sent by a whitelist
+// admin. The slashed amount goes to the ecosystem bucket.
+type SlashWorkerBondRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Worker  string                `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// misbehavior the worker is slashed for
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SlashWorkerBondRequest) Reset()         { *m = SlashWorkerBondRequest{} }
+func (m *SlashWorkerBondRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashWorkerBondRequest) ProtoMessage()    {}
+func (*SlashWorkerBondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{44}
+}
+func (m *SlashWorkerBondRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashWorkerBondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashWorkerBondRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SlashWorkerBondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashWorkerBondRequest.Merge(m, src)
+}
+func (m *SlashWorkerBondRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashWorkerBondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashWorkerBondRequest.DiscardUnknown(m)
 }
 
-func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SlashWorkerBondRequest proto.InternalMessageInfo
+
+func (m *SlashWorkerBondRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PNormSafeDiv) > 0 {
-		for iNdEx := len(m.PNormSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PNormSafeDiv[iNdEx].Size()
-				i -= size
-				if _, err := m.PNormSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x8a
-		}
+func (m *SlashWorkerBondRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.InitialRegretQuantile) > 0 {
-		for iNdEx := len(m.InitialRegretQuantile) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.InitialRegretQuantile[iNdEx].Size()
-				i -= size
-				if _, err := m.InitialRegretQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x82
-		}
+	return 0
+}
+
+func (m *SlashWorkerBondRequest) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	if len(m.MaxStringLength) > 0 {
-		dAtA2 := make([]byte, len(m.MaxStringLength)*10)
-		var j1 int
-		for _, num := range m.MaxStringLength {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+	return ""
+}
+
+func (m *SlashWorkerBondRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SlashWorkerBondResponse struct {
+}
+
+func (m *SlashWorkerBondResponse) Reset()         { *m = SlashWorkerBondResponse{} }
+func (m *SlashWorkerBondResponse) String() string { return proto.CompactTextString(m) }
+func (*SlashWorkerBondResponse) ProtoMessage()    {}
+func (*SlashWorkerBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{45}
+}
+func (m *SlashWorkerBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashWorkerBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashWorkerBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xfa
+		return b[:n], nil
 	}
-	if len(m.MaxActiveTopicsPerBlock) > 0 {
-		dAtA4 := make([]byte, len(m.MaxActiveTopicsPerBlock)*10)
-		var j3 int
-		for _, num := range m.MaxActiveTopicsPerBlock {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+}
+func (m *SlashWorkerBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashWorkerBondResponse.Merge(m, src)
+}
+func (m *SlashWorkerBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashWorkerBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashWorkerBondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashWorkerBondResponse proto.InternalMessageInfo
+
+type DelegateStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string                `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *DelegateStakeRequest) Reset()         { *m = DelegateStakeRequest{} }
+func (m *DelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegateStakeRequest) ProtoMessage()    {}
+func (*DelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{46}
+}
+func (m *DelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xf2
+		return b[:n], nil
 	}
-	if len(m.MaxElementsPerForecast) > 0 {
-		dAtA6 := make([]byte, len(m.MaxElementsPerForecast)*10)
-		var j5 int
-		for _, num := range m.MaxElementsPerForecast {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xea
-	}
-	if len(m.EpsilonSafeDiv) > 0 {
-		for iNdEx := len(m.EpsilonSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.EpsilonSafeDiv[iNdEx].Size()
-				i -= size
-				if _, err := m.EpsilonSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xe2
-		}
+}
+func (m *DelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateStakeRequest.Merge(m, src)
+}
+func (m *DelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateStakeRequest proto.InternalMessageInfo
+
+func (m *DelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.DataSendingFee) > 0 {
-		for iNdEx := len(m.DataSendingFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.DataSendingFee[iNdEx].Size()
-				i -= size
-				if _, err := m.DataSendingFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xda
-		}
+	return ""
+}
+
+func (m *DelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
-		dAtA8 := make([]byte, len(m.HalfMaxProcessStakeRemovalsEndBlock)*10)
-		var j7 int
-		for _, num := range m.HalfMaxProcessStakeRemovalsEndBlock {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd2
+	return 0
+}
+
+func (m *DelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	if len(m.EpsilonReputer) > 0 {
-		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.EpsilonReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xc2
+	return ""
+}
+
+type DelegateStakeResponse struct {
+}
+
+func (m *DelegateStakeResponse) Reset()         { *m = DelegateStakeResponse{} }
+func (m *DelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegateStakeResponse) ProtoMessage()    {}
+func (*DelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{47}
+}
+func (m *DelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CNorm) > 0 {
-		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CNorm[iNdEx].Size()
-				i -= size
-				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xb2
+}
+func (m *DelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateStakeResponse.Merge(m, src)
+}
+func (m *DelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateStakeResponse proto.InternalMessageInfo
+
+type RemoveDelegateStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Reputer string                `protobuf:"bytes,2,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	TopicId uint64                `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RemoveDelegateStakeRequest) Reset()         { *m = RemoveDelegateStakeRequest{} }
+func (m *RemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDelegateStakeRequest) ProtoMessage()    {}
+func (*RemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{48}
+}
+func (m *RemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CRewardForecast) > 0 {
-		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xaa
-		}
+}
+func (m *RemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDelegateStakeRequest.Merge(m, src)
+}
+func (m *RemoveDelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDelegateStakeRequest proto.InternalMessageInfo
+
+func (m *RemoveDelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.CRewardInference) > 0 {
-		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xa2
-		}
+	return ""
+}
+
+func (m *RemoveDelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	if len(m.PRewardReputer) > 0 {
-		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x9a
-		}
+	return ""
+}
+
+func (m *RemoveDelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.PRewardForecast) > 0 {
-		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x92
+	return 0
+}
+
+type RemoveDelegateStakeResponse struct {
+}
+
+func (m *RemoveDelegateStakeResponse) Reset()         { *m = RemoveDelegateStakeResponse{} }
+func (m *RemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDelegateStakeResponse) ProtoMessage()    {}
+func (*RemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{49}
+}
+func (m *RemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PRewardInference) > 0 {
-		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x8a
+}
+func (m *RemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDelegateStakeResponse.Merge(m, src)
+}
+func (m *RemoveDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDelegateStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDelegateStakeResponse proto.InternalMessageInfo
+
+type CancelRemoveDelegateStakeRequest struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Reputer   string `protobuf:"bytes,4,opt,name=reputer,proto3" json:"reputer,omitempty"`
+}
+
+func (m *CancelRemoveDelegateStakeRequest) Reset()         { *m = CancelRemoveDelegateStakeRequest{} }
+func (m *CancelRemoveDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveDelegateStakeRequest) ProtoMessage()    {}
+func (*CancelRemoveDelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{50}
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveDelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BlocksPerMonth) > 0 {
-		dAtA10 := make([]byte, len(m.BlocksPerMonth)*10)
-		var j9 int
-		for _, num := range m.BlocksPerMonth {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintTx(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveDelegateStakeRequest.Merge(m, src)
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRemoveDelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveDelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelRemoveDelegateStakeRequest proto.InternalMessageInfo
+
+func (m *CancelRemoveDelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		dAtA12 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
-		var j11 int
-		for _, num1 := range m.MinEpochLengthRecordLimit {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
+	return ""
+}
+
+func (m *CancelRemoveDelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.MaxPageLimit) > 0 {
-		dAtA14 := make([]byte, len(m.MaxPageLimit)*10)
-		var j13 int
-		for _, num := range m.MaxPageLimit {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintTx(dAtA, i, uint64(j13))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
+	return 0
+}
+
+func (m *CancelRemoveDelegateStakeRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		dAtA16 := make([]byte, len(m.DefaultPageLimit)*10)
-		var j15 int
-		for _, num := range m.DefaultPageLimit {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
+	return ""
+}
+
+func (m *CancelRemoveDelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+type CancelRemoveDelegateStakeResponse struct {
+}
+
+func (m *CancelRemoveDelegateStakeResponse) Reset()         { *m = CancelRemoveDelegateStakeResponse{} }
+func (m *CancelRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelRemoveDelegateStakeResponse) ProtoMessage()    {}
+func (*CancelRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{51}
+}
+func (m *CancelRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRemoveDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRemoveDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintTx(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+		return b[:n], nil
 	}
-	if len(m.RegistrationFee) > 0 {
-		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RegistrationFee[iNdEx].Size()
-				i -= size
-				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xe2
+}
+func (m *CancelRemoveDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRemoveDelegateStakeResponse.Merge(m, src)
+}
+func (m *CancelRemoveDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRemoveDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRemoveDelegateStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelRemoveDelegateStakeResponse proto.InternalMessageInfo
+
+// Sets the commission the sender charges as a reputer on the rewards of its delegators in a topic.
+// The max rate and max change rate cannot be changed afterwards.
+type CreateReputerCommissionRequest struct {
+	Sender        string                                          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId       uint64                                          `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Rate          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"rate"`
+	MaxRate       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_rate"`
+	MaxChangeRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_change_rate"`
+}
+
+func (m *CreateReputerCommissionRequest) Reset()         { *m = CreateReputerCommissionRequest{} }
+func (m *CreateReputerCommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateReputerCommissionRequest) ProtoMessage()    {}
+func (*CreateReputerCommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{52}
+}
+func (m *CreateReputerCommissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateReputerCommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateReputerCommissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		dAtA18 := make([]byte, len(m.GradientDescentMaxIters)*10)
-		var j17 int
-		for _, num := range m.GradientDescentMaxIters {
-			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j17++
-			}
-			dAtA18[j17] = uint8(num)
-			j17++
-		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintTx(dAtA, i, uint64(j17))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+}
+func (m *CreateReputerCommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReputerCommissionRequest.Merge(m, src)
+}
+func (m *CreateReputerCommissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateReputerCommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReputerCommissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReputerCommissionRequest proto.InternalMessageInfo
+
+func (m *CreateReputerCommissionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CreateTopicFee[iNdEx].Size()
-				i -= size
-				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
-		}
+	return ""
+}
+
+func (m *CreateReputerCommissionRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		dAtA20 := make([]byte, len(m.MaxTopReputersToReward)*10)
-		var j19 int
-		for _, num := range m.MaxTopReputersToReward {
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
+	return 0
+}
+
+type CreateReputerCommissionResponse struct {
+}
+
+func (m *CreateReputerCommissionResponse) Reset()         { *m = CreateReputerCommissionResponse{} }
+func (m *CreateReputerCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateReputerCommissionResponse) ProtoMessage()    {}
+func (*CreateReputerCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{53}
+}
+func (m *CreateReputerCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateReputerCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateReputerCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintTx(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		return b[:n], nil
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		dAtA22 := make([]byte, len(m.MaxTopForecastersToReward)*10)
-		var j21 int
-		for _, num := range m.MaxTopForecastersToReward {
-			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA22[j21] = uint8(num)
-			j21++
+}
+func (m *CreateReputerCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReputerCommissionResponse.Merge(m, src)
+}
+func (m *CreateReputerCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateReputerCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReputerCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReputerCommissionResponse proto.InternalMessageInfo
+
+// Changes the commission rate of the sender as a reputer in a topic, within the limits of its commission
+type EditReputerCommissionRequest struct {
+	Sender  string                                          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                                          `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Rate    github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"rate"`
+}
+
+func (m *EditReputerCommissionRequest) Reset()         { *m = EditReputerCommissionRequest{} }
+func (m *EditReputerCommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*EditReputerCommissionRequest) ProtoMessage()    {}
+func (*EditReputerCommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{54}
+}
+func (m *EditReputerCommissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditReputerCommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditReputerCommissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintTx(dAtA, i, uint64(j21))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		return b[:n], nil
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		dAtA24 := make([]byte, len(m.MaxTopInferersToReward)*10)
-		var j23 int
-		for _, num := range m.MaxTopInferersToReward {
-			for num >= 1<<7 {
-				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j23++
-			}
-			dAtA24[j23] = uint8(num)
-			j23++
-		}
-		i -= j23
-		copy(dAtA[i:], dAtA24[:j23])
-		i = encodeVarintTx(dAtA, i, uint64(j23))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+}
+func (m *EditReputerCommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditReputerCommissionRequest.Merge(m, src)
+}
+func (m *EditReputerCommissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditReputerCommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditReputerCommissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditReputerCommissionRequest proto.InternalMessageInfo
+
+func (m *EditReputerCommissionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		dAtA26 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
-		var j25 int
-		for _, num := range m.MaxSamplesToScaleScores {
-			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j25++
-			}
-			dAtA26[j25] = uint8(num)
-			j25++
-		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintTx(dAtA, i, uint64(j25))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return ""
+}
+
+func (m *EditReputerCommissionRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
-				i -= size
-				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
+	return 0
+}
+
+type EditReputerCommissionResponse struct {
+}
+
+func (m *EditReputerCommissionResponse) Reset()         { *m = EditReputerCommissionResponse{} }
+func (m *EditReputerCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*EditReputerCommissionResponse) ProtoMessage()    {}
+func (*EditReputerCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{55}
+}
+func (m *EditReputerCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditReputerCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditReputerCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TaskRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
+}
+func (m *EditReputerCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditReputerCommissionResponse.Merge(m, src)
+}
+func (m *EditReputerCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditReputerCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditReputerCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditReputerCommissionResponse proto.InternalMessageInfo
+
+type RedelegateStakeRequest struct {
+	Sender     string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId    uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	SrcReputer string                `protobuf:"bytes,3,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstReputer string                `protobuf:"bytes,4,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RedelegateStakeRequest) Reset()         { *m = RedelegateStakeRequest{} }
+func (m *RedelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RedelegateStakeRequest) ProtoMessage()    {}
+func (*RedelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{56}
+}
+func (m *RedelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+}
+func (m *RedelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegateStakeRequest.Merge(m, src)
+}
+func (m *RedelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegateStakeRequest proto.InternalMessageInfo
+
+func (m *RedelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
+	return ""
+}
+
+func (m *RedelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardStakeImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
+	return 0
+}
+
+func (m *RedelegateStakeRequest) GetSrcReputer() string {
+	if m != nil {
+		return m.SrcReputer
 	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		dAtA28 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
-		var j27 int
-		for _, num := range m.MaxUnfulfilledReputerRequests {
-			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j27++
-			}
-			dAtA28[j27] = uint8(num)
-			j27++
-		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintTx(dAtA, i, uint64(j27))
-		i--
-		dAtA[i] = 0x72
+	return ""
+}
+
+func (m *RedelegateStakeRequest) GetDstReputer() string {
+	if m != nil {
+		return m.DstReputer
 	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		dAtA30 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
-		var j29 int
-		for _, num := range m.MaxUnfulfilledWorkerRequests {
-			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j29++
-			}
-			dAtA30[j29] = uint8(num)
-			j29++
+	return ""
+}
+
+type RedelegateStakeResponse struct {
+}
+
+func (m *RedelegateStakeResponse) Reset()         { *m = RedelegateStakeResponse{} }
+func (m *RedelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegateStakeResponse) ProtoMessage()    {}
+func (*RedelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{57}
+}
+func (m *RedelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintTx(dAtA, i, uint64(j29))
-		i--
-		dAtA[i] = 0x6a
+		return b[:n], nil
 	}
-	if len(m.MinStakeFraction) > 0 {
-		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinStakeFraction[iNdEx].Size()
-				i -= size
-				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+}
+func (m *RedelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegateStakeResponse.Merge(m, src)
+}
+func (m *RedelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegateStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegateStakeResponse proto.InternalMessageInfo
+
+type SetDelegateRewardAutoCompoundRequest struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// whether the pending rewards of the delegator are restaked into its delegations at each payout
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *SetDelegateRewardAutoCompoundRequest) Reset()         { *m = SetDelegateRewardAutoCompoundRequest{} }
+func (m *SetDelegateRewardAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDelegateRewardAutoCompoundRequest) ProtoMessage()    {}
+func (*SetDelegateRewardAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{58}
+}
+func (m *SetDelegateRewardAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDelegateRewardAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDelegateRewardAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MaxGradientThreshold[iNdEx].Size()
-				i -= size
-				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+}
+func (m *SetDelegateRewardAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDelegateRewardAutoCompoundRequest.Merge(m, src)
+}
+func (m *SetDelegateRewardAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDelegateRewardAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDelegateRewardAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDelegateRewardAutoCompoundRequest proto.InternalMessageInfo
+
+func (m *SetDelegateRewardAutoCompoundRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.LearningRate) > 0 {
-		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.LearningRate[iNdEx].Size()
-				i -= size
-				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return ""
+}
+
+func (m *SetDelegateRewardAutoCompoundRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if len(m.BetaEntropy) > 0 {
-		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.BetaEntropy[iNdEx].Size()
-				i -= size
-				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+	return false
+}
+
+type SetDelegateRewardAutoCompoundResponse struct {
+}
+
+func (m *SetDelegateRewardAutoCompoundResponse) Reset()         { *m = SetDelegateRewardAutoCompoundResponse{} }
+func (m *SetDelegateRewardAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*SetDelegateRewardAutoCompoundResponse) ProtoMessage()    {}
+func (*SetDelegateRewardAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{59}
+}
+func (m *SetDelegateRewardAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDelegateRewardAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDelegateRewardAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MinEpochLength) > 0 {
-		dAtA32 := make([]byte, len(m.MinEpochLength)*10)
-		var j31 int
-		for _, num1 := range m.MinEpochLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j31++
-			}
-			dAtA32[j31] = uint8(num)
-			j31++
+}
+func (m *SetDelegateRewardAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDelegateRewardAutoCompoundResponse.Merge(m, src)
+}
+func (m *SetDelegateRewardAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDelegateRewardAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDelegateRewardAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDelegateRewardAutoCompoundResponse proto.InternalMessageInfo
+
+type ClaimAllDelegateRewardsRequest struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *ClaimAllDelegateRewardsRequest) Reset()         { *m = ClaimAllDelegateRewardsRequest{} }
+func (m *ClaimAllDelegateRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimAllDelegateRewardsRequest) ProtoMessage()    {}
+func (*ClaimAllDelegateRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{60}
+}
+func (m *ClaimAllDelegateRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimAllDelegateRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimAllDelegateRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintTx(dAtA, i, uint64(j31))
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		dAtA34 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
-		var j33 int
-		for _, num1 := range m.RemoveStakeDelayWindow {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j33++
-			}
-			dAtA34[j33] = uint8(num)
-			j33++
+}
+func (m *ClaimAllDelegateRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimAllDelegateRewardsRequest.Merge(m, src)
+}
+func (m *ClaimAllDelegateRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimAllDelegateRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimAllDelegateRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimAllDelegateRewardsRequest proto.InternalMessageInfo
+
+func (m *ClaimAllDelegateRewardsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type ClaimAllDelegateRewardsResponse struct {
+	// total reward paid out across the delegations of the sender
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ClaimAllDelegateRewardsResponse) Reset()         { *m = ClaimAllDelegateRewardsResponse{} }
+func (m *ClaimAllDelegateRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimAllDelegateRewardsResponse) ProtoMessage()    {}
+func (*ClaimAllDelegateRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{61}
+}
+func (m *ClaimAllDelegateRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimAllDelegateRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimAllDelegateRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintTx(dAtA, i, uint64(j33))
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RequiredMinimumStake[iNdEx].Size()
-				i -= size
-				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+}
+func (m *ClaimAllDelegateRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimAllDelegateRewardsResponse.Merge(m, src)
+}
+func (m *ClaimAllDelegateRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimAllDelegateRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimAllDelegateRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimAllDelegateRewardsResponse proto.InternalMessageInfo
+
+type TokenizeDelegateStakeRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string                `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *TokenizeDelegateStakeRequest) Reset()         { *m = TokenizeDelegateStakeRequest{} }
+func (m *TokenizeDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenizeDelegateStakeRequest) ProtoMessage()    {}
+func (*TokenizeDelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{62}
+}
+func (m *TokenizeDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeDelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MinTopicWeight) > 0 {
-		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinTopicWeight[iNdEx].Size()
-				i -= size
-				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *TokenizeDelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeDelegateStakeRequest.Merge(m, src)
+}
+func (m *TokenizeDelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeDelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeDelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeDelegateStakeRequest proto.InternalMessageInfo
+
+func (m *TokenizeDelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		dAtA36 := make([]byte, len(m.MaxSerializedMsgLength)*10)
-		var j35 int
-		for _, num1 := range m.MaxSerializedMsgLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j35++
-			}
-			dAtA36[j35] = uint8(num)
-			j35++
-		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintTx(dAtA, i, uint64(j35))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *TokenizeDelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.Version) > 0 {
-		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Version[iNdEx])
-			copy(dAtA[i:], m.Version[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TokenizeDelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+type TokenizeDelegateStakeResponse struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bank denom of the receipts minted
+	Denom  string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Shares cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.Int" json:"shares"`
+}
+
+func (m *TokenizeDelegateStakeResponse) Reset()         { *m = TokenizeDelegateStakeResponse{} }
+func (m *TokenizeDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenizeDelegateStakeResponse) ProtoMessage()    {}
+func (*TokenizeDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{63}
+}
+func (m *TokenizeDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TokenizeDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeDelegateStakeResponse.Merge(m, src)
+}
+func (m *TokenizeDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeDelegateStakeResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TokenizeDelegateStakeResponse proto.InternalMessageInfo
+
+func (m *TokenizeDelegateStakeResponse) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TokenizeDelegateStakeResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *UpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+type RedeemDelegateStakeReceiptRequest struct {
+	Sender string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolId uint64                `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Shares cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.Int" json:"shares"`
+}
+
+func (m *RedeemDelegateStakeReceiptRequest) Reset()         { *m = RedeemDelegateStakeReceiptRequest{} }
+func (m *RedeemDelegateStakeReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*RedeemDelegateStakeReceiptRequest) ProtoMessage()    {}
+func (*RedeemDelegateStakeReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{64}
+}
+func (m *RedeemDelegateStakeReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedeemDelegateStakeReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedeemDelegateStakeReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RedeemDelegateStakeReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemDelegateStakeReceiptRequest.Merge(m, src)
+}
+func (m *RedeemDelegateStakeReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedeemDelegateStakeReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemDelegateStakeReceiptRequest.DiscardUnknown(m)
 }
 
-func (m *UpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RedeemDelegateStakeReceiptRequest proto.InternalMessageInfo
+
+func (m *RedeemDelegateStakeReceiptRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RedeemDelegateStakeReceiptRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type RedeemDelegateStakeReceiptResponse struct {
+	// stake delegated back to the reputer of the pool on behalf of the sender
+	DelegatedAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"delegated_amount"`
+	// coins paid out to the sender, from the reserve of the pool or from its stake once its topic is archived
+	ReturnedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=returned_amount,json=returnedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"returned_amount"`
 }
 
-func (m *CreateNewTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RedeemDelegateStakeReceiptResponse) Reset()         { *m = RedeemDelegateStakeReceiptResponse{} }
+func (m *RedeemDelegateStakeReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*RedeemDelegateStakeReceiptResponse) ProtoMessage()    {}
+func (*RedeemDelegateStakeReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{65}
+}
+func (m *RedeemDelegateStakeReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedeemDelegateStakeReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedeemDelegateStakeReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RedeemDelegateStakeReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemDelegateStakeReceiptResponse.Merge(m, src)
+}
+func (m *RedeemDelegateStakeReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedeemDelegateStakeReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemDelegateStakeReceiptResponse.DiscardUnknown(m)
 }
 
-func (m *CreateNewTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RedeemDelegateStakeReceiptResponse proto.InternalMessageInfo
+
+// Inferences are requested by consumers who fund topics by sending ALLO to
+// ecosystem account via TopicFund messages
+type FundTopicRequest struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *CreateNewTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ActiveReputerQuantile.Size()
-		i -= size
-		if _, err := m.ActiveReputerQuantile.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *FundTopicRequest) Reset()         { *m = FundTopicRequest{} }
+func (m *FundTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*FundTopicRequest) ProtoMessage()    {}
+func (*FundTopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{66}
+}
+func (m *FundTopicRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundTopicRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
-	{
-		size := m.ActiveForecasterQuantile.Size()
-		i -= size
-		if _, err := m.ActiveForecasterQuantile.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size := m.ActiveInfererQuantile.Size()
-		i -= size
-		if _, err := m.ActiveInfererQuantile.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.MeritSortitionAlpha.Size()
-		i -= size
-		if _, err := m.MeritSortitionAlpha.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	if m.WorkerSubmissionWindow != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WorkerSubmissionWindow))
-		i--
-		dAtA[i] = 0x70
-	}
-	{
-		size := m.Epsilon.Size()
-		i -= size
-		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AllowNegative {
-		i--
-		if m.AllowNegative {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	{
-		size := m.AlphaRegret.Size()
-		i -= size
-		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PNorm.Size()
-		i -= size
-		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if m.GroundTruthLag != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.EpochLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.LossMethod) > 0 {
-		i -= len(m.LossMethod)
-		copy(dAtA[i:], m.LossMethod)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *FundTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTopicRequest.Merge(m, src)
+}
+func (m *FundTopicRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTopicRequest.DiscardUnknown(m)
 }
 
-func (m *CreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FundTopicRequest proto.InternalMessageInfo
+
+func (m *FundTopicRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FundTopicRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *CreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type FundTopicResponse struct {
 }
 
-func (m *InsertReputerPayloadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FundTopicResponse) Reset()         { *m = FundTopicResponse{} }
+func (m *FundTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*FundTopicResponse) ProtoMessage()    {}
+func (*FundTopicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{67}
+}
+func (m *FundTopicResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundTopicResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *FundTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTopicResponse.Merge(m, src)
+}
+func (m *FundTopicResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTopicResponse.DiscardUnknown(m)
 }
 
-func (m *InsertReputerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_FundTopicResponse proto.InternalMessageInfo
+
+// Escrows `escrow_amount` to fund a topic with `amount_per_drip` every
+// `interval_blocks` blocks, or once per epoch of the topic if 0
+type CreateFundingSubscriptionRequest struct {
+	Sender         string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId        uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	AmountPerDrip  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount_per_drip,json=amountPerDrip,proto3,customtype=cosmossdk.io/math.Int" json:"amount_per_drip"`
+	IntervalBlocks int64                 `protobuf:"varint,4,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	EscrowAmount   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=escrow_amount,json=escrowAmount,proto3,customtype=cosmossdk.io/math.Int" json:"escrow_amount"`
 }
 
-func (m *InsertReputerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReputerValueBundle != nil {
-		{
-			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *CreateFundingSubscriptionRequest) Reset()         { *m = CreateFundingSubscriptionRequest{} }
+func (m *CreateFundingSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateFundingSubscriptionRequest) ProtoMessage()    {}
+func (*CreateFundingSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{68}
+}
+func (m *CreateFundingSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateFundingSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateFundingSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CreateFundingSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFundingSubscriptionRequest.Merge(m, src)
+}
+func (m *CreateFundingSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateFundingSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFundingSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFundingSubscriptionRequest proto.InternalMessageInfo
+
+func (m *CreateFundingSubscriptionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *InsertReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateFundingSubscriptionRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InsertReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateFundingSubscriptionRequest) GetIntervalBlocks() int64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
 }
 
-func (m *InsertReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type CreateFundingSubscriptionResponse struct {
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (m *InsertWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CreateFundingSubscriptionResponse) Reset()         { *m = CreateFundingSubscriptionResponse{} }
+func (m *CreateFundingSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateFundingSubscriptionResponse) ProtoMessage()    {}
+func (*CreateFundingSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{69}
 }
-
-func (m *InsertWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateFundingSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *InsertWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WorkerDataBundle != nil {
-		{
-			size, err := m.WorkerDataBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *CreateFundingSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateFundingSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *InsertWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CreateFundingSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFundingSubscriptionResponse.Merge(m, src)
 }
-
-func (m *InsertWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateFundingSubscriptionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *InsertWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *CreateFundingSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFundingSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateFundingSubscriptionResponse proto.InternalMessageInfo
+
+func (m *CreateFundingSubscriptionResponse) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TopUpFundingSubscriptionRequest struct {
+	Sender         string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubscriptionId uint64                `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *RegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *TopUpFundingSubscriptionRequest) Reset()         { *m = TopUpFundingSubscriptionRequest{} }
+func (m *TopUpFundingSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*TopUpFundingSubscriptionRequest) ProtoMessage()    {}
+func (*TopUpFundingSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{70}
+}
+func (m *TopUpFundingSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopUpFundingSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopUpFundingSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TopUpFundingSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopUpFundingSubscriptionRequest.Merge(m, src)
+}
+func (m *TopUpFundingSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopUpFundingSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopUpFundingSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopUpFundingSubscriptionRequest proto.InternalMessageInfo
+
+func (m *TopUpFundingSubscriptionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RegisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TopUpFundingSubscriptionRequest) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TopUpFundingSubscriptionResponse struct {
 }
 
-func (m *RegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *TopUpFundingSubscriptionResponse) Reset()         { *m = TopUpFundingSubscriptionResponse{} }
+func (m *TopUpFundingSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*TopUpFundingSubscriptionResponse) ProtoMessage()    {}
+func (*TopUpFundingSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{71}
+}
+func (m *TopUpFundingSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopUpFundingSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopUpFundingSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RemoveRegistrationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TopUpFundingSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopUpFundingSubscriptionResponse.Merge(m, src)
+}
+func (m *TopUpFundingSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopUpFundingSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopUpFundingSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveRegistrationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TopUpFundingSubscriptionResponse proto.InternalMessageInfo
+
+// Cancels a subscription and refunds its unspent escrow to the funder
+type CancelFundingSubscriptionRequest struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SubscriptionId uint64 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (m *RemoveRegistrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *CancelFundingSubscriptionRequest) Reset()         { *m = CancelFundingSubscriptionRequest{} }
+func (m *CancelFundingSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelFundingSubscriptionRequest) ProtoMessage()    {}
+func (*CancelFundingSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{72}
+}
+func (m *CancelFundingSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelFundingSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelFundingSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CancelFundingSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelFundingSubscriptionRequest.Merge(m, src)
+}
+func (m *CancelFundingSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelFundingSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelFundingSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelFundingSubscriptionRequest proto.InternalMessageInfo
+
+func (m *CancelFundingSubscriptionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CancelFundingSubscriptionRequest) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CancelFundingSubscriptionResponse struct {
+	RefundedAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=refunded_amount,json=refundedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"refunded_amount"`
 }
 
-func (m *RemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *CancelFundingSubscriptionResponse) Reset()         { *m = CancelFundingSubscriptionResponse{} }
+func (m *CancelFundingSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelFundingSubscriptionResponse) ProtoMessage()    {}
+func (*CancelFundingSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{73}
+}
+func (m *CancelFundingSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelFundingSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelFundingSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AddStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CancelFundingSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelFundingSubscriptionResponse.Merge(m, src)
+}
+func (m *CancelFundingSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelFundingSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelFundingSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *AddStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CancelFundingSubscriptionResponse proto.InternalMessageInfo
+
+type AddToWhitelistAdminRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *AddStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *AddToWhitelistAdminRequest) Reset()         { *m = AddToWhitelistAdminRequest{} }
+func (m *AddToWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToWhitelistAdminRequest) ProtoMessage()    {}
+func (*AddToWhitelistAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{74}
+}
+func (m *AddToWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToWhitelistAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AddToWhitelistAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToWhitelistAdminRequest.Merge(m, src)
+}
+func (m *AddToWhitelistAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToWhitelistAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToWhitelistAdminRequest.DiscardUnknown(m)
 }
 
-func (m *AddStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddToWhitelistAdminRequest proto.InternalMessageInfo
+
+func (m *AddToWhitelistAdminRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddToWhitelistAdminRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *AddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type AddToWhitelistAdminResponse struct {
 }
 
-func (m *RemoveStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddToWhitelistAdminResponse) Reset()         { *m = AddToWhitelistAdminResponse{} }
+func (m *AddToWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToWhitelistAdminResponse) ProtoMessage()    {}
+func (*AddToWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{75}
+}
+func (m *AddToWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToWhitelistAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AddToWhitelistAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToWhitelistAdminResponse.Merge(m, src)
+}
+func (m *AddToWhitelistAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToWhitelistAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToWhitelistAdminResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AddToWhitelistAdminResponse proto.InternalMessageInfo
+
+type RemoveFromWhitelistAdminRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *RemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *RemoveFromWhitelistAdminRequest) Reset()         { *m = RemoveFromWhitelistAdminRequest{} }
+func (m *RemoveFromWhitelistAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromWhitelistAdminRequest) ProtoMessage()    {}
+func (*RemoveFromWhitelistAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{76}
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromWhitelistAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RemoveFromWhitelistAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromWhitelistAdminRequest.Merge(m, src)
 }
-
-func (m *RemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemoveFromWhitelistAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromWhitelistAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromWhitelistAdminRequest.DiscardUnknown(m)
 }
 
-func (m *RemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_RemoveFromWhitelistAdminRequest proto.InternalMessageInfo
+
+func (m *RemoveFromWhitelistAdminRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *CancelRemoveStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemoveFromWhitelistAdminRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CancelRemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RemoveFromWhitelistAdminResponse struct {
 }
 
-func (m *CancelRemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *RemoveFromWhitelistAdminResponse) Reset()         { *m = RemoveFromWhitelistAdminResponse{} }
+func (m *RemoveFromWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromWhitelistAdminResponse) ProtoMessage()    {}
+func (*RemoveFromWhitelistAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{77}
 }
-
-func (m *CancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemoveFromWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromWhitelistAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromWhitelistAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *CancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemoveFromWhitelistAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromWhitelistAdminResponse.Merge(m, src)
 }
-
-func (m *CancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RemoveFromWhitelistAdminResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *DelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RemoveFromWhitelistAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromWhitelistAdminResponse.DiscardUnknown(m)
 }
 
-func (m *DelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RemoveFromWhitelistAdminResponse proto.InternalMessageInfo
+
+type EnableTopicWorkerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *DelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EnableTopicWorkerWhitelistRequest) Reset()         { *m = EnableTopicWorkerWhitelistRequest{} }
+func (m *EnableTopicWorkerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*EnableTopicWorkerWhitelistRequest) ProtoMessage()    {}
+func (*EnableTopicWorkerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{78}
+}
+func (m *EnableTopicWorkerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableTopicWorkerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableTopicWorkerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EnableTopicWorkerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableTopicWorkerWhitelistRequest.Merge(m, src)
+}
+func (m *EnableTopicWorkerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableTopicWorkerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableTopicWorkerWhitelistRequest.DiscardUnknown(m)
 }
 
-func (m *DelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EnableTopicWorkerWhitelistRequest proto.InternalMessageInfo
+
+func (m *EnableTopicWorkerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EnableTopicWorkerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *DelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type EnableTopicWorkerWhitelistResponse struct {
 }
 
-func (m *RemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EnableTopicWorkerWhitelistResponse) Reset()         { *m = EnableTopicWorkerWhitelistResponse{} }
+func (m *EnableTopicWorkerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*EnableTopicWorkerWhitelistResponse) ProtoMessage()    {}
+func (*EnableTopicWorkerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{79}
+}
+func (m *EnableTopicWorkerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableTopicWorkerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableTopicWorkerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EnableTopicWorkerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableTopicWorkerWhitelistResponse.Merge(m, src)
+}
+func (m *EnableTopicWorkerWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableTopicWorkerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableTopicWorkerWhitelistResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EnableTopicWorkerWhitelistResponse proto.InternalMessageInfo
+
+type DisableTopicWorkerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *RemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *DisableTopicWorkerWhitelistRequest) Reset()         { *m = DisableTopicWorkerWhitelistRequest{} }
+func (m *DisableTopicWorkerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableTopicWorkerWhitelistRequest) ProtoMessage()    {}
+func (*DisableTopicWorkerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{80}
+}
+func (m *DisableTopicWorkerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableTopicWorkerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableTopicWorkerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DisableTopicWorkerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTopicWorkerWhitelistRequest.Merge(m, src)
 }
-
-func (m *RemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DisableTopicWorkerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableTopicWorkerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTopicWorkerWhitelistRequest.DiscardUnknown(m)
 }
 
-func (m *RemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_DisableTopicWorkerWhitelistRequest proto.InternalMessageInfo
+
+func (m *DisableTopicWorkerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *CancelRemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DisableTopicWorkerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CancelRemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DisableTopicWorkerWhitelistResponse struct {
 }
 
-func (m *CancelRemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *DisableTopicWorkerWhitelistResponse) Reset()         { *m = DisableTopicWorkerWhitelistResponse{} }
+func (m *DisableTopicWorkerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableTopicWorkerWhitelistResponse) ProtoMessage()    {}
+func (*DisableTopicWorkerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{81}
 }
-
-func (m *CancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DisableTopicWorkerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableTopicWorkerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableTopicWorkerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *CancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DisableTopicWorkerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTopicWorkerWhitelistResponse.Merge(m, src)
 }
-
-func (m *CancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *DisableTopicWorkerWhitelistResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *FundTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DisableTopicWorkerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTopicWorkerWhitelistResponse.DiscardUnknown(m)
 }
 
-func (m *FundTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DisableTopicWorkerWhitelistResponse proto.InternalMessageInfo
+
+type EnableTopicReputerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *FundTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EnableTopicReputerWhitelistRequest) Reset()         { *m = EnableTopicReputerWhitelistRequest{} }
+func (m *EnableTopicReputerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*EnableTopicReputerWhitelistRequest) ProtoMessage()    {}
+func (*EnableTopicReputerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{82}
+}
+func (m *EnableTopicReputerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableTopicReputerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableTopicReputerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EnableTopicReputerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableTopicReputerWhitelistRequest.Merge(m, src)
+}
+func (m *EnableTopicReputerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableTopicReputerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableTopicReputerWhitelistRequest.DiscardUnknown(m)
 }
 
-func (m *FundTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EnableTopicReputerWhitelistRequest proto.InternalMessageInfo
+
+func (m *EnableTopicReputerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EnableTopicReputerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *FundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type EnableTopicReputerWhitelistResponse struct {
 }
 
-func (m *AddToWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EnableTopicReputerWhitelistResponse) Reset()         { *m = EnableTopicReputerWhitelistResponse{} }
+func (m *EnableTopicReputerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*EnableTopicReputerWhitelistResponse) ProtoMessage()    {}
+func (*EnableTopicReputerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{83}
+}
+func (m *EnableTopicReputerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableTopicReputerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableTopicReputerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EnableTopicReputerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableTopicReputerWhitelistResponse.Merge(m, src)
+}
+func (m *EnableTopicReputerWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableTopicReputerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableTopicReputerWhitelistResponse.DiscardUnknown(m)
 }
 
-func (m *AddToWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EnableTopicReputerWhitelistResponse proto.InternalMessageInfo
+
+type DisableTopicReputerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
 
-func (m *AddToWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *DisableTopicReputerWhitelistRequest) Reset()         { *m = DisableTopicReputerWhitelistRequest{} }
+func (m *DisableTopicReputerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableTopicReputerWhitelistRequest) ProtoMessage()    {}
+func (*DisableTopicReputerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{84}
+}
+func (m *DisableTopicReputerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableTopicReputerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableTopicReputerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DisableTopicReputerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTopicReputerWhitelistRequest.Merge(m, src)
+}
+func (m *DisableTopicReputerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableTopicReputerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTopicReputerWhitelistRequest.DiscardUnknown(m)
 }
 
-func (m *AddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DisableTopicReputerWhitelistRequest proto.InternalMessageInfo
+
+func (m *DisableTopicReputerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DisableTopicReputerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *AddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type DisableTopicReputerWhitelistResponse struct {
 }
 
-func (m *RemoveFromWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DisableTopicReputerWhitelistResponse) Reset()         { *m = DisableTopicReputerWhitelistResponse{} }
+func (m *DisableTopicReputerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableTopicReputerWhitelistResponse) ProtoMessage()    {}
+func (*DisableTopicReputerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{85}
+}
+func (m *DisableTopicReputerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableTopicReputerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableTopicReputerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DisableTopicReputerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTopicReputerWhitelistResponse.Merge(m, src)
+}
+func (m *DisableTopicReputerWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableTopicReputerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTopicReputerWhitelistResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveFromWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DisableTopicReputerWhitelistResponse proto.InternalMessageInfo
+
+type AddToTopicWorkerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *RemoveFromWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *AddToTopicWorkerWhitelistRequest) Reset()         { *m = AddToTopicWorkerWhitelistRequest{} }
+func (m *AddToTopicWorkerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToTopicWorkerWhitelistRequest) ProtoMessage()    {}
+func (*AddToTopicWorkerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{86}
+}
+func (m *AddToTopicWorkerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToTopicWorkerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToTopicWorkerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *AddToTopicWorkerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToTopicWorkerWhitelistRequest.Merge(m, src)
+}
+func (m *AddToTopicWorkerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToTopicWorkerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToTopicWorkerWhitelistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToTopicWorkerWhitelistRequest proto.InternalMessageInfo
+
+func (m *AddToTopicWorkerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddToTopicWorkerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddToTopicWorkerWhitelistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *RemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type AddToTopicWorkerWhitelistResponse struct {
 }
 
-func (m *RewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddToTopicWorkerWhitelistResponse) Reset()         { *m = AddToTopicWorkerWhitelistResponse{} }
+func (m *AddToTopicWorkerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToTopicWorkerWhitelistResponse) ProtoMessage()    {}
+func (*AddToTopicWorkerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{87}
+}
+func (m *AddToTopicWorkerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToTopicWorkerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToTopicWorkerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *RewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddToTopicWorkerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToTopicWorkerWhitelistResponse.Merge(m, src)
+}
+func (m *AddToTopicWorkerWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToTopicWorkerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToTopicWorkerWhitelistResponse.DiscardUnknown(m)
 }
 
-func (m *RewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_AddToTopicWorkerWhitelistResponse proto.InternalMessageInfo
+
+type RemoveFromTopicWorkerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *RewardDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemoveFromTopicWorkerWhitelistRequest) Reset()         { *m = RemoveFromTopicWorkerWhitelistRequest{} }
+func (m *RemoveFromTopicWorkerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromTopicWorkerWhitelistRequest) ProtoMessage()    {}
+func (*RemoveFromTopicWorkerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{88}
+}
+func (m *RemoveFromTopicWorkerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromTopicWorkerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromTopicWorkerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *RewardDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemoveFromTopicWorkerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromTopicWorkerWhitelistRequest.Merge(m, src)
+}
+func (m *RemoveFromTopicWorkerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromTopicWorkerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromTopicWorkerWhitelistRequest.DiscardUnknown(m)
 }
 
-func (m *RewardDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_RemoveFromTopicWorkerWhitelistRequest proto.InternalMessageInfo
+
+func (m *RemoveFromTopicWorkerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RemoveFromTopicWorkerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *OptionalParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		for _, s := range m.Version {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (m *RemoveFromTopicWorkerWhitelistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		l = 0
-		for _, e := range m.MaxSerializedMsgLength {
-			l += sovTx(uint64(e))
+	return ""
+}
+
+type RemoveFromTopicWorkerWhitelistResponse struct {
+}
+
+func (m *RemoveFromTopicWorkerWhitelistResponse) Reset() {
+	*m = RemoveFromTopicWorkerWhitelistResponse{}
+}
+func (m *RemoveFromTopicWorkerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromTopicWorkerWhitelistResponse) ProtoMessage()    {}
+func (*RemoveFromTopicWorkerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{89}
+}
+func (m *RemoveFromTopicWorkerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromTopicWorkerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromTopicWorkerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if len(m.MinTopicWeight) > 0 {
-		for _, e := range m.MinTopicWeight {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *RemoveFromTopicWorkerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromTopicWorkerWhitelistResponse.Merge(m, src)
+}
+func (m *RemoveFromTopicWorkerWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromTopicWorkerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromTopicWorkerWhitelistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFromTopicWorkerWhitelistResponse proto.InternalMessageInfo
+
+type AddToTopicReputerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AddToTopicReputerWhitelistRequest) Reset()         { *m = AddToTopicReputerWhitelistRequest{} }
+func (m *AddToTopicReputerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToTopicReputerWhitelistRequest) ProtoMessage()    {}
+func (*AddToTopicReputerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{90}
+}
+func (m *AddToTopicReputerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToTopicReputerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToTopicReputerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for _, e := range m.RequiredMinimumStake {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+}
+func (m *AddToTopicReputerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToTopicReputerWhitelistRequest.Merge(m, src)
+}
+func (m *AddToTopicReputerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToTopicReputerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToTopicReputerWhitelistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToTopicReputerWhitelistRequest proto.InternalMessageInfo
+
+func (m *AddToTopicReputerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		l = 0
-		for _, e := range m.RemoveStakeDelayWindow {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return ""
+}
+
+func (m *AddToTopicReputerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.MinEpochLength) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLength {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return 0
+}
+
+func (m *AddToTopicReputerWhitelistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.BetaEntropy) > 0 {
-		for _, e := range m.BetaEntropy {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.LearningRate) > 0 {
-		for _, e := range m.LearningRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for _, e := range m.MaxGradientThreshold {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MinStakeFraction) > 0 {
-		for _, e := range m.MinStakeFraction {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledWorkerRequests {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledReputerRequests {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for _, e := range m.TopicRewardStakeImportance {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type AddToTopicReputerWhitelistResponse struct {
+}
+
+func (m *AddToTopicReputerWhitelistResponse) Reset()         { *m = AddToTopicReputerWhitelistResponse{} }
+func (m *AddToTopicReputerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToTopicReputerWhitelistResponse) ProtoMessage()    {}
+func (*AddToTopicReputerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{91}
+}
+func (m *AddToTopicReputerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToTopicReputerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToTopicReputerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for _, e := range m.TopicRewardFeeRevenueImportance {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
+}
+func (m *AddToTopicReputerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToTopicReputerWhitelistResponse.Merge(m, src)
+}
+func (m *AddToTopicReputerWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToTopicReputerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToTopicReputerWhitelistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToTopicReputerWhitelistResponse proto.InternalMessageInfo
+
+type RemoveFromTopicReputerWhitelistRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RemoveFromTopicReputerWhitelistRequest) Reset() {
+	*m = RemoveFromTopicReputerWhitelistRequest{}
+}
+func (m *RemoveFromTopicReputerWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromTopicReputerWhitelistRequest) ProtoMessage()    {}
+func (*RemoveFromTopicReputerWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{92}
+}
+func (m *RemoveFromTopicReputerWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromTopicReputerWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromTopicReputerWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for _, e := range m.TopicRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+}
+func (m *RemoveFromTopicReputerWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromTopicReputerWhitelistRequest.Merge(m, src)
+}
+func (m *RemoveFromTopicReputerWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromTopicReputerWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromTopicReputerWhitelistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFromTopicReputerWhitelistRequest proto.InternalMessageInfo
+
+func (m *RemoveFromTopicReputerWhitelistRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for _, e := range m.TaskRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return ""
+}
+
+func (m *RemoveFromTopicReputerWhitelistRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for _, e := range m.ValidatorsVsAlloraPercentReward {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return 0
+}
+
+func (m *RemoveFromTopicReputerWhitelistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		l = 0
-		for _, e := range m.MaxSamplesToScaleScores {
-			l += sovTx(uint64(e))
+	return ""
+}
+
+type RemoveFromTopicReputerWhitelistResponse struct {
+}
+
+func (m *RemoveFromTopicReputerWhitelistResponse) Reset() {
+	*m = RemoveFromTopicReputerWhitelistResponse{}
+}
+func (m *RemoveFromTopicReputerWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromTopicReputerWhitelistResponse) ProtoMessage()    {}
+func (*RemoveFromTopicReputerWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{93}
+}
+func (m *RemoveFromTopicReputerWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveFromTopicReputerWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromTopicReputerWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopInferersToReward {
-			l += sovTx(uint64(e))
+}
+func (m *RemoveFromTopicReputerWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromTopicReputerWhitelistResponse.Merge(m, src)
+}
+func (m *RemoveFromTopicReputerWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveFromTopicReputerWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromTopicReputerWhitelistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveFromTopicReputerWhitelistResponse proto.InternalMessageInfo
+
+type RewardDelegateStakeResponse struct {
+}
+
+func (m *RewardDelegateStakeResponse) Reset()         { *m = RewardDelegateStakeResponse{} }
+func (m *RewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RewardDelegateStakeResponse) ProtoMessage()    {}
+func (*RewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{94}
+}
+func (m *RewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopForecastersToReward {
-			l += sovTx(uint64(e))
+}
+func (m *RewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDelegateStakeResponse.Merge(m, src)
+}
+func (m *RewardDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDelegateStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDelegateStakeResponse proto.InternalMessageInfo
+
+type RewardDelegateStakeRequest struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+}
+
+func (m *RewardDelegateStakeRequest) Reset()         { *m = RewardDelegateStakeRequest{} }
+func (m *RewardDelegateStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RewardDelegateStakeRequest) ProtoMessage()    {}
+func (*RewardDelegateStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6928f235d40e7988, []int{95}
+}
+func (m *RewardDelegateStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDelegateStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDelegateStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopReputersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+}
+func (m *RewardDelegateStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDelegateStakeRequest.Merge(m, src)
+}
+func (m *RewardDelegateStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDelegateStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDelegateStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDelegateStakeRequest proto.InternalMessageInfo
+
+func (m *RewardDelegateStakeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for _, e := range m.CreateTopicFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return ""
+}
+
+func (m *RewardDelegateStakeRequest) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		l = 0
-		for _, e := range m.GradientDescentMaxIters {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return 0
+}
+
+func (m *RewardDelegateStakeRequest) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	if len(m.RegistrationFee) > 0 {
-		for _, e := range m.RegistrationFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*OptionalParams)(nil), "emissions.v5.OptionalParams")
+	proto.RegisterType((*UpdateParamsRequest)(nil), "emissions.v5.UpdateParamsRequest")
+	proto.RegisterType((*UpdateParamsResponse)(nil), "emissions.v5.UpdateParamsResponse")
+	proto.RegisterType((*CreateNewTopicRequest)(nil), "emissions.v5.CreateNewTopicRequest")
+	proto.RegisterType((*CreateNewTopicResponse)(nil), "emissions.v5.CreateNewTopicResponse")
+	proto.RegisterType((*UpdateTopicRequest)(nil), "emissions.v5.UpdateTopicRequest")
+	proto.RegisterType((*UpdateTopicResponse)(nil), "emissions.v5.UpdateTopicResponse")
+	proto.RegisterType((*ArchiveTopicRequest)(nil), "emissions.v5.ArchiveTopicRequest")
+	proto.RegisterType((*ArchiveTopicResponse)(nil), "emissions.v5.ArchiveTopicResponse")
+	proto.RegisterType((*InsertReputerPayloadRequest)(nil), "emissions.v5.InsertReputerPayloadRequest")
+	proto.RegisterType((*InsertReputerPayloadResponse)(nil), "emissions.v5.InsertReputerPayloadResponse")
+	proto.RegisterType((*CommitReputerPayloadRequest)(nil), "emissions.v5.CommitReputerPayloadRequest")
+	proto.RegisterType((*CommitReputerPayloadResponse)(nil), "emissions.v5.CommitReputerPayloadResponse")
+	proto.RegisterType((*RevealReputerPayloadRequest)(nil), "emissions.v5.RevealReputerPayloadRequest")
+	proto.RegisterType((*RevealReputerPayloadResponse)(nil), "emissions.v5.RevealReputerPayloadResponse")
+	proto.RegisterType((*InsertWorkerPayloadRequest)(nil), "emissions.v5.InsertWorkerPayloadRequest")
+	proto.RegisterType((*InsertWorkerPayloadResponse)(nil), "emissions.v5.InsertWorkerPayloadResponse")
+	proto.RegisterType((*InsertWorkerPayloadsRequest)(nil), "emissions.v5.InsertWorkerPayloadsRequest")
+	proto.RegisterType((*WorkerPayloadResult)(nil), "emissions.v5.WorkerPayloadResult")
+	proto.RegisterType((*InsertWorkerPayloadsResponse)(nil), "emissions.v5.InsertWorkerPayloadsResponse")
+	proto.RegisterType((*CommitWorkerPayloadRequest)(nil), "emissions.v5.CommitWorkerPayloadRequest")
+	proto.RegisterType((*CommitWorkerPayloadResponse)(nil), "emissions.v5.CommitWorkerPayloadResponse")
+	proto.RegisterType((*RevealWorkerPayloadRequest)(nil), "emissions.v5.RevealWorkerPayloadRequest")
+	proto.RegisterType((*RevealWorkerPayloadResponse)(nil), "emissions.v5.RevealWorkerPayloadResponse")
+	proto.RegisterType((*RegisterRequest)(nil), "emissions.v5.RegisterRequest")
+	proto.RegisterType((*RegisterResponse)(nil), "emissions.v5.RegisterResponse")
+	proto.RegisterType((*RemoveRegistrationRequest)(nil), "emissions.v5.RemoveRegistrationRequest")
+	proto.RegisterType((*RemoveRegistrationResponse)(nil), "emissions.v5.RemoveRegistrationResponse")
+	proto.RegisterType((*UpdateNodeInfoRequest)(nil), "emissions.v5.UpdateNodeInfoRequest")
+	proto.RegisterType((*UpdateNodeInfoResponse)(nil), "emissions.v5.UpdateNodeInfoResponse")
+	proto.RegisterType((*UnjailRequest)(nil), "emissions.v5.UnjailRequest")
+	proto.RegisterType((*UnjailResponse)(nil), "emissions.v5.UnjailResponse")
+	proto.RegisterType((*AddStakeRequest)(nil), "emissions.v5.AddStakeRequest")
+	proto.RegisterType((*AddStakeResponse)(nil), "emissions.v5.AddStakeResponse")
+	proto.RegisterType((*RemoveStakeRequest)(nil), "emissions.v5.RemoveStakeRequest")
+	proto.RegisterType((*RemoveStakeResponse)(nil), "emissions.v5.RemoveStakeResponse")
+	proto.RegisterType((*CancelRemoveStakeRequest)(nil), "emissions.v5.CancelRemoveStakeRequest")
+	proto.RegisterType((*CancelRemoveStakeResponse)(nil), "emissions.v5.CancelRemoveStakeResponse")
+	proto.RegisterType((*AddWorkerBondRequest)(nil), "emissions.v5.AddWorkerBondRequest")
+	proto.RegisterType((*AddWorkerBondResponse)(nil), "emissions.v5.AddWorkerBondResponse")
+	proto.RegisterType((*RemoveWorkerBondRequest)(nil), "emissions.v5.RemoveWorkerBondRequest")
+	proto.RegisterType((*RemoveWorkerBondResponse)(nil), "emissions.v5.RemoveWorkerBondResponse")
+	proto.RegisterType((*CancelRemoveWorkerBondRequest)(nil), "emissions.v5.CancelRemoveWorkerBondRequest")
+	proto.RegisterType((*CancelRemoveWorkerBondResponse)(nil), "emissions.v5.CancelRemoveWorkerBondResponse")
+	proto.RegisterType((*SlashWorkerBondRequest)(nil), "emissions.v5.SlashWorkerBondRequest")
+	proto.RegisterType((*SlashWorkerBondResponse)(nil), "emissions.v5.SlashWorkerBondResponse")
+	proto.RegisterType((*DelegateStakeRequest)(nil), "emissions.v5.DelegateStakeRequest")
+	proto.RegisterType((*DelegateStakeResponse)(nil), "emissions.v5.DelegateStakeResponse")
+	proto.RegisterType((*RemoveDelegateStakeRequest)(nil), "emissions.v5.RemoveDelegateStakeRequest")
+	proto.RegisterType((*RemoveDelegateStakeResponse)(nil), "emissions.v5.RemoveDelegateStakeResponse")
+	proto.RegisterType((*CancelRemoveDelegateStakeRequest)(nil), "emissions.v5.CancelRemoveDelegateStakeRequest")
+	proto.RegisterType((*CancelRemoveDelegateStakeResponse)(nil), "emissions.v5.CancelRemoveDelegateStakeResponse")
+	proto.RegisterType((*CreateReputerCommissionRequest)(nil), "emissions.v5.CreateReputerCommissionRequest")
+	proto.RegisterType((*CreateReputerCommissionResponse)(nil), "emissions.v5.CreateReputerCommissionResponse")
+	proto.RegisterType((*EditReputerCommissionRequest)(nil), "emissions.v5.EditReputerCommissionRequest")
+	proto.RegisterType((*EditReputerCommissionResponse)(nil), "emissions.v5.EditReputerCommissionResponse")
+	proto.RegisterType((*RedelegateStakeRequest)(nil), "emissions.v5.RedelegateStakeRequest")
+	proto.RegisterType((*RedelegateStakeResponse)(nil), "emissions.v5.RedelegateStakeResponse")
+	proto.RegisterType((*SetDelegateRewardAutoCompoundRequest)(nil), "emissions.v5.SetDelegateRewardAutoCompoundRequest")
+	proto.RegisterType((*SetDelegateRewardAutoCompoundResponse)(nil), "emissions.v5.SetDelegateRewardAutoCompoundResponse")
+	proto.RegisterType((*ClaimAllDelegateRewardsRequest)(nil), "emissions.v5.ClaimAllDelegateRewardsRequest")
+	proto.RegisterType((*ClaimAllDelegateRewardsResponse)(nil), "emissions.v5.ClaimAllDelegateRewardsResponse")
+	proto.RegisterType((*TokenizeDelegateStakeRequest)(nil), "emissions.v5.TokenizeDelegateStakeRequest")
+	proto.RegisterType((*TokenizeDelegateStakeResponse)(nil), "emissions.v5.TokenizeDelegateStakeResponse")
+	proto.RegisterType((*RedeemDelegateStakeReceiptRequest)(nil), "emissions.v5.RedeemDelegateStakeReceiptRequest")
+	proto.RegisterType((*RedeemDelegateStakeReceiptResponse)(nil), "emissions.v5.RedeemDelegateStakeReceiptResponse")
+	proto.RegisterType((*FundTopicRequest)(nil), "emissions.v5.FundTopicRequest")
+	proto.RegisterType((*FundTopicResponse)(nil), "emissions.v5.FundTopicResponse")
+	proto.RegisterType((*CreateFundingSubscriptionRequest)(nil), "emissions.v5.CreateFundingSubscriptionRequest")
+	proto.RegisterType((*CreateFundingSubscriptionResponse)(nil), "emissions.v5.CreateFundingSubscriptionResponse")
+	proto.RegisterType((*TopUpFundingSubscriptionRequest)(nil), "emissions.v5.TopUpFundingSubscriptionRequest")
+	proto.RegisterType((*TopUpFundingSubscriptionResponse)(nil), "emissions.v5.TopUpFundingSubscriptionResponse")
+	proto.RegisterType((*CancelFundingSubscriptionRequest)(nil), "emissions.v5.CancelFundingSubscriptionRequest")
+	proto.RegisterType((*CancelFundingSubscriptionResponse)(nil), "emissions.v5.CancelFundingSubscriptionResponse")
+	proto.RegisterType((*AddToWhitelistAdminRequest)(nil), "emissions.v5.AddToWhitelistAdminRequest")
+	proto.RegisterType((*AddToWhitelistAdminResponse)(nil), "emissions.v5.AddToWhitelistAdminResponse")
+	proto.RegisterType((*RemoveFromWhitelistAdminRequest)(nil), "emissions.v5.RemoveFromWhitelistAdminRequest")
+	proto.RegisterType((*RemoveFromWhitelistAdminResponse)(nil), "emissions.v5.RemoveFromWhitelistAdminResponse")
+	proto.RegisterType((*EnableTopicWorkerWhitelistRequest)(nil), "emissions.v5.EnableTopicWorkerWhitelistRequest")
+	proto.RegisterType((*EnableTopicWorkerWhitelistResponse)(nil), "emissions.v5.EnableTopicWorkerWhitelistResponse")
+	proto.RegisterType((*DisableTopicWorkerWhitelistRequest)(nil), "emissions.v5.DisableTopicWorkerWhitelistRequest")
+	proto.RegisterType((*DisableTopicWorkerWhitelistResponse)(nil), "emissions.v5.DisableTopicWorkerWhitelistResponse")
+	proto.RegisterType((*EnableTopicReputerWhitelistRequest)(nil), "emissions.v5.EnableTopicReputerWhitelistRequest")
+	proto.RegisterType((*EnableTopicReputerWhitelistResponse)(nil), "emissions.v5.EnableTopicReputerWhitelistResponse")
+	proto.RegisterType((*DisableTopicReputerWhitelistRequest)(nil), "emissions.v5.DisableTopicReputerWhitelistRequest")
+	proto.RegisterType((*DisableTopicReputerWhitelistResponse)(nil), "emissions.v5.DisableTopicReputerWhitelistResponse")
+	proto.RegisterType((*AddToTopicWorkerWhitelistRequest)(nil), "emissions.v5.AddToTopicWorkerWhitelistRequest")
+	proto.RegisterType((*AddToTopicWorkerWhitelistResponse)(nil), "emissions.v5.AddToTopicWorkerWhitelistResponse")
+	proto.RegisterType((*RemoveFromTopicWorkerWhitelistRequest)(nil), "emissions.v5.RemoveFromTopicWorkerWhitelistRequest")
+	proto.RegisterType((*RemoveFromTopicWorkerWhitelistResponse)(nil), "emissions.v5.RemoveFromTopicWorkerWhitelistResponse")
+	proto.RegisterType((*AddToTopicReputerWhitelistRequest)(nil), "emissions.v5.AddToTopicReputerWhitelistRequest")
+	proto.RegisterType((*AddToTopicReputerWhitelistResponse)(nil), "emissions.v5.AddToTopicReputerWhitelistResponse")
+	proto.RegisterType((*RemoveFromTopicReputerWhitelistRequest)(nil), "emissions.v5.RemoveFromTopicReputerWhitelistRequest")
+	proto.RegisterType((*RemoveFromTopicReputerWhitelistResponse)(nil), "emissions.v5.RemoveFromTopicReputerWhitelistResponse")
+	proto.RegisterType((*RewardDelegateStakeResponse)(nil), "emissions.v5.RewardDelegateStakeResponse")
+	proto.RegisterType((*RewardDelegateStakeRequest)(nil), "emissions.v5.RewardDelegateStakeRequest")
+}
+
+func init() { proto.RegisterFile("emissions/v5/tx.proto", fileDescriptor_6928f235d40e7988) }
+
+var fileDescriptor_6928f235d40e7988 = []byte{
+	// 4889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0xdd, 0x6f, 0x1c, 0x47,
+	0x72, 0xf7, 0x68, 0x97, 0x14, 0x55, 0xfc, 0x5a, 0x36, 0xbf, 0x86, 0xc3, 0xaf, 0xe5, 0x4a, 0xb2,
+	0x28, 0xd9, 0x22, 0x65, 0xc9, 0xce, 0xf9, 0xec, 0x0b, 0x12, 0x4a, 0x94, 0x7c, 0xa2, 0x25, 0x99,
+	0x37, 0xa4, 0x6c, 0xc7, 0x0e, 0x6e, 0xae, 0x35, 0xd3, 0x5c, 0xce, 0x69, 0x3e, 0xf6, 0x66, 0x66,
+	0x97, 0x94, 0xe1, 0x5c, 0x12, 0x03, 0x07, 0x04, 0x08, 0x90, 0x04, 0x79, 0x08, 0x82, 0x24, 0x08,
+	0x02, 0xe4, 0x25, 0x48, 0x82, 0xe0, 0x1e, 0x82, 0x00, 0x09, 0xf2, 0x10, 0xe4, 0xe9, 0x1e, 0x0f,
+	0xf7, 0x94, 0x04, 0xc1, 0xe1, 0x60, 0x07, 0xb8, 0xb7, 0xfc, 0x0d, 0x41, 0x7f, 0xcc, 0xe7, 0xce,
+	0xec, 0x2e, 0x39, 0xa4, 0xed, 0xbc, 0xd8, 0x9c, 0xee, 0xea, 0xaa, 0x5f, 0x55, 0x57, 0x57, 0x75,
+	0x57, 0xf7, 0x0a, 0x66, 0x89, 0x6d, 0xfa, 0xbe, 0xe9, 0x3a, 0xfe, 0x66, 0xe7, 0x8d, 0xcd, 0xe0,
+	0x78, 0xa3, 0xe5, 0xb9, 0x81, 0x8b, 0xc6, 0xa2, 0xe6, 0x8d, 0xce, 0x1b, 0xca, 0x14, 0xb6, 0x4d,
+	0xc7, 0xdd, 0x64, 0xff, 0xe5, 0x04, 0xca, 0xbc, 0xee, 0xfa, 0xb6, 0xeb, 0x6f, 0xda, 0x7e, 0x73,
+	0xb3, 0xf3, 0x1a, 0xfd, 0x9f, 0xe8, 0x58, 0xe0, 0x1d, 0x1a, 0xfb, 0xda, 0xe4, 0x1f, 0xa2, 0x4b,
+	0x49, 0xc8, 0xba, 0xb3, 0xe9, 0x91, 0x56, 0x3b, 0x20, 0x9e, 0xe8, 0x93, 0x53, 0x7d, 0x81, 0xdb,
+	0x32, 0xf5, 0x90, 0x61, 0xaa, 0xe7, 0xc8, 0xf5, 0x9e, 0x47, 0x83, 0x66, 0x9a, 0x6e, 0xd3, 0xe5,
+	0x82, 0xe8, 0x5f, 0xbc, 0xb5, 0xf1, 0x9f, 0x57, 0x61, 0xe2, 0xbd, 0x56, 0x60, 0xba, 0x0e, 0xb6,
+	0x76, 0xb1, 0x87, 0x6d, 0x1f, 0xc9, 0x70, 0xb1, 0x43, 0x3c, 0xca, 0x44, 0x96, 0xea, 0x95, 0xf5,
+	0x4b, 0x6a, 0xf8, 0x89, 0xbe, 0x09, 0x0b, 0x36, 0x3e, 0xd6, 0x7c, 0xe2, 0x99, 0xd8, 0x32, 0x3f,
+	0x21, 0x86, 0x66, 0xfb, 0x4d, 0xcd, 0x22, 0x4e, 0x33, 0x38, 0x94, 0x2f, 0xd4, 0x2b, 0xeb, 0x15,
+	0x75, 0xce, 0xc6, 0xc7, 0x7b, 0x51, 0xff, 0x63, 0xbf, 0xf9, 0x88, 0xf5, 0x22, 0x0c, 0x35, 0xdb,
+	0x74, 0x34, 0x86, 0x55, 0x3b, 0x22, 0x66, 0xf3, 0x30, 0x90, 0x2b, 0x94, 0xfb, 0xdd, 0x6f, 0xfc,
+	0xe4, 0xe7, 0xab, 0x2f, 0xfd, 0xd7, 0xcf, 0x57, 0x37, 0x9b, 0x66, 0x70, 0xd8, 0x7e, 0xb6, 0xa1,
+	0xbb, 0xf6, 0x26, 0xb6, 0x2c, 0xd7, 0xc3, 0x37, 0x1d, 0x12, 0x50, 0x15, 0xc2, 0x4f, 0xfd, 0x10,
+	0x9b, 0xce, 0xa6, 0x8d, 0x83, 0xc3, 0x8d, 0x6d, 0xa2, 0xab, 0x13, 0xb6, 0xe9, 0xec, 0x53, 0x7e,
+	0x1f, 0x30, 0x76, 0xe8, 0x00, 0xe6, 0x3c, 0xf2, 0x83, 0xb6, 0xe9, 0x51, 0x5c, 0xa6, 0x63, 0xda,
+	0x6d, 0x5b, 0xf3, 0x03, 0xfc, 0x9c, 0xc8, 0x43, 0x4c, 0xd0, 0x2d, 0x21, 0x68, 0x96, 0xdb, 0xd9,
+	0x37, 0x9e, 0x6f, 0x98, 0x2e, 0x67, 0xf7, 0xd0, 0x09, 0x7e, 0xf6, 0x8f, 0x37, 0x41, 0x4c, 0xc0,
+	0x43, 0x27, 0xf8, 0x9b, 0x5f, 0xfe, 0xf8, 0x86, 0xa4, 0xce, 0x84, 0xfc, 0x1e, 0x73, 0x76, 0x7b,
+	0x94, 0x1b, 0xb5, 0x82, 0x47, 0x6c, 0xb7, 0x43, 0x38, 0x77, 0xcd, 0x20, 0x16, 0x7e, 0xa1, 0x1d,
+	0x99, 0x8e, 0xe1, 0x1e, 0xc9, 0xc3, 0xdc, 0x0a, 0x9c, 0x80, 0xd1, 0x6f, 0xd3, 0xee, 0x0f, 0x58,
+	0x2f, 0x5a, 0xe7, 0x56, 0x20, 0x2d, 0x57, 0x3f, 0x0c, 0xed, 0x76, 0x91, 0x8d, 0xa0, 0xca, 0xdc,
+	0xa7, 0xcd, 0xc2, 0x5e, 0x1f, 0xc1, 0xd8, 0x33, 0x12, 0x60, 0x8d, 0x38, 0x81, 0xe7, 0xb6, 0x5e,
+	0xc8, 0x23, 0xe5, 0x6c, 0x35, 0x4a, 0x99, 0xdd, 0xe7, 0xbc, 0xd0, 0x6f, 0xc2, 0xb8, 0x45, 0xb0,
+	0xe7, 0x98, 0x4e, 0x53, 0xf3, 0x70, 0x40, 0xe4, 0x4b, 0xe5, 0x98, 0x8f, 0x85, 0xdc, 0x54, 0x1c,
+	0x10, 0x64, 0x03, 0xf5, 0x01, 0xad, 0xe9, 0x61, 0xc3, 0x24, 0x4e, 0xa0, 0x05, 0x87, 0x1e, 0xf1,
+	0x0f, 0x5d, 0xcb, 0x90, 0xa1, 0x9c, 0x98, 0x19, 0x1b, 0x1f, 0xbf, 0x23, 0xb8, 0xee, 0x87, 0x4c,
+	0x11, 0x01, 0x44, 0x4d, 0xca, 0xa7, 0xe2, 0xc0, 0xc3, 0x3a, 0xf5, 0x65, 0x79, 0xb4, 0x9c, 0x28,
+	0x3a, 0x4b, 0x6c, 0xf2, 0x1e, 0x08, 0x86, 0xe8, 0x3e, 0xac, 0x52, 0xad, 0xda, 0xce, 0x41, 0xdb,
+	0x3a, 0x30, 0x2d, 0x8b, 0x18, 0x1a, 0x5f, 0x5d, 0x1a, 0xf5, 0x11, 0xe2, 0x07, 0xbe, 0x3c, 0x5e,
+	0xaf, 0xac, 0x57, 0xd5, 0x25, 0x1b, 0x1f, 0x3f, 0x8d, 0xa9, 0x3e, 0x60, 0x44, 0xaa, 0xa0, 0x41,
+	0xef, 0x40, 0x3d, 0xcb, 0x46, 0x2c, 0xed, 0x98, 0xcf, 0x04, 0xe3, 0xb3, 0x9c, 0xe6, 0xa3, 0x72,
+	0xaa, 0x88, 0xd1, 0x27, 0xb0, 0xcc, 0xd7, 0x92, 0x47, 0x8e, 0xb0, 0x67, 0x08, 0xfd, 0x4d, 0xbb,
+	0xe5, 0x7a, 0x01, 0x76, 0x74, 0x22, 0x4f, 0x96, 0xb3, 0x80, 0xc2, 0xb8, 0xab, 0x8c, 0x39, 0xb3,
+	0xc4, 0xc3, 0x88, 0x35, 0xfa, 0x91, 0x04, 0x97, 0x53, 0xc2, 0x0f, 0x08, 0xd1, 0x3c, 0xd2, 0x21,
+	0x4e, 0x3b, 0x05, 0xa1, 0x56, 0x0e, 0xc2, 0x6a, 0x02, 0xc2, 0x03, 0x42, 0x54, 0x2e, 0x20, 0x81,
+	0x83, 0x00, 0x4a, 0xc1, 0xc0, 0x56, 0xeb, 0x10, 0xcb, 0x53, 0x25, 0xa7, 0x3e, 0x21, 0x75, 0x8b,
+	0x32, 0x44, 0x3a, 0x4c, 0x05, 0xd8, 0x7f, 0x9e, 0x96, 0x82, 0xca, 0x49, 0x99, 0xa4, 0x1c, 0x93,
+	0x42, 0xa8, 0x4d, 0x3b, 0xd8, 0x32, 0x0d, 0x1c, 0xb8, 0x9e, 0xaf, 0x75, 0x7c, 0x8d, 0x0f, 0xd4,
+	0x5a, 0xc4, 0xd3, 0xe9, 0x32, 0xe2, 0xd2, 0xe5, 0xe9, 0x92, 0x36, 0x8d, 0x65, 0xbc, 0xef, 0x6f,
+	0x31, 0x92, 0x5d, 0x2e, 0x80, 0x83, 0x41, 0xdf, 0x82, 0x45, 0x16, 0xe2, 0xb1, 0xdd, 0xb2, 0x88,
+	0xaf, 0x05, 0xae, 0xe6, 0xeb, 0xd8, 0x22, 0x9a, 0xaf, 0xbb, 0x1e, 0xf1, 0xe5, 0x19, 0xe6, 0x9b,
+	0xf3, 0x34, 0xc8, 0x73, 0x8a, 0x7d, 0x77, 0x8f, 0xf6, 0xef, 0xb1, 0x6e, 0xf4, 0x16, 0x28, 0x74,
+	0x74, 0xe0, 0xb6, 0x34, 0xd3, 0x39, 0x20, 0x1e, 0xf1, 0x18, 0x0b, 0x81, 0x7d, 0x96, 0x0d, 0xa6,
+	0xd1, 0x61, 0xdf, 0x6d, 0x3d, 0x14, 0xfd, 0xfb, 0xae, 0x90, 0xfc, 0xeb, 0xb0, 0x1c, 0x8e, 0x3d,
+	0x70, 0x3d, 0xa2, 0x63, 0x3f, 0x48, 0x0f, 0x9f, 0x63, 0xc3, 0x17, 0xf8, 0xf0, 0x07, 0x31, 0x49,
+	0xc4, 0x21, 0x21, 0x5d, 0x2c, 0xaa, 0xe4, 0xf0, 0xf9, 0xa4, 0x74, 0xb1, 0x9c, 0xe2, 0xb1, 0x1f,
+	0x41, 0x4d, 0xf7, 0x08, 0x0e, 0x88, 0x48, 0x51, 0x07, 0x84, 0xc8, 0xf2, 0x29, 0xd3, 0xc6, 0x04,
+	0xe7, 0xc4, 0x72, 0xd3, 0x03, 0x42, 0xd0, 0xdb, 0xa0, 0x44, 0xd1, 0xd0, 0x20, 0x3e, 0x9b, 0x4e,
+	0x0a, 0xd4, 0xa4, 0x08, 0xe4, 0x05, 0x6e, 0xd2, 0x90, 0x62, 0x9b, 0x13, 0x3c, 0xc6, 0xc7, 0x0f,
+	0x69, 0x37, 0xfa, 0x18, 0x6a, 0x1e, 0x69, 0x9a, 0x7e, 0xe0, 0x61, 0x1a, 0x88, 0x18, 0xb0, 0xa5,
+	0x53, 0x02, 0x9b, 0x4c, 0x72, 0xa2, 0xc8, 0x5e, 0x05, 0x64, 0x90, 0x03, 0xdc, 0xb6, 0x02, 0xad,
+	0x85, 0x9b, 0x44, 0xb3, 0x4c, 0xdb, 0x0c, 0xe4, 0x65, 0x86, 0xa8, 0x26, 0x7a, 0x76, 0x71, 0x93,
+	0x3c, 0xa2, 0xed, 0xe8, 0x0a, 0x4c, 0x50, 0xd8, 0x09, 0xca, 0x15, 0x46, 0x39, 0x66, 0xe3, 0xe3,
+	0x98, 0x8a, 0xce, 0x63, 0x26, 0xc7, 0x69, 0x1e, 0xd1, 0x5d, 0xcf, 0x10, 0x83, 0x56, 0x59, 0xc2,
+	0x5b, 0x48, 0x27, 0x3c, 0x95, 0x51, 0x70, 0x0e, 0xeb, 0x50, 0x7b, 0x66, 0xb9, 0xfa, 0x73, 0x9f,
+	0x3a, 0xbf, 0x66, 0xbb, 0x4e, 0x70, 0x28, 0xd7, 0x99, 0xa4, 0x09, 0xde, 0xbe, 0x4b, 0xbc, 0xc7,
+	0xb4, 0x95, 0x46, 0x80, 0x56, 0xb8, 0x2e, 0xb9, 0xc3, 0xd1, 0xb8, 0xb3, 0x56, 0x32, 0x02, 0xb4,
+	0xb8, 0x4f, 0x3c, 0x0c, 0x19, 0xd2, 0x08, 0x10, 0x89, 0x09, 0x7d, 0x53, 0x6e, 0x94, 0x8c, 0x00,
+	0x42, 0x4a, 0xe8, 0xc8, 0x74, 0x87, 0x14, 0x09, 0x11, 0xee, 0x2b, 0x5f, 0x2e, 0xb9, 0x43, 0x12,
+	0x32, 0x84, 0xb7, 0x53, 0x73, 0xe9, 0xdd, 0xe6, 0xba, 0x52, 0xd2, 0x5c, 0x7a, 0x8e, 0xb9, 0xf4,
+	0x2e, 0x73, 0x5d, 0x2d, 0x69, 0x2e, 0x3d, 0x63, 0xae, 0x27, 0x30, 0xac, 0x6b, 0x8e, 0xeb, 0xd9,
+	0xf2, 0xcb, 0xe5, 0x38, 0x0f, 0xe9, 0x4f, 0x5c, 0xcf, 0x46, 0xdf, 0x83, 0x49, 0xd2, 0xf2, 0x4d,
+	0xcb, 0x75, 0x22, 0xeb, 0xaf, 0x97, 0xb4, 0xbe, 0xe0, 0x17, 0x5a, 0xff, 0x7d, 0xb8, 0x7e, 0x88,
+	0xad, 0x03, 0xb6, 0xf4, 0x5b, 0x9e, 0xab, 0x13, 0xdf, 0x17, 0x69, 0x9b, 0xed, 0x16, 0xb1, 0xe5,
+	0x6b, 0xc4, 0x31, 0x34, 0xe6, 0xe2, 0xf2, 0x0d, 0xe6, 0xef, 0x97, 0xe9, 0x80, 0xc7, 0xf8, 0x78,
+	0x97, 0x93, 0xb3, 0x44, 0xac, 0x0a, 0xe2, 0xfb, 0x8e, 0x71, 0x97, 0x92, 0xd2, 0xd0, 0x65, 0xe0,
+	0x00, 0x6b, 0x3e, 0x71, 0x0c, 0xba, 0xa5, 0xa3, 0x11, 0xe2, 0x95, 0xd3, 0x86, 0x2e, 0xca, 0x69,
+	0x8f, 0x33, 0xa2, 0x01, 0x02, 0x43, 0x2d, 0xb4, 0x8a, 0x8f, 0x0f, 0x88, 0x66, 0x98, 0x1d, 0xf9,
+	0xd5, 0xb3, 0x31, 0xcb, 0x1e, 0x3e, 0x20, 0xdb, 0x66, 0x27, 0x3c, 0x54, 0x10, 0x8b, 0xd8, 0xc4,
+	0x09, 0xf8, 0x9a, 0x8f, 0xbc, 0xe6, 0x66, 0x14, 0xb4, 0xef, 0x8b, 0xfe, 0x5d, 0xe2, 0x45, 0x3e,
+	0x20, 0x92, 0x15, 0xdd, 0xa2, 0x75, 0x44, 0xe0, 0xe6, 0xe3, 0xb9, 0x0d, 0x37, 0xa2, 0x64, 0xb5,
+	0xc5, 0x28, 0x58, 0x40, 0xa6, 0x0c, 0xb8, 0xdd, 0x6e, 0xc0, 0x14, 0x1d, 0xed, 0x07, 0x1e, 0xb5,
+	0x9a, 0xd8, 0x8d, 0x6f, 0xb2, 0x31, 0x93, 0x34, 0xc1, 0xb1, 0x76, 0xb1, 0x1d, 0x77, 0x61, 0xde,
+	0x74, 0xcc, 0xc0, 0xc4, 0x96, 0xe6, 0x91, 0xa6, 0x47, 0x02, 0xed, 0x07, 0x6d, 0xec, 0x04, 0xa6,
+	0x45, 0xe4, 0x5b, 0xe5, 0xcc, 0x31, 0x2b, 0xf8, 0xaa, 0x8c, 0xed, 0x77, 0x04, 0x57, 0xf4, 0x5d,
+	0x98, 0x6c, 0x31, 0xf7, 0x8e, 0xed, 0xfe, 0x5a, 0xc9, 0x5d, 0x7a, 0x8b, 0xfa, 0x79, 0x68, 0x75,
+	0x15, 0xae, 0x71, 0x3f, 0x6c, 0x3b, 0x44, 0xc3, 0x9e, 0x7e, 0x68, 0x76, 0x88, 0x21, 0x72, 0xdf,
+	0x73, 0xf2, 0x22, 0xe9, 0x8a, 0xb7, 0x99, 0x49, 0xd6, 0x68, 0x90, 0xa7, 0xd4, 0x5b, 0x82, 0x98,
+	0x59, 0xf3, 0x5d, 0xf2, 0x22, 0x76, 0xc4, 0xa7, 0xb0, 0x4e, 0x79, 0x1e, 0xb4, 0xb9, 0x1f, 0xfa,
+	0xed, 0x67, 0xbe, 0xee, 0x99, 0xec, 0x70, 0xa9, 0x19, 0x9e, 0xd9, 0x4a, 0x32, 0xbd, 0xc3, 0xfd,
+	0xdb, 0xc6, 0xc7, 0x0f, 0x38, 0xf9, 0x5e, 0x82, 0x7a, 0x9b, 0x12, 0x47, 0x6c, 0x8f, 0x61, 0x49,
+	0xa4, 0x75, 0x53, 0xd7, 0x58, 0x6a, 0x75, 0x3d, 0x4d, 0x77, 0x6d, 0x71, 0xd2, 0x95, 0x5f, 0x2f,
+	0x67, 0x17, 0xb1, 0xa1, 0x30, 0xf5, 0x7b, 0x9c, 0xf5, 0xbd, 0x88, 0x33, 0x7a, 0x1d, 0xe6, 0x1c,
+	0xd7, 0x20, 0x9a, 0xe7, 0x06, 0x3c, 0xf9, 0xea, 0xae, 0x6b, 0x19, 0xee, 0x91, 0x23, 0xbf, 0xc1,
+	0x72, 0xd8, 0x0c, 0xed, 0x55, 0x45, 0xe7, 0x3d, 0xd1, 0x87, 0x7e, 0x0d, 0x96, 0xc2, 0x3d, 0xbd,
+	0xdb, 0x0e, 0x2c, 0x93, 0x78, 0x9a, 0x6f, 0x61, 0xff, 0x90, 0xa7, 0x44, 0x5f, 0xfe, 0x15, 0xbe,
+	0x8f, 0x11, 0x34, 0xef, 0x71, 0x92, 0x3d, 0x4a, 0xc1, 0xf2, 0xa1, 0x8f, 0x3e, 0x85, 0x95, 0x7c,
+	0x06, 0xd1, 0xf1, 0xe6, 0x1b, 0xe5, 0x54, 0x5e, 0xcc, 0x91, 0x1d, 0x9d, 0x74, 0xee, 0xf0, 0xf3,
+	0x1b, 0xb5, 0x01, 0x31, 0x04, 0x66, 0xed, 0xfb, 0xd8, 0xb4, 0xe4, 0x37, 0x19, 0xf0, 0x69, 0x1b,
+	0x1f, 0x3f, 0x66, 0x9d, 0x1c, 0xee, 0x0e, 0x36, 0x2d, 0xaa, 0x73, 0xf7, 0x20, 0x83, 0xf0, 0xfd,
+	0x06, 0xf1, 0xe4, 0x6f, 0x46, 0x7b, 0xb7, 0xe4, 0xd0, 0xed, 0x88, 0x00, 0xbd, 0x0b, 0x8d, 0x50,
+	0xe7, 0x78, 0x6a, 0xb5, 0x76, 0xcb, 0xa0, 0x5b, 0xb2, 0xc8, 0xec, 0x6f, 0x31, 0xb3, 0xaf, 0x0a,
+	0xca, 0x78, 0xa6, 0x9e, 0x32, 0xba, 0x68, 0x06, 0x7e, 0xc8, 0x4f, 0x59, 0x06, 0xb1, 0x48, 0x93,
+	0x6f, 0xe9, 0x34, 0x9f, 0x58, 0x07, 0x61, 0xb4, 0xa5, 0xd3, 0x25, 0xbf, 0x5d, 0xd2, 0x84, 0x36,
+	0x3e, 0xde, 0x16, 0xfc, 0xf7, 0xdd, 0x3d, 0x62, 0x1d, 0xf0, 0xe0, 0x4c, 0x79, 0xa3, 0x00, 0x56,
+	0x52, 0xf2, 0xb9, 0xdc, 0x16, 0x3b, 0xe4, 0xf1, 0xd4, 0xf2, 0xad, 0x53, 0xc6, 0x67, 0x25, 0x21,
+	0x96, 0x09, 0xdc, 0x25, 0x5e, 0x98, 0x5f, 0xc4, 0xf2, 0xc3, 0xed, 0xc0, 0xa5, 0x36, 0x6c, 0xb9,
+	0x6d, 0xc7, 0x08, 0x31, 0x98, 0xae, 0xc3, 0x23, 0x63, 0x0b, 0xbf, 0x70, 0xdb, 0x81, 0xfc, 0xab,
+	0xd1, 0xf2, 0xdb, 0x6a, 0x07, 0xee, 0x3d, 0x41, 0xbd, 0x1d, 0x13, 0xef, 0x12, 0x6f, 0x97, 0x91,
+	0xee, 0x54, 0x47, 0xaa, 0xb5, 0xa1, 0x9d, 0xea, 0x88, 0x52, 0x5b, 0xdc, 0xa9, 0x8e, 0x2c, 0xd6,
+	0x96, 0x76, 0xaa, 0x23, 0xd7, 0x6a, 0xeb, 0x3b, 0xd5, 0x91, 0xeb, 0xb5, 0x1b, 0xea, 0x4c, 0xb4,
+	0x34, 0x13, 0x91, 0x57, 0x5d, 0x64, 0x3b, 0xc0, 0x83, 0x03, 0x92, 0x88, 0xcc, 0xe1, 0x09, 0x91,
+	0x49, 0xd5, 0x3c, 0x12, 0x78, 0x26, 0x3f, 0x60, 0xf0, 0x33, 0xae, 0xe6, 0xb8, 0x8e, 0x4e, 0x7c,
+	0x71, 0xaa, 0x56, 0x97, 0xa2, 0x6d, 0x78, 0x38, 0x4e, 0x33, 0x88, 0x8e, 0x5f, 0xd0, 0xd9, 0x23,
+	0xea, 0x95, 0x9e, 0x2c, 0x84, 0x91, 0x1b, 0x2d, 0x98, 0xe6, 0x8e, 0xc1, 0x0b, 0x5b, 0xe2, 0xec,
+	0x8c, 0xe6, 0x60, 0x98, 0xa6, 0x4a, 0xe2, 0xc9, 0x52, 0x5d, 0x5a, 0xbf, 0xa4, 0x8a, 0x2f, 0xf4,
+	0x3a, 0x0c, 0xb7, 0x18, 0xa1, 0x7c, 0xa1, 0x2e, 0xad, 0x8f, 0xde, 0x5e, 0xda, 0x48, 0xd6, 0xf5,
+	0x36, 0xd2, 0x55, 0x32, 0x55, 0xd0, 0xbe, 0x35, 0xfa, 0xd9, 0x2f, 0x7f, 0x7c, 0x43, 0xb0, 0x68,
+	0xcc, 0xc1, 0x4c, 0x5a, 0xa2, 0xdf, 0x72, 0x1d, 0x9f, 0x34, 0xfe, 0x69, 0x02, 0x66, 0x59, 0x78,
+	0x21, 0x4f, 0xc8, 0xd1, 0x3e, 0x3f, 0x60, 0x72, 0x30, 0x32, 0x5c, 0x14, 0x21, 0x4d, 0xa0, 0x09,
+	0x3f, 0x91, 0x02, 0x23, 0x36, 0x09, 0x30, 0x4d, 0xc8, 0x0c, 0xd0, 0x25, 0x35, 0xfa, 0x46, 0xab,
+	0x30, 0x6a, 0xb9, 0xbe, 0xaf, 0xd9, 0x24, 0x38, 0x74, 0x0d, 0xb9, 0xca, 0xba, 0x81, 0x36, 0x3d,
+	0x66, 0x2d, 0x68, 0x0d, 0xc6, 0x32, 0x45, 0x26, 0x69, 0xbd, 0xa2, 0x8e, 0x92, 0x44, 0x85, 0x69,
+	0x1d, 0x6a, 0x4d, 0x8f, 0xf9, 0x48, 0xe0, 0xb5, 0x83, 0x43, 0xcd, 0xc2, 0x4d, 0x79, 0x84, 0x91,
+	0x4d, 0xf0, 0xf6, 0x7d, 0xda, 0xfc, 0x08, 0x37, 0xe9, 0x56, 0x8b, 0xe7, 0x22, 0x19, 0xea, 0x52,
+	0x99, 0x45, 0x33, 0xc4, 0x52, 0x10, 0xad, 0x6d, 0xb1, 0x43, 0xb4, 0x48, 0xa5, 0xf2, 0x68, 0x39,
+	0xae, 0xa3, 0x8c, 0x19, 0xcf, 0x9f, 0xe8, 0x2a, 0x4c, 0x50, 0xaa, 0x23, 0xcd, 0x61, 0x9e, 0xdc,
+	0x21, 0xf2, 0x58, 0x5d, 0x5a, 0x1f, 0x51, 0xc7, 0x59, 0xeb, 0x13, 0xd1, 0x88, 0xbe, 0x03, 0x17,
+	0xc5, 0x36, 0x44, 0x1e, 0x2f, 0x27, 0x3d, 0xe4, 0x83, 0xde, 0x04, 0x59, 0x54, 0x84, 0xfc, 0xf6,
+	0xb3, 0x30, 0x80, 0x89, 0xaa, 0xe0, 0x04, 0xb3, 0xeb, 0x1c, 0xef, 0xdf, 0x8b, 0xba, 0x45, 0x55,
+	0xf0, 0x39, 0xcc, 0xda, 0xc4, 0x33, 0x03, 0xcd, 0x77, 0xbd, 0xc0, 0x64, 0x89, 0x86, 0x17, 0x19,
+	0x26, 0xcb, 0x41, 0x9b, 0x66, 0x5c, 0xf7, 0x42, 0xa6, 0xbc, 0xd0, 0xe0, 0xc2, 0xbc, 0xd8, 0x2f,
+	0x89, 0x13, 0x7a, 0xbc, 0x93, 0xa9, 0x95, 0x13, 0x37, 0xcb, 0xf9, 0x8a, 0x83, 0x7d, 0xb4, 0x93,
+	0x69, 0x83, 0x22, 0x04, 0xc6, 0xc7, 0xfa, 0x58, 0xe6, 0x54, 0x39, 0x99, 0x32, 0x67, 0x1d, 0x57,
+	0x03, 0x22, 0xb1, 0xb1, 0x9e, 0x61, 0x5e, 0x89, 0x64, 0xa2, 0x33, 0xd1, 0x53, 0x84, 0xdd, 0x48,
+	0xe0, 0x5d, 0x98, 0x08, 0x5e, 0xb4, 0x68, 0xed, 0x39, 0x5c, 0xb5, 0xd3, 0x2c, 0x8c, 0x2c, 0x26,
+	0xc3, 0xc8, 0x9d, 0x0d, 0xb6, 0xfa, 0x1f, 0x0b, 0x12, 0x75, 0x9c, 0x0d, 0x09, 0x3f, 0xd1, 0x6d,
+	0x98, 0x65, 0xc2, 0x58, 0xfc, 0x6e, 0x07, 0xc4, 0xd0, 0xe8, 0x9a, 0x66, 0x75, 0x17, 0xea, 0xc4,
+	0xd3, 0xac, 0xf3, 0x9e, 0xe8, 0x7b, 0xc4, 0xba, 0xd0, 0x75, 0xa8, 0xb9, 0xed, 0xa0, 0xd5, 0x0e,
+	0x34, 0xc3, 0xb4, 0x89, 0xc3, 0xb6, 0x44, 0xb3, 0x75, 0x89, 0xee, 0x62, 0x79, 0xfb, 0x76, 0xd8,
+	0x4c, 0xcf, 0x38, 0xa1, 0x11, 0x34, 0x8b, 0x74, 0x88, 0xe5, 0xb3, 0xa2, 0x4a, 0x99, 0xcd, 0x7c,
+	0xc8, 0xef, 0x11, 0x63, 0x87, 0xde, 0x83, 0xe9, 0x54, 0x50, 0xf1, 0xdd, 0xb6, 0xa7, 0x13, 0x79,
+	0x9e, 0x59, 0x62, 0x35, 0x6d, 0x89, 0x77, 0xe2, 0x28, 0xb3, 0xc7, 0xc8, 0xd4, 0xa9, 0x66, 0xb6,
+	0x09, 0x1d, 0x00, 0xca, 0xd9, 0xf2, 0xc9, 0xe5, 0x66, 0x70, 0x4a, 0xef, 0xda, 0xea, 0xdd, 0x82,
+	0x99, 0xa8, 0x9e, 0xdb, 0x21, 0xd8, 0x0a, 0x57, 0xee, 0x02, 0x5b, 0xb9, 0x88, 0xf7, 0xa9, 0xac,
+	0x4b, 0xac, 0xda, 0xdb, 0x30, 0x1b, 0x97, 0x6e, 0x93, 0x43, 0x14, 0x36, 0x64, 0x5a, 0x74, 0xa6,
+	0xc6, 0x7c, 0x08, 0x93, 0x34, 0x33, 0x0a, 0x49, 0xcf, 0x5c, 0xc7, 0x90, 0x17, 0xeb, 0xd2, 0xa9,
+	0x76, 0x02, 0xe3, 0xb6, 0xe9, 0xf0, 0xe2, 0xf2, 0x5d, 0xd7, 0x31, 0x90, 0x0f, 0x0b, 0xd9, 0x3d,
+	0x63, 0x5c, 0x78, 0x5f, 0x2a, 0x67, 0xae, 0xf9, 0xf4, 0x76, 0x31, 0xaa, 0xbd, 0xbf, 0x35, 0x46,
+	0x73, 0x5f, 0x98, 0xb0, 0x76, 0xaa, 0x23, 0x95, 0x5a, 0x75, 0xa7, 0x3a, 0x32, 0x54, 0x1b, 0xde,
+	0xa9, 0x8e, 0x0c, 0xd7, 0x2e, 0xee, 0x54, 0x47, 0x2e, 0xd5, 0x80, 0xe7, 0x25, 0xcd, 0x72, 0x9b,
+	0xa6, 0xae, 0x4e, 0x46, 0x65, 0x07, 0xd1, 0x50, 0x8b, 0x1b, 0x78, 0x32, 0x53, 0x47, 0xc3, 0x8a,
+	0x14, 0xf6, 0x9a, 0x8d, 0x3b, 0x30, 0x97, 0xcd, 0x9b, 0x3c, 0xa5, 0xa2, 0x05, 0x18, 0xe1, 0x5b,
+	0x04, 0xd3, 0x60, 0x99, 0xb3, 0xaa, 0x5e, 0x64, 0xdf, 0x0f, 0x8d, 0xc6, 0xff, 0x8e, 0x02, 0xe2,
+	0x69, 0x38, 0x95, 0x6a, 0x8b, 0xf2, 0x7e, 0x92, 0xd3, 0x85, 0x14, 0xa7, 0x54, 0x0e, 0x66, 0xb7,
+	0x55, 0x89, 0x1c, 0x9c, 0x4d, 0xb1, 0xd5, 0x7a, 0x65, 0x90, 0x14, 0x3b, 0xc4, 0xaf, 0x7b, 0x32,
+	0x29, 0xb6, 0x57, 0xf2, 0x10, 0x57, 0x4a, 0x05, 0xc9, 0x23, 0x4e, 0xce, 0x17, 0xeb, 0x95, 0x73,
+	0x48, 0xce, 0x65, 0x2f, 0x9e, 0x92, 0xc9, 0x39, 0x91, 0x75, 0x4b, 0x5e, 0x39, 0x45, 0x59, 0xb7,
+	0x30, 0x77, 0x96, 0xbc, 0x6c, 0x3a, 0x69, 0xee, 0x2c, 0x79, 0xe1, 0x74, 0xaa, 0xdc, 0x39, 0x56,
+	0xaf, 0x7c, 0xe9, 0xb9, 0x73, 0xbc, 0x5e, 0xf9, 0x52, 0x72, 0x27, 0xbd, 0x04, 0x3b, 0x59, 0xee,
+	0x2c, 0x48, 0x3d, 0x93, 0xf5, 0xca, 0x99, 0xa6, 0x9e, 0x92, 0x97, 0x5a, 0x27, 0x48, 0x3d, 0x53,
+	0xf5, 0xca, 0x49, 0x53, 0x0f, 0xaa, 0x57, 0x4e, 0x90, 0x7a, 0xa6, 0xeb, 0x95, 0x73, 0x4f, 0x3d,
+	0x33, 0xf5, 0xca, 0xb9, 0xa4, 0x9e, 0xd4, 0xb1, 0x6b, 0x36, 0x3c, 0xe8, 0xa5, 0x52, 0x44, 0xe3,
+	0x29, 0x4c, 0x8b, 0x62, 0x55, 0xc9, 0x3c, 0xd0, 0x75, 0xc8, 0x4b, 0xb3, 0x15, 0xe2, 0xfe, 0x44,
+	0x82, 0xc5, 0x87, 0x8e, 0x4f, 0xbc, 0x40, 0xb8, 0xf7, 0x2e, 0x7e, 0x61, 0xb9, 0xd8, 0xe8, 0x27,
+	0x57, 0x85, 0x99, 0xd0, 0x7e, 0x1d, 0x6c, 0xb5, 0x89, 0xf6, 0xac, 0xed, 0x18, 0x16, 0x11, 0xa7,
+	0xd0, 0x7a, 0xda, 0x73, 0x05, 0xeb, 0xf7, 0x29, 0xe1, 0x5d, 0x46, 0xa7, 0x22, 0xaf, 0xab, 0x2d,
+	0x0d, 0x78, 0x05, 0x96, 0xf2, 0x71, 0x09, 0xe0, 0x7f, 0x27, 0xc1, 0x22, 0xf3, 0xc7, 0x13, 0x02,
+	0xef, 0x91, 0x38, 0x5f, 0x05, 0xc4, 0x0e, 0xe3, 0xbc, 0x1e, 0xa0, 0x1d, 0x86, 0x0f, 0x3e, 0xe8,
+	0xce, 0xa8, 0xc6, 0x7a, 0x58, 0x6d, 0xef, 0xdb, 0xac, 0x1d, 0xad, 0x00, 0xb0, 0x15, 0x16, 0xd8,
+	0xc4, 0x09, 0xd8, 0x69, 0x76, 0x4c, 0x4d, 0xb4, 0x74, 0x69, 0x93, 0x0f, 0x56, 0x68, 0xf3, 0xb7,
+	0x12, 0x2c, 0x72, 0xcf, 0xff, 0xca, 0xa7, 0x01, 0x21, 0xa8, 0xfa, 0xd8, 0xe2, 0x8a, 0x8f, 0xa9,
+	0xec, 0xef, 0x2e, 0x65, 0xf2, 0xb1, 0x0a, 0x65, 0xfe, 0x50, 0x02, 0x85, 0xcf, 0x1d, 0x5f, 0x70,
+	0x03, 0xea, 0xf2, 0x08, 0x44, 0xd8, 0xd0, 0xd8, 0xcd, 0x40, 0x4a, 0x93, 0x95, 0xb4, 0x26, 0x9c,
+	0xef, 0x36, 0x0e, 0xb0, 0xd0, 0xa3, 0x76, 0x94, 0x69, 0x49, 0x23, 0x5e, 0x0e, 0x9d, 0x3c, 0x03,
+	0x48, 0x00, 0xfe, 0x63, 0x29, 0xb7, 0xbf, 0x6f, 0xf1, 0xe5, 0x09, 0x4c, 0x77, 0x23, 0xf6, 0xd9,
+	0xa3, 0xa2, 0xfe, 0x90, 0xa7, 0xb2, 0x90, 0x33, 0x65, 0x99, 0x63, 0x98, 0xce, 0xa2, 0x6d, 0x5b,
+	0x41, 0x8f, 0x2d, 0x24, 0x85, 0xc9, 0x79, 0x8a, 0xd2, 0x8b, 0xf8, 0xa2, 0xe5, 0x1a, 0xbf, 0xad,
+	0xeb, 0xc4, 0xf7, 0xd9, 0x9c, 0x8e, 0xa8, 0xe1, 0x27, 0x9a, 0x81, 0x21, 0xe2, 0x79, 0xae, 0x27,
+	0x8a, 0x31, 0xfc, 0xa3, 0xf1, 0x71, 0xb8, 0xf4, 0xb2, 0xd6, 0x10, 0xbb, 0xd8, 0xb7, 0xe1, 0xa2,
+	0xc7, 0xc0, 0xf8, 0xec, 0xad, 0xd5, 0xe8, 0xed, 0xb5, 0x74, 0xd1, 0x29, 0x07, 0xb6, 0x1a, 0x8e,
+	0xa0, 0x9e, 0xae, 0xf0, 0xa5, 0x70, 0x22, 0xe7, 0xf8, 0x6a, 0x96, 0xed, 0x32, 0x2c, 0xe6, 0x62,
+	0x15, 0x7e, 0xf3, 0xd7, 0x12, 0x28, 0x22, 0x5f, 0x7d, 0x65, 0x8e, 0xde, 0x7f, 0xb9, 0x2e, 0x87,
+	0xa1, 0x25, 0x5f, 0x89, 0x7f, 0x90, 0x60, 0x52, 0x15, 0x15, 0xed, 0x93, 0xcc, 0x42, 0x35, 0x3d,
+	0x0b, 0x33, 0x30, 0xe4, 0x1e, 0x39, 0xc4, 0x93, 0x87, 0xb8, 0x2b, 0xb1, 0x0f, 0xb4, 0x0c, 0x60,
+	0x46, 0xb5, 0x4d, 0x79, 0x98, 0x79, 0xdf, 0x25, 0xd3, 0x17, 0x81, 0x23, 0x85, 0x73, 0xa7, 0x3a,
+	0x72, 0xa1, 0x56, 0xe1, 0x07, 0x32, 0x75, 0xd4, 0x32, 0x9f, 0x69, 0xad, 0xdb, 0x2d, 0x7a, 0xad,
+	0xa3, 0x8e, 0xdb, 0x6d, 0x2b, 0x30, 0x35, 0x6c, 0x18, 0x1e, 0xf1, 0xfd, 0xc6, 0x03, 0xa8, 0xc5,
+	0x78, 0x85, 0x4b, 0x26, 0x5c, 0x5c, 0x4a, 0xbb, 0xb8, 0x0c, 0x17, 0x6d, 0xe2, 0xfb, 0xb8, 0x49,
+	0xc4, 0xaa, 0x08, 0x3f, 0x1b, 0x9f, 0xc0, 0x02, 0xbb, 0x96, 0x24, 0x6a, 0xe2, 0x81, 0x41, 0x09,
+	0x3f, 0x4c, 0xeb, 0x5a, 0xe9, 0xa5, 0x6b, 0x63, 0x17, 0x94, 0x3c, 0xd9, 0x25, 0xb4, 0xf9, 0x85,
+	0x04, 0xb3, 0x7c, 0x3f, 0xf1, 0xc4, 0x35, 0xe8, 0x9e, 0xdc, 0x3d, 0x37, 0x55, 0xe8, 0x29, 0x92,
+	0x5d, 0x31, 0x89, 0x19, 0x11, 0xd1, 0x63, 0x94, 0xb6, 0x6d, 0xf1, 0x26, 0x7a, 0x8a, 0x74, 0xc8,
+	0x91, 0x96, 0x22, 0xe3, 0x9e, 0x31, 0xe1, 0x90, 0xa3, 0x27, 0x09, 0xca, 0x45, 0xb8, 0x44, 0x29,
+	0xb9, 0xf3, 0x0c, 0xf3, 0x9a, 0xb1, 0x43, 0x8e, 0xde, 0x3b, 0x72, 0xb2, 0x46, 0x93, 0x61, 0x2e,
+	0xab, 0xa1, 0xf0, 0x61, 0x07, 0xc6, 0x9f, 0x3a, 0xf4, 0xba, 0xe7, 0x4b, 0x9a, 0xbe, 0x1a, 0x4c,
+	0x84, 0xf2, 0x04, 0x82, 0x3f, 0x97, 0x60, 0x72, 0xcb, 0x30, 0xc4, 0x65, 0xf7, 0xa9, 0x41, 0x7c,
+	0x1b, 0x86, 0xb1, 0xed, 0xb6, 0x1d, 0xbe, 0x9c, 0x4f, 0xb3, 0xcf, 0x15, 0xe3, 0xd3, 0x78, 0x11,
+	0xd4, 0x62, 0x70, 0x02, 0xf1, 0x5f, 0x4a, 0x80, 0xd4, 0xf8, 0xc5, 0xe7, 0xd7, 0x0f, 0xf4, 0x2c,
+	0x4c, 0xa7, 0xf0, 0x09, 0xdc, 0x1f, 0x81, 0x7c, 0x0f, 0x3b, 0x3a, 0xb1, 0xce, 0x04, 0x7c, 0x5a,
+	0xe4, 0x22, 0x2c, 0xe4, 0xf0, 0x16, 0x82, 0xff, 0x4a, 0x82, 0x99, 0x2d, 0xc3, 0x88, 0x0f, 0x11,
+	0x5f, 0x3f, 0x93, 0xcd, 0xc3, 0x6c, 0x06, 0x61, 0x9c, 0xa9, 0xe6, 0xb9, 0x4e, 0x5f, 0x67, 0xf8,
+	0x0a, 0xc8, 0xdd, 0x20, 0x85, 0x06, 0x1a, 0x2c, 0x27, 0xa7, 0xe6, 0x2c, 0xd4, 0x48, 0x0b, 0xaf,
+	0xc3, 0x4a, 0x91, 0x00, 0x01, 0xe1, 0x67, 0x12, 0xcc, 0xb1, 0x6b, 0xe7, 0x33, 0xb1, 0x61, 0xbc,
+	0x5b, 0xab, 0xa4, 0x76, 0x6b, 0xb1, 0x6d, 0xab, 0xe5, 0x6c, 0x4b, 0x25, 0x78, 0x04, 0xfb, 0xae,
+	0x23, 0x22, 0xaf, 0xf8, 0x4a, 0xab, 0xbd, 0x00, 0xf3, 0x5d, 0x3a, 0x09, 0x7d, 0xff, 0x45, 0x82,
+	0x99, 0xd4, 0xbd, 0x6d, 0x09, 0x6d, 0x65, 0xba, 0x67, 0x8c, 0x43, 0xeb, 0x25, 0x35, 0xfc, 0x3c,
+	0x3b, 0x7d, 0xbb, 0x96, 0x42, 0x06, 0xbb, 0xd0, 0xea, 0xdf, 0xa4, 0x30, 0xf7, 0x9e, 0x48, 0xb7,
+	0x84, 0x02, 0x17, 0xd2, 0x0a, 0x24, 0xb5, 0xae, 0x14, 0xad, 0x93, 0x33, 0xd5, 0x8d, 0xed, 0xe8,
+	0x72, 0x34, 0x10, 0x1a, 0xfe, 0x99, 0x04, 0xf5, 0xa4, 0x2b, 0x9f, 0xd5, 0x1c, 0x2e, 0xc1, 0x25,
+	0x71, 0x33, 0xef, 0x86, 0xb3, 0x18, 0x37, 0x24, 0x0d, 0x54, 0x4d, 0x19, 0x28, 0x8d, 0xfd, 0x32,
+	0xac, 0xf5, 0xc0, 0x26, 0x34, 0xf8, 0xfc, 0x02, 0xac, 0xf0, 0x12, 0xba, 0x9a, 0x7d, 0x35, 0x51,
+	0x02, 0xff, 0xbb, 0x50, 0x65, 0xbf, 0x1c, 0xa8, 0x94, 0xbb, 0x59, 0x60, 0x4c, 0x90, 0x0a, 0x23,
+	0xec, 0x3e, 0x9f, 0x32, 0xac, 0x96, 0xbc, 0x8d, 0xb5, 0xf1, 0x31, 0xfb, 0x15, 0x82, 0x06, 0xf4,
+	0x0d, 0x97, 0xa6, 0x1f, 0x62, 0xa7, 0x49, 0x38, 0xeb, 0xa1, 0x72, 0xac, 0xc7, 0x6d, 0x7c, 0x7c,
+	0x8f, 0xb1, 0xa3, 0x02, 0xd2, 0x33, 0xb1, 0x06, 0xab, 0x85, 0x36, 0x8e, 0x8b, 0x2c, 0x4b, 0xf7,
+	0x0d, 0x33, 0xc8, 0xa1, 0xf8, 0x5a, 0xcc, 0x42, 0x5a, 0xa1, 0x55, 0x58, 0x2e, 0x00, 0x2b, 0xd4,
+	0xf9, 0x1f, 0x09, 0xe6, 0x54, 0x62, 0x9c, 0xd1, 0x72, 0x58, 0x85, 0x51, 0xdf, 0xd3, 0xb5, 0x74,
+	0x58, 0x03, 0xdf, 0xd3, 0xc3, 0x6d, 0xf2, 0x2a, 0x8c, 0x1a, 0x7e, 0xa0, 0xa5, 0x57, 0x05, 0x18,
+	0x7e, 0x88, 0x30, 0x11, 0x1e, 0x86, 0xce, 0x32, 0x3c, 0x2c, 0xc0, 0x7c, 0x97, 0x96, 0xc2, 0x02,
+	0x04, 0xae, 0xec, 0x91, 0x20, 0x5c, 0x74, 0xe2, 0x2d, 0x7f, 0xe2, 0x31, 0xcd, 0x00, 0x51, 0x90,
+	0x38, 0xf8, 0x99, 0x45, 0xb8, 0x35, 0x46, 0xd4, 0xf0, 0x33, 0x8d, 0xe0, 0x1a, 0x5c, 0xed, 0x23,
+	0x46, 0xe0, 0xb9, 0x0f, 0x2b, 0xf7, 0x2c, 0x6c, 0xda, 0x5b, 0x96, 0x95, 0xa6, 0xee, 0x57, 0x7b,
+	0x49, 0xcb, 0x7b, 0x0e, 0xab, 0x85, 0x6c, 0xc4, 0x99, 0x2a, 0xb6, 0xb5, 0x54, 0xce, 0xd6, 0x8d,
+	0x7f, 0x97, 0x60, 0x69, 0xdf, 0x7d, 0x4e, 0x1c, 0xf3, 0x13, 0xf2, 0xff, 0x36, 0x3d, 0xfe, 0xa9,
+	0x04, 0xcb, 0x05, 0x4a, 0x08, 0x83, 0xcd, 0xc3, 0xc5, 0x96, 0xeb, 0x5a, 0x71, 0x9d, 0x69, 0x98,
+	0x7e, 0xf2, 0x93, 0xbe, 0x41, 0x1c, 0xd7, 0x16, 0x79, 0x90, 0x7f, 0x50, 0x9c, 0xfe, 0x21, 0xf6,
+	0x88, 0x7f, 0xfa, 0x2d, 0x21, 0x1f, 0x4f, 0x83, 0xce, 0x1a, 0xf5, 0x5f, 0x62, 0x67, 0x80, 0xe9,
+	0xc4, 0x6c, 0x05, 0xfd, 0x8c, 0x9c, 0x80, 0x7d, 0x21, 0x05, 0xfb, 0xcc, 0x00, 0xa6, 0x0d, 0xf9,
+	0xdf, 0x12, 0x34, 0x7a, 0xa1, 0x15, 0xd6, 0xfc, 0x18, 0x6a, 0xe1, 0x8a, 0x34, 0xb4, 0x92, 0x8e,
+	0x38, 0x19, 0x71, 0xda, 0xe2, 0x1b, 0xbd, 0xdf, 0x80, 0x49, 0x8f, 0x04, 0x6d, 0xcf, 0x89, 0x79,
+	0x5f, 0x38, 0x25, 0xef, 0x89, 0x90, 0x11, 0x67, 0xdd, 0xf8, 0x0b, 0x09, 0x6a, 0xf4, 0xb1, 0x6b,
+	0xd9, 0x4b, 0xe9, 0x73, 0x3a, 0x31, 0x4c, 0xc3, 0x54, 0x02, 0x9d, 0x08, 0x2a, 0xff, 0x7a, 0x01,
+	0xea, 0x3c, 0xb3, 0xe5, 0x3c, 0xd3, 0x2d, 0xa1, 0xc3, 0x87, 0x30, 0xc9, 0x31, 0xb0, 0x47, 0x83,
+	0xf4, 0x71, 0xf0, 0xa9, 0x95, 0x19, 0xe7, 0x8c, 0x76, 0x89, 0x47, 0x9f, 0x0d, 0xa3, 0x6b, 0x30,
+	0x69, 0x3a, 0x01, 0xf1, 0x3a, 0xd8, 0xe2, 0x55, 0x4c, 0x5e, 0x53, 0xa9, 0xa8, 0x13, 0x61, 0x33,
+	0x2b, 0x61, 0xfa, 0xe8, 0x29, 0x8c, 0x13, 0x5f, 0xf7, 0xdc, 0x23, 0xad, 0x64, 0xe2, 0x18, 0xe3,
+	0x6c, 0xb6, 0x72, 0x6c, 0xfa, 0x08, 0xd6, 0x7a, 0x58, 0x4f, 0xf8, 0xf3, 0x35, 0x98, 0x4c, 0x3d,
+	0x95, 0x8e, 0xa2, 0xc4, 0x44, 0xb2, 0xf9, 0xa1, 0xd1, 0xf8, 0x67, 0x09, 0x56, 0xf7, 0xdd, 0xd6,
+	0xd3, 0xd6, 0x29, 0xe6, 0x22, 0x47, 0xc8, 0x85, 0x3c, 0x21, 0xe7, 0xe5, 0x5d, 0x0d, 0xa8, 0x17,
+	0x43, 0x17, 0xce, 0xd6, 0x0a, 0xf7, 0xda, 0xe7, 0xa8, 0x5f, 0x1a, 0xd5, 0x0f, 0x61, 0xad, 0x87,
+	0x44, 0x31, 0x3f, 0x2c, 0x24, 0xd0, 0x47, 0xed, 0xe5, 0xc3, 0xcd, 0x44, 0xc8, 0x48, 0x84, 0x84,
+	0x8f, 0x41, 0xd9, 0x32, 0x8c, 0x7d, 0xf7, 0x83, 0x43, 0x33, 0x20, 0x96, 0xe9, 0x07, 0x5b, 0x86,
+	0x6d, 0x3a, 0x03, 0xec, 0x1c, 0xc2, 0x3a, 0xa0, 0x38, 0x3f, 0x89, 0xcf, 0xae, 0xa3, 0x4d, 0x2e,
+	0x73, 0x61, 0xed, 0xef, 0xc1, 0x2a, 0x3f, 0x37, 0x3c, 0xf0, 0x5c, 0xfb, 0x5c, 0x00, 0x34, 0xa0,
+	0x5e, 0x2c, 0x41, 0xa0, 0xd0, 0x61, 0xed, 0x3e, 0xdb, 0xf6, 0xf0, 0x5f, 0x72, 0xb3, 0x93, 0x73,
+	0x44, 0x7a, 0x56, 0xf5, 0x88, 0x2b, 0xd0, 0xe8, 0x25, 0x44, 0x40, 0x31, 0xa0, 0xb1, 0x6d, 0xfa,
+	0xe7, 0x8d, 0xe5, 0x2a, 0x5c, 0xee, 0x29, 0x25, 0x06, 0x93, 0x80, 0x2c, 0x76, 0xb9, 0xe7, 0x01,
+	0xa6, 0xa7, 0x94, 0x68, 0xab, 0x9b, 0xc2, 0x7c, 0x5e, 0x68, 0x5e, 0x86, 0x2b, 0xbd, 0xc5, 0x08,
+	0x38, 0x9f, 0x42, 0x9d, 0x39, 0xf6, 0xd9, 0x4e, 0x53, 0xd2, 0xab, 0x2b, 0x3d, 0xbc, 0xfa, 0x32,
+	0xac, 0xf5, 0x90, 0x2e, 0x20, 0xfe, 0xae, 0x04, 0x57, 0x63, 0xdf, 0xff, 0x6a, 0x80, 0xae, 0xc3,
+	0xcb, 0xfd, 0x20, 0x08, 0xb4, 0xbf, 0x95, 0x54, 0xe9, 0xec, 0x66, 0x77, 0x50, 0xa0, 0x57, 0xa0,
+	0xd1, 0x4b, 0xbc, 0x00, 0xf9, 0x99, 0xd4, 0xa5, 0xcf, 0x97, 0x0f, 0xf5, 0x3a, 0x5c, 0xeb, 0x8b,
+	0x41, 0xe0, 0x65, 0x95, 0x25, 0x7a, 0x70, 0xca, 0xaf, 0xcb, 0x74, 0x40, 0xc9, 0xed, 0x3e, 0xfb,
+	0x73, 0x4f, 0x4a, 0x83, 0xdb, 0x7f, 0xbf, 0x0e, 0xf0, 0xd8, 0x6f, 0xee, 0x11, 0xaf, 0x63, 0xea,
+	0x04, 0x3d, 0x85, 0xb1, 0xe4, 0x2f, 0x16, 0x50, 0xe6, 0xfe, 0x39, 0xe7, 0xf7, 0x13, 0x4a, 0xa3,
+	0x17, 0x49, 0xb4, 0x47, 0x9f, 0x48, 0xbf, 0xdb, 0x44, 0x97, 0xd3, 0xa3, 0x72, 0x7f, 0x0d, 0xa1,
+	0x5c, 0xe9, 0x4d, 0x24, 0x98, 0xab, 0x30, 0x9a, 0x78, 0xee, 0x83, 0xea, 0x79, 0x78, 0x52, 0x6c,
+	0xd7, 0x7a, 0x50, 0x08, 0x9e, 0x4f, 0x61, 0x2c, 0xf9, 0xa8, 0x27, 0x6b, 0x87, 0x9c, 0x77, 0x44,
+	0x4a, 0xa3, 0x17, 0x89, 0x60, 0xfb, 0x10, 0x46, 0xc2, 0x0b, 0x56, 0xb4, 0x9c, 0xa6, 0xcf, 0x5c,
+	0x14, 0x2b, 0x2b, 0x45, 0xdd, 0x82, 0x55, 0x33, 0xbc, 0x63, 0x4a, 0xde, 0x73, 0xa2, 0x6b, 0xd9,
+	0x51, 0x05, 0xb7, 0xb0, 0xca, 0x7a, 0x7f, 0xc2, 0x78, 0xee, 0xd2, 0x77, 0x83, 0xd9, 0xb9, 0xcb,
+	0xbd, 0x1b, 0x55, 0xae, 0xf4, 0x26, 0x12, 0xcc, 0xef, 0xc1, 0x30, 0xbf, 0xee, 0x43, 0x8b, 0x19,
+	0xfa, 0xe4, 0xa5, 0xa3, 0xb2, 0x94, 0xdf, 0x19, 0x5b, 0x35, 0xbc, 0x83, 0xcb, 0x5a, 0x35, 0x73,
+	0x71, 0xa8, 0xac, 0x14, 0x75, 0xc7, 0xbe, 0x94, 0xb8, 0xa0, 0xca, 0xfa, 0x52, 0xf7, 0xbd, 0x98,
+	0xb2, 0xd6, 0x83, 0x42, 0xf0, 0x34, 0x60, 0xaa, 0xeb, 0xea, 0x0b, 0xbd, 0x9c, 0x71, 0xed, 0x82,
+	0x7b, 0x37, 0xe5, 0x5a, 0x5f, 0x3a, 0x21, 0xe5, 0x43, 0x18, 0x4f, 0x5d, 0x50, 0xa1, 0x46, 0x97,
+	0xaa, 0x5d, 0x97, 0x2b, 0xca, 0xe5, 0x9e, 0x34, 0x82, 0x33, 0x86, 0x1a, 0x17, 0x98, 0x60, 0x7e,
+	0x35, 0x4f, 0xed, 0x6e, 0xfe, 0x2f, 0xf7, 0x23, 0x13, 0x22, 0x7c, 0x98, 0xcb, 0xbf, 0x21, 0x42,
+	0xaf, 0x14, 0xeb, 0xdf, 0x2d, 0xee, 0xd5, 0xc1, 0x88, 0x85, 0xd0, 0xef, 0xc2, 0x64, 0xe6, 0x7e,
+	0x06, 0x65, 0x9c, 0x36, 0xff, 0x4a, 0x4a, 0xb9, 0xda, 0x87, 0x2a, 0x9e, 0x91, 0x54, 0x30, 0xcf,
+	0xce, 0x48, 0x5e, 0xa4, 0x57, 0x2e, 0xf7, 0xa4, 0x11, 0x9c, 0xbf, 0x0f, 0xd3, 0x39, 0xc9, 0x02,
+	0x75, 0xad, 0xe9, 0xa2, 0x7c, 0xa2, 0x5c, 0x1f, 0x80, 0x32, 0x29, 0xab, 0xeb, 0x3e, 0x01, 0xe5,
+	0xc6, 0x8f, 0xc1, 0x64, 0x15, 0x5e, 0x4e, 0xa0, 0x4f, 0xd3, 0x97, 0xc4, 0x69, 0x89, 0x1b, 0xc5,
+	0x93, 0x9b, 0x2b, 0x77, 0x73, 0x60, 0x7a, 0x21, 0xbd, 0x03, 0xf3, 0x05, 0x55, 0x7b, 0xf4, 0x6a,
+	0x5e, 0x22, 0x2a, 0x2a, 0xdd, 0x2b, 0x37, 0x07, 0xa4, 0x16, 0x72, 0x5b, 0x30, 0x9b, 0x5b, 0x5c,
+	0x47, 0x37, 0xd2, 0x7c, 0x7a, 0x5d, 0x17, 0x28, 0xaf, 0x0c, 0x44, 0x1b, 0x7b, 0x7e, 0xa6, 0x8c,
+	0x9d, 0xf5, 0xfc, 0xfc, 0x5a, 0xbe, 0x72, 0xb5, 0x0f, 0x95, 0xe0, 0xff, 0x7b, 0x12, 0x2c, 0xf7,
+	0xac, 0x52, 0xa3, 0xdb, 0x99, 0x25, 0x34, 0x40, 0xe5, 0x5c, 0xb9, 0x73, 0xa2, 0x31, 0x89, 0x49,
+	0xcd, 0xaf, 0x5f, 0x77, 0x4d, 0x6a, 0xcf, 0x6a, 0xb9, 0x72, 0x73, 0x40, 0xea, 0x78, 0x52, 0x73,
+	0x8b, 0xc0, 0xd9, 0x49, 0xed, 0x55, 0xee, 0x56, 0x5e, 0x19, 0x88, 0x56, 0x48, 0xfc, 0x6d, 0x50,
+	0x8a, 0xab, 0xa5, 0x68, 0xb3, 0x7b, 0xe6, 0x7a, 0x56, 0x81, 0x95, 0x5b, 0x83, 0x0f, 0x10, 0x00,
+	0x1e, 0xc1, 0xa5, 0xa8, 0x62, 0x88, 0x32, 0x89, 0x36, 0x5b, 0xe8, 0x54, 0x56, 0x0b, 0xfb, 0x13,
+	0xb1, 0xa0, 0xa8, 0x56, 0xd6, 0x15, 0x0b, 0xfa, 0x94, 0x24, 0x95, 0xcd, 0x81, 0xe9, 0x85, 0xf4,
+	0x17, 0x20, 0x17, 0xd5, 0xa7, 0xd0, 0xcd, 0xec, 0xac, 0xf4, 0x2c, 0xc1, 0x29, 0x1b, 0x83, 0x92,
+	0x67, 0x83, 0xe0, 0x20, 0x8a, 0xf7, 0xa9, 0x8f, 0x29, 0x9b, 0x03, 0xd3, 0xc7, 0xe1, 0x3e, 0xa7,
+	0x4a, 0x94, 0x0d, 0xf7, 0xc5, 0x55, 0x2a, 0xe5, 0xfa, 0x00, 0x94, 0xb1, 0x91, 0x8b, 0x0a, 0x42,
+	0x59, 0x23, 0xf7, 0x29, 0x4d, 0x29, 0x1b, 0x83, 0x92, 0xc7, 0x8b, 0xa5, 0xb8, 0x04, 0x94, 0x5d,
+	0x2c, 0x7d, 0x2b, 0x52, 0xca, 0xad, 0xc1, 0x07, 0x08, 0x00, 0x9f, 0x49, 0xb0, 0xd8, 0xa3, 0xf0,
+	0x83, 0x32, 0x1c, 0xfb, 0x57, 0xa2, 0x94, 0xd7, 0x4e, 0x30, 0x22, 0x01, 0xa2, 0x47, 0xc1, 0x07,
+	0x15, 0xab, 0x55, 0x70, 0xd4, 0x56, 0x5e, 0x3b, 0xc1, 0x08, 0x01, 0xe2, 0x47, 0x12, 0x2c, 0xf5,
+	0xaa, 0xf3, 0xa0, 0x1e, 0x8a, 0x15, 0xc1, 0xb8, 0x7d, 0x92, 0x21, 0xf1, 0xba, 0x2b, 0x2c, 0xe4,
+	0x64, 0xd7, 0x5d, 0xbf, 0x7a, 0x93, 0xb2, 0x39, 0x30, 0xbd, 0x90, 0xfe, 0xfb, 0x12, 0xac, 0xf4,
+	0x2e, 0xcf, 0xa0, 0x3b, 0x45, 0x3e, 0xde, 0x0b, 0xc8, 0xeb, 0x27, 0x1b, 0x14, 0x2f, 0x8f, 0xe2,
+	0x12, 0x0c, 0x2a, 0x54, 0xae, 0x68, 0x3a, 0x6e, 0x0d, 0x3e, 0x40, 0x00, 0xf8, 0x03, 0x29, 0x59,
+	0x8e, 0xce, 0x87, 0xd1, 0x5b, 0xb5, 0x22, 0x2c, 0x6f, 0x9c, 0x70, 0x54, 0x1c, 0x17, 0x73, 0x5e,
+	0xee, 0x67, 0xe3, 0x62, 0xf1, 0x6f, 0x33, 0x94, 0xeb, 0x03, 0x50, 0x0a, 0x59, 0x36, 0xcc, 0xe4,
+	0x74, 0xfb, 0xa8, 0x3f, 0x8b, 0x68, 0xb7, 0x72, 0x63, 0x10, 0xd2, 0x58, 0xb5, 0x9c, 0xa7, 0xf8,
+	0x59, 0xd5, 0x8a, 0x7f, 0x59, 0xa0, 0x5c, 0x1f, 0x80, 0x32, 0x79, 0x9a, 0xe8, 0x7a, 0x31, 0xdf,
+	0x7d, 0x9a, 0x28, 0x7a, 0xf9, 0xaf, 0x5c, 0x1f, 0x80, 0x32, 0x6b, 0xc6, 0xf4, 0x8f, 0x69, 0xf2,
+	0xcd, 0x98, 0xfb, 0xe3, 0x20, 0xe5, 0xc6, 0x20, 0xa4, 0xb1, 0xb8, 0xbc, 0x1f, 0x22, 0xa1, 0x5c,
+	0xeb, 0x0c, 0x24, 0xae, 0xd7, 0xef, 0x9a, 0xa8, 0xb8, 0xbc, 0x9f, 0x0a, 0xa1, 0x5c, 0x03, 0x0d,
+	0x24, 0xae, 0xd7, 0x2f, 0x8f, 0x94, 0xa1, 0xdf, 0xa1, 0x37, 0x56, 0x77, 0xd5, 0x9f, 0x7c, 0xbe,
+	0x22, 0xfd, 0xf4, 0xf3, 0x15, 0xe9, 0x17, 0x9f, 0xaf, 0x48, 0x7f, 0xf4, 0xc5, 0xca, 0x4b, 0x3f,
+	0xfd, 0x62, 0xe5, 0xa5, 0xff, 0xf8, 0x62, 0xe5, 0xa5, 0x8f, 0xde, 0x1c, 0xf0, 0x99, 0xd1, 0xf1,
+	0x66, 0x24, 0x74, 0x93, 0xfe, 0x62, 0xd3, 0x7f, 0x36, 0xcc, 0xfe, 0xe9, 0xe1, 0x3b, 0xff, 0x37,
+	0x00, 0xcd, 0x47, 0x98, 0x2b, 0x4f, 0x59, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgServiceClient is the client API for MsgService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgServiceClient interface {
+	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
+	CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error)
+	UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error)
+	ArchiveTopic(ctx context.Context, in *ArchiveTopicRequest, opts ...grpc.CallOption) (*ArchiveTopicResponse, error)
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error)
+	UpdateNodeInfo(ctx context.Context, in *UpdateNodeInfoRequest, opts ...grpc.CallOption) (*UpdateNodeInfoResponse, error)
+	Unjail(ctx context.Context, in *UnjailRequest, opts ...grpc.CallOption) (*UnjailResponse, error)
+	AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error)
+	RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error)
+	CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error)
+	AddWorkerBond(ctx context.Context, in *AddWorkerBondRequest, opts ...grpc.CallOption) (*AddWorkerBondResponse, error)
+	RemoveWorkerBond(ctx context.Context, in *RemoveWorkerBondRequest, opts ...grpc.CallOption) (*RemoveWorkerBondResponse, error)
+	CancelRemoveWorkerBond(ctx context.Context, in *CancelRemoveWorkerBondRequest, opts ...grpc.CallOption) (*CancelRemoveWorkerBondResponse, error)
+	SlashWorkerBond(ctx context.Context, in *SlashWorkerBondRequest, opts ...grpc.CallOption) (*SlashWorkerBondResponse, error)
+	DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error)
+	RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error)
+	RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error)
+	CreateReputerCommission(ctx context.Context, in *CreateReputerCommissionRequest, opts ...grpc.CallOption) (*CreateReputerCommissionResponse, error)
+	EditReputerCommission(ctx context.Context, in *EditReputerCommissionRequest, opts ...grpc.CallOption) (*EditReputerCommissionResponse, error)
+	RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error)
+	SetDelegateRewardAutoCompound(ctx context.Context, in *SetDelegateRewardAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateRewardAutoCompoundResponse, error)
+	ClaimAllDelegateRewards(ctx context.Context, in *ClaimAllDelegateRewardsRequest, opts ...grpc.CallOption) (*ClaimAllDelegateRewardsResponse, error)
+	TokenizeDelegateStake(ctx context.Context, in *TokenizeDelegateStakeRequest, opts ...grpc.CallOption) (*TokenizeDelegateStakeResponse, error)
+	RedeemDelegateStakeReceipt(ctx context.Context, in *RedeemDelegateStakeReceiptRequest, opts ...grpc.CallOption) (*RedeemDelegateStakeReceiptResponse, error)
+	FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error)
+	CreateFundingSubscription(ctx context.Context, in *CreateFundingSubscriptionRequest, opts ...grpc.CallOption) (*CreateFundingSubscriptionResponse, error)
+	TopUpFundingSubscription(ctx context.Context, in *TopUpFundingSubscriptionRequest, opts ...grpc.CallOption) (*TopUpFundingSubscriptionResponse, error)
+	CancelFundingSubscription(ctx context.Context, in *CancelFundingSubscriptionRequest, opts ...grpc.CallOption) (*CancelFundingSubscriptionResponse, error)
+	AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error)
+	EnableTopicWorkerWhitelist(ctx context.Context, in *EnableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicWorkerWhitelistResponse, error)
+	DisableTopicWorkerWhitelist(ctx context.Context, in *DisableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicWorkerWhitelistResponse, error)
+	EnableTopicReputerWhitelist(ctx context.Context, in *EnableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicReputerWhitelistResponse, error)
+	DisableTopicReputerWhitelist(ctx context.Context, in *DisableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicReputerWhitelistResponse, error)
+	AddToTopicWorkerWhitelist(ctx context.Context, in *AddToTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicWorkerWhitelistResponse, error)
+	RemoveFromTopicWorkerWhitelist(ctx context.Context, in *RemoveFromTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicWorkerWhitelistResponse, error)
+	AddToTopicReputerWhitelist(ctx context.Context, in *AddToTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicReputerWhitelistResponse, error)
+	RemoveFromTopicReputerWhitelist(ctx context.Context, in *RemoveFromTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicReputerWhitelistResponse, error)
+	InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error)
+	InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error)
+	CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error)
+	InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error)
+	CommitReputerPayload(ctx context.Context, in *CommitReputerPayloadRequest, opts ...grpc.CallOption) (*CommitReputerPayloadResponse, error)
+	RevealReputerPayload(ctx context.Context, in *RevealReputerPayloadRequest, opts ...grpc.CallOption) (*RevealReputerPayloadResponse, error)
+}
+
+type msgServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgServiceClient(cc grpc1.ClientConn) MsgServiceClient {
+	return &msgServiceClient{cc}
+}
+
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error) {
+	out := new(UpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		l = 0
-		for _, e := range m.DefaultPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return out, nil
+}
+
+func (c *msgServiceClient) CreateNewTopic(ctx context.Context, in *CreateNewTopicRequest, opts ...grpc.CallOption) (*CreateNewTopicResponse, error) {
+	out := new(CreateNewTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CreateNewTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxPageLimit) > 0 {
-		l = 0
-		for _, e := range m.MaxPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return out, nil
+}
+
+func (c *msgServiceClient) UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error) {
+	out := new(UpdateTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UpdateTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLengthRecordLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return out, nil
+}
+
+func (c *msgServiceClient) ArchiveTopic(ctx context.Context, in *ArchiveTopicRequest, opts ...grpc.CallOption) (*ArchiveTopicResponse, error) {
+	out := new(ArchiveTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/ArchiveTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BlocksPerMonth) > 0 {
-		l = 0
-		for _, e := range m.BlocksPerMonth {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return out, nil
+}
+
+func (c *msgServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardInference) > 0 {
-		for _, e := range m.PRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveRegistration(ctx context.Context, in *RemoveRegistrationRequest, opts ...grpc.CallOption) (*RemoveRegistrationResponse, error) {
+	out := new(RemoveRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardForecast) > 0 {
-		for _, e := range m.PRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) UpdateNodeInfo(ctx context.Context, in *UpdateNodeInfoRequest, opts ...grpc.CallOption) (*UpdateNodeInfoResponse, error) {
+	out := new(UpdateNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/UpdateNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardReputer) > 0 {
-		for _, e := range m.PRewardReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) Unjail(ctx context.Context, in *UnjailRequest, opts ...grpc.CallOption) (*UnjailResponse, error) {
+	out := new(UnjailResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/Unjail", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CRewardInference) > 0 {
-		for _, e := range m.CRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) AddStake(ctx context.Context, in *AddStakeRequest, opts ...grpc.CallOption) (*AddStakeResponse, error) {
+	out := new(AddStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CRewardForecast) > 0 {
-		for _, e := range m.CRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveStake(ctx context.Context, in *RemoveStakeRequest, opts ...grpc.CallOption) (*RemoveStakeResponse, error) {
+	out := new(RemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CNorm) > 0 {
-		for _, e := range m.CNorm {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) CancelRemoveStake(ctx context.Context, in *CancelRemoveStakeRequest, opts ...grpc.CallOption) (*CancelRemoveStakeResponse, error) {
+	out := new(CancelRemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.EpsilonReputer) > 0 {
-		for _, e := range m.EpsilonReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) AddWorkerBond(ctx context.Context, in *AddWorkerBondRequest, opts ...grpc.CallOption) (*AddWorkerBondResponse, error) {
+	out := new(AddWorkerBondResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddWorkerBond", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
-		l = 0
-		for _, e := range m.HalfMaxProcessStakeRemovalsEndBlock {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveWorkerBond(ctx context.Context, in *RemoveWorkerBondRequest, opts ...grpc.CallOption) (*RemoveWorkerBondResponse, error) {
+	out := new(RemoveWorkerBondResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveWorkerBond", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DataSendingFee) > 0 {
-		for _, e := range m.DataSendingFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) CancelRemoveWorkerBond(ctx context.Context, in *CancelRemoveWorkerBondRequest, opts ...grpc.CallOption) (*CancelRemoveWorkerBondResponse, error) {
+	out := new(CancelRemoveWorkerBondResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveWorkerBond", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.EpsilonSafeDiv) > 0 {
-		for _, e := range m.EpsilonSafeDiv {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) SlashWorkerBond(ctx context.Context, in *SlashWorkerBondRequest, opts ...grpc.CallOption) (*SlashWorkerBondResponse, error) {
+	out := new(SlashWorkerBondResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/SlashWorkerBond", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxElementsPerForecast) > 0 {
-		l = 0
-		for _, e := range m.MaxElementsPerForecast {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.MaxActiveTopicsPerBlock) > 0 {
-		l = 0
-		for _, e := range m.MaxActiveTopicsPerBlock {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.MaxStringLength) > 0 {
-		l = 0
-		for _, e := range m.MaxStringLength {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.InitialRegretQuantile) > 0 {
-		for _, e := range m.InitialRegretQuantile {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) DelegateStake(ctx context.Context, in *DelegateStakeRequest, opts ...grpc.CallOption) (*DelegateStakeResponse, error) {
+	out := new(DelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/DelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PNormSafeDiv) > 0 {
-		for _, e := range m.PNormSafeDiv {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgServiceClient) RewardDelegateStake(ctx context.Context, in *RewardDelegateStakeRequest, opts ...grpc.CallOption) (*RewardDelegateStakeResponse, error) {
+	out := new(RewardDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RewardDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) RemoveDelegateStake(ctx context.Context, in *RemoveDelegateStakeRequest, opts ...grpc.CallOption) (*RemoveDelegateStakeResponse, error) {
+	out := new(RemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) CancelRemoveDelegateStake(ctx context.Context, in *CancelRemoveDelegateStakeRequest, opts ...grpc.CallOption) (*CancelRemoveDelegateStakeResponse, error) {
+	out := new(CancelRemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelRemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) CreateReputerCommission(ctx context.Context, in *CreateReputerCommissionRequest, opts ...grpc.CallOption) (*CreateReputerCommissionResponse, error) {
+	out := new(CreateReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CreateReputerCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) EditReputerCommission(ctx context.Context, in *EditReputerCommissionRequest, opts ...grpc.CallOption) (*EditReputerCommissionResponse, error) {
+	out := new(EditReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/EditReputerCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *CreateNewTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) RedelegateStake(ctx context.Context, in *RedelegateStakeRequest, opts ...grpc.CallOption) (*RedelegateStakeResponse, error) {
+	out := new(RedelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RedelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) SetDelegateRewardAutoCompound(ctx context.Context, in *SetDelegateRewardAutoCompoundRequest, opts ...grpc.CallOption) (*SetDelegateRewardAutoCompoundResponse, error) {
+	out := new(SetDelegateRewardAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/SetDelegateRewardAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) ClaimAllDelegateRewards(ctx context.Context, in *ClaimAllDelegateRewardsRequest, opts ...grpc.CallOption) (*ClaimAllDelegateRewardsResponse, error) {
+	out := new(ClaimAllDelegateRewardsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/ClaimAllDelegateRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) TokenizeDelegateStake(ctx context.Context, in *TokenizeDelegateStakeRequest, opts ...grpc.CallOption) (*TokenizeDelegateStakeResponse, error) {
+	out := new(TokenizeDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/TokenizeDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTx(uint64(m.EpochLength))
+	return out, nil
+}
+
+func (c *msgServiceClient) RedeemDelegateStakeReceipt(ctx context.Context, in *RedeemDelegateStakeReceiptRequest, opts ...grpc.CallOption) (*RedeemDelegateStakeReceiptResponse, error) {
+	out := new(RedeemDelegateStakeReceiptResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RedeemDelegateStakeReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GroundTruthLag != 0 {
-		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	return out, nil
+}
+
+func (c *msgServiceClient) FundTopic(ctx context.Context, in *FundTopicRequest, opts ...grpc.CallOption) (*FundTopicResponse, error) {
+	out := new(FundTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/FundTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.PNorm.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.AlphaRegret.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.AllowNegative {
-		n += 2
+	return out, nil
+}
+
+func (c *msgServiceClient) CreateFundingSubscription(ctx context.Context, in *CreateFundingSubscriptionRequest, opts ...grpc.CallOption) (*CreateFundingSubscriptionResponse, error) {
+	out := new(CreateFundingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CreateFundingSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Epsilon.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.WorkerSubmissionWindow != 0 {
-		n += 1 + sovTx(uint64(m.WorkerSubmissionWindow))
+	return out, nil
+}
+
+func (c *msgServiceClient) TopUpFundingSubscription(ctx context.Context, in *TopUpFundingSubscriptionRequest, opts ...grpc.CallOption) (*TopUpFundingSubscriptionResponse, error) {
+	out := new(TopUpFundingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/TopUpFundingSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.MeritSortitionAlpha.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ActiveInfererQuantile.Size()
-	n += 2 + l + sovTx(uint64(l))
-	l = m.ActiveForecasterQuantile.Size()
-	n += 2 + l + sovTx(uint64(l))
-	l = m.ActiveReputerQuantile.Size()
-	n += 2 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *CreateNewTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) CancelFundingSubscription(ctx context.Context, in *CancelFundingSubscriptionRequest, opts ...grpc.CallOption) (*CancelFundingSubscriptionResponse, error) {
+	out := new(CancelFundingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CancelFundingSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	return out, nil
+}
+
+func (c *msgServiceClient) AddToWhitelistAdmin(ctx context.Context, in *AddToWhitelistAdminRequest, opts ...grpc.CallOption) (*AddToWhitelistAdminResponse, error) {
+	out := new(AddToWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddToWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *InsertReputerPayloadRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) RemoveFromWhitelistAdmin(ctx context.Context, in *RemoveFromWhitelistAdminRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistAdminResponse, error) {
+	out := new(RemoveFromWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveFromWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) EnableTopicWorkerWhitelist(ctx context.Context, in *EnableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicWorkerWhitelistResponse, error) {
+	out := new(EnableTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/EnableTopicWorkerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ReputerValueBundle != nil {
-		l = m.ReputerValueBundle.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) DisableTopicWorkerWhitelist(ctx context.Context, in *DisableTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicWorkerWhitelistResponse, error) {
+	out := new(DisableTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/DisableTopicWorkerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *InsertReputerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) EnableTopicReputerWhitelist(ctx context.Context, in *EnableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*EnableTopicReputerWhitelistResponse, error) {
+	out := new(EnableTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/EnableTopicReputerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *InsertWorkerPayloadRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) DisableTopicReputerWhitelist(ctx context.Context, in *DisableTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*DisableTopicReputerWhitelistResponse, error) {
+	out := new(DisableTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/DisableTopicReputerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) AddToTopicWorkerWhitelist(ctx context.Context, in *AddToTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicWorkerWhitelistResponse, error) {
+	out := new(AddToTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddToTopicWorkerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.WorkerDataBundle != nil {
-		l = m.WorkerDataBundle.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgServiceClient) RemoveFromTopicWorkerWhitelist(ctx context.Context, in *RemoveFromTopicWorkerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicWorkerWhitelistResponse, error) {
+	out := new(RemoveFromTopicWorkerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveFromTopicWorkerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *InsertWorkerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) AddToTopicReputerWhitelist(ctx context.Context, in *AddToTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*AddToTopicReputerWhitelistResponse, error) {
+	out := new(AddToTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/AddToTopicReputerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *RegisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) RemoveFromTopicReputerWhitelist(ctx context.Context, in *RemoveFromTopicReputerWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromTopicReputerWhitelistResponse, error) {
+	out := new(RemoveFromTopicReputerWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RemoveFromTopicReputerWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.IsReputer {
-		n += 2
-	}
-	return n
+	return out, nil
 }
 
-func (m *RegisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgServiceClient) InsertWorkerPayload(ctx context.Context, in *InsertWorkerPayloadRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadResponse, error) {
+	out := new(InsertWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertWorkerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RemoveRegistrationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	if m.IsReputer {
-		n += 2
+func (c *msgServiceClient) InsertWorkerPayloads(ctx context.Context, in *InsertWorkerPayloadsRequest, opts ...grpc.CallOption) (*InsertWorkerPayloadsResponse, error) {
+	out := new(InsertWorkerPayloadsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertWorkerPayloads", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RemoveRegistrationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgServiceClient) CommitWorkerPayload(ctx context.Context, in *CommitWorkerPayloadRequest, opts ...grpc.CallOption) (*CommitWorkerPayloadResponse, error) {
+	out := new(CommitWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CommitWorkerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *AddStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+func (c *msgServiceClient) RevealWorkerPayload(ctx context.Context, in *RevealWorkerPayloadRequest, opts ...grpc.CallOption) (*RevealWorkerPayloadResponse, error) {
+	out := new(RevealWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RevealWorkerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *AddStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) InsertReputerPayload(ctx context.Context, in *InsertReputerPayloadRequest, opts ...grpc.CallOption) (*InsertReputerPayloadResponse, error) {
+	out := new(InsertReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/InsertReputerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *RemoveStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+func (c *msgServiceClient) CommitReputerPayload(ctx context.Context, in *CommitReputerPayloadRequest, opts ...grpc.CallOption) (*CommitReputerPayloadResponse, error) {
+	out := new(CommitReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/CommitReputerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *RemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgServiceClient) RevealReputerPayload(ctx context.Context, in *RevealReputerPayloadRequest, opts ...grpc.CallOption) (*RevealReputerPayloadResponse, error) {
+	out := new(RevealReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v5.MsgService/RevealReputerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *CancelRemoveStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	return n
+// MsgServiceServer is the server API for MsgService service.
+type MsgServiceServer interface {
+	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
+	CreateNewTopic(context.Context, *CreateNewTopicRequest) (*CreateNewTopicResponse, error)
+	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
+	ArchiveTopic(context.Context, *ArchiveTopicRequest) (*ArchiveTopicResponse, error)
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	RemoveRegistration(context.Context, *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error)
+	UpdateNodeInfo(context.Context, *UpdateNodeInfoRequest) (*UpdateNodeInfoResponse, error)
+	Unjail(context.Context, *UnjailRequest) (*UnjailResponse, error)
+	AddStake(context.Context, *AddStakeRequest) (*AddStakeResponse, error)
+	RemoveStake(context.Context, *RemoveStakeRequest) (*RemoveStakeResponse, error)
+	CancelRemoveStake(context.Context, *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error)
+	AddWorkerBond(context.Context, *AddWorkerBondRequest) (*AddWorkerBondResponse, error)
+	RemoveWorkerBond(context.Context, *RemoveWorkerBondRequest) (*RemoveWorkerBondResponse, error)
+	CancelRemoveWorkerBond(context.Context, *CancelRemoveWorkerBondRequest) (*CancelRemoveWorkerBondResponse, error)
+	SlashWorkerBond(context.Context, *SlashWorkerBondRequest) (*SlashWorkerBondResponse, error)
+	DelegateStake(context.Context, *DelegateStakeRequest) (*DelegateStakeResponse, error)
+	RewardDelegateStake(context.Context, *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error)
+	RemoveDelegateStake(context.Context, *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(context.Context, *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error)
+	CreateReputerCommission(context.Context, *CreateReputerCommissionRequest) (*CreateReputerCommissionResponse, error)
+	EditReputerCommission(context.Context, *EditReputerCommissionRequest) (*EditReputerCommissionResponse, error)
+	RedelegateStake(context.Context, *RedelegateStakeRequest) (*RedelegateStakeResponse, error)
+	SetDelegateRewardAutoCompound(context.Context, *SetDelegateRewardAutoCompoundRequest) (*SetDelegateRewardAutoCompoundResponse, error)
+	ClaimAllDelegateRewards(context.Context, *ClaimAllDelegateRewardsRequest) (*ClaimAllDelegateRewardsResponse, error)
+	TokenizeDelegateStake(context.Context, *TokenizeDelegateStakeRequest) (*TokenizeDelegateStakeResponse, error)
+	RedeemDelegateStakeReceipt(context.Context, *RedeemDelegateStakeReceiptRequest) (*RedeemDelegateStakeReceiptResponse, error)
+	FundTopic(context.Context, *FundTopicRequest) (*FundTopicResponse, error)
+	CreateFundingSubscription(context.Context, *CreateFundingSubscriptionRequest) (*CreateFundingSubscriptionResponse, error)
+	TopUpFundingSubscription(context.Context, *TopUpFundingSubscriptionRequest) (*TopUpFundingSubscriptionResponse, error)
+	CancelFundingSubscription(context.Context, *CancelFundingSubscriptionRequest) (*CancelFundingSubscriptionResponse, error)
+	AddToWhitelistAdmin(context.Context, *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(context.Context, *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error)
+	EnableTopicWorkerWhitelist(context.Context, *EnableTopicWorkerWhitelistRequest) (*EnableTopicWorkerWhitelistResponse, error)
+	DisableTopicWorkerWhitelist(context.Context, *DisableTopicWorkerWhitelistRequest) (*DisableTopicWorkerWhitelistResponse, error)
+	EnableTopicReputerWhitelist(context.Context, *EnableTopicReputerWhitelistRequest) (*EnableTopicReputerWhitelistResponse, error)
+	DisableTopicReputerWhitelist(context.Context, *DisableTopicReputerWhitelistRequest) (*DisableTopicReputerWhitelistResponse, error)
+	AddToTopicWorkerWhitelist(context.Context, *AddToTopicWorkerWhitelistRequest) (*AddToTopicWorkerWhitelistResponse, error)
+	RemoveFromTopicWorkerWhitelist(context.Context, *RemoveFromTopicWorkerWhitelistRequest) (*RemoveFromTopicWorkerWhitelistResponse, error)
+	AddToTopicReputerWhitelist(context.Context, *AddToTopicReputerWhitelistRequest) (*AddToTopicReputerWhitelistResponse, error)
+	RemoveFromTopicReputerWhitelist(context.Context, *RemoveFromTopicReputerWhitelistRequest) (*RemoveFromTopicReputerWhitelistResponse, error)
+	InsertWorkerPayload(context.Context, *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error)
+	InsertWorkerPayloads(context.Context, *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error)
+	CommitWorkerPayload(context.Context, *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error)
+	RevealWorkerPayload(context.Context, *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error)
+	InsertReputerPayload(context.Context, *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error)
+	CommitReputerPayload(context.Context, *CommitReputerPayloadRequest) (*CommitReputerPayloadResponse, error)
+	RevealReputerPayload(context.Context, *RevealReputerPayloadRequest) (*RevealReputerPayloadResponse, error)
 }
 
-func (m *CancelRemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServiceServer struct {
 }
 
-func (m *DelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *UpdateParamsRequest) (*UpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *DelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServiceServer) CreateNewTopic(ctx context.Context, req *CreateNewTopicRequest) (*CreateNewTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
-
-func (m *RemoveDelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServiceServer) UpdateTopic(ctx context.Context, req *UpdateTopicRequest) (*UpdateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopic not implemented")
 }
-
-func (m *RemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedMsgServiceServer) ArchiveTopic(ctx context.Context, req *ArchiveTopicRequest) (*ArchiveTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTopic not implemented")
 }
-
-func (m *CancelRemoveDelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServiceServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveRegistration(ctx context.Context, req *RemoveRegistrationRequest) (*RemoveRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
+}
+func (*UnimplementedMsgServiceServer) UpdateNodeInfo(ctx context.Context, req *UpdateNodeInfoRequest) (*UpdateNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeInfo not implemented")
+}
+func (*UnimplementedMsgServiceServer) Unjail(ctx context.Context, req *UnjailRequest) (*UnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddStake(ctx context.Context, req *AddStakeRequest) (*AddStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveStake(ctx context.Context, req *RemoveStakeRequest) (*RemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) CancelRemoveStake(ctx context.Context, req *CancelRemoveStakeRequest) (*CancelRemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddWorkerBond(ctx context.Context, req *AddWorkerBondRequest) (*AddWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkerBond not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveWorkerBond(ctx context.Context, req *RemoveWorkerBondRequest) (*RemoveWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkerBond not implemented")
+}
+func (*UnimplementedMsgServiceServer) CancelRemoveWorkerBond(ctx context.Context, req *CancelRemoveWorkerBondRequest) (*CancelRemoveWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveWorkerBond not implemented")
+}
+func (*UnimplementedMsgServiceServer) SlashWorkerBond(ctx context.Context, req *SlashWorkerBondRequest) (*SlashWorkerBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWorkerBond not implemented")
+}
+func (*UnimplementedMsgServiceServer) DelegateStake(ctx context.Context, req *DelegateStakeRequest) (*DelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) RewardDelegateStake(ctx context.Context, req *RewardDelegateStakeRequest) (*RewardDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveDelegateStake(ctx context.Context, req *RemoveDelegateStakeRequest) (*RemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) CancelRemoveDelegateStake(ctx context.Context, req *CancelRemoveDelegateStakeRequest) (*CancelRemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) CreateReputerCommission(ctx context.Context, req *CreateReputerCommissionRequest) (*CreateReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReputerCommission not implemented")
+}
+func (*UnimplementedMsgServiceServer) EditReputerCommission(ctx context.Context, req *EditReputerCommissionRequest) (*EditReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditReputerCommission not implemented")
+}
+func (*UnimplementedMsgServiceServer) RedelegateStake(ctx context.Context, req *RedelegateStakeRequest) (*RedelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) SetDelegateRewardAutoCompound(ctx context.Context, req *SetDelegateRewardAutoCompoundRequest) (*SetDelegateRewardAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateRewardAutoCompound not implemented")
+}
+func (*UnimplementedMsgServiceServer) ClaimAllDelegateRewards(ctx context.Context, req *ClaimAllDelegateRewardsRequest) (*ClaimAllDelegateRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllDelegateRewards not implemented")
+}
+func (*UnimplementedMsgServiceServer) TokenizeDelegateStake(ctx context.Context, req *TokenizeDelegateStakeRequest) (*TokenizeDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeDelegateStake not implemented")
+}
+func (*UnimplementedMsgServiceServer) RedeemDelegateStakeReceipt(ctx context.Context, req *RedeemDelegateStakeReceiptRequest) (*RedeemDelegateStakeReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemDelegateStakeReceipt not implemented")
+}
+func (*UnimplementedMsgServiceServer) FundTopic(ctx context.Context, req *FundTopicRequest) (*FundTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+}
+func (*UnimplementedMsgServiceServer) CreateFundingSubscription(ctx context.Context, req *CreateFundingSubscriptionRequest) (*CreateFundingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFundingSubscription not implemented")
+}
+func (*UnimplementedMsgServiceServer) TopUpFundingSubscription(ctx context.Context, req *TopUpFundingSubscriptionRequest) (*TopUpFundingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpFundingSubscription not implemented")
+}
+func (*UnimplementedMsgServiceServer) CancelFundingSubscription(ctx context.Context, req *CancelFundingSubscriptionRequest) (*CancelFundingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFundingSubscription not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddToWhitelistAdmin(ctx context.Context, req *AddToWhitelistAdminRequest) (*AddToWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveFromWhitelistAdmin(ctx context.Context, req *RemoveFromWhitelistAdminRequest) (*RemoveFromWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServiceServer) EnableTopicWorkerWhitelist(ctx context.Context, req *EnableTopicWorkerWhitelistRequest) (*EnableTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTopicWorkerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) DisableTopicWorkerWhitelist(ctx context.Context, req *DisableTopicWorkerWhitelistRequest) (*DisableTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTopicWorkerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) EnableTopicReputerWhitelist(ctx context.Context, req *EnableTopicReputerWhitelistRequest) (*EnableTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTopicReputerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) DisableTopicReputerWhitelist(ctx context.Context, req *DisableTopicReputerWhitelistRequest) (*DisableTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTopicReputerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddToTopicWorkerWhitelist(ctx context.Context, req *AddToTopicWorkerWhitelistRequest) (*AddToTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicWorkerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveFromTopicWorkerWhitelist(ctx context.Context, req *RemoveFromTopicWorkerWhitelistRequest) (*RemoveFromTopicWorkerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicWorkerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) AddToTopicReputerWhitelist(ctx context.Context, req *AddToTopicReputerWhitelistRequest) (*AddToTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicReputerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) RemoveFromTopicReputerWhitelist(ctx context.Context, req *RemoveFromTopicReputerWhitelistRequest) (*RemoveFromTopicReputerWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicReputerWhitelist not implemented")
+}
+func (*UnimplementedMsgServiceServer) InsertWorkerPayload(ctx context.Context, req *InsertWorkerPayloadRequest) (*InsertWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) InsertWorkerPayloads(ctx context.Context, req *InsertWorkerPayloadsRequest) (*InsertWorkerPayloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertWorkerPayloads not implemented")
+}
+func (*UnimplementedMsgServiceServer) CommitWorkerPayload(ctx context.Context, req *CommitWorkerPayloadRequest) (*CommitWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) RevealWorkerPayload(ctx context.Context, req *RevealWorkerPayloadRequest) (*RevealWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) InsertReputerPayload(ctx context.Context, req *InsertReputerPayloadRequest) (*InsertReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertReputerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) CommitReputerPayload(ctx context.Context, req *CommitReputerPayloadRequest) (*CommitReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReputerPayload not implemented")
+}
+func (*UnimplementedMsgServiceServer) RevealReputerPayload(ctx context.Context, req *RevealReputerPayloadRequest) (*RevealReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealReputerPayload not implemented")
 }
 
-func (m *CancelRemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
+	s.RegisterService(&_MsgService_serviceDesc, srv)
+}
+
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*UpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FundTopicRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNewTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateNewTopic(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CreateNewTopic",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateNewTopic(ctx, req.(*CreateNewTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FundTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/UpdateTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateTopic(ctx, req.(*UpdateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddToWhitelistAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_ArchiveTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ArchiveTopic(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/ArchiveTopic",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ArchiveTopic(ctx, req.(*ArchiveTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddToWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveFromWhitelistAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveRegistration(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveRegistration",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveRegistration(ctx, req.(*RemoveRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveFromWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_UpdateNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/UpdateNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateNodeInfo(ctx, req.(*UpdateNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RewardDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnjailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServiceServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/Unjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).Unjail(ctx, req.(*UnjailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RewardDelegateStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MsgService_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddStake(ctx, in)
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddStake(ctx, req.(*AddStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveStake(ctx, req.(*RemoveStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRemoveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelRemoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CancelRemoveStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelRemoveStake(ctx, req.(*CancelRemoveStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddWorkerBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddWorkerBond(ctx, req.(*AddWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveWorkerBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveWorkerBond(ctx, req.(*RemoveWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelRemoveWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRemoveWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelRemoveWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CancelRemoveWorkerBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelRemoveWorkerBond(ctx, req.(*CancelRemoveWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SlashWorkerBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashWorkerBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SlashWorkerBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/SlashWorkerBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SlashWorkerBond(ctx, req.(*SlashWorkerBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/DelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DelegateStake(ctx, req.(*DelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RewardDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RewardDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RewardDelegateStake(ctx, req.(*RewardDelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveDelegateStake(ctx, req.(*RemoveDelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRemoveDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CancelRemoveDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelRemoveDelegateStake(ctx, req.(*CancelRemoveDelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CreateReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CreateReputerCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateReputerCommission(ctx, req.(*CreateReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_EditReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EditReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/EditReputerCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EditReputerCommission(ctx, req.(*EditReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RedelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RedelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RedelegateStake(ctx, req.(*RedelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_SetDelegateRewardAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDelegateRewardAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetDelegateRewardAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/SetDelegateRewardAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetDelegateRewardAutoCompound(ctx, req.(*SetDelegateRewardAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_ClaimAllDelegateRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAllDelegateRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ClaimAllDelegateRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/ClaimAllDelegateRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ClaimAllDelegateRewards(ctx, req.(*ClaimAllDelegateRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_TokenizeDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeDelegateStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).TokenizeDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/TokenizeDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).TokenizeDelegateStake(ctx, req.(*TokenizeDelegateStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RedeemDelegateStakeReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemDelegateStakeReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RedeemDelegateStakeReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RedeemDelegateStakeReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RedeemDelegateStakeReceipt(ctx, req.(*RedeemDelegateStakeReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).FundTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/FundTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).FundTopic(ctx, req.(*FundTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CreateFundingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFundingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CreateFundingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CreateFundingSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CreateFundingSubscription(ctx, req.(*CreateFundingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_TopUpFundingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpFundingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).TopUpFundingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/TopUpFundingSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).TopUpFundingSubscription(ctx, req.(*TopUpFundingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CancelFundingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFundingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CancelFundingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CancelFundingSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CancelFundingSubscription(ctx, req.(*CancelFundingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWhitelistAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddToWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToWhitelistAdmin(ctx, req.(*AddToWhitelistAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWhitelistAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveFromWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromWhitelistAdmin(ctx, req.(*RemoveFromWhitelistAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_EnableTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EnableTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/EnableTopicWorkerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EnableTopicWorkerWhitelist(ctx, req.(*EnableTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DisableTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DisableTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/DisableTopicWorkerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DisableTopicWorkerWhitelist(ctx, req.(*DisableTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_EnableTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EnableTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/EnableTopicReputerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EnableTopicReputerWhitelist(ctx, req.(*EnableTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_DisableTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).DisableTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/DisableTopicReputerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).DisableTopicReputerWhitelist(ctx, req.(*DisableTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddToTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddToTopicWorkerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToTopicWorkerWhitelist(ctx, req.(*AddToTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveFromTopicWorkerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromTopicWorkerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromTopicWorkerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveFromTopicWorkerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromTopicWorkerWhitelist(ctx, req.(*RemoveFromTopicWorkerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_AddToTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).AddToTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/AddToTopicReputerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).AddToTopicReputerWhitelist(ctx, req.(*AddToTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RemoveFromTopicReputerWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromTopicReputerWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RemoveFromTopicReputerWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RemoveFromTopicReputerWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RemoveFromTopicReputerWhitelist(ctx, req.(*RemoveFromTopicReputerWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_InsertWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/InsertWorkerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertWorkerPayload(ctx, req.(*InsertWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_InsertWorkerPayloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertWorkerPayloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/InsertWorkerPayloads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertWorkerPayloads(ctx, req.(*InsertWorkerPayloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CommitWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CommitWorkerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CommitWorkerPayload(ctx, req.(*CommitWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevealWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealWorkerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RevealWorkerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevealWorkerPayload(ctx, req.(*RevealWorkerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_InsertReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertReputerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).InsertReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/InsertReputerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).InsertReputerPayload(ctx, req.(*InsertReputerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_CommitReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReputerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).CommitReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/CommitReputerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).CommitReputerPayload(ctx, req.(*CommitReputerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_RevealReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealReputerPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RevealReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v5.MsgService/RevealReputerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RevealReputerPayload(ctx, req.(*RevealReputerPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var MsgService_serviceDesc = _MsgService_serviceDesc
+var _MsgService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "emissions.v5.MsgService",
+	HandlerType: (*MsgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateNewTopic",
+			Handler:    _MsgService_CreateNewTopic_Handler,
+		},
+		{
+			MethodName: "UpdateTopic",
+			Handler:    _MsgService_UpdateTopic_Handler,
+		},
+		{
+			MethodName: "ArchiveTopic",
+			Handler:    _MsgService_ArchiveTopic_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _MsgService_Register_Handler,
+		},
+		{
+			MethodName: "RemoveRegistration",
+			Handler:    _MsgService_RemoveRegistration_Handler,
+		},
+		{
+			MethodName: "UpdateNodeInfo",
+			Handler:    _MsgService_UpdateNodeInfo_Handler,
+		},
+		{
+			MethodName: "Unjail",
+			Handler:    _MsgService_Unjail_Handler,
+		},
+		{
+			MethodName: "AddStake",
+			Handler:    _MsgService_AddStake_Handler,
+		},
+		{
+			MethodName: "RemoveStake",
+			Handler:    _MsgService_RemoveStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveStake",
+			Handler:    _MsgService_CancelRemoveStake_Handler,
+		},
+		{
+			MethodName: "AddWorkerBond",
+			Handler:    _MsgService_AddWorkerBond_Handler,
+		},
+		{
+			MethodName: "RemoveWorkerBond",
+			Handler:    _MsgService_RemoveWorkerBond_Handler,
+		},
+		{
+			MethodName: "CancelRemoveWorkerBond",
+			Handler:    _MsgService_CancelRemoveWorkerBond_Handler,
+		},
+		{
+			MethodName: "SlashWorkerBond",
+			Handler:    _MsgService_SlashWorkerBond_Handler,
+		},
+		{
+			MethodName: "DelegateStake",
+			Handler:    _MsgService_DelegateStake_Handler,
+		},
+		{
+			MethodName: "RewardDelegateStake",
+			Handler:    _MsgService_RewardDelegateStake_Handler,
+		},
+		{
+			MethodName: "RemoveDelegateStake",
+			Handler:    _MsgService_RemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveDelegateStake",
+			Handler:    _MsgService_CancelRemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "CreateReputerCommission",
+			Handler:    _MsgService_CreateReputerCommission_Handler,
+		},
+		{
+			MethodName: "EditReputerCommission",
+			Handler:    _MsgService_EditReputerCommission_Handler,
+		},
+		{
+			MethodName: "RedelegateStake",
+			Handler:    _MsgService_RedelegateStake_Handler,
+		},
+		{
+			MethodName: "SetDelegateRewardAutoCompound",
+			Handler:    _MsgService_SetDelegateRewardAutoCompound_Handler,
+		},
+		{
+			MethodName: "ClaimAllDelegateRewards",
+			Handler:    _MsgService_ClaimAllDelegateRewards_Handler,
+		},
+		{
+			MethodName: "TokenizeDelegateStake",
+			Handler:    _MsgService_TokenizeDelegateStake_Handler,
+		},
+		{
+			MethodName: "RedeemDelegateStakeReceipt",
+			Handler:    _MsgService_RedeemDelegateStakeReceipt_Handler,
+		},
+		{
+			MethodName: "FundTopic",
+			Handler:    _MsgService_FundTopic_Handler,
+		},
+		{
+			MethodName: "CreateFundingSubscription",
+			Handler:    _MsgService_CreateFundingSubscription_Handler,
+		},
+		{
+			MethodName: "TopUpFundingSubscription",
+			Handler:    _MsgService_TopUpFundingSubscription_Handler,
+		},
+		{
+			MethodName: "CancelFundingSubscription",
+			Handler:    _MsgService_CancelFundingSubscription_Handler,
+		},
+		{
+			MethodName: "AddToWhitelistAdmin",
+			Handler:    _MsgService_AddToWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveFromWhitelistAdmin",
+			Handler:    _MsgService_RemoveFromWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "EnableTopicWorkerWhitelist",
+			Handler:    _MsgService_EnableTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "DisableTopicWorkerWhitelist",
+			Handler:    _MsgService_DisableTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "EnableTopicReputerWhitelist",
+			Handler:    _MsgService_EnableTopicReputerWhitelist_Handler,
+		},
+		{
+			MethodName: "DisableTopicReputerWhitelist",
+			Handler:    _MsgService_DisableTopicReputerWhitelist_Handler,
+		},
+		{
+			MethodName: "AddToTopicWorkerWhitelist",
+			Handler:    _MsgService_AddToTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicWorkerWhitelist",
+			Handler:    _MsgService_RemoveFromTopicWorkerWhitelist_Handler,
+		},
+		{
+			MethodName: "AddToTopicReputerWhitelist",
+			Handler:    _MsgService_AddToTopicReputerWhitelist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicReputerWhitelist",
+			Handler:    _MsgService_RemoveFromTopicReputerWhitelist_Handler,
+		},
+		{
+			MethodName: "InsertWorkerPayload",
+			Handler:    _MsgService_InsertWorkerPayload_Handler,
+		},
+		{
+			MethodName: "InsertWorkerPayloads",
+			Handler:    _MsgService_InsertWorkerPayloads_Handler,
+		},
+		{
+			MethodName: "CommitWorkerPayload",
+			Handler:    _MsgService_CommitWorkerPayload_Handler,
+		},
+		{
+			MethodName: "RevealWorkerPayload",
+			Handler:    _MsgService_RevealWorkerPayload_Handler,
+		},
+		{
+			MethodName: "InsertReputerPayload",
+			Handler:    _MsgService_InsertReputerPayload_Handler,
+		},
+		{
+			MethodName: "CommitReputerPayload",
+			Handler:    _MsgService_CommitReputerPayload_Handler,
+		},
+		{
+			MethodName: "RevealReputerPayload",
+			Handler:    _MsgService_RevealReputerPayload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emissions/v5/tx.proto",
+}
+
+func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxAutoCompoundDelegationsPerPayout) > 0 {
+		dAtA2 := make([]byte, len(m.MaxAutoCompoundDelegationsPerPayout)*10)
+		var j1 int
+		for _, num := range m.MaxAutoCompoundDelegationsPerPayout {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.MaxDelegateStakePerReputer) > 0 {
+		for iNdEx := len(m.MaxDelegateStakePerReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxDelegateStakePerReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxDelegateStakePerReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.MaxDelegateToSelfStakeRatio) > 0 {
+		for iNdEx := len(m.MaxDelegateToSelfStakeRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxDelegateToSelfStakeRatio[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxDelegateToSelfStakeRatio[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.ReputerCommissionUpdateCooldown) > 0 {
+		dAtA4 := make([]byte, len(m.ReputerCommissionUpdateCooldown)*10)
+		var j3 int
+		for _, num1 := range m.ReputerCommissionUpdateCooldown {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.MaxMissedEpochsDeregister) > 0 {
+		dAtA6 := make([]byte, len(m.MaxMissedEpochsDeregister)*10)
+		var j5 int
+		for _, num := range m.MaxMissedEpochsDeregister {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.MaxMissedEpochsJail) > 0 {
+		dAtA8 := make([]byte, len(m.MaxMissedEpochsJail)*10)
+		var j7 int
+		for _, num := range m.MaxMissedEpochsJail {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.ReputerOutlierSlashFraction) > 0 {
+		for iNdEx := len(m.ReputerOutlierSlashFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ReputerOutlierSlashFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.ReputerOutlierSlashFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.ReputerOutlierSlashEpochs) > 0 {
+		dAtA10 := make([]byte, len(m.ReputerOutlierSlashEpochs)*10)
+		var j9 int
+		for _, num := range m.ReputerOutlierSlashEpochs {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.NodeRotationCooldown) > 0 {
+		dAtA12 := make([]byte, len(m.NodeRotationCooldown)*10)
+		var j11 int
+		for _, num1 := range m.NodeRotationCooldown {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.MaxTopicCreatorCommission) > 0 {
+		for iNdEx := len(m.MaxTopicCreatorCommission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxTopicCreatorCommission[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxTopicCreatorCommission[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MaxFundingSubscriptionDripsEndBlock) > 0 {
+		dAtA14 := make([]byte, len(m.MaxFundingSubscriptionDripsEndBlock)*10)
+		var j13 int
+		for _, num := range m.MaxFundingSubscriptionDripsEndBlock {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.MaxPruneArchivedTopicKeysEndBlock) > 0 {
+		dAtA16 := make([]byte, len(m.MaxPruneArchivedTopicKeysEndBlock)*10)
+		var j15 int
+		for _, num := range m.MaxPruneArchivedTopicKeysEndBlock {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.PNormSafeDiv) > 0 {
+		for iNdEx := len(m.PNormSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PNormSafeDiv[iNdEx].Size()
+				i -= size
+				if _, err := m.PNormSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.InitialRegretQuantile) > 0 {
+		for iNdEx := len(m.InitialRegretQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.InitialRegretQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.InitialRegretQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MaxStringLength) > 0 {
+		dAtA18 := make([]byte, len(m.MaxStringLength)*10)
+		var j17 int
+		for _, num := range m.MaxStringLength {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTx(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.MaxActiveTopicsPerBlock) > 0 {
+		dAtA20 := make([]byte, len(m.MaxActiveTopicsPerBlock)*10)
+		var j19 int
+		for _, num := range m.MaxActiveTopicsPerBlock {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.MaxElementsPerForecast) > 0 {
+		dAtA22 := make([]byte, len(m.MaxElementsPerForecast)*10)
+		var j21 int
+		for _, num := range m.MaxElementsPerForecast {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTx(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.EpsilonSafeDiv) > 0 {
+		for iNdEx := len(m.EpsilonSafeDiv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpsilonSafeDiv[iNdEx].Size()
+				i -= size
+				if _, err := m.EpsilonSafeDiv[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.DataSendingFee) > 0 {
+		for iNdEx := len(m.DataSendingFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DataSendingFee[iNdEx].Size()
+				i -= size
+				if _, err := m.DataSendingFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
+		dAtA24 := make([]byte, len(m.HalfMaxProcessStakeRemovalsEndBlock)*10)
+		var j23 int
+		for _, num := range m.HalfMaxProcessStakeRemovalsEndBlock {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintTx(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.EpsilonReputer) > 0 {
+		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpsilonReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.CNorm) > 0 {
+		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.CRewardForecast) > 0 {
+		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.CRewardInference) > 0 {
+		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PRewardReputer) > 0 {
+		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PRewardForecast) > 0 {
+		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PRewardInference) > 0 {
+		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.BlocksPerMonth) > 0 {
+		dAtA26 := make([]byte, len(m.BlocksPerMonth)*10)
+		var j25 int
+		for _, num := range m.BlocksPerMonth {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintTx(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		dAtA28 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
+		var j27 int
+		for _, num1 := range m.MinEpochLengthRecordLimit {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintTx(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.MaxPageLimit) > 0 {
+		dAtA30 := make([]byte, len(m.MaxPageLimit)*10)
+		var j29 int
+		for _, num := range m.MaxPageLimit {
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintTx(dAtA, i, uint64(j29))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.DefaultPageLimit) > 0 {
+		dAtA32 := make([]byte, len(m.DefaultPageLimit)*10)
+		var j31 int
+		for _, num := range m.DefaultPageLimit {
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintTx(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RegistrationFee[iNdEx].Size()
+				i -= size
+				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.GradientDescentMaxIters) > 0 {
+		dAtA34 := make([]byte, len(m.GradientDescentMaxIters)*10)
+		var j33 int
+		for _, num := range m.GradientDescentMaxIters {
+			for num >= 1<<7 {
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			dAtA34[j33] = uint8(num)
+			j33++
+		}
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintTx(dAtA, i, uint64(j33))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.CreateTopicFee) > 0 {
+		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CreateTopicFee[iNdEx].Size()
+				i -= size
+				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		dAtA36 := make([]byte, len(m.MaxTopReputersToReward)*10)
+		var j35 int
+		for _, num := range m.MaxTopReputersToReward {
+			for num >= 1<<7 {
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA36[j35] = uint8(num)
+			j35++
+		}
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintTx(dAtA, i, uint64(j35))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		dAtA38 := make([]byte, len(m.MaxTopForecastersToReward)*10)
+		var j37 int
+		for _, num := range m.MaxTopForecastersToReward {
+			for num >= 1<<7 {
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA38[j37] = uint8(num)
+			j37++
+		}
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintTx(dAtA, i, uint64(j37))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		dAtA40 := make([]byte, len(m.MaxTopInferersToReward)*10)
+		var j39 int
+		for _, num := range m.MaxTopInferersToReward {
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA40[j39] = uint8(num)
+			j39++
+		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintTx(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		dAtA42 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
+		var j41 int
+		for _, num := range m.MaxSamplesToScaleScores {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintTx(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
+				i -= size
+				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TaskRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardStakeImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		dAtA44 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
+		var j43 int
+		for _, num := range m.MaxUnfulfilledReputerRequests {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintTx(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		dAtA46 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
+		var j45 int
+		for _, num := range m.MaxUnfulfilledWorkerRequests {
+			for num >= 1<<7 {
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j45++
+			}
+			dAtA46[j45] = uint8(num)
+			j45++
+		}
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintTx(dAtA, i, uint64(j45))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinStakeFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxGradientThreshold[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LearningRate[iNdEx].Size()
+				i -= size
+				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BetaEntropy) > 0 {
+		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BetaEntropy[iNdEx].Size()
+				i -= size
+				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MinEpochLength) > 0 {
+		dAtA48 := make([]byte, len(m.MinEpochLength)*10)
+		var j47 int
+		for _, num1 := range m.MinEpochLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j47++
+			}
+			dAtA48[j47] = uint8(num)
+			j47++
+		}
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintTx(dAtA, i, uint64(j47))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		dAtA50 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
+		var j49 int
+		for _, num1 := range m.RemoveStakeDelayWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j49++
+			}
+			dAtA50[j49] = uint8(num)
+			j49++
+		}
+		i -= j49
+		copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintTx(dAtA, i, uint64(j49))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RequiredMinimumStake[iNdEx].Size()
+				i -= size
+				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinTopicWeight[iNdEx].Size()
+				i -= size
+				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		dAtA52 := make([]byte, len(m.MaxSerializedMsgLength)*10)
+		var j51 int
+		for _, num1 := range m.MaxSerializedMsgLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j51++
+			}
+			dAtA52[j51] = uint8(num)
+			j51++
+		}
+		i -= j51
+		copy(dAtA[i:], dAtA52[:j51])
+		i = encodeVarintTx(dAtA, i, uint64(j51))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Version[iNdEx])
+			copy(dAtA[i:], m.Version[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNewTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNewTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNewTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReputerOutlierThreshold.Size()
+		i -= size
+		if _, err := m.ReputerOutlierThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.MinWorkerBond.Size()
+		i -= size
+		if _, err := m.MinWorkerBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.ReputerRevealWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReputerRevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.WorkerRevealWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WorkerRevealWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size := m.CreatorCommission.Size()
+		i -= size
+		if _, err := m.CreatorCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if m.GroundTruthSource != nil {
+		{
+			size, err := m.GroundTruthSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.QuantileLevels) > 0 {
+		for iNdEx := len(m.QuantileLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.QuantileLevels[iNdEx].Size()
+				i -= size
+				if _, err := m.QuantileLevels[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.OutputDimension != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutputDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ChainComputedLosses {
+		i--
+		if m.ChainComputedLosses {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.TypedMetadata != nil {
+		{
+			size, err := m.TypedMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	{
+		size := m.ActiveReputerQuantile.Size()
+		i -= size
+		if _, err := m.ActiveReputerQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.ActiveForecasterQuantile.Size()
+		i -= size
+		if _, err := m.ActiveForecasterQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.ActiveInfererQuantile.Size()
+		i -= size
+		if _, err := m.ActiveInfererQuantile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MeritSortitionAlpha.Size()
+		i -= size
+		if _, err := m.MeritSortitionAlpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.WorkerSubmissionWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WorkerSubmissionWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.Epsilon.Size()
+		i -= size
+		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AllowNegative {
+		i--
+		if m.AllowNegative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.AlphaRegret.Size()
+		i -= size
+		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PNorm.Size()
+		i -= size
+		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.GroundTruthLag != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LossMethod) > 0 {
+		i -= len(m.LossMethod)
+		copy(dAtA[i:], m.LossMethod)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReputerOutlierThreshold) > 0 {
+		for iNdEx := len(m.ReputerOutlierThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ReputerOutlierThreshold[iNdEx].Size()
+				i -= size
+				if _, err := m.ReputerOutlierThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MinWorkerBond) > 0 {
+		for iNdEx := len(m.MinWorkerBond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinWorkerBond[iNdEx].Size()
+				i -= size
+				if _, err := m.MinWorkerBond[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ReputerRevealWindow) > 0 {
+		dAtA57 := make([]byte, len(m.ReputerRevealWindow)*10)
+		var j56 int
+		for _, num1 := range m.ReputerRevealWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j56++
+			}
+			dAtA57[j56] = uint8(num)
+			j56++
+		}
+		i -= j56
+		copy(dAtA[i:], dAtA57[:j56])
+		i = encodeVarintTx(dAtA, i, uint64(j56))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.WorkerRevealWindow) > 0 {
+		dAtA59 := make([]byte, len(m.WorkerRevealWindow)*10)
+		var j58 int
+		for _, num1 := range m.WorkerRevealWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j58++
+			}
+			dAtA59[j58] = uint8(num)
+			j58++
+		}
+		i -= j58
+		copy(dAtA[i:], dAtA59[:j58])
+		i = encodeVarintTx(dAtA, i, uint64(j58))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.CreatorCommission) > 0 {
+		for iNdEx := len(m.CreatorCommission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CreatorCommission[iNdEx].Size()
+				i -= size
+				if _, err := m.CreatorCommission[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.GroundTruthSource) > 0 {
+		for iNdEx := len(m.GroundTruthSource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroundTruthSource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TypedMetadata) > 0 {
+		for iNdEx := len(m.TypedMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TypedMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ActiveReputerQuantile) > 0 {
+		for iNdEx := len(m.ActiveReputerQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveReputerQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveReputerQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ActiveForecasterQuantile) > 0 {
+		for iNdEx := len(m.ActiveForecasterQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveForecasterQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveForecasterQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ActiveInfererQuantile) > 0 {
+		for iNdEx := len(m.ActiveInfererQuantile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ActiveInfererQuantile[iNdEx].Size()
+				i -= size
+				if _, err := m.ActiveInfererQuantile[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MeritSortitionAlpha) > 0 {
+		for iNdEx := len(m.MeritSortitionAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MeritSortitionAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.MeritSortitionAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Epsilon) > 0 {
+		for iNdEx := len(m.Epsilon) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Epsilon[iNdEx].Size()
+				i -= size
+				if _, err := m.Epsilon[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AlphaRegret) > 0 {
+		for iNdEx := len(m.AlphaRegret) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AlphaRegret[iNdEx].Size()
+				i -= size
+				if _, err := m.AlphaRegret[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PNorm) > 0 {
+		for iNdEx := len(m.PNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.PNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.WorkerSubmissionWindow) > 0 {
+		dAtA61 := make([]byte, len(m.WorkerSubmissionWindow)*10)
+		var j60 int
+		for _, num1 := range m.WorkerSubmissionWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j60++
+			}
+			dAtA61[j60] = uint8(num)
+			j60++
+		}
+		i -= j60
+		copy(dAtA[i:], dAtA61[:j60])
+		i = encodeVarintTx(dAtA, i, uint64(j60))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GroundTruthLag) > 0 {
+		dAtA63 := make([]byte, len(m.GroundTruthLag)*10)
+		var j62 int
+		for _, num1 := range m.GroundTruthLag {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j62++
+			}
+			dAtA63[j62] = uint8(num)
+			j62++
+		}
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintTx(dAtA, i, uint64(j62))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EpochLength) > 0 {
+		dAtA65 := make([]byte, len(m.EpochLength)*10)
+		var j64 int
+		for _, num1 := range m.EpochLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j64++
+			}
+			dAtA65[j64] = uint8(num)
+			j64++
+		}
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintTx(dAtA, i, uint64(j64))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Metadata[iNdEx])
+			copy(dAtA[i:], m.Metadata[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertReputerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertReputerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertReputerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundle != nil {
+		{
+			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitReputerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitReputerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitReputerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NonceBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NonceBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RevealReputerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevealReputerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevealReputerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReputerValueBundle != nil {
+		{
+			size, err := m.ReputerValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevealReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevealReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevealReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerDataBundle != nil {
+		{
+			size, err := m.WorkerDataBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerDataBundles) > 0 {
+		for iNdEx := len(m.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkerDataBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerPayloadResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerPayloadResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerPayloadResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsertWorkerPayloadsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertWorkerPayloadsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertWorkerPayloadsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NonceBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NonceBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RevealWorkerPayloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevealWorkerPayloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevealWorkerPayloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkerDataBundle != nil {
+		{
+			size, err := m.WorkerDataBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevealWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevealWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevealWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRegistrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRegistrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRegistrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NewNodeAddress) > 0 {
+		i -= len(m.NewNodeAddress)
+		copy(dAtA[i:], m.NewNodeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewNodeAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnjailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnjailRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnjailRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddWorkerBondRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddWorkerBondRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddWorkerBondRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddWorkerBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddWorkerBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddWorkerBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveWorkerBondRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveWorkerBondRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveWorkerBondRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveWorkerBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveWorkerBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveWorkerBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveWorkerBondRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveWorkerBondRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveWorkerBondRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveWorkerBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveWorkerBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveWorkerBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashWorkerBondRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashWorkerBondRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashWorkerBondRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashWorkerBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashWorkerBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashWorkerBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateReputerCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateReputerCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateReputerCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateReputerCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateReputerCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateReputerCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EditReputerCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditReputerCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditReputerCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditReputerCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditReputerCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditReputerCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DstReputer) > 0 {
+		i -= len(m.DstReputer)
+		copy(dAtA[i:], m.DstReputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstReputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcReputer) > 0 {
+		i -= len(m.SrcReputer)
+		copy(dAtA[i:], m.SrcReputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcReputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDelegateRewardAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDelegateRewardAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDelegateRewardAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDelegateRewardAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDelegateRewardAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDelegateRewardAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAllDelegateRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimAllDelegateRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAllDelegateRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAllDelegateRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimAllDelegateRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAllDelegateRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedeemDelegateStakeReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedeemDelegateStakeReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedeemDelegateStakeReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedeemDelegateStakeReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedeemDelegateStakeReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedeemDelegateStakeReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReturnedAmount.Size()
+		i -= size
+		if _, err := m.ReturnedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FundTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateFundingSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFundingSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateFundingSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EscrowAmount.Size()
+		i -= size
+		if _, err := m.EscrowAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AmountPerDrip.Size()
+		i -= size
+		if _, err := m.AmountPerDrip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateFundingSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFundingSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateFundingSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopUpFundingSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopUpFundingSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopUpFundingSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopUpFundingSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopUpFundingSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopUpFundingSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelFundingSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelFundingSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelFundingSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelFundingSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelFundingSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelFundingSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefundedAmount.Size()
+		i -= size
+		if _, err := m.RefundedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromWhitelistAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromWhitelistAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromWhitelistAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableTopicWorkerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableTopicWorkerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableTopicWorkerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableTopicWorkerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableTopicWorkerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableTopicWorkerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DisableTopicWorkerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableTopicWorkerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableTopicWorkerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisableTopicWorkerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableTopicWorkerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableTopicWorkerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableTopicReputerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableTopicReputerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableTopicReputerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableTopicReputerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableTopicReputerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableTopicReputerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DisableTopicReputerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableTopicReputerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableTopicReputerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisableTopicReputerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableTopicReputerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableTopicReputerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToTopicWorkerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToTopicWorkerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToTopicWorkerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToTopicWorkerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToTopicWorkerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToTopicWorkerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromTopicWorkerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromTopicWorkerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromTopicWorkerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromTopicWorkerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromTopicWorkerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromTopicWorkerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToTopicReputerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToTopicReputerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToTopicReputerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToTopicReputerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToTopicReputerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToTopicReputerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromTopicReputerWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromTopicReputerWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromTopicReputerWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromTopicReputerWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromTopicReputerWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromTopicReputerWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDelegateStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDelegateStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDelegateStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OptionalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		for _, s := range m.Version {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		l = 0
+		for _, e := range m.MaxSerializedMsgLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for _, e := range m.MinTopicWeight {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for _, e := range m.RequiredMinimumStake {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		l = 0
+		for _, e := range m.RemoveStakeDelayWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLength) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.BetaEntropy) > 0 {
+		for _, e := range m.BetaEntropy {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for _, e := range m.LearningRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for _, e := range m.MaxGradientThreshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for _, e := range m.MinStakeFraction {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledWorkerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledReputerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for _, e := range m.TopicRewardStakeImportance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for _, e := range m.TopicRewardFeeRevenueImportance {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for _, e := range m.TopicRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for _, e := range m.TaskRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for _, e := range m.ValidatorsVsAlloraPercentReward {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		l = 0
+		for _, e := range m.MaxSamplesToScaleScores {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopInferersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopForecastersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopReputersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.CreateTopicFee) > 0 {
+		for _, e := range m.CreateTopicFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.GradientDescentMaxIters) > 0 {
+		l = 0
+		for _, e := range m.GradientDescentMaxIters {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DefaultPageLimit) > 0 {
+		l = 0
+		for _, e := range m.DefaultPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxPageLimit) > 0 {
+		l = 0
+		for _, e := range m.MaxPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLengthRecordLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.BlocksPerMonth) > 0 {
+		l = 0
+		for _, e := range m.BlocksPerMonth {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.PRewardInference) > 0 {
+		for _, e := range m.PRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardForecast) > 0 {
+		for _, e := range m.PRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardReputer) > 0 {
+		for _, e := range m.PRewardReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardInference) > 0 {
+		for _, e := range m.CRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardForecast) > 0 {
+		for _, e := range m.CRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CNorm) > 0 {
+		for _, e := range m.CNorm {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpsilonReputer) > 0 {
+		for _, e := range m.EpsilonReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
+		l = 0
+		for _, e := range m.HalfMaxProcessStakeRemovalsEndBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.DataSendingFee) > 0 {
+		for _, e := range m.DataSendingFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpsilonSafeDiv) > 0 {
+		for _, e := range m.EpsilonSafeDiv {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxElementsPerForecast) > 0 {
+		l = 0
+		for _, e := range m.MaxElementsPerForecast {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxActiveTopicsPerBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxActiveTopicsPerBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxStringLength) > 0 {
+		l = 0
+		for _, e := range m.MaxStringLength {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.InitialRegretQuantile) > 0 {
+		for _, e := range m.InitialRegretQuantile {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PNormSafeDiv) > 0 {
+		for _, e := range m.PNormSafeDiv {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxPruneArchivedTopicKeysEndBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxPruneArchivedTopicKeysEndBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxFundingSubscriptionDripsEndBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxFundingSubscriptionDripsEndBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopicCreatorCommission) > 0 {
+		for _, e := range m.MaxTopicCreatorCommission {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.NodeRotationCooldown) > 0 {
+		l = 0
+		for _, e := range m.NodeRotationCooldown {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ReputerOutlierSlashEpochs) > 0 {
+		l = 0
+		for _, e := range m.ReputerOutlierSlashEpochs {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ReputerOutlierSlashFraction) > 0 {
+		for _, e := range m.ReputerOutlierSlashFraction {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxMissedEpochsJail) > 0 {
+		l = 0
+		for _, e := range m.MaxMissedEpochsJail {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxMissedEpochsDeregister) > 0 {
+		l = 0
+		for _, e := range m.MaxMissedEpochsDeregister {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ReputerCommissionUpdateCooldown) > 0 {
+		l = 0
+		for _, e := range m.ReputerCommissionUpdateCooldown {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxDelegateToSelfStakeRatio) > 0 {
+		for _, e := range m.MaxDelegateToSelfStakeRatio {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxDelegateStakePerReputer) > 0 {
+		for _, e := range m.MaxDelegateStakePerReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxAutoCompoundDelegationsPerPayout) > 0 {
+		l = 0
+		for _, e := range m.MaxAutoCompoundDelegationsPerPayout {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *UpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CreateNewTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTx(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
+		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	}
+	l = m.PNorm.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AlphaRegret.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AllowNegative {
+		n += 2
+	}
+	l = m.Epsilon.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.WorkerSubmissionWindow != 0 {
+		n += 1 + sovTx(uint64(m.WorkerSubmissionWindow))
+	}
+	l = m.MeritSortitionAlpha.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ActiveInfererQuantile.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.ActiveForecasterQuantile.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.ActiveReputerQuantile.Size()
+	n += 2 + l + sovTx(uint64(l))
+	if m.TypedMetadata != nil {
+		l = m.TypedMetadata.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.ChainComputedLosses {
+		n += 3
+	}
+	if m.OutputDimension != 0 {
+		n += 2 + sovTx(uint64(m.OutputDimension))
+	}
+	if len(m.QuantileLevels) > 0 {
+		for _, e := range m.QuantileLevels {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GroundTruthSource != nil {
+		l = m.GroundTruthSource.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = m.CreatorCommission.Size()
+	n += 2 + l + sovTx(uint64(l))
+	if m.WorkerRevealWindow != 0 {
+		n += 2 + sovTx(uint64(m.WorkerRevealWindow))
+	}
+	if m.ReputerRevealWindow != 0 {
+		n += 2 + sovTx(uint64(m.ReputerRevealWindow))
+	}
+	l = m.MinWorkerBond.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.ReputerOutlierThreshold.Size()
+	n += 2 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *CreateNewTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *UpdateTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if len(m.Metadata) > 0 {
+		for _, s := range m.Metadata {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpochLength) > 0 {
+		l = 0
+		for _, e := range m.EpochLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.GroundTruthLag) > 0 {
+		l = 0
+		for _, e := range m.GroundTruthLag {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.WorkerSubmissionWindow) > 0 {
+		l = 0
+		for _, e := range m.WorkerSubmissionWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.PNorm) > 0 {
+		for _, e := range m.PNorm {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AlphaRegret) > 0 {
+		for _, e := range m.AlphaRegret {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Epsilon) > 0 {
+		for _, e := range m.Epsilon {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MeritSortitionAlpha) > 0 {
+		for _, e := range m.MeritSortitionAlpha {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ActiveInfererQuantile) > 0 {
+		for _, e := range m.ActiveInfererQuantile {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ActiveForecasterQuantile) > 0 {
+		for _, e := range m.ActiveForecasterQuantile {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ActiveReputerQuantile) > 0 {
+		for _, e := range m.ActiveReputerQuantile {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TypedMetadata) > 0 {
+		for _, e := range m.TypedMetadata {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.GroundTruthSource) > 0 {
+		for _, e := range m.GroundTruthSource {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CreatorCommission) > 0 {
+		for _, e := range m.CreatorCommission {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.WorkerRevealWindow) > 0 {
+		l = 0
+		for _, e := range m.WorkerRevealWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ReputerRevealWindow) > 0 {
+		l = 0
+		for _, e := range m.ReputerRevealWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinWorkerBond) > 0 {
+		for _, e := range m.MinWorkerBond {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReputerOutlierThreshold) > 0 {
+		for _, e := range m.ReputerOutlierThreshold {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ArchiveTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *ArchiveTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsertReputerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReputerValueBundle != nil {
+		l = m.ReputerValueBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertReputerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommitReputerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.NonceBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.NonceBlockHeight))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitReputerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RevealReputerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReputerValueBundle != nil {
+		l = m.ReputerValueBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RevealReputerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsertWorkerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WorkerDataBundle != nil {
+		l = m.WorkerDataBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsertWorkerPayloadsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WorkerDataBundles) > 0 {
+		for _, e := range m.WorkerDataBundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkerPayloadResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertWorkerPayloadsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommitWorkerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.NonceBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.NonceBlockHeight))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RevealWorkerPayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WorkerDataBundle != nil {
+		l = m.WorkerDataBundle.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RevealWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RegisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *RegisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveRegistrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *RemoveRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateNodeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewNodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnjailRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *UnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *AddStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelRemoveStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *CancelRemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddWorkerBondRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *AddWorkerBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveWorkerBondRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RemoveWorkerBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelRemoveWorkerBondRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *CancelRemoveWorkerBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SlashWorkerBondRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashWorkerBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *DelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveDelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelRemoveDelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelRemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CreateReputerCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *CreateReputerCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EditReputerCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *EditReputerCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RedelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.SrcReputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstReputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RedelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetDelegateRewardAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetDelegateRewardAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ClaimAllDelegateRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimAllDelegateRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *TokenizeDelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *TokenizeDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RedeemDelegateStakeReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *RedeemDelegateStakeReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ReturnedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *FundTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *FundTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CreateFundingSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.AmountPerDrip.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovTx(uint64(m.IntervalBlocks))
+	}
+	l = m.EscrowAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *CreateFundingSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *TopUpFundingSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *TopUpFundingSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelFundingSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *CancelFundingSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RefundedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *AddToWhitelistAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AddToWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveFromWhitelistAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveFromWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnableTopicWorkerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *EnableTopicWorkerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DisableTopicWorkerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *DisableTopicWorkerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EnableTopicReputerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *EnableTopicReputerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DisableTopicReputerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *DisableTopicReputerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddToTopicWorkerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AddToTopicWorkerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveFromTopicWorkerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveFromTopicWorkerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddToTopicReputerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AddToTopicReputerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveFromTopicReputerWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveFromTopicReputerWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RewardDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RewardDelegateStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OptionalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
+					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinTopicWeight = append(m.MinTopicWeight, v)
+			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
+			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
+					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLength = append(m.MinEpochLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLength) == 0 {
+					m.MinEpochLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLength = append(m.MinEpochLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BetaEntropy = append(m.BetaEntropy, v)
+			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.LearningRate = append(m.LearningRate, v)
+			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
+			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinStakeFraction = append(m.MinStakeFraction, v)
+			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
+					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+			}
+		case 14:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
+					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
+			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
+			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
+			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
+			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
+			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
+					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
+			}
+		case 21:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
+					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+			}
+		case 22:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
+					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+			}
+		case 23:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
+					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.CreateTopicFee = append(m.CreateTopicFee, v)
+			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
+					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RegistrationFee = append(m.RegistrationFee, v)
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
+					m.DefaultPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
+			}
+		case 30:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxPageLimit = append(m.MaxPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
+					m.MaxPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxPageLimit = append(m.MaxPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
+			}
+		case 31:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
+					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
+					m.BlocksPerMonth = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardInference = append(m.PRewardInference, v)
+			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardForecast = append(m.PRewardForecast, v)
+			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardReputer = append(m.PRewardReputer, v)
+			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardInference = append(m.CRewardInference, v)
+			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardForecast = append(m.CRewardForecast, v)
+			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CNorm = append(m.CNorm, v)
+			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.EpsilonReputer = append(m.EpsilonReputer, v)
+			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HalfMaxProcessStakeRemovalsEndBlock) == 0 {
+					m.HalfMaxProcessStakeRemovalsEndBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfMaxProcessStakeRemovalsEndBlock", wireType)
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DataSendingFee = append(m.DataSendingFee, v)
+			if err := m.DataSendingFee[len(m.DataSendingFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonSafeDiv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.EpsilonSafeDiv = append(m.EpsilonSafeDiv, v)
+			if err := m.EpsilonSafeDiv[len(m.EpsilonSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxElementsPerForecast) == 0 {
+					m.MaxElementsPerForecast = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElementsPerForecast", wireType)
+			}
+		case 46:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxActiveTopicsPerBlock) == 0 {
+					m.MaxActiveTopicsPerBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveTopicsPerBlock", wireType)
+			}
+		case 47:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxStringLength = append(m.MaxStringLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxStringLength) == 0 {
+					m.MaxStringLength = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxStringLength = append(m.MaxStringLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStringLength", wireType)
+			}
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegretQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.InitialRegretQuantile = append(m.InitialRegretQuantile, v)
+			if err := m.InitialRegretQuantile[len(m.InitialRegretQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNormSafeDiv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PNormSafeDiv = append(m.PNormSafeDiv, v)
+			if err := m.PNormSafeDiv[len(m.PNormSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxPruneArchivedTopicKeysEndBlock = append(m.MaxPruneArchivedTopicKeysEndBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxPruneArchivedTopicKeysEndBlock) == 0 {
+					m.MaxPruneArchivedTopicKeysEndBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxPruneArchivedTopicKeysEndBlock = append(m.MaxPruneArchivedTopicKeysEndBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPruneArchivedTopicKeysEndBlock", wireType)
+			}
+		case 51:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxFundingSubscriptionDripsEndBlock = append(m.MaxFundingSubscriptionDripsEndBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxFundingSubscriptionDripsEndBlock) == 0 {
+					m.MaxFundingSubscriptionDripsEndBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxFundingSubscriptionDripsEndBlock = append(m.MaxFundingSubscriptionDripsEndBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingSubscriptionDripsEndBlock", wireType)
+			}
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicCreatorCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxTopicCreatorCommission = append(m.MaxTopicCreatorCommission, v)
+			if err := m.MaxTopicCreatorCommission[len(m.MaxTopicCreatorCommission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 53:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NodeRotationCooldown = append(m.NodeRotationCooldown, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NodeRotationCooldown) == 0 {
+					m.NodeRotationCooldown = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NodeRotationCooldown = append(m.NodeRotationCooldown, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeRotationCooldown", wireType)
+			}
+		case 54:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReputerOutlierSlashEpochs = append(m.ReputerOutlierSlashEpochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReputerOutlierSlashEpochs) == 0 {
+					m.ReputerOutlierSlashEpochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReputerOutlierSlashEpochs = append(m.ReputerOutlierSlashEpochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierSlashEpochs", wireType)
+			}
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ReputerOutlierSlashFraction = append(m.ReputerOutlierSlashFraction, v)
+			if err := m.ReputerOutlierSlashFraction[len(m.ReputerOutlierSlashFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 56:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxMissedEpochsJail = append(m.MaxMissedEpochsJail, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxMissedEpochsJail) == 0 {
+					m.MaxMissedEpochsJail = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxMissedEpochsJail = append(m.MaxMissedEpochsJail, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochsJail", wireType)
+			}
+		case 57:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxMissedEpochsDeregister = append(m.MaxMissedEpochsDeregister, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxMissedEpochsDeregister) == 0 {
+					m.MaxMissedEpochsDeregister = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxMissedEpochsDeregister = append(m.MaxMissedEpochsDeregister, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochsDeregister", wireType)
+			}
+		case 58:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReputerCommissionUpdateCooldown = append(m.ReputerCommissionUpdateCooldown, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReputerCommissionUpdateCooldown) == 0 {
+					m.ReputerCommissionUpdateCooldown = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReputerCommissionUpdateCooldown = append(m.ReputerCommissionUpdateCooldown, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerCommissionUpdateCooldown", wireType)
+			}
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegateToSelfStakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxDelegateToSelfStakeRatio = append(m.MaxDelegateToSelfStakeRatio, v)
+			if err := m.MaxDelegateToSelfStakeRatio[len(m.MaxDelegateToSelfStakeRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegateStakePerReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxDelegateStakePerReputer = append(m.MaxDelegateStakePerReputer, v)
+			if err := m.MaxDelegateStakePerReputer[len(m.MaxDelegateStakePerReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxAutoCompoundDelegationsPerPayout = append(m.MaxAutoCompoundDelegationsPerPayout, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxAutoCompoundDelegationsPerPayout) == 0 {
+					m.MaxAutoCompoundDelegationsPerPayout = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxAutoCompoundDelegationsPerPayout = append(m.MaxAutoCompoundDelegationsPerPayout, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundDelegationsPerPayout", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateNewTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateNewTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNegative = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+			}
+			m.WorkerSubmissionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerSubmissionWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MeritSortitionAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveInfererQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveForecasterQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveReputerQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TypedMetadata == nil {
+				m.TypedMetadata = &TopicMetadata{}
+			}
+			if err := m.TypedMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainComputedLosses", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainComputedLosses = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
+			}
+			m.OutputDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantileLevels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.QuantileLevels = append(m.QuantileLevels, v)
+			if err := m.QuantileLevels[len(m.QuantileLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroundTruthSource == nil {
+				m.GroundTruthSource = &GroundTruthSource{}
+			}
+			if err := m.GroundTruthSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatorCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+			}
+			m.WorkerRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRevealWindow", wireType)
+			}
+			m.ReputerRevealWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerRevealWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkerBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWorkerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerOutlierThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateNewTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EpochLength = append(m.EpochLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EpochLength) == 0 {
+					m.EpochLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EpochLength = append(m.EpochLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GroundTruthLag = append(m.GroundTruthLag, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GroundTruthLag) == 0 {
+					m.GroundTruthLag = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GroundTruthLag = append(m.GroundTruthLag, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WorkerSubmissionWindow = append(m.WorkerSubmissionWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WorkerSubmissionWindow) == 0 {
+					m.WorkerSubmissionWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WorkerSubmissionWindow = append(m.WorkerSubmissionWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PNorm = append(m.PNorm, v)
+			if err := m.PNorm[len(m.PNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.AlphaRegret = append(m.AlphaRegret, v)
+			if err := m.AlphaRegret[len(m.AlphaRegret)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Epsilon = append(m.Epsilon, v)
+			if err := m.Epsilon[len(m.Epsilon)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MeritSortitionAlpha = append(m.MeritSortitionAlpha, v)
+			if err := m.MeritSortitionAlpha[len(m.MeritSortitionAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveInfererQuantile = append(m.ActiveInfererQuantile, v)
+			if err := m.ActiveInfererQuantile[len(m.ActiveInfererQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveForecasterQuantile = append(m.ActiveForecasterQuantile, v)
+			if err := m.ActiveForecasterQuantile[len(m.ActiveForecasterQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ActiveReputerQuantile = append(m.ActiveReputerQuantile, v)
+			if err := m.ActiveReputerQuantile[len(m.ActiveReputerQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypedMetadata = append(m.TypedMetadata, &TopicMetadata{})
+			if err := m.TypedMetadata[len(m.TypedMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroundTruthSource = append(m.GroundTruthSource, &GroundTruthSource{})
+			if err := m.GroundTruthSource[len(m.GroundTruthSource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CreatorCommission = append(m.CreatorCommission, v)
+			if err := m.CreatorCommission[len(m.CreatorCommission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WorkerRevealWindow = append(m.WorkerRevealWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WorkerRevealWindow) == 0 {
+					m.WorkerRevealWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WorkerRevealWindow = append(m.WorkerRevealWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRevealWindow", wireType)
+			}
+		case 18:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReputerRevealWindow = append(m.ReputerRevealWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReputerRevealWindow) == 0 {
+					m.ReputerRevealWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReputerRevealWindow = append(m.ReputerRevealWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRevealWindow", wireType)
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkerBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinWorkerBond = append(m.MinWorkerBond, v)
+			if err := m.MinWorkerBond[len(m.MinWorkerBond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerOutlierThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ReputerOutlierThreshold = append(m.ReputerOutlierThreshold, v)
+			if err := m.ReputerOutlierThreshold[len(m.ReputerOutlierThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertReputerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertReputerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReputerValueBundle == nil {
+				m.ReputerValueBundle = &ReputerValueBundle{}
+			}
+			if err := m.ReputerValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertReputerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitReputerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitReputerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitReputerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+			}
+			m.NonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitReputerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevealReputerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevealReputerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevealReputerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReputerValueBundle == nil {
+				m.ReputerValueBundle = &ReputerValueBundle{}
+			}
+			if err := m.ReputerValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevealReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevealReputerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevealReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerDataBundle == nil {
+				m.WorkerDataBundle = &WorkerDataBundle{}
+			}
+			if err := m.WorkerDataBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerDataBundles = append(m.WorkerDataBundles, &WorkerDataBundle{})
+			if err := m.WorkerDataBundles[len(m.WorkerDataBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerPayloadResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerPayloadResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerPayloadResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertWorkerPayloadsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertWorkerPayloadsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &WorkerPayloadResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitWorkerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+			}
+			m.NonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevealWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevealWorkerPayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevealWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerDataBundle == nil {
+				m.WorkerDataBundle = &WorkerDataBundle{}
+			}
+			if err := m.WorkerDataBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevealWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevealWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevealWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRegistrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRegistrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRegistrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateNodeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateNodeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewNodeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewNodeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateNodeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateNodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnjailRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnjailRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnjailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *OptionalParams) Unmarshal(dAtA []byte) error {
+func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5434,15 +21809,15 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveStakeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5470,87 +21845,164 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
-					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5578,17 +22030,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinTopicWeight = append(m.MinTopicWeight, v)
-			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5598,183 +22046,114 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
-			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
-					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MinEpochLength = append(m.MinEpochLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLength) == 0 {
-					m.MinEpochLength = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLength = append(m.MinEpochLength, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddWorkerBondRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddWorkerBondRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddWorkerBondRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5802,17 +22181,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.BetaEntropy = append(m.BetaEntropy, v)
-			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5822,31 +22197,14 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.LearningRate = append(m.LearningRate, v)
-			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5874,203 +22232,113 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
-			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddWorkerBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinStakeFraction = append(m.MinStakeFraction, v)
-			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddWorkerBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddWorkerBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
-					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveWorkerBondRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 14:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
-					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 15:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveWorkerBondRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveWorkerBondRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6098,15 +22366,30 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
-			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6134,15 +22417,113 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
-			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveWorkerBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveWorkerBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveWorkerBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveWorkerBondRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveWorkerBondRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveWorkerBondRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6170,17 +22551,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
-			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6190,31 +22567,114 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
-			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveWorkerBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveWorkerBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveWorkerBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 19:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashWorkerBondRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashWorkerBondRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashWorkerBondRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6242,319 +22702,279 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
-			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
-					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
-			}
-		case 21:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
-					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
 			}
-		case 22:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
-					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashWorkerBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashWorkerBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashWorkerBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 23:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
-					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
 			}
-		case 24:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6582,91 +23002,145 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.CreateTopicFee = append(m.CreateTopicFee, v)
-			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
-					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
 			}
-		case 28:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDelegateStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6693,320 +23167,280 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.RegistrationFee = append(m.RegistrationFee, v)
-			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 29:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
-					m.DefaultPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
-			}
-		case 30:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxPageLimit = append(m.MaxPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
-					m.MaxPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxPageLimit = append(m.MaxPageLimit, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
-			}
-		case 31:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
-					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
-			}
-		case 32:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDelegateStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if postIndex > l {
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
-					m.BlocksPerMonth = make([]uint64, 0, elementCount)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
 			}
-		case 33:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7034,15 +23468,111 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardInference = append(m.PRewardInference, v)
-			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 34:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateReputerCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateReputerCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateReputerCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7070,15 +23600,30 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardForecast = append(m.PRewardForecast, v)
-			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 35:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7106,15 +23651,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardReputer = append(m.PRewardReputer, v)
-			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7142,15 +23685,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardInference = append(m.CRewardInference, v)
-			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7178,15 +23719,113 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardForecast = append(m.CRewardForecast, v)
-			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateReputerCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateReputerCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateReputerCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditReputerCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditReputerCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditReputerCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7214,15 +23853,30 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CNorm = append(m.CNorm, v)
-			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 40:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7250,91 +23904,164 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.EpsilonReputer = append(m.EpsilonReputer, v)
-			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 42:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditReputerCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditReputerCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditReputerCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegateStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegateStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.HalfMaxProcessStakeRemovalsEndBlock) == 0 {
-					m.HalfMaxProcessStakeRemovalsEndBlock = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field HalfMaxProcessStakeRemovalsEndBlock", wireType)
 			}
-		case 43:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSendingFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7362,15 +24089,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.DataSendingFee = append(m.DataSendingFee, v)
-			if err := m.DataSendingFee[len(m.DataSendingFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SrcReputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 44:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonSafeDiv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7398,243 +24121,297 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.EpsilonSafeDiv = append(m.EpsilonSafeDiv, v)
-			if err := m.EpsilonSafeDiv[len(m.EpsilonSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DstReputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 45:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxElementsPerForecast) == 0 {
-					m.MaxElementsPerForecast = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxElementsPerForecast = append(m.MaxElementsPerForecast, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxElementsPerForecast", wireType)
-			}
-		case 46:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxActiveTopicsPerBlock) == 0 {
-					m.MaxActiveTopicsPerBlock = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxActiveTopicsPerBlock = append(m.MaxActiveTopicsPerBlock, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveTopicsPerBlock", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-		case 47:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxStringLength = append(m.MaxStringLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegateStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegateStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDelegateRewardAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDelegateRewardAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDelegateRewardAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxStringLength) == 0 {
-					m.MaxStringLength = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxStringLength = append(m.MaxStringLength, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxStringLength", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDelegateRewardAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 48:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDelegateRewardAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDelegateRewardAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimAllDelegateRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimAllDelegateRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimAllDelegateRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegretQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7662,15 +24439,61 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.InitialRegretQuantile = append(m.InitialRegretQuantile, v)
-			if err := m.InitialRegretQuantile[len(m.InitialRegretQuantile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 49:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimAllDelegateRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimAllDelegateRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimAllDelegateRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNormSafeDiv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7698,9 +24521,7 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PNormSafeDiv = append(m.PNormSafeDiv, v)
-			if err := m.PNormSafeDiv[len(m.PNormSafeDiv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7725,7 +24546,7 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *TokenizeDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7748,10 +24569,10 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeDelegateStakeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7787,10 +24608,29 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7800,78 +24640,58 @@ func (m *UpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &OptionalParams{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7893,7 +24713,7 @@ func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *TokenizeDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7916,15 +24736,34 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateNewTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateNewTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7952,11 +24791,11 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7984,11 +24823,63 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedeemDelegateStakeReceiptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedeemDelegateStakeReceiptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedeemDelegateStakeReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8016,32 +24907,13 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
-			}
-			m.EpochLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.GroundTruthLag = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8051,14 +24923,14 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroundTruthLag |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8086,67 +24958,63 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedeemDelegateStakeReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AllowNegative = bool(v != 0)
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedeemDelegateStakeReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedeemDelegateStakeReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8174,32 +25042,13 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerSubmissionWindow", wireType)
-			}
-			m.WorkerSubmissionWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkerSubmissionWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeritSortitionAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8227,13 +25076,63 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MeritSortitionAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReturnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveInfererQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8261,15 +25160,13 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActiveInfererQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForecasterQuantile", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8279,29 +25176,14 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ActiveForecasterQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveReputerQuantile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8329,7 +25211,7 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActiveReputerQuantile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8354,7 +25236,7 @@ func (m *CreateNewTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *FundTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8377,31 +25259,12 @@ func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateNewTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8423,7 +25286,7 @@ func (m *CreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateFundingSubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8446,10 +25309,10 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertReputerPayloadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateFundingSubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertReputerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateFundingSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8485,10 +25348,29 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerDrip", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8498,25 +25380,76 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReputerValueBundle == nil {
-				m.ReputerValueBundle = &ReputerValueBundle{}
+			if err := m.AmountPerDrip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ReputerValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8541,7 +25474,7 @@ func (m *InsertReputerPayloadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateFundingSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8564,12 +25497,31 @@ func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertReputerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateFundingSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateFundingSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8591,7 +25543,7 @@ func (m *InsertReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
+func (m *TopUpFundingSubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8614,10 +25566,10 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertWorkerPayloadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopUpFundingSubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertWorkerPayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopUpFundingSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8653,10 +25605,29 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8666,25 +25637,23 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkerDataBundle == nil {
-				m.WorkerDataBundle = &WorkerDataBundle{}
-			}
-			if err := m.WorkerDataBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8709,7 +25678,7 @@ func (m *InsertWorkerPayloadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *TopUpFundingSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8732,10 +25701,10 @@ func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertWorkerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopUpFundingSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopUpFundingSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8759,89 +25728,38 @@ func (m *InsertWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelFundingSubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelFundingSubscriptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelFundingSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8869,13 +25787,13 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
 			}
-			var v int
+			m.SubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8885,12 +25803,11 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsReputer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8912,7 +25829,7 @@ func (m *RegisterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelFundingSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8935,35 +25852,15 @@ func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelFundingSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelFundingSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8991,7 +25888,9 @@ func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.RefundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9014,7 +25913,7 @@ func (m *RegisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
+func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9037,10 +25936,10 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRegistrationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToWhitelistAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRegistrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9076,10 +25975,10 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9089,31 +25988,24 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.IsReputer = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9135,7 +26027,7 @@ func (m *RemoveRegistrationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
+func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9158,64 +26050,12 @@ func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRegistrationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9237,7 +26077,7 @@ func (m *RemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9260,10 +26100,10 @@ func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9299,27 +26139,8 @@ func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9347,9 +26168,7 @@ func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9372,7 +26191,7 @@ func (m *AddStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9395,10 +26214,10 @@ func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9422,7 +26241,7 @@ func (m *AddStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *EnableTopicWorkerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9445,10 +26264,10 @@ func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableTopicWorkerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableTopicWorkerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9502,40 +26321,6 @@ func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9557,7 +26342,7 @@ func (m *RemoveStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *EnableTopicWorkerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9580,10 +26365,10 @@ func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableTopicWorkerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableTopicWorkerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9607,7 +26392,7 @@ func (m *RemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *DisableTopicWorkerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9630,10 +26415,10 @@ func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableTopicWorkerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableTopicWorkerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9708,7 +26493,7 @@ func (m *CancelRemoveStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *DisableTopicWorkerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9731,10 +26516,10 @@ func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableTopicWorkerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableTopicWorkerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9758,7 +26543,7 @@ func (m *CancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *EnableTopicReputerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9781,10 +26566,10 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableTopicReputerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableTopicReputerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9838,72 +26623,6 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9925,7 +26644,7 @@ func (m *DelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *EnableTopicReputerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9948,10 +26667,10 @@ func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableTopicReputerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableTopicReputerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9975,7 +26694,7 @@ func (m *DelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *DisableTopicReputerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9998,10 +26717,10 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDelegateStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableTopicReputerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableTopicReputerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10037,38 +26756,6 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -10087,40 +26774,6 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10142,7 +26795,7 @@ func (m *RemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *DisableTopicReputerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10165,10 +26818,10 @@ func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableTopicReputerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableTopicReputerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10192,7 +26845,7 @@ func (m *RemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *AddToTopicWorkerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10215,10 +26868,10 @@ func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToTopicWorkerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToTopicWorkerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10274,39 +26927,7 @@ func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10334,7 +26955,7 @@ func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10357,7 +26978,7 @@ func (m *CancelRemoveDelegateStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *AddToTopicWorkerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10380,10 +27001,10 @@ func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToTopicWorkerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToTopicWorkerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10407,7 +27028,7 @@ func (m *CancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromTopicWorkerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10430,10 +27051,10 @@ func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromTopicWorkerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromTopicWorkerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10489,7 +27110,7 @@ func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10517,9 +27138,7 @@ func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10542,7 +27161,7 @@ func (m *FundTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromTopicWorkerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10565,10 +27184,10 @@ func (m *FundTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromTopicWorkerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromTopicWorkerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10592,7 +27211,7 @@ func (m *FundTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *AddToTopicReputerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10615,10 +27234,10 @@ func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddToWhitelistAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToTopicReputerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddToWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToTopicReputerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10654,6 +27273,25 @@ func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -10706,7 +27344,7 @@ func (m *AddToWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *AddToTopicReputerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10729,10 +27367,10 @@ func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddToWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToTopicReputerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToTopicReputerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10756,7 +27394,7 @@ func (m *AddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromTopicReputerWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10779,10 +27417,10 @@ func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromTopicReputerWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromTopicReputerWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10818,6 +27456,25 @@ func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -10870,7 +27527,7 @@ func (m *RemoveFromWhitelistAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromTopicReputerWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10893,10 +27550,10 @@ func (m *RemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromTopicReputerWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromTopicReputerWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
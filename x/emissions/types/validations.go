@@ -17,6 +17,14 @@ var (
 	inferenceForecastsBundleBufferPool = utils.NewBytesPool(1024, 0)
 )
 
+const (
+	// Tags and categories of topics are indexed => they are kept few and short
+	MaxTopicMetadataTags        = 8
+	MaxTopicMetadataLabelLength = 32
+	// Every output of a topic is synthesized separately => the number of outputs is bounded
+	MaxTopicOutputDimension = 32
+)
+
 /// EXTERNAL TYPE VALIDATIONS
 
 // ValidateDec checks if the given value is a valid Dec by our standards
@@ -120,11 +128,68 @@ func (inference *Inference) Validate() error {
 	if err := ValidateDec(inference.Value); err != nil {
 		return errors.Wrap(err, "inference value is invalid")
 	}
+	if len(inference.Values) > 0 && !inference.Value.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference cannot have both a value and values")
+	}
+	for _, value := range inference.Values {
+		if err := ValidateDec(value); err != nil {
+			return errors.Wrap(err, "inference values are invalid")
+		}
+	}
 	// ExtraData not validated as it is not used by the chain
 	// Proof not validated as it is not used by the chain
 	return nil
 }
 
+// validate that an inference has as many values as the outputs of its topic
+func (inference *Inference) ValidateOutputDimension(outputDimension uint64) error {
+	if outputDimension <= 1 {
+		if len(inference.Values) > 0 {
+			return errors.Wrap(ErrInvalidOutputDimension, "topic expects a single inference value")
+		}
+		return nil
+	}
+	if uint64(len(inference.Values)) != outputDimension {
+		return errors.Wrapf(ErrInvalidOutputDimension, "topic expects %d inference values, got %d", outputDimension, len(inference.Values))
+	}
+	return nil
+}
+
+// validate that the values of an inference of a probabilistic topic, its quantiles, do not decrease with their level
+func (inference *Inference) ValidateQuantiles() error {
+	for i := 1; i < len(inference.Values); i++ {
+		if inference.Values[i].Lt(inference.Values[i-1]) {
+			return errors.Wrapf(ErrCrossingQuantiles, "value %d of inference is below value %d", i, i-1)
+		}
+	}
+	return nil
+}
+
+// validate the quantile levels of a probabilistic topic, if any, against its output dimension and loss method
+func ValidateQuantileLevels(quantileLevels []alloraMath.Dec, outputDimension uint64, lossMethod string) error {
+	if len(quantileLevels) == 0 {
+		return nil
+	}
+	if uint64(len(quantileLevels)) != outputDimension {
+		return errors.Wrapf(ErrInvalidQuantileLevels, "%d quantile levels for an output dimension of %d", len(quantileLevels), outputDimension)
+	}
+	if !IsQuantileLossMethod(lossMethod) {
+		return errors.Wrapf(ErrInvalidQuantileLevels, "loss method %q cannot score quantiles, expected one of %v", lossMethod, QuantileLossMethods())
+	}
+	for i, level := range quantileLevels {
+		if err := ValidateDec(level); err != nil {
+			return errors.Wrapf(ErrInvalidQuantileLevels, "level %d: %s", i, err)
+		}
+		if !level.IsPositive() || level.Gte(alloraMath.OneDec()) {
+			return errors.Wrapf(ErrInvalidQuantileLevels, "level %s not in (0, 1)", level)
+		}
+		if i > 0 && level.Lte(quantileLevels[i-1]) {
+			return errors.Wrap(ErrInvalidQuantileLevels, "levels must be strictly increasing")
+		}
+	}
+	return nil
+}
+
 // validate that a forecast follows the expected format
 func (forecast *Forecast) Validate() error {
 	if forecast == nil {
@@ -306,9 +371,65 @@ func (bundle *ValueBundle) Validate() error {
 			return errors.Wrap(err, "value bundle one out inferer forecaster value is invalid")
 		}
 	}
+
+	// without ground truth, the losses of the bundle are reported by the reputer
+	if bundle.GroundTruth != nil {
+		if len(bundle.GroundTruthValues) > 0 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "value bundle cannot have both a ground truth and ground truth values")
+		}
+		if err := ValidateDec(*bundle.GroundTruth); err != nil {
+			return errors.Wrap(err, "value bundle ground truth is invalid")
+		}
+	}
+	for _, value := range bundle.GroundTruthValues {
+		if err := ValidateDec(value); err != nil {
+			return errors.Wrap(err, "value bundle ground truth values are invalid")
+		}
+	}
 	return nil
 }
 
+// True if the bundle reports the ground truth, as a single value or one value per output of the topic
+func (bundle *ValueBundle) ReportsGroundTruth() bool {
+	return bundle.GroundTruth != nil || len(bundle.GroundTruthValues) > 0
+}
+
+// True if none of the losses that the chain computes from the ground truth are set in the bundle
+func (bundle *ValueBundle) HasNoLosses() bool {
+	return bundle.CombinedValue.IsZero() &&
+		bundle.NaiveValue.IsZero() &&
+		len(bundle.InfererValues) == 0 &&
+		len(bundle.ForecasterValues) == 0 &&
+		len(bundle.OneOutInfererValues) == 0 &&
+		len(bundle.OneOutForecasterValues) == 0 &&
+		len(bundle.OneInForecasterValues) == 0 &&
+		len(bundle.OneOutInfererForecasterValues) == 0
+}
+
+// Returns the bundle as signed by its reputer. The losses of a bundle carrying a ground truth
+// are computed by the chain once the bundle is signed, so they are left out of the signed bytes.
+func (bundle *ValueBundle) signedValueBundle() *ValueBundle {
+	if !bundle.ReportsGroundTruth() {
+		return bundle
+	}
+	return &ValueBundle{
+		TopicId:                       bundle.TopicId,
+		ReputerRequestNonce:           bundle.ReputerRequestNonce,
+		Reputer:                       bundle.Reputer,
+		ExtraData:                     bundle.ExtraData,
+		CombinedValue:                 alloraMath.Dec{},
+		InfererValues:                 nil,
+		ForecasterValues:              nil,
+		NaiveValue:                    alloraMath.Dec{},
+		OneOutInfererValues:           nil,
+		OneOutForecasterValues:        nil,
+		OneInForecasterValues:         nil,
+		OneOutInfererForecasterValues: nil,
+		GroundTruth:                   bundle.GroundTruth,
+		GroundTruthValues:             bundle.GroundTruthValues,
+	}
+}
+
 // validate that a reputer value bundle follows the expected format
 func (bundle *ReputerValueBundle) Validate() error {
 	if bundle.ValueBundle == nil {
@@ -332,7 +453,7 @@ func (bundle *ReputerValueBundle) Validate() error {
 
 	buf := reputerValueBundleBufferPool.Get()
 	defer reputerValueBundleBufferPool.Put(buf)
-	marshaled, err := bundle.ValueBundle.XXX_Marshal(buf, true)
+	marshaled, err := bundle.ValueBundle.signedValueBundle().XXX_Marshal(buf, true)
 	if err != nil {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to marshal value bundle: %s", err)
 	}
@@ -354,9 +475,29 @@ func (topic Topic) Validate(params Params) error {
 	if uint64(len(topic.Metadata)) > params.MaxStringLength {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic metadata invalid")
 	}
+	if err := topic.TypedMetadata.Validate(params.MaxStringLength); err != nil {
+		return errors.Wrap(err, "topic typed metadata invalid")
+	}
 	if len(topic.LossMethod) == 0 || uint64(len(topic.LossMethod)) > params.MaxStringLength {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic loss method invalid")
 	}
+	// topics created before the loss method registry may use other loss methods,
+	// probabilistic topics use quantile loss methods, checked along with their levels
+	if topic.ChainComputedLosses && !topic.IsProbabilistic() && !IsRegisteredLossMethod(topic.LossMethod) {
+		return errors.Wrap(ErrUnregisteredLossMethod, "chain computed losses require a registered loss method")
+	}
+	if topic.OutputDimension > MaxTopicOutputDimension {
+		return errors.Wrapf(ErrInvalidOutputDimension, "topic output dimension cannot exceed %d", MaxTopicOutputDimension)
+	}
+	if err := ValidateQuantileLevels(topic.QuantileLevels, topic.OutputDimension, topic.LossMethod); err != nil {
+		return errors.Wrap(err, "topic quantile levels invalid")
+	}
+	if err := validateTopicGroundTruthSource(topic.GroundTruthSource, topic.LossMethod, topic.OutputDimension, topic.QuantileLevels); err != nil {
+		return errors.Wrap(err, "topic ground truth source invalid")
+	}
+	if topic.IsDerived() && topic.GroundTruthSource.ParentTopicId == topic.Id {
+		return errors.Wrap(ErrTopicDependencyCycle, "topic cannot be its own ground truth source")
+	}
 	if topic.EpochLastEnded < 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic epoch last ended cannot be negative")
 	}
@@ -384,6 +525,27 @@ func (topic Topic) Validate(params Params) error {
 	if topic.WorkerSubmissionWindow > topic.EpochLength {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker submission window cannot be higher than epoch length")
 	}
+	if topic.WorkerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker reveal window cannot be negative")
+	}
+	if topic.WorkerSubmissionWindow+topic.WorkerRevealWindow > topic.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic worker submission and reveal windows cannot be longer than epoch length")
+	}
+	if topic.ReputerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic reputer reveal window cannot be negative")
+	}
+	if topic.ReputerRevealWindow >= topic.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic reputer reveal window must be shorter than epoch length")
+	}
+	if !topic.MinWorkerBond.IsNil() && topic.MinWorkerBond.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic min worker bond cannot be negative")
+	}
+	if err := ValidateDec(topic.ReputerOutlierThreshold); err != nil {
+		return errors.Wrap(err, "topic reputer outlier threshold is invalid")
+	}
+	if topic.ReputerOutlierThreshold.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "topic reputer outlier threshold cannot be negative")
+	}
 	if err := ValidateDec(topic.AlphaRegret); err != nil {
 		return errors.Wrap(err, "topic alpha regret is invalid")
 	}
@@ -429,6 +591,12 @@ func (topic Topic) Validate(params Params) error {
 	if !isAlloraDecZeroOrLessThanOne(topic.ActiveReputerQuantile) {
 		return errors.Wrap(sdkerrors.ErrInvalidType, "topic active reputer quantile must be between 0 and 1 inclusive")
 	}
+	if err := ValidateDec(topic.CreatorCommission); err != nil {
+		return errors.Wrap(err, "topic creator commission is invalid")
+	}
+	if !isAlloraDecZeroOrLessThanOne(topic.CreatorCommission) {
+		return errors.Wrap(ErrInvalidCreatorCommission, "topic creator commission must be in [0, 1)")
+	}
 
 	return nil
 }
@@ -613,6 +781,98 @@ func (stakeRemovalInfo *StakeRemovalInfo) Validate() error {
 	return nil
 }
 
+// validate that a WorkerBondRemovalInfo follows expected format
+func (workerBondRemovalInfo *WorkerBondRemovalInfo) Validate() error {
+	if err := ValidateTopicId(workerBondRemovalInfo.TopicId); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo topic id is invalid")
+	}
+	if err := ValidateBech32(workerBondRemovalInfo.Worker); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo worker is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(workerBondRemovalInfo.Amount); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo amount is invalid")
+	}
+	if err := ValidateBlockHeight(workerBondRemovalInfo.BlockRemovalStarted); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo block removal started is invalid")
+	}
+	if err := ValidateBlockHeight(workerBondRemovalInfo.BlockRemovalCompleted); err != nil {
+		return errors.Wrap(err, "workerBondRemovalInfo block removal completed is invalid")
+	}
+	if workerBondRemovalInfo.BlockRemovalStarted > workerBondRemovalInfo.BlockRemovalCompleted {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest,
+			"workerBondRemovalInfo block removal started cannot be greater than block removal completed")
+	}
+	return nil
+}
+
+// validate that a Redelegation follows expected format
+func (redelegation *Redelegation) Validate() error {
+	if err := ValidateTopicId(redelegation.TopicId); err != nil {
+		return errors.Wrap(err, "redelegation topic id is invalid")
+	}
+	for _, addr := range []string{redelegation.Delegator, redelegation.SrcReputer, redelegation.DstReputer} {
+		if err := ValidateBech32(addr); err != nil {
+			return errors.Wrap(err, "redelegation address is invalid")
+		}
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(redelegation.Amount); err != nil {
+		return errors.Wrap(err, "redelegation amount is invalid")
+	}
+	if err := ValidateBlockHeight(redelegation.BlockRedelegated); err != nil {
+		return errors.Wrap(err, "redelegation block redelegated is invalid")
+	}
+	if err := ValidateBlockHeight(redelegation.BlockMatured); err != nil {
+		return errors.Wrap(err, "redelegation block matured is invalid")
+	}
+	if redelegation.BlockRedelegated > redelegation.BlockMatured {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "redelegation block redelegated cannot be greater than block matured")
+	}
+	return nil
+}
+
+// validate that a DelegateStakeReceiptPool follows expected format
+func (pool *DelegateStakeReceiptPool) Validate() error {
+	if pool.Id == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "delegate stake receipt pool id cannot be zero")
+	}
+	if err := ValidateTopicId(pool.TopicId); err != nil {
+		return errors.Wrap(err, "delegate stake receipt pool topic id is invalid")
+	}
+	if err := ValidateBech32(pool.Reputer); err != nil {
+		return errors.Wrap(err, "delegate stake receipt pool reputer is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(pool.Shares); err != nil {
+		return errors.Wrap(err, "delegate stake receipt pool shares are invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(pool.Reserve); err != nil {
+		return errors.Wrap(err, "delegate stake receipt pool reserve is invalid")
+	}
+	return nil
+}
+
+// validate that a ReputerSlash follows expected format
+func (reputerSlash *ReputerSlash) Validate() error {
+	if err := ValidateTopicId(reputerSlash.TopicId); err != nil {
+		return errors.Wrap(err, "reputerSlash topic id is invalid")
+	}
+	if err := ValidateBech32(reputerSlash.Reputer); err != nil {
+		return errors.Wrap(err, "reputerSlash reputer is invalid")
+	}
+	if err := ValidateBlockHeight(reputerSlash.BlockHeight); err != nil {
+		return errors.Wrap(err, "reputerSlash block height is invalid")
+	}
+	if err := ValidateDec(reputerSlash.Distance); err != nil {
+		return errors.Wrap(err, "reputerSlash distance is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(reputerSlash.ReputerStakeSlashed); err != nil {
+		return errors.Wrap(err, "reputerSlash reputer stake slashed is invalid")
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(reputerSlash.DelegateStakeSlashed); err != nil {
+		return errors.Wrap(err, "reputerSlash delegate stake slashed is invalid")
+	}
+	return nil
+}
+
 // validate that a DelegateStakeRemovalInfo follows expected format
 func (delegateStakeRemovalInfo *DelegateStakeRemovalInfo) Validate() error {
 	if err := ValidateTopicId(delegateStakeRemovalInfo.TopicId); err != nil {
@@ -676,6 +936,106 @@ func (msg *RemoveRegistrationRequest) Validate() error {
 	return nil
 }
 
+// validate that a batch of worker payloads follows the expected format, the bundles are validated one by one on processing
+func (msg *InsertWorkerPayloadsRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(msg.WorkerDataBundles) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "at least one worker data bundle is required")
+	}
+	return nil
+}
+
+// validate that a worker payload commitment follows the expected format
+func (msg *CommitWorkerPayloadRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	commitment := WorkerCommitment{
+		TopicId:          msg.TopicId,
+		NonceBlockHeight: msg.NonceBlockHeight,
+		Worker:           msg.Sender,
+		Commitment:       msg.Commitment,
+	}
+	return commitment.Validate()
+}
+
+// validate that a worker payload reveal follows the expected format, the bundle is validated on processing
+func (msg *RevealWorkerPayloadRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.WorkerDataBundle == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker data bundle cannot be nil")
+	}
+	if len(msg.Salt) == 0 || len(msg.Salt) > MaxCommitmentSaltLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "salt must have between 1 and %d bytes", MaxCommitmentSaltLength)
+	}
+	return nil
+}
+
+// validate that a reputer payload commitment follows the expected format
+func (msg *CommitReputerPayloadRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	commitment := ReputerCommitment{
+		TopicId:          msg.TopicId,
+		NonceBlockHeight: msg.NonceBlockHeight,
+		Reputer:          msg.Sender,
+		Commitment:       msg.Commitment,
+	}
+	return commitment.Validate()
+}
+
+// validate that a reputer payload reveal follows the expected format, the bundle is validated on processing
+func (msg *RevealReputerPayloadRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.ReputerValueBundle == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer value bundle cannot be nil")
+	}
+	if len(msg.Salt) == 0 || len(msg.Salt) > MaxCommitmentSaltLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "salt must have between 1 and %d bytes", MaxCommitmentSaltLength)
+	}
+	return nil
+}
+
+// validate that an update node info request follows the expected format
+func (msg *UpdateNodeInfoRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.NodeAddress)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid node address (%s)", err)
+	}
+	if msg.NewNodeAddress == "" && msg.NewOwner == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "either a new node address or a new owner must be given")
+	}
+	if msg.NewNodeAddress != "" {
+		_, err = sdk.AccAddressFromBech32(msg.NewNodeAddress)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new node address (%s)", err)
+		}
+	}
+	if msg.NewOwner != "" {
+		_, err = sdk.AccAddressFromBech32(msg.NewOwner)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+		}
+	}
+	return nil
+}
+
 // stakeValidateHelper validates the provided addresses and amount.
 // The `allowZeroAmount` parameter determines whether zero amounts are acceptable.
 // In some cases, such as cancel or reward operations, zero amounts are valid, as they indicate no specific stake or value transfer is expected.
@@ -730,11 +1090,183 @@ func (msg *RewardDelegateStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender, msg.Reputer}, cosmosMath.ZeroInt(), true)
 }
 
+// validate that a redelegate stake request follows the expected format
+func (msg *RedelegateStakeRequest) Validate() error {
+	if msg.SrcReputer == msg.DstReputer {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot redelegate to the same reputer")
+	}
+	if msg.DstReputer == msg.Sender {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cannot self delegate")
+	}
+	return stakeValidateHelper([]string{msg.Sender, msg.SrcReputer, msg.DstReputer}, msg.Amount, false)
+}
+
+// validate that a set delegate reward auto-compound request follows the expected format
+func (msg *SetDelegateRewardAutoCompoundRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
+}
+
+// validate that a claim all delegate rewards request follows the expected format
+func (msg *ClaimAllDelegateRewardsRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
+}
+
+// validate that a tokenize delegate stake request follows the expected format
+func (msg *TokenizeDelegateStakeRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender, msg.Reputer}, msg.Amount, false)
+}
+
+// validate that a redeem delegate stake receipt request follows the expected format
+func (msg *RedeemDelegateStakeReceiptRequest) Validate() error {
+	if msg.PoolId == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "pool id cannot be zero")
+	}
+	return stakeValidateHelper([]string{msg.Sender}, msg.Shares, false)
+}
+
 // validate that a cancel remove stake request follows the expected format
 func (msg *CancelRemoveStakeRequest) Validate() error {
 	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
 }
 
+// validate that an add worker bond request follows the expected format
+func (msg *AddWorkerBondRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
+}
+
+// validate that a remove worker bond request follows the expected format
+func (msg *RemoveWorkerBondRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
+}
+
+// validate that a cancel remove worker bond request follows the expected format
+func (msg *CancelRemoveWorkerBondRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, cosmosMath.ZeroInt(), true)
+}
+
+// validate that a slash worker bond request follows the expected format
+func (msg *SlashWorkerBondRequest) Validate() error {
+	if err := stakeValidateHelper([]string{msg.Sender, msg.Worker}, msg.Amount, false); err != nil {
+		return err
+	}
+	if msg.Reason == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "the reason of the slash must be given")
+	}
+	return nil
+}
+
+// Validate checks that the structured metadata of a topic, if any, is valid.
+// Tags and category must be labels as checked by ValidateTopicMetadataLabel, and tags must be unique
+func (metadata *TopicMetadata) Validate(maxStringLen uint64) error {
+	if metadata == nil {
+		return nil
+	}
+	if uint64(len(metadata.Target)) > maxStringLen {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "target too long")
+	}
+	if uint64(len(metadata.Unit)) > maxStringLen {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "unit too long")
+	}
+	if metadata.PredictionHorizonSeconds < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidType, "prediction horizon cannot be negative")
+	}
+	if len(metadata.Tags) > MaxTopicMetadataTags {
+		return errors.Wrapf(sdkerrors.ErrInvalidType, "at most %d tags are allowed", MaxTopicMetadataTags)
+	}
+	seen := make(map[string]bool, len(metadata.Tags))
+	for _, tag := range metadata.Tags {
+		if err := ValidateTopicMetadataLabel(tag); err != nil {
+			return errors.Wrapf(err, "tag %q invalid", tag)
+		}
+		if seen[tag] {
+			return errors.Wrapf(sdkerrors.ErrInvalidType, "duplicate tag %q", tag)
+		}
+		seen[tag] = true
+	}
+	if metadata.Category != "" {
+		if err := ValidateTopicMetadataLabel(metadata.Category); err != nil {
+			return errors.Wrapf(err, "category %q invalid", metadata.Category)
+		}
+	}
+	return nil
+}
+
+// ValidateTopicMetadataLabel checks that a topic tag or category is a non-empty label of at most
+// MaxTopicMetadataLabelLength characters among lowercase letters, digits and "-", "_", ".", "/"
+func ValidateTopicMetadataLabel(label string) error {
+	if len(label) == 0 || len(label) > MaxTopicMetadataLabelLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidType, "label must have between 1 and %d characters", MaxTopicMetadataLabelLength)
+	}
+	for _, c := range []byte(label) {
+		isLower := c >= 'a' && c <= 'z'
+		isDigit := c >= '0' && c <= '9'
+		if !isLower && !isDigit && c != '-' && c != '_' && c != '.' && c != '/' {
+			return errors.Wrap(sdkerrors.ErrInvalidType, "label can only contain lowercase letters, digits and - _ . /")
+		}
+	}
+	return nil
+}
+
+// validate that an update topic request follows the expected format.
+// The updated values themselves are validated against the resulting topic with Topic.Validate
+func (msg *UpdateTopicRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid msg topic id")
+	}
+	numFields := []int{
+		len(msg.Metadata),
+		len(msg.EpochLength),
+		len(msg.GroundTruthLag),
+		len(msg.WorkerSubmissionWindow),
+		len(msg.PNorm),
+		len(msg.AlphaRegret),
+		len(msg.Epsilon),
+		len(msg.MeritSortitionAlpha),
+		len(msg.ActiveInfererQuantile),
+		len(msg.ActiveForecasterQuantile),
+		len(msg.ActiveReputerQuantile),
+		len(msg.TypedMetadata),
+		len(msg.GroundTruthSource),
+		len(msg.CreatorCommission),
+		len(msg.WorkerRevealWindow),
+		len(msg.ReputerRevealWindow),
+		len(msg.MinWorkerBond),
+		len(msg.ReputerOutlierThreshold),
+	}
+	anySet := false
+	for _, n := range numFields {
+		if n > 1 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "each updated topic field must have at most one value")
+		}
+		if n == 1 {
+			anySet = true
+		}
+	}
+	if !anySet {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "at least one topic field must be updated")
+	}
+	if len(msg.GroundTruthSource) == 1 {
+		if err := msg.GroundTruthSource[0].Validate(); err != nil {
+			return errors.Wrap(err, "invalid msg ground truth source")
+		}
+	}
+	return nil
+}
+
+// validate that an archive topic request follows the expected format
+func (msg *ArchiveTopicRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid msg topic id")
+	}
+	return nil
+}
+
 // Validate checks if the given CreateNewTopicRequest is valid
 func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if err := ValidateBech32(msg.Creator); err != nil {
@@ -744,6 +1276,19 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if len(msg.LossMethod) == 0 || uint64(len(msg.LossMethod)) > maxStringLen {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "loss method invalid")
 	}
+	// probabilistic topics use quantile loss methods, checked along with their levels
+	if len(msg.QuantileLevels) == 0 && !IsRegisteredLossMethod(msg.LossMethod) {
+		return errors.Wrapf(ErrUnregisteredLossMethod, "%q, expected one of %v", msg.LossMethod, RegisteredLossMethods())
+	}
+	if msg.OutputDimension > MaxTopicOutputDimension {
+		return errors.Wrapf(ErrInvalidOutputDimension, "output dimension cannot exceed %d", MaxTopicOutputDimension)
+	}
+	if err := ValidateQuantileLevels(msg.QuantileLevels, msg.OutputDimension, msg.LossMethod); err != nil {
+		return errors.Wrap(err, "quantile levels invalid")
+	}
+	if err := validateTopicGroundTruthSource(msg.GroundTruthSource, msg.LossMethod, msg.OutputDimension, msg.QuantileLevels); err != nil {
+		return errors.Wrap(err, "ground truth source invalid")
+	}
 	if msg.EpochLength <= 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "epoch length must be greater than zero")
 	}
@@ -756,6 +1301,24 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if msg.WorkerSubmissionWindow > msg.EpochLength {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker submission window cannot be higher than epoch length")
 	}
+	if msg.WorkerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker reveal window cannot be negative")
+	}
+	if msg.WorkerSubmissionWindow+msg.WorkerRevealWindow > msg.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker submission and reveal windows cannot be longer than epoch length")
+	}
+	if msg.ReputerRevealWindow < 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer reveal window cannot be negative")
+	}
+	if msg.ReputerRevealWindow >= msg.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer reveal window must be shorter than epoch length")
+	}
+	if !msg.MinWorkerBond.IsNil() && msg.MinWorkerBond.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "min worker bond cannot be negative")
+	}
+	if msg.ReputerOutlierThreshold.IsNegative() || ValidateDec(msg.ReputerOutlierThreshold) != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reputer outlier threshold cannot be negative")
+	}
 	if msg.AlphaRegret.Lte(alloraMath.ZeroDec()) || msg.AlphaRegret.Gt(alloraMath.OneDec()) || ValidateDec(msg.AlphaRegret) != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "alpha regret must be greater than 0 and less than or equal to 1")
 	}
@@ -768,6 +1331,9 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if uint64(len(msg.Metadata)) > maxStringLen {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "metadata invalid")
 	}
+	if err := msg.TypedMetadata.Validate(maxStringLen); err != nil {
+		return errors.Wrap(err, "typed metadata invalid")
+	}
 	// no validation on AllowNegative because either it is true or false
 	// and both are valid values
 	//	AllowNegative            bool
@@ -784,6 +1350,199 @@ func (msg *CreateNewTopicRequest) Validate(maxStringLen uint64) error {
 	if !isAlloraDecZeroOrLessThanOne(msg.ActiveReputerQuantile) {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "active reputer quantile must be between 0 and 1 inclusive")
 	}
+	if !isAlloraDecZeroOrLessThanOne(msg.CreatorCommission) || ValidateDec(msg.CreatorCommission) != nil {
+		return errors.Wrap(ErrInvalidCreatorCommission, "creator commission must be in [0, 1)")
+	}
+
+	return nil
+}
+
+// validate that a request enabling or disabling a whitelist of a topic follows the expected format
+func validateTopicWhitelistToggle(sender string, topicId uint64) error {
+	if err := ValidateBech32(sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(topicId); err != nil {
+		return errors.Wrap(err, "invalid msg topic id")
+	}
+	return nil
+}
+
+// validate that a request adding to or removing from a whitelist of a topic follows the expected format
+func validateTopicWhitelistUpdate(sender string, topicId uint64, address string) error {
+	if err := validateTopicWhitelistToggle(sender, topicId); err != nil {
+		return err
+	}
+	if err := ValidateBech32(address); err != nil {
+		return errors.Wrap(err, "invalid msg Address")
+	}
+	return nil
+}
+
+func (msg *EnableTopicWorkerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistToggle(msg.Sender, msg.TopicId)
+}
+
+func (msg *DisableTopicWorkerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistToggle(msg.Sender, msg.TopicId)
+}
+
+func (msg *EnableTopicReputerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistToggle(msg.Sender, msg.TopicId)
+}
+
+func (msg *DisableTopicReputerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistToggle(msg.Sender, msg.TopicId)
+}
+
+func (msg *AddToTopicWorkerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistUpdate(msg.Sender, msg.TopicId, msg.Address)
+}
+
+func (msg *RemoveFromTopicWorkerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistUpdate(msg.Sender, msg.TopicId, msg.Address)
+}
+
+func (msg *AddToTopicReputerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistUpdate(msg.Sender, msg.TopicId, msg.Address)
+}
+
+func (msg *RemoveFromTopicReputerWhitelistRequest) Validate() error {
+	return validateTopicWhitelistUpdate(msg.Sender, msg.TopicId, msg.Address)
+}
+
+// validate that a funding subscription drips a positive amount at a non-negative interval
+func (subscription *FundingSubscription) Validate() error {
+	if err := ValidateTopicId(subscription.TopicId); err != nil {
+		return errors.Wrap(err, "invalid topic id")
+	}
+	if err := ValidateBech32(subscription.Funder); err != nil {
+		return errors.Wrap(err, "invalid funder address")
+	}
+	if err := validateFundingSubscriptionDrips(subscription.AmountPerDrip, subscription.IntervalBlocks); err != nil {
+		return err
+	}
+	if err := ValidateSdkIntRepresentingMonetaryValue(subscription.Escrow); err != nil {
+		return errors.Wrap(err, "invalid escrow")
+	}
+	if err := ValidateBlockHeight(subscription.NextDripBlockHeight); err != nil {
+		return errors.Wrap(err, "invalid next drip block height")
+	}
+	return nil
+}
 
+func validateFundingSubscriptionDrips(amountPerDrip cosmosMath.Int, intervalBlocks int64) error {
+	if amountPerDrip.IsNil() || !amountPerDrip.IsPositive() {
+		return errors.Wrap(ErrInvalidFundingSubscription, "amount per drip must be positive")
+	}
+	if intervalBlocks < 0 {
+		return errors.Wrap(ErrInvalidFundingSubscription, "interval cannot be negative")
+	}
+	return nil
+}
+
+func (msg *CreateFundingSubscriptionRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	if err := ValidateTopicId(msg.TopicId); err != nil {
+		return errors.Wrap(err, "invalid msg topic id")
+	}
+	if err := validateFundingSubscriptionDrips(msg.AmountPerDrip, msg.IntervalBlocks); err != nil {
+		return err
+	}
+	if msg.EscrowAmount.IsNil() || msg.EscrowAmount.LT(msg.AmountPerDrip) {
+		return errors.Wrap(ErrInvalidFundingSubscription, "escrow must cover at least one drip")
+	}
+	return nil
+}
+
+func (msg *TopUpFundingSubscriptionRequest) Validate() error {
+	return stakeValidateHelper([]string{msg.Sender}, msg.Amount, false)
+}
+
+func (msg *CancelFundingSubscriptionRequest) Validate() error {
+	if err := ValidateBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid msg Sender address")
+	}
+	return nil
+}
+
+// validate that an ActorLiveness follows expected format
+func (liveness *ActorLiveness) Validate() error {
+	if err := ValidateBlockHeight(liveness.LastSubmissionNonce); err != nil {
+		return errors.Wrap(err, "liveness last submission nonce is invalid")
+	}
+	if err := ValidateBlockHeight(liveness.ActiveSince); err != nil {
+		return errors.Wrap(err, "liveness active since is invalid")
+	}
+	if err := ValidateBlockHeight(liveness.JailedAtBlock); err != nil {
+		return errors.Wrap(err, "liveness jailed at block is invalid")
+	}
+	return nil
+}
+
+func (msg *UnjailRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// Validates the rates of a reputer commission the way x/staking validates those of a validator commission
+func validateReputerCommissionRates(rate, maxRate, maxChangeRate alloraMath.Dec) error {
+	for _, commissionRate := range []alloraMath.Dec{rate, maxRate, maxChangeRate} {
+		if err := ValidateDec(commissionRate); err != nil {
+			return errors.Wrap(ErrInvalidReputerCommission, err.Error())
+		}
+		if !isAlloraDecBetweenZeroAndOneInclusive(commissionRate) {
+			return errors.Wrap(ErrInvalidReputerCommission, "commission rates must be in [0, 1]")
+		}
+	}
+	if rate.Gt(maxRate) {
+		return errors.Wrap(ErrInvalidReputerCommission, "commission rate cannot be more than the max rate")
+	}
+	if maxChangeRate.Gt(maxRate) {
+		return errors.Wrap(ErrInvalidReputerCommission, "commission max change rate cannot be more than the max rate")
+	}
+	return nil
+}
+
+func (commission *ReputerCommission) Validate() error {
+	if err := ValidateTopicId(commission.TopicId); err != nil {
+		return errors.Wrap(err, "commission topic id is invalid")
+	}
+	if err := ValidateBech32(commission.Reputer); err != nil {
+		return errors.Wrap(err, "commission reputer is invalid")
+	}
+	if err := validateReputerCommissionRates(commission.Rate, commission.MaxRate, commission.MaxChangeRate); err != nil {
+		return err
+	}
+	if err := ValidateBlockHeight(commission.UpdateBlockHeight); err != nil {
+		return errors.Wrap(err, "commission update block height is invalid")
+	}
+	return nil
+}
+
+func (msg *CreateReputerCommissionRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return validateReputerCommissionRates(msg.Rate, msg.MaxRate, msg.MaxChangeRate)
+}
+
+func (msg *EditReputerCommissionRequest) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateDec(msg.Rate); err != nil {
+		return errors.Wrap(ErrInvalidReputerCommission, err.Error())
+	}
+	if !isAlloraDecBetweenZeroAndOneInclusive(msg.Rate) {
+		return errors.Wrap(ErrInvalidReputerCommission, "commission rate must be in [0, 1]")
+	}
 	return nil
 }
@@ -77,6 +77,9 @@ type Inference struct {
 	Value       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
 	ExtraData   []byte                                          `protobuf:"bytes,5,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
 	Proof       string                                          `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	// One value per output of a topic whose output dimension is greater than 1,
+	// in which case value is left unset
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -191,6 +194,48 @@ func (m *Inferences) GetInferences() []*Inference {
 	return nil
 }
 
+// Value of the quantile of a distribution at a level in (0, 1)
+type Quantile struct {
+	Level github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=level,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"level"`
+	Value github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+}
+
+func (m *Quantile) Reset()         { *m = Quantile{} }
+func (m *Quantile) String() string { return proto.CompactTextString(m) }
+func (*Quantile) ProtoMessage()    {}
+func (*Quantile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60e5359cc895864b, []int{3}
+}
+func (m *Quantile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quantile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quantile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quantile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quantile.Merge(m, src)
+}
+func (m *Quantile) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quantile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quantile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quantile proto.InternalMessageInfo
+
+// Forecasted loss of an inferer. The losses of a topic are defined over all of
+// its outputs, so forecast elements hold a single value whatever the output
+// dimension of the topic.
 type ForecastElement struct {
 	Inferer string                                          `protobuf:"bytes,1,opt,name=inferer,proto3" json:"inferer,omitempty"`
 	Value   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
@@ -200,7 +245,7 @@ func (m *ForecastElement) Reset()         { *m = ForecastElement{} }
 func (m *ForecastElement) String() string { return proto.CompactTextString(m) }
 func (*ForecastElement) ProtoMessage()    {}
 func (*ForecastElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60e5359cc895864b, []int{3}
+	return fileDescriptor_60e5359cc895864b, []int{4}
 }
 func (m *ForecastElement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +293,7 @@ func (m *Forecast) Reset()         { *m = Forecast{} }
 func (m *Forecast) String() string { return proto.CompactTextString(m) }
 func (*Forecast) ProtoMessage()    {}
 func (*Forecast) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60e5359cc895864b, []int{4}
+	return fileDescriptor_60e5359cc895864b, []int{5}
 }
 func (m *Forecast) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +365,7 @@ func (m *Forecasts) Reset()         { *m = Forecasts{} }
 func (m *Forecasts) String() string { return proto.CompactTextString(m) }
 func (*Forecasts) ProtoMessage()    {}
 func (*Forecasts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60e5359cc895864b, []int{5}
+	return fileDescriptor_60e5359cc895864b, []int{6}
 }
 func (m *Forecasts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +410,7 @@ func (m *InferenceForecastBundle) Reset()         { *m = InferenceForecastBundle
 func (m *InferenceForecastBundle) String() string { return proto.CompactTextString(m) }
 func (*InferenceForecastBundle) ProtoMessage()    {}
 func (*InferenceForecastBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60e5359cc895864b, []int{6}
+	return fileDescriptor_60e5359cc895864b, []int{7}
 }
 func (m *InferenceForecastBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +466,7 @@ func (m *WorkerDataBundle) Reset()         { *m = WorkerDataBundle{} }
 func (m *WorkerDataBundle) String() string { return proto.CompactTextString(m) }
 func (*WorkerDataBundle) ProtoMessage()    {}
 func (*WorkerDataBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60e5359cc895864b, []int{7}
+	return fileDescriptor_60e5359cc895864b, []int{8}
 }
 func (m *WorkerDataBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +545,7 @@ func (m *WorkerDataBundles) Reset()         { *m = WorkerDataBundles{} }
 func (m *WorkerDataBundles) String() string { return proto.CompactTextString(m) }
 func (*WorkerDataBundles) ProtoMessage()    {}
 func (*WorkerDataBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60e5359cc895864b, []int{8}
+	return fileDescriptor_60e5359cc895864b, []int{9}
 }
 func (m *WorkerDataBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,63 +581,141 @@ func (m *WorkerDataBundles) GetWorkerDataBundles() []*WorkerDataBundle {
 	return nil
 }
 
+// Hash a worker committed to in the submission window of a worker nonce, for
+// the inference forecast bundle it reveals in the reveal window of the nonce
+type WorkerCommitment struct {
+	TopicId          uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64  `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	Worker           string `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	// sha256 of the serialized inference forecast bundle followed by a salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *WorkerCommitment) Reset()         { *m = WorkerCommitment{} }
+func (m *WorkerCommitment) String() string { return proto.CompactTextString(m) }
+func (*WorkerCommitment) ProtoMessage()    {}
+func (*WorkerCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60e5359cc895864b, []int{10}
+}
+func (m *WorkerCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerCommitment.Merge(m, src)
+}
+func (m *WorkerCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerCommitment proto.InternalMessageInfo
+
+func (m *WorkerCommitment) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *WorkerCommitment) GetNonceBlockHeight() int64 {
+	if m != nil {
+		return m.NonceBlockHeight
+	}
+	return 0
+}
+
+func (m *WorkerCommitment) GetWorker() string {
+	if m != nil {
+		return m.Worker
+	}
+	return ""
+}
+
+func (m *WorkerCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TimestampedValue)(nil), "emissions.v3.TimestampedValue")
 	proto.RegisterType((*Inference)(nil), "emissions.v3.Inference")
 	proto.RegisterType((*Inferences)(nil), "emissions.v3.Inferences")
+	proto.RegisterType((*Quantile)(nil), "emissions.v3.Quantile")
 	proto.RegisterType((*ForecastElement)(nil), "emissions.v3.ForecastElement")
 	proto.RegisterType((*Forecast)(nil), "emissions.v3.Forecast")
 	proto.RegisterType((*Forecasts)(nil), "emissions.v3.Forecasts")
 	proto.RegisterType((*InferenceForecastBundle)(nil), "emissions.v3.InferenceForecastBundle")
 	proto.RegisterType((*WorkerDataBundle)(nil), "emissions.v3.WorkerDataBundle")
 	proto.RegisterType((*WorkerDataBundles)(nil), "emissions.v3.WorkerDataBundles")
+	proto.RegisterType((*WorkerCommitment)(nil), "emissions.v3.WorkerCommitment")
 }
 
 func init() { proto.RegisterFile("emissions/v3/worker.proto", fileDescriptor_60e5359cc895864b) }
 
 var fileDescriptor_60e5359cc895864b = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0xd0, 0x16, 0xe8, 0x83, 0x44, 0x18, 0x08, 0x2c, 0x24, 0x2c, 0xb5, 0x09, 0x49, 0x3d,
-	0xd8, 0x4d, 0x40, 0x83, 0xf1, 0x26, 0x01, 0x23, 0x26, 0x92, 0x38, 0x1a, 0x4d, 0xbc, 0x6c, 0xa6,
-	0xdb, 0x69, 0x3b, 0xa1, 0xdd, 0xd9, 0xec, 0x4c, 0xf9, 0x73, 0xf3, 0xec, 0xc9, 0x8f, 0xe0, 0xa7,
-	0x31, 0x1c, 0x39, 0xaa, 0x07, 0x62, 0xe0, 0xe2, 0xc5, 0xef, 0x60, 0x76, 0x76, 0x76, 0x97, 0x5d,
-	0xac, 0x9a, 0xc8, 0x6d, 0xdf, 0xdb, 0x5f, 0xdf, 0xfb, 0xfd, 0xd9, 0x99, 0xc2, 0x0a, 0x1b, 0x72,
-	0x29, 0xb9, 0xf0, 0xa5, 0x73, 0xb4, 0xe5, 0x1c, 0x8b, 0xf0, 0x90, 0x85, 0xad, 0x20, 0x14, 0x4a,
-	0xe0, 0xd9, 0xf4, 0x55, 0xeb, 0x68, 0x6b, 0xd5, 0xca, 0x01, 0x7d, 0xe1, 0x7b, 0x2c, 0xc6, 0xad,
-	0x2e, 0xf6, 0x44, 0x4f, 0xe8, 0x47, 0x27, 0x7a, 0x8a, 0xbb, 0x8d, 0x0f, 0x08, 0xe6, 0x5e, 0xf3,
-	0x21, 0x93, 0x8a, 0x0e, 0x03, 0xd6, 0x79, 0x43, 0x07, 0x23, 0x86, 0xef, 0xc2, 0x6c, 0x7b, 0x20,
-	0xbc, 0x43, 0xb7, 0xcf, 0x78, 0xaf, 0xaf, 0x2c, 0x54, 0x47, 0xcd, 0x32, 0x99, 0xd1, 0xbd, 0x67,
-	0xba, 0x85, 0x5f, 0x40, 0xf5, 0x28, 0xc2, 0x5a, 0x13, 0x75, 0xd4, 0xac, 0xed, 0x6c, 0x9f, 0x5d,
-	0xac, 0x97, 0xbe, 0x5d, 0xac, 0x3b, 0x3d, 0xae, 0xfa, 0xa3, 0x76, 0xcb, 0x13, 0x43, 0x87, 0x0e,
-	0x06, 0x22, 0xa4, 0xf7, 0x7d, 0xa6, 0x22, 0xbe, 0x49, 0xe9, 0xf5, 0x29, 0xf7, 0x9d, 0x21, 0x55,
-	0xfd, 0xd6, 0x2e, 0xf3, 0x48, 0x3c, 0xe5, 0x71, 0xe5, 0xc7, 0xa7, 0x75, 0xd4, 0xf8, 0x89, 0xa0,
-	0xb6, 0xef, 0x77, 0x59, 0xc8, 0x7c, 0x8f, 0xe1, 0x15, 0x98, 0x56, 0x22, 0xe0, 0x9e, 0xcb, 0x3b,
-	0x9a, 0x41, 0x85, 0x4c, 0xe9, 0x7a, 0xbf, 0x73, 0x83, 0xe0, 0xc4, 0x4d, 0x82, 0x16, 0x4c, 0x71,
-	0x3d, 0x2a, 0xb4, 0xca, 0x11, 0x45, 0x92, 0x94, 0x19, 0xf5, 0xca, 0x6d, 0x50, 0xc7, 0x6b, 0x00,
-	0xec, 0x44, 0x85, 0xd4, 0xed, 0x50, 0x45, 0xad, 0x6a, 0x1d, 0x35, 0x67, 0x49, 0x4d, 0x77, 0x76,
-	0xa9, 0xa2, 0x78, 0x11, 0xaa, 0x41, 0x28, 0x44, 0xd7, 0x9a, 0xd4, 0x2c, 0xe2, 0xc2, 0xe8, 0xdd,
-	0x03, 0x48, 0xe5, 0x4a, 0xbc, 0x0d, 0xc0, 0xd3, 0xca, 0x42, 0xf5, 0x72, 0x73, 0x66, 0x73, 0xb9,
-	0x75, 0x3d, 0xdd, 0x56, 0x8a, 0x26, 0xd7, 0xa0, 0x8d, 0xf7, 0x08, 0xee, 0x3c, 0x15, 0x21, 0xf3,
-	0xa8, 0x54, 0x7b, 0x03, 0x36, 0x64, 0x7e, 0x4e, 0x3e, 0x1a, 0x23, 0xff, 0x36, 0x93, 0xfb, 0x8a,
-	0x60, 0x3a, 0xa1, 0xf0, 0x9f, 0xc1, 0xd9, 0x00, 0x5d, 0x33, 0x29, 0xcd, 0xee, 0x5a, 0x07, 0x3f,
-	0x87, 0xf9, 0xa4, 0x72, 0x59, 0xac, 0x56, 0x5a, 0x15, 0xed, 0xd6, 0x5a, 0xde, 0xad, 0x82, 0x27,
-	0x64, 0xae, 0x9b, 0x6f, 0xc8, 0xbf, 0x64, 0x67, 0xb4, 0x3d, 0x81, 0x5a, 0x32, 0x49, 0xe2, 0x07,
-	0x50, 0x4b, 0xa6, 0x24, 0x19, 0x2d, 0xfd, 0x7e, 0x2b, 0xc9, 0x80, 0xd1, 0x29, 0x5b, 0x4e, 0xb3,
-	0x4b, 0x00, 0x3b, 0x23, 0xbf, 0x33, 0x60, 0xf8, 0x21, 0xd4, 0xd2, 0x2c, 0xb5, 0x5d, 0x7f, 0x48,
-	0x3d, 0x43, 0xe2, 0x4d, 0x98, 0x4e, 0xe6, 0x6b, 0x17, 0xc7, 0xf3, 0x48, 0x71, 0x46, 0xcf, 0xe7,
-	0x09, 0x98, 0x7b, 0xab, 0x6f, 0x90, 0x48, 0xa4, 0x61, 0xb1, 0x04, 0x93, 0xf1, 0xad, 0x62, 0x3e,
-	0x17, 0x53, 0xe1, 0x7b, 0x50, 0xd5, 0x97, 0x88, 0xd9, 0xb1, 0x90, 0xdf, 0x71, 0x10, 0xbd, 0x22,
-	0x31, 0x22, 0x17, 0x7b, 0x39, 0x1f, 0xbb, 0x07, 0xab, 0x29, 0x73, 0x37, 0xb5, 0xc5, 0x6d, 0xeb,
-	0xdd, 0xfa, 0x1c, 0xce, 0x6c, 0x6e, 0x8c, 0x11, 0x9d, 0xb7, 0x8b, 0x58, 0xbc, 0xf8, 0x42, 0x1a,
-	0x09, 0x2f, 0x61, 0x23, 0x3b, 0x14, 0x37, 0xb6, 0xb8, 0x92, 0xf7, 0x7c, 0xaa, 0x46, 0x21, 0x33,
-	0x39, 0x37, 0x32, 0x70, 0x61, 0xd2, 0xab, 0x04, 0x19, 0xb9, 0x12, 0x8c, 0xda, 0x87, 0xec, 0xd4,
-	0x9c, 0x5e, 0x53, 0x19, 0x23, 0x3d, 0x98, 0x2f, 0xfa, 0x28, 0xf1, 0x01, 0x2c, 0xc4, 0xd6, 0xe9,
-	0x6f, 0xca, 0x2c, 0x4f, 0x3e, 0x15, 0x3b, 0xaf, 0xb1, 0xf8, 0x6b, 0x32, 0x7f, 0x5c, 0x9c, 0xb7,
-	0x43, 0xce, 0x2e, 0x6d, 0x74, 0x7e, 0x69, 0xa3, 0xef, 0x97, 0x36, 0xfa, 0x78, 0x65, 0x97, 0xce,
-	0xaf, 0xec, 0xd2, 0x97, 0x2b, 0xbb, 0xf4, 0xee, 0xd1, 0x3f, 0x9e, 0xd8, 0x13, 0x27, 0xfb, 0x4f,
-	0x50, 0xa7, 0x01, 0x93, 0xed, 0x49, 0x7d, 0xf7, 0x6f, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xae,
-	0x9e, 0x56, 0xa4, 0x56, 0x06, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xe6, 0xaf, 0xc9, 0x34, 0x12, 0xe9, 0xb6, 0x6a, 0xdd, 0x4a, 0x75, 0x43, 0xa4, 0x4a,
+	0x41, 0x82, 0x44, 0x6a, 0x41, 0x45, 0xdc, 0x08, 0x2d, 0xa2, 0x48, 0x14, 0xd5, 0x20, 0x90, 0xb8,
+	0x58, 0x8e, 0xb3, 0x49, 0x56, 0xb5, 0xbd, 0x91, 0x77, 0x93, 0xb6, 0x37, 0xce, 0x9c, 0x90, 0x78,
+	0x01, 0x9e, 0x80, 0x47, 0xe0, 0x88, 0x7a, 0xec, 0x11, 0x38, 0x54, 0xa8, 0xbd, 0xf0, 0x18, 0xc8,
+	0xeb, 0xb5, 0x13, 0xa7, 0x3f, 0x20, 0xb5, 0xdc, 0x3c, 0xb3, 0xe3, 0x99, 0x6f, 0xbe, 0xf9, 0x76,
+	0x16, 0x16, 0x89, 0x4b, 0x39, 0xa7, 0xcc, 0xe3, 0x8d, 0xe1, 0x7a, 0x63, 0x9f, 0xf9, 0x7b, 0xc4,
+	0xaf, 0xf7, 0x7d, 0x26, 0x18, 0x2e, 0xc5, 0x47, 0xf5, 0xe1, 0xfa, 0x92, 0x96, 0x08, 0xf4, 0x98,
+	0x67, 0x93, 0x30, 0x6e, 0x69, 0xae, 0xcb, 0xba, 0x4c, 0x7e, 0x36, 0x82, 0xaf, 0xd0, 0x5b, 0xfd,
+	0x80, 0xa0, 0xfc, 0x9a, 0xba, 0x84, 0x0b, 0xcb, 0xed, 0x93, 0xf6, 0x1b, 0xcb, 0x19, 0x10, 0x7c,
+	0x1b, 0x4a, 0x2d, 0x87, 0xd9, 0x7b, 0x66, 0x8f, 0xd0, 0x6e, 0x4f, 0x68, 0xa8, 0x82, 0x6a, 0x19,
+	0x63, 0x5a, 0xfa, 0x9e, 0x49, 0x17, 0x7e, 0x01, 0xb9, 0x61, 0x10, 0xab, 0xa5, 0x2b, 0xa8, 0x56,
+	0x6c, 0x6e, 0x1c, 0x9d, 0xac, 0xa4, 0x7e, 0x9e, 0xac, 0x34, 0xba, 0x54, 0xf4, 0x06, 0xad, 0xba,
+	0xcd, 0xdc, 0x86, 0xe5, 0x38, 0xcc, 0xb7, 0xee, 0x79, 0x44, 0x04, 0x78, 0x23, 0xd3, 0xee, 0x59,
+	0xd4, 0x6b, 0xb8, 0x96, 0xe8, 0xd5, 0x37, 0x89, 0x6d, 0x84, 0x59, 0x1e, 0x65, 0x7f, 0x7f, 0x5e,
+	0x41, 0xd5, 0xaf, 0x69, 0x28, 0x6e, 0x7b, 0x1d, 0xe2, 0x13, 0xcf, 0x26, 0x78, 0x11, 0x0a, 0x82,
+	0xf5, 0xa9, 0x6d, 0xd2, 0xb6, 0x44, 0x90, 0x35, 0xa6, 0xa4, 0xbd, 0xdd, 0x3e, 0x07, 0x30, 0x7d,
+	0x1e, 0xa0, 0x06, 0x53, 0x54, 0xa6, 0xf2, 0xb5, 0x4c, 0x00, 0xd1, 0x88, 0xcc, 0x11, 0xf4, 0xec,
+	0x4d, 0x40, 0xc7, 0xcb, 0x00, 0xe4, 0x40, 0xf8, 0x96, 0xd9, 0xb6, 0x84, 0xa5, 0xe5, 0x2a, 0xa8,
+	0x56, 0x32, 0x8a, 0xd2, 0xb3, 0x69, 0x09, 0x0b, 0xcf, 0x41, 0xae, 0xef, 0x33, 0xd6, 0xd1, 0xf2,
+	0x12, 0x45, 0x68, 0xe0, 0x97, 0x90, 0x97, 0x7f, 0x73, 0x6d, 0xaa, 0x92, 0xb9, 0x0e, 0x08, 0x95,
+	0x46, 0x11, 0xb8, 0x05, 0x10, 0xf3, 0xc7, 0xf1, 0x06, 0x00, 0x8d, 0x2d, 0x0d, 0x55, 0x32, 0xb5,
+	0xe9, 0xb5, 0x85, 0xfa, 0xb8, 0x5c, 0xea, 0x71, 0xb4, 0x31, 0x16, 0x5a, 0xfd, 0x82, 0xa0, 0xb0,
+	0x3b, 0xb0, 0x3c, 0x41, 0x1d, 0x12, 0xd0, 0xe5, 0x90, 0x21, 0x71, 0xe4, 0x0c, 0xae, 0x43, 0x97,
+	0xcc, 0xf2, 0x7f, 0x84, 0xf3, 0x1e, 0xc1, 0xad, 0xa7, 0xcc, 0x27, 0xb6, 0xc5, 0xc5, 0x96, 0x43,
+	0x5c, 0xe2, 0x25, 0x04, 0x80, 0x2e, 0x11, 0xc0, 0x4d, 0x42, 0xf8, 0x81, 0xa0, 0x10, 0x41, 0xb8,
+	0xa6, 0x74, 0x75, 0x80, 0x8e, 0xca, 0x14, 0xab, 0x77, 0xcc, 0x83, 0x9f, 0xc3, 0x4c, 0x64, 0x99,
+	0x24, 0xec, 0x96, 0x6b, 0x59, 0x39, 0xde, 0xe5, 0xe4, 0x78, 0x27, 0x38, 0x31, 0xca, 0x9d, 0xa4,
+	0x83, 0xff, 0x45, 0xbd, 0xaa, 0xb7, 0xc7, 0x50, 0x8c, 0x32, 0x71, 0x7c, 0x1f, 0x8a, 0x51, 0x96,
+	0x48, 0x54, 0xf3, 0x17, 0x57, 0x35, 0x46, 0x81, 0xc1, 0x9e, 0x59, 0x88, 0xc5, 0x16, 0x05, 0x34,
+	0x07, 0x5e, 0xdb, 0x21, 0xf8, 0x01, 0x14, 0x63, 0xf1, 0x49, 0xba, 0xae, 0x90, 0xe9, 0x28, 0x12,
+	0xaf, 0x41, 0x21, 0xca, 0x2f, 0x59, 0xbc, 0x1c, 0x47, 0x1c, 0xa7, 0xfa, 0xf9, 0x96, 0x86, 0xf2,
+	0x5b, 0xb9, 0x43, 0x83, 0x26, 0x15, 0x8a, 0x79, 0xc8, 0x87, 0x7b, 0x55, 0xc9, 0x45, 0x59, 0xf8,
+	0x0e, 0xe4, 0xe4, 0x1a, 0x55, 0x35, 0x66, 0x93, 0x35, 0x76, 0x82, 0x23, 0x23, 0x8c, 0x48, 0x8c,
+	0x3d, 0x93, 0x1c, 0xbb, 0x0d, 0x4b, 0x31, 0x72, 0x33, 0xa6, 0xc5, 0x6c, 0xc9, 0xda, 0x72, 0x13,
+	0x4d, 0xaf, 0xad, 0x5e, 0xd2, 0x74, 0x92, 0x2e, 0x43, 0xa3, 0x93, 0x07, 0x5c, 0xb5, 0xb0, 0x0b,
+	0xab, 0xf1, 0x19, 0x3f, 0x57, 0xc5, 0xe4, 0xb4, 0xeb, 0x59, 0x62, 0xe0, 0x13, 0x35, 0xe7, 0xea,
+	0x28, 0x78, 0x22, 0xd3, 0xab, 0x28, 0x32, 0x60, 0xa5, 0x3f, 0x68, 0xed, 0x91, 0x43, 0xb5, 0xbf,
+	0x94, 0xa5, 0x88, 0xb4, 0x61, 0x66, 0x92, 0x47, 0x8e, 0x77, 0x60, 0x36, 0xa4, 0x4e, 0x6a, 0x4a,
+	0x15, 0x8f, 0xa4, 0xa2, 0x27, 0x7b, 0x9c, 0xfc, 0xdb, 0x98, 0xd9, 0x9f, 0xcc, 0x57, 0xfd, 0x84,
+	0xa2, 0x69, 0x3d, 0x61, 0xae, 0x4b, 0x85, 0xbc, 0xdd, 0x57, 0xdc, 0xb0, 0xbb, 0x80, 0xe5, 0x38,
+	0xcc, 0x0b, 0xee, 0x59, 0x59, 0x9e, 0x34, 0xc7, 0x2e, 0xdb, 0x68, 0xec, 0x99, 0xc4, 0xd8, 0x75,
+	0x00, 0x3b, 0x2e, 0x27, 0x07, 0x54, 0x32, 0xc6, 0x3c, 0x4d, 0xe3, 0xe8, 0x54, 0x47, 0xc7, 0xa7,
+	0x3a, 0xfa, 0x75, 0xaa, 0xa3, 0x8f, 0x67, 0x7a, 0xea, 0xf8, 0x4c, 0x4f, 0x7d, 0x3f, 0xd3, 0x53,
+	0xef, 0x1e, 0xfe, 0xe3, 0x1e, 0x39, 0x68, 0x8c, 0xde, 0x6a, 0x71, 0xd8, 0x27, 0xbc, 0x95, 0x97,
+	0x6f, 0xf2, 0xfa, 0x9f, 0x01, 0x00, 0x5f, 0x63, 0xc3, 0xff, 0xee, 0x07, 0x00, 0x00,
 }
 
 func (this *TimestampedValue) Equal(that interface{}) bool {
@@ -659,6 +782,41 @@ func (this *Inference) Equal(that interface{}) bool {
 	if this.Proof != that1.Proof {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Quantile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Quantile)
+	if !ok {
+		that2, ok := that.(Quantile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Level.Equal(that1.Level) {
+		return false
+	}
+	if !this.Value.Equal(that1.Value) {
+		return false
+	}
 	return true
 }
 func (this *ForecastElement) Equal(that interface{}) bool {
@@ -853,6 +1011,20 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Proof) > 0 {
 		i -= len(m.Proof)
 		copy(dAtA[i:], m.Proof)
@@ -934,6 +1106,49 @@ func (m *Inferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Quantile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quantile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Quantile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWorker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Level.Size()
+		i -= size
+		if _, err := m.Level.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWorker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ForecastElement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1229,6 +1444,53 @@ func (m *WorkerDataBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintWorker(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintWorker(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NonceBlockHeight != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.NonceBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorker(v)
 	base := offset
@@ -1280,6 +1542,12 @@ func (m *Inference) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1298,6 +1566,19 @@ func (m *Inferences) Size() (n int) {
 	return n
 }
 
+func (m *Quantile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Level.Size()
+	n += 1 + l + sovWorker(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovWorker(uint64(l))
+	return n
+}
+
 func (m *ForecastElement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1421,6 +1702,29 @@ func (m *WorkerDataBundles) Size() (n int) {
 	return n
 }
 
+func (m *WorkerCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovWorker(uint64(m.TopicId))
+	}
+	if m.NonceBlockHeight != 0 {
+		n += 1 + sovWorker(uint64(m.NonceBlockHeight))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovWorker(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovWorker(uint64(l))
+	}
+	return n
+}
+
 func sovWorker(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1729,6 +2033,42 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -1834,6 +2174,124 @@ func (m *Inferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Quantile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quantile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quantile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Level.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ForecastElement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2667,6 +3125,160 @@ func (m *WorkerDataBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkerCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+			}
+			m.NonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorker(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,6 @@
+package types
+
+// True if workers must hold a bond in the topic for their payloads to be accepted
+func (topic Topic) RequiresWorkerBond() bool {
+	return !topic.MinWorkerBond.IsNil() && topic.MinWorkerBond.IsPositive()
+}
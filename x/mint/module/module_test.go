@@ -89,6 +89,9 @@ func (s *MintModuleTestSuite) SetupTest() {
 		"mint":                                  {"minter"},
 		emissionstypes.AlloraRewardsAccountName: nil,
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: nil,
+		emissionstypes.AlloraRequestsAccountName:                  nil,
+		emissionstypes.AlloraWorkerBondsAccountName:               nil,
+		emissionstypes.AlloraDelegateStakeReceiptsAccountName:     nil,
 		emissionstypes.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
 		"bonded_tokens_pool":                                      {"burner", "staking"},
 		"not_bonded_tokens_pool":                                  {"burner", "staking"},